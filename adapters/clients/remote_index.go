@@ -404,12 +404,12 @@ func (c *RemoteIndex) MultiGetObjects(ctx context.Context, hostName, indexName,
 }
 
 func (c *RemoteIndex) SearchShard(ctx context.Context, hostName, indexName,
-	shardName string, vector []float32, limit int, filters *filters.LocalFilter,
+	shardName string, vector []float32, targetVector string, limit int, filters *filters.LocalFilter,
 	keywordRanking *searchparams.KeywordRanking, sort []filters.Sort,
-	additional additional.Properties,
+	cursor *filters.Cursor, additional additional.Properties,
 ) ([]*storobj.Object, []float32, error) {
 	paramsBytes, err := clusterapi.IndicesPayloads.SearchParams.
-		Marshal(vector, limit, filters, keywordRanking, sort, additional)
+		Marshal(vector, targetVector, limit, filters, keywordRanking, sort, cursor, additional)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "marshal request payload")
 	}
@@ -608,6 +608,52 @@ func (c *RemoteIndex) DeleteObjectBatch(ctx context.Context, hostName, indexName
 	return bactchDeleteResults
 }
 
+func (c *RemoteIndex) DeleteObjects(ctx context.Context, hostName, indexName,
+	shardName string, ids []strfmt.UUID,
+) []error {
+	path := fmt.Sprintf("/indices/%s/shards/%s/objects/_delete", indexName, shardName)
+	method := http.MethodPost
+	url := url.URL{Scheme: "http", Host: hostName, Path: path}
+
+	marshalled, err := clusterapi.IndicesPayloads.DeleteObjectsParams.Marshal(ids)
+	if err != nil {
+		return duplicateErr(errors.Wrap(err, "marshal payload"), len(ids))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url.String(),
+		bytes.NewReader(marshalled))
+	if err != nil {
+		return duplicateErr(errors.Wrap(err, "open http request"), len(ids))
+	}
+
+	clusterapi.IndicesPayloads.DeleteObjectsParams.SetContentTypeHeaderReq(req)
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return duplicateErr(errors.Wrap(err, "send http request"), len(ids))
+	}
+
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(res.Body)
+		return duplicateErr(errors.Errorf("unexpected status code %d (%s)",
+			res.StatusCode, body), len(ids))
+	}
+
+	if ct, ok := clusterapi.IndicesPayloads.ErrorList.
+		CheckContentTypeHeader(res); !ok {
+		return duplicateErr(errors.Errorf("unexpected content type: %s",
+			ct), len(ids))
+	}
+
+	resBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		return duplicateErr(errors.Wrap(err, "ready body"), len(ids))
+	}
+
+	return clusterapi.IndicesPayloads.ErrorList.Unmarshal(resBytes)
+}
+
 func (c *RemoteIndex) GetShardStatus(ctx context.Context,
 	hostName, indexName, shardName string,
 ) (string, error) {
@@ -68,6 +68,12 @@ const (
 	WhereValueRangeDistanceMax             = "The maximum distance from the point specified geoCoordinates."
 	WhereValueText                         = "Specify a Text value that the target property will be compared to"
 	WhereValueDate                         = "Specify a Date value that the target property will be compared to"
+	WhereValueIntArray                     = "Specify a list of Integer values that the target property will be compared to, requires the ContainsAny, ContainsAll or In operator"
+	WhereValueNumberArray                  = "Specify a list of Float values that the target property will be compared to, requires the ContainsAny, ContainsAll or In operator"
+	WhereValueBooleanArray                 = "Specify a list of Boolean values that the target property will be compared to, requires the ContainsAny, ContainsAll or In operator"
+	WhereValueStringArray                  = "Specify a list of String values that the target property will be compared to, requires the ContainsAny, ContainsAll or In operator"
+	WhereValueTextArray                    = "Specify a list of Text values that the target property will be compared to, requires the ContainsAny, ContainsAll or In operator"
+	WhereValueDateArray                    = "Specify a list of Date values that the target property will be compared to, requires the ContainsAny, ContainsAll or In operator"
 )
 
 // Properties and Classes filter elements (used by Fetch and Introspect Where filters)
@@ -132,8 +138,9 @@ const NetworkTimeout = "Specify the time in seconds after which an unresolved re
 
 // Pagination filter elements
 const (
-	First = "Show the first x results (pagination option)"
-	After = "Show the results after the first x results (pagination option)"
+	First   = "Show the first x results (pagination option)"
+	After   = "Show the results after the first x results (pagination option)"
+	AfterID = "Show the results after the object with the given id, iterating all objects of the class in id order (cursor pagination option)"
 )
 
 const (
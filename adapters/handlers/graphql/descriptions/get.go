@@ -43,3 +43,15 @@ const (
 )
 
 const NetworkGetClassUUID = "The UUID of a Object, assigned by the Weaviate network" // TODO check this with @lauraham
+
+const (
+	HybridQuery      = "The query to run the keyword (bm25) part of the hybrid search with"
+	HybridProperties = "The properties to run the keyword (bm25) part of the hybrid search on, optionally boosted, e.g. \"title^3\""
+	HybridAlpha      = "The weight of the vector search on a scale of 0 (pure keyword search) to 1 (pure vector search)"
+	HybridVector     = "The vector to run the vector part of the hybrid search with, optional if a near<Media> argument is set"
+	HybridFusionType = "How the keyword and the vector results are combined into a single ranking"
+)
+
+const ConsistencyLevel = "How many replicas of a shard have to answer the query (ONE, QUORUM or ALL), defaults to QUORUM"
+
+const Tenant = "The tenant to run the query against, required for classes with multi-tenancy enabled"
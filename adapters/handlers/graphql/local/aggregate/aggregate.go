@@ -129,6 +129,13 @@ func classField(class *models.Class, description string,
 		Resolve: makeResolveClass(modulesProvider, class),
 	}
 
+	if schema.MultiTenancyEnabled(class) {
+		fieldsField.Args["tenant"] = &graphql.ArgumentConfig{
+			Description: descriptions.Tenant,
+			Type:        graphql.String,
+		}
+	}
+
 	if modulesProvider != nil {
 		for name, argument := range modulesProvider.AggregateArguments(class) {
 			fieldsField.Args[name] = argument
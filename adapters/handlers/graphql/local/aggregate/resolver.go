@@ -132,6 +132,10 @@ func makeResolveClass(modulesProvider ModulesProvider, class *models.Class) grap
 			ModuleParams:     moduleParams,
 		}
 
+		if tenant, ok := p.Args["tenant"]; ok {
+			params.Tenant = tenant.(string) // guaranteed by graphql
+		}
+
 		// we might support objectLimit without nearMedia filters later, e.g. with sort
 		if params.ObjectLimit != nil && !areNearMediaFiltersIncluded(params) {
 			return nil, fmt.Errorf("objectLimit can only be used with a near<Media> filter")
@@ -38,6 +38,9 @@ func BuildNew(path string) graphql.InputObjectConfigFieldMap {
 					"LessThanEqual":    &graphql.EnumValueConfig{},
 					"WithinGeoRange":   &graphql.EnumValueConfig{},
 					"IsNull":           &graphql.EnumValueConfig{},
+					"ContainsAny":      &graphql.EnumValueConfig{},
+					"ContainsAll":      &graphql.EnumValueConfig{},
+					"In":               &graphql.EnumValueConfig{},
 				},
 				Description: descriptions.WhereOperatorEnum,
 			}),
@@ -75,6 +78,30 @@ func BuildNew(path string) graphql.InputObjectConfigFieldMap {
 			Type:        newGeoRangeInputObject(path),
 			Description: descriptions.WhereValueRange,
 		},
+		"valueIntArray": &graphql.InputObjectFieldConfig{
+			Type:        graphql.NewList(graphql.Int),
+			Description: descriptions.WhereValueIntArray,
+		},
+		"valueNumberArray": &graphql.InputObjectFieldConfig{
+			Type:        graphql.NewList(graphql.Float),
+			Description: descriptions.WhereValueNumberArray,
+		},
+		"valueBooleanArray": &graphql.InputObjectFieldConfig{
+			Type:        graphql.NewList(graphql.Boolean),
+			Description: descriptions.WhereValueBooleanArray,
+		},
+		"valueStringArray": &graphql.InputObjectFieldConfig{
+			Type:        graphql.NewList(graphql.String),
+			Description: descriptions.WhereValueStringArray,
+		},
+		"valueTextArray": &graphql.InputObjectFieldConfig{
+			Type:        graphql.NewList(graphql.String),
+			Description: descriptions.WhereValueTextArray,
+		},
+		"valueDateArray": &graphql.InputObjectFieldConfig{
+			Type:        graphql.NewList(graphql.String),
+			Description: descriptions.WhereValueDateArray,
+		},
 	}
 
 	// Recurse into the same time.
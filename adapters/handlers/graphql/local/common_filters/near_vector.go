@@ -44,5 +44,10 @@ func ExtractNearVector(source map[string]interface{}) (searchparams.NearVector,
 			fmt.Errorf("cannot provide distance and certainty")
 	}
 
+	targetVector, ok := source["targetVector"]
+	if ok {
+		args.TargetVector = targetVector.(string)
+	}
+
 	return args, nil
 }
@@ -54,6 +54,73 @@ func TestExtractFilterToplevelField(t *testing.T) {
 	resolver.AssertResolve(t, query)
 }
 
+func TestExtractFilterArrayOperators(t *testing.T) {
+	t.Parallel()
+
+	t.Run("ContainsAny", func(t *testing.T) {
+		resolver := newMockResolver(t, mockParams{reportFilter: true})
+		expectedParams := &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorContainsAny,
+			On: &filters.Path{
+				Class:    schema.AssertValidClassName("SomeAction"),
+				Property: schema.AssertValidPropertyName("intField"),
+			},
+			Value: &filters.Value{
+				Value: []int{1, 2},
+				Type:  schema.DataTypeIntArray,
+			},
+		}}
+
+		resolver.On("ReportFilters", expectedParams).
+			Return(test_helper.EmptyList(), nil).Once()
+
+		query := `{ SomeAction(where: { path: ["intField"], operator: ContainsAny, valueIntArray: [1, 2]}) }`
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("ContainsAll", func(t *testing.T) {
+		resolver := newMockResolver(t, mockParams{reportFilter: true})
+		expectedParams := &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorContainsAll,
+			On: &filters.Path{
+				Class:    schema.AssertValidClassName("SomeAction"),
+				Property: schema.AssertValidPropertyName("name"),
+			},
+			Value: &filters.Value{
+				Value: []string{"foo", "bar"},
+				Type:  schema.DataTypeStringArray,
+			},
+		}}
+
+		resolver.On("ReportFilters", expectedParams).
+			Return(test_helper.EmptyList(), nil).Once()
+
+		query := `{ SomeAction(where: { path: ["name"], operator: ContainsAll, valueStringArray: ["foo", "bar"]}) }`
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("In", func(t *testing.T) {
+		resolver := newMockResolver(t, mockParams{reportFilter: true})
+		expectedParams := &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorIn,
+			On: &filters.Path{
+				Class:    schema.AssertValidClassName("SomeAction"),
+				Property: schema.AssertValidPropertyName("intField"),
+			},
+			Value: &filters.Value{
+				Value: []int{3},
+				Type:  schema.DataTypeIntArray,
+			},
+		}}
+
+		resolver.On("ReportFilters", expectedParams).
+			Return(test_helper.EmptyList(), nil).Once()
+
+		query := `{ SomeAction(where: { path: ["intField"], operator: In, valueIntArray: [3]}) }`
+		resolver.AssertResolve(t, query)
+	})
+}
+
 func TestExtractFilterLike(t *testing.T) {
 	t.Parallel()
 
@@ -139,6 +139,7 @@ func (b *classBuilder) additionalFields(classProperties graphql.Fields, class *m
 	additionalProperties["classification"] = b.additionalClassificationField(class)
 	additionalProperties["certainty"] = b.additionalCertaintyField(class)
 	additionalProperties["distance"] = b.additionalDistanceField(class)
+	additionalProperties["score"] = b.additionalScoreField(class)
 	additionalProperties["vector"] = b.additionalVectorField(class)
 	additionalProperties["id"] = b.additionalIDField()
 	additionalProperties["creationTimeUnix"] = b.additionalCreationTimeUnix()
@@ -191,6 +192,12 @@ func (b *classBuilder) additionalDistanceField(class *models.Class) *graphql.Fie
 	}
 }
 
+func (b *classBuilder) additionalScoreField(class *models.Class) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.Float,
+	}
+}
+
 func (b *classBuilder) additionalVectorField(class *models.Class) *graphql.Field {
 	return &graphql.Field{
 		Type: graphql.NewList(graphql.Float),
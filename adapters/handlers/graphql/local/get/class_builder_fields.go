@@ -213,12 +213,18 @@ func buildGetClassField(classObject *graphql.Object,
 				Description: descriptions.After,
 				Type:        graphql.Int,
 			},
+			"after": &graphql.ArgumentConfig{
+				Description: descriptions.AfterID,
+				Type:        graphql.String,
+			},
 
 			"sort":       sortArgument(class.Class),
 			"nearVector": nearVectorArgument(class.Class),
 			"nearObject": nearObjectArgument(class.Class),
 			"where":      whereArgument(class.Class),
 			"group":      groupArgument(class.Class),
+
+			"consistencyLevel": consistencyLevelArgument(class.Class),
 		},
 		Resolve: newResolver(modulesProvider).makeResolveGetClass(class.Class),
 	}
@@ -226,6 +232,11 @@ func buildGetClassField(classObject *graphql.Object,
 	// hacky way to temporarily check feature flag
 	if os.Getenv("ENABLE_EXPERIMENTAL_BM25") != "" {
 		field.Args["bm25"] = bm25Argument(class.Class)
+		field.Args["hybrid"] = hybridArgument(class.Class)
+	}
+
+	if schema.MultiTenancyEnabled(class) {
+		field.Args["tenant"] = tenantArgument()
 	}
 
 	if modulesProvider != nil {
@@ -326,6 +337,8 @@ func (r *resolver) makeResolveGetClass(className string) graphql.FieldResolveFn
 			return nil, err
 		}
 
+		cursor := filters.ExtractCursorFromArgs(p.Args)
+
 		var sort []filters.Sort
 		if sortArg, ok := p.Args["sort"]; ok {
 			sort = filters.ExtractSortFromArgs(sortArg.([]interface{}))
@@ -368,20 +381,32 @@ func (r *resolver) makeResolveGetClass(className string) graphql.FieldResolveFn
 			keywordRankingParams = &p
 		}
 
+		var hybridParams *searchparams.HybridSearch
+		if hybrid, ok := p.Args["hybrid"]; ok {
+			p := common_filters.ExtractHybridSearch(hybrid.(map[string]interface{}))
+			hybridParams = &p
+		}
+
 		group := extractGroup(p.Args)
+		replProps := extractReplicationProperties(p.Args)
+		tenant := extractTenant(p.Args)
 
 		params := traverser.GetParams{
-			Filters:              filters,
-			ClassName:            className,
-			Pagination:           pagination,
-			Properties:           properties,
-			Sort:                 sort,
-			NearVector:           nearVectorParams,
-			NearObject:           nearObjectParams,
-			Group:                group,
-			ModuleParams:         moduleParams,
-			AdditionalProperties: additional,
-			KeywordRanking:       keywordRankingParams,
+			Filters:               filters,
+			ClassName:             className,
+			Pagination:            pagination,
+			Cursor:                cursor,
+			Properties:            properties,
+			Sort:                  sort,
+			NearVector:            nearVectorParams,
+			NearObject:            nearObjectParams,
+			Group:                 group,
+			ModuleParams:          moduleParams,
+			AdditionalProperties:  additional,
+			KeywordRanking:        keywordRankingParams,
+			HybridSearch:          hybridParams,
+			ReplicationProperties: replProps,
+			Tenant:                tenant,
 		}
 
 		// need to perform vector search by distance
@@ -400,6 +425,12 @@ func (r *resolver) makeResolveGetClass(className string) graphql.FieldResolveFn
 // search by distance. it knows to do this by watching for a limit
 // flag, specifically filters.LimitFlagSearchByDistance
 func setLimitBasedOnVectorSearchParams(params *traverser.GetParams) {
+	if params.HybridSearch != nil {
+		// a hybrid search is always ranked by its fused score, a search by
+		// distance would not make any sense for the keyword part
+		return
+	}
+
 	setLimit := func(params *traverser.GetParams) {
 		if params.Pagination == nil {
 			// limit was omitted entirely, implicitly
@@ -484,7 +515,7 @@ type additionalCheck struct {
 
 func (ac *additionalCheck) isAdditional(name string) bool {
 	if name == "classification" || name == "certainty" ||
-		name == "distance" || name == "id" || name == "vector" ||
+		name == "distance" || name == "score" || name == "id" || name == "vector" ||
 		name == "creationTimeUnix" || name == "lastUpdateTimeUnix" {
 		return true
 	}
@@ -560,6 +591,11 @@ func extractProperties(className string, selections *ast.SelectionSet,
 							continue
 						}
 
+						if additionalProperty == "score" {
+							additionalProps.Score = true
+							continue
+						}
+
 						if additionalProperty == "id" {
 							additionalProps.ID = true
 							continue
@@ -0,0 +1,45 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package get
+
+import (
+	"fmt"
+
+	"github.com/graphql-go/graphql"
+	"github.com/semi-technologies/weaviate/adapters/handlers/graphql/descriptions"
+	"github.com/semi-technologies/weaviate/entities/additional"
+)
+
+func consistencyLevelArgument(className string) *graphql.ArgumentConfig {
+	return &graphql.ArgumentConfig{
+		Description: descriptions.ConsistencyLevel,
+		Type: graphql.NewEnum(graphql.EnumConfig{
+			Name: fmt.Sprintf("GetObjects%sConsistencyLevelEnum", className),
+			Values: graphql.EnumValueConfigMap{
+				additional.ConsistencyLevelOne:    &graphql.EnumValueConfig{},
+				additional.ConsistencyLevelQuorum: &graphql.EnumValueConfig{},
+				additional.ConsistencyLevelAll:    &graphql.EnumValueConfig{},
+			},
+		}),
+	}
+}
+
+func extractReplicationProperties(args map[string]interface{}) *additional.ReplicationProperties {
+	level, ok := args["consistencyLevel"]
+	if !ok {
+		return nil
+	}
+
+	return &additional.ReplicationProperties{
+		ConsistencyLevel: level.(string), // guaranteed by graphql
+	}
+}
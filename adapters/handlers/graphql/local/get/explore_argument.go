@@ -15,7 +15,9 @@ import (
 	"fmt"
 
 	"github.com/graphql-go/graphql"
+	"github.com/semi-technologies/weaviate/adapters/handlers/graphql/descriptions"
 	"github.com/semi-technologies/weaviate/adapters/handlers/graphql/local/common_filters"
+	"github.com/semi-technologies/weaviate/entities/searchparams"
 )
 
 func nearVectorArgument(className string) *graphql.ArgumentConfig {
@@ -50,3 +52,46 @@ func bm25Fields(prefix string) graphql.InputObjectConfigFieldMap {
 		},
 	}
 }
+
+func hybridArgument(className string) *graphql.ArgumentConfig {
+	prefix := fmt.Sprintf("GetObjects%s", className)
+	return &graphql.ArgumentConfig{
+		Type: graphql.NewInputObject(
+			graphql.InputObjectConfig{
+				Name:   fmt.Sprintf("%sHybridInpObj", prefix),
+				Fields: hybridFields(prefix),
+			},
+		),
+	}
+}
+
+func hybridFields(prefix string) graphql.InputObjectConfigFieldMap {
+	return graphql.InputObjectConfigFieldMap{
+		"query": &graphql.InputObjectFieldConfig{
+			Description: descriptions.HybridQuery,
+			Type:        graphql.String,
+		},
+		"properties": &graphql.InputObjectFieldConfig{
+			Description: descriptions.HybridProperties,
+			Type:        graphql.NewList(graphql.String),
+		},
+		"alpha": &graphql.InputObjectFieldConfig{
+			Description: descriptions.HybridAlpha,
+			Type:        graphql.Float,
+		},
+		"vector": &graphql.InputObjectFieldConfig{
+			Description: descriptions.HybridVector,
+			Type:        graphql.NewList(graphql.Float),
+		},
+		"fusionType": &graphql.InputObjectFieldConfig{
+			Description: descriptions.HybridFusionType,
+			Type: graphql.NewEnum(graphql.EnumConfig{
+				Name: fmt.Sprintf("%sHybridFusionTypeEnum", prefix),
+				Values: graphql.EnumValueConfigMap{
+					searchparams.HybridRankedFusion:        &graphql.EnumValueConfig{},
+					searchparams.HybridRelativeScoreFusion: &graphql.EnumValueConfig{},
+				},
+			}),
+		},
+	}
+}
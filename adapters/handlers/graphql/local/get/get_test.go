@@ -46,6 +46,32 @@ func TestSimpleFieldParamsOK(t *testing.T) {
 	resolver.AssertResolve(t, "{ Get { SomeAction { intField } } }")
 }
 
+func TestConsistencyLevelArgument(t *testing.T) {
+	t.Parallel()
+
+	t.Run("with a valid level", func(t *testing.T) {
+		resolver := newMockResolver()
+		expectedParams := traverser.GetParams{
+			ClassName:  "SomeAction",
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			ReplicationProperties: &additional.ReplicationProperties{
+				ConsistencyLevel: additional.ConsistencyLevelOne,
+			},
+		}
+
+		resolver.On("GetClass", expectedParams).
+			Return(test_helper.EmptyList(), nil).Once()
+
+		resolver.AssertResolve(t, "{ Get { SomeAction(consistencyLevel: ONE) { intField } } }")
+	})
+
+	t.Run("with an unknown level", func(t *testing.T) {
+		resolver := newMockResolver()
+
+		resolver.AssertFailToResolve(t, "{ Get { SomeAction(consistencyLevel: TWO) { intField } } }")
+	})
+}
+
 func TestExtractIntField(t *testing.T) {
 	t.Parallel()
 
@@ -1162,6 +1188,26 @@ func TestNearVectorRanker(t *testing.T) {
 		resolver.AssertResolve(t, query)
 	})
 
+	t.Run("for things with a target vector", func(t *testing.T) {
+		query := `{ Get { SomeThing(nearVector: {
+							  vector: [0.123, 0.984]
+							  targetVector: "title"
+        			}) { intField } } }`
+
+		expectedParams := traverser.GetParams{
+			ClassName:  "SomeThing",
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			NearVector: &searchparams.NearVector{
+				Vector:       []float32{0.123, 0.984},
+				TargetVector: "title",
+			},
+		}
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
 	t.Run("for things with optional certainty set", func(t *testing.T) {
 		query := `{ Get { SomeThing(nearVector: {
 							  vector: [0.123, 0.984] 
@@ -1282,6 +1328,71 @@ func TestNearVectorRanker(t *testing.T) {
 	})
 }
 
+func TestHybridArgument(t *testing.T) {
+	// the hybrid argument is only present behind the experimental bm25 flag
+	t.Setenv("ENABLE_EXPERIMENTAL_BM25", "on")
+
+	resolver := newMockResolver()
+
+	t.Run("with defaults", func(t *testing.T) {
+		query := `{ Get { SomeThing(hybrid: {
+							  query: "some text"
+							  properties: ["name"]
+							  vector: [0.123, 0.984]
+        			}) { intField _additional { score } } } }`
+
+		expectedParams := traverser.GetParams{
+			ClassName:  "SomeThing",
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			HybridSearch: &searchparams.HybridSearch{
+				Query:      "some text",
+				Properties: []string{"name"},
+				Vector:     []float32{0.123, 0.984},
+				Alpha:      searchparams.DefaultHybridAlpha,
+				FusionType: searchparams.HybridRankedFusion,
+			},
+			AdditionalProperties: additional.Properties{
+				Score: true,
+			},
+		}
+
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("with alpha and fusion type set", func(t *testing.T) {
+		query := `{ Get { SomeThing(hybrid: {
+							  query: "some text"
+							  properties: ["name"]
+							  alpha: 0.3
+							  fusionType: relativeScoreFusion
+							}, nearVector: {
+							  vector: [0.123, 0.984]
+        			}) { intField } } }`
+
+		expectedParams := traverser.GetParams{
+			ClassName:  "SomeThing",
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			HybridSearch: &searchparams.HybridSearch{
+				Query:      "some text",
+				Properties: []string{"name"},
+				Alpha:      0.3,
+				FusionType: searchparams.HybridRelativeScoreFusion,
+			},
+			NearVector: &searchparams.NearVector{
+				Vector: []float32{0.123, 0.984},
+			},
+		}
+
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+}
+
 func TestExtractPagination(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,33 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package get
+
+import (
+	"github.com/graphql-go/graphql"
+	"github.com/semi-technologies/weaviate/adapters/handlers/graphql/descriptions"
+)
+
+func tenantArgument() *graphql.ArgumentConfig {
+	return &graphql.ArgumentConfig{
+		Description: descriptions.Tenant,
+		Type:        graphql.String,
+	}
+}
+
+func extractTenant(args map[string]interface{}) string {
+	tenant, ok := args["tenant"]
+	if !ok {
+		return ""
+	}
+
+	return tenant.(string) // guaranteed by graphql
+}
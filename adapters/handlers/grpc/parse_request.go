@@ -0,0 +1,211 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package grpc
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/filterext"
+	"github.com/semi-technologies/weaviate/entities/additional"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/entities/searchparams"
+	pb "github.com/semi-technologies/weaviate/grpc"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+)
+
+func searchParamsFromProto(req *pb.SearchRequest) (traverser.GetParams, error) {
+	out := traverser.GetParams{
+		ClassName:             req.ClassName,
+		AdditionalProperties:  additionalPropsFromProto(req.AdditionalProperties),
+		ReplicationProperties: replicationPropsFromProto(req.ConsistencyLevel),
+		Tenant:                req.Tenant,
+	}
+
+	if out.ClassName == "" {
+		return out, fmt.Errorf("class_name must be set")
+	}
+
+	for _, prop := range req.Properties {
+		out.Properties = append(out.Properties, search.SelectProperty{
+			Name:        prop,
+			IsPrimitive: true,
+		})
+	}
+
+	if nv := req.NearVector; nv != nil {
+		out.NearVector = &searchparams.NearVector{
+			Vector:       nv.Vector,
+			Certainty:    nv.GetCertainty(),
+			Distance:     nv.GetDistance(),
+			WithDistance: nv.Distance != nil,
+		}
+	}
+
+	if no := req.NearObject; no != nil {
+		out.NearObject = &searchparams.NearObject{
+			ID:           no.Id,
+			Certainty:    no.GetCertainty(),
+			Distance:     no.GetDistance(),
+			WithDistance: no.Distance != nil,
+		}
+	}
+
+	if bm25 := req.Bm25; bm25 != nil {
+		out.KeywordRanking = &searchparams.KeywordRanking{
+			Type:       "bm25",
+			Query:      bm25.Query,
+			Properties: bm25.Properties,
+		}
+	}
+
+	if req.Filters != nil {
+		where, err := whereFilterFromProto(req.Filters)
+		if err != nil {
+			return out, err
+		}
+
+		out.Filters, err = filterext.Parse(where, req.ClassName)
+		if err != nil {
+			return out, err
+		}
+	}
+
+	if req.Limit > 0 || req.Offset > 0 {
+		out.Pagination = &filters.Pagination{
+			Offset: int(req.Offset),
+			Limit:  int(req.Limit),
+		}
+		if req.Limit == 0 {
+			out.Pagination.Limit = filters.LimitFlagNotSet
+		}
+	}
+
+	// same as in the GraphQL API, a vector search with a certainty or
+	// distance, but without a limit returns all results within the threshold
+	nv, no := out.NearVector, out.NearObject
+	if req.Limit == 0 && ((nv != nil && (nv.Certainty != 0 || nv.WithDistance)) ||
+		(no != nil && (no.Certainty != 0 || no.WithDistance))) {
+		if out.Pagination == nil {
+			out.Pagination = &filters.Pagination{}
+		}
+		out.Pagination.Limit = filters.LimitFlagSearchByDist
+	}
+
+	return out, nil
+}
+
+var operators = map[pb.Filters_Operator]string{
+	pb.Filters_OPERATOR_EQUAL:              models.WhereFilterOperatorEqual,
+	pb.Filters_OPERATOR_NOT_EQUAL:          models.WhereFilterOperatorNotEqual,
+	pb.Filters_OPERATOR_GREATER_THAN:       models.WhereFilterOperatorGreaterThan,
+	pb.Filters_OPERATOR_GREATER_THAN_EQUAL: models.WhereFilterOperatorGreaterThanEqual,
+	pb.Filters_OPERATOR_LESS_THAN:          models.WhereFilterOperatorLessThan,
+	pb.Filters_OPERATOR_LESS_THAN_EQUAL:    models.WhereFilterOperatorLessThanEqual,
+	pb.Filters_OPERATOR_AND:                models.WhereFilterOperatorAnd,
+	pb.Filters_OPERATOR_OR:                 models.WhereFilterOperatorOr,
+	pb.Filters_OPERATOR_LIKE:               models.WhereFilterOperatorLike,
+	pb.Filters_OPERATOR_IS_NULL:            models.WhereFilterOperatorIsNull,
+}
+
+// whereFilterFromProto turns the filters into the REST representation of a
+// where filter, so they can be parsed and validated like any other filter
+func whereFilterFromProto(in *pb.Filters) (*models.WhereFilter, error) {
+	operator, ok := operators[in.Operator]
+	if !ok {
+		return nil, fmt.Errorf("unsupported filter operator %s", in.Operator)
+	}
+
+	out := &models.WhereFilter{
+		Operator: operator,
+		Path:     in.On,
+	}
+
+	for _, operand := range in.Filters {
+		parsed, err := whereFilterFromProto(operand)
+		if err != nil {
+			return nil, err
+		}
+		out.Operands = append(out.Operands, parsed)
+	}
+
+	switch v := in.TestValue.(type) {
+	case *pb.Filters_ValueString:
+		out.ValueString = &v.ValueString
+	case *pb.Filters_ValueText:
+		out.ValueText = &v.ValueText
+	case *pb.Filters_ValueInt:
+		out.ValueInt = &v.ValueInt
+	case *pb.Filters_ValueBoolean:
+		out.ValueBoolean = &v.ValueBoolean
+	case *pb.Filters_ValueNumber:
+		out.ValueNumber = &v.ValueNumber
+	case *pb.Filters_ValueDate:
+		out.ValueDate = &v.ValueDate
+	}
+
+	return out, nil
+}
+
+func additionalPropsFromProto(in *pb.AdditionalProperties) additional.Properties {
+	if in == nil {
+		return additional.Properties{}
+	}
+
+	return additional.Properties{
+		ID:                 in.Uuid,
+		Vector:             in.Vector,
+		CreationTimeUnix:   in.CreationTimeUnix,
+		LastUpdateTimeUnix: in.LastUpdateTimeUnix,
+		Distance:           in.Distance,
+		Certainty:          in.Certainty,
+		Score:              in.Score,
+	}
+}
+
+var consistencyLevels = map[pb.ConsistencyLevel]string{
+	pb.ConsistencyLevel_CONSISTENCY_LEVEL_ONE:    additional.ConsistencyLevelOne,
+	pb.ConsistencyLevel_CONSISTENCY_LEVEL_QUORUM: additional.ConsistencyLevelQuorum,
+	pb.ConsistencyLevel_CONSISTENCY_LEVEL_ALL:    additional.ConsistencyLevelAll,
+}
+
+func replicationPropsFromProto(in pb.ConsistencyLevel) *additional.ReplicationProperties {
+	level, ok := consistencyLevels[in]
+	if !ok {
+		return nil
+	}
+
+	return &additional.ReplicationProperties{ConsistencyLevel: level}
+}
+
+func batchObjectsFromProto(req *pb.BatchObjectsRequest) ([]*models.Object, error) {
+	out := make([]*models.Object, len(req.Objects))
+	for i, obj := range req.Objects {
+		if obj == nil {
+			return nil, fmt.Errorf("object at position %d is empty", i)
+		}
+
+		out[i] = &models.Object{
+			ID:     strfmt.UUID(obj.Uuid),
+			Class:  obj.ClassName,
+			Vector: obj.Vector,
+			Tenant: obj.Tenant,
+		}
+		if obj.Properties != nil {
+			out[i].Properties = obj.Properties.AsMap()
+		}
+	}
+
+	return out, nil
+}
@@ -0,0 +1,226 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package grpc
+
+import (
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/additional"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/entities/searchparams"
+	pb "github.com/semi-technologies/weaviate/grpc"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestSearchParamsFromProto(t *testing.T) {
+	distance := 0.3
+	certainty := 0.8
+
+	tests := []struct {
+		name        string
+		req         *pb.SearchRequest
+		expected    traverser.GetParams
+		expectedErr bool
+	}{
+		{
+			name:        "without a class",
+			req:         &pb.SearchRequest{},
+			expectedErr: true,
+		},
+		{
+			name: "list with properties and pagination",
+			req: &pb.SearchRequest{
+				ClassName:  "Article",
+				Limit:      10,
+				Offset:     5,
+				Properties: []string{"title"},
+				AdditionalProperties: &pb.AdditionalProperties{
+					Uuid:   true,
+					Vector: true,
+				},
+				ConsistencyLevel: pb.ConsistencyLevel_CONSISTENCY_LEVEL_QUORUM,
+			},
+			expected: traverser.GetParams{
+				ClassName:  "Article",
+				Pagination: &filters.Pagination{Offset: 5, Limit: 10},
+				Properties: search.SelectProperties{{Name: "title", IsPrimitive: true}},
+				AdditionalProperties: additional.Properties{
+					ID:     true,
+					Vector: true,
+				},
+				ReplicationProperties: &additional.ReplicationProperties{
+					ConsistencyLevel: additional.ConsistencyLevelQuorum,
+				},
+			},
+		},
+		{
+			name: "near vector with a distance and without a limit",
+			req: &pb.SearchRequest{
+				ClassName: "Article",
+				NearVector: &pb.NearVectorParams{
+					Vector:   []float32{1, 2, 3},
+					Distance: &distance,
+				},
+			},
+			expected: traverser.GetParams{
+				ClassName:  "Article",
+				Pagination: &filters.Pagination{Limit: filters.LimitFlagSearchByDist},
+				NearVector: &searchparams.NearVector{
+					Vector:       []float32{1, 2, 3},
+					Distance:     0.3,
+					WithDistance: true,
+				},
+			},
+		},
+		{
+			name: "near object with a certainty and a limit",
+			req: &pb.SearchRequest{
+				ClassName: "Article",
+				Limit:     3,
+				NearObject: &pb.NearObjectParams{
+					Id:        "8d5a3aa2-3c8d-4589-9ae1-3f638f506970",
+					Certainty: &certainty,
+				},
+				Tenant: "tenant1",
+			},
+			expected: traverser.GetParams{
+				ClassName:  "Article",
+				Pagination: &filters.Pagination{Limit: 3},
+				NearObject: &searchparams.NearObject{
+					ID:        "8d5a3aa2-3c8d-4589-9ae1-3f638f506970",
+					Certainty: 0.8,
+				},
+				Tenant: "tenant1",
+			},
+		},
+		{
+			name: "bm25",
+			req: &pb.SearchRequest{
+				ClassName: "Article",
+				Bm25: &pb.BM25{
+					Query:      "journey",
+					Properties: []string{"title^2"},
+				},
+			},
+			expected: traverser.GetParams{
+				ClassName: "Article",
+				KeywordRanking: &searchparams.KeywordRanking{
+					Type:       "bm25",
+					Query:      "journey",
+					Properties: []string{"title^2"},
+				},
+			},
+		},
+		{
+			name: "with filters",
+			req: &pb.SearchRequest{
+				ClassName: "Article",
+				Filters: &pb.Filters{
+					Operator: pb.Filters_OPERATOR_AND,
+					Filters: []*pb.Filters{
+						{
+							Operator:  pb.Filters_OPERATOR_EQUAL,
+							On:        []string{"title"},
+							TestValue: &pb.Filters_ValueText{ValueText: "journey"},
+						},
+						{
+							Operator:  pb.Filters_OPERATOR_GREATER_THAN,
+							On:        []string{"wordCount"},
+							TestValue: &pb.Filters_ValueInt{ValueInt: 100},
+						},
+					},
+				},
+			},
+			expected: traverser.GetParams{
+				ClassName: "Article",
+				Filters: &filters.LocalFilter{Root: &filters.Clause{
+					Operator: filters.OperatorAnd,
+					Operands: []filters.Clause{
+						{
+							Operator: filters.OperatorEqual,
+							On:       &filters.Path{Class: "Article", Property: "title"},
+							Value:    &filters.Value{Value: "journey", Type: schema.DataTypeText},
+						},
+						{
+							Operator: filters.OperatorGreaterThan,
+							On:       &filters.Path{Class: "Article", Property: "wordCount"},
+							Value:    &filters.Value{Value: 100, Type: schema.DataTypeInt},
+						},
+					},
+				}},
+			},
+		},
+		{
+			name: "with an unspecified filter operator",
+			req: &pb.SearchRequest{
+				ClassName: "Article",
+				Filters:   &pb.Filters{On: []string{"title"}},
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			params, err := searchParamsFromProto(test.req)
+			if test.expectedErr {
+				require.NotNil(t, err)
+				return
+			}
+
+			require.Nil(t, err)
+			assert.Equal(t, test.expected, params)
+		})
+	}
+}
+
+func TestBatchObjectsFromProto(t *testing.T) {
+	props, err := structpb.NewStruct(map[string]interface{}{
+		"title":     "journey",
+		"wordCount": 100,
+	})
+	require.Nil(t, err)
+
+	objs, err := batchObjectsFromProto(&pb.BatchObjectsRequest{
+		Objects: []*pb.BatchObject{
+			{
+				Uuid:       "8d5a3aa2-3c8d-4589-9ae1-3f638f506970",
+				ClassName:  "Article",
+				Properties: props,
+				Vector:     []float32{1, 2, 3},
+				Tenant:     "tenant1",
+			},
+			{
+				ClassName: "Article",
+			},
+		},
+	})
+	require.Nil(t, err)
+	require.Len(t, objs, 2)
+
+	assert.Equal(t, "8d5a3aa2-3c8d-4589-9ae1-3f638f506970", objs[0].ID.String())
+	assert.Equal(t, "Article", objs[0].Class)
+	assert.Equal(t, map[string]interface{}{
+		"title":     "journey",
+		"wordCount": float64(100),
+	}, objs[0].Properties)
+	assert.Equal(t, []float32{1, 2, 3}, []float32(objs[0].Vector))
+	assert.Equal(t, "tenant1", objs[0].Tenant)
+
+	assert.Equal(t, "", objs[1].ID.String())
+	assert.Nil(t, objs[1].Properties)
+}
@@ -0,0 +1,154 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package grpc
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/additional"
+	"github.com/semi-technologies/weaviate/entities/models"
+	pb "github.com/semi-technologies/weaviate/grpc"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// searchResultsToProto converts the results of traverser.GetClass, which are
+// already shaped for the GraphQL API, into their protobuf representation
+func searchResultsToProto(res interface{}, properties []string) ([]*pb.Object, error) {
+	list, ok := res.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected a list of results, got %T", res)
+	}
+
+	selected := map[string]struct{}{}
+	for _, prop := range properties {
+		selected[prop] = struct{}{}
+	}
+
+	out := make([]*pb.Object, len(list))
+	for i, item := range list {
+		schemaMap, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("result %d: expected a map, got %T", i, item)
+		}
+
+		props := map[string]interface{}{}
+		for name, value := range schemaMap {
+			if name == "_additional" {
+				continue
+			}
+			if _, ok := selected[name]; len(selected) > 0 && !ok {
+				continue
+			}
+			props[name] = value
+		}
+
+		propsStruct, err := propertiesToStruct(props)
+		if err != nil {
+			return nil, fmt.Errorf("result %d: %w", i, err)
+		}
+
+		addl, _ := schemaMap["_additional"].(map[string]interface{})
+		out[i] = &pb.Object{
+			Properties:           propsStruct,
+			AdditionalProperties: additionalPropsToProto(addl),
+		}
+	}
+
+	return out, nil
+}
+
+func objectToProto(obj *models.Object, addl additional.Properties) (*pb.Object, error) {
+	props, _ := obj.Properties.(map[string]interface{})
+	propsStruct, err := propertiesToStruct(props)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &pb.Object{
+		Properties: propsStruct,
+		AdditionalProperties: &pb.ObjectAdditionalProperties{
+			Id: obj.ID.String(),
+		},
+	}
+
+	if addl.Vector {
+		out.AdditionalProperties.Vector = obj.Vector
+	}
+	if addl.CreationTimeUnix {
+		out.AdditionalProperties.CreationTimeUnix = obj.CreationTimeUnix
+	}
+	if addl.LastUpdateTimeUnix {
+		out.AdditionalProperties.LastUpdateTimeUnix = obj.LastUpdateTimeUnix
+	}
+
+	return out, nil
+}
+
+// propertiesToStruct converts the properties of an object. References are
+// skipped, as they can't be selected through the gRPC API yet.
+func propertiesToStruct(props map[string]interface{}) (*structpb.Struct, error) {
+	primitive := make(map[string]interface{}, len(props))
+	for name, value := range props {
+		if _, ok := value.(models.MultipleRef); ok {
+			continue
+		}
+		primitive[name] = value
+	}
+
+	// property values can be of types such as []string or
+	// *models.GeoCoordinates, which structpb can't convert directly. Going
+	// through JSON gives them the same representation as in the REST API.
+	raw, err := json.Marshal(primitive)
+	if err != nil {
+		return nil, fmt.Errorf("marshal properties: %w", err)
+	}
+
+	out := &structpb.Struct{}
+	if err := out.UnmarshalJSON(raw); err != nil {
+		return nil, fmt.Errorf("convert properties: %w", err)
+	}
+
+	return out, nil
+}
+
+func additionalPropsToProto(in map[string]interface{}) *pb.ObjectAdditionalProperties {
+	if len(in) == 0 {
+		return nil
+	}
+
+	out := &pb.ObjectAdditionalProperties{}
+	if id, ok := in["id"].(strfmt.UUID); ok {
+		out.Id = id.String()
+	}
+	if vector, ok := in["vector"].([]float32); ok {
+		out.Vector = vector
+	}
+	if created, ok := in["creationTimeUnix"].(int64); ok {
+		out.CreationTimeUnix = created
+	}
+	if updated, ok := in["lastUpdateTimeUnix"].(int64); ok {
+		out.LastUpdateTimeUnix = updated
+	}
+	if distance, ok := in["distance"].(float32); ok {
+		out.Distance = distance
+	}
+	if certainty, ok := in["certainty"].(float64); ok {
+		out.Certainty = float32(certainty)
+	}
+	if score, ok := in["score"].(float32); ok {
+		out.Score = score
+	}
+
+	return out
+}
@@ -0,0 +1,136 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package grpc
+
+import (
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/additional"
+	"github.com/semi-technologies/weaviate/entities/models"
+	pb "github.com/semi-technologies/weaviate/grpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchResultsToProto(t *testing.T) {
+	res := []interface{}{
+		map[string]interface{}{
+			"title":     "journey",
+			"tags":      []string{"a", "b"},
+			"wordCount": int64(100),
+			"location": &models.GeoCoordinates{
+				Latitude:  ptFloat32(52.3),
+				Longitude: ptFloat32(4.9),
+			},
+			"hasAuthors": models.MultipleRef{},
+			"_additional": map[string]interface{}{
+				"id":        strfmt.UUID("8d5a3aa2-3c8d-4589-9ae1-3f638f506970"),
+				"vector":    []float32{1, 2, 3},
+				"distance":  float32(0.2),
+				"certainty": float64(0.9),
+			},
+		},
+		map[string]interface{}{
+			"title": "destination",
+		},
+	}
+
+	t.Run("without selected properties", func(t *testing.T) {
+		out, err := searchResultsToProto(res, nil)
+		require.Nil(t, err)
+		require.Len(t, out, 2)
+
+		assert.Equal(t, map[string]interface{}{
+			"title":     "journey",
+			"tags":      []interface{}{"a", "b"},
+			"wordCount": float64(100),
+			"location": map[string]interface{}{
+				"latitude":  52.3,
+				"longitude": 4.9,
+			},
+		}, out[0].Properties.AsMap())
+		assert.Equal(t, &pb.ObjectAdditionalProperties{
+			Id:        "8d5a3aa2-3c8d-4589-9ae1-3f638f506970",
+			Vector:    []float32{1, 2, 3},
+			Distance:  0.2,
+			Certainty: 0.9,
+		}, out[0].AdditionalProperties)
+
+		assert.Equal(t, map[string]interface{}{
+			"title": "destination",
+		}, out[1].Properties.AsMap())
+		assert.Nil(t, out[1].AdditionalProperties)
+	})
+
+	t.Run("with selected properties", func(t *testing.T) {
+		out, err := searchResultsToProto(res, []string{"title"})
+		require.Nil(t, err)
+		require.Len(t, out, 2)
+
+		assert.Equal(t, map[string]interface{}{
+			"title": "journey",
+		}, out[0].Properties.AsMap())
+		assert.Equal(t, map[string]interface{}{
+			"title": "destination",
+		}, out[1].Properties.AsMap())
+	})
+
+	t.Run("with an unexpected result type", func(t *testing.T) {
+		_, err := searchResultsToProto("foo", nil)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestObjectToProto(t *testing.T) {
+	obj := &models.Object{
+		ID:    "8d5a3aa2-3c8d-4589-9ae1-3f638f506970",
+		Class: "Article",
+		Properties: map[string]interface{}{
+			"title": "journey",
+		},
+		Vector:             []float32{1, 2, 3},
+		CreationTimeUnix:   1000,
+		LastUpdateTimeUnix: 2000,
+	}
+
+	t.Run("without additional properties", func(t *testing.T) {
+		out, err := objectToProto(obj, additional.Properties{})
+		require.Nil(t, err)
+
+		assert.Equal(t, map[string]interface{}{"title": "journey"},
+			out.Properties.AsMap())
+		assert.Equal(t, &pb.ObjectAdditionalProperties{
+			Id: "8d5a3aa2-3c8d-4589-9ae1-3f638f506970",
+		}, out.AdditionalProperties)
+	})
+
+	t.Run("with additional properties", func(t *testing.T) {
+		out, err := objectToProto(obj, additional.Properties{
+			Vector:             true,
+			CreationTimeUnix:   true,
+			LastUpdateTimeUnix: true,
+		})
+		require.Nil(t, err)
+
+		assert.Equal(t, &pb.ObjectAdditionalProperties{
+			Id:                 "8d5a3aa2-3c8d-4589-9ae1-3f638f506970",
+			Vector:             []float32{1, 2, 3},
+			CreationTimeUnix:   1000,
+			LastUpdateTimeUnix: 2000,
+		}, out.AdditionalProperties)
+	})
+}
+
+func ptFloat32(in float32) *float32 {
+	return &in
+}
@@ -0,0 +1,69 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package grpc
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/state"
+	pb "github.com/semi-technologies/weaviate/grpc"
+	"github.com/semi-technologies/weaviate/usecases/auth/authentication/composer"
+	"github.com/semi-technologies/weaviate/usecases/objects"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"google.golang.org/grpc"
+)
+
+// maxMessageSize is raised from the gRPC default of 4MB, so that batches of
+// the same size as accepted by the REST API can be imported
+const maxMessageSize = 104858000 // 100MB
+
+// CreateGRPCServer creates a gRPC server serving the weaviate service. The
+// server shares the use cases, and therefore the authorization and locking,
+// with the REST and GraphQL APIs.
+func CreateGRPCServer(state *state.State, traverser *traverser.Traverser,
+	batchManager *objects.BatchManager, objectsManager *objects.Manager,
+) *grpc.Server {
+	s := grpc.NewServer(
+		grpc.MaxRecvMsgSize(maxMessageSize),
+		grpc.MaxSendMsgSize(maxMessageSize),
+	)
+
+	authComposer := composer.New(
+		state.ServerConfig.Config.Authentication,
+		state.APIKey,
+		state.OIDC,
+	)
+
+	pb.RegisterWeaviateServer(s, NewService(traverser, batchManager,
+		objectsManager, AuthComposer(authComposer),
+		state.ServerConfig.Config.Authentication.AnonymousAccess.Enabled,
+		state.Logger))
+
+	return s
+}
+
+// StartAndListen blocks while the server is serving on the configured gRPC
+// port
+func StartAndListen(s *grpc.Server, state *state.State) error {
+	port := state.ServerConfig.Config.GRPC.Port
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return errors.Wrapf(err, "listen on port %d", port)
+	}
+
+	state.Logger.WithField("action", "grpc_startup").
+		Infof("grpc server listening at %v", lis.Addr())
+
+	return s.Serve(lis)
+}
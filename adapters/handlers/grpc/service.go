@@ -0,0 +1,194 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package grpc
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	pb "github.com/semi-technologies/weaviate/grpc"
+	autherrs "github.com/semi-technologies/weaviate/usecases/auth/authorization/errors"
+	"github.com/semi-technologies/weaviate/usecases/objects"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// AuthComposer turns the bearer token of a request into a principal
+type AuthComposer func(token string, scopes []string) (*models.Principal, error)
+
+// Service implements the weaviate gRPC service on top of the same use cases
+// that back the REST and GraphQL APIs
+type Service struct {
+	pb.UnimplementedWeaviateServer
+
+	traverser            *traverser.Traverser
+	batchManager         *objects.BatchManager
+	objectsManager       *objects.Manager
+	authComposer         AuthComposer
+	allowAnonymousAccess bool
+	logger               logrus.FieldLogger
+}
+
+func NewService(traverser *traverser.Traverser, batchManager *objects.BatchManager,
+	objectsManager *objects.Manager, authComposer AuthComposer,
+	allowAnonymousAccess bool, logger logrus.FieldLogger,
+) *Service {
+	return &Service{
+		traverser:            traverser,
+		batchManager:         batchManager,
+		objectsManager:       objectsManager,
+		authComposer:         authComposer,
+		allowAnonymousAccess: allowAnonymousAccess,
+		logger:               logger,
+	}
+}
+
+func (s *Service) Search(ctx context.Context, req *pb.SearchRequest) (*pb.SearchReply, error) {
+	before := time.Now()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := searchParamsFromProto(req)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "extract params: %v", err)
+	}
+
+	res, err := s.traverser.GetClass(ctx, principal, params)
+	if err != nil {
+		return nil, toRPCError(err)
+	}
+
+	results, err := searchResultsToProto(res, req.Properties)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "prepare reply: %v", err)
+	}
+
+	return &pb.SearchReply{
+		Results: results,
+		Took:    float32(time.Since(before).Seconds()),
+	}, nil
+}
+
+func (s *Service) BatchObjects(ctx context.Context, req *pb.BatchObjectsRequest) (*pb.BatchObjectsReply, error) {
+	before := time.Now()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	objs, err := batchObjectsFromProto(req)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "extract objects: %v", err)
+	}
+
+	res, err := s.batchManager.AddObjects(ctx, principal, objs, nil,
+		replicationPropsFromProto(req.ConsistencyLevel))
+	if err != nil {
+		return nil, toRPCError(err)
+	}
+
+	var batchErrors []*pb.BatchError
+	for _, obj := range res {
+		if obj.Err != nil {
+			batchErrors = append(batchErrors, &pb.BatchError{
+				Index: int32(obj.OriginalIndex),
+				Error: obj.Err.Error(),
+			})
+		}
+	}
+
+	return &pb.BatchObjectsReply{
+		Errors: batchErrors,
+		Took:   float32(time.Since(before).Seconds()),
+	}, nil
+}
+
+func (s *Service) ObjectByID(ctx context.Context, req *pb.ObjectByIDRequest) (*pb.ObjectByIDReply, error) {
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	addl := additionalPropsFromProto(req.AdditionalProperties)
+	obj, err := s.objectsManager.GetObject(ctx, principal, req.ClassName,
+		strfmt.UUID(req.Id), addl, replicationPropsFromProto(req.ConsistencyLevel),
+		req.Tenant)
+	if err != nil {
+		return nil, toRPCError(err)
+	}
+
+	res, err := objectToProto(obj, addl)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "prepare reply: %v", err)
+	}
+
+	return &pb.ObjectByIDReply{Object: res}, nil
+}
+
+// principalFromContext validates the bearer token in the request metadata.
+// Requests without a token are only accepted if anonymous access is enabled,
+// matching the behavior of the REST API.
+func (s *Service) principalFromContext(ctx context.Context) (*models.Principal, error) {
+	const prefix = "Bearer "
+
+	var token string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, header := range md.Get("authorization") {
+			if strings.HasPrefix(header, prefix) {
+				token = strings.TrimPrefix(header, prefix)
+			}
+		}
+	}
+
+	if token == "" {
+		if s.allowAnonymousAccess {
+			return nil, nil
+		}
+
+		return nil, status.Error(codes.Unauthenticated,
+			"anonymous access not enabled, please provide an auth scheme such as OIDC")
+	}
+
+	principal, err := s.authComposer(token, nil)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	return principal, nil
+}
+
+// toRPCError maps the error types of the use cases to gRPC status codes, the
+// same way the REST handlers map them to status codes
+func toRPCError(err error) error {
+	switch {
+	case errors.As(err, &autherrs.Forbidden{}):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.As(err, &objects.ErrInvalidUserInput{}),
+		errors.As(err, &objects.ErrMultiTenancy{}):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.As(err, &objects.ErrNotFound{}):
+		return status.Error(codes.NotFound, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
@@ -198,3 +198,41 @@ func (n *NilMigrator) UpdateInvertedIndexConfig(ctx context.Context, className s
 func (n *NilMigrator) RecalculateVectorDimensions(ctx context.Context) error {
 	return nil
 }
+
+func (n *NilMigrator) NewTenants(ctx context.Context, className string, tenants []string) error {
+	return nil
+}
+
+func (n *NilMigrator) DeleteTenants(ctx context.Context, className string, tenants []string) error {
+	return nil
+}
+
+func (n *NilMigrator) StartReindex(ctx context.Context, className, id string,
+	props []*models.Property, vectorConfig schemaent.VectorIndexConfig,
+) error {
+	return nil
+}
+
+func (n *NilMigrator) CommitReindex(ctx context.Context, className, id string) error {
+	return nil
+}
+
+func (n *NilMigrator) CleanupReindex(ctx context.Context, className, id string) error {
+	return nil
+}
+
+func (n *NilMigrator) CancelReindex(ctx context.Context, className, id string) error {
+	return nil
+}
+
+func (n *NilMigrator) ReindexStatus(ctx context.Context,
+	className string,
+) ([]*models.ReindexShardStatus, error) {
+	return nil, nil
+}
+
+func (n *NilMigrator) PropertyDeletionStatus(ctx context.Context,
+	className string,
+) ([]*models.PropertyDeletionStatus, error) {
+	return nil, nil
+}
@@ -37,6 +37,7 @@ type indices struct {
 	regexpObjectsSearch       *regexp.Regexp
 	regexpObjectsFind         *regexp.Regexp
 	regexpObjectsAggregations *regexp.Regexp
+	regexpObjectsDelete       *regexp.Regexp
 	regexpObject              *regexp.Regexp
 	regexpReferences          *regexp.Regexp
 	regexpShards              *regexp.Regexp
@@ -44,19 +45,21 @@ type indices struct {
 
 const (
 	urlPatternObjects = `\/indices\/([A-Za-z0-9_+-]+)` +
-		`\/shards\/([A-Za-z0-9]+)\/objects`
+		`\/shards\/([A-Za-z0-9_-]+)\/objects`
 	urlPatternObjectsSearch = `\/indices\/([A-Za-z0-9_+-]+)` +
-		`\/shards\/([A-Za-z0-9]+)\/objects\/_search`
+		`\/shards\/([A-Za-z0-9_-]+)\/objects\/_search`
 	urlPatternObjectsFind = `\/indices\/([A-Za-z0-9_+-]+)` +
-		`\/shards\/([A-Za-z0-9]+)\/objects\/_find`
+		`\/shards\/([A-Za-z0-9_-]+)\/objects\/_find`
 	urlPatternObjectsAggregations = `\/indices\/([A-Za-z0-9_+-]+)` +
-		`\/shards\/([A-Za-z0-9]+)\/objects\/_aggregations`
+		`\/shards\/([A-Za-z0-9_-]+)\/objects\/_aggregations`
+	urlPatternObjectsDelete = `\/indices\/([A-Za-z0-9_+-]+)` +
+		`\/shards\/([A-Za-z0-9_-]+)\/objects\/_delete`
 	urlPatternObject = `\/indices\/([A-Za-z0-9_+-]+)` +
-		`\/shards\/([A-Za-z0-9]+)\/objects\/([A-Za-z0-9_+-]+)`
+		`\/shards\/([A-Za-z0-9_-]+)\/objects\/([A-Za-z0-9_+-]+)`
 	urlPatternReferences = `\/indices\/([A-Za-z0-9_+-]+)` +
-		`\/shards\/([A-Za-z0-9]+)\/references`
+		`\/shards\/([A-Za-z0-9_-]+)\/references`
 	urlPatternShards = `\/indices\/([A-Za-z0-9_+-]+)` +
-		`\/shards\/([A-Za-z0-9]+)\/_status`
+		`\/shards\/([A-Za-z0-9_-]+)\/_status`
 )
 
 type shards interface {
@@ -78,15 +81,18 @@ type shards interface {
 	MultiGetObjects(ctx context.Context, indexName, shardName string,
 		id []strfmt.UUID) ([]*storobj.Object, error)
 	Search(ctx context.Context, indexName, shardName string,
-		vector []float32, distance float32, limit int, filters *filters.LocalFilter,
+		vector []float32, targetVector string, distance float32, limit int, filters *filters.LocalFilter,
 		keywordRanking *searchparams.KeywordRanking, sort []filters.Sort,
-		additional additional.Properties) ([]*storobj.Object, []float32, error)
+		cursor *filters.Cursor, additional additional.Properties,
+	) ([]*storobj.Object, []float32, error)
 	Aggregate(ctx context.Context, indexName, shardName string,
 		params aggregation.Params) (*aggregation.Result, error)
 	FindDocIDs(ctx context.Context, indexName, shardName string,
 		filters *filters.LocalFilter) ([]uint64, error)
 	DeleteObjectBatch(ctx context.Context, indexName, shardName string,
 		docIDs []uint64, dryRun bool) objects.BatchSimpleObjects
+	DeleteObjects(ctx context.Context, indexName, shardName string,
+		ids []strfmt.UUID) []error
 	GetShardStatus(ctx context.Context, indexName, shardName string) (string, error)
 	UpdateShardStatus(ctx context.Context, indexName, shardName,
 		targetStatus string) error
@@ -98,6 +104,7 @@ func NewIndices(shards shards) *indices {
 		regexpObjectsSearch:       regexp.MustCompile(urlPatternObjectsSearch),
 		regexpObjectsFind:         regexp.MustCompile(urlPatternObjectsFind),
 		regexpObjectsAggregations: regexp.MustCompile(urlPatternObjectsAggregations),
+		regexpObjectsDelete:       regexp.MustCompile(urlPatternObjectsDelete),
 		regexpObject:              regexp.MustCompile(urlPatternObject),
 		regexpReferences:          regexp.MustCompile(urlPatternReferences),
 		regexpShards:              regexp.MustCompile(urlPatternShards),
@@ -133,6 +140,14 @@ func (i *indices) Indices() http.Handler {
 
 			i.postAggregateObjects().ServeHTTP(w, r)
 			return
+		case i.regexpObjectsDelete.MatchString(path):
+			if r.Method != http.MethodPost {
+				http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+				return
+			}
+
+			i.postDeleteObjects().ServeHTTP(w, r)
+			return
 		case i.regexpObject.MatchString(path):
 			if r.Method == http.MethodGet {
 				i.getObject().ServeHTTP(w, r)
@@ -504,7 +519,7 @@ func (i *indices) postSearchObjects() http.Handler {
 			return
 		}
 
-		vector, certainty, limit, filters, keywordRanking, sort, additional, err := IndicesPayloads.SearchParams.
+		vector, targetVector, certainty, limit, filters, keywordRanking, sort, cursor, additional, err := IndicesPayloads.SearchParams.
 			Unmarshal(reqPayload)
 		if err != nil {
 			http.Error(w, "unmarshal search params from json: "+err.Error(),
@@ -513,7 +528,7 @@ func (i *indices) postSearchObjects() http.Handler {
 		}
 
 		results, dists, err := i.shards.Search(r.Context(), index, shard,
-			vector, certainty, limit, filters, keywordRanking, sort, additional)
+			vector, targetVector, certainty, limit, filters, keywordRanking, sort, cursor, additional)
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
@@ -717,6 +732,49 @@ func (i *indices) deleteObjects() http.Handler {
 	})
 }
 
+func (i *indices) postDeleteObjects() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := i.regexpObjectsDelete.FindStringSubmatch(r.URL.Path)
+		if len(args) != 3 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index, shard := args[1], args[2]
+
+		defer r.Body.Close()
+		reqPayload, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, "read request body: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		ct, ok := IndicesPayloads.DeleteObjectsParams.CheckContentTypeHeaderReq(r)
+		if !ok {
+			http.Error(w, errors.Errorf("unexpected content type: %s", ct).Error(),
+				http.StatusUnsupportedMediaType)
+			return
+		}
+
+		ids, err := IndicesPayloads.DeleteObjectsParams.Unmarshal(reqPayload)
+		if err != nil {
+			http.Error(w, "unmarshal delete objects params from json: "+err.Error(),
+				http.StatusBadRequest)
+			return
+		}
+
+		errs := i.shards.DeleteObjects(r.Context(), index, shard, ids)
+		errsJSON, err := IndicesPayloads.ErrorList.Marshal(errs)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		IndicesPayloads.ErrorList.SetContentTypeHeader(w)
+		w.Write(errsJSON)
+	})
+}
+
 func (i *indices) getGetShardStatus() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		args := i.regexpShards.FindStringSubmatch(r.URL.Path)
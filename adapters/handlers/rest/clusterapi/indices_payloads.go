@@ -19,6 +19,7 @@ import (
 	"math"
 	"net/http"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/entities/additional"
 	"github.com/semi-technologies/weaviate/entities/aggregation"
@@ -44,6 +45,7 @@ type indicesPayloads struct {
 	FindDocIDsResults         findDocIDsResultsPayload
 	BatchDeleteParams         batchDeleteParamsPayload
 	BatchDeleteResults        batchDeleteResultsPayload
+	DeleteObjectsParams       deleteObjectsParamsPayload
 	GetShardStatusParams      getShardStatusParamsPayload
 	GetShardStatusResults     getShardStatusResultsPayload
 	UpdateShardStatusParams   updateShardStatusParamsPayload
@@ -229,39 +231,44 @@ func (p mergeDocPayload) Unmarshal(in []byte) (objects.MergeDocument, error) {
 
 type searchParamsPayload struct{}
 
-func (p searchParamsPayload) Marshal(vector []float32, limit int,
+func (p searchParamsPayload) Marshal(vector []float32, targetVector string, limit int,
 	filter *filters.LocalFilter, keywordRanking *searchparams.KeywordRanking,
-	sort []filters.Sort, addP additional.Properties,
+	sort []filters.Sort, cursor *filters.Cursor, addP additional.Properties,
 ) ([]byte, error) {
 	type params struct {
 		SearchVector   []float32                    `json:"searchVector"`
+		TargetVector   string                       `json:"targetVector"`
 		Limit          int                          `json:"limit"`
 		Filters        *filters.LocalFilter         `json:"filters"`
 		KeywordRanking *searchparams.KeywordRanking `json:"keywordRanking"`
 		Sort           []filters.Sort               `json:"sort"`
+		Cursor         *filters.Cursor              `json:"cursor"`
 		Additional     additional.Properties        `json:"additional"`
 	}
 
-	par := params{vector, limit, filter, keywordRanking, sort, addP}
+	par := params{vector, targetVector, limit, filter, keywordRanking, sort, cursor, addP}
 	return json.Marshal(par)
 }
 
-func (p searchParamsPayload) Unmarshal(in []byte) ([]float32, float32, int,
-	*filters.LocalFilter, *searchparams.KeywordRanking, []filters.Sort, additional.Properties, error,
+func (p searchParamsPayload) Unmarshal(in []byte) ([]float32, string, float32, int,
+	*filters.LocalFilter, *searchparams.KeywordRanking, []filters.Sort,
+	*filters.Cursor, additional.Properties, error,
 ) {
 	type searchParametersPayload struct {
 		SearchVector   []float32                    `json:"searchVector"`
+		TargetVector   string                       `json:"targetVector"`
 		Distance       float32                      `json:"distance"`
 		Limit          int                          `json:"limit"`
 		Filters        *filters.LocalFilter         `json:"filters"`
 		KeywordRanking *searchparams.KeywordRanking `json:"keywordRanking"`
 		Sort           []filters.Sort               `json:"sort"`
+		Cursor         *filters.Cursor              `json:"cursor"`
 		Additional     additional.Properties        `json:"additional"`
 	}
 	var par searchParametersPayload
 	err := json.Unmarshal(in, &par)
-	return par.SearchVector, par.Distance, par.Limit,
-		par.Filters, par.KeywordRanking, par.Sort, par.Additional, err
+	return par.SearchVector, par.TargetVector, par.Distance, par.Limit,
+		par.Filters, par.KeywordRanking, par.Sort, par.Cursor, par.Additional, err
 }
 
 func (p searchParamsPayload) MIME() string {
@@ -552,6 +559,31 @@ func (p batchDeleteResultsPayload) CheckContentTypeHeader(r *http.Response) (str
 	return ct, ct == p.MIME()
 }
 
+type deleteObjectsParamsPayload struct{}
+
+func (p deleteObjectsParamsPayload) Marshal(ids []strfmt.UUID) ([]byte, error) {
+	return json.Marshal(ids)
+}
+
+func (p deleteObjectsParamsPayload) Unmarshal(in []byte) ([]strfmt.UUID, error) {
+	var ids []strfmt.UUID
+	err := json.Unmarshal(in, &ids)
+	return ids, err
+}
+
+func (p deleteObjectsParamsPayload) MIME() string {
+	return "vnd.weaviate.deleteobjectsparams+json"
+}
+
+func (p deleteObjectsParamsPayload) CheckContentTypeHeaderReq(r *http.Request) (string, bool) {
+	ct := r.Header.Get("content-type")
+	return ct, ct == p.MIME()
+}
+
+func (p deleteObjectsParamsPayload) SetContentTypeHeaderReq(r *http.Request) {
+	r.Header.Set("content-type", p.MIME())
+}
+
 type getShardStatusParamsPayload struct{}
 
 func (p getShardStatusParamsPayload) MIME() string {
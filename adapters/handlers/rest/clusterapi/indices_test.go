@@ -0,0 +1,229 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package clusterapi_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/adapters/clients"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/clusterapi"
+	"github.com/semi-technologies/weaviate/entities/additional"
+	"github.com/semi-technologies/weaviate/entities/aggregation"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/entities/searchparams"
+	"github.com/semi-technologies/weaviate/entities/storobj"
+	"github.com/semi-technologies/weaviate/usecases/objects"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInternalIndicesAPIRoutesTenantShards(t *testing.T) {
+	shards := &fakeShards{}
+	server := httptest.NewServer(clusterapi.NewIndices(shards).Indices())
+	defer server.Close()
+
+	parsedURL, err := url.Parse(server.URL)
+	require.Nil(t, err)
+	host := parsedURL.Host
+
+	client := clients.NewRemoteIndex(&http.Client{})
+	ctx := context.Background()
+	id := strfmt.UUID("8d5a3aa2-3c8d-4589-9ae1-3f638f506970")
+
+	// tenant names are used as shard names and may contain '-' and '_'
+	for _, tenant := range []string{"tenant-a", "t_1", "Tenant1"} {
+		t.Run(tenant, func(t *testing.T) {
+			calls := []struct {
+				name string
+				call func() error
+			}{
+				{"put object", func() error {
+					obj := storobj.FromObject(&models.Object{Class: "Article", ID: id}, nil)
+					return client.PutObject(ctx, host, "article", tenant, obj)
+				}},
+				{"exists", func() error {
+					_, err := client.Exists(ctx, host, "article", tenant, id)
+					return err
+				}},
+				{"delete object", func() error {
+					return client.DeleteObject(ctx, host, "article", tenant, id)
+				}},
+				{"search", func() error {
+					_, _, err := client.SearchShard(ctx, host, "article", tenant,
+						nil, "", 10, nil, nil, nil, nil, additional.Properties{})
+					return err
+				}},
+				{"find doc ids", func() error {
+					_, err := client.FindDocIDs(ctx, host, "article", tenant, nil)
+					return err
+				}},
+				{"aggregate", func() error {
+					_, err := client.Aggregate(ctx, host, "article", tenant,
+						aggregation.Params{ClassName: "Article"})
+					return err
+				}},
+				{"delete objects", func() error {
+					errs := client.DeleteObjects(ctx, host, "article", tenant,
+						[]strfmt.UUID{id})
+					for _, err := range errs {
+						if err != nil {
+							return err
+						}
+					}
+					return nil
+				}},
+				{"add references", func() error {
+					errs := client.BatchAddReferences(ctx, host, "article", tenant,
+						objects.BatchReferences{})
+					for _, err := range errs {
+						if err != nil {
+							return err
+						}
+					}
+					return nil
+				}},
+				{"get shard status", func() error {
+					_, err := client.GetShardStatus(ctx, host, "article", tenant)
+					return err
+				}},
+			}
+
+			for _, c := range calls {
+				t.Run(c.name, func(t *testing.T) {
+					shards.shardName = ""
+					require.Nil(t, c.call())
+					assert.Equal(t, tenant, shards.shardName)
+				})
+			}
+		})
+	}
+}
+
+// fakeShards records the shard name of the last request it has received
+type fakeShards struct {
+	shardName string
+}
+
+func (f *fakeShards) PutObject(ctx context.Context, indexName, shardName string,
+	obj *storobj.Object,
+) error {
+	f.shardName = shardName
+	return nil
+}
+
+func (f *fakeShards) BatchPutObjects(ctx context.Context, indexName, shardName string,
+	objs []*storobj.Object,
+) []error {
+	f.shardName = shardName
+	return make([]error, len(objs))
+}
+
+func (f *fakeShards) BatchAddReferences(ctx context.Context, indexName, shardName string,
+	refs objects.BatchReferences,
+) []error {
+	f.shardName = shardName
+	return make([]error, len(refs))
+}
+
+func (f *fakeShards) GetObject(ctx context.Context, indexName, shardName string,
+	id strfmt.UUID, selectProperties search.SelectProperties,
+	additional additional.Properties,
+) (*storobj.Object, error) {
+	f.shardName = shardName
+	return nil, nil
+}
+
+func (f *fakeShards) Exists(ctx context.Context, indexName, shardName string,
+	id strfmt.UUID,
+) (bool, error) {
+	f.shardName = shardName
+	return true, nil
+}
+
+func (f *fakeShards) DeleteObject(ctx context.Context, indexName, shardName string,
+	id strfmt.UUID,
+) error {
+	f.shardName = shardName
+	return nil
+}
+
+func (f *fakeShards) MergeObject(ctx context.Context, indexName, shardName string,
+	mergeDoc objects.MergeDocument,
+) error {
+	f.shardName = shardName
+	return nil
+}
+
+func (f *fakeShards) MultiGetObjects(ctx context.Context, indexName, shardName string,
+	id []strfmt.UUID,
+) ([]*storobj.Object, error) {
+	f.shardName = shardName
+	return nil, nil
+}
+
+func (f *fakeShards) Search(ctx context.Context, indexName, shardName string,
+	vector []float32, targetVector string, distance float32, limit int,
+	filters *filters.LocalFilter, keywordRanking *searchparams.KeywordRanking,
+	sort []filters.Sort, cursor *filters.Cursor, additional additional.Properties,
+) ([]*storobj.Object, []float32, error) {
+	f.shardName = shardName
+	return nil, nil, nil
+}
+
+func (f *fakeShards) Aggregate(ctx context.Context, indexName, shardName string,
+	params aggregation.Params,
+) (*aggregation.Result, error) {
+	f.shardName = shardName
+	return &aggregation.Result{}, nil
+}
+
+func (f *fakeShards) FindDocIDs(ctx context.Context, indexName, shardName string,
+	filters *filters.LocalFilter,
+) ([]uint64, error) {
+	f.shardName = shardName
+	return nil, nil
+}
+
+func (f *fakeShards) DeleteObjectBatch(ctx context.Context, indexName, shardName string,
+	docIDs []uint64, dryRun bool,
+) objects.BatchSimpleObjects {
+	f.shardName = shardName
+	return nil
+}
+
+func (f *fakeShards) DeleteObjects(ctx context.Context, indexName, shardName string,
+	ids []strfmt.UUID,
+) []error {
+	f.shardName = shardName
+	return make([]error, len(ids))
+}
+
+func (f *fakeShards) GetShardStatus(ctx context.Context, indexName,
+	shardName string,
+) (string, error) {
+	f.shardName = shardName
+	return "READY", nil
+}
+
+func (f *fakeShards) UpdateShardStatus(ctx context.Context, indexName, shardName,
+	targetStatus string,
+) error {
+	f.shardName = shardName
+	return nil
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/semi-technologies/weaviate/adapters/clients"
+	"github.com/semi-technologies/weaviate/adapters/handlers/grpc"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/clusterapi"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/state"
@@ -37,13 +38,13 @@ import (
 	"github.com/semi-technologies/weaviate/adapters/repos/db/inverted"
 	modulestorage "github.com/semi-technologies/weaviate/adapters/repos/modules"
 	schemarepo "github.com/semi-technologies/weaviate/adapters/repos/schema"
-	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/moduletools"
 	"github.com/semi-technologies/weaviate/entities/search"
 	enthnsw "github.com/semi-technologies/weaviate/entities/vectorindex/hnsw"
 	modstgfs "github.com/semi-technologies/weaviate/modules/backup-filesystem"
 	modstggcs "github.com/semi-technologies/weaviate/modules/backup-gcs"
 	modstgs3 "github.com/semi-technologies/weaviate/modules/backup-s3"
+	modgenerativeopenai "github.com/semi-technologies/weaviate/modules/generative-openai"
 	modimage "github.com/semi-technologies/weaviate/modules/img2vec-neural"
 	modclip "github.com/semi-technologies/weaviate/modules/multi2vec-clip"
 	modner "github.com/semi-technologies/weaviate/modules/ner-transformers"
@@ -56,6 +57,7 @@ import (
 	modhuggingface "github.com/semi-technologies/weaviate/modules/text2vec-huggingface"
 	modopenai "github.com/semi-technologies/weaviate/modules/text2vec-openai"
 	modtransformers "github.com/semi-technologies/weaviate/modules/text2vec-transformers"
+	"github.com/semi-technologies/weaviate/usecases/auth/authentication/composer"
 	"github.com/semi-technologies/weaviate/usecases/backup"
 	"github.com/semi-technologies/weaviate/usecases/classification"
 	"github.com/semi-technologies/weaviate/usecases/cluster"
@@ -68,6 +70,7 @@ import (
 	"github.com/semi-technologies/weaviate/usecases/sharding"
 	"github.com/semi-technologies/weaviate/usecases/traverser"
 	"github.com/sirupsen/logrus"
+	grpcgo "google.golang.org/grpc"
 )
 
 const MinimumRequiredContextionaryVersion = "1.0.2"
@@ -133,9 +136,11 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 
 	api.JSONConsumer = runtime.JSONConsumer()
 
-	api.OidcAuth = func(token string, scopes []string) (*models.Principal, error) {
-		return appState.OIDC.ValidateAndExtract(token, scopes)
-	}
+	api.OidcAuth = composer.New(
+		appState.ServerConfig.Config.Authentication,
+		appState.APIKey,
+		appState.OIDC,
+	)
 
 	api.Logger = func(msg string, args ...interface{}) {
 		appState.Logger.WithField("action", "restapi_management").Infof(msg, args...)
@@ -162,12 +167,14 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		ServerVersion:                    config.ServerVersion,
 		GitHash:                          config.GitHash,
 		FlushIdleAfter:                   appState.ServerConfig.Config.Persistence.FlushIdleMemtablesAfter,
+		ObjectsCompression:               appState.ServerConfig.Config.Persistence.ObjectsCompression,
 		RootPath:                         appState.ServerConfig.Config.Persistence.DataPath,
 		QueryLimit:                       appState.ServerConfig.Config.QueryDefaults.Limit,
 		QueryMaximumResults:              appState.ServerConfig.Config.QueryMaximumResults,
 		MaxImportGoroutinesFactor:        appState.ServerConfig.Config.MaxImportGoroutinesFactor,
 		TrackVectorDimensions:            appState.ServerConfig.Config.TrackVectorDimensions,
 		ReindexVectorDimensionsAtStartup: appState.ServerConfig.Config.ReindexVectorDimensionsAtStartup,
+		IndexFilterableRoaringSet:        appState.ServerConfig.Config.IndexFilterableRoaringSet,
 		ResourceUsage:                    appState.ServerConfig.Config.ResourceUsage,
 	}, remoteIndexClient, appState.Cluster, remoteNodesClient, appState.Metrics) // TODO client
 	vectorMigrator = db.NewMigrator(repo, appState.Logger)
@@ -269,10 +276,30 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 	setupBackupHandlers(api, backupScheduler)
 	setupNodesHandlers(api, schemaManager, repo, appState)
 
+	var grpcServer *grpcgo.Server
+	if appState.ServerConfig.Config.GRPC.IsEnabled() {
+		grpcServer = grpc.CreateGRPCServer(appState, objectsTraverser,
+			batchObjectsManager, objectsManager)
+		go func() {
+			// the REST API stays usable if the gRPC port is taken, so a failing
+			// listener is not fatal
+			if err := grpc.StartAndListen(grpcServer, appState); err != nil {
+				appState.Logger.WithField("action", "grpc_startup").WithError(err).
+					Error("failed to start grpc server, gRPC API is unavailable")
+			}
+		}()
+	}
+
 	api.ServerShutdown = func() {
 		ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
 		defer cancel()
 
+		// stop accepting gRPC requests and wait for running ones to finish
+		// before the db is shut down
+		if grpcServer != nil {
+			grpcServer.GracefulStop()
+		}
+
 		if err := repo.Shutdown(ctx); err != nil {
 			panic(err)
 		}
@@ -298,6 +325,10 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 	schema := schemaManager.GetSchemaSkipAuth()
 	updateSchemaCallback(schema)
 
+	// scheduled backups need the backup modules to be initialized
+	backupScheduler.StartSchedule(ctx, appState.ServerConfig.Config.BackupSchedule,
+		appState.Cluster)
+
 	// Add dimensions to all the objects in the database, if requested by the user
 	if appState.ServerConfig.Config.ReindexVectorDimensionsAtStartup {
 		appState.Logger.
@@ -344,11 +375,12 @@ func startupRoutine(ctx context.Context) *state.State {
 		Debug("config loaded")
 
 	appState.OIDC = configureOIDC(appState)
+	appState.APIKey = configureAPIKey(appState)
 	appState.AnonymousAccess = configureAnonymousAccess(appState)
 	appState.Authorizer = configureAuthorizer(appState)
 
 	logger.WithField("action", "startup").WithField("startup_time_left", timeTillDeadline(ctx)).
-		Debug("configured OIDC and anonymous access client")
+		Debug("configured OIDC, API key and anonymous access client")
 
 	appState.Locks = &dummyLock{}
 
@@ -540,6 +572,14 @@ func registerModules(appState *state.State) error {
 			Debug("enabled module")
 	}
 
+	if _, ok := enabledModules[modgenerativeopenai.Name]; ok {
+		appState.Modules.Register(modgenerativeopenai.New())
+		appState.Logger.
+			WithField("action", "startup").
+			WithField("module", modgenerativeopenai.Name).
+			Debug("enabled module")
+	}
+
 	appState.Logger.
 		WithField("action", "startup").
 		Debug("completed registering modules")
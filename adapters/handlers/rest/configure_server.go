@@ -22,6 +22,7 @@ import (
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/state"
 	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/usecases/auth/authentication/anonymous"
+	"github.com/semi-technologies/weaviate/usecases/auth/authentication/apikey"
 	"github.com/semi-technologies/weaviate/usecases/auth/authentication/oidc"
 	"github.com/semi-technologies/weaviate/usecases/auth/authorization"
 	"github.com/semi-technologies/weaviate/usecases/config"
@@ -84,6 +85,19 @@ func configureOIDC(appState *state.State) *oidc.Client {
 	return c
 }
 
+// configureAPIKey will always be called, even if API keys are disabled, this
+// way the middleware will still be able to provide the user with a valuable
+// error message.
+func configureAPIKey(appState *state.State) *apikey.Client {
+	c, err := apikey.New(appState.ServerConfig.Config)
+	if err != nil {
+		appState.Logger.WithField("action", "apikey_init").WithError(err).Fatal("apikey client could not start up")
+		os.Exit(1)
+	}
+
+	return c
+}
+
 // configureAnonymousAccess will always be called, even if anonymous access is
 // disabled. In this case the middleware provided by this client will block
 // anonymous requests
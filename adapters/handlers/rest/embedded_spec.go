@@ -148,6 +148,54 @@ func init() {
       }
     },
     "/backups/{backend}": {
+      "get": {
+        "description": "Returns the backups stored by a backup backend",
+        "tags": [
+          "backups"
+        ],
+        "operationId": "backups.list",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Backups successfully listed.",
+            "schema": {
+              "$ref": "#/definitions/BackupListResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid backup list attempt.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      },
       "post": {
         "description": "Starts a process of creating a backup for a set of classes",
         "tags": [
@@ -266,6 +314,64 @@ func init() {
         "x-serviceIds": [
           "weaviate.local.backup"
         ]
+      },
+      "delete": {
+        "description": "Deletes a backup and all of its files from a backup backend",
+        "tags": [
+          "backups"
+        ],
+        "operationId": "backups.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Backup successfully deleted."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Backup does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid backup deletion attempt.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
       }
     },
     "/backups/{backend}/{id}/restore": {
@@ -434,6 +540,9 @@ func init() {
                 }
               }
             }
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           }
         ],
         "responses": {
@@ -490,6 +599,12 @@ func init() {
             "schema": {
               "$ref": "#/definitions/BatchDelete"
             }
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
@@ -549,6 +664,9 @@ func init() {
                 "$ref": "#/definitions/BatchReference"
               }
             }
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           }
         ],
         "responses": {
@@ -901,6 +1019,9 @@ func init() {
         "summary": "Get a list of Objects.",
         "operationId": "objects.list",
         "parameters": [
+          {
+            "$ref": "#/parameters/CommonAfterParameterQuery"
+          },
           {
             "$ref": "#/parameters/CommonOffsetParameterQuery"
           },
@@ -918,6 +1039,9 @@ func init() {
           },
           {
             "$ref": "#/parameters/CommonClassParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
@@ -979,6 +1103,9 @@ func init() {
             "schema": {
               "$ref": "#/definitions/Object"
             }
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           }
         ],
         "responses": {
@@ -1093,6 +1220,12 @@ func init() {
           },
           {
             "$ref": "#/parameters/CommonIncludeParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
@@ -1120,6 +1253,12 @@ func init() {
           "404": {
             "description": "Successful query result but no resource was found."
           },
+          "422": {
+            "description": "Request is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the tenant exists and the class has multi-tenancy enabled?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -1162,6 +1301,9 @@ func init() {
             "schema": {
               "$ref": "#/definitions/Object"
             }
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           }
         ],
         "responses": {
@@ -1223,6 +1365,12 @@ func init() {
             "name": "id",
             "in": "path",
             "required": true
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
@@ -1241,6 +1389,12 @@ func init() {
           "404": {
             "description": "Successful query result but no resource was found."
           },
+          "422": {
+            "description": "Request is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the tenant exists and the class has multi-tenancy enabled?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -1276,6 +1430,12 @@ func init() {
             "name": "id",
             "in": "path",
             "required": true
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
@@ -1294,6 +1454,12 @@ func init() {
           "404": {
             "description": "Object doesn't exist."
           },
+          "422": {
+            "description": "Request is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the tenant exists and the class has multi-tenancy enabled?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -1337,6 +1503,9 @@ func init() {
             "schema": {
               "$ref": "#/definitions/Object"
             }
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           }
         ],
         "responses": {
@@ -1416,6 +1585,12 @@ func init() {
             "schema": {
               "$ref": "#/definitions/MultipleRef"
             }
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
@@ -1490,6 +1665,12 @@ func init() {
             "schema": {
               "$ref": "#/definitions/SingleRef"
             }
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
@@ -1564,6 +1745,12 @@ func init() {
             "schema": {
               "$ref": "#/definitions/SingleRef"
             }
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
@@ -2378,26 +2565,36 @@ func init() {
         ]
       }
     },
-    "/schema/{className}/shards": {
-      "get": {
+    "/schema/{className}/properties/{propertyName}": {
+      "delete": {
+        "description": "The property is removed from the schema and its indexes are dropped right away. Its values are removed from the stored objects in the background, the progress is part of the nodes status.",
         "tags": [
           "schema"
         ],
-        "summary": "Get the shards status of an Object class",
-        "operationId": "schema.objects.shards.get",
+        "summary": "Remove a property from an Object class.",
+        "operationId": "schema.objects.properties.delete",
         "parameters": [
           {
             "type": "string",
             "name": "className",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Found the status of the shards, returned as body",
+            "description": "Removed the property from the Object class. Its values are removed from the stored objects in the background."
+          },
+          "400": {
+            "description": "Could not delete the property.",
             "schema": {
-              "$ref": "#/definitions/ShardStatusList"
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -2409,12 +2606,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "This class does not exist",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -2423,17 +2614,18 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.get.meta"
+          "weaviate.local.manipulate.meta"
         ]
       }
     },
-    "/schema/{className}/shards/{shardName}": {
-      "put": {
-        "description": "Update shard status of an Object Class",
+    "/schema/{className}/reindex": {
+      "post": {
+        "description": "Starts a job which rebuilds the inverted index of the given properties and/or the vector index of the class from the stored objects with the new settings. The new indexes are built next to the existing ones, which keep serving queries and writes, and are swapped in once they are complete in every shard.",
         "tags": [
           "schema"
         ],
-        "operationId": "schema.objects.shards.update",
+        "summary": "Rebuild indexes of an Object class with new settings.",
+        "operationId": "schema.objects.reindex.create",
         "parameters": [
           {
             "type": "string",
@@ -2441,26 +2633,20 @@ func init() {
             "in": "path",
             "required": true
           },
-          {
-            "type": "string",
-            "name": "shardName",
-            "in": "path",
-            "required": true
-          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/ShardStatus"
+              "$ref": "#/definitions/ReindexRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Shard status was updated successfully",
+            "description": "Started the reindex job.",
             "schema": {
-              "$ref": "#/definitions/ShardStatus"
+              "$ref": "#/definitions/ReindexJob"
             }
           },
           "401": {
@@ -2473,13 +2659,13 @@ func init() {
             }
           },
           "404": {
-            "description": "Shard to be updated does not exist",
+            "description": "This class does not exist.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "422": {
-            "description": "Invalid update attempt",
+            "description": "Invalid reindex request.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2495,1779 +2681,3039 @@ func init() {
           "weaviate.local.manipulate.meta"
         ]
       }
-    }
-  },
-  "definitions": {
-    "AdditionalProperties": {
-      "description": "Additional Meta information about a single object object.",
-      "type": "object",
-      "additionalProperties": {
-        "type": "object"
-      }
-    },
-    "BM25Config": {
-      "description": "tuning parameters for the BM25 algorithm",
-      "type": "object",
-      "properties": {
-        "b": {
-          "description": "calibrates term-weight scaling based on the document length",
-          "type": "number",
-          "format": "float"
-        },
-        "k1": {
-          "description": "calibrates term-weight scaling based on the term frequency within a document",
-          "type": "number",
-          "format": "float"
-        }
-      }
     },
-    "BackupCreateRequest": {
-      "description": "Request body for creating a backup of a set of classes",
-      "properties": {
-        "config": {
-          "description": "Custom configuration for the backup creation process",
-          "type": "object"
-        },
-        "exclude": {
-          "description": "List of classes to exclude from the backup creation process",
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/schema/{className}/reindex/{id}": {
+      "get": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Get the status of a reindex job.",
+        "operationId": "schema.objects.reindex.get",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
           }
-        },
-        "id": {
-          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-          "type": "string"
-        },
-        "include": {
-          "description": "List of classes to include in the backup creation process",
-          "type": "array",
-          "items": {
-            "type": "string"
+        ],
+        "responses": {
+          "200": {
+            "description": "The status of the reindex job.",
+            "schema": {
+              "$ref": "#/definitions/ReindexJob"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "This reindex job does not exist.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
-        }
-      }
-    },
-    "BackupCreateResponse": {
-      "description": "The definition of a backup create response body",
-      "properties": {
-        "backend": {
-          "description": "Backup backend name e.g. filesystem, gcs, s3.",
-          "type": "string"
         },
-        "classes": {
-          "description": "The list of classes for which the backup creation process was started",
-          "type": "array",
-          "items": {
-            "type": "string"
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      },
+      "delete": {
+        "description": "Stops the job in every shard and removes the partially built indexes. The existing indexes and settings of the class remain unchanged.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Cancel a reindex job.",
+        "operationId": "schema.objects.reindex.cancel",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
           }
-        },
-        "error": {
-          "description": "error message if creation failed",
-          "type": "string"
-        },
-        "id": {
-          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-          "type": "string"
-        },
-        "path": {
-          "description": "destination path of backup files proper to selected backend",
-          "type": "string"
-        },
-        "status": {
-          "description": "phase of backup creation process",
-          "type": "string",
-          "default": "STARTED",
-          "enum": [
-            "STARTED",
-            "TRANSFERRING",
-            "TRANSFERRED",
-            "SUCCESS",
-            "FAILED"
-          ]
-        }
-      }
-    },
-    "BackupCreateStatusResponse": {
-      "description": "The definition of a backup create metadata",
-      "properties": {
-        "backend": {
-          "description": "Backup backend name e.g. filesystem, gcs, s3.",
-          "type": "string"
-        },
-        "error": {
-          "description": "error message if creation failed",
-          "type": "string"
-        },
-        "id": {
-          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-          "type": "string"
-        },
-        "path": {
-          "description": "destination path of backup files proper to selected backend",
-          "type": "string"
-        },
-        "status": {
-          "description": "phase of backup creation process",
-          "type": "string",
-          "default": "STARTED",
-          "enum": [
-            "STARTED",
-            "TRANSFERRING",
-            "TRANSFERRED",
-            "SUCCESS",
-            "FAILED"
-          ]
-        }
-      }
-    },
-    "BackupRestoreRequest": {
-      "description": "Request body for restoring a backup for a set of classes",
-      "properties": {
-        "config": {
-          "description": "Custom configuration for the backup restoration process",
-          "type": "object"
-        },
-        "exclude": {
-          "description": "List of classes to exclude from the backup restoration process",
-          "type": "array",
-          "items": {
-            "type": "string"
+        ],
+        "responses": {
+          "200": {
+            "description": "Cancelled the reindex job.",
+            "schema": {
+              "$ref": "#/definitions/ReindexJob"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "This reindex job does not exist.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The reindex job is already finished.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
-        "include": {
-          "description": "List of classes to include in the backup restoration process",
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        }
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
       }
     },
-    "BackupRestoreResponse": {
-      "description": "The definition of a backup restore response body",
-      "properties": {
-        "backend": {
-          "description": "Backup backend name e.g. filesystem, gcs, s3.",
-          "type": "string"
-        },
-        "classes": {
-          "description": "The list of classes for which the backup restoration process was started",
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/schema/{className}/shards": {
+      "get": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Get the shards status of an Object class",
+        "operationId": "schema.objects.shards.get",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Found the status of the shards, returned as body",
+            "schema": {
+              "$ref": "#/definitions/ShardStatusList"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "This class does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
-        "error": {
-          "description": "error message if restoration failed",
-          "type": "string"
-        },
-        "id": {
-          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-          "type": "string"
-        },
-        "path": {
-          "description": "destination path of backup files proper to selected backend",
-          "type": "string"
-        },
-        "status": {
-          "description": "phase of backup restoration process",
-          "type": "string",
-          "default": "STARTED",
-          "enum": [
-            "STARTED",
-            "TRANSFERRING",
-            "TRANSFERRED",
-            "SUCCESS",
-            "FAILED"
-          ]
-        }
+        "x-serviceIds": [
+          "weaviate.local.get.meta"
+        ]
       }
     },
-    "BackupRestoreStatusResponse": {
-      "description": "The definition of a backup restore metadata",
-      "properties": {
-        "backend": {
-          "description": "Backup backend name e.g. filesystem, gcs, s3.",
-          "type": "string"
-        },
-        "error": {
-          "description": "error message if restoration failed",
-          "type": "string"
-        },
-        "id": {
-          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-          "type": "string"
-        },
-        "path": {
-          "description": "destination path of backup files proper to selected backup backend",
-          "type": "string"
-        },
-        "status": {
-          "description": "phase of backup restoration process",
-          "type": "string",
-          "default": "STARTED",
-          "enum": [
-            "STARTED",
-            "TRANSFERRING",
-            "TRANSFERRED",
-            "SUCCESS",
-            "FAILED"
-          ]
-        }
-      }
-    },
-    "BatchDelete": {
-      "type": "object",
-      "properties": {
-        "dryRun": {
-          "description": "If true, objects will not be deleted yet, but merely listed. Defaults to false.",
-          "type": "boolean",
-          "default": false
-        },
-        "match": {
-          "description": "Outlines how to find the objects to be deleted.",
-          "type": "object",
-          "properties": {
-            "class": {
-              "description": "Class (name) which objects will be deleted.",
-              "type": "string",
-              "example": "City"
-            },
-            "where": {
-              "description": "Filter to limit the objects to be deleted.",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
+    "/schema/{className}/shards/{shardName}": {
+      "put": {
+        "description": "Update shard status of an Object Class",
+        "tags": [
+          "schema"
+        ],
+        "operationId": "schema.objects.shards.update",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "shardName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ShardStatus"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Shard status was updated successfully",
+            "schema": {
+              "$ref": "#/definitions/ShardStatus"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Shard to be updated does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid update attempt",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
-        "output": {
-          "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
-          "type": "string",
-          "default": "minimal"
-        }
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
       }
     },
-    "BatchDeleteResponse": {
-      "description": "Delete Objects response.",
-      "type": "object",
-      "properties": {
-        "dryRun": {
-          "description": "If true, objects will not be deleted yet, but merely listed. Defaults to false.",
-          "type": "boolean",
-          "default": false
-        },
-        "match": {
-          "description": "Outlines how to find the objects to be deleted.",
-          "type": "object",
-          "properties": {
-            "class": {
-              "description": "Class (name) which objects will be deleted.",
-              "type": "string",
-              "example": "City"
-            },
-            "where": {
-              "description": "Filter to limit the objects to be deleted.",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
+    "/schema/{className}/tenants": {
+      "get": {
+        "description": "List the tenants of a class with multi-tenancy enabled",
+        "tags": [
+          "schema"
+        ],
+        "operationId": "schema.tenants.get",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The tenants of the specified class",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid tenants or class, see the ErrorResponse for details.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
-        "output": {
-          "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
-          "type": "string",
-          "default": "minimal"
-        },
-        "results": {
-          "type": "object",
-          "properties": {
-            "failed": {
-              "description": "How many objects should have been deleted but could not be deleted.",
-              "type": "number",
-              "format": "int64",
-              "x-omitempty": false
-            },
-            "limit": {
-              "description": "The most amount of objects that can be deleted in a single query, equals QUERY_MAXIMUM_RESULTS.",
-              "type": "number",
-              "format": "int64",
-              "x-omitempty": false
-            },
-            "matches": {
-              "description": "How many objects were matched by the filter.",
-              "type": "number",
-              "format": "int64",
-              "x-omitempty": false
-            },
-            "objects": {
-              "description": "With output set to \"minimal\" only objects with error occurred will the be described. Successfully deleted objects would be omitted. Output set to \"verbose\" will list all of the objets with their respective statuses.",
+        "x-serviceIds": [
+          "weaviate.local.get.meta"
+        ]
+      },
+      "post": {
+        "description": "Create new tenants in a class with multi-tenancy enabled. Each tenant gets its own shard.",
+        "tags": [
+          "schema"
+        ],
+        "operationId": "schema.tenants.create",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
               "type": "array",
               "items": {
-                "description": "Results for this specific Object.",
-                "format": "object",
-                "properties": {
-                  "errors": {
-                    "$ref": "#/definitions/ErrorResponse"
-                  },
-                  "id": {
-                    "description": "ID of the Object.",
-                    "type": "string",
-                    "format": "uuid"
-                  },
-                  "status": {
-                    "type": "string",
-                    "default": "SUCCESS",
-                    "enum": [
-                      "SUCCESS",
-                      "DRYRUN",
-                      "FAILED"
-                    ]
-                  }
-                }
+                "$ref": "#/definitions/Tenant"
               }
-            },
-            "successful": {
-              "description": "How many objects were successfully deleted in this round.",
-              "type": "number",
-              "format": "int64",
-              "x-omitempty": false
             }
           }
-        }
-      }
+        ],
+        "responses": {
+          "200": {
+            "description": "Added new tenants to the specified class",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid tenants or class, see the ErrorResponse for details.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      },
+      "delete": {
+        "description": "Delete tenants from a class with multi-tenancy enabled. All data of the deleted tenants is removed.",
+        "tags": [
+          "schema"
+        ],
+        "operationId": "schema.tenants.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "tenants",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Deleted tenants from the specified class"
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid tenants or class, see the ErrorResponse for details.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    }
+  },
+  "definitions": {
+    "AdditionalProperties": {
+      "description": "Additional Meta information about a single object object.",
+      "type": "object",
+      "additionalProperties": {
+        "type": "object"
+      }
     },
-    "BatchReference": {
+    "BM25Config": {
+      "description": "tuning parameters for the BM25 algorithm",
+      "type": "object",
       "properties": {
-        "from": {
-          "description": "Long-form beacon-style URI to identify the source of the cross-ref including the property name. Should be in the form of weaviate://localhost/\u003ckinds\u003e/\u003cuuid\u003e/\u003cclassName\u003e/\u003cpropertyName\u003e, where \u003ckinds\u003e must be one of 'objects', 'objects' and \u003cclassName\u003e and \u003cpropertyName\u003e must represent the cross-ref property of source class to be used.",
-          "type": "string",
-          "format": "uri",
-          "example": "weaviate://localhost/Zoo/a5d09582-4239-4702-81c9-92a6e0122bb4/hasAnimals"
+        "b": {
+          "description": "calibrates term-weight scaling based on the document length",
+          "type": "number",
+          "format": "float"
         },
-        "to": {
-          "description": "Short-form URI to point to the cross-ref. Should be in the form of weaviate://localhost/\u003cuuid\u003e for the example of a local cross-ref to an object",
-          "type": "string",
-          "format": "uri",
-          "example": "weaviate://localhost/97525810-a9a5-4eb0-858a-71449aeb007f"
+        "k1": {
+          "description": "calibrates term-weight scaling based on the term frequency within a document",
+          "type": "number",
+          "format": "float"
         }
       }
     },
-    "BatchReferenceResponse": {
-      "type": "object",
-      "allOf": [
-        {
-          "$ref": "#/definitions/BatchReference"
+    "BackupCreateRequest": {
+      "description": "Request body for creating a backup of a set of classes",
+      "properties": {
+        "baseBackupId": {
+          "description": "The ID of an earlier successful backup on the same backend. Files which are unchanged since then are referenced instead of being copied again.",
+          "type": "string"
         },
-        {
-          "properties": {
-            "result": {
-              "description": "Results for this specific reference.",
-              "format": "object",
-              "properties": {
-                "errors": {
-                  "$ref": "#/definitions/ErrorResponse"
-                },
-                "status": {
-                  "type": "string",
-                  "default": "SUCCESS",
-                  "enum": [
-                    "SUCCESS",
-                    "PENDING",
-                    "FAILED"
-                  ]
-                }
-              }
-            }
+        "config": {
+          "description": "Custom configuration for the backup creation process. Set 'archive' to true to store the shard files in compressed, checksummed chunks of at most 'chunkSize' MB (default 128) using the 'compressionLevel' DefaultCompression, BestSpeed or BestCompression.",
+          "type": "object"
+        },
+        "exclude": {
+          "description": "List of classes to exclude from the backup creation process",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "id": {
+          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
+        },
+        "include": {
+          "description": "List of classes to include in the backup creation process",
+          "type": "array",
+          "items": {
+            "type": "string"
           }
         }
-      ]
+      }
     },
-    "C11yExtension": {
-      "description": "A resource describing an extension to the contextinoary, containing both the identifier and the definition of the extension",
+    "BackupCreateResponse": {
+      "description": "The definition of a backup create response body",
       "properties": {
-        "concept": {
-          "description": "The new concept you want to extend. Must be an all-lowercase single word, or a space delimited compound word. Examples: 'foobarium', 'my custom concept'",
-          "type": "string",
-          "example": "foobarium"
+        "backend": {
+          "description": "Backup backend name e.g. filesystem, gcs, s3.",
+          "type": "string"
         },
-        "definition": {
-          "description": "A list of space-delimited words or a sentence describing what the custom concept is about. Avoid using the custom concept itself. An Example definition for the custom concept 'foobarium': would be 'a naturally occourring element which can only be seen by programmers'",
+        "classes": {
+          "description": "The list of classes for which the backup creation process was started",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "error": {
+          "description": "error message if creation failed",
           "type": "string"
         },
-        "weight": {
-          "description": "Weight of the definition of the new concept where 1='override existing definition entirely' and 0='ignore custom definition'. Note that if the custom concept is not present in the contextionary yet, the weight cannot be less than 1.",
-          "type": "number",
-          "format": "float"
+        "id": {
+          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
+        },
+        "path": {
+          "description": "destination path of backup files proper to selected backend",
+          "type": "string"
+        },
+        "status": {
+          "description": "phase of backup creation process",
+          "type": "string",
+          "default": "STARTED",
+          "enum": [
+            "STARTED",
+            "TRANSFERRING",
+            "TRANSFERRED",
+            "SUCCESS",
+            "FAILED"
+          ]
         }
       }
     },
-    "C11yNearestNeighbors": {
-      "description": "C11y function to show the nearest neighbors to a word.",
-      "type": "array",
-      "items": {
-        "type": "object",
-        "properties": {
-          "distance": {
-            "type": "number",
-            "format": "float"
-          },
-          "word": {
-            "type": "string"
-          }
+    "BackupCreateStatusResponse": {
+      "description": "The definition of a backup create metadata",
+      "properties": {
+        "backend": {
+          "description": "Backup backend name e.g. filesystem, gcs, s3.",
+          "type": "string"
+        },
+        "error": {
+          "description": "error message if creation failed",
+          "type": "string"
+        },
+        "id": {
+          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
+        },
+        "path": {
+          "description": "destination path of backup files proper to selected backend",
+          "type": "string"
+        },
+        "status": {
+          "description": "phase of backup creation process",
+          "type": "string",
+          "default": "STARTED",
+          "enum": [
+            "STARTED",
+            "TRANSFERRING",
+            "TRANSFERRED",
+            "SUCCESS",
+            "FAILED"
+          ]
         }
       }
     },
-    "C11yVector": {
-      "description": "A Vector in the Contextionary",
-      "type": "array",
-      "items": {
-        "type": "number",
-        "format": "float"
-      }
-    },
-    "C11yVectorBasedQuestion": {
-      "description": "Receive question based on array of classes, properties and values.",
-      "type": "array",
-      "items": {
-        "type": "object",
-        "properties": {
-          "classProps": {
-            "description": "Vectorized properties.",
-            "type": "array",
-            "maxItems": 300,
-            "minItems": 300,
-            "items": {
-              "type": "object",
-              "properties": {
-                "propsVectors": {
-                  "type": "array",
-                  "items": {
-                    "type": "number",
-                    "format": "float"
-                  }
-                },
-                "value": {
-                  "description": "String with valuename.",
-                  "type": "string"
-                }
-              }
-            }
-          },
-          "classVectors": {
-            "description": "Vectorized classname.",
-            "type": "array",
-            "maxItems": 300,
-            "minItems": 300,
-            "items": {
-              "type": "number",
-              "format": "float"
-            }
+    "BackupListItem": {
+      "description": "A backup stored by a backup backend",
+      "properties": {
+        "baseBackupId": {
+          "description": "The ID of the backup this one is incremental to, if any.",
+          "type": "string"
+        },
+        "classes": {
+          "description": "The list of classes included in the backup.",
+          "type": "array",
+          "items": {
+            "type": "string"
           }
+        },
+        "error": {
+          "description": "error message if creation failed",
+          "type": "string"
+        },
+        "id": {
+          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
+        },
+        "status": {
+          "description": "phase of backup creation process",
+          "type": "string",
+          "enum": [
+            "STARTED",
+            "TRANSFERRING",
+            "TRANSFERRED",
+            "SUCCESS",
+            "FAILED"
+          ]
         }
       }
     },
-    "C11yWordsResponse": {
-      "description": "An array of available words and contexts.",
+    "BackupListResponse": {
+      "description": "The definition of a backup list response body",
       "properties": {
-        "concatenatedWord": {
-          "description": "Weighted results for all words",
-          "type": "object",
-          "properties": {
-            "concatenatedNearestNeighbors": {
-              "$ref": "#/definitions/C11yNearestNeighbors"
-            },
-            "concatenatedVector": {
-              "$ref": "#/definitions/C11yVector"
-            },
-            "concatenatedWord": {
-              "type": "string"
-            },
-            "singleWords": {
-              "type": "array",
-              "items": {
-                "format": "string"
-              }
-            }
-          }
-        },
-        "individualWords": {
-          "description": "Weighted results for per individual word",
+        "backups": {
+          "description": "The backups stored by the backup backend.",
           "type": "array",
           "items": {
-            "type": "object",
-            "properties": {
-              "info": {
-                "type": "object",
-                "properties": {
-                  "nearestNeighbors": {
-                    "$ref": "#/definitions/C11yNearestNeighbors"
-                  },
-                  "vector": {
-                    "$ref": "#/definitions/C11yVector"
-                  }
-                }
-              },
-              "present": {
-                "type": "boolean"
-              },
-              "word": {
-                "type": "string"
-              }
-            }
+            "$ref": "#/definitions/BackupListItem"
           }
         }
       }
     },
-    "Class": {
-      "type": "object",
+    "BackupRestoreRequest": {
+      "description": "Request body for restoring a backup for a set of classes",
       "properties": {
-        "class": {
-          "description": "Name of the class as URI relative to the schema URL.",
-          "type": "string"
-        },
-        "description": {
-          "description": "Description of the class.",
-          "type": "string"
-        },
-        "invertedIndexConfig": {
-          "$ref": "#/definitions/InvertedIndexConfig"
-        },
-        "moduleConfig": {
-          "description": "Configuration specific to modules this Weaviate instance has installed",
+        "config": {
+          "description": "Custom configuration for the backup restoration process",
           "type": "object"
         },
-        "properties": {
-          "description": "The properties of the class.",
+        "exclude": {
+          "description": "List of classes to exclude from the backup restoration process",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/Property"
+            "type": "string"
           }
         },
-        "shardingConfig": {
-          "description": "Manage how the index should be sharded and distributed in the cluster",
-          "type": "object"
-        },
-        "vectorIndexConfig": {
-          "description": "Vector-index config, that is specific to the type of index selected in vectorIndexType",
-          "type": "object"
-        },
-        "vectorIndexType": {
-          "description": "Name of the vector index to use, eg. (HNSW)",
-          "type": "string"
-        },
-        "vectorizer": {
-          "description": "Specify how the vectors for this class should be determined. The options are either 'none' - this means you have to import a vector with each object yourself - or the name of a module that provides vectorization capabilities, such as 'text2vec-contextionary'. If left empty, it will use the globally configured default which can itself either be 'none' or a specific module.",
-          "type": "string"
+        "include": {
+          "description": "List of classes to include in the backup restoration process",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         }
       }
     },
-    "Classification": {
-      "description": "Manage classifications, trigger them and view status of past classifications.",
-      "type": "object",
+    "BackupRestoreResponse": {
+      "description": "The definition of a backup restore response body",
       "properties": {
-        "basedOnProperties": {
-          "description": "base the text-based classification on these fields (of type text)",
-          "type": "array",
-          "items": {
-            "type": "string"
-          },
-          "example": [
-            "description"
-          ]
-        },
-        "class": {
-          "description": "class (name) which is used in this classification",
-          "type": "string",
-          "example": "City"
+        "backend": {
+          "description": "Backup backend name e.g. filesystem, gcs, s3.",
+          "type": "string"
         },
-        "classifyProperties": {
-          "description": "which ref-property to set as part of the classification",
+        "classes": {
+          "description": "The list of classes for which the backup restoration process was started",
           "type": "array",
           "items": {
             "type": "string"
-          },
-          "example": [
-            "inCountry"
-          ]
+          }
         },
         "error": {
-          "description": "error message if status == failed",
-          "type": "string",
-          "default": "",
-          "example": "classify xzy: something went wrong"
-        },
-        "filters": {
-          "type": "object",
-          "properties": {
-            "sourceWhere": {
-              "description": "limit the objects to be classified",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
-            },
-            "targetWhere": {
-              "description": "Limit the possible sources when using an algorithm which doesn't really on training data, e.g. 'contextual'. When using an algorithm with a training set, such as 'knn', limit the training set instead",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
-            },
-            "trainingSetWhere": {
-              "description": "Limit the training objects to be considered during the classification. Can only be used on types with explicit training sets, such as 'knn'",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
-            }
-          }
+          "description": "error message if restoration failed",
+          "type": "string"
         },
         "id": {
-          "description": "ID to uniquely identify this classification run",
-          "type": "string",
-          "format": "uuid",
-          "example": "ee722219-b8ec-4db1-8f8d-5150bb1a9e0c"
-        },
-        "meta": {
-          "description": "additional meta information about the classification",
-          "type": "object",
-          "$ref": "#/definitions/ClassificationMeta"
+          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
         },
-        "settings": {
-          "description": "classification-type specific settings",
-          "type": "object"
+        "path": {
+          "description": "destination path of backup files proper to selected backend",
+          "type": "string"
         },
         "status": {
-          "description": "status of this classification",
+          "description": "phase of backup restoration process",
           "type": "string",
+          "default": "STARTED",
           "enum": [
-            "running",
-            "completed",
-            "failed"
-          ],
-          "example": "running"
-        },
-        "type": {
-          "description": "which algorithm to use for classifications",
-          "type": "string"
+            "STARTED",
+            "TRANSFERRING",
+            "TRANSFERRED",
+            "SUCCESS",
+            "FAILED"
+          ]
         }
       }
     },
-    "ClassificationMeta": {
-      "description": "Additional information to a specific classification",
-      "type": "object",
+    "BackupRestoreStatusResponse": {
+      "description": "The definition of a backup restore metadata",
       "properties": {
-        "completed": {
-          "description": "time when this classification finished",
-          "type": "string",
-          "format": "date-time",
-          "example": "2017-07-21T17:32:28Z"
+        "backend": {
+          "description": "Backup backend name e.g. filesystem, gcs, s3.",
+          "type": "string"
         },
-        "count": {
-          "description": "number of objects which were taken into consideration for classification",
-          "type": "integer",
-          "example": 147
+        "error": {
+          "description": "error message if restoration failed",
+          "type": "string"
         },
-        "countFailed": {
-          "description": "number of objects which could not be classified - see error message for details",
-          "type": "integer",
-          "example": 7
+        "id": {
+          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
         },
-        "countSucceeded": {
-          "description": "number of objects successfully classified",
-          "type": "integer",
-          "example": 140
+        "path": {
+          "description": "destination path of backup files proper to selected backup backend",
+          "type": "string"
         },
-        "started": {
-          "description": "time when this classification was started",
+        "status": {
+          "description": "phase of backup restoration process",
           "type": "string",
-          "format": "date-time",
-          "example": "2017-07-21T17:32:28Z"
+          "default": "STARTED",
+          "enum": [
+            "STARTED",
+            "TRANSFERRING",
+            "TRANSFERRED",
+            "SUCCESS",
+            "FAILED"
+          ]
         }
       }
     },
-    "Deprecation": {
+    "BatchDelete": {
       "type": "object",
       "properties": {
-        "apiType": {
-          "description": "Describes which API is effected, usually one of: REST, GraphQL",
-          "type": "string"
-        },
-        "id": {
-          "description": "The id that uniquely identifies this particular deprecations (mostly used internally)",
-          "type": "string"
+        "dryRun": {
+          "description": "If true, objects will not be deleted yet, but merely listed. Defaults to false.",
+          "type": "boolean",
+          "default": false
         },
-        "locations": {
-          "description": "The locations within the specified API affected by this deprecation",
-          "type": "array",
-          "items": {
-            "type": "string"
+        "match": {
+          "description": "Outlines how to find the objects to be deleted.",
+          "type": "object",
+          "properties": {
+            "class": {
+              "description": "Class (name) which objects will be deleted.",
+              "type": "string",
+              "example": "City"
+            },
+            "where": {
+              "description": "Filter to limit the objects to be deleted.",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            }
           }
         },
-        "mitigation": {
-          "description": "User-required object to not be affected by the (planned) removal",
-          "type": "string"
-        },
-        "msg": {
-          "description": "What this deprecation is about",
-          "type": "string"
-        },
-        "plannedRemovalVersion": {
-          "description": "A best-effort guess of which upcoming version will remove the feature entirely",
-          "type": "string"
-        },
-        "removedIn": {
-          "description": "If the feature has already been removed, it was removed in this version",
-          "type": "string",
-          "x-nullable": true
-        },
-        "removedTime": {
-          "description": "If the feature has already been removed, it was removed at this timestamp",
-          "type": "string",
-          "format": "date-time",
-          "x-nullable": true
-        },
-        "sinceTime": {
-          "description": "The deprecation was introduced in this version",
+        "output": {
+          "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
           "type": "string",
-          "format": "date-time"
-        },
-        "sinceVersion": {
-          "description": "The deprecation was introduced in this version",
-          "type": "string"
-        },
-        "status": {
-          "description": "Whether the problematic API functionality is deprecated (planned to be removed) or already removed",
-          "type": "string"
+          "default": "minimal"
         }
       }
     },
-    "ErrorResponse": {
-      "description": "An error response given by Weaviate end-points.",
+    "BatchDeleteResponse": {
+      "description": "Delete Objects response.",
       "type": "object",
       "properties": {
-        "error": {
-          "type": "array",
-          "items": {
-            "type": "object",
-            "properties": {
-              "message": {
-                "type": "string"
-              }
+        "dryRun": {
+          "description": "If true, objects will not be deleted yet, but merely listed. Defaults to false.",
+          "type": "boolean",
+          "default": false
+        },
+        "match": {
+          "description": "Outlines how to find the objects to be deleted.",
+          "type": "object",
+          "properties": {
+            "class": {
+              "description": "Class (name) which objects will be deleted.",
+              "type": "string",
+              "example": "City"
+            },
+            "where": {
+              "description": "Filter to limit the objects to be deleted.",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
             }
           }
-        }
-      }
-    },
-    "GeoCoordinates": {
-      "properties": {
-        "latitude": {
-          "description": "The latitude of the point on earth in decimal form",
-          "type": "number",
-          "format": "float",
-          "x-nullable": true
         },
-        "longitude": {
-          "description": "The longitude of the point on earth in decimal form",
-          "type": "number",
-          "format": "float",
-          "x-nullable": true
+        "output": {
+          "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
+          "type": "string",
+          "default": "minimal"
+        },
+        "results": {
+          "type": "object",
+          "properties": {
+            "failed": {
+              "description": "How many objects should have been deleted but could not be deleted.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            },
+            "limit": {
+              "description": "The most amount of objects that can be deleted in a single query, equals QUERY_MAXIMUM_RESULTS.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            },
+            "matches": {
+              "description": "How many objects were matched by the filter.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            },
+            "objects": {
+              "description": "With output set to \"minimal\" only objects with error occurred will the be described. Successfully deleted objects would be omitted. Output set to \"verbose\" will list all of the objets with their respective statuses.",
+              "type": "array",
+              "items": {
+                "description": "Results for this specific Object.",
+                "format": "object",
+                "properties": {
+                  "errors": {
+                    "$ref": "#/definitions/ErrorResponse"
+                  },
+                  "id": {
+                    "description": "ID of the Object.",
+                    "type": "string",
+                    "format": "uuid"
+                  },
+                  "status": {
+                    "type": "string",
+                    "default": "SUCCESS",
+                    "enum": [
+                      "SUCCESS",
+                      "DRYRUN",
+                      "FAILED"
+                    ]
+                  }
+                }
+              }
+            },
+            "successful": {
+              "description": "How many objects were successfully deleted in this round.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            }
+          }
         }
       }
     },
-    "GraphQLError": {
-      "description": "An error response caused by a GraphQL query.",
+    "BatchReference": {
       "properties": {
-        "locations": {
-          "type": "array",
-          "items": {
-            "type": "object",
-            "properties": {
-              "column": {
-                "type": "integer",
-                "format": "int64"
-              },
-              "line": {
-                "type": "integer",
-                "format": "int64"
-              }
-            }
-          }
+        "from": {
+          "description": "Long-form beacon-style URI to identify the source of the cross-ref including the property name. Should be in the form of weaviate://localhost/\u003ckinds\u003e/\u003cuuid\u003e/\u003cclassName\u003e/\u003cpropertyName\u003e, where \u003ckinds\u003e must be one of 'objects', 'objects' and \u003cclassName\u003e and \u003cpropertyName\u003e must represent the cross-ref property of source class to be used.",
+          "type": "string",
+          "format": "uri",
+          "example": "weaviate://localhost/Zoo/a5d09582-4239-4702-81c9-92a6e0122bb4/hasAnimals"
         },
-        "message": {
+        "tenant": {
+          "description": "Name of the tenant the source object belongs to. Required if the source class has multi-tenancy enabled.",
           "type": "string"
         },
-        "path": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "to": {
+          "description": "Short-form URI to point to the cross-ref. Should be in the form of weaviate://localhost/\u003cuuid\u003e for the example of a local cross-ref to an object",
+          "type": "string",
+          "format": "uri",
+          "example": "weaviate://localhost/97525810-a9a5-4eb0-858a-71449aeb007f"
         }
       }
     },
-    "GraphQLQueries": {
-      "description": "A list of GraphQL queries.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/GraphQLQuery"
-      }
-    },
-    "GraphQLQuery": {
-      "description": "GraphQL query based on: http://facebook.github.io/graphql/.",
+    "BatchReferenceResponse": {
       "type": "object",
+      "allOf": [
+        {
+          "$ref": "#/definitions/BatchReference"
+        },
+        {
+          "properties": {
+            "result": {
+              "description": "Results for this specific reference.",
+              "format": "object",
+              "properties": {
+                "errors": {
+                  "$ref": "#/definitions/ErrorResponse"
+                },
+                "status": {
+                  "type": "string",
+                  "default": "SUCCESS",
+                  "enum": [
+                    "SUCCESS",
+                    "PENDING",
+                    "FAILED"
+                  ]
+                }
+              }
+            }
+          }
+        }
+      ]
+    },
+    "C11yExtension": {
+      "description": "A resource describing an extension to the contextinoary, containing both the identifier and the definition of the extension",
       "properties": {
-        "operationName": {
-          "description": "The name of the operation if multiple exist in the query.",
-          "type": "string"
+        "concept": {
+          "description": "The new concept you want to extend. Must be an all-lowercase single word, or a space delimited compound word. Examples: 'foobarium', 'my custom concept'",
+          "type": "string",
+          "example": "foobarium"
         },
-        "query": {
-          "description": "Query based on GraphQL syntax.",
+        "definition": {
+          "description": "A list of space-delimited words or a sentence describing what the custom concept is about. Avoid using the custom concept itself. An Example definition for the custom concept 'foobarium': would be 'a naturally occourring element which can only be seen by programmers'",
           "type": "string"
         },
-        "variables": {
-          "description": "Additional variables for the query.",
-          "type": "object"
+        "weight": {
+          "description": "Weight of the definition of the new concept where 1='override existing definition entirely' and 0='ignore custom definition'. Note that if the custom concept is not present in the contextionary yet, the weight cannot be less than 1.",
+          "type": "number",
+          "format": "float"
         }
       }
     },
-    "GraphQLResponse": {
-      "description": "GraphQL based response: http://facebook.github.io/graphql/.",
-      "properties": {
-        "data": {
-          "description": "GraphQL data object.",
-          "type": "object",
-          "additionalProperties": {
-            "$ref": "#/definitions/JsonObject"
-          }
-        },
-        "errors": {
-          "description": "Array with errors.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/GraphQLError"
+    "C11yNearestNeighbors": {
+      "description": "C11y function to show the nearest neighbors to a word.",
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "distance": {
+            "type": "number",
+            "format": "float"
           },
-          "x-omitempty": true
+          "word": {
+            "type": "string"
+          }
         }
       }
     },
-    "GraphQLResponses": {
-      "description": "A list of GraphQL responses.",
+    "C11yVector": {
+      "description": "A Vector in the Contextionary",
       "type": "array",
       "items": {
-        "$ref": "#/definitions/GraphQLResponse"
+        "type": "number",
+        "format": "float"
       }
     },
-    "InvertedIndexConfig": {
-      "description": "Configure the inverted index built into Weaviate",
-      "type": "object",
+    "C11yVectorBasedQuestion": {
+      "description": "Receive question based on array of classes, properties and values.",
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "classProps": {
+            "description": "Vectorized properties.",
+            "type": "array",
+            "maxItems": 300,
+            "minItems": 300,
+            "items": {
+              "type": "object",
+              "properties": {
+                "propsVectors": {
+                  "type": "array",
+                  "items": {
+                    "type": "number",
+                    "format": "float"
+                  }
+                },
+                "value": {
+                  "description": "String with valuename.",
+                  "type": "string"
+                }
+              }
+            }
+          },
+          "classVectors": {
+            "description": "Vectorized classname.",
+            "type": "array",
+            "maxItems": 300,
+            "minItems": 300,
+            "items": {
+              "type": "number",
+              "format": "float"
+            }
+          }
+        }
+      }
+    },
+    "C11yWordsResponse": {
+      "description": "An array of available words and contexts.",
       "properties": {
-        "bm25": {
-          "$ref": "#/definitions/BM25Config"
-        },
-        "cleanupIntervalSeconds": {
-          "description": "Asynchronous index clean up happens every n seconds",
-          "type": "number",
-          "format": "int"
-        },
-        "indexNullState": {
-          "description": "Index each object with the null state",
-          "type": "boolean"
-        },
-        "indexPropertyLength": {
-          "description": "Index length of properties",
-          "type": "boolean"
-        },
-        "indexTimestamps": {
-          "description": "Index each object by its internal timestamps",
-          "type": "boolean"
+        "concatenatedWord": {
+          "description": "Weighted results for all words",
+          "type": "object",
+          "properties": {
+            "concatenatedNearestNeighbors": {
+              "$ref": "#/definitions/C11yNearestNeighbors"
+            },
+            "concatenatedVector": {
+              "$ref": "#/definitions/C11yVector"
+            },
+            "concatenatedWord": {
+              "type": "string"
+            },
+            "singleWords": {
+              "type": "array",
+              "items": {
+                "format": "string"
+              }
+            }
+          }
         },
-        "stopwords": {
-          "$ref": "#/definitions/StopwordConfig"
+        "individualWords": {
+          "description": "Weighted results for per individual word",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "info": {
+                "type": "object",
+                "properties": {
+                  "nearestNeighbors": {
+                    "$ref": "#/definitions/C11yNearestNeighbors"
+                  },
+                  "vector": {
+                    "$ref": "#/definitions/C11yVector"
+                  }
+                }
+              },
+              "present": {
+                "type": "boolean"
+              },
+              "word": {
+                "type": "string"
+              }
+            }
+          }
         }
       }
     },
-    "JsonObject": {
-      "description": "JSON object value.",
-      "type": "object"
-    },
-    "Link": {
+    "Class": {
       "type": "object",
       "properties": {
-        "documentationHref": {
-          "description": "weaviate documentation about this resource group",
+        "class": {
+          "description": "Name of the class as URI relative to the schema URL.",
           "type": "string"
         },
-        "href": {
-          "description": "target of the link",
+        "description": {
+          "description": "Description of the class.",
           "type": "string"
         },
-        "name": {
-          "description": "human readable name of the resource group",
+        "invertedIndexConfig": {
+          "$ref": "#/definitions/InvertedIndexConfig"
+        },
+        "moduleConfig": {
+          "description": "Configuration specific to modules this Weaviate instance has installed",
+          "type": "object"
+        },
+        "multiTenancyConfig": {
+          "$ref": "#/definitions/MultiTenancyConfig"
+        },
+        "properties": {
+          "description": "The properties of the class.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Property"
+          }
+        },
+        "replicationConfig": {
+          "$ref": "#/definitions/ReplicationConfig"
+        },
+        "shardingConfig": {
+          "description": "Manage how the index should be sharded and distributed in the cluster",
+          "type": "object"
+        },
+        "vectorConfig": {
+          "description": "Configure named vector spaces in addition to the default vector of the class. Each named vector has its own vectorizer and vector index. The name can be used to select the target vector in vector searches.",
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/VectorConfig"
+          }
+        },
+        "vectorIndexConfig": {
+          "description": "Vector-index config, that is specific to the type of index selected in vectorIndexType",
+          "type": "object"
+        },
+        "vectorIndexType": {
+          "description": "Name of the vector index to use, either \"hnsw\" (default) or \"flat\"",
           "type": "string"
         },
-        "rel": {
-          "description": "relationship if both resources are related, e.g. 'next', 'previous', 'parent', etc.",
+        "vectorizer": {
+          "description": "Specify how the vectors for this class should be determined. The options are either 'none' - this means you have to import a vector with each object yourself - or the name of a module that provides vectorization capabilities, such as 'text2vec-contextionary'. If left empty, it will use the globally configured default which can itself either be 'none' or a specific module.",
           "type": "string"
         }
       }
     },
-    "Meta": {
-      "description": "Contains meta information of the current Weaviate instance.",
+    "Classification": {
+      "description": "Manage classifications, trigger them and view status of past classifications.",
       "type": "object",
       "properties": {
-        "hostname": {
-          "description": "The url of the host.",
-          "type": "string",
-          "format": "url"
+        "basedOnProperties": {
+          "description": "base the text-based classification on these fields (of type text)",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "description"
+          ]
         },
-        "modules": {
-          "description": "Module-specific meta information",
+        "class": {
+          "description": "class (name) which is used in this classification",
+          "type": "string",
+          "example": "City"
+        },
+        "classifyProperties": {
+          "description": "which ref-property to set as part of the classification",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "inCountry"
+          ]
+        },
+        "error": {
+          "description": "error message if status == failed",
+          "type": "string",
+          "default": "",
+          "example": "classify xzy: something went wrong"
+        },
+        "filters": {
+          "type": "object",
+          "properties": {
+            "sourceWhere": {
+              "description": "limit the objects to be classified",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            },
+            "targetWhere": {
+              "description": "Limit the possible sources when using an algorithm which doesn't really on training data, e.g. 'contextual'. When using an algorithm with a training set, such as 'knn', limit the training set instead",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            },
+            "trainingSetWhere": {
+              "description": "Limit the training objects to be considered during the classification. Can only be used on types with explicit training sets, such as 'knn'",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            }
+          }
+        },
+        "id": {
+          "description": "ID to uniquely identify this classification run",
+          "type": "string",
+          "format": "uuid",
+          "example": "ee722219-b8ec-4db1-8f8d-5150bb1a9e0c"
+        },
+        "meta": {
+          "description": "additional meta information about the classification",
+          "type": "object",
+          "$ref": "#/definitions/ClassificationMeta"
+        },
+        "settings": {
+          "description": "classification-type specific settings",
           "type": "object"
         },
-        "version": {
-          "description": "Version of weaviate you are currently running",
+        "status": {
+          "description": "status of this classification",
+          "type": "string",
+          "enum": [
+            "running",
+            "completed",
+            "failed"
+          ],
+          "example": "running"
+        },
+        "type": {
+          "description": "which algorithm to use for classifications",
           "type": "string"
         }
       }
     },
-    "MultipleRef": {
-      "description": "Multiple instances of references to other objects.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/SingleRef"
-      }
-    },
-    "NodeShardStatus": {
-      "description": "The definition of a node shard status response body",
+    "ClassificationMeta": {
+      "description": "Additional information to a specific classification",
+      "type": "object",
       "properties": {
-        "class": {
-          "description": "The name of shard's class.",
+        "completed": {
+          "description": "time when this classification finished",
           "type": "string",
-          "x-omitempty": false
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
         },
-        "name": {
-          "description": "The name of the shard.",
-          "type": "string",
-          "x-omitempty": false
+        "count": {
+          "description": "number of objects which were taken into consideration for classification",
+          "type": "integer",
+          "example": 147
         },
-        "objectCount": {
-          "description": "The number of objects in shard.",
-          "type": "number",
-          "format": "int64",
-          "x-omitempty": false
-        }
-      }
-    },
-    "NodeStats": {
-      "description": "The summary of Weaviate's statistics.",
-      "properties": {
-        "objectCount": {
-          "description": "The total number of objects in DB.",
-          "type": "number",
-          "format": "int64",
-          "x-omitempty": false
+        "countFailed": {
+          "description": "number of objects which could not be classified - see error message for details",
+          "type": "integer",
+          "example": 7
         },
-        "shardCount": {
-          "description": "The count of Weaviate's shards.",
-          "type": "number",
-          "format": "int",
-          "x-omitempty": false
+        "countSucceeded": {
+          "description": "number of objects successfully classified",
+          "type": "integer",
+          "example": 140
+        },
+        "started": {
+          "description": "time when this classification was started",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
         }
       }
     },
-    "NodeStatus": {
-      "description": "The definition of a backup node status response body",
+    "Deprecation": {
+      "type": "object",
       "properties": {
-        "gitHash": {
-          "description": "The gitHash of Weaviate.",
+        "apiType": {
+          "description": "Describes which API is effected, usually one of: REST, GraphQL",
           "type": "string"
         },
-        "name": {
-          "description": "The name of the node.",
+        "id": {
+          "description": "The id that uniquely identifies this particular deprecations (mostly used internally)",
           "type": "string"
         },
-        "shards": {
-          "description": "The list of the shards with it's statistics.",
+        "locations": {
+          "description": "The locations within the specified API affected by this deprecation",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/NodeShardStatus"
+            "type": "string"
           }
         },
-        "stats": {
-          "description": "Weaviate overall statistics.",
-          "type": "object",
-          "$ref": "#/definitions/NodeStats"
+        "mitigation": {
+          "description": "User-required object to not be affected by the (planned) removal",
+          "type": "string"
         },
-        "status": {
-          "description": "Node's status.",
+        "msg": {
+          "description": "What this deprecation is about",
+          "type": "string"
+        },
+        "plannedRemovalVersion": {
+          "description": "A best-effort guess of which upcoming version will remove the feature entirely",
+          "type": "string"
+        },
+        "removedIn": {
+          "description": "If the feature has already been removed, it was removed in this version",
           "type": "string",
-          "default": "HEALTHY",
-          "enum": [
-            "HEALTHY",
-            "UNHEALTHY",
-            "UNAVAILABLE"
-          ]
+          "x-nullable": true
         },
-        "version": {
-          "description": "The version of Weaviate.",
+        "removedTime": {
+          "description": "If the feature has already been removed, it was removed at this timestamp",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "sinceTime": {
+          "description": "The deprecation was introduced in this version",
+          "type": "string",
+          "format": "date-time"
+        },
+        "sinceVersion": {
+          "description": "The deprecation was introduced in this version",
+          "type": "string"
+        },
+        "status": {
+          "description": "Whether the problematic API functionality is deprecated (planned to be removed) or already removed",
           "type": "string"
         }
       }
     },
-    "NodesStatusResponse": {
-      "description": "The status of all of the Weaviate nodes",
+    "ErrorResponse": {
+      "description": "An error response given by Weaviate end-points.",
       "type": "object",
       "properties": {
-        "nodes": {
+        "error": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/NodeStatus"
+            "type": "object",
+            "properties": {
+              "message": {
+                "type": "string"
+              }
+            }
           }
         }
       }
     },
-    "Object": {
-      "type": "object",
+    "GeoCoordinates": {
       "properties": {
-        "additional": {
-          "$ref": "#/definitions/AdditionalProperties"
+        "latitude": {
+          "description": "The latitude of the point on earth in decimal form",
+          "type": "number",
+          "format": "float",
+          "x-nullable": true
         },
-        "class": {
-          "description": "Class of the Object, defined in the schema.",
-          "type": "string"
+        "longitude": {
+          "description": "The longitude of the point on earth in decimal form",
+          "type": "number",
+          "format": "float",
+          "x-nullable": true
+        }
+      }
+    },
+    "GraphQLError": {
+      "description": "An error response caused by a GraphQL query.",
+      "properties": {
+        "locations": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "column": {
+                "type": "integer",
+                "format": "int64"
+              },
+              "line": {
+                "type": "integer",
+                "format": "int64"
+              }
+            }
+          }
         },
-        "creationTimeUnix": {
-          "description": "Timestamp of creation of this Object in milliseconds since epoch UTC.",
-          "type": "integer",
-          "format": "int64"
-        },
-        "id": {
-          "description": "ID of the Object.",
-          "type": "string",
-          "format": "uuid"
-        },
-        "lastUpdateTimeUnix": {
-          "description": "Timestamp of the last Object update in milliseconds since epoch UTC.",
-          "type": "integer",
-          "format": "int64"
-        },
-        "properties": {
-          "$ref": "#/definitions/PropertySchema"
-        },
-        "vector": {
-          "description": "This object's position in the Contextionary vector space. Read-only if using a vectorizer other than 'none'. Writable and required if using 'none' as vectorizer.",
-          "$ref": "#/definitions/C11yVector"
+        "message": {
+          "type": "string"
         },
-        "vectorWeights": {
-          "$ref": "#/definitions/VectorWeights"
+        "path": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         }
       }
     },
-    "ObjectsGetResponse": {
+    "GraphQLQueries": {
+      "description": "A list of GraphQL queries.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/GraphQLQuery"
+      }
+    },
+    "GraphQLQuery": {
+      "description": "GraphQL query based on: http://facebook.github.io/graphql/.",
       "type": "object",
-      "allOf": [
-        {
-          "$ref": "#/definitions/Object"
+      "properties": {
+        "operationName": {
+          "description": "The name of the operation if multiple exist in the query.",
+          "type": "string"
         },
-        {
-          "properties": {
-            "deprecations": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Deprecation"
-              }
-            }
-          }
+        "query": {
+          "description": "Query based on GraphQL syntax.",
+          "type": "string"
         },
-        {
-          "properties": {
-            "result": {
-              "description": "Results for this specific Object.",
-              "format": "object",
-              "properties": {
-                "errors": {
-                  "$ref": "#/definitions/ErrorResponse"
-                },
-                "status": {
-                  "type": "string",
-                  "default": "SUCCESS",
-                  "enum": [
-                    "SUCCESS",
-                    "PENDING",
-                    "FAILED"
-                  ]
-                }
-              }
-            }
-          }
+        "variables": {
+          "description": "Additional variables for the query.",
+          "type": "object"
         }
-      ]
+      }
     },
-    "ObjectsListResponse": {
-      "description": "List of Objects.",
-      "type": "object",
+    "GraphQLResponse": {
+      "description": "GraphQL based response: http://facebook.github.io/graphql/.",
       "properties": {
-        "deprecations": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/Deprecation"
+        "data": {
+          "description": "GraphQL data object.",
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/JsonObject"
           }
         },
-        "objects": {
-          "description": "The actual list of Objects.",
+        "errors": {
+          "description": "Array with errors.",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/Object"
-          }
-        },
-        "totalResults": {
-          "description": "The total number of Objects for the query. The number of items in a response may be smaller due to paging.",
-          "type": "integer",
-          "format": "int64"
+            "$ref": "#/definitions/GraphQLError"
+          },
+          "x-omitempty": true
         }
       }
     },
-    "PatchDocumentAction": {
-      "description": "Either a JSONPatch document as defined by RFC 6902 (from, op, path, value), or a merge document (RFC 7396).",
-      "required": [
-        "op",
-        "path"
-      ],
+    "GraphQLResponses": {
+      "description": "A list of GraphQL responses.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/GraphQLResponse"
+      }
+    },
+    "InvertedIndexConfig": {
+      "description": "Configure the inverted index built into Weaviate",
+      "type": "object",
       "properties": {
-        "from": {
-          "description": "A string containing a JSON Pointer value.",
-          "type": "string"
+        "bm25": {
+          "$ref": "#/definitions/BM25Config"
         },
-        "merge": {
-          "$ref": "#/definitions/Object"
+        "cleanupIntervalSeconds": {
+          "description": "Asynchronous index clean up happens every n seconds",
+          "type": "number",
+          "format": "int"
         },
-        "op": {
-          "description": "The operation to be performed.",
-          "type": "string",
-          "enum": [
-            "add",
-            "remove",
-            "replace",
-            "move",
-            "copy",
-            "test"
-          ]
+        "indexNullState": {
+          "description": "Index each object with the null state",
+          "type": "boolean"
         },
-        "path": {
-          "description": "A JSON-Pointer.",
-          "type": "string"
+        "indexPropertyLength": {
+          "description": "Index length of properties",
+          "type": "boolean"
         },
-        "value": {
-          "description": "The value to be used within the operations.",
-          "type": "object"
+        "indexTimestamps": {
+          "description": "Index each object by its internal timestamps",
+          "type": "boolean"
+        },
+        "stopwords": {
+          "$ref": "#/definitions/StopwordConfig"
         }
       }
     },
-    "PatchDocumentObject": {
-      "description": "Either a JSONPatch document as defined by RFC 6902 (from, op, path, value), or a merge document (RFC 7396).",
-      "required": [
-        "op",
-        "path"
-      ],
+    "JsonObject": {
+      "description": "JSON object value.",
+      "type": "object"
+    },
+    "Link": {
+      "type": "object",
       "properties": {
-        "from": {
-          "description": "A string containing a JSON Pointer value.",
+        "documentationHref": {
+          "description": "weaviate documentation about this resource group",
           "type": "string"
         },
-        "merge": {
-          "$ref": "#/definitions/Object"
-        },
-        "op": {
-          "description": "The operation to be performed.",
-          "type": "string",
-          "enum": [
-            "add",
-            "remove",
-            "replace",
-            "move",
-            "copy",
-            "test"
-          ]
+        "href": {
+          "description": "target of the link",
+          "type": "string"
         },
-        "path": {
-          "description": "A JSON-Pointer.",
+        "name": {
+          "description": "human readable name of the resource group",
           "type": "string"
         },
-        "value": {
-          "description": "The value to be used within the operations.",
-          "type": "object"
+        "rel": {
+          "description": "relationship if both resources are related, e.g. 'next', 'previous', 'parent', etc.",
+          "type": "string"
         }
       }
     },
-    "PeerUpdate": {
-      "description": "A single peer in the network.",
+    "Meta": {
+      "description": "Contains meta information of the current Weaviate instance.",
+      "type": "object",
       "properties": {
-        "id": {
-          "description": "The session ID of the peer.",
+        "hostname": {
+          "description": "The url of the host.",
           "type": "string",
-          "format": "uuid"
+          "format": "url"
         },
-        "name": {
-          "description": "Human readable name.",
-          "type": "string"
+        "modules": {
+          "description": "Module-specific meta information",
+          "type": "object"
         },
-        "schemaHash": {
-          "description": "The latest known hash of the peer's schema.",
+        "version": {
+          "description": "Version of weaviate you are currently running",
           "type": "string"
-        },
-        "uri": {
-          "description": "The location where the peer is exposed to the internet.",
-          "type": "string",
-          "format": "uri"
         }
       }
     },
-    "PeerUpdateList": {
-      "description": "List of known peers.",
+    "MultiTenancyConfig": {
+      "description": "Configuration related to multi-tenancy within a class",
+      "type": "object",
+      "properties": {
+        "enabled": {
+          "description": "Whether or not multi-tenancy is enabled for this class. Each tenant of a multi-tenant class is stored in its own shard.",
+          "type": "boolean",
+          "x-omitempty": false
+        }
+      }
+    },
+    "MultipleRef": {
+      "description": "Multiple instances of references to other objects.",
       "type": "array",
       "items": {
-        "$ref": "#/definitions/PeerUpdate"
+        "$ref": "#/definitions/SingleRef"
       }
     },
-    "PhoneNumber": {
+    "NodeShardStatus": {
+      "description": "The definition of a node shard status response body",
       "properties": {
-        "countryCode": {
-          "description": "Read-only. The numerical country code (e.g. 49)",
-          "type": "number",
-          "format": "uint64"
-        },
-        "defaultCountry": {
-          "description": "Optional. The ISO 3166-1 alpha-2 country code. This is used to figure out the correct countryCode and international format if only a national number (e.g. 0123 4567) is provided",
-          "type": "string"
-        },
-        "input": {
-          "description": "The raw input as the phone number is present in your raw data set. It will be parsed into the standardized formats if valid.",
-          "type": "string"
+        "class": {
+          "description": "The name of shard's class.",
+          "type": "string",
+          "x-omitempty": false
         },
-        "internationalFormatted": {
-          "description": "Read-only. Parsed result in the international format (e.g. +49 123 ...)",
-          "type": "string"
+        "name": {
+          "description": "The name of the shard.",
+          "type": "string",
+          "x-omitempty": false
         },
-        "national": {
-          "description": "Read-only. The numerical representation of the national part",
+        "objectCount": {
+          "description": "The number of objects in shard.",
           "type": "number",
-          "format": "uint64"
+          "format": "int64",
+          "x-omitempty": false
         },
-        "nationalFormatted": {
-          "description": "Read-only. Parsed result in the national format (e.g. 0123 456789)",
-          "type": "string"
+        "propertyDeletions": {
+          "description": "The progress of removing the values of deleted properties from the objects in shard.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/PropertyDeletionStatus"
+          }
         },
-        "valid": {
-          "description": "Read-only. Indicates whether the parsed number is a valid phone number",
-          "type": "boolean"
+        "reindex": {
+          "description": "The progress of the latest reindex job of the shard.",
+          "type": "object",
+          "$ref": "#/definitions/ReindexShardStatus"
         }
       }
     },
-    "Principal": {
-      "type": "object",
+    "NodeStats": {
+      "description": "The summary of Weaviate's statistics.",
       "properties": {
-        "groups": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "objectCount": {
+          "description": "The total number of objects in DB.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
         },
-        "username": {
-          "description": "The username that was extracted either from the authentication information",
-          "type": "string"
+        "shardCount": {
+          "description": "The count of Weaviate's shards.",
+          "type": "number",
+          "format": "int",
+          "x-omitempty": false
         }
       }
     },
-    "Property": {
-      "type": "object",
+    "NodeStatus": {
+      "description": "The definition of a backup node status response body",
       "properties": {
-        "dataType": {
-          "description": "Can be a reference to another type when it starts with a capital (for example Person), otherwise \"string\" or \"int\".",
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        },
-        "description": {
-          "description": "Description of the property.",
+        "gitHash": {
+          "description": "The gitHash of Weaviate.",
           "type": "string"
         },
-        "indexInverted": {
-          "description": "Optional. Should this property be indexed in the inverted index. Defaults to true. If you choose false, you will not be able to use this property in where filters. This property has no affect on vectorization decisions done by modules",
-          "type": "boolean",
-          "x-nullable": true
-        },
-        "moduleConfig": {
-          "description": "Configuration specific to modules this Weaviate instance has installed",
-          "type": "object"
-        },
         "name": {
-          "description": "Name of the property as URI relative to the schema URL.",
+          "description": "The name of the node.",
           "type": "string"
         },
-        "tokenization": {
-          "description": "Determines tokenization of the property as separate words or whole field. Optional. Applies to string, string[], text and text[] data types. Allowed values are ` + "`" + `word` + "`" + ` (default) and ` + "`" + `field` + "`" + ` for string and string[], ` + "`" + `word` + "`" + ` (default) for text and text[]. Not supported for remaining data types",
+        "shards": {
+          "description": "The list of the shards with it's statistics.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NodeShardStatus"
+          }
+        },
+        "stats": {
+          "description": "Weaviate overall statistics.",
+          "type": "object",
+          "$ref": "#/definitions/NodeStats"
+        },
+        "status": {
+          "description": "Node's status.",
           "type": "string",
+          "default": "HEALTHY",
           "enum": [
-            "word",
-            "field"
+            "HEALTHY",
+            "UNHEALTHY",
+            "UNAVAILABLE"
           ]
+        },
+        "version": {
+          "description": "The version of Weaviate.",
+          "type": "string"
         }
       }
     },
-    "PropertySchema": {
-      "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
-      "type": "object"
+    "NodesStatusResponse": {
+      "description": "The status of all of the Weaviate nodes",
+      "type": "object",
+      "properties": {
+        "nodes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NodeStatus"
+          }
+        }
+      }
     },
-    "ReferenceMetaClassification": {
-      "description": "This meta field contains additional info about the classified reference property",
+    "Object": {
+      "type": "object",
       "properties": {
-        "closestLosingDistance": {
-          "description": "The lowest distance of a neighbor in the losing group. Optional. If k equals the size of the winning group, there is no losing group",
-          "type": "number",
-          "format": "float32",
-          "x-nullable": true
-        },
-        "closestOverallDistance": {
-          "description": "The lowest distance of any neighbor, regardless of whether they were in the winning or losing group",
-          "type": "number",
-          "format": "float32"
+        "additional": {
+          "$ref": "#/definitions/AdditionalProperties"
         },
-        "closestWinningDistance": {
-          "description": "Closest distance of a neighbor from the winning group",
-          "type": "number",
-          "format": "float32"
+        "class": {
+          "description": "Class of the Object, defined in the schema.",
+          "type": "string"
         },
-        "losingCount": {
-          "description": "size of the losing group, can be 0 if the winning group size euqals k",
-          "type": "number",
+        "creationTimeUnix": {
+          "description": "Timestamp of creation of this Object in milliseconds since epoch UTC.",
+          "type": "integer",
           "format": "int64"
         },
-        "losingDistance": {
-          "description": "deprecated - do not use, to be removed in 0.23.0",
-          "type": "number",
-          "format": "float32",
-          "x-nullable": true
+        "id": {
+          "description": "ID of the Object.",
+          "type": "string",
+          "format": "uuid"
         },
-        "meanLosingDistance": {
-          "description": "Mean distance of all neighbors from the losing group. Optional. If k equals the size of the winning group, there is no losing group.",
-          "type": "number",
-          "format": "float32",
-          "x-nullable": true
+        "lastUpdateTimeUnix": {
+          "description": "Timestamp of the last Object update in milliseconds since epoch UTC.",
+          "type": "integer",
+          "format": "int64"
         },
-        "meanWinningDistance": {
-          "description": "Mean distance of all neighbors from the winning group",
-          "type": "number",
-          "format": "float32"
+        "properties": {
+          "$ref": "#/definitions/PropertySchema"
         },
-        "overallCount": {
-          "description": "overall neighbors checked as part of the classification. In most cases this will equal k, but could be lower than k - for example if not enough data was present",
-          "type": "number",
-          "format": "int64"
+        "tenant": {
+          "description": "Name of the tenant the object belongs to. Required for classes with multi-tenancy enabled, must be empty otherwise.",
+          "type": "string"
         },
-        "winningCount": {
-          "description": "size of the winning group, a number between 1..k",
-          "type": "number",
-          "format": "int64"
+        "vector": {
+          "description": "This object's position in the Contextionary vector space. Read-only if using a vectorizer other than 'none'. Writable and required if using 'none' as vectorizer.",
+          "$ref": "#/definitions/C11yVector"
         },
-        "winningDistance": {
-          "description": "deprecated - do not use, to be removed in 0.23.0",
-          "type": "number",
-          "format": "float32"
+        "vectorWeights": {
+          "$ref": "#/definitions/VectorWeights"
+        },
+        "vectors": {
+          "$ref": "#/definitions/Vectors"
         }
       }
     },
-    "Schema": {
-      "description": "Definitions of semantic schemas (also see: https://github.com/semi-technologies/weaviate-semantic-schemas).",
+    "ObjectsGetResponse": {
+      "type": "object",
+      "allOf": [
+        {
+          "$ref": "#/definitions/Object"
+        },
+        {
+          "properties": {
+            "deprecations": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Deprecation"
+              }
+            }
+          }
+        },
+        {
+          "properties": {
+            "result": {
+              "description": "Results for this specific Object.",
+              "format": "object",
+              "properties": {
+                "errors": {
+                  "$ref": "#/definitions/ErrorResponse"
+                },
+                "status": {
+                  "type": "string",
+                  "default": "SUCCESS",
+                  "enum": [
+                    "SUCCESS",
+                    "PENDING",
+                    "FAILED"
+                  ]
+                }
+              }
+            }
+          }
+        }
+      ]
+    },
+    "ObjectsListResponse": {
+      "description": "List of Objects.",
       "type": "object",
       "properties": {
-        "classes": {
-          "description": "Semantic classes that are available.",
+        "deprecations": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/Class"
+            "$ref": "#/definitions/Deprecation"
           }
         },
-        "maintainer": {
-          "description": "Email of the maintainer.",
-          "type": "string",
-          "format": "email"
+        "objects": {
+          "description": "The actual list of Objects.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Object"
+          }
         },
-        "name": {
-          "description": "Name of the schema.",
-          "type": "string"
+        "totalResults": {
+          "description": "The total number of Objects for the query. The number of items in a response may be smaller due to paging.",
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "SchemaHistory": {
-      "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
-      "type": "object"
+    "PatchDocumentAction": {
+      "description": "Either a JSONPatch document as defined by RFC 6902 (from, op, path, value), or a merge document (RFC 7396).",
+      "required": [
+        "op",
+        "path"
+      ],
+      "properties": {
+        "from": {
+          "description": "A string containing a JSON Pointer value.",
+          "type": "string"
+        },
+        "merge": {
+          "$ref": "#/definitions/Object"
+        },
+        "op": {
+          "description": "The operation to be performed.",
+          "type": "string",
+          "enum": [
+            "add",
+            "remove",
+            "replace",
+            "move",
+            "copy",
+            "test"
+          ]
+        },
+        "path": {
+          "description": "A JSON-Pointer.",
+          "type": "string"
+        },
+        "value": {
+          "description": "The value to be used within the operations.",
+          "type": "object"
+        }
+      }
     },
-    "ShardStatus": {
-      "description": "The status of a single shard",
+    "PatchDocumentObject": {
+      "description": "Either a JSONPatch document as defined by RFC 6902 (from, op, path, value), or a merge document (RFC 7396).",
+      "required": [
+        "op",
+        "path"
+      ],
       "properties": {
-        "status": {
-          "description": "Status of the shard",
+        "from": {
+          "description": "A string containing a JSON Pointer value.",
+          "type": "string"
+        },
+        "merge": {
+          "$ref": "#/definitions/Object"
+        },
+        "op": {
+          "description": "The operation to be performed.",
+          "type": "string",
+          "enum": [
+            "add",
+            "remove",
+            "replace",
+            "move",
+            "copy",
+            "test"
+          ]
+        },
+        "path": {
+          "description": "A JSON-Pointer.",
           "type": "string"
+        },
+        "value": {
+          "description": "The value to be used within the operations.",
+          "type": "object"
         }
       }
     },
-    "ShardStatusGetResponse": {
-      "description": "Response body of shard status get request",
+    "PeerUpdate": {
+      "description": "A single peer in the network.",
       "properties": {
+        "id": {
+          "description": "The session ID of the peer.",
+          "type": "string",
+          "format": "uuid"
+        },
         "name": {
-          "description": "Name of the shard",
+          "description": "Human readable name.",
           "type": "string"
         },
-        "status": {
-          "description": "Status of the shard",
+        "schemaHash": {
+          "description": "The latest known hash of the peer's schema.",
           "type": "string"
+        },
+        "uri": {
+          "description": "The location where the peer is exposed to the internet.",
+          "type": "string",
+          "format": "uri"
         }
       }
     },
-    "ShardStatusList": {
-      "description": "The status of all the shards of a Class",
+    "PeerUpdateList": {
+      "description": "List of known peers.",
       "type": "array",
       "items": {
-        "$ref": "#/definitions/ShardStatusGetResponse"
+        "$ref": "#/definitions/PeerUpdate"
       }
     },
-    "SingleRef": {
-      "description": "Either set beacon (direct reference) or set class and schema (concept reference)",
+    "PhoneNumber": {
       "properties": {
-        "beacon": {
-          "description": "If using a direct reference, specify the URI to point to the cross-ref here. Should be in the form of weaviate://localhost/\u003cuuid\u003e for the example of a local cross-ref to an object",
-          "type": "string",
-          "format": "uri"
+        "countryCode": {
+          "description": "Read-only. The numerical country code (e.g. 49)",
+          "type": "number",
+          "format": "uint64"
         },
-        "class": {
-          "description": "If using a concept reference (rather than a direct reference), specify the desired class name here",
-          "type": "string",
-          "format": "uri"
+        "defaultCountry": {
+          "description": "Optional. The ISO 3166-1 alpha-2 country code. This is used to figure out the correct countryCode and international format if only a national number (e.g. 0123 4567) is provided",
+          "type": "string"
         },
-        "classification": {
-          "description": "Additional Meta information about classifications if the item was part of one",
-          "$ref": "#/definitions/ReferenceMetaClassification"
+        "input": {
+          "description": "The raw input as the phone number is present in your raw data set. It will be parsed into the standardized formats if valid.",
+          "type": "string"
         },
-        "href": {
-          "description": "If using a direct reference, this read-only fields provides a link to the referenced resource. If 'origin' is globally configured, an absolute URI is shown - a relative URI otherwise.",
-          "type": "string",
-          "format": "uri"
+        "internationalFormatted": {
+          "description": "Read-only. Parsed result in the international format (e.g. +49 123 ...)",
+          "type": "string"
         },
-        "schema": {
-          "description": "If using a concept reference (rather than a direct reference), specify the desired properties here",
-          "$ref": "#/definitions/PropertySchema"
+        "national": {
+          "description": "Read-only. The numerical representation of the national part",
+          "type": "number",
+          "format": "uint64"
+        },
+        "nationalFormatted": {
+          "description": "Read-only. Parsed result in the national format (e.g. 0123 456789)",
+          "type": "string"
+        },
+        "valid": {
+          "description": "Read-only. Indicates whether the parsed number is a valid phone number",
+          "type": "boolean"
         }
       }
     },
-    "StopwordConfig": {
-      "description": "fine-grained control over stopword list usage",
+    "Principal": {
       "type": "object",
       "properties": {
-        "additions": {
-          "description": "stopwords to be considered additionally",
+        "groups": {
           "type": "array",
           "items": {
             "type": "string"
           }
         },
-        "preset": {
-          "description": "pre-existing list of common words by language",
+        "username": {
+          "description": "The username that was extracted either from the authentication information",
           "type": "string"
-        },
-        "removals": {
-          "description": "stopwords to be removed from consideration",
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
         }
       }
     },
-    "VectorWeights": {
-      "description": "Allow custom overrides of vector weights as math expressions. E.g. \"pancake\": \"7\" will set the weight for the word pancake to 7 in the vectorization, whereas \"w * 3\" would triple the originally calculated word. This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value (string/string) object.",
-      "type": "object"
-    },
-    "WhereFilter": {
-      "description": "Filter search results using a where filter",
+    "Property": {
       "type": "object",
       "properties": {
-        "operands": {
-          "description": "combine multiple where filters, requires 'And' or 'Or' operator",
+        "dataType": {
+          "description": "Can be a reference to another type when it starts with a capital (for example Person), otherwise \"string\" or \"int\".",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/WhereFilter"
+            "type": "string"
           }
         },
-        "operator": {
-          "description": "operator to use",
-          "type": "string",
-          "enum": [
-            "And",
-            "Or",
-            "Equal",
-            "Like",
-            "Not",
-            "NotEqual",
-            "GreaterThan",
-            "GreaterThanEqual",
-            "LessThan",
-            "LessThanEqual",
-            "WithinGeoRange",
-            "IsNull"
-          ],
-          "example": "GreaterThanEqual"
-        },
-        "path": {
-          "description": "path to the property currently being filtered",
-          "type": "array",
-          "items": {
-            "type": "string"
-          },
-          "example": [
-            "inCity",
-            "City",
-            "name"
-          ]
+        "description": {
+          "description": "Description of the property.",
+          "type": "string"
         },
-        "valueBoolean": {
-          "description": "value as boolean",
+        "indexInverted": {
+          "description": "Optional. Should this property be indexed in the inverted index. Defaults to true. If you choose false, you will not be able to use this property in where filters. This property has no affect on vectorization decisions done by modules",
           "type": "boolean",
-          "x-nullable": true,
-          "example": false
+          "x-nullable": true
         },
-        "valueDate": {
-          "description": "value as date (as string)",
+        "moduleConfig": {
+          "description": "Configuration specific to modules this Weaviate instance has installed",
+          "type": "object"
+        },
+        "name": {
+          "description": "Name of the property as URI relative to the schema URL.",
+          "type": "string"
+        },
+        "stemmer": {
+          "description": "Reduces the words of the property to their stem in the inverted index, so that filters and keyword search (bm25) for ` + "`" + `running` + "`" + ` also match ` + "`" + `run` + "`" + `. Optional. Applies to text and text[] with ` + "`" + `word` + "`" + ` or ` + "`" + `lowercase` + "`" + ` tokenization and to string and string[] with ` + "`" + `lowercase` + "`" + ` tokenization. Allowed values are ` + "`" + `none` + "`" + ` (default) and ` + "`" + `en` + "`" + `. Stemming is only available for English, the stopword presets of the other languages can be used without a stemmer",
           "type": "string",
-          "x-nullable": true,
-          "example": "TODO"
+          "enum": [
+            "none",
+            "en"
+          ]
         },
-        "valueGeoRange": {
-          "description": "value as geo coordinates and distance",
-          "type": "object",
-          "x-nullable": true,
-          "$ref": "#/definitions/WhereFilterGeoRange"
+        "tokenization": {
+          "description": "Determines tokenization of the property as separate words or whole field. Optional. Applies to string, string[], text and text[] data types. Allowed values are ` + "`" + `word` + "`" + ` (default), ` + "`" + `field` + "`" + `, ` + "`" + `whitespace` + "`" + `, ` + "`" + `lowercase` + "`" + `, ` + "`" + `trigram` + "`" + ` and ` + "`" + `cjk` + "`" + ` for string and string[], ` + "`" + `word` + "`" + ` (default), ` + "`" + `whitespace` + "`" + `, ` + "`" + `lowercase` + "`" + `, ` + "`" + `trigram` + "`" + ` and ` + "`" + `cjk` + "`" + ` for text and text[]. Not supported for remaining data types",
+          "type": "string",
+          "enum": [
+            "word",
+            "field",
+            "whitespace",
+            "lowercase",
+            "trigram",
+            "cjk"
+          ]
+        }
+      }
+    },
+    "PropertyDeletionStatus": {
+      "description": "The progress of removing the values of a deleted property from the objects of a shard",
+      "properties": {
+        "error": {
+          "description": "The error which stopped the removal, if it failed.",
+          "type": "string"
         },
-        "valueInt": {
-          "description": "value as integer",
-          "type": "integer",
+        "objectsProcessed": {
+          "description": "The number of objects which have been checked for values of the property so far.",
+          "type": "number",
           "format": "int64",
-          "x-nullable": true,
-          "example": 2000
+          "x-omitempty": false
         },
-        "valueNumber": {
-          "description": "value as number/float",
+        "property": {
+          "description": "The name of the deleted property.",
+          "type": "string"
+        },
+        "status": {
+          "description": "The status of the removal.",
+          "type": "string",
+          "enum": [
+            "IN_PROGRESS",
+            "DONE",
+            "FAILED"
+          ]
+        }
+      }
+    },
+    "PropertySchema": {
+      "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
+      "type": "object"
+    },
+    "ReferenceMetaClassification": {
+      "description": "This meta field contains additional info about the classified reference property",
+      "properties": {
+        "closestLosingDistance": {
+          "description": "The lowest distance of a neighbor in the losing group. Optional. If k equals the size of the winning group, there is no losing group",
           "type": "number",
-          "format": "float64",
-          "x-nullable": true,
-          "example": 3.14
+          "format": "float32",
+          "x-nullable": true
         },
-        "valueString": {
-          "description": "value as string",
+        "closestOverallDistance": {
+          "description": "The lowest distance of any neighbor, regardless of whether they were in the winning or losing group",
+          "type": "number",
+          "format": "float32"
+        },
+        "closestWinningDistance": {
+          "description": "Closest distance of a neighbor from the winning group",
+          "type": "number",
+          "format": "float32"
+        },
+        "losingCount": {
+          "description": "size of the losing group, can be 0 if the winning group size euqals k",
+          "type": "number",
+          "format": "int64"
+        },
+        "losingDistance": {
+          "description": "deprecated - do not use, to be removed in 0.23.0",
+          "type": "number",
+          "format": "float32",
+          "x-nullable": true
+        },
+        "meanLosingDistance": {
+          "description": "Mean distance of all neighbors from the losing group. Optional. If k equals the size of the winning group, there is no losing group.",
+          "type": "number",
+          "format": "float32",
+          "x-nullable": true
+        },
+        "meanWinningDistance": {
+          "description": "Mean distance of all neighbors from the winning group",
+          "type": "number",
+          "format": "float32"
+        },
+        "overallCount": {
+          "description": "overall neighbors checked as part of the classification. In most cases this will equal k, but could be lower than k - for example if not enough data was present",
+          "type": "number",
+          "format": "int64"
+        },
+        "winningCount": {
+          "description": "size of the winning group, a number between 1..k",
+          "type": "number",
+          "format": "int64"
+        },
+        "winningDistance": {
+          "description": "deprecated - do not use, to be removed in 0.23.0",
+          "type": "number",
+          "format": "float32"
+        }
+      }
+    },
+    "ReindexJob": {
+      "description": "The status of a reindex job across all shards of a class",
+      "properties": {
+        "className": {
+          "description": "The name of the class which is reindexed.",
+          "type": "string"
+        },
+        "error": {
+          "description": "The errors which stopped the job, if it failed.",
+          "type": "string"
+        },
+        "id": {
+          "description": "The id of the job.",
+          "type": "string"
+        },
+        "objectCount": {
+          "description": "The number of objects in all shards when the job was started.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "objectsProcessed": {
+          "description": "The number of objects which have been added to the new indexes so far.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "status": {
+          "description": "The status of the job.",
           "type": "string",
-          "x-nullable": true,
-          "example": "my search term"
+          "enum": [
+            "IN_PROGRESS",
+            "SUCCESS",
+            "FAILED",
+            "CANCELLED"
+          ]
+        }
+      }
+    },
+    "ReindexProperty": {
+      "description": "The new inverted index settings of a property",
+      "properties": {
+        "dataType": {
+          "description": "The data type to change the property to. Only types whose stored values stay valid can be converted: string and text into each other and int into number, as well as their array types. Optional, the current data type is kept if omitted.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "indexInverted": {
+          "description": "Whether the property is indexed in the inverted index. Optional, the current setting is kept if omitted.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "name": {
+          "description": "The name of the property.",
+          "type": "string"
+        },
+        "stemmer": {
+          "description": "The stemmer to reduce the words of the property with. Optional, the current setting is kept if omitted.",
+          "type": "string",
+          "enum": [
+            "none",
+            "en"
+          ]
+        },
+        "tokenization": {
+          "description": "The tokenization of the property. Optional, the current setting is kept if omitted.",
+          "type": "string",
+          "enum": [
+            "word",
+            "field",
+            "whitespace",
+            "lowercase",
+            "trigram",
+            "cjk"
+          ]
+        }
+      }
+    },
+    "ReindexRequest": {
+      "description": "The index settings of a class to change by rebuilding the indexes from the stored objects. The vectors are not recomputed, so the vectorizer of a class cannot be changed by a reindex job.",
+      "properties": {
+        "properties": {
+          "description": "The properties whose inverted index is rebuilt with new settings.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ReindexProperty"
+          }
+        },
+        "vectorIndexConfig": {
+          "description": "The config of the rebuilt vector index. Optional, the defaults of the vector index type are used if omitted.",
+          "type": "object"
+        },
+        "vectorIndexType": {
+          "description": "The type to rebuild the vector index as. Optional, the vector index is kept if omitted.",
+          "type": "string"
+        }
+      }
+    },
+    "ReindexShardStatus": {
+      "description": "The progress of a reindex job in a shard",
+      "properties": {
+        "error": {
+          "description": "The error which stopped the job, if it failed.",
+          "type": "string"
+        },
+        "jobId": {
+          "description": "The id of the job.",
+          "type": "string"
+        },
+        "objectCount": {
+          "description": "The number of objects in the shard when the job was started.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "objectsProcessed": {
+          "description": "The number of objects which have been added to the new indexes so far.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "status": {
+          "description": "The status of the job in the shard. The new indexes are swapped in once every shard is READY.",
+          "type": "string",
+          "enum": [
+            "IN_PROGRESS",
+            "READY",
+            "SUCCESS",
+            "FAILED",
+            "CANCELLED"
+          ]
+        }
+      }
+    },
+    "ReplicationConfig": {
+      "description": "Configure how replication is executed in a cluster",
+      "type": "object",
+      "properties": {
+        "factor": {
+          "description": "Number of times a class is replicated",
+          "type": "integer"
+        }
+      }
+    },
+    "Schema": {
+      "description": "Definitions of semantic schemas (also see: https://github.com/semi-technologies/weaviate-semantic-schemas).",
+      "type": "object",
+      "properties": {
+        "classes": {
+          "description": "Semantic classes that are available.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Class"
+          }
+        },
+        "maintainer": {
+          "description": "Email of the maintainer.",
+          "type": "string",
+          "format": "email"
+        },
+        "name": {
+          "description": "Name of the schema.",
+          "type": "string"
+        }
+      }
+    },
+    "SchemaHistory": {
+      "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
+      "type": "object"
+    },
+    "ShardStatus": {
+      "description": "The status of a single shard",
+      "properties": {
+        "status": {
+          "description": "Status of the shard",
+          "type": "string"
+        }
+      }
+    },
+    "ShardStatusGetResponse": {
+      "description": "Response body of shard status get request",
+      "properties": {
+        "name": {
+          "description": "Name of the shard",
+          "type": "string"
+        },
+        "status": {
+          "description": "Status of the shard",
+          "type": "string"
+        }
+      }
+    },
+    "ShardStatusList": {
+      "description": "The status of all the shards of a Class",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/ShardStatusGetResponse"
+      }
+    },
+    "SingleRef": {
+      "description": "Either set beacon (direct reference) or set class and schema (concept reference)",
+      "properties": {
+        "beacon": {
+          "description": "If using a direct reference, specify the URI to point to the cross-ref here. Should be in the form of weaviate://localhost/\u003cuuid\u003e for the example of a local cross-ref to an object",
+          "type": "string",
+          "format": "uri"
+        },
+        "class": {
+          "description": "If using a concept reference (rather than a direct reference), specify the desired class name here",
+          "type": "string",
+          "format": "uri"
+        },
+        "classification": {
+          "description": "Additional Meta information about classifications if the item was part of one",
+          "$ref": "#/definitions/ReferenceMetaClassification"
+        },
+        "href": {
+          "description": "If using a direct reference, this read-only fields provides a link to the referenced resource. If 'origin' is globally configured, an absolute URI is shown - a relative URI otherwise.",
+          "type": "string",
+          "format": "uri"
+        },
+        "schema": {
+          "description": "If using a concept reference (rather than a direct reference), specify the desired properties here",
+          "$ref": "#/definitions/PropertySchema"
+        }
+      }
+    },
+    "StopwordConfig": {
+      "description": "fine-grained control over stopword list usage",
+      "type": "object",
+      "properties": {
+        "additions": {
+          "description": "stopwords to be considered additionally",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "preset": {
+          "description": "pre-existing list of common words by language. Allowed values are ` + "`" + `en` + "`" + ` (default), ` + "`" + `de` + "`" + `, ` + "`" + `fr` + "`" + `, ` + "`" + `es` + "`" + `, ` + "`" + `it` + "`" + `, ` + "`" + `nl` + "`" + `, ` + "`" + `pt` + "`" + ` and ` + "`" + `none` + "`" + `",
+          "type": "string"
+        },
+        "removals": {
+          "description": "stopwords to be removed from consideration",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "Tenant": {
+      "description": "attributes representing a single tenant within weaviate",
+      "type": "object",
+      "properties": {
+        "name": {
+          "description": "name of the tenant",
+          "type": "string"
+        }
+      }
+    },
+    "VectorConfig": {
+      "description": "Configuration of a named vector space of a class. Each named vector has its own vectorizer and vector index.",
+      "type": "object",
+      "properties": {
+        "sourceProperties": {
+          "description": "Properties used by the vectorizer to create this vector. If empty, the vectorizer decides based on the module config of the class, just like for the default vector.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "vectorIndexConfig": {
+          "description": "Vector-index config, that is specific to the type of index selected in vectorIndexType",
+          "type": "object"
+        },
+        "vectorIndexType": {
+          "description": "Name of the vector index to use, either \"hnsw\" (default) or \"flat\"",
+          "type": "string"
+        },
+        "vectorizer": {
+          "description": "Name of the module that creates this vector, or 'none' if the vector is provided at import time",
+          "type": "string"
+        }
+      }
+    },
+    "VectorWeights": {
+      "description": "Allow custom overrides of vector weights as math expressions. E.g. \"pancake\": \"7\" will set the weight for the word pancake to 7 in the vectorization, whereas \"w * 3\" would triple the originally calculated word. This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value (string/string) object.",
+      "type": "object"
+    },
+    "Vectors": {
+      "description": "A map of named vectors, the keys are the names of the vector spaces configured in the class' vectorConfig.",
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/C11yVector"
+      }
+    },
+    "WhereFilter": {
+      "description": "Filter search results using a where filter",
+      "type": "object",
+      "properties": {
+        "operands": {
+          "description": "combine multiple where filters, requires 'And' or 'Or' operator",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/WhereFilter"
+          }
+        },
+        "operator": {
+          "description": "operator to use",
+          "type": "string",
+          "enum": [
+            "And",
+            "Or",
+            "Equal",
+            "Like",
+            "Not",
+            "NotEqual",
+            "GreaterThan",
+            "GreaterThanEqual",
+            "LessThan",
+            "LessThanEqual",
+            "WithinGeoRange",
+            "IsNull",
+            "ContainsAny",
+            "ContainsAll",
+            "In"
+          ],
+          "example": "GreaterThanEqual"
+        },
+        "path": {
+          "description": "path to the property currently being filtered",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "inCity",
+            "City",
+            "name"
+          ]
+        },
+        "valueBoolean": {
+          "description": "value as boolean",
+          "type": "boolean",
+          "x-nullable": true,
+          "example": false
+        },
+        "valueBooleanArray": {
+          "description": "value as boolean array, requires 'ContainsAny', 'ContainsAll' or 'In' operator",
+          "type": "array",
+          "items": {
+            "type": "boolean"
+          },
+          "x-omitempty": true
+        },
+        "valueDate": {
+          "description": "value as date (as string)",
+          "type": "string",
+          "x-nullable": true,
+          "example": "TODO"
+        },
+        "valueDateArray": {
+          "description": "value as date array (as strings), requires 'ContainsAny', 'ContainsAll' or 'In' operator",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "valueGeoRange": {
+          "description": "value as geo coordinates and distance",
+          "type": "object",
+          "x-nullable": true,
+          "$ref": "#/definitions/WhereFilterGeoRange"
+        },
+        "valueInt": {
+          "description": "value as integer",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true,
+          "example": 2000
+        },
+        "valueIntArray": {
+          "description": "value as integer array, requires 'ContainsAny', 'ContainsAll' or 'In' operator",
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "int64"
+          },
+          "x-omitempty": true
+        },
+        "valueNumber": {
+          "description": "value as number/float",
+          "type": "number",
+          "format": "float64",
+          "x-nullable": true,
+          "example": 3.14
+        },
+        "valueNumberArray": {
+          "description": "value as number/float array, requires 'ContainsAny', 'ContainsAll' or 'In' operator",
+          "type": "array",
+          "items": {
+            "type": "number",
+            "format": "float64"
+          },
+          "x-omitempty": true
+        },
+        "valueString": {
+          "description": "value as string",
+          "type": "string",
+          "x-nullable": true,
+          "example": "my search term"
+        },
+        "valueStringArray": {
+          "description": "value as string array, requires 'ContainsAny', 'ContainsAll' or 'In' operator",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "valueText": {
+          "description": "value as text (on text props)",
+          "type": "string",
+          "x-nullable": true,
+          "example": "my search term"
+        },
+        "valueTextArray": {
+          "description": "value as text array (on text props), requires 'ContainsAny', 'ContainsAll' or 'In' operator",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        }
+      }
+    },
+    "WhereFilterGeoRange": {
+      "description": "filter within a distance of a georange",
+      "type": "object",
+      "properties": {
+        "distance": {
+          "type": "object",
+          "properties": {
+            "max": {
+              "type": "number",
+              "format": "float64"
+            }
+          }
+        },
+        "geoCoordinates": {
+          "x-nullable": false,
+          "$ref": "#/definitions/GeoCoordinates"
+        }
+      }
+    }
+  },
+  "parameters": {
+    "CommonAfterParameterQuery": {
+      "description": "The starting ID of the result window. Objects are returned in ID order, starting after the given ID. Requires the class parameter and can't be combined with offset, sort or order.",
+      "in": "query",
+      "name": "after",
+      "required": false,
+      "type": "string"
+    },
+    "CommonClassParameterQuery": {
+      "type": "string",
+      "description": "Class parameter specifies the class from which to query objects",
+      "name": "class",
+      "in": "query"
+    },
+    "CommonConsistencyLevelParameterQuery": {
+      "enum": [
+        "ONE",
+        "QUORUM",
+        "ALL"
+      ],
+      "type": "string",
+      "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+      "name": "consistency_level",
+      "in": "query"
+    },
+    "CommonIncludeParameterQuery": {
+      "type": "string",
+      "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
+      "name": "include",
+      "in": "query"
+    },
+    "CommonLimitParameterQuery": {
+      "type": "integer",
+      "format": "int64",
+      "description": "The maximum number of items to be returned per page. Default value is set in Weaviate config.",
+      "name": "limit",
+      "in": "query"
+    },
+    "CommonOffsetParameterQuery": {
+      "type": "integer",
+      "format": "int64",
+      "default": 0,
+      "description": "The starting index of the result window. Default value is 0.",
+      "name": "offset",
+      "in": "query"
+    },
+    "CommonOrderParameterQuery": {
+      "type": "string",
+      "description": "Order parameter to tell how to order (asc or desc) data within given field",
+      "name": "order",
+      "in": "query"
+    },
+    "CommonSortParameterQuery": {
+      "type": "string",
+      "description": "Sort parameter to pass an information about the names of the sort fields",
+      "name": "sort",
+      "in": "query"
+    },
+    "CommonTenantParameterQuery": {
+      "type": "string",
+      "description": "Specifies the tenant in a request targeting a multi-tenant class",
+      "name": "tenant",
+      "in": "query"
+    }
+  },
+  "securityDefinitions": {
+    "oidc": {
+      "description": "OIDC (OpenConnect ID - based on OAuth2)",
+      "type": "oauth2",
+      "flow": "implicit",
+      "authorizationUrl": "http://to-be-configured-in-the-application-config"
+    }
+  },
+  "security": [
+    {},
+    {
+      "oidc": []
+    }
+  ],
+  "tags": [
+    {
+      "name": "objects"
+    },
+    {
+      "description": "These operations allow to execute batch requests for Objects and Objects. Mostly used for importing large datasets.",
+      "name": "batch"
+    },
+    {
+      "name": "graphql"
+    },
+    {
+      "name": "meta"
+    },
+    {
+      "name": "P2P"
+    },
+    {
+      "description": "All functions related to the Contextionary.",
+      "name": "contextionary-API"
+    },
+    {
+      "description": "These operations enable manipulation of the schema in Weaviate schema.",
+      "name": "schema"
+    }
+  ],
+  "externalDocs": {
+    "url": "https://github.com/semi-technologies/weaviate"
+  }
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "consumes": [
+    "application/json",
+    "application/yaml"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "schemes": [
+    "https"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "description": "Cloud-native, modular vector search engine",
+    "title": "Weaviate",
+    "contact": {
+      "name": "Weaviate",
+      "url": "https://github.com/semi-technologies",
+      "email": "hello@semi.technology"
+    },
+    "version": "1.16.0"
+  },
+  "basePath": "/v1",
+  "paths": {
+    "/": {
+      "get": {
+        "description": "Home. Discover the REST API",
+        "operationId": "weaviate.root",
+        "responses": {
+          "200": {
+            "description": "Weaviate is alive and ready to serve content",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "links": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/Link"
+                  }
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "/.well-known/live": {
+      "get": {
+        "description": "Determines whether the application is alive. Can be used for kubernetes liveness probe",
+        "operationId": "weaviate.wellknown.liveness",
+        "responses": {
+          "200": {
+            "description": "The application is able to respond to HTTP requests"
+          }
+        }
+      }
+    },
+    "/.well-known/openid-configuration": {
+      "get": {
+        "description": "OIDC Discovery page, redirects to the token issuer if one is configured",
+        "tags": [
+          "well-known",
+          "oidc",
+          "discovery"
+        ],
+        "summary": "OIDC discovery information if OIDC auth is enabled",
+        "responses": {
+          "200": {
+            "description": "Successful response, inspect body",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "clientId": {
+                  "description": "OAuth Client ID",
+                  "type": "string"
+                },
+                "href": {
+                  "description": "The Location to redirect to",
+                  "type": "string"
+                },
+                "scopes": {
+                  "description": "OAuth Scopes",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  },
+                  "x-omitempty": true
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "Not found, no oidc provider present"
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
+      }
+    },
+    "/.well-known/ready": {
+      "get": {
+        "description": "Determines whether the application is ready to receive traffic. Can be used for kubernetes readiness probe.",
+        "operationId": "weaviate.wellknown.readiness",
+        "responses": {
+          "200": {
+            "description": "The application has completed its start-up routine and is ready to accept traffic."
+          },
+          "503": {
+            "description": "The application is currently not able to serve traffic. If other horizontal replicas of weaviate are available and they are capable of receiving traffic, all traffic should be redirected there instead."
+          }
+        }
+      }
+    },
+    "/backups/{backend}": {
+      "get": {
+        "description": "Returns the backups stored by a backup backend",
+        "tags": [
+          "backups"
+        ],
+        "operationId": "backups.list",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Backups successfully listed.",
+            "schema": {
+              "$ref": "#/definitions/BackupListResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid backup list attempt.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      },
+      "post": {
+        "description": "Starts a process of creating a backup for a set of classes",
+        "tags": [
+          "backups"
+        ],
+        "operationId": "backups.create",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BackupCreateRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Backup create process successfully started.",
+            "schema": {
+              "$ref": "#/definitions/BackupCreateResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid backup creation attempt.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      }
+    },
+    "/backups/{backend}/{id}": {
+      "get": {
+        "description": "Returns status of backup creation attempt for a set of classes",
+        "tags": [
+          "backups"
+        ],
+        "operationId": "backups.create.status",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Backup creation status successfully returned",
+            "schema": {
+              "$ref": "#/definitions/BackupCreateStatusResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Backup does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid backup restoration status attempt.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      },
+      "delete": {
+        "description": "Deletes a backup and all of its files from a backup backend",
+        "tags": [
+          "backups"
+        ],
+        "operationId": "backups.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Backup successfully deleted."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Backup does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid backup deletion attempt.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      }
+    },
+    "/backups/{backend}/{id}/restore": {
+      "get": {
+        "description": "Returns status of a backup restoration attempt for a set of classes",
+        "tags": [
+          "backups"
+        ],
+        "operationId": "backups.restore.status",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Backup restoration status successfully returned",
+            "schema": {
+              "$ref": "#/definitions/BackupRestoreStatusResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Backup does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "valueText": {
-          "description": "value as text (on text props)",
-          "type": "string",
-          "x-nullable": true,
-          "example": "my search term"
-        }
-      }
-    },
-    "WhereFilterGeoRange": {
-      "description": "filter within a distance of a georange",
-      "type": "object",
-      "properties": {
-        "distance": {
-          "type": "object",
-          "properties": {
-            "max": {
-              "type": "number",
-              "format": "float64"
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      },
+      "post": {
+        "description": "Starts a process of restoring a backup for a set of classes",
+        "tags": [
+          "backups"
+        ],
+        "operationId": "backups.restore",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BackupRestoreRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Backup restoration process successfully started.",
+            "schema": {
+              "$ref": "#/definitions/BackupRestoreResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Backup does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid backup restoration attempt.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
-        "geoCoordinates": {
-          "x-nullable": false,
-          "$ref": "#/definitions/GeoCoordinates"
-        }
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
       }
-    }
-  },
-  "parameters": {
-    "CommonClassParameterQuery": {
-      "type": "string",
-      "description": "Class parameter specifies the class from which to query objects",
-      "name": "class",
-      "in": "query"
-    },
-    "CommonIncludeParameterQuery": {
-      "type": "string",
-      "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
-      "name": "include",
-      "in": "query"
-    },
-    "CommonLimitParameterQuery": {
-      "type": "integer",
-      "format": "int64",
-      "description": "The maximum number of items to be returned per page. Default value is set in Weaviate config.",
-      "name": "limit",
-      "in": "query"
-    },
-    "CommonOffsetParameterQuery": {
-      "type": "integer",
-      "format": "int64",
-      "default": 0,
-      "description": "The starting index of the result window. Default value is 0.",
-      "name": "offset",
-      "in": "query"
-    },
-    "CommonOrderParameterQuery": {
-      "type": "string",
-      "description": "Order parameter to tell how to order (asc or desc) data within given field",
-      "name": "order",
-      "in": "query"
-    },
-    "CommonSortParameterQuery": {
-      "type": "string",
-      "description": "Sort parameter to pass an information about the names of the sort fields",
-      "name": "sort",
-      "in": "query"
-    }
-  },
-  "securityDefinitions": {
-    "oidc": {
-      "description": "OIDC (OpenConnect ID - based on OAuth2)",
-      "type": "oauth2",
-      "flow": "implicit",
-      "authorizationUrl": "http://to-be-configured-in-the-application-config"
-    }
-  },
-  "security": [
-    {},
-    {
-      "oidc": []
-    }
-  ],
-  "tags": [
-    {
-      "name": "objects"
-    },
-    {
-      "description": "These operations allow to execute batch requests for Objects and Objects. Mostly used for importing large datasets.",
-      "name": "batch"
-    },
-    {
-      "name": "graphql"
-    },
-    {
-      "name": "meta"
-    },
-    {
-      "name": "P2P"
-    },
-    {
-      "description": "All functions related to the Contextionary.",
-      "name": "contextionary-API"
-    },
-    {
-      "description": "These operations enable manipulation of the schema in Weaviate schema.",
-      "name": "schema"
-    }
-  ],
-  "externalDocs": {
-    "url": "https://github.com/semi-technologies/weaviate"
-  }
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "consumes": [
-    "application/json",
-    "application/yaml"
-  ],
-  "produces": [
-    "application/json"
-  ],
-  "schemes": [
-    "https"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "description": "Cloud-native, modular vector search engine",
-    "title": "Weaviate",
-    "contact": {
-      "name": "Weaviate",
-      "url": "https://github.com/semi-technologies",
-      "email": "hello@semi.technology"
     },
-    "version": "1.16.0"
-  },
-  "basePath": "/v1",
-  "paths": {
-    "/": {
-      "get": {
-        "description": "Home. Discover the REST API",
-        "operationId": "weaviate.root",
-        "responses": {
-          "200": {
-            "description": "Weaviate is alive and ready to serve content",
+    "/batch/objects": {
+      "post": {
+        "description": "Register new Objects in bulk. Provided meta-data and schema values are validated.",
+        "tags": [
+          "batch",
+          "objects"
+        ],
+        "summary": "Creates new Objects based on a Object template as a batch.",
+        "operationId": "batch.objects.create",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
             "schema": {
               "type": "object",
               "properties": {
-                "links": {
+                "fields": {
+                  "description": "Define which fields need to be returned. Default value is ALL",
                   "type": "array",
                   "items": {
-                    "$ref": "#/definitions/Link"
+                    "type": "string",
+                    "default": "ALL",
+                    "enum": [
+                      "ALL",
+                      "class",
+                      "schema",
+                      "id",
+                      "creationTimeUnix"
+                    ]
+                  }
+                },
+                "objects": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/Object"
                   }
                 }
               }
             }
+          },
+          {
+            "enum": [
+              "ONE",
+              "QUORUM",
+              "ALL"
+            ],
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
           }
-        }
-      }
-    },
-    "/.well-known/live": {
-      "get": {
-        "description": "Determines whether the application is alive. Can be used for kubernetes liveness probe",
-        "operationId": "weaviate.wellknown.liveness",
-        "responses": {
-          "200": {
-            "description": "The application is able to respond to HTTP requests"
-          }
-        }
-      }
-    },
-    "/.well-known/openid-configuration": {
-      "get": {
-        "description": "OIDC Discovery page, redirects to the token issuer if one is configured",
-        "tags": [
-          "well-known",
-          "oidc",
-          "discovery"
         ],
-        "summary": "OIDC discovery information if OIDC auth is enabled",
         "responses": {
           "200": {
-            "description": "Successful response, inspect body",
+            "description": "Request succeeded, see response body to get detailed information about each batched item.",
             "schema": {
-              "type": "object",
-              "properties": {
-                "clientId": {
-                  "description": "OAuth Client ID",
-                  "type": "string"
-                },
-                "href": {
-                  "description": "The Location to redirect to",
-                  "type": "string"
-                },
-                "scopes": {
-                  "description": "OAuth Scopes",
-                  "type": "array",
-                  "items": {
-                    "type": "string"
-                  },
-                  "x-omitempty": true
-                }
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/ObjectsGetResponse"
               }
             }
           },
-          "404": {
-            "description": "Not found, no oidc provider present"
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -4277,52 +5723,51 @@ func init() {
           }
         },
         "x-available-in-mqtt": false,
-        "x-available-in-websocket": false
-      }
-    },
-    "/.well-known/ready": {
-      "get": {
-        "description": "Determines whether the application is ready to receive traffic. Can be used for kubernetes readiness probe.",
-        "operationId": "weaviate.wellknown.readiness",
-        "responses": {
-          "200": {
-            "description": "The application has completed its start-up routine and is ready to accept traffic."
-          },
-          "503": {
-            "description": "The application is currently not able to serve traffic. If other horizontal replicas of weaviate are available and they are capable of receiving traffic, all traffic should be redirected there instead."
-          }
-        }
-      }
-    },
-    "/backups/{backend}": {
-      "post": {
-        "description": "Starts a process of creating a backup for a set of classes",
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.add"
+        ]
+      },
+      "delete": {
+        "description": "Delete Objects in bulk that match a certain filter.",
         "tags": [
-          "backups"
+          "batch",
+          "objects"
         ],
-        "operationId": "backups.create",
+        "summary": "Deletes Objects based on a match filter as a batch.",
+        "operationId": "batch.objects.delete",
         "parameters": [
-          {
-            "type": "string",
-            "description": "Backup backend name e.g. filesystem, gcs, s3.",
-            "name": "backend",
-            "in": "path",
-            "required": true
-          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/BackupCreateRequest"
+              "$ref": "#/definitions/BatchDelete"
             }
+          },
+          {
+            "enum": [
+              "ONE",
+              "QUORUM",
+              "ALL"
+            ],
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Backup create process successfully started.",
+            "description": "Request succeeded, see response body to get detailed information about each batched item.",
             "schema": {
-              "$ref": "#/definitions/BackupCreateResponse"
+              "$ref": "#/definitions/BatchDeleteResponse"
             }
           },
           "401": {
@@ -4335,7 +5780,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Invalid backup creation attempt.",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -4347,39 +5792,55 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.backup"
+          "weaviate.local.manipulate"
         ]
       }
     },
-    "/backups/{backend}/{id}": {
-      "get": {
-        "description": "Returns status of backup creation attempt for a set of classes",
+    "/batch/references": {
+      "post": {
+        "description": "Register cross-references between any class items (objects or objects) in bulk.",
         "tags": [
-          "backups"
+          "batch",
+          "references"
         ],
-        "operationId": "backups.create.status",
+        "summary": "Creates new Cross-References between arbitrary classes in bulk.",
+        "operationId": "batch.references.create",
         "parameters": [
           {
-            "type": "string",
-            "description": "Backup backend name e.g. filesystem, gcs, s3.",
-            "name": "backend",
-            "in": "path",
-            "required": true
+            "description": "A list of references to be batched. The ideal size depends on the used database connector. Please see the documentation of the used connector for help",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BatchReference"
+              }
+            }
           },
           {
+            "enum": [
+              "ONE",
+              "QUORUM",
+              "ALL"
+            ],
             "type": "string",
-            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-            "name": "id",
-            "in": "path",
-            "required": true
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Backup creation status successfully returned",
+            "description": "Request Successful. Warning: A successful request does not guarantee that every batched reference was successfully created. Inspect the response body to see which references succeeded and which failed.",
             "schema": {
-              "$ref": "#/definitions/BackupCreateStatusResponse"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BatchReferenceResponse"
+              }
             }
           },
           "401": {
@@ -4391,14 +5852,63 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Not Found - Backup does not exist",
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Invalid backup restoration status attempt.",
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.add"
+        ]
+      }
+    },
+    "/classifications/": {
+      "post": {
+        "description": "Trigger a classification based on the specified params. Classifications will run in the background, use GET /classifications/\u003cid\u003e to retrieve the status of your classification.",
+        "tags": [
+          "classifications"
+        ],
+        "summary": "Starts a classification.",
+        "operationId": "classifications.post",
+        "parameters": [
+          {
+            "description": "parameters to start a classification",
+            "name": "params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Classification"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Successfully started classification.",
+            "schema": {
+              "$ref": "#/definitions/Classification"
+            }
+          },
+          "400": {
+            "description": "Incorrect request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -4411,38 +5921,82 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.backup"
+          "weaviate.classifications.post"
         ]
       }
     },
-    "/backups/{backend}/{id}/restore": {
+    "/classifications/{id}": {
       "get": {
-        "description": "Returns status of a backup restoration attempt for a set of classes",
+        "description": "Get status, results and metadata of a previously created classification",
         "tags": [
-          "backups"
+          "classifications"
         ],
-        "operationId": "backups.restore.status",
+        "summary": "View previously created classification",
+        "operationId": "classifications.get",
         "parameters": [
           {
             "type": "string",
-            "description": "Backup backend name e.g. filesystem, gcs, s3.",
-            "name": "backend",
+            "description": "classification id",
+            "name": "id",
             "in": "path",
             "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Found the classification, returned as body",
+            "schema": {
+              "$ref": "#/definitions/Classification"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Classification does not exist"
           },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.classifications.get"
+        ]
+      }
+    },
+    "/graphql": {
+      "post": {
+        "description": "Get an object based on GraphQL",
+        "tags": [
+          "graphql"
+        ],
+        "summary": "Get a response based on GraphQL",
+        "operationId": "graphql.post",
+        "parameters": [
           {
-            "type": "string",
-            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-            "name": "id",
-            "in": "path",
-            "required": true
+            "description": "The GraphQL query request parameters.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/GraphQLQuery"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Backup restoration status successfully returned",
+            "description": "Successful query (with select).",
             "schema": {
-              "$ref": "#/definitions/BackupRestoreStatusResponse"
+              "$ref": "#/definitions/GraphQLResponse"
             }
           },
           "401": {
@@ -4454,8 +6008,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Not Found - Backup does not exist",
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -4467,45 +6021,40 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.backup"
+          "weaviate.local.query",
+          "weaviate.local.query.meta",
+          "weaviate.network.query",
+          "weaviate.network.query.meta"
         ]
-      },
+      }
+    },
+    "/graphql/batch": {
       "post": {
-        "description": "Starts a process of restoring a backup for a set of classes",
+        "description": "Perform a batched GraphQL query",
         "tags": [
-          "backups"
+          "graphql"
         ],
-        "operationId": "backups.restore",
+        "summary": "Get a response based on GraphQL.",
+        "operationId": "graphql.batch",
         "parameters": [
           {
-            "type": "string",
-            "description": "Backup backend name e.g. filesystem, gcs, s3.",
-            "name": "backend",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
+            "description": "The GraphQL queries.",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/BackupRestoreRequest"
+              "$ref": "#/definitions/GraphQLQueries"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Backup restoration process successfully started.",
+            "description": "Successful query (with select).",
             "schema": {
-              "$ref": "#/definitions/BackupRestoreResponse"
+              "$ref": "#/definitions/GraphQLResponses"
             }
           },
           "401": {
@@ -4517,14 +6066,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Not Found - Backup does not exist",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "422": {
-            "description": "Invalid backup restoration attempt.",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -4536,61 +6079,66 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.backup"
+          "weaviate.local.query",
+          "weaviate.local.query.meta",
+          "weaviate.network.query",
+          "weaviate.network.query.meta"
         ]
       }
     },
-    "/batch/objects": {
-      "post": {
-        "description": "Register new Objects in bulk. Provided meta-data and schema values are validated.",
+    "/meta": {
+      "get": {
+        "description": "Gives meta information about the server and can be used to provide information to another Weaviate instance that wants to interact with the current instance.",
         "tags": [
-          "batch",
-          "objects"
+          "meta"
         ],
-        "summary": "Creates new Objects based on a Object template as a batch.",
-        "operationId": "batch.objects.create",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
+        "summary": "Returns meta information of the current Weaviate instance.",
+        "operationId": "meta.get",
+        "responses": {
+          "200": {
+            "description": "Successful response.",
             "schema": {
-              "type": "object",
-              "properties": {
-                "fields": {
-                  "description": "Define which fields need to be returned. Default value is ALL",
-                  "type": "array",
-                  "items": {
-                    "type": "string",
-                    "default": "ALL",
-                    "enum": [
-                      "ALL",
-                      "class",
-                      "schema",
-                      "id",
-                      "creationTimeUnix"
-                    ]
-                  }
-                },
-                "objects": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/Object"
-                  }
-                }
-              }
+              "$ref": "#/definitions/Meta"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      }
+    },
+    "/nodes": {
+      "get": {
+        "description": "Returns status of Weaviate DB.",
+        "tags": [
+          "nodes"
         ],
+        "operationId": "nodes.get",
         "responses": {
           "200": {
-            "description": "Request succeeded, see response body to get detailed information about each batched item.",
+            "description": "Nodes status successfully returned",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/ObjectsGetResponse"
-              }
+              "$ref": "#/definitions/NodesStatusResponse"
             }
           },
           "401": {
@@ -4602,8 +6150,14 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Not Found - Backup does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Invalid backup restoration status attempt.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -4615,35 +6169,84 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add"
+          "weaviate.nodes.status.get"
         ]
-      },
-      "delete": {
-        "description": "Delete Objects in bulk that match a certain filter.",
+      }
+    },
+    "/objects": {
+      "get": {
+        "description": "Lists all Objects in reverse order of creation, owned by the user that belongs to the used token.",
         "tags": [
-          "batch",
           "objects"
         ],
-        "summary": "Deletes Objects based on a match filter as a batch.",
-        "operationId": "batch.objects.delete",
+        "summary": "Get a list of Objects.",
+        "operationId": "objects.list",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/BatchDelete"
-            }
+            "description": "The starting ID of the result window. Objects are returned in ID order, starting after the given ID. Requires the class parameter and can't be combined with offset, sort or order.",
+            "in": "query",
+            "name": "after",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "default": 0,
+            "description": "The starting index of the result window. Default value is 0.",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The maximum number of items to be returned per page. Default value is set in Weaviate config.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
+            "name": "include",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Sort parameter to pass an information about the names of the sort fields",
+            "name": "sort",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Order parameter to tell how to order (asc or desc) data within given field",
+            "name": "order",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Class parameter specifies the class from which to query objects",
+            "name": "class",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Request succeeded, see response body to get detailed information about each batched item.",
+            "description": "Successful response.",
             "schema": {
-              "$ref": "#/definitions/BatchDeleteResponse"
+              "$ref": "#/definitions/ObjectsListResponse"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -4655,6 +6258,9 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
           "422": {
             "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
@@ -4671,41 +6277,42 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
-        ]
-      }
-    },
-    "/batch/references": {
+          "weaviate.local.query"
+        ]
+      },
       "post": {
-        "description": "Register cross-references between any class items (objects or objects) in bulk.",
+        "description": "Registers a new Object. Provided meta-data and schema values are validated.",
         "tags": [
-          "batch",
-          "references"
+          "objects"
         ],
-        "summary": "Creates new Cross-References between arbitrary classes in bulk.",
-        "operationId": "batch.references.create",
+        "summary": "Create Objects between two Objects (object and subject).",
+        "operationId": "objects.create",
         "parameters": [
           {
-            "description": "A list of references to be batched. The ideal size depends on the used database connector. Please see the documentation of the used connector for help",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/BatchReference"
-              }
+              "$ref": "#/definitions/Object"
             }
+          },
+          {
+            "enum": [
+              "ONE",
+              "QUORUM",
+              "ALL"
+            ],
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Request Successful. Warning: A successful request does not guarantee that every batched reference was successfully created. Inspect the response body to see which references succeeded and which failed.",
+            "description": "Object created.",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/BatchReferenceResponse"
-              }
+              "$ref": "#/definitions/Object"
             }
           },
           "401": {
@@ -4737,37 +6344,27 @@ func init() {
         ]
       }
     },
-    "/classifications/": {
+    "/objects/validate": {
       "post": {
-        "description": "Trigger a classification based on the specified params. Classifications will run in the background, use GET /classifications/\u003cid\u003e to retrieve the status of your classification.",
+        "description": "Validate an Object's schema and meta-data. It has to be based on a schema, which is related to the given Object to be accepted by this validation.",
         "tags": [
-          "classifications"
+          "objects"
         ],
-        "summary": "Starts a classification.",
-        "operationId": "classifications.post",
+        "summary": "Validate an Object based on a schema.",
+        "operationId": "objects.validate",
         "parameters": [
           {
-            "description": "parameters to start a classification",
-            "name": "params",
+            "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Classification"
+              "$ref": "#/definitions/Object"
             }
           }
         ],
         "responses": {
-          "201": {
-            "description": "Successfully started classification.",
-            "schema": {
-              "$ref": "#/definitions/Classification"
-            }
-          },
-          "400": {
-            "description": "Incorrect request",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "200": {
+            "description": "Successfully validated."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -4778,6 +6375,12 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -4785,33 +6388,71 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.classifications.post"
+          "weaviate.local.query.meta"
         ]
       }
     },
-    "/classifications/{id}": {
+    "/objects/{className}/{id}": {
       "get": {
-        "description": "Get status, results and metadata of a previously created classification",
+        "description": "Get a single data object",
         "tags": [
-          "classifications"
+          "objects"
         ],
-        "summary": "View previously created classification",
-        "operationId": "classifications.get",
+        "summary": "Get a specific Object based on its class and UUID. Also available as Websocket bus.",
+        "operationId": "objects.class.get",
         "parameters": [
           {
             "type": "string",
-            "description": "classification id",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
             "name": "id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
+            "name": "include",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "ONE",
+              "QUORUM",
+              "ALL"
+            ],
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Found the classification, returned as body",
+            "description": "Successful response.",
             "schema": {
-              "$ref": "#/definitions/Classification"
+              "$ref": "#/definitions/Object"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -4824,7 +6465,13 @@ func init() {
             }
           },
           "404": {
-            "description": "Not Found - Classification does not exist"
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "Request is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the tenant exists and the class has multi-tenancy enabled?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -4833,35 +6480,59 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.classifications.get"
+          "weaviate.local.query"
         ]
-      }
-    },
-    "/graphql": {
-      "post": {
-        "description": "Get an object based on GraphQL",
+      },
+      "put": {
+        "description": "Update an individual data object based on its class and uuid.",
         "tags": [
-          "graphql"
+          "objects"
         ],
-        "summary": "Get a response based on GraphQL",
-        "operationId": "graphql.post",
+        "summary": "Update a class object based on its uuid",
+        "operationId": "objects.class.put",
         "parameters": [
           {
-            "description": "The GraphQL query request parameters.",
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The uuid of the data object to update.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/GraphQLQuery"
+              "$ref": "#/definitions/Object"
             }
+          },
+          {
+            "enum": [
+              "ONE",
+              "QUORUM",
+              "ALL"
+            ],
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful query (with select).",
+            "description": "Successfully received.",
             "schema": {
-              "$ref": "#/definitions/GraphQLResponse"
+              "$ref": "#/definitions/Object"
             }
           },
           "401": {
@@ -4873,6 +6544,9 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
           "422": {
             "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
@@ -4889,38 +6563,52 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query",
-          "weaviate.local.query.meta",
-          "weaviate.network.query",
-          "weaviate.network.query.meta"
+          "weaviate.local.manipulate"
         ]
-      }
-    },
-    "/graphql/batch": {
-      "post": {
-        "description": "Perform a batched GraphQL query",
+      },
+      "delete": {
+        "description": "Delete a single data object.",
         "tags": [
-          "graphql"
+          "objects"
         ],
-        "summary": "Get a response based on GraphQL.",
-        "operationId": "graphql.batch",
+        "summary": "Delete object based on its class and UUID.",
+        "operationId": "objects.class.delete",
         "parameters": [
           {
-            "description": "The GraphQL queries.",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/GraphQLQueries"
-            }
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "ONE",
+              "QUORUM",
+              "ALL"
+            ],
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successful query (with select).",
-            "schema": {
-              "$ref": "#/definitions/GraphQLResponses"
-            }
+          "204": {
+            "description": "Successfully deleted."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -4931,8 +6619,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Request is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the tenant exists and the class has multi-tenancy enabled?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -4944,30 +6635,56 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true,
         "x-serviceIds": [
-          "weaviate.local.query",
-          "weaviate.local.query.meta",
-          "weaviate.network.query",
-          "weaviate.network.query.meta"
+          "weaviate.local.manipulate"
         ]
-      }
-    },
-    "/meta": {
-      "get": {
-        "description": "Gives meta information about the server and can be used to provide information to another Weaviate instance that wants to interact with the current instance.",
+      },
+      "head": {
+        "description": "Checks if a data object exists without retrieving it.",
         "tags": [
-          "meta"
+          "objects"
+        ],
+        "summary": "Checks object's existence based on its class and uuid.",
+        "operationId": "objects.class.head",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The uuid of the data object",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "ONE",
+              "QUORUM",
+              "ALL"
+            ],
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
+          }
         ],
-        "summary": "Returns meta information of the current Weaviate instance.",
-        "operationId": "meta.get",
         "responses": {
-          "200": {
-            "description": "Successful response.",
-            "schema": {
-              "$ref": "#/definitions/Meta"
-            }
+          "204": {
+            "description": "Object exists."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -4978,6 +6695,15 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Object doesn't exist."
+          },
+          "422": {
+            "description": "Request is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the tenant exists and the class has multi-tenancy enabled?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -4985,27 +6711,62 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true,
         "x-serviceIds": [
-          "weaviate.local.query.meta"
+          "weaviate.local.manipulate"
         ]
-      }
-    },
-    "/nodes": {
-      "get": {
-        "description": "Returns status of Weaviate DB.",
+      },
+      "patch": {
+        "description": "Update an individual data object based on its class and uuid. This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
         "tags": [
-          "nodes"
+          "objects"
         ],
-        "operationId": "nodes.get",
-        "responses": {
-          "200": {
-            "description": "Nodes status successfully returned",
+        "summary": "Update an Object based on its UUID (using patch semantics).",
+        "operationId": "objects.class.patch",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The uuid of the data object to update.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object.",
+            "name": "body",
+            "in": "body",
             "schema": {
-              "$ref": "#/definitions/NodesStatusResponse"
+              "$ref": "#/definitions/Object"
             }
           },
+          {
+            "enum": [
+              "ONE",
+              "QUORUM",
+              "ALL"
+            ],
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully applied. No content provided."
+          },
+          "400": {
+            "description": "The patch-JSON is malformed."
+          },
           "401": {
             "description": "Unauthorized or invalid credentials."
           },
@@ -5016,13 +6777,10 @@ func init() {
             }
           },
           "404": {
-            "description": "Not Found - Backup does not exist",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "Successful query result but no resource was found."
           },
           "422": {
-            "description": "Invalid backup restoration status attempt.",
+            "description": "The patch-JSON is valid but unprocessable.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -5034,72 +6792,73 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.nodes.status.get"
+          "weaviate.local.manipulate"
         ]
       }
     },
-    "/objects": {
-      "get": {
-        "description": "Lists all Objects in reverse order of creation, owned by the user that belongs to the used token.",
+    "/objects/{className}/{id}/references/{propertyName}": {
+      "put": {
+        "description": "Update all references of a property of a data object.",
         "tags": [
           "objects"
         ],
-        "summary": "Get a list of Objects.",
-        "operationId": "objects.list",
+        "summary": "Replace all references to a class-property.",
+        "operationId": "objects.class.references.put",
         "parameters": [
           {
-            "type": "integer",
-            "format": "int64",
-            "default": 0,
-            "description": "The starting index of the result window. Default value is 0.",
-            "name": "offset",
-            "in": "query"
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
           },
           {
-            "type": "integer",
-            "format": "int64",
-            "description": "The maximum number of items to be returned per page. Default value is set in Weaviate config.",
-            "name": "limit",
-            "in": "query"
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
           },
           {
             "type": "string",
-            "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
-            "name": "include",
-            "in": "query"
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
           },
           {
-            "type": "string",
-            "description": "Sort parameter to pass an information about the names of the sort fields",
-            "name": "sort",
-            "in": "query"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/MultipleRef"
+            }
           },
           {
+            "enum": [
+              "ONE",
+              "QUORUM",
+              "ALL"
+            ],
             "type": "string",
-            "description": "Order parameter to tell how to order (asc or desc) data within given field",
-            "name": "order",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
             "in": "query"
           },
           {
             "type": "string",
-            "description": "Class parameter specifies the class from which to query objects",
-            "name": "class",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
             "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful response.",
-            "schema": {
-              "$ref": "#/definitions/ObjectsListResponse"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "Successfully replaced all the references."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -5111,10 +6870,10 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "Source object doesn't exist."
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -5129,32 +6888,68 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query"
+          "weaviate.local.manipulate"
         ]
       },
       "post": {
-        "description": "Registers a new Object. Provided meta-data and schema values are validated.",
+        "description": "Add a single reference to a class-property.",
         "tags": [
           "objects"
         ],
-        "summary": "Create Objects between two Objects (object and subject).",
-        "operationId": "objects.create",
+        "summary": "Add a single reference to a class-property.",
+        "operationId": "objects.class.references.create",
         "parameters": [
+          {
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/SingleRef"
             }
+          },
+          {
+            "enum": [
+              "ONE",
+              "QUORUM",
+              "ALL"
+            ],
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Object created.",
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
+            "description": "Successfully added the reference."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -5165,8 +6960,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Source object doesn't exist."
+          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -5181,31 +6979,68 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add"
+          "weaviate.local.manipulate"
         ]
-      }
-    },
-    "/objects/validate": {
-      "post": {
-        "description": "Validate an Object's schema and meta-data. It has to be based on a schema, which is related to the given Object to be accepted by this validation.",
+      },
+      "delete": {
+        "description": "Delete the single reference that is given in the body from the list of references that this property of a data object has",
         "tags": [
           "objects"
         ],
-        "summary": "Validate an Object based on a schema.",
-        "operationId": "objects.validate",
+        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
+        "operationId": "objects.class.references.delete",
         "parameters": [
+          {
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/SingleRef"
             }
+          },
+          {
+            "enum": [
+              "ONE",
+              "QUORUM",
+              "ALL"
+            ],
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successfully validated."
+          "204": {
+            "description": "Successfully deleted."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -5216,8 +7051,14 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Successful query result but no resource was found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -5232,25 +7073,20 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query.meta"
+          "weaviate.local.manipulate"
         ]
       }
     },
-    "/objects/{className}/{id}": {
+    "/objects/{id}": {
       "get": {
-        "description": "Get a single data object",
+        "description": "Lists Objects.",
         "tags": [
           "objects"
         ],
-        "summary": "Get a specific Object based on its class and UUID. Also available as Websocket bus.",
-        "operationId": "objects.class.get",
+        "summary": "Get a specific Object based on its UUID and a Object UUID. Also available as Websocket bus.",
+        "operationId": "objects.get",
+        "deprecated": true,
         "parameters": [
-          {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
           {
             "type": "string",
             "format": "uuid",
@@ -5305,23 +7141,18 @@ func init() {
         ]
       },
       "put": {
-        "description": "Update an individual data object based on its class and uuid.",
+        "description": "Updates an Object's data. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
         "tags": [
           "objects"
         ],
-        "summary": "Update a class object based on its uuid",
-        "operationId": "objects.class.put",
+        "summary": "Update an Object based on its UUID.",
+        "operationId": "objects.update",
+        "deprecated": true,
         "parameters": [
-          {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The uuid of the data object to update.",
+            "description": "Unique ID of the Object.",
             "name": "id",
             "in": "path",
             "required": true
@@ -5374,19 +7205,14 @@ func init() {
         ]
       },
       "delete": {
-        "description": "Delete a single data object.",
+        "description": "Deletes an Object from the system.",
         "tags": [
           "objects"
         ],
-        "summary": "Delete object based on its class and UUID.",
-        "operationId": "objects.class.delete",
+        "summary": "Delete an Object based on its UUID.",
+        "operationId": "objects.delete",
+        "deprecated": true,
         "parameters": [
-          {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
           {
             "type": "string",
             "format": "uuid",
@@ -5426,24 +7252,18 @@ func init() {
         ]
       },
       "head": {
-        "description": "Checks if a data object exists without retrieving it.",
+        "description": "Checks if an Object exists in the system.",
         "tags": [
           "objects"
         ],
-        "summary": "Checks object's existence based on its class and uuid.",
-        "operationId": "objects.class.head",
+        "summary": "Checks Object's existence based on its UUID.",
+        "operationId": "objects.head",
+        "deprecated": true,
         "parameters": [
-          {
-            "type": "string",
-            "description": "The class name as defined in the schema",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The uuid of the data object",
+            "description": "Unique ID of the Object.",
             "name": "id",
             "in": "path",
             "required": true
@@ -5479,24 +7299,18 @@ func init() {
         ]
       },
       "patch": {
-        "description": "Update an individual data object based on its class and uuid. This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+        "description": "Updates an Object. This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
         "tags": [
           "objects"
         ],
         "summary": "Update an Object based on its UUID (using patch semantics).",
-        "operationId": "objects.class.patch",
+        "operationId": "objects.patch",
+        "deprecated": true,
         "parameters": [
-          {
-            "type": "string",
-            "description": "The class name as defined in the schema",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The uuid of the data object to update.",
+            "description": "Unique ID of the Object.",
             "name": "id",
             "in": "path",
             "required": true
@@ -5549,22 +7363,16 @@ func init() {
         ]
       }
     },
-    "/objects/{className}/{id}/references/{propertyName}": {
+    "/objects/{id}/references/{propertyName}": {
       "put": {
-        "description": "Update all references of a property of a data object.",
+        "description": "Replace all references to a class-property.",
         "tags": [
           "objects"
         ],
         "summary": "Replace all references to a class-property.",
-        "operationId": "objects.class.references.put",
+        "operationId": "objects.references.update",
+        "deprecated": true,
         "parameters": [
-          {
-            "type": "string",
-            "description": "The class name as defined in the schema",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
           {
             "type": "string",
             "format": "uuid",
@@ -5602,9 +7410,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Source object doesn't exist."
-          },
           "422": {
             "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
             "schema": {
@@ -5630,15 +7435,9 @@ func init() {
           "objects"
         ],
         "summary": "Add a single reference to a class-property.",
-        "operationId": "objects.class.references.create",
+        "operationId": "objects.references.create",
+        "deprecated": true,
         "parameters": [
-          {
-            "type": "string",
-            "description": "The class name as defined in the schema",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
           {
             "type": "string",
             "format": "uuid",
@@ -5676,86 +7475,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Source object doesn't exist."
-          },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          }
-        },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
-        "x-serviceIds": [
-          "weaviate.local.manipulate"
-        ]
-      },
-      "delete": {
-        "description": "Delete the single reference that is given in the body from the list of references that this property of a data object has",
-        "tags": [
-          "objects"
-        ],
-        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
-        "operationId": "objects.class.references.delete",
-        "parameters": [
-          {
-            "type": "string",
-            "description": "The class name as defined in the schema",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/SingleRef"
-            }
-          }
-        ],
-        "responses": {
-          "204": {
-            "description": "Successfully deleted."
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "404": {
-            "description": "Successful query result but no resource was found.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "422": {
             "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
             "schema": {
@@ -5772,80 +7491,16 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
-        ]
-      }
-    },
-    "/objects/{id}": {
-      "get": {
-        "description": "Lists Objects.",
-        "tags": [
-          "objects"
-        ],
-        "summary": "Get a specific Object based on its UUID and a Object UUID. Also available as Websocket bus.",
-        "operationId": "objects.get",
-        "deprecated": true,
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
-            "name": "include",
-            "in": "query"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Successful response.",
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "404": {
-            "description": "Successful query result but no resource was found."
-          },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          }
-        },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
-        "x-serviceIds": [
-          "weaviate.local.query"
+          "weaviate.local.manipulate"
         ]
       },
-      "put": {
-        "description": "Updates an Object's data. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+      "delete": {
+        "description": "Delete the single reference that is given in the body from the list of references that this property has.",
         "tags": [
           "objects"
         ],
-        "summary": "Update an Object based on its UUID.",
-        "operationId": "objects.update",
+        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
+        "operationId": "objects.references.delete",
         "deprecated": true,
         "parameters": [
           {
@@ -5856,21 +7511,25 @@ func init() {
             "in": "path",
             "required": true
           },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/SingleRef"
             }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successfully received.",
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
+          "204": {
+            "description": "Successfully deleted."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -5882,10 +7541,7 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found."
-          },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Successful query result but no resource was found.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -5902,28 +7558,21 @@ func init() {
         "x-serviceIds": [
           "weaviate.local.manipulate"
         ]
-      },
-      "delete": {
-        "description": "Deletes an Object from the system.",
+      }
+    },
+    "/schema": {
+      "get": {
         "tags": [
-          "objects"
-        ],
-        "summary": "Delete an Object based on its UUID.",
-        "operationId": "objects.delete",
-        "deprecated": true,
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          }
+          "schema"
         ],
+        "summary": "Dump the current the database schema.",
+        "operationId": "schema.dump",
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
+          "200": {
+            "description": "Successfully dumped the database schema.",
+            "schema": {
+              "$ref": "#/definitions/Schema"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -5934,9 +7583,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -5944,33 +7590,32 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.query.meta"
         ]
       },
-      "head": {
-        "description": "Checks if an Object exists in the system.",
+      "post": {
         "tags": [
-          "objects"
+          "schema"
         ],
-        "summary": "Checks Object's existence based on its UUID.",
-        "operationId": "objects.head",
-        "deprecated": true,
+        "summary": "Create a new Object class in the schema.",
+        "operationId": "schema.objects.create",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
+            "name": "objectClass",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Class"
+            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Object exists."
+          "200": {
+            "description": "Added the new Object class to the schema.",
+            "schema": {
+              "$ref": "#/definitions/Class"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -5981,8 +7626,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Object doesn't exist."
+          "422": {
+            "description": "Invalid Object class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -5991,44 +7639,32 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.add.meta"
         ]
-      },
-      "patch": {
-        "description": "Updates an Object. This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+      }
+    },
+    "/schema/{className}": {
+      "get": {
         "tags": [
-          "objects"
+          "schema"
         ],
-        "summary": "Update an Object based on its UUID (using patch semantics).",
-        "operationId": "objects.patch",
-        "deprecated": true,
+        "summary": "Get a single class from the schema",
+        "operationId": "schema.objects.get",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
+            "name": "className",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object.",
-            "name": "body",
-            "in": "body",
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully applied. No content provided."
-          },
-          "400": {
-            "description": "The patch-JSON is malformed."
+          "200": {
+            "description": "Found the Class, returned as body",
+            "schema": {
+              "$ref": "#/definitions/Class"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -6040,13 +7676,7 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found."
-          },
-          "422": {
-            "description": "The patch-JSON is valid but unprocessable.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "This class does not exist"
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -6055,50 +7685,39 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.get.meta"
         ]
-      }
-    },
-    "/objects/{id}/references/{propertyName}": {
+      },
       "put": {
-        "description": "Replace all references to a class-property.",
+        "description": "Use this endpoint to alter an existing class in the schema. Note that not all settings are mutable. If an error about immutable fields is returned and you still need to update this particular setting, you will have to delete the class (and the underlying data) and recreate. This endpoint cannot be used to modify properties. Instead use POST /v1/schema/{className}/properties. A typical use case for this endpoint is to update configuration, such as the vectorIndexConfig. Note that even in mutable sections, such as vectorIndexConfig, some fields may be immutable.",
         "tags": [
-          "objects"
+          "schema"
         ],
-        "summary": "Replace all references to a class-property.",
-        "operationId": "objects.references.update",
-        "deprecated": true,
+        "summary": "Update settings of an existing schema class",
+        "operationId": "schema.objects.update",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
+            "name": "className",
             "in": "path",
             "required": true
           },
           {
-            "name": "body",
+            "name": "objectClass",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/MultipleRef"
+              "$ref": "#/definitions/Class"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully replaced all the references."
+            "description": "Class was updated successfully",
+            "schema": {
+              "$ref": "#/definitions/Class"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -6109,8 +7728,14 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Class to be updated does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "description": "Invalid update attempt",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -6122,48 +7747,33 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.manipulate.meta"
         ]
       },
-      "post": {
-        "description": "Add a single reference to a class-property.",
+      "delete": {
         "tags": [
-          "objects"
+          "schema"
         ],
-        "summary": "Add a single reference to a class-property.",
-        "operationId": "objects.references.create",
-        "deprecated": true,
+        "summary": "Remove an Object class (and all data in the instances) from the schema.",
+        "operationId": "schema.objects.delete",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
+            "name": "className",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/SingleRef"
-            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully added the reference."
+            "description": "Removed the Object class from the schema."
+          },
+          "400": {
+            "description": "Could not delete the Object class.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -6174,12 +7784,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -6187,33 +7791,22 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.manipulate.meta"
         ]
-      },
-      "delete": {
-        "description": "Delete the single reference that is given in the body from the list of references that this property has.",
+      }
+    },
+    "/schema/{className}/properties": {
+      "post": {
         "tags": [
-          "objects"
+          "schema"
         ],
-        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
-        "operationId": "objects.references.delete",
-        "deprecated": true,
+        "summary": "Add a property to an Object class.",
+        "operationId": "schema.objects.properties.add",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
+            "name": "className",
             "in": "path",
             "required": true
           },
@@ -6222,13 +7815,16 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/SingleRef"
+              "$ref": "#/definitions/Property"
             }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
+          "200": {
+            "description": "Added the property.",
+            "schema": {
+              "$ref": "#/definitions/Property"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -6239,8 +7835,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found.",
+          "422": {
+            "description": "Invalid property.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -6252,25 +7848,41 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.manipulate.meta"
         ]
       }
     },
-    "/schema": {
-      "get": {
+    "/schema/{className}/properties/{propertyName}": {
+      "delete": {
+        "description": "The property is removed from the schema and its indexes are dropped right away. Its values are removed from the stored objects in the background, the progress is part of the nodes status.",
         "tags": [
           "schema"
         ],
-        "summary": "Dump the current the database schema.",
-        "operationId": "schema.dump",
+        "summary": "Remove a property from an Object class.",
+        "operationId": "schema.objects.properties.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          }
+        ],
         "responses": {
           "200": {
-            "description": "Successfully dumped the database schema.",
+            "description": "Removed the property from the Object class. Its values are removed from the stored objects in the background."
+          },
+          "400": {
+            "description": "Could not delete the property.",
             "schema": {
-              "$ref": "#/definitions/Schema"
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -6290,30 +7902,39 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.query.meta"
+          "weaviate.local.manipulate.meta"
         ]
-      },
+      }
+    },
+    "/schema/{className}/reindex": {
       "post": {
+        "description": "Starts a job which rebuilds the inverted index of the given properties and/or the vector index of the class from the stored objects with the new settings. The new indexes are built next to the existing ones, which keep serving queries and writes, and are swapped in once they are complete in every shard.",
         "tags": [
           "schema"
         ],
-        "summary": "Create a new Object class in the schema.",
-        "operationId": "schema.objects.create",
+        "summary": "Rebuild indexes of an Object class with new settings.",
+        "operationId": "schema.objects.reindex.create",
         "parameters": [
           {
-            "name": "objectClass",
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Class"
+              "$ref": "#/definitions/ReindexRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Added the new Object class to the schema.",
+            "description": "Started the reindex job.",
             "schema": {
-              "$ref": "#/definitions/Class"
+              "$ref": "#/definitions/ReindexJob"
             }
           },
           "401": {
@@ -6325,8 +7946,14 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "This class does not exist.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Invalid Object class",
+            "description": "Invalid reindex request.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -6339,30 +7966,36 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.add.meta"
+          "weaviate.local.manipulate.meta"
         ]
       }
     },
-    "/schema/{className}": {
+    "/schema/{className}/reindex/{id}": {
       "get": {
         "tags": [
           "schema"
         ],
-        "summary": "Get a single class from the schema",
-        "operationId": "schema.objects.get",
+        "summary": "Get the status of a reindex job.",
+        "operationId": "schema.objects.reindex.get",
         "parameters": [
           {
             "type": "string",
             "name": "className",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Found the Class, returned as body",
+            "description": "The status of the reindex job.",
             "schema": {
-              "$ref": "#/definitions/Class"
+              "$ref": "#/definitions/ReindexJob"
             }
           },
           "401": {
@@ -6375,7 +8008,10 @@ func init() {
             }
           },
           "404": {
-            "description": "This class does not exist"
+            "description": "This reindex job does not exist.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -6385,16 +8021,16 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.get.meta"
+          "weaviate.local.query.meta"
         ]
       },
-      "put": {
-        "description": "Use this endpoint to alter an existing class in the schema. Note that not all settings are mutable. If an error about immutable fields is returned and you still need to update this particular setting, you will have to delete the class (and the underlying data) and recreate. This endpoint cannot be used to modify properties. Instead use POST /v1/schema/{className}/properties. A typical use case for this endpoint is to update configuration, such as the vectorIndexConfig. Note that even in mutable sections, such as vectorIndexConfig, some fields may be immutable.",
+      "delete": {
+        "description": "Stops the job in every shard and removes the partially built indexes. The existing indexes and settings of the class remain unchanged.",
         "tags": [
           "schema"
         ],
-        "summary": "Update settings of an existing schema class",
-        "operationId": "schema.objects.update",
+        "summary": "Cancel a reindex job.",
+        "operationId": "schema.objects.reindex.cancel",
         "parameters": [
           {
             "type": "string",
@@ -6403,19 +8039,17 @@ func init() {
             "required": true
           },
           {
-            "name": "objectClass",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Class"
-            }
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Class was updated successfully",
+            "description": "Cancelled the reindex job.",
             "schema": {
-              "$ref": "#/definitions/Class"
+              "$ref": "#/definitions/ReindexJob"
             }
           },
           "401": {
@@ -6428,13 +8062,13 @@ func init() {
             }
           },
           "404": {
-            "description": "Class to be updated does not exist",
+            "description": "This reindex job does not exist.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "422": {
-            "description": "Invalid update attempt",
+            "description": "The reindex job is already finished.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -6449,13 +8083,15 @@ func init() {
         "x-serviceIds": [
           "weaviate.local.manipulate.meta"
         ]
-      },
-      "delete": {
+      }
+    },
+    "/schema/{className}/shards": {
+      "get": {
         "tags": [
           "schema"
         ],
-        "summary": "Remove an Object class (and all data in the instances) from the schema.",
-        "operationId": "schema.objects.delete",
+        "summary": "Get the shards status of an Object class",
+        "operationId": "schema.objects.shards.get",
         "parameters": [
           {
             "type": "string",
@@ -6466,12 +8102,9 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "Removed the Object class from the schema."
-          },
-          "400": {
-            "description": "Could not delete the Object class.",
+            "description": "Found the status of the shards, returned as body",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/ShardStatusList"
             }
           },
           "401": {
@@ -6483,6 +8116,12 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "This class does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -6491,17 +8130,17 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
+          "weaviate.local.get.meta"
         ]
       }
     },
-    "/schema/{className}/properties": {
-      "post": {
+    "/schema/{className}/shards/{shardName}": {
+      "put": {
+        "description": "Update shard status of an Object Class",
         "tags": [
           "schema"
         ],
-        "summary": "Add a property to an Object class.",
-        "operationId": "schema.objects.properties.add",
+        "operationId": "schema.objects.shards.update",
         "parameters": [
           {
             "type": "string",
@@ -6509,20 +8148,26 @@ func init() {
             "in": "path",
             "required": true
           },
+          {
+            "type": "string",
+            "name": "shardName",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Property"
+              "$ref": "#/definitions/ShardStatus"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Added the property.",
+            "description": "Shard status was updated successfully",
             "schema": {
-              "$ref": "#/definitions/Property"
+              "$ref": "#/definitions/ShardStatus"
             }
           },
           "401": {
@@ -6534,8 +8179,14 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Shard to be updated does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Invalid property.",
+            "description": "Invalid update attempt",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -6552,13 +8203,13 @@ func init() {
         ]
       }
     },
-    "/schema/{className}/shards": {
+    "/schema/{className}/tenants": {
       "get": {
+        "description": "List the tenants of a class with multi-tenancy enabled",
         "tags": [
           "schema"
         ],
-        "summary": "Get the shards status of an Object class",
-        "operationId": "schema.objects.shards.get",
+        "operationId": "schema.tenants.get",
         "parameters": [
           {
             "type": "string",
@@ -6569,9 +8220,12 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "Found the status of the shards, returned as body",
+            "description": "The tenants of the specified class",
             "schema": {
-              "$ref": "#/definitions/ShardStatusList"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
             }
           },
           "401": {
@@ -6583,8 +8237,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "This class does not exist",
+          "422": {
+            "description": "Invalid tenants or class, see the ErrorResponse for details.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -6599,15 +8253,13 @@ func init() {
         "x-serviceIds": [
           "weaviate.local.get.meta"
         ]
-      }
-    },
-    "/schema/{className}/shards/{shardName}": {
-      "put": {
-        "description": "Update shard status of an Object Class",
+      },
+      "post": {
+        "description": "Create new tenants in a class with multi-tenancy enabled. Each tenant gets its own shard.",
         "tags": [
           "schema"
         ],
-        "operationId": "schema.objects.shards.update",
+        "operationId": "schema.tenants.create",
         "parameters": [
           {
             "type": "string",
@@ -6615,26 +8267,26 @@ func init() {
             "in": "path",
             "required": true
           },
-          {
-            "type": "string",
-            "name": "shardName",
-            "in": "path",
-            "required": true
-          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/ShardStatus"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Shard status was updated successfully",
+            "description": "Added new tenants to the specified class",
             "schema": {
-              "$ref": "#/definitions/ShardStatus"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
             }
           },
           "401": {
@@ -6646,14 +8298,63 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Shard to be updated does not exist",
+          "422": {
+            "description": "Invalid tenants or class, see the ErrorResponse for details.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      },
+      "delete": {
+        "description": "Delete tenants from a class with multi-tenancy enabled. All data of the deleted tenants is removed.",
+        "tags": [
+          "schema"
+        ],
+        "operationId": "schema.tenants.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "tenants",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Deleted tenants from the specified class"
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "422": {
-            "description": "Invalid update attempt",
+            "description": "Invalid tenants or class, see the ErrorResponse for details.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -6698,8 +8399,12 @@ func init() {
     "BackupCreateRequest": {
       "description": "Request body for creating a backup of a set of classes",
       "properties": {
+        "baseBackupId": {
+          "description": "The ID of an earlier successful backup on the same backend. Files which are unchanged since then are referenced instead of being copied again.",
+          "type": "string"
+        },
         "config": {
-          "description": "Custom configuration for the backup creation process",
+          "description": "Custom configuration for the backup creation process. Set 'archive' to true to store the shard files in compressed, checksummed chunks of at most 'chunkSize' MB (default 128) using the 'compressionLevel' DefaultCompression, BestSpeed or BestCompression.",
           "type": "object"
         },
         "exclude": {
@@ -6795,6 +8500,53 @@ func init() {
         }
       }
     },
+    "BackupListItem": {
+      "description": "A backup stored by a backup backend",
+      "properties": {
+        "baseBackupId": {
+          "description": "The ID of the backup this one is incremental to, if any.",
+          "type": "string"
+        },
+        "classes": {
+          "description": "The list of classes included in the backup.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "error": {
+          "description": "error message if creation failed",
+          "type": "string"
+        },
+        "id": {
+          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
+        },
+        "status": {
+          "description": "phase of backup creation process",
+          "type": "string",
+          "enum": [
+            "STARTED",
+            "TRANSFERRING",
+            "TRANSFERRED",
+            "SUCCESS",
+            "FAILED"
+          ]
+        }
+      }
+    },
+    "BackupListResponse": {
+      "description": "The definition of a backup list response body",
+      "properties": {
+        "backups": {
+          "description": "The backups stored by the backup backend.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/BackupListItem"
+          }
+        }
+      }
+    },
     "BackupRestoreRequest": {
       "description": "Request body for restoring a backup for a set of classes",
       "properties": {
@@ -7089,6 +8841,10 @@ func init() {
           "format": "uri",
           "example": "weaviate://localhost/Zoo/a5d09582-4239-4702-81c9-92a6e0122bb4/hasAnimals"
         },
+        "tenant": {
+          "description": "Name of the tenant the source object belongs to. Required if the source class has multi-tenancy enabled.",
+          "type": "string"
+        },
         "to": {
           "description": "Short-form URI to point to the cross-ref. Should be in the form of weaviate://localhost/\u003cuuid\u003e for the example of a local cross-ref to an object",
           "type": "string",
@@ -7343,6 +9099,9 @@ func init() {
           "description": "Configuration specific to modules this Weaviate instance has installed",
           "type": "object"
         },
+        "multiTenancyConfig": {
+          "$ref": "#/definitions/MultiTenancyConfig"
+        },
         "properties": {
           "description": "The properties of the class.",
           "type": "array",
@@ -7350,16 +9109,26 @@ func init() {
             "$ref": "#/definitions/Property"
           }
         },
+        "replicationConfig": {
+          "$ref": "#/definitions/ReplicationConfig"
+        },
         "shardingConfig": {
           "description": "Manage how the index should be sharded and distributed in the cluster",
           "type": "object"
         },
+        "vectorConfig": {
+          "description": "Configure named vector spaces in addition to the default vector of the class. Each named vector has its own vectorizer and vector index. The name can be used to select the target vector in vector searches.",
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/VectorConfig"
+          }
+        },
         "vectorIndexConfig": {
           "description": "Vector-index config, that is specific to the type of index selected in vectorIndexType",
           "type": "object"
         },
         "vectorIndexType": {
-          "description": "Name of the vector index to use, eg. (HNSW)",
+          "description": "Name of the vector index to use, either \"hnsw\" (default) or \"flat\"",
           "type": "string"
         },
         "vectorizer": {
@@ -7757,6 +9526,17 @@ func init() {
         }
       }
     },
+    "MultiTenancyConfig": {
+      "description": "Configuration related to multi-tenancy within a class",
+      "type": "object",
+      "properties": {
+        "enabled": {
+          "description": "Whether or not multi-tenancy is enabled for this class. Each tenant of a multi-tenant class is stored in its own shard.",
+          "type": "boolean",
+          "x-omitempty": false
+        }
+      }
+    },
     "MultipleRef": {
       "description": "Multiple instances of references to other objects.",
       "type": "array",
@@ -7782,6 +9562,18 @@ func init() {
           "type": "number",
           "format": "int64",
           "x-omitempty": false
+        },
+        "propertyDeletions": {
+          "description": "The progress of removing the values of deleted properties from the objects in shard.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/PropertyDeletionStatus"
+          }
+        },
+        "reindex": {
+          "description": "The progress of the latest reindex job of the shard.",
+          "type": "object",
+          "$ref": "#/definitions/ReindexShardStatus"
         }
       }
     },
@@ -7881,12 +9673,19 @@ func init() {
         "properties": {
           "$ref": "#/definitions/PropertySchema"
         },
+        "tenant": {
+          "description": "Name of the tenant the object belongs to. Required for classes with multi-tenancy enabled, must be empty otherwise.",
+          "type": "string"
+        },
         "vector": {
           "description": "This object's position in the Contextionary vector space. Read-only if using a vectorizer other than 'none'. Writable and required if using 'none' as vectorizer.",
           "$ref": "#/definitions/C11yVector"
         },
         "vectorWeights": {
           "$ref": "#/definitions/VectorWeights"
+        },
+        "vectors": {
+          "$ref": "#/definitions/Vectors"
         }
       }
     },
@@ -8150,12 +9949,52 @@ func init() {
           "description": "Name of the property as URI relative to the schema URL.",
           "type": "string"
         },
+        "stemmer": {
+          "description": "Reduces the words of the property to their stem in the inverted index, so that filters and keyword search (bm25) for ` + "`" + `running` + "`" + ` also match ` + "`" + `run` + "`" + `. Optional. Applies to text and text[] with ` + "`" + `word` + "`" + ` or ` + "`" + `lowercase` + "`" + ` tokenization and to string and string[] with ` + "`" + `lowercase` + "`" + ` tokenization. Allowed values are ` + "`" + `none` + "`" + ` (default) and ` + "`" + `en` + "`" + `. Stemming is only available for English, the stopword presets of the other languages can be used without a stemmer",
+          "type": "string",
+          "enum": [
+            "none",
+            "en"
+          ]
+        },
         "tokenization": {
-          "description": "Determines tokenization of the property as separate words or whole field. Optional. Applies to string, string[], text and text[] data types. Allowed values are ` + "`" + `word` + "`" + ` (default) and ` + "`" + `field` + "`" + ` for string and string[], ` + "`" + `word` + "`" + ` (default) for text and text[]. Not supported for remaining data types",
+          "description": "Determines tokenization of the property as separate words or whole field. Optional. Applies to string, string[], text and text[] data types. Allowed values are ` + "`" + `word` + "`" + ` (default), ` + "`" + `field` + "`" + `, ` + "`" + `whitespace` + "`" + `, ` + "`" + `lowercase` + "`" + `, ` + "`" + `trigram` + "`" + ` and ` + "`" + `cjk` + "`" + ` for string and string[], ` + "`" + `word` + "`" + ` (default), ` + "`" + `whitespace` + "`" + `, ` + "`" + `lowercase` + "`" + `, ` + "`" + `trigram` + "`" + ` and ` + "`" + `cjk` + "`" + ` for text and text[]. Not supported for remaining data types",
           "type": "string",
           "enum": [
             "word",
-            "field"
+            "field",
+            "whitespace",
+            "lowercase",
+            "trigram",
+            "cjk"
+          ]
+        }
+      }
+    },
+    "PropertyDeletionStatus": {
+      "description": "The progress of removing the values of a deleted property from the objects of a shard",
+      "properties": {
+        "error": {
+          "description": "The error which stopped the removal, if it failed.",
+          "type": "string"
+        },
+        "objectsProcessed": {
+          "description": "The number of objects which have been checked for values of the property so far.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "property": {
+          "description": "The name of the deleted property.",
+          "type": "string"
+        },
+        "status": {
+          "description": "The status of the removal.",
+          "type": "string",
+          "enum": [
+            "IN_PROGRESS",
+            "DONE",
+            "FAILED"
           ]
         }
       }
@@ -8222,6 +10061,152 @@ func init() {
         }
       }
     },
+    "ReindexJob": {
+      "description": "The status of a reindex job across all shards of a class",
+      "properties": {
+        "className": {
+          "description": "The name of the class which is reindexed.",
+          "type": "string"
+        },
+        "error": {
+          "description": "The errors which stopped the job, if it failed.",
+          "type": "string"
+        },
+        "id": {
+          "description": "The id of the job.",
+          "type": "string"
+        },
+        "objectCount": {
+          "description": "The number of objects in all shards when the job was started.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "objectsProcessed": {
+          "description": "The number of objects which have been added to the new indexes so far.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "status": {
+          "description": "The status of the job.",
+          "type": "string",
+          "enum": [
+            "IN_PROGRESS",
+            "SUCCESS",
+            "FAILED",
+            "CANCELLED"
+          ]
+        }
+      }
+    },
+    "ReindexProperty": {
+      "description": "The new inverted index settings of a property",
+      "properties": {
+        "dataType": {
+          "description": "The data type to change the property to. Only types whose stored values stay valid can be converted: string and text into each other and int into number, as well as their array types. Optional, the current data type is kept if omitted.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "indexInverted": {
+          "description": "Whether the property is indexed in the inverted index. Optional, the current setting is kept if omitted.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "name": {
+          "description": "The name of the property.",
+          "type": "string"
+        },
+        "stemmer": {
+          "description": "The stemmer to reduce the words of the property with. Optional, the current setting is kept if omitted.",
+          "type": "string",
+          "enum": [
+            "none",
+            "en"
+          ]
+        },
+        "tokenization": {
+          "description": "The tokenization of the property. Optional, the current setting is kept if omitted.",
+          "type": "string",
+          "enum": [
+            "word",
+            "field",
+            "whitespace",
+            "lowercase",
+            "trigram",
+            "cjk"
+          ]
+        }
+      }
+    },
+    "ReindexRequest": {
+      "description": "The index settings of a class to change by rebuilding the indexes from the stored objects. The vectors are not recomputed, so the vectorizer of a class cannot be changed by a reindex job.",
+      "properties": {
+        "properties": {
+          "description": "The properties whose inverted index is rebuilt with new settings.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ReindexProperty"
+          }
+        },
+        "vectorIndexConfig": {
+          "description": "The config of the rebuilt vector index. Optional, the defaults of the vector index type are used if omitted.",
+          "type": "object"
+        },
+        "vectorIndexType": {
+          "description": "The type to rebuild the vector index as. Optional, the vector index is kept if omitted.",
+          "type": "string"
+        }
+      }
+    },
+    "ReindexShardStatus": {
+      "description": "The progress of a reindex job in a shard",
+      "properties": {
+        "error": {
+          "description": "The error which stopped the job, if it failed.",
+          "type": "string"
+        },
+        "jobId": {
+          "description": "The id of the job.",
+          "type": "string"
+        },
+        "objectCount": {
+          "description": "The number of objects in the shard when the job was started.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "objectsProcessed": {
+          "description": "The number of objects which have been added to the new indexes so far.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "status": {
+          "description": "The status of the job in the shard. The new indexes are swapped in once every shard is READY.",
+          "type": "string",
+          "enum": [
+            "IN_PROGRESS",
+            "READY",
+            "SUCCESS",
+            "FAILED",
+            "CANCELLED"
+          ]
+        }
+      }
+    },
+    "ReplicationConfig": {
+      "description": "Configure how replication is executed in a cluster",
+      "type": "object",
+      "properties": {
+        "factor": {
+          "description": "Number of times a class is replicated",
+          "type": "integer"
+        }
+      }
+    },
     "Schema": {
       "description": "Definitions of semantic schemas (also see: https://github.com/semi-technologies/weaviate-semantic-schemas).",
       "type": "object",
@@ -8317,7 +10302,7 @@ func init() {
           }
         },
         "preset": {
-          "description": "pre-existing list of common words by language",
+          "description": "pre-existing list of common words by language. Allowed values are ` + "`" + `en` + "`" + ` (default), ` + "`" + `de` + "`" + `, ` + "`" + `fr` + "`" + `, ` + "`" + `es` + "`" + `, ` + "`" + `it` + "`" + `, ` + "`" + `nl` + "`" + `, ` + "`" + `pt` + "`" + ` and ` + "`" + `none` + "`" + `",
           "type": "string"
         },
         "removals": {
@@ -8329,10 +10314,52 @@ func init() {
         }
       }
     },
+    "Tenant": {
+      "description": "attributes representing a single tenant within weaviate",
+      "type": "object",
+      "properties": {
+        "name": {
+          "description": "name of the tenant",
+          "type": "string"
+        }
+      }
+    },
+    "VectorConfig": {
+      "description": "Configuration of a named vector space of a class. Each named vector has its own vectorizer and vector index.",
+      "type": "object",
+      "properties": {
+        "sourceProperties": {
+          "description": "Properties used by the vectorizer to create this vector. If empty, the vectorizer decides based on the module config of the class, just like for the default vector.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "vectorIndexConfig": {
+          "description": "Vector-index config, that is specific to the type of index selected in vectorIndexType",
+          "type": "object"
+        },
+        "vectorIndexType": {
+          "description": "Name of the vector index to use, either \"hnsw\" (default) or \"flat\"",
+          "type": "string"
+        },
+        "vectorizer": {
+          "description": "Name of the module that creates this vector, or 'none' if the vector is provided at import time",
+          "type": "string"
+        }
+      }
+    },
     "VectorWeights": {
       "description": "Allow custom overrides of vector weights as math expressions. E.g. \"pancake\": \"7\" will set the weight for the word pancake to 7 in the vectorization, whereas \"w * 3\" would triple the originally calculated word. This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value (string/string) object.",
       "type": "object"
     },
+    "Vectors": {
+      "description": "A map of named vectors, the keys are the names of the vector spaces configured in the class' vectorConfig.",
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/C11yVector"
+      }
+    },
     "WhereFilter": {
       "description": "Filter search results using a where filter",
       "type": "object",
@@ -8359,7 +10386,10 @@ func init() {
             "LessThan",
             "LessThanEqual",
             "WithinGeoRange",
-            "IsNull"
+            "IsNull",
+            "ContainsAny",
+            "ContainsAll",
+            "In"
           ],
           "example": "GreaterThanEqual"
         },
@@ -8381,12 +10411,28 @@ func init() {
           "x-nullable": true,
           "example": false
         },
+        "valueBooleanArray": {
+          "description": "value as boolean array, requires 'ContainsAny', 'ContainsAll' or 'In' operator",
+          "type": "array",
+          "items": {
+            "type": "boolean"
+          },
+          "x-omitempty": true
+        },
         "valueDate": {
           "description": "value as date (as string)",
           "type": "string",
           "x-nullable": true,
           "example": "TODO"
         },
+        "valueDateArray": {
+          "description": "value as date array (as strings), requires 'ContainsAny', 'ContainsAll' or 'In' operator",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
         "valueGeoRange": {
           "description": "value as geo coordinates and distance",
           "type": "object",
@@ -8400,6 +10446,15 @@ func init() {
           "x-nullable": true,
           "example": 2000
         },
+        "valueIntArray": {
+          "description": "value as integer array, requires 'ContainsAny', 'ContainsAll' or 'In' operator",
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "int64"
+          },
+          "x-omitempty": true
+        },
         "valueNumber": {
           "description": "value as number/float",
           "type": "number",
@@ -8407,17 +10462,42 @@ func init() {
           "x-nullable": true,
           "example": 3.14
         },
+        "valueNumberArray": {
+          "description": "value as number/float array, requires 'ContainsAny', 'ContainsAll' or 'In' operator",
+          "type": "array",
+          "items": {
+            "type": "number",
+            "format": "float64"
+          },
+          "x-omitempty": true
+        },
         "valueString": {
           "description": "value as string",
           "type": "string",
           "x-nullable": true,
           "example": "my search term"
         },
+        "valueStringArray": {
+          "description": "value as string array, requires 'ContainsAny', 'ContainsAll' or 'In' operator",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
         "valueText": {
           "description": "value as text (on text props)",
           "type": "string",
           "x-nullable": true,
           "example": "my search term"
+        },
+        "valueTextArray": {
+          "description": "value as text array (on text props), requires 'ContainsAny', 'ContainsAll' or 'In' operator",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
         }
       }
     },
@@ -8451,12 +10531,30 @@ func init() {
     }
   },
   "parameters": {
+    "CommonAfterParameterQuery": {
+      "description": "The starting ID of the result window. Objects are returned in ID order, starting after the given ID. Requires the class parameter and can't be combined with offset, sort or order.",
+      "in": "query",
+      "name": "after",
+      "required": false,
+      "type": "string"
+    },
     "CommonClassParameterQuery": {
       "type": "string",
       "description": "Class parameter specifies the class from which to query objects",
       "name": "class",
       "in": "query"
     },
+    "CommonConsistencyLevelParameterQuery": {
+      "enum": [
+        "ONE",
+        "QUORUM",
+        "ALL"
+      ],
+      "type": "string",
+      "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+      "name": "consistency_level",
+      "in": "query"
+    },
     "CommonIncludeParameterQuery": {
       "type": "string",
       "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
@@ -8489,6 +10587,12 @@ func init() {
       "description": "Sort parameter to pass an information about the names of the sort fields",
       "name": "sort",
       "in": "query"
+    },
+    "CommonTenantParameterQuery": {
+      "type": "string",
+      "description": "Specifies the tenant in a request targeting a multi-tenant class",
+      "name": "tenant",
+      "in": "query"
     }
   },
   "securityDefinitions": {
@@ -143,6 +143,12 @@ func parseOperator(in string) (filters.Operator, error) {
 		return filters.OperatorNot, nil
 	case models.WhereFilterOperatorIsNull:
 		return filters.OperatorIsNull, nil
+	case models.WhereFilterOperatorContainsAny:
+		return filters.OperatorContainsAny, nil
+	case models.WhereFilterOperatorContainsAll:
+		return filters.OperatorContainsAll, nil
+	case models.WhereFilterOperatorIn:
+		return filters.OperatorIn, nil
 	default:
 		return -1, fmt.Errorf("unrecognized operator: %s", in)
 	}
@@ -168,5 +174,11 @@ func allValuesNil(in *models.WhereFilter) bool {
 		in.ValueText == nil &&
 		in.ValueInt == nil &&
 		in.ValueNumber == nil &&
-		in.ValueGeoRange == nil
+		in.ValueGeoRange == nil &&
+		in.ValueBooleanArray == nil &&
+		in.ValueDateArray == nil &&
+		in.ValueStringArray == nil &&
+		in.ValueTextArray == nil &&
+		in.ValueIntArray == nil &&
+		in.ValueNumberArray == nil
 }
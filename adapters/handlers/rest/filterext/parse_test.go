@@ -150,6 +150,63 @@ func Test_ExtractFlatFilters(t *testing.T) {
 					},
 				}},
 			},
+			{
+				name: "valid int array filter",
+				input: &models.WhereFilter{
+					Operator:      "ContainsAny",
+					ValueIntArray: []int64{1, 2},
+					Path:          []string{"intField"},
+				},
+				expectedFilter: &filters.LocalFilter{Root: &filters.Clause{
+					Operator: filters.OperatorContainsAny,
+					On: &filters.Path{
+						Class:    schema.AssertValidClassName("Todo"),
+						Property: schema.AssertValidPropertyName("intField"),
+					},
+					Value: &filters.Value{
+						Value: []int{1, 2},
+						Type:  schema.DataTypeIntArray,
+					},
+				}},
+			},
+			{
+				name: "valid string array filter",
+				input: &models.WhereFilter{
+					Operator:         "ContainsAll",
+					ValueStringArray: []string{"foo", "bar"},
+					Path:             []string{"stringField"},
+				},
+				expectedFilter: &filters.LocalFilter{Root: &filters.Clause{
+					Operator: filters.OperatorContainsAll,
+					On: &filters.Path{
+						Class:    schema.AssertValidClassName("Todo"),
+						Property: schema.AssertValidPropertyName("stringField"),
+					},
+					Value: &filters.Value{
+						Value: []string{"foo", "bar"},
+						Type:  schema.DataTypeStringArray,
+					},
+				}},
+			},
+			{
+				name: "valid number array filter",
+				input: &models.WhereFilter{
+					Operator:         "In",
+					ValueNumberArray: []float64{1.5, 2.5},
+					Path:             []string{"numberField"},
+				},
+				expectedFilter: &filters.LocalFilter{Root: &filters.Clause{
+					Operator: filters.OperatorIn,
+					On: &filters.Path{
+						Class:    schema.AssertValidClassName("Todo"),
+						Property: schema.AssertValidPropertyName("numberField"),
+					},
+					Value: &filters.Value{
+						Value: []float64{1.5, 2.5},
+						Type:  schema.DataTypeNumberArray,
+					},
+				}},
+			},
 			{
 				name: "valid geo range filter",
 				input: &models.WhereFilter{
@@ -330,6 +387,21 @@ func Test_ExtractFlatFilters(t *testing.T) {
 				input:          inputIntFilterWithOp("LessThanEqual"),
 				expectedFilter: intFilterWithOp(filters.OperatorLessThanEqual),
 			},
+			{
+				name:           "contains any",
+				input:          inputIntFilterWithOp("ContainsAny"),
+				expectedFilter: intFilterWithOp(filters.OperatorContainsAny),
+			},
+			{
+				name:           "contains all",
+				input:          inputIntFilterWithOp("ContainsAll"),
+				expectedFilter: intFilterWithOp(filters.OperatorContainsAll),
+			},
+			{
+				name:           "in",
+				input:          inputIntFilterWithOp("In"),
+				expectedFilter: intFilterWithOp(filters.OperatorIn),
+			},
 		}
 
 		for _, test := range tests {
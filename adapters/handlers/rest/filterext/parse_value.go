@@ -124,6 +124,59 @@ var valueExtractors = []valueExtractorFunc{
 			},
 		}, schema.DataTypeGeoCoordinates), nil
 	},
+	// int array
+	func(in *models.WhereFilter) (*filters.Value, error) {
+		if in.ValueIntArray == nil {
+			return nil, nil
+		}
+
+		values := make([]int, len(in.ValueIntArray))
+		for i, value := range in.ValueIntArray {
+			values[i] = int(value)
+		}
+
+		return valueFilter(values, schema.DataTypeIntArray), nil
+	},
+	// number array
+	func(in *models.WhereFilter) (*filters.Value, error) {
+		if in.ValueNumberArray == nil {
+			return nil, nil
+		}
+
+		return valueFilter(in.ValueNumberArray, schema.DataTypeNumberArray), nil
+	},
+	// string array
+	func(in *models.WhereFilter) (*filters.Value, error) {
+		if in.ValueStringArray == nil {
+			return nil, nil
+		}
+
+		return valueFilter(in.ValueStringArray, schema.DataTypeStringArray), nil
+	},
+	// text array
+	func(in *models.WhereFilter) (*filters.Value, error) {
+		if in.ValueTextArray == nil {
+			return nil, nil
+		}
+
+		return valueFilter(in.ValueTextArray, schema.DataTypeTextArray), nil
+	},
+	// date array (as strings)
+	func(in *models.WhereFilter) (*filters.Value, error) {
+		if in.ValueDateArray == nil {
+			return nil, nil
+		}
+
+		return valueFilter(in.ValueDateArray, schema.DataTypeDateArray), nil
+	},
+	// boolean array
+	func(in *models.WhereFilter) (*filters.Value, error) {
+		if in.ValueBooleanArray == nil {
+			return nil, nil
+		}
+
+		return valueFilter(in.ValueBooleanArray, schema.DataTypeBooleanArray), nil
+	},
 }
 
 func valueFilter(value interface{}, dt schema.DataType) *filters.Value {
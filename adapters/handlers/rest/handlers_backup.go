@@ -28,11 +28,18 @@ type backupHandlers struct {
 func (s *backupHandlers) createBackup(params backups.BackupsCreateParams,
 	principal *models.Principal,
 ) middleware.Responder {
+	archive, err := ubak.ParseArchiveConfig(params.Body.Config)
+	if err != nil {
+		return backups.NewBackupsCreateUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
 	req := ubak.BackupRequest{
-		ID:      params.Body.ID,
-		Backend: params.Backend,
-		Include: params.Body.Include,
-		Exclude: params.Body.Exclude,
+		ID:           params.Body.ID,
+		Backend:      params.Backend,
+		Include:      params.Body.Include,
+		Exclude:      params.Body.Exclude,
+		Archive:      archive,
+		BaseBackupID: params.Body.BaseBackupID,
 	}
 	meta, err := s.manager.Backup(params.HTTPRequest.Context(), principal, &req)
 	if err != nil {
@@ -146,6 +153,51 @@ func (s *backupHandlers) restoreBackupStatus(params backups.BackupsRestoreStatus
 	return backups.NewBackupsRestoreStatusOK().WithPayload(&payload)
 }
 
+func (s *backupHandlers) listBackups(params backups.BackupsListParams,
+	principal *models.Principal,
+) middleware.Responder {
+	list, err := s.manager.List(params.HTTPRequest.Context(), principal, params.Backend)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return backups.NewBackupsListForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case backup.ErrUnprocessable:
+			return backups.NewBackupsListUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return backups.NewBackupsListInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return backups.NewBackupsListOK().WithPayload(list)
+}
+
+func (s *backupHandlers) deleteBackup(params backups.BackupsDeleteParams,
+	principal *models.Principal,
+) middleware.Responder {
+	err := s.manager.Delete(params.HTTPRequest.Context(), principal, params.Backend, params.ID)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return backups.NewBackupsDeleteForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case backup.ErrUnprocessable:
+			return backups.NewBackupsDeleteUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		case backup.ErrNotFound:
+			return backups.NewBackupsDeleteNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return backups.NewBackupsDeleteInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return backups.NewBackupsDeleteNoContent()
+}
+
 func setupBackupHandlers(api *operations.WeaviateAPI,
 	scheduler *ubak.Scheduler,
 ) {
@@ -154,6 +206,10 @@ func setupBackupHandlers(api *operations.WeaviateAPI,
 		BackupsCreateHandlerFunc(h.createBackup)
 	api.BackupsBackupsCreateStatusHandler = backups.
 		BackupsCreateStatusHandlerFunc(h.createBackupStatus)
+	api.BackupsBackupsListHandler = backups.
+		BackupsListHandlerFunc(h.listBackups)
+	api.BackupsBackupsDeleteHandler = backups.
+		BackupsDeleteHandlerFunc(h.deleteBackup)
 	api.BackupsBackupsRestoreHandler = backups.
 		BackupsRestoreHandlerFunc(h.restoreBackup)
 	api.BackupsBackupsRestoreStatusHandler = backups.
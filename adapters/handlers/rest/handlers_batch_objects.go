@@ -29,7 +29,8 @@ func (h *batchObjectHandlers) addObjects(params batch.BatchObjectsCreateParams,
 	principal *models.Principal,
 ) middleware.Responder {
 	objs, err := h.manager.AddObjects(params.HTTPRequest.Context(), principal,
-		params.Body.Objects, params.Body.Fields)
+		params.Body.Objects, params.Body.Fields,
+		replicationProperties(params.ConsistencyLevel))
 	if err != nil {
 		switch err.(type) {
 		case errors.Forbidden:
@@ -71,7 +72,8 @@ func (h *batchObjectHandlers) objectsResponse(input objects.BatchObjects) []*mod
 func (h *batchObjectHandlers) addReferences(params batch.BatchReferencesCreateParams,
 	principal *models.Principal,
 ) middleware.Responder {
-	references, err := h.manager.AddReferences(params.HTTPRequest.Context(), principal, params.Body)
+	references, err := h.manager.AddReferences(params.HTTPRequest.Context(), principal, params.Body,
+		replicationProperties(params.ConsistencyLevel))
 	if err != nil {
 		switch err.(type) {
 		case errors.Forbidden:
@@ -103,6 +105,7 @@ func (h *batchObjectHandlers) referencesResponse(input objects.BatchReferences)
 		} else {
 			reference.From = strfmt.URI(ref.From.String())
 			reference.To = strfmt.URI(ref.To.String())
+			reference.Tenant = ref.Tenant
 		}
 
 		response[i] = &models.BatchReferenceResponse{
@@ -121,7 +124,8 @@ func (h *batchObjectHandlers) deleteObjects(params batch.BatchObjectsDeleteParam
 	principal *models.Principal,
 ) middleware.Responder {
 	res, err := h.manager.DeleteObjects(params.HTTPRequest.Context(), principal,
-		params.Body.Match, params.Body.DryRun, params.Body.Output)
+		params.Body.Match, params.Body.DryRun, params.Body.Output,
+		replicationProperties(params.ConsistencyLevel), getTenant(params.Tenant))
 	if err != nil {
 		switch err.(type) {
 		case errors.Forbidden:
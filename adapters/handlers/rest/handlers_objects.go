@@ -43,25 +43,26 @@ type ModulesProvider interface {
 }
 
 type objectsManager interface {
-	AddObject(context.Context, *models.Principal, *models.Object) (*models.Object, error)
+	AddObject(context.Context, *models.Principal, *models.Object, *additional.ReplicationProperties) (*models.Object, error)
 	ValidateObject(context.Context, *models.Principal, *models.Object) error
-	GetObject(_ context.Context, _ *models.Principal, class string, _ strfmt.UUID, _ additional.Properties) (*models.Object, error)
-	DeleteObject(_ context.Context, _ *models.Principal, class string, _ strfmt.UUID) error
-	UpdateObject(_ context.Context, _ *models.Principal, class string, _ strfmt.UUID, _ *models.Object) (*models.Object, error)
-	HeadObject(_ context.Context, _ *models.Principal, class string, _ strfmt.UUID) (bool, *uco.Error)
+	GetObject(_ context.Context, _ *models.Principal, class string, _ strfmt.UUID, _ additional.Properties, _ *additional.ReplicationProperties, tenant string) (*models.Object, error)
+	DeleteObject(_ context.Context, _ *models.Principal, class string, _ strfmt.UUID, _ *additional.ReplicationProperties, tenant string) error
+	UpdateObject(_ context.Context, _ *models.Principal, class string, _ strfmt.UUID, _ *models.Object, _ *additional.ReplicationProperties) (*models.Object, error)
+	HeadObject(_ context.Context, _ *models.Principal, class string, _ strfmt.UUID, _ *additional.ReplicationProperties, tenant string) (bool, *uco.Error)
 	GetObjects(context.Context, *models.Principal, *int64, *int64, *string, *string, additional.Properties) ([]*models.Object, error)
 	Query(ctx context.Context, principal *models.Principal, params *uco.QueryParams) ([]*models.Object, *uco.Error)
-	MergeObject(context.Context, *models.Principal, *models.Object) *uco.Error
-	AddObjectReference(context.Context, *models.Principal, *uco.AddReferenceInput) *uco.Error
-	UpdateObjectReferences(context.Context, *models.Principal, *uco.PutReferenceInput) *uco.Error
-	DeleteObjectReference(context.Context, *models.Principal, *uco.DeleteReferenceInput) *uco.Error
+	MergeObject(context.Context, *models.Principal, *models.Object, *additional.ReplicationProperties) *uco.Error
+	AddObjectReference(context.Context, *models.Principal, *uco.AddReferenceInput, *additional.ReplicationProperties) *uco.Error
+	UpdateObjectReferences(context.Context, *models.Principal, *uco.PutReferenceInput, *additional.ReplicationProperties) *uco.Error
+	DeleteObjectReference(context.Context, *models.Principal, *uco.DeleteReferenceInput, *additional.ReplicationProperties) *uco.Error
 	GetObjectsClass(ctx context.Context, principal *models.Principal, id strfmt.UUID) (*models.Class, error)
 }
 
 func (h *objectHandlers) addObject(params objects.ObjectsCreateParams,
 	principal *models.Principal,
 ) middleware.Responder {
-	object, err := h.manager.AddObject(params.HTTPRequest.Context(), principal, params.Body)
+	object, err := h.manager.AddObject(params.HTTPRequest.Context(), principal, params.Body,
+		replicationProperties(params.ConsistencyLevel))
 	if err != nil {
 		switch err.(type) {
 		case errors.Forbidden:
@@ -109,6 +110,7 @@ func (h *objectHandlers) validateObject(params objects.ObjectsValidateParams,
 func (h *objectHandlers) getObject(params objects.ObjectsClassGetParams,
 	principal *models.Principal,
 ) middleware.Responder {
+	repl := replicationProperties(params.ConsistencyLevel)
 	var additional additional.Properties
 
 	// The process to extract additional params depends on knowing the schema
@@ -131,7 +133,8 @@ func (h *objectHandlers) getObject(params objects.ObjectsClassGetParams,
 		}
 	}
 
-	object, err := h.manager.GetObject(params.HTTPRequest.Context(), principal, params.ClassName, params.ID, additional)
+	object, err := h.manager.GetObject(params.HTTPRequest.Context(), principal, params.ClassName, params.ID, additional, repl,
+		getTenant(params.Tenant))
 	if err != nil {
 		switch err.(type) {
 		case errors.Forbidden:
@@ -139,6 +142,9 @@ func (h *objectHandlers) getObject(params objects.ObjectsClassGetParams,
 				WithPayload(errPayloadFromSingleErr(err))
 		case uco.ErrNotFound:
 			return objects.NewObjectsClassGetNotFound()
+		case uco.ErrMultiTenancy:
+			return objects.NewObjectsClassGetUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
 		default:
 			return objects.NewObjectsClassGetInternalServerError().
 				WithPayload(errPayloadFromSingleErr(err))
@@ -159,6 +165,10 @@ func (h *objectHandlers) getObjects(params objects.ObjectsListParams,
 	if params.Class != nil && *params.Class != "" {
 		return h.query(params, principal)
 	}
+	if params.After != nil {
+		return objects.NewObjectsListUnprocessableEntity().WithPayload(
+			errPayloadFromSingleErr(fmt.Errorf("class must be set when using the after parameter")))
+	}
 	additional, err := parseIncludeParam(params.Include, h.modulesProvider, h.shouldIncludeGetObjectsModuleParams(), nil)
 	if err != nil {
 		return objects.NewObjectsListBadRequest().
@@ -207,24 +217,26 @@ func (h *objectHandlers) query(params objects.ObjectsListParams,
 		Class:      *params.Class,
 		Offset:     params.Offset,
 		Limit:      params.Limit,
+		After:      params.After,
 		Sort:       params.Sort,
 		Order:      params.Order,
 		Additional: additional,
+		Tenant:     getTenant(params.Tenant),
 	}
 	resultSet, rerr := h.manager.Query(params.HTTPRequest.Context(), principal, &req)
 	if rerr != nil {
 		switch rerr.Code {
 		case uco.StatusForbidden:
 			return objects.NewObjectsListForbidden().
-				WithPayload(errPayloadFromSingleErr(err))
+				WithPayload(errPayloadFromSingleErr(rerr))
 		case uco.StatusNotFound:
 			return objects.NewObjectsListNotFound()
 		case uco.StatusBadRequest:
 			return objects.NewObjectsListUnprocessableEntity().
-				WithPayload(errPayloadFromSingleErr(err))
+				WithPayload(errPayloadFromSingleErr(rerr))
 		default:
 			return objects.NewObjectsListInternalServerError().
-				WithPayload(errPayloadFromSingleErr(err))
+				WithPayload(errPayloadFromSingleErr(rerr))
 		}
 	}
 
@@ -247,7 +259,8 @@ func (h *objectHandlers) query(params objects.ObjectsListParams,
 func (h *objectHandlers) deleteObject(params objects.ObjectsClassDeleteParams,
 	principal *models.Principal,
 ) middleware.Responder {
-	err := h.manager.DeleteObject(params.HTTPRequest.Context(), principal, params.ClassName, params.ID)
+	err := h.manager.DeleteObject(params.HTTPRequest.Context(), principal, params.ClassName, params.ID,
+		replicationProperties(params.ConsistencyLevel), getTenant(params.Tenant))
 	if err != nil {
 		switch err.(type) {
 		case errors.Forbidden:
@@ -255,6 +268,9 @@ func (h *objectHandlers) deleteObject(params objects.ObjectsClassDeleteParams,
 				WithPayload(errPayloadFromSingleErr(err))
 		case uco.ErrNotFound:
 			return objects.NewObjectsClassDeleteNotFound()
+		case uco.ErrMultiTenancy:
+			return objects.NewObjectsClassDeleteUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
 		default:
 			return objects.NewObjectsClassDeleteInternalServerError().
 				WithPayload(errPayloadFromSingleErr(err))
@@ -267,13 +283,14 @@ func (h *objectHandlers) deleteObject(params objects.ObjectsClassDeleteParams,
 func (h *objectHandlers) updateObject(params objects.ObjectsClassPutParams,
 	principal *models.Principal,
 ) middleware.Responder {
-	object, err := h.manager.UpdateObject(params.HTTPRequest.Context(), principal, params.ClassName, params.ID, params.Body)
+	object, err := h.manager.UpdateObject(params.HTTPRequest.Context(), principal, params.ClassName, params.ID, params.Body,
+		replicationProperties(params.ConsistencyLevel))
 	if err != nil {
 		switch err.(type) {
 		case errors.Forbidden:
 			return objects.NewObjectsClassPutForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
-		case uco.ErrInvalidUserInput:
+		case uco.ErrInvalidUserInput, uco.ErrMultiTenancy:
 			return objects.NewObjectsClassPutUnprocessableEntity().
 				WithPayload(errPayloadFromSingleErr(err))
 		case uco.ErrNotFound:
@@ -295,12 +312,16 @@ func (h *objectHandlers) updateObject(params objects.ObjectsClassPutParams,
 func (h *objectHandlers) headObject(r objects.ObjectsClassHeadParams,
 	principal *models.Principal,
 ) middleware.Responder {
-	ok, err := h.manager.HeadObject(r.HTTPRequest.Context(), principal, r.ClassName, r.ID)
+	ok, err := h.manager.HeadObject(r.HTTPRequest.Context(), principal, r.ClassName, r.ID,
+		replicationProperties(r.ConsistencyLevel), getTenant(r.Tenant))
 	if err != nil {
 		switch {
 		case err.Forbidden():
 			return objects.NewObjectsClassHeadForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
+		case err.BadRequest():
+			return objects.NewObjectsClassHeadUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
 		default:
 			return objects.NewObjectsClassHeadInternalServerError().
 				WithPayload(errPayloadFromSingleErr(err))
@@ -317,7 +338,8 @@ func (h *objectHandlers) patchObject(params objects.ObjectsClassPatchParams, pri
 	updates := params.Body
 	updates.ID = params.ID
 	updates.Class = params.ClassName
-	err := h.manager.MergeObject(params.HTTPRequest.Context(), principal, updates)
+	err := h.manager.MergeObject(params.HTTPRequest.Context(), principal, updates,
+		replicationProperties(params.ConsistencyLevel))
 	if err != nil {
 		switch {
 		case err.NotFound():
@@ -346,8 +368,10 @@ func (h *objectHandlers) addObjectReference(
 		ID:       params.ID,
 		Property: params.PropertyName,
 		Ref:      *params.Body,
+		Tenant:   getTenant(params.Tenant),
 	}
-	err := h.manager.AddObjectReference(params.HTTPRequest.Context(), principal, &input)
+	err := h.manager.AddObjectReference(params.HTTPRequest.Context(), principal, &input,
+		replicationProperties(params.ConsistencyLevel))
 	if err != nil {
 		switch {
 		case err.Forbidden():
@@ -375,8 +399,10 @@ func (h *objectHandlers) putObjectReferences(params objects.ObjectsClassReferenc
 		ID:       params.ID,
 		Property: params.PropertyName,
 		Refs:     params.Body,
+		Tenant:   getTenant(params.Tenant),
 	}
-	err := h.manager.UpdateObjectReferences(params.HTTPRequest.Context(), principal, &input)
+	err := h.manager.UpdateObjectReferences(params.HTTPRequest.Context(), principal, &input,
+		replicationProperties(params.ConsistencyLevel))
 	if err != nil {
 		switch {
 		case err.Forbidden():
@@ -404,8 +430,10 @@ func (h *objectHandlers) deleteObjectReference(params objects.ObjectsClassRefere
 		ID:        params.ID,
 		Property:  params.PropertyName,
 		Reference: *params.Body,
+		Tenant:    getTenant(params.Tenant),
 	}
-	err := h.manager.DeleteObjectReference(params.HTTPRequest.Context(), principal, &input)
+	err := h.manager.DeleteObjectReference(params.HTTPRequest.Context(), principal, &input,
+		replicationProperties(params.ConsistencyLevel))
 	if err != nil {
 		switch err.Code {
 		case uco.StatusForbidden:
@@ -570,6 +598,16 @@ func (h *objectHandlers) deleteObjectReferenceDeprecated(params objects.ObjectsR
 	return h.deleteObjectReference(req, principal)
 }
 
+// replicationProperties builds the replication properties of a request from
+// its optional consistency level, nil means the defaults are used
+func replicationProperties(consistencyLevel *string) *additional.ReplicationProperties {
+	if consistencyLevel == nil {
+		return nil
+	}
+
+	return &additional.ReplicationProperties{ConsistencyLevel: *consistencyLevel}
+}
+
 func (h *objectHandlers) extendPropertiesWithAPILinks(schema map[string]interface{}) map[string]interface{} {
 	if schema == nil {
 		return schema
@@ -657,3 +695,13 @@ func getModuleParams(moduleParams map[string]interface{}) map[string]interface{}
 	}
 	return moduleParams
 }
+
+// getTenant returns the tenant of a request from its optional tenant
+// parameter, an empty string addresses a class without multi-tenancy
+func getTenant(maybeTenant *string) string {
+	if maybeTenant == nil {
+		return ""
+	}
+
+	return *maybeTenant
+}
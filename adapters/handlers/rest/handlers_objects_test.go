@@ -840,6 +840,17 @@ func TestEnrichObjectsWithLinks(t *testing.T) {
 		if _, ok := res.(*objects.ObjectsClassHeadNotFound); !ok {
 			t.Errorf("expected: %T got: %T", objects.ObjectsClassHeadNotFound{}, res)
 		}
+		if m.repl != nil {
+			t.Errorf("expected default replication properties, got %v", m.repl)
+		}
+
+		// the consistency level is passed on to the manager
+		level := additional.ConsistencyLevelAll
+		req.ConsistencyLevel = &level
+		h.headObject(req, nil)
+		assert.Equal(t, &additional.ReplicationProperties{
+			ConsistencyLevel: additional.ConsistencyLevelAll,
+		}, m.repl)
 	})
 
 	t.Run("PostReference", func(t *testing.T) {
@@ -1045,13 +1056,22 @@ type fakeManager struct {
 	addRefErr          *uco.Error
 	putRefErr          *uco.Error
 	deleteRefErr       *uco.Error
+
+	// repl holds the replication properties of the last request
+	repl *additional.ReplicationProperties
 }
 
-func (f *fakeManager) HeadObject(context.Context, *models.Principal, string, strfmt.UUID) (bool, *uco.Error) {
+func (f *fakeManager) HeadObject(_ context.Context, _ *models.Principal, _ string, _ strfmt.UUID,
+	repl *additional.ReplicationProperties, _ string,
+) (bool, *uco.Error) {
+	f.repl = repl
 	return f.headObjectReturn, f.headObjectErr
 }
 
-func (f *fakeManager) AddObject(_ context.Context, _ *models.Principal, object *models.Object) (*models.Object, error) {
+func (f *fakeManager) AddObject(_ context.Context, _ *models.Principal, object *models.Object,
+	repl *additional.ReplicationProperties,
+) (*models.Object, error) {
+	f.repl = repl
 	return object, nil
 }
 
@@ -1059,7 +1079,9 @@ func (f *fakeManager) ValidateObject(_ context.Context, _ *models.Principal, _ *
 	panic("not implemented") // TODO: Implement
 }
 
-func (f *fakeManager) GetObject(_ context.Context, _ *models.Principal, class string, _ strfmt.UUID, _ additional.Properties) (*models.Object, error) {
+func (f *fakeManager) GetObject(_ context.Context, _ *models.Principal, class string, _ strfmt.UUID,
+	_ additional.Properties, _ *additional.ReplicationProperties, _ string,
+) (*models.Object, error) {
 	return f.getObjectReturn, f.getObjectErr
 }
 
@@ -1079,26 +1101,38 @@ func (f *fakeManager) Query(_ context.Context, _ *models.Principal, _ *uco.Query
 	return f.queryResult, f.queryErr
 }
 
-func (f *fakeManager) UpdateObject(_ context.Context, _ *models.Principal, class string, _ strfmt.UUID, updates *models.Object) (*models.Object, error) {
+func (f *fakeManager) UpdateObject(_ context.Context, _ *models.Principal, class string, _ strfmt.UUID,
+	updates *models.Object, _ *additional.ReplicationProperties,
+) (*models.Object, error) {
 	return updates, f.updateObjectErr
 }
 
-func (f *fakeManager) MergeObject(_ context.Context, _ *models.Principal, _ *models.Object) *uco.Error {
+func (f *fakeManager) MergeObject(_ context.Context, _ *models.Principal, _ *models.Object,
+	_ *additional.ReplicationProperties,
+) *uco.Error {
 	return f.patchObjectReturn
 }
 
-func (f *fakeManager) DeleteObject(_ context.Context, _ *models.Principal, class string, _ strfmt.UUID) error {
+func (f *fakeManager) DeleteObject(_ context.Context, _ *models.Principal, class string, _ strfmt.UUID,
+	_ *additional.ReplicationProperties, _ string,
+) error {
 	return f.deleteObjectReturn
 }
 
-func (f *fakeManager) AddObjectReference(context.Context, *models.Principal, *uco.AddReferenceInput) *uco.Error {
+func (f *fakeManager) AddObjectReference(context.Context, *models.Principal, *uco.AddReferenceInput,
+	*additional.ReplicationProperties,
+) *uco.Error {
 	return f.addRefErr
 }
 
-func (f *fakeManager) UpdateObjectReferences(context.Context, *models.Principal, *uco.PutReferenceInput) *uco.Error {
+func (f *fakeManager) UpdateObjectReferences(context.Context, *models.Principal, *uco.PutReferenceInput,
+	*additional.ReplicationProperties,
+) *uco.Error {
 	return f.putRefErr
 }
 
-func (f *fakeManager) DeleteObjectReference(context.Context, *models.Principal, *uco.DeleteReferenceInput) *uco.Error {
+func (f *fakeManager) DeleteObjectReference(context.Context, *models.Principal, *uco.DeleteReferenceInput,
+	*additional.ReplicationProperties,
+) *uco.Error {
 	return f.deleteRefErr
 }
@@ -120,6 +120,25 @@ func (s *schemaHandlers) addClassProperty(params schema.SchemaObjectsPropertiesA
 	return schema.NewSchemaObjectsPropertiesAddOK().WithPayload(params.Body)
 }
 
+func (s *schemaHandlers) deleteClassProperty(params schema.SchemaObjectsPropertiesDeleteParams,
+	principal *models.Principal,
+) middleware.Responder {
+	err := s.manager.DeleteClassProperty(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.PropertyName)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaObjectsPropertiesDeleteForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsPropertiesDeleteBadRequest().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaObjectsPropertiesDeleteOK()
+}
+
 func (s *schemaHandlers) getSchema(params schema.SchemaDumpParams, principal *models.Principal) middleware.Responder {
 	dbSchema, err := s.manager.GetSchema(principal)
 	if err != nil {
@@ -178,6 +197,135 @@ func (s *schemaHandlers) updateShardStatus(params schema.SchemaObjectsShardsUpda
 	return schema.NewSchemaObjectsShardsUpdateOK().WithPayload(payload)
 }
 
+func (s *schemaHandlers) addTenants(params schema.SchemaTenantsCreateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	err := s.manager.AddTenants(
+		params.HTTPRequest.Context(), principal, params.ClassName, params.Body)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaTenantsCreateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaTenantsCreateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaTenantsCreateOK().WithPayload(params.Body)
+}
+
+func (s *schemaHandlers) deleteTenants(params schema.SchemaTenantsDeleteParams,
+	principal *models.Principal,
+) middleware.Responder {
+	err := s.manager.DeleteTenants(
+		params.HTTPRequest.Context(), principal, params.ClassName, params.Tenants)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaTenantsDeleteForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaTenantsDeleteUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaTenantsDeleteOK()
+}
+
+func (s *schemaHandlers) getTenants(params schema.SchemaTenantsGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	tenants, err := s.manager.GetTenants(params.HTTPRequest.Context(), principal, params.ClassName)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaTenantsGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaTenantsGetUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaTenantsGetOK().WithPayload(tenants)
+}
+
+func (s *schemaHandlers) reindexClass(params schema.SchemaObjectsReindexCreateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	job, err := s.manager.ReindexClass(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.Body)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaObjectsReindexCreateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			if err == schemaUC.ErrNotFound {
+				return schema.NewSchemaObjectsReindexCreateNotFound().
+					WithPayload(errPayloadFromSingleErr(err))
+			}
+			return schema.NewSchemaObjectsReindexCreateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaObjectsReindexCreateOK().WithPayload(job)
+}
+
+func (s *schemaHandlers) getReindexJob(params schema.SchemaObjectsReindexGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	job, err := s.manager.GetReindexJob(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.ID)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaObjectsReindexGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			if err == schemaUC.ErrNotFound {
+				return schema.NewSchemaObjectsReindexGetNotFound().
+					WithPayload(errPayloadFromSingleErr(err))
+			}
+			return schema.NewSchemaObjectsReindexGetInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaObjectsReindexGetOK().WithPayload(job)
+}
+
+func (s *schemaHandlers) cancelReindexJob(params schema.SchemaObjectsReindexCancelParams,
+	principal *models.Principal,
+) middleware.Responder {
+	job, err := s.manager.CancelReindexJob(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.ID)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaObjectsReindexCancelForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			switch err {
+			case schemaUC.ErrNotFound:
+				return schema.NewSchemaObjectsReindexCancelNotFound().
+					WithPayload(errPayloadFromSingleErr(err))
+			case schemaUC.ErrReindexJobFinished:
+				return schema.NewSchemaObjectsReindexCancelUnprocessableEntity().
+					WithPayload(errPayloadFromSingleErr(err))
+			}
+			return schema.NewSchemaObjectsReindexCancelInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaObjectsReindexCancelOK().WithPayload(job)
+}
+
 func setupSchemaHandlers(api *operations.WeaviateAPI, manager *schemaUC.Manager) {
 	h := &schemaHandlers{manager}
 
@@ -187,6 +335,8 @@ func setupSchemaHandlers(api *operations.WeaviateAPI, manager *schemaUC.Manager)
 		SchemaObjectsDeleteHandlerFunc(h.deleteClass)
 	api.SchemaSchemaObjectsPropertiesAddHandler = schema.
 		SchemaObjectsPropertiesAddHandlerFunc(h.addClassProperty)
+	api.SchemaSchemaObjectsPropertiesDeleteHandler = schema.
+		SchemaObjectsPropertiesDeleteHandlerFunc(h.deleteClassProperty)
 
 	api.SchemaSchemaObjectsUpdateHandler = schema.
 		SchemaObjectsUpdateHandlerFunc(h.updateClass)
@@ -196,8 +346,22 @@ func setupSchemaHandlers(api *operations.WeaviateAPI, manager *schemaUC.Manager)
 	api.SchemaSchemaDumpHandler = schema.
 		SchemaDumpHandlerFunc(h.getSchema)
 
+	api.SchemaSchemaObjectsReindexCreateHandler = schema.
+		SchemaObjectsReindexCreateHandlerFunc(h.reindexClass)
+	api.SchemaSchemaObjectsReindexGetHandler = schema.
+		SchemaObjectsReindexGetHandlerFunc(h.getReindexJob)
+	api.SchemaSchemaObjectsReindexCancelHandler = schema.
+		SchemaObjectsReindexCancelHandlerFunc(h.cancelReindexJob)
+
 	api.SchemaSchemaObjectsShardsGetHandler = schema.
 		SchemaObjectsShardsGetHandlerFunc(h.getShardsStatus)
 	api.SchemaSchemaObjectsShardsUpdateHandler = schema.
 		SchemaObjectsShardsUpdateHandlerFunc(h.updateShardStatus)
+
+	api.SchemaSchemaTenantsCreateHandler = schema.
+		SchemaTenantsCreateHandlerFunc(h.addTenants)
+	api.SchemaSchemaTenantsDeleteHandler = schema.
+		SchemaTenantsDeleteHandlerFunc(h.deleteTenants)
+	api.SchemaSchemaTenantsGetHandler = schema.
+		SchemaTenantsGetHandlerFunc(h.getTenants)
 }
@@ -23,6 +23,8 @@ import (
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
 )
 
 // NewBatchObjectsCreateParams creates a new BatchObjectsCreateParams object
@@ -46,6 +48,10 @@ type BatchObjectsCreateParams struct {
 	  In: body
 	*/
 	Body BatchObjectsCreateBody
+	/*Determines how many replicas must acknowledge a request before it is considered successful
+	  In: query
+	*/
+	ConsistencyLevel *string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -57,6 +63,8 @@ func (o *BatchObjectsCreateParams) BindRequest(r *http.Request, route *middlewar
 
 	o.HTTPRequest = r
 
+	qs := runtime.Values(r.URL.Query())
+
 	if runtime.HasBody(r) {
 		defer r.Body.Close()
 		var body BatchObjectsCreateBody
@@ -79,8 +87,45 @@ func (o *BatchObjectsCreateParams) BindRequest(r *http.Request, route *middlewar
 	} else {
 		res = append(res, errors.Required("body", "body", ""))
 	}
+	qConsistencyLevel, qhkConsistencyLevel, _ := qs.GetOK("consistency_level")
+	if err := o.bindConsistencyLevel(qConsistencyLevel, qhkConsistencyLevel, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
+
+// bindConsistencyLevel binds and validates parameter ConsistencyLevel from query.
+func (o *BatchObjectsCreateParams) bindConsistencyLevel(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.ConsistencyLevel = &raw
+
+	if err := o.validateConsistencyLevel(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateConsistencyLevel carries on validations for parameter ConsistencyLevel
+func (o *BatchObjectsCreateParams) validateConsistencyLevel(formats strfmt.Registry) error {
+
+	if err := validate.EnumCase("consistency_level", "query", *o.ConsistencyLevel, []interface{}{"ONE", "QUORUM", "ALL"}, true); err != nil {
+		return err
+	}
+
+	return nil
+}
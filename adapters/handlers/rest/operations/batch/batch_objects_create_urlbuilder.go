@@ -24,7 +24,11 @@ import (
 
 // BatchObjectsCreateURL generates an URL for the batch objects create operation
 type BatchObjectsCreateURL struct {
+	ConsistencyLevel *string
+
 	_basePath string
+	// avoid unkeyed usage
+	_ struct{}
 }
 
 // WithBasePath sets the base path for this url builder, only required when it's different from the
@@ -54,6 +58,18 @@ func (o *BatchObjectsCreateURL) Build() (*url.URL, error) {
 	}
 	_result.Path = golangswaggerpaths.Join(_basePath, _path)
 
+	qs := make(url.Values)
+
+	var consistencyLevelQ string
+	if o.ConsistencyLevel != nil {
+		consistencyLevelQ = *o.ConsistencyLevel
+	}
+	if consistencyLevelQ != "" {
+		qs.Set("consistency_level", consistencyLevelQ)
+	}
+
+	_result.RawQuery = qs.Encode()
+
 	return &_result, nil
 }
 
@@ -23,6 +23,8 @@ import (
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
 
 	"github.com/semi-technologies/weaviate/entities/models"
 )
@@ -48,6 +50,14 @@ type BatchObjectsDeleteParams struct {
 	  In: body
 	*/
 	Body *models.BatchDelete
+	/*Determines how many replicas must acknowledge a request before it is considered successful
+	  In: query
+	*/
+	ConsistencyLevel *string
+	/*Specifies the tenant in a request targeting a multi-tenant class
+	  In: query
+	*/
+	Tenant *string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -59,6 +69,8 @@ func (o *BatchObjectsDeleteParams) BindRequest(r *http.Request, route *middlewar
 
 	o.HTTPRequest = r
 
+	qs := runtime.Values(r.URL.Query())
+
 	if runtime.HasBody(r) {
 		defer r.Body.Close()
 		var body models.BatchDelete
@@ -81,8 +93,68 @@ func (o *BatchObjectsDeleteParams) BindRequest(r *http.Request, route *middlewar
 	} else {
 		res = append(res, errors.Required("body", "body", ""))
 	}
+	qConsistencyLevel, qhkConsistencyLevel, _ := qs.GetOK("consistency_level")
+	if err := o.bindConsistencyLevel(qConsistencyLevel, qhkConsistencyLevel, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qTenant, qhkTenant, _ := qs.GetOK("tenant")
+	if err := o.bindTenant(qTenant, qhkTenant, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
+
+// bindConsistencyLevel binds and validates parameter ConsistencyLevel from query.
+func (o *BatchObjectsDeleteParams) bindConsistencyLevel(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.ConsistencyLevel = &raw
+
+	if err := o.validateConsistencyLevel(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateConsistencyLevel carries on validations for parameter ConsistencyLevel
+func (o *BatchObjectsDeleteParams) validateConsistencyLevel(formats strfmt.Registry) error {
+
+	if err := validate.EnumCase("consistency_level", "query", *o.ConsistencyLevel, []interface{}{"ONE", "QUORUM", "ALL"}, true); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindTenant binds and validates parameter Tenant from query.
+func (o *BatchObjectsDeleteParams) bindTenant(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Tenant = &raw
+
+	return nil
+}
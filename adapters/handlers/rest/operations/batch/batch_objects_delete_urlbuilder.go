@@ -24,7 +24,12 @@ import (
 
 // BatchObjectsDeleteURL generates an URL for the batch objects delete operation
 type BatchObjectsDeleteURL struct {
+	ConsistencyLevel *string
+	Tenant           *string
+
 	_basePath string
+	// avoid unkeyed usage
+	_ struct{}
 }
 
 // WithBasePath sets the base path for this url builder, only required when it's different from the
@@ -54,6 +59,26 @@ func (o *BatchObjectsDeleteURL) Build() (*url.URL, error) {
 	}
 	_result.Path = golangswaggerpaths.Join(_basePath, _path)
 
+	qs := make(url.Values)
+
+	var consistencyLevelQ string
+	if o.ConsistencyLevel != nil {
+		consistencyLevelQ = *o.ConsistencyLevel
+	}
+	if consistencyLevelQ != "" {
+		qs.Set("consistency_level", consistencyLevelQ)
+	}
+
+	var tenantQ string
+	if o.Tenant != nil {
+		tenantQ = *o.Tenant
+	}
+	if tenantQ != "" {
+		qs.Set("tenant", tenantQ)
+	}
+
+	_result.RawQuery = qs.Encode()
+
 	return &_result, nil
 }
 
@@ -20,6 +20,7 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/validate"
@@ -46,11 +47,19 @@ type ObjectsClassDeleteParams struct {
 	  In: path
 	*/
 	ClassName string
+	/*Determines how many replicas must acknowledge a request before it is considered successful
+	  In: query
+	*/
+	ConsistencyLevel *string
 	/*Unique ID of the Object.
 	  Required: true
 	  In: path
 	*/
 	ID strfmt.UUID
+	/*Specifies the tenant in a request targeting a multi-tenant class
+	  In: query
+	*/
+	Tenant *string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -62,16 +71,28 @@ func (o *ObjectsClassDeleteParams) BindRequest(r *http.Request, route *middlewar
 
 	o.HTTPRequest = r
 
+	qs := runtime.Values(r.URL.Query())
+
 	rClassName, rhkClassName, _ := route.Params.GetOK("className")
 	if err := o.bindClassName(rClassName, rhkClassName, route.Formats); err != nil {
 		res = append(res, err)
 	}
 
+	qConsistencyLevel, qhkConsistencyLevel, _ := qs.GetOK("consistency_level")
+	if err := o.bindConsistencyLevel(qConsistencyLevel, qhkConsistencyLevel, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	rID, rhkID, _ := route.Params.GetOK("id")
 	if err := o.bindID(rID, rhkID, route.Formats); err != nil {
 		res = append(res, err)
 	}
 
+	qTenant, qhkTenant, _ := qs.GetOK("tenant")
+	if err := o.bindTenant(qTenant, qhkTenant, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -93,6 +114,38 @@ func (o *ObjectsClassDeleteParams) bindClassName(rawData []string, hasKey bool,
 	return nil
 }
 
+// bindConsistencyLevel binds and validates parameter ConsistencyLevel from query.
+func (o *ObjectsClassDeleteParams) bindConsistencyLevel(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.ConsistencyLevel = &raw
+
+	if err := o.validateConsistencyLevel(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateConsistencyLevel carries on validations for parameter ConsistencyLevel
+func (o *ObjectsClassDeleteParams) validateConsistencyLevel(formats strfmt.Registry) error {
+
+	if err := validate.EnumCase("consistency_level", "query", *o.ConsistencyLevel, []interface{}{"ONE", "QUORUM", "ALL"}, true); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // bindID binds and validates parameter ID from path.
 func (o *ObjectsClassDeleteParams) bindID(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -125,3 +178,21 @@ func (o *ObjectsClassDeleteParams) validateID(formats strfmt.Registry) error {
 	}
 	return nil
 }
+
+// bindTenant binds and validates parameter Tenant from query.
+func (o *ObjectsClassDeleteParams) bindTenant(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Tenant = &raw
+
+	return nil
+}
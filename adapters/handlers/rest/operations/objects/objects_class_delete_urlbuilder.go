@@ -30,6 +30,9 @@ type ObjectsClassDeleteURL struct {
 	ClassName string
 	ID        strfmt.UUID
 
+	ConsistencyLevel *string
+	Tenant           *string
+
 	_basePath string
 	// avoid unkeyed usage
 	_ struct{}
@@ -76,6 +79,26 @@ func (o *ObjectsClassDeleteURL) Build() (*url.URL, error) {
 	}
 	_result.Path = golangswaggerpaths.Join(_basePath, _path)
 
+	qs := make(url.Values)
+
+	var consistencyLevelQ string
+	if o.ConsistencyLevel != nil {
+		consistencyLevelQ = *o.ConsistencyLevel
+	}
+	if consistencyLevelQ != "" {
+		qs.Set("consistency_level", consistencyLevelQ)
+	}
+
+	var tenantQ string
+	if o.Tenant != nil {
+		tenantQ = *o.Tenant
+	}
+	if tenantQ != "" {
+		qs.Set("tenant", tenantQ)
+	}
+
+	_result.RawQuery = qs.Encode()
+
 	return &_result, nil
 }
 
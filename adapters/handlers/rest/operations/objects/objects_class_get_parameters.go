@@ -47,6 +47,10 @@ type ObjectsClassGetParams struct {
 	  In: path
 	*/
 	ClassName string
+	/*Determines how many replicas must acknowledge a request before it is considered successful
+	  In: query
+	*/
+	ConsistencyLevel *string
 	/*Unique ID of the Object.
 	  Required: true
 	  In: path
@@ -56,6 +60,10 @@ type ObjectsClassGetParams struct {
 	  In: query
 	*/
 	Include *string
+	/*Specifies the tenant in a request targeting a multi-tenant class
+	  In: query
+	*/
+	Tenant *string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -74,6 +82,11 @@ func (o *ObjectsClassGetParams) BindRequest(r *http.Request, route *middleware.M
 		res = append(res, err)
 	}
 
+	qConsistencyLevel, qhkConsistencyLevel, _ := qs.GetOK("consistency_level")
+	if err := o.bindConsistencyLevel(qConsistencyLevel, qhkConsistencyLevel, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	rID, rhkID, _ := route.Params.GetOK("id")
 	if err := o.bindID(rID, rhkID, route.Formats); err != nil {
 		res = append(res, err)
@@ -84,6 +97,11 @@ func (o *ObjectsClassGetParams) BindRequest(r *http.Request, route *middleware.M
 		res = append(res, err)
 	}
 
+	qTenant, qhkTenant, _ := qs.GetOK("tenant")
+	if err := o.bindTenant(qTenant, qhkTenant, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -105,6 +123,38 @@ func (o *ObjectsClassGetParams) bindClassName(rawData []string, hasKey bool, for
 	return nil
 }
 
+// bindConsistencyLevel binds and validates parameter ConsistencyLevel from query.
+func (o *ObjectsClassGetParams) bindConsistencyLevel(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.ConsistencyLevel = &raw
+
+	if err := o.validateConsistencyLevel(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateConsistencyLevel carries on validations for parameter ConsistencyLevel
+func (o *ObjectsClassGetParams) validateConsistencyLevel(formats strfmt.Registry) error {
+
+	if err := validate.EnumCase("consistency_level", "query", *o.ConsistencyLevel, []interface{}{"ONE", "QUORUM", "ALL"}, true); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // bindID binds and validates parameter ID from path.
 func (o *ObjectsClassGetParams) bindID(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -155,3 +205,21 @@ func (o *ObjectsClassGetParams) bindInclude(rawData []string, hasKey bool, forma
 
 	return nil
 }
+
+// bindTenant binds and validates parameter Tenant from query.
+func (o *ObjectsClassGetParams) bindTenant(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Tenant = &raw
+
+	return nil
+}
@@ -30,7 +30,9 @@ type ObjectsClassGetURL struct {
 	ClassName string
 	ID        strfmt.UUID
 
-	Include *string
+	ConsistencyLevel *string
+	Include          *string
+	Tenant           *string
 
 	_basePath string
 	// avoid unkeyed usage
@@ -80,6 +82,14 @@ func (o *ObjectsClassGetURL) Build() (*url.URL, error) {
 
 	qs := make(url.Values)
 
+	var consistencyLevelQ string
+	if o.ConsistencyLevel != nil {
+		consistencyLevelQ = *o.ConsistencyLevel
+	}
+	if consistencyLevelQ != "" {
+		qs.Set("consistency_level", consistencyLevelQ)
+	}
+
 	var includeQ string
 	if o.Include != nil {
 		includeQ = *o.Include
@@ -88,6 +98,14 @@ func (o *ObjectsClassGetURL) Build() (*url.URL, error) {
 		qs.Set("include", includeQ)
 	}
 
+	var tenantQ string
+	if o.Tenant != nil {
+		tenantQ = *o.Tenant
+	}
+	if tenantQ != "" {
+		qs.Set("tenant", tenantQ)
+	}
+
 	_result.RawQuery = qs.Encode()
 
 	return &_result, nil
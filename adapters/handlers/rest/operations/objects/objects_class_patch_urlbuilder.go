@@ -30,6 +30,8 @@ type ObjectsClassPatchURL struct {
 	ClassName string
 	ID        strfmt.UUID
 
+	ConsistencyLevel *string
+
 	_basePath string
 	// avoid unkeyed usage
 	_ struct{}
@@ -76,6 +78,18 @@ func (o *ObjectsClassPatchURL) Build() (*url.URL, error) {
 	}
 	_result.Path = golangswaggerpaths.Join(_basePath, _path)
 
+	qs := make(url.Values)
+
+	var consistencyLevelQ string
+	if o.ConsistencyLevel != nil {
+		consistencyLevelQ = *o.ConsistencyLevel
+	}
+	if consistencyLevelQ != "" {
+		qs.Set("consistency_level", consistencyLevelQ)
+	}
+
+	_result.RawQuery = qs.Encode()
+
 	return &_result, nil
 }
 
@@ -50,6 +50,10 @@ type ObjectsListParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*The starting ID of the result window. Objects are returned in ID order, starting after the given ID. Requires the class parameter and can't be combined with offset, sort or order.
+	  In: query
+	*/
+	After *string
 	/*Class parameter specifies the class from which to query objects
 	  In: query
 	*/
@@ -75,6 +79,10 @@ type ObjectsListParams struct {
 	  In: query
 	*/
 	Sort *string
+	/*Specifies the tenant in a request targeting a multi-tenant class
+	  In: query
+	*/
+	Tenant *string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -88,6 +96,11 @@ func (o *ObjectsListParams) BindRequest(r *http.Request, route *middleware.Match
 
 	qs := runtime.Values(r.URL.Query())
 
+	qAfter, qhkAfter, _ := qs.GetOK("after")
+	if err := o.bindAfter(qAfter, qhkAfter, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qClass, qhkClass, _ := qs.GetOK("class")
 	if err := o.bindClass(qClass, qhkClass, route.Formats); err != nil {
 		res = append(res, err)
@@ -118,12 +131,35 @@ func (o *ObjectsListParams) BindRequest(r *http.Request, route *middleware.Match
 		res = append(res, err)
 	}
 
+	qTenant, qhkTenant, _ := qs.GetOK("tenant")
+	if err := o.bindTenant(qTenant, qhkTenant, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+// bindAfter binds and validates parameter After from query.
+func (o *ObjectsListParams) bindAfter(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.After = &raw
+
+	return nil
+}
+
 // bindClass binds and validates parameter Class from query.
 func (o *ObjectsListParams) bindClass(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -240,3 +276,21 @@ func (o *ObjectsListParams) bindSort(rawData []string, hasKey bool, formats strf
 
 	return nil
 }
+
+// bindTenant binds and validates parameter Tenant from query.
+func (o *ObjectsListParams) bindTenant(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Tenant = &raw
+
+	return nil
+}
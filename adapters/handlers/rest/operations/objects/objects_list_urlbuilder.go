@@ -26,12 +26,14 @@ import (
 
 // ObjectsListURL generates an URL for the objects list operation
 type ObjectsListURL struct {
+	After   *string
 	Class   *string
 	Include *string
 	Limit   *int64
 	Offset  *int64
 	Order   *string
 	Sort    *string
+	Tenant  *string
 
 	_basePath string
 	// avoid unkeyed usage
@@ -67,6 +69,14 @@ func (o *ObjectsListURL) Build() (*url.URL, error) {
 
 	qs := make(url.Values)
 
+	var afterQ string
+	if o.After != nil {
+		afterQ = *o.After
+	}
+	if afterQ != "" {
+		qs.Set("after", afterQ)
+	}
+
 	var classQ string
 	if o.Class != nil {
 		classQ = *o.Class
@@ -115,6 +125,14 @@ func (o *ObjectsListURL) Build() (*url.URL, error) {
 		qs.Set("sort", sortQ)
 	}
 
+	var tenantQ string
+	if o.Tenant != nil {
+		tenantQ = *o.Tenant
+	}
+	if tenantQ != "" {
+		qs.Set("tenant", tenantQ)
+	}
+
 	_result.RawQuery = qs.Encode()
 
 	return &_result, nil
@@ -74,6 +74,12 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		BackupsBackupsCreateStatusHandler: backups.BackupsCreateStatusHandlerFunc(func(params backups.BackupsCreateStatusParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation backups.BackupsCreateStatus has not yet been implemented")
 		}),
+		BackupsBackupsDeleteHandler: backups.BackupsDeleteHandlerFunc(func(params backups.BackupsDeleteParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation backups.BackupsDelete has not yet been implemented")
+		}),
+		BackupsBackupsListHandler: backups.BackupsListHandlerFunc(func(params backups.BackupsListParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation backups.BackupsList has not yet been implemented")
+		}),
 		BackupsBackupsRestoreHandler: backups.BackupsRestoreHandlerFunc(func(params backups.BackupsRestoreParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation backups.BackupsRestore has not yet been implemented")
 		}),
@@ -179,6 +185,18 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		SchemaSchemaObjectsPropertiesAddHandler: schema.SchemaObjectsPropertiesAddHandlerFunc(func(params schema.SchemaObjectsPropertiesAddParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaObjectsPropertiesAdd has not yet been implemented")
 		}),
+		SchemaSchemaObjectsPropertiesDeleteHandler: schema.SchemaObjectsPropertiesDeleteHandlerFunc(func(params schema.SchemaObjectsPropertiesDeleteParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsPropertiesDelete has not yet been implemented")
+		}),
+		SchemaSchemaObjectsReindexCancelHandler: schema.SchemaObjectsReindexCancelHandlerFunc(func(params schema.SchemaObjectsReindexCancelParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsReindexCancel has not yet been implemented")
+		}),
+		SchemaSchemaObjectsReindexCreateHandler: schema.SchemaObjectsReindexCreateHandlerFunc(func(params schema.SchemaObjectsReindexCreateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsReindexCreate has not yet been implemented")
+		}),
+		SchemaSchemaObjectsReindexGetHandler: schema.SchemaObjectsReindexGetHandlerFunc(func(params schema.SchemaObjectsReindexGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsReindexGet has not yet been implemented")
+		}),
 		SchemaSchemaObjectsShardsGetHandler: schema.SchemaObjectsShardsGetHandlerFunc(func(params schema.SchemaObjectsShardsGetParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaObjectsShardsGet has not yet been implemented")
 		}),
@@ -188,6 +206,15 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		SchemaSchemaObjectsUpdateHandler: schema.SchemaObjectsUpdateHandlerFunc(func(params schema.SchemaObjectsUpdateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaObjectsUpdate has not yet been implemented")
 		}),
+		SchemaSchemaTenantsCreateHandler: schema.SchemaTenantsCreateHandlerFunc(func(params schema.SchemaTenantsCreateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaTenantsCreate has not yet been implemented")
+		}),
+		SchemaSchemaTenantsDeleteHandler: schema.SchemaTenantsDeleteHandlerFunc(func(params schema.SchemaTenantsDeleteParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaTenantsDelete has not yet been implemented")
+		}),
+		SchemaSchemaTenantsGetHandler: schema.SchemaTenantsGetHandlerFunc(func(params schema.SchemaTenantsGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaTenantsGet has not yet been implemented")
+		}),
 		WeaviateRootHandler: WeaviateRootHandlerFunc(func(params WeaviateRootParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation WeaviateRoot has not yet been implemented")
 		}),
@@ -252,6 +279,10 @@ type WeaviateAPI struct {
 	BackupsBackupsCreateHandler backups.BackupsCreateHandler
 	// BackupsBackupsCreateStatusHandler sets the operation handler for the backups create status operation
 	BackupsBackupsCreateStatusHandler backups.BackupsCreateStatusHandler
+	// BackupsBackupsDeleteHandler sets the operation handler for the backups delete operation
+	BackupsBackupsDeleteHandler backups.BackupsDeleteHandler
+	// BackupsBackupsListHandler sets the operation handler for the backups list operation
+	BackupsBackupsListHandler backups.BackupsListHandler
 	// BackupsBackupsRestoreHandler sets the operation handler for the backups restore operation
 	BackupsBackupsRestoreHandler backups.BackupsRestoreHandler
 	// BackupsBackupsRestoreStatusHandler sets the operation handler for the backups restore status operation
@@ -322,12 +353,26 @@ type WeaviateAPI struct {
 	SchemaSchemaObjectsGetHandler schema.SchemaObjectsGetHandler
 	// SchemaSchemaObjectsPropertiesAddHandler sets the operation handler for the schema objects properties add operation
 	SchemaSchemaObjectsPropertiesAddHandler schema.SchemaObjectsPropertiesAddHandler
+	// SchemaSchemaObjectsPropertiesDeleteHandler sets the operation handler for the schema objects properties delete operation
+	SchemaSchemaObjectsPropertiesDeleteHandler schema.SchemaObjectsPropertiesDeleteHandler
+	// SchemaSchemaObjectsReindexCancelHandler sets the operation handler for the schema objects reindex cancel operation
+	SchemaSchemaObjectsReindexCancelHandler schema.SchemaObjectsReindexCancelHandler
+	// SchemaSchemaObjectsReindexCreateHandler sets the operation handler for the schema objects reindex create operation
+	SchemaSchemaObjectsReindexCreateHandler schema.SchemaObjectsReindexCreateHandler
+	// SchemaSchemaObjectsReindexGetHandler sets the operation handler for the schema objects reindex get operation
+	SchemaSchemaObjectsReindexGetHandler schema.SchemaObjectsReindexGetHandler
 	// SchemaSchemaObjectsShardsGetHandler sets the operation handler for the schema objects shards get operation
 	SchemaSchemaObjectsShardsGetHandler schema.SchemaObjectsShardsGetHandler
 	// SchemaSchemaObjectsShardsUpdateHandler sets the operation handler for the schema objects shards update operation
 	SchemaSchemaObjectsShardsUpdateHandler schema.SchemaObjectsShardsUpdateHandler
 	// SchemaSchemaObjectsUpdateHandler sets the operation handler for the schema objects update operation
 	SchemaSchemaObjectsUpdateHandler schema.SchemaObjectsUpdateHandler
+	// SchemaSchemaTenantsCreateHandler sets the operation handler for the schema tenants create operation
+	SchemaSchemaTenantsCreateHandler schema.SchemaTenantsCreateHandler
+	// SchemaSchemaTenantsDeleteHandler sets the operation handler for the schema tenants delete operation
+	SchemaSchemaTenantsDeleteHandler schema.SchemaTenantsDeleteHandler
+	// SchemaSchemaTenantsGetHandler sets the operation handler for the schema tenants get operation
+	SchemaSchemaTenantsGetHandler schema.SchemaTenantsGetHandler
 	// WeaviateRootHandler sets the operation handler for the weaviate root operation
 	WeaviateRootHandler WeaviateRootHandler
 	// WeaviateWellknownLivenessHandler sets the operation handler for the weaviate wellknown liveness operation
@@ -416,6 +461,12 @@ func (o *WeaviateAPI) Validate() error {
 	if o.BackupsBackupsCreateStatusHandler == nil {
 		unregistered = append(unregistered, "backups.BackupsCreateStatusHandler")
 	}
+	if o.BackupsBackupsDeleteHandler == nil {
+		unregistered = append(unregistered, "backups.BackupsDeleteHandler")
+	}
+	if o.BackupsBackupsListHandler == nil {
+		unregistered = append(unregistered, "backups.BackupsListHandler")
+	}
 	if o.BackupsBackupsRestoreHandler == nil {
 		unregistered = append(unregistered, "backups.BackupsRestoreHandler")
 	}
@@ -521,6 +572,18 @@ func (o *WeaviateAPI) Validate() error {
 	if o.SchemaSchemaObjectsPropertiesAddHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaObjectsPropertiesAddHandler")
 	}
+	if o.SchemaSchemaObjectsPropertiesDeleteHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsPropertiesDeleteHandler")
+	}
+	if o.SchemaSchemaObjectsReindexCancelHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsReindexCancelHandler")
+	}
+	if o.SchemaSchemaObjectsReindexCreateHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsReindexCreateHandler")
+	}
+	if o.SchemaSchemaObjectsReindexGetHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsReindexGetHandler")
+	}
 	if o.SchemaSchemaObjectsShardsGetHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaObjectsShardsGetHandler")
 	}
@@ -530,6 +593,15 @@ func (o *WeaviateAPI) Validate() error {
 	if o.SchemaSchemaObjectsUpdateHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaObjectsUpdateHandler")
 	}
+	if o.SchemaSchemaTenantsCreateHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaTenantsCreateHandler")
+	}
+	if o.SchemaSchemaTenantsDeleteHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaTenantsDeleteHandler")
+	}
+	if o.SchemaSchemaTenantsGetHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaTenantsGetHandler")
+	}
 	if o.WeaviateRootHandler == nil {
 		unregistered = append(unregistered, "WeaviateRootHandler")
 	}
@@ -651,6 +723,14 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/backups/{backend}/{id}"] = backups.NewBackupsCreateStatus(o.context, o.BackupsBackupsCreateStatusHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/backups/{backend}/{id}"] = backups.NewBackupsDelete(o.context, o.BackupsBackupsDeleteHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/backups/{backend}"] = backups.NewBackupsList(o.context, o.BackupsBackupsListHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -791,6 +871,22 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/schema/{className}/properties"] = schema.NewSchemaObjectsPropertiesAdd(o.context, o.SchemaSchemaObjectsPropertiesAddHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/schema/{className}/properties/{propertyName}"] = schema.NewSchemaObjectsPropertiesDelete(o.context, o.SchemaSchemaObjectsPropertiesDeleteHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/schema/{className}/reindex/{id}"] = schema.NewSchemaObjectsReindexCancel(o.context, o.SchemaSchemaObjectsReindexCancelHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/{className}/reindex"] = schema.NewSchemaObjectsReindexCreate(o.context, o.SchemaSchemaObjectsReindexCreateHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/{className}/reindex/{id}"] = schema.NewSchemaObjectsReindexGet(o.context, o.SchemaSchemaObjectsReindexGetHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -803,6 +899,18 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
 	o.handlers["PUT"]["/schema/{className}"] = schema.NewSchemaObjectsUpdate(o.context, o.SchemaSchemaObjectsUpdateHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/{className}/tenants"] = schema.NewSchemaTenantsCreate(o.context, o.SchemaSchemaTenantsCreateHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/schema/{className}/tenants"] = schema.NewSchemaTenantsDelete(o.context, o.SchemaSchemaTenantsDeleteHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/{className}/tenants"] = schema.NewSchemaTenantsGet(o.context, o.SchemaSchemaTenantsGetHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -15,6 +15,7 @@ import (
 	"github.com/semi-technologies/weaviate/adapters/handlers/graphql"
 	"github.com/semi-technologies/weaviate/adapters/repos/classifications"
 	"github.com/semi-technologies/weaviate/usecases/auth/authentication/anonymous"
+	"github.com/semi-technologies/weaviate/usecases/auth/authentication/apikey"
 	"github.com/semi-technologies/weaviate/usecases/auth/authentication/oidc"
 	"github.com/semi-technologies/weaviate/usecases/auth/authorization"
 	"github.com/semi-technologies/weaviate/usecases/backup"
@@ -33,6 +34,7 @@ import (
 // TODO: remove dependencies to anything that's not an ent or uc
 type State struct {
 	OIDC                *oidc.Client
+	APIKey              *apikey.Client
 	AnonymousAccess     *anonymous.Client
 	Authorizer          authorization.Authorizer
 	ServerConfig        *config.WeaviateConfig
@@ -17,6 +17,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/lsmkv"
 	"github.com/semi-technologies/weaviate/entities/aggregation"
 	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/entities/storobj"
@@ -36,7 +37,7 @@ func (ua unfilteredAggregator) boolArrayProperty(ctx context.Context,
 
 func (ua unfilteredAggregator) parseBoolProp(ctx context.Context,
 	prop aggregation.ParamProperty,
-	parseFn func(agg *boolAggregator, k []byte, v [][]byte) error,
+	parseFn func(agg *boolAggregator, k []byte, count uint64) error,
 ) (*aggregation.Property, error) {
 	out := aggregation.Property{
 		Type: aggregation.PropertyTypeBoolean,
@@ -49,36 +50,63 @@ func (ua unfilteredAggregator) parseBoolProp(ctx context.Context,
 
 	agg := newBoolAggregator()
 
-	c := b.SetCursor() // bool never has a frequency, so it's always a Set
+	// bool never has a frequency, so it's always a Set or RoaringSet
+	if err := ua.forEachRowCount(b, func(k []byte, count uint64) error {
+		return parseFn(agg, k, count)
+	}); err != nil {
+		return nil, err
+	}
+
+	out.BooleanAggregation = agg.Res()
+
+	return &out, nil
+}
+
+// forEachRowCount iterates over all rows of an inverted index bucket without
+// frequencies and calls fn with the key and the number of doc ids in each row
+func (ua unfilteredAggregator) forEachRowCount(b *lsmkv.Bucket,
+	fn func(k []byte, count uint64) error,
+) error {
+	if b.Strategy() == lsmkv.StrategyRoaringSet {
+		c := b.CursorRoaringSet()
+		defer c.Close()
+
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			if err := fn(k, v.GetCardinality()); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	c := b.SetCursor()
 	defer c.Close()
 
 	for k, v := c.First(); k != nil; k, v = c.Next() {
-		err := parseFn(agg, k, v)
-		if err != nil {
-			return nil, err
+		if err := fn(k, uint64(len(v))); err != nil {
+			return err
 		}
 	}
 
-	out.BooleanAggregation = agg.Res()
-
-	return &out, nil
+	return nil
 }
 
-func (ua unfilteredAggregator) parseAndAddBoolRow(agg *boolAggregator, k []byte, v [][]byte) error {
+func (ua unfilteredAggregator) parseAndAddBoolRow(agg *boolAggregator, k []byte, count uint64) error {
 	if len(k) != 1 {
 		// we expect to see a single byte for a marshalled bool
 		return fmt.Errorf("unexpected key length on inverted index, "+
 			"expected 1: got %d", len(k))
 	}
 
-	if err := agg.AddBoolRow(k, uint64(len(v))); err != nil {
+	if err := agg.AddBoolRow(k, count); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (ua unfilteredAggregator) parseAndAddBoolArrayRow(agg *boolAggregator, k []byte, v [][]byte) error {
+func (ua unfilteredAggregator) parseAndAddBoolArrayRow(agg *boolAggregator, k []byte, count uint64) error {
 	values := make([][]byte, len(k))
 	for i := range k {
 		values[i] = []byte{k[i]}
@@ -108,13 +136,11 @@ func (ua unfilteredAggregator) floatProperty(ctx context.Context,
 
 	agg := newNumericalAggregator()
 
-	c := b.SetCursor() // flat never has a frequency, so it's always a Set
-	defer c.Close()
-
-	for k, v := c.First(); k != nil; k, v = c.Next() {
-		if err := ua.parseAndAddFloatRow(agg, k, v); err != nil {
-			return nil, err
-		}
+	// flat never has a frequency, so it's always a Set or RoaringSet
+	if err := ua.forEachRowCount(b, func(k []byte, count uint64) error {
+		return ua.parseAndAddFloatRow(agg, k, count)
+	}); err != nil {
+		return nil, err
 	}
 
 	addNumericalAggregations(&out, prop.Aggregators, agg)
@@ -137,13 +163,11 @@ func (ua unfilteredAggregator) intProperty(ctx context.Context,
 
 	agg := newNumericalAggregator()
 
-	c := b.SetCursor() // int never has a frequency, so it's always a Set
-	defer c.Close()
-
-	for k, v := c.First(); k != nil; k, v = c.Next() {
-		if err := ua.parseAndAddIntRow(agg, k, v); err != nil {
-			return nil, err
-		}
+	// int never has a frequency, so it's always a Set or RoaringSet
+	if err := ua.forEachRowCount(b, func(k []byte, count uint64) error {
+		return ua.parseAndAddIntRow(agg, k, count)
+	}); err != nil {
+		return nil, err
 	}
 
 	addNumericalAggregations(&out, prop.Aggregators, agg)
@@ -166,13 +190,11 @@ func (ua unfilteredAggregator) dateProperty(ctx context.Context,
 
 	agg := newDateAggregator()
 
-	c := b.SetCursor() // dates don't have frequency, so it's always a Set
-	defer c.Close()
-
-	for k, v := c.First(); k != nil; k, v = c.Next() {
-		if err := ua.parseAndAddDateRow(agg, k, v); err != nil {
-			return nil, err
-		}
+	// dates don't have frequency, so it's always a Set or RoaringSet
+	if err := ua.forEachRowCount(b, func(k []byte, count uint64) error {
+		return ua.parseAndAddDateRow(agg, k, count)
+	}); err != nil {
+		return nil, err
 	}
 
 	addDateAggregations(&out, prop.Aggregators, agg)
@@ -181,7 +203,7 @@ func (ua unfilteredAggregator) dateProperty(ctx context.Context,
 }
 
 func (ua unfilteredAggregator) parseAndAddDateRow(agg *dateAggregator, k []byte,
-	v [][]byte,
+	count uint64,
 ) error {
 	if len(k) != 8 {
 		// dates are stored as epoch nanoseconds, we expect to see an int64
@@ -189,7 +211,7 @@ func (ua unfilteredAggregator) parseAndAddDateRow(agg *dateAggregator, k []byte,
 			"expected 8: got %d", len(k))
 	}
 
-	if err := agg.AddTimestampRow(k, uint64(len(v))); err != nil {
+	if err := agg.AddTimestampRow(k, count); err != nil {
 		return err
 	}
 
@@ -247,7 +269,7 @@ func (ua unfilteredAggregator) parseAndAddDateArrayRow(agg *dateAggregator,
 }
 
 func (ua unfilteredAggregator) parseAndAddFloatRow(agg *numericalAggregator, k []byte,
-	v [][]byte,
+	count uint64,
 ) error {
 	if len(k) != 8 {
 		// we expect to see either an int64 or a float64, so any non-8 length
@@ -256,7 +278,7 @@ func (ua unfilteredAggregator) parseAndAddFloatRow(agg *numericalAggregator, k [
 			"expected 8: got %d", len(k))
 	}
 
-	if err := agg.AddFloat64Row(k, uint64(len(v))); err != nil {
+	if err := agg.AddFloat64Row(k, count); err != nil {
 		return err
 	}
 
@@ -264,7 +286,7 @@ func (ua unfilteredAggregator) parseAndAddFloatRow(agg *numericalAggregator, k [
 }
 
 func (ua unfilteredAggregator) parseAndAddIntRow(agg *numericalAggregator, k []byte,
-	v [][]byte,
+	count uint64,
 ) error {
 	if len(k) != 8 {
 		// we expect to see either an int64 or a float64, so any non-8 length
@@ -273,7 +295,7 @@ func (ua unfilteredAggregator) parseAndAddIntRow(agg *numericalAggregator, k []b
 			"expected 8: got %d", len(k))
 	}
 
-	if err := agg.AddInt64Row(k, uint64(len(v))); err != nil {
+	if err := agg.AddInt64Row(k, count); err != nil {
 		return err
 	}
 
@@ -91,12 +91,17 @@ func (db *DB) ClassExists(name string) bool {
 	return db.GetIndex(schema.ClassName(name)) != nil
 }
 
+// Shards returns the nodes which take part in backing up the class. Every
+// replica of a shard is backed up by the node holding it, so that a restore
+// brings back the data of all replicas and not only of one of them.
 func (db *DB) Shards(ctx context.Context, class string) []string {
 	unique := make(map[string]struct{})
 
 	ss := db.schemaGetter.ShardingState(class)
 	for _, shard := range ss.Physical {
-		unique[shard.BelongsToNode] = struct{}{}
+		for _, node := range shard.BelongsToNodes {
+			unique[node] = struct{}{}
+		}
 	}
 
 	var (
@@ -133,6 +138,9 @@ func (i *Index) descriptor(ctx context.Context, backupid string, desc *backup.Cl
 			i.resetBackupOnFailedCreate(ctx, err)
 		}
 	}()
+
+	i.shardsLock.RLock()
+	defer i.shardsLock.RUnlock()
 	for _, s := range i.Shards {
 		if err = s.beginBackup(ctx); err != nil {
 			return fmt.Errorf("pause compaction and flush: %w", err)
@@ -202,12 +210,14 @@ func (i *Index) resetBackupState() {
 func (i *Index) resumeMaintenanceCycles(ctx context.Context) error {
 	var g errgroup.Group
 
+	i.shardsLock.RLock()
 	for _, shard := range i.Shards {
 		s := shard
 		g.Go(func() error {
 			return s.resumeMaintenanceCycles(ctx)
 		})
 	}
+	i.shardsLock.RUnlock()
 
 	if err := g.Wait(); err != nil {
 		return errors.Wrap(err, "resume maintenance cycles")
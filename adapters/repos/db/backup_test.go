@@ -0,0 +1,55 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/sharding"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackupReplicatedClass(t *testing.T) {
+	// replication factor 2: every shard is held by two nodes
+	ss := &sharding.State{
+		IndexID: "replicated",
+		Physical: map[string]sharding.Physical{
+			"shard1": {Name: "shard1", BelongsToNodes: []string{"node1", "node2"}},
+			"shard2": {Name: "shard2", BelongsToNodes: []string{"node2", "node1"}},
+			"shard3": {Name: "shard3", BelongsToNodes: []string{"node2", "node3"}},
+		},
+	}
+	ss.SetLocalName("node1")
+	sg := &fakeSchemaGetter{shardState: ss}
+
+	t.Run("every replica of a shard is backed up", func(t *testing.T) {
+		db := &DB{schemaGetter: sg}
+		assert.ElementsMatch(t, []string{"node1", "node2", "node3"},
+			db.Shards(context.Background(), "Replicated"))
+	})
+
+	t.Run("the local replicas are stored under the local node", func(t *testing.T) {
+		index := &Index{
+			getSchema: sg,
+			Config:    IndexConfig{ClassName: schema.ClassName("Replicated")},
+		}
+
+		// node1 holds replicas of shard1 and shard2, both are backed up by it
+		assert.Equal(t, "node1", (&Shard{name: "shard1", index: index}).nodeName())
+		assert.Equal(t, "node1", (&Shard{name: "shard2", index: index}).nodeName())
+	})
+}
@@ -15,6 +15,7 @@ import (
 	"context"
 
 	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/additional"
 	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/entities/storobj"
 	"github.com/semi-technologies/weaviate/usecases/objects"
@@ -25,7 +26,9 @@ type batchQueue struct {
 	originalIndex []int
 }
 
-func (db *DB) BatchPutObjects(ctx context.Context, objects objects.BatchObjects) (objects.BatchObjects, error) {
+func (db *DB) BatchPutObjects(ctx context.Context, objects objects.BatchObjects,
+	repl *additional.ReplicationProperties,
+) (objects.BatchObjects, error) {
 	byIndex := map[string]batchQueue{}
 	db.indexLock.Lock()
 	defer db.indexLock.Unlock()
@@ -50,7 +53,7 @@ func (db *DB) BatchPutObjects(ctx context.Context, objects objects.BatchObjects)
 	}
 
 	for indexID, queue := range byIndex {
-		errs := db.indices[indexID].putObjectBatch(ctx, queue.objects)
+		errs := db.indices[indexID].putObjectBatch(ctx, queue.objects, repl)
 		for index, err := range errs {
 			if err != nil {
 				objects[queue.originalIndex[index]].Err = err
@@ -61,7 +64,9 @@ func (db *DB) BatchPutObjects(ctx context.Context, objects objects.BatchObjects)
 	return objects, nil
 }
 
-func (db *DB) AddBatchReferences(ctx context.Context, references objects.BatchReferences) (objects.BatchReferences, error) {
+func (db *DB) AddBatchReferences(ctx context.Context, references objects.BatchReferences,
+	repl *additional.ReplicationProperties,
+) (objects.BatchReferences, error) {
 	byIndex := map[string]objects.BatchReferences{}
 	db.indexLock.Lock()
 	defer db.indexLock.Unlock()
@@ -83,7 +88,7 @@ func (db *DB) AddBatchReferences(ctx context.Context, references objects.BatchRe
 	}
 
 	for indexID, queue := range byIndex {
-		errs := db.indices[indexID].addReferencesBatch(ctx, queue)
+		errs := db.indices[indexID].addReferencesBatch(ctx, queue, repl)
 		for index, err := range errs {
 			if err != nil {
 				references[queue[index].OriginalIndex].Err = err
@@ -94,11 +99,13 @@ func (db *DB) AddBatchReferences(ctx context.Context, references objects.BatchRe
 	return references, nil
 }
 
-func (db *DB) BatchDeleteObjects(ctx context.Context, params objects.BatchDeleteParams) (objects.BatchDeleteResult, error) {
+func (db *DB) BatchDeleteObjects(ctx context.Context, params objects.BatchDeleteParams,
+	repl *additional.ReplicationProperties,
+) (objects.BatchDeleteResult, error) {
 	// get index for a given class
 	idx := db.GetIndex(params.ClassName)
 	// find all DocIDs in all shards that match the filter
-	shardDocIDs, err := idx.findDocIDs(ctx, params.Filters)
+	shardDocIDs, err := idx.findDocIDs(ctx, params.Filters, params.Tenant)
 	if err != nil {
 		return objects.BatchDeleteResult{}, errors.Wrapf(err, "cannot find objects")
 	}
@@ -119,7 +126,7 @@ func (db *DB) BatchDeleteObjects(ctx context.Context, params objects.BatchDelete
 		matches += docIDsLength
 	}
 	// delete the DocIDs in given shards
-	deletedObjects, err := idx.batchDeleteObjects(ctx, toDelete, params.DryRun)
+	deletedObjects, err := idx.batchDeleteObjects(ctx, toDelete, params.DryRun, repl)
 	if err != nil {
 		return objects.BatchDeleteResult{}, errors.Wrapf(err, "cannot delete objects")
 	}
@@ -223,7 +223,7 @@ func delete2Objects(t *testing.T, repo *DB, className string) {
 			},
 			DryRun: false,
 			Output: "verbose",
-		})
+		}, nil)
 	require.Nil(t, err)
 	require.Equal(t, 2, len(batchDeleteRes.Objects), "Objects deleted")
 
@@ -388,7 +388,7 @@ func testBatchImportObjectsNoVector(repo *DB) func(t *testing.T) {
 			}
 
 			t.Run("can import", func(t *testing.T) {
-				batchRes, err := repo.BatchPutObjects(context.Background(), batch)
+				batchRes, err := repo.BatchPutObjects(context.Background(), batch, nil)
 				require.Nil(t, err)
 
 				assert.Nil(t, batchRes[0].Err)
@@ -424,7 +424,7 @@ func simpleInsertObjects(t *testing.T, repo *DB, class string, count int) {
 		}
 	}
 
-	repo.BatchPutObjects(context.Background(), batch)
+	repo.BatchPutObjects(context.Background(), batch, nil)
 }
 
 func testBatchImportObjects(repo *DB) func(t *testing.T) {
@@ -473,7 +473,7 @@ func testBatchImportObjects(repo *DB) func(t *testing.T) {
 			}
 
 			t.Run("can import", func(t *testing.T) {
-				batchRes, err := repo.BatchPutObjects(context.Background(), batch)
+				batchRes, err := repo.BatchPutObjects(context.Background(), batch, nil)
 				require.Nil(t, err)
 
 				assert.Nil(t, batchRes[0].Err)
@@ -557,7 +557,7 @@ func testBatchImportObjects(repo *DB) func(t *testing.T) {
 			}
 
 			t.Run("can import", func(t *testing.T) {
-				batchRes, err := repo.BatchPutObjects(context.Background(), batch)
+				batchRes, err := repo.BatchPutObjects(context.Background(), batch, nil)
 				require.Nil(t, err, "there shouldn't be an overall error, only inividual ones")
 
 				t.Run("element errors are marked correctly", func(t *testing.T) {
@@ -654,7 +654,7 @@ func testBatchImportObjects(repo *DB) func(t *testing.T) {
 			}
 
 			t.Run("can import", func(t *testing.T) {
-				batchRes, err := repo.BatchPutObjects(context.Background(), batch)
+				batchRes, err := repo.BatchPutObjects(context.Background(), batch, nil)
 				require.Nil(t, err, "there shouldn't be an overall error, only inividual ones")
 
 				t.Run("element errors are marked correctly", func(t *testing.T) {
@@ -711,7 +711,7 @@ func testBatchImportObjects(repo *DB) func(t *testing.T) {
 				ctx, cancel := context.WithTimeout(context.Background(), 1*time.Millisecond)
 				defer cancel()
 
-				batchRes, err := repo.BatchPutObjects(ctx, batch)
+				batchRes, err := repo.BatchPutObjects(ctx, batch, nil)
 				require.Nil(t, err, "there shouldn't be an overall error, only inividual ones")
 
 				t.Run("some elements have error'd due to context", func(t *testing.T) {
@@ -767,7 +767,7 @@ func testBatchImportGeoObjects(repo *DB) func(t *testing.T) {
 					}
 				}
 
-				res, err := repo.BatchPutObjects(context.Background(), batch)
+				res, err := repo.BatchPutObjects(context.Background(), batch, nil)
 				require.Nil(t, err)
 				assertAllItemsErrorFree(t, res)
 			}
@@ -849,7 +849,7 @@ func testBatchImportGeoObjects(repo *DB) func(t *testing.T) {
 					}
 				}
 
-				res, err := repo.BatchPutObjects(context.Background(), batch)
+				res, err := repo.BatchPutObjects(context.Background(), batch, nil)
 				require.Nil(t, err)
 				assertAllItemsErrorFree(t, res)
 			}
@@ -939,7 +939,7 @@ func testBatchDeleteObjects(repo *DB) func(t *testing.T) {
 			require.True(t, beforeDelete > 0)
 			// dryRun == true, only test how many objects can be deleted
 			batchDeleteRes, err := repo.BatchDeleteObjects(context.Background(),
-				getParams(true, "verbose"))
+				getParams(true, "verbose"), nil)
 			cacheSizeAfter := filterCacheSize(repo.GetIndex(schema.ClassName("ThingForBatching")))
 			require.Nil(t, err)
 			require.Equal(t, int64(beforeDelete), batchDeleteRes.Matches)
@@ -961,7 +961,7 @@ func testBatchDeleteObjects(repo *DB) func(t *testing.T) {
 			require.True(t, beforeDelete > 0)
 			// dryRun == true, only test how many objects can be deleted
 			batchDeleteRes, err := repo.BatchDeleteObjects(context.Background(),
-				getParams(true, "minimal"))
+				getParams(true, "minimal"), nil)
 			require.Nil(t, err)
 			require.Equal(t, int64(beforeDelete), batchDeleteRes.Matches)
 			require.Equal(t, beforeDelete, len(batchDeleteRes.Objects))
@@ -1015,7 +1015,7 @@ func testBatchDeleteObjects(repo *DB) func(t *testing.T) {
 					},
 					DryRun: false,
 					Output: "verbose",
-				})
+				}, nil)
 			cacheSizeAfter := filterCacheSize(repo.GetIndex(schema.ClassName("ThingForBatching")))
 			require.Nil(t, err)
 			require.Equal(t, int64(2), batchDeleteRes.Matches)
@@ -1038,7 +1038,7 @@ func testBatchDeleteObjects(repo *DB) func(t *testing.T) {
 			require.True(t, beforeDelete > 0)
 			// dryRun == true, only test how many objects can be deleted
 			batchDeleteRes, err := repo.BatchDeleteObjects(context.Background(),
-				getParams(false, "verbose"))
+				getParams(false, "verbose"), nil)
 			cacheSizeAfter := filterCacheSize(repo.GetIndex(schema.ClassName("ThingForBatching")))
 			require.Nil(t, err)
 			require.Equal(t, int64(beforeDelete), batchDeleteRes.Matches)
@@ -1084,7 +1084,7 @@ func testBatchDeleteObjectsJourney(repo *DB, queryMaximumResults int64) func(t *
 		t.Run("batch delete journey", func(t *testing.T) {
 			// delete objects to limit
 			batchDeleteRes, err := repo.BatchDeleteObjects(context.Background(),
-				getParams(true, "verbose"))
+				getParams(true, "verbose"), nil)
 			require.Nil(t, err)
 			objectsMatches := batchDeleteRes.Matches
 
@@ -1094,7 +1094,7 @@ func testBatchDeleteObjectsJourney(repo *DB, queryMaximumResults int64) func(t *
 			for true {
 				// delete objects to limit
 				batchDeleteRes, err := repo.BatchDeleteObjects(context.Background(),
-					getParams(false, "verbose"))
+					getParams(false, "verbose"), nil)
 				require.Nil(t, err)
 				matches, deleted := batchDeleteRes.Matches, len(batchDeleteRes.Objects)
 				require.Equal(t, leftToDelete, matches)
@@ -1102,7 +1102,7 @@ func testBatchDeleteObjectsJourney(repo *DB, queryMaximumResults int64) func(t *
 				deletedObjectsCount += deleted
 
 				batchDeleteRes, err = repo.BatchDeleteObjects(context.Background(),
-					getParams(true, "verbose"))
+					getParams(true, "verbose"), nil)
 				require.Nil(t, err)
 				leftToDelete = batchDeleteRes.Matches
 
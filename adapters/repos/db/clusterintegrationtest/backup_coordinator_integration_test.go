@@ -82,7 +82,7 @@ func TestDistributedBackups(t *testing.T) {
 			for _, obj := range data {
 				node := nodes[rand.Intn(len(nodes))]
 
-				err := node.repo.PutObject(context.Background(), obj, obj.Vector)
+				err := node.repo.PutObject(context.Background(), obj, obj.Vector, nil)
 				require.Nil(t, err)
 			}
 		})
@@ -91,7 +91,7 @@ func TestDistributedBackups(t *testing.T) {
 			for _, obj := range refData {
 				node := nodes[rand.Intn(len(nodes))]
 
-				err := node.repo.PutObject(context.Background(), obj, obj.Vector)
+				err := node.repo.PutObject(context.Background(), obj, obj.Vector, nil)
 				require.Nil(t, err)
 			}
 		})
@@ -280,6 +280,18 @@ func (f *fakeBackupBackend) Initialize(ctx context.Context, backupID string) err
 	return nil
 }
 
+func (f *fakeBackupBackend) ListBackups(ctx context.Context) ([]string, error) {
+	f.Lock()
+	defer f.Unlock()
+	return []string{f.backupID}, nil
+}
+
+func (f *fakeBackupBackend) DeleteBackup(ctx context.Context, backupID string) error {
+	f.Lock()
+	defer f.Unlock()
+	return nil
+}
+
 func (f *fakeBackupBackend) successGlobalMeta() backup.DistributedBackupDescriptor {
 	return backup.DistributedBackupDescriptor{
 		StartedAt: f.startedAt,
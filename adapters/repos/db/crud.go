@@ -30,7 +30,7 @@ import (
 )
 
 func (d *DB) PutObject(ctx context.Context, obj *models.Object,
-	vector []float32,
+	vector []float32, repl *additional.ReplicationProperties,
 ) error {
 	object := storobj.FromObject(obj, vector)
 	idx := d.GetIndex(object.Class())
@@ -38,7 +38,7 @@ func (d *DB) PutObject(ctx context.Context, obj *models.Object,
 		return fmt.Errorf("import into non-existing index for %s", object.Class())
 	}
 
-	if err := idx.putObject(ctx, object); err != nil {
+	if err := idx.putObject(ctx, object, repl); err != nil {
 		return errors.Wrapf(err, "import into index %s", idx.ID())
 	}
 
@@ -46,13 +46,15 @@ func (d *DB) PutObject(ctx context.Context, obj *models.Object,
 }
 
 // DeleteObject from of a specific class giving its ID
-func (d *DB) DeleteObject(ctx context.Context, class string, id strfmt.UUID) error {
+func (d *DB) DeleteObject(ctx context.Context, class string, id strfmt.UUID,
+	repl *additional.ReplicationProperties, tenant string,
+) error {
 	idx := d.GetIndex(schema.ClassName(class))
 	if idx == nil {
 		return fmt.Errorf("delete from non-existing index for %s", class)
 	}
 
-	err := idx.deleteObject(ctx, id)
+	err := idx.deleteObject(ctx, id, repl, tenant)
 	if err != nil {
 		return errors.Wrapf(err, "delete from index %s", idx.ID())
 	}
@@ -77,6 +79,12 @@ func (d *DB) MultiGet(ctx context.Context,
 				continue
 			}
 
+			// objects of a multi-tenant class can't be addressed by their id
+			// alone, so there is no way to resolve a reference to them
+			if index.partitioningEnabled() {
+				continue
+			}
+
 			queue := byIndex[index.ID()]
 			queue = append(queue, q)
 			byIndex[index.ID()] = queue
@@ -132,7 +140,12 @@ func (d *DB) ObjectsByID(ctx context.Context, id strfmt.UUID,
 	d.indexLock.Lock()
 
 	for _, index := range d.indices {
-		res, err := index.objectByID(ctx, id, props, additional)
+		if index.partitioningEnabled() {
+			// a multi-tenant class can only be searched with a tenant
+			continue
+		}
+
+		res, err := index.objectByID(ctx, id, props, additional, nil, "")
 		if err != nil {
 			d.indexLock.Unlock()
 			return nil, errors.Wrapf(err, "search index %s", index.ID())
@@ -155,14 +168,15 @@ func (d *DB) ObjectsByID(ctx context.Context, id strfmt.UUID,
 // Object gets object with id from index of specified class.
 func (d *DB) Object(ctx context.Context, class string,
 	id strfmt.UUID, props search.SelectProperties,
-	adds additional.Properties,
+	adds additional.Properties, repl *additional.ReplicationProperties,
+	tenant string,
 ) (*search.Result, error) {
 	idx := d.GetIndex(schema.ClassName(class))
 	if idx == nil {
 		return nil, nil
 	}
 
-	obj, err := idx.objectByID(ctx, id, props, adds)
+	obj, err := idx.objectByID(ctx, id, props, adds, repl, tenant)
 	if err != nil {
 		return nil, errors.Wrapf(err, "search index %s", idx.ID())
 	}
@@ -173,6 +187,7 @@ func (d *DB) Object(ctx context.Context, class string,
 	if r == nil {
 		return nil, nil
 	}
+	r.Tenant = tenant
 	return d.enrichRefsForSingle(ctx, r, props, adds)
 }
 
@@ -188,25 +203,34 @@ func (d *DB) enrichRefsForSingle(ctx context.Context, obj *search.Result,
 	return &res[0], nil
 }
 
-func (d *DB) Exists(ctx context.Context, class string, id strfmt.UUID) (bool, error) {
+func (d *DB) Exists(ctx context.Context, class string, id strfmt.UUID,
+	repl *additional.ReplicationProperties, tenant string,
+) (bool, error) {
 	if class == "" {
-		return d.anyExists(ctx, id)
+		return d.anyExists(ctx, id, repl)
 	}
 	index := d.GetIndex(schema.ClassName(class))
 	if index == nil {
 		return false, nil
 	}
-	return index.exists(ctx, id)
+	return index.exists(ctx, id, repl, tenant)
 }
 
-func (d *DB) anyExists(ctx context.Context, id strfmt.UUID) (bool, error) {
+func (d *DB) anyExists(ctx context.Context, id strfmt.UUID,
+	repl *additional.ReplicationProperties,
+) (bool, error) {
 	// TODO: Search in parallel, rather than sequentially or this will be
 	// painfully slow on large schemas
 	d.indexLock.Lock()
 	defer d.indexLock.Unlock()
 
 	for _, index := range d.indices {
-		ok, err := index.exists(ctx, id)
+		if index.partitioningEnabled() {
+			// a multi-tenant class can only be searched with a tenant
+			continue
+		}
+
+		ok, err := index.exists(ctx, id, repl, "")
 		if err != nil {
 			return false, errors.Wrapf(err, "search index %s", index.ID())
 		}
@@ -220,7 +244,8 @@ func (d *DB) anyExists(ctx context.Context, id strfmt.UUID) (bool, error) {
 
 func (d *DB) AddReference(ctx context.Context,
 	className string, source strfmt.UUID, propName string,
-	ref *models.SingleRef,
+	ref *models.SingleRef, repl *additional.ReplicationProperties,
+	tenant string,
 ) error {
 	target, err := crossref.ParseSingleRef(ref)
 	if err != nil {
@@ -235,19 +260,23 @@ func (d *DB) AddReference(ctx context.Context,
 			objects.BatchReference{
 				From: crossref.NewSource(schema.ClassName(className),
 					schema.PropertyName(propName), source),
-				To: target,
+				To:     target,
+				Tenant: tenant,
 			},
 		},
-	})
+		Tenant: tenant,
+	}, repl)
 }
 
-func (d *DB) Merge(ctx context.Context, merge objects.MergeDocument) error {
+func (d *DB) Merge(ctx context.Context, merge objects.MergeDocument,
+	repl *additional.ReplicationProperties,
+) error {
 	idx := d.GetIndex(schema.ClassName(merge.Class))
 	if idx == nil {
 		return fmt.Errorf("merge from non-existing index for %s", merge.Class)
 	}
 
-	err := idx.mergeObject(ctx, merge)
+	err := idx.mergeObject(ctx, merge, repl)
 	if err != nil {
 		return errors.Wrapf(err, "merge into index %s", idx.ID())
 	}
@@ -63,7 +63,7 @@ func TestDeleteJourney(t *testing.T) {
 
 	t.Run("import some objects", func(t *testing.T) {
 		for _, res := range updateTestData() {
-			err := repo.PutObject(context.Background(), res.Object(), res.Vector)
+			err := repo.PutObject(context.Background(), res.Object(), res.Vector, nil)
 			require.Nil(t, err)
 		}
 	})
@@ -132,7 +132,7 @@ func TestDeleteJourney(t *testing.T) {
 		func(t *testing.T) {
 			id := updateTestData()[0].ID
 
-			err := repo.DeleteObject(context.Background(), "UpdateTestClass", id)
+			err := repo.DeleteObject(context.Background(), "UpdateTestClass", id, nil, "")
 			require.Nil(t, err)
 		})
 
@@ -174,7 +174,7 @@ func TestDeleteJourney(t *testing.T) {
 		func(t *testing.T) {
 			id := updateTestData()[1].ID
 
-			err := repo.DeleteObject(context.Background(), "UpdateTestClass", id)
+			err := repo.DeleteObject(context.Background(), "UpdateTestClass", id, nil, "")
 			require.Nil(t, err)
 		})
 
@@ -228,7 +228,7 @@ func TestDeleteJourney(t *testing.T) {
 
 		id := updateTestData()[2].ID
 
-		err = repo.DeleteObject(context.Background(), "UpdateTestClass", id)
+		err = repo.DeleteObject(context.Background(), "UpdateTestClass", id, nil, "")
 		require.Nil(t, err)
 
 		index := repo.GetIndex("UpdateTestClass")
@@ -117,14 +117,14 @@ func TestNullArrayClass(t *testing.T) {
 			}
 
 			if name == names[0] {
-				assert.Nil(t, repo.PutObject(context.Background(), arrayObjNil, []float32{1}))
-				assert.Nil(t, repo.PutObject(context.Background(), arrayObjEmpty, []float32{1}))
+				assert.Nil(t, repo.PutObject(context.Background(), arrayObjNil, []float32{1}, nil))
+				assert.Nil(t, repo.PutObject(context.Background(), arrayObjEmpty, []float32{1}, nil))
 
 			} else {
 				batch := make([]objects.BatchObject, 2)
 				batch[0] = objects.BatchObject{Object: arrayObjNil, UUID: arrayObjNil.ID}
 				batch[1] = objects.BatchObject{Object: arrayObjEmpty, UUID: arrayObjEmpty.ID}
-				_, err := repo.BatchPutObjects(context.Background(), batch)
+				_, err := repo.BatchPutObjects(context.Background(), batch, nil)
 				assert.Nil(t, err)
 			}
 
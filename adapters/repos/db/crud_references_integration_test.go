@@ -207,7 +207,7 @@ func TestNestedReferences(t *testing.T) {
 		for _, thing := range objects {
 			t.Run(fmt.Sprintf("add %s", thing.ID), func(t *testing.T) {
 				err := repo.PutObject(context.Background(), &thing,
-					[]float32{1, 2, 3, 4, 5, 6, 7})
+					[]float32{1, 2, 3, 4, 5, 6, 7}, nil)
 				require.Nil(t, err)
 			})
 		}
@@ -381,7 +381,7 @@ func TestNestedReferences(t *testing.T) {
 			CreationTimeUnix: 1566464912,
 		}
 
-		err := repo.PutObject(context.Background(), &newPlace, []float32{1, 2, 3, 4, 5, 6, 7})
+		err := repo.PutObject(context.Background(), &newPlace, []float32{1, 2, 3, 4, 5, 6, 7}, nil)
 		require.Nil(t, err)
 	})
 }
@@ -613,7 +613,7 @@ func Test_AddingReferenceOneByOne(t *testing.T) {
 			Properties: map[string]interface{}{
 				"name": "source item",
 			},
-		}, []float32{0.5})
+		}, []float32{0.5}, nil)
 		require.Nil(t, err)
 
 		err = repo.PutObject(context.Background(), &models.Object{
@@ -622,7 +622,7 @@ func Test_AddingReferenceOneByOne(t *testing.T) {
 			Properties: map[string]interface{}{
 				"name": "target item",
 			},
-		}, []float32{0.5})
+		}, []float32{0.5}, nil)
 
 		err = repo.PutObject(context.Background(), &models.Object{
 			ID:    target2ID,
@@ -630,7 +630,7 @@ func Test_AddingReferenceOneByOne(t *testing.T) {
 			Properties: map[string]interface{}{
 				"name": "another target item",
 			},
-		}, []float32{0.5})
+		}, []float32{0.5}, nil)
 		require.Nil(t, err)
 	})
 
@@ -642,7 +642,7 @@ func Test_AddingReferenceOneByOne(t *testing.T) {
 		err := repo.AddReference(context.Background(),
 			"AddingReferencesTestSource", sourceID, "toTarget", &models.SingleRef{
 				Beacon: strfmt.URI(fmt.Sprintf("weaviate://localhost/%s", targetID)),
-			})
+			}, nil, "")
 		assert.Nil(t, err)
 
 		// Check dimensions after adding reference
@@ -681,7 +681,7 @@ func Test_AddingReferenceOneByOne(t *testing.T) {
 		err := repo.AddReference(context.Background(),
 			"AddingReferencesTestSource", sourceID, "toTarget", &models.SingleRef{
 				Beacon: strfmt.URI(fmt.Sprintf("weaviate://localhost/%s", target2ID)),
-			})
+			}, nil, "")
 		assert.Nil(t, err)
 	})
 
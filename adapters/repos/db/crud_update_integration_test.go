@@ -71,7 +71,7 @@ func TestUpdateJourney(t *testing.T) {
 
 	t.Run("import some objects", func(t *testing.T) {
 		for _, res := range updateTestData() {
-			err := repo.PutObject(context.Background(), res.Object(), res.Vector)
+			err := repo.PutObject(context.Background(), res.Object(), res.Vector, nil)
 			require.Nil(t, err)
 		}
 	})
@@ -121,7 +121,7 @@ func TestUpdateJourney(t *testing.T) {
 			expectedOrder := []interface{}{
 				"element-0", "element-1", "element-2", "element-3",
 			}
-			assert.Equal(t, expectedOrder, searchInv(t, filters.OperatorGreaterThanEqual, 0))
+			assert.ElementsMatch(t, expectedOrder, searchInv(t, filters.OperatorGreaterThanEqual, 0))
 
 			expectedOrder = []interface{}{"element-0"}
 			assert.Equal(t, expectedOrder, searchInv(t, filters.OperatorEqual, 0))
@@ -146,7 +146,7 @@ func TestUpdateJourney(t *testing.T) {
 				additional.Properties{})
 			require.Nil(t, err)
 
-			err = repo.PutObject(context.Background(), old.Object(), updatedVec)
+			err = repo.PutObject(context.Background(), old.Object(), updatedVec, nil)
 			require.Nil(t, err)
 		})
 
@@ -172,7 +172,7 @@ func TestUpdateJourney(t *testing.T) {
 		expectedOrder := []interface{}{
 			"element-0", "element-1", "element-2", "element-3",
 		}
-		assert.Equal(t, expectedOrder, searchInv(t, filters.OperatorGreaterThanEqual, 0))
+		assert.ElementsMatch(t, expectedOrder, searchInv(t, filters.OperatorGreaterThanEqual, 0))
 
 		expectedOrder = []interface{}{"element-0"}
 		assert.Equal(t, expectedOrder, searchInv(t, filters.OperatorEqual, 0))
@@ -201,7 +201,7 @@ func TestUpdateJourney(t *testing.T) {
 
 			old.Schema.(map[string]interface{})["intProp"] = int64(21)
 
-			err = repo.PutObject(context.Background(), old.Object(), updatedVec)
+			err = repo.PutObject(context.Background(), old.Object(), updatedVec, nil)
 			require.Nil(t, err)
 		})
 
@@ -227,7 +227,7 @@ func TestUpdateJourney(t *testing.T) {
 		expectedOrder := []interface{}{
 			"element-0", "element-1", "element-2", "element-3",
 		}
-		assert.Equal(t, expectedOrder, searchInv(t, filters.OperatorGreaterThanEqual, 0))
+		assert.ElementsMatch(t, expectedOrder, searchInv(t, filters.OperatorGreaterThanEqual, 0))
 
 		expectedOrder = []interface{}{"element-0"}
 		assert.Equal(t, expectedOrder, searchInv(t, filters.OperatorEqual, 0))
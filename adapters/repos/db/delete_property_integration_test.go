@@ -0,0 +1,250 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/entities/additional"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	enthnsw "github.com/semi-technologies/weaviate/entities/vectorindex/hnsw"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteProperty(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	newRepo := func() *DB {
+		repo := New(logger, Config{
+			FlushIdleAfter:            60,
+			RootPath:                  dirName,
+			QueryMaximumResults:       10000,
+			MaxImportGoroutinesFactor: 1,
+		}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil)
+		repo.SetSchemaGetter(schemaGetter)
+		require.Nil(t, repo.WaitForStartup(testCtx()))
+		return repo
+	}
+	repo := newRepo()
+	defer func() { repo.Shutdown(context.Background()) }()
+	migrator := NewMigrator(repo, logger)
+
+	class := &models.Class{
+		Class:               "DeletePropertyTest",
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:         "keep",
+				DataType:     []string{string(schema.DataTypeText)},
+				Tokenization: "word",
+			},
+			{
+				Name:         "remove",
+				DataType:     []string{string(schema.DataTypeText)},
+				Tokenization: "word",
+			},
+		},
+	}
+
+	ids := []strfmt.UUID{
+		"8d5a3aa2-3c8d-4589-9ae1-3f638f506970",
+		"86a380e9-cb60-4b2a-bc48-51f52acd72d6",
+		"c7b7a3b4-3e7e-4c0d-8a0b-0c6a1a3f2b11",
+	}
+
+	putObject := func(t *testing.T, id strfmt.UUID, removeValue string) {
+		err := repo.PutObject(context.Background(), &models.Object{
+			Class: class.Class,
+			ID:    id,
+			Properties: map[string]interface{}{
+				"keep":   "some value",
+				"remove": removeValue,
+			},
+		}, []float32{1, 2, 3}, nil)
+		require.Nil(t, err)
+	}
+
+	t.Run("import objects", func(t *testing.T) {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+		schemaGetter.schema.Objects = &models.Schema{
+			Classes: []*models.Class{class},
+		}
+
+		for _, id := range ids {
+			putObject(t, id, "another value")
+		}
+	})
+
+	shard := func() *Shard {
+		idx := repo.GetIndex(schema.ClassName(class.Class))
+		require.NotNil(t, idx)
+		require.Len(t, idx.Shards, 1)
+		for _, shard := range idx.Shards {
+			return shard
+		}
+		return nil
+	}
+
+	bucketNames := []string{
+		helpers.BucketFromPropNameLSM("remove"),
+		helpers.HashBucketFromPropNameLSM("remove"),
+		helpers.BucketFromPropNameLSM("remove" + filters.InternalPropertyLength),
+		helpers.BucketFromPropNameLSM("remove" + filters.InternalNullIndex),
+	}
+
+	t.Run("verify the buckets of the prop exist", func(t *testing.T) {
+		for _, name := range bucketNames {
+			assert.NotNil(t, shard().store.Bucket(name), name)
+		}
+	})
+
+	propLengthMean := func(t *testing.T) float32 {
+		mean, err := shard().propLengths.PropertyMean("remove")
+		require.Nil(t, err)
+		return mean
+	}
+
+	t.Run("verify the lengths of the prop are tracked", func(t *testing.T) {
+		// "another value"
+		assert.Equal(t, float32(2), propLengthMean(t))
+	})
+
+	t.Run("drop the prop", func(t *testing.T) {
+		class.Properties = class.Properties[:1]
+		err := migrator.DropProperty(context.Background(), class.Class, "remove")
+		require.Nil(t, err)
+	})
+
+	t.Run("verify the buckets of the prop are gone", func(t *testing.T) {
+		for _, name := range bucketNames {
+			assert.Nil(t, shard().store.Bucket(name), name)
+		}
+		assert.NotNil(t, shard().store.Bucket(helpers.BucketFromPropNameLSM("keep")))
+	})
+
+	t.Run("verify the lengths of the prop are reset", func(t *testing.T) {
+		assert.Equal(t, float32(0), propLengthMean(t))
+	})
+
+	t.Run("wait for the values to be removed", func(t *testing.T) {
+		var deletions []*models.PropertyDeletionStatus
+		require.Eventually(t, func() bool {
+			statuses, err := repo.GetNodeStatuses(context.Background())
+			require.Nil(t, err)
+			require.Len(t, statuses, 1)
+			require.Len(t, statuses[0].Shards, 1)
+
+			deletions = statuses[0].Shards[0].PropertyDeletions
+			return len(deletions) == 1 &&
+				deletions[0].Status != models.PropertyDeletionStatusStatusINPROGRESS
+		}, 5*time.Second, 10*time.Millisecond)
+
+		assert.Equal(t, &models.PropertyDeletionStatus{
+			Property:         "remove",
+			Status:           models.PropertyDeletionStatusStatusDONE,
+			ObjectsProcessed: int64(len(ids)),
+		}, deletions[0])
+	})
+
+	t.Run("verify the values are removed from the objects", func(t *testing.T) {
+		for _, id := range ids {
+			res, err := repo.ObjectByID(context.Background(), id, nil,
+				additional.Properties{})
+			require.Nil(t, err)
+			require.NotNil(t, res)
+
+			props := res.Object().Properties.(map[string]interface{})
+			assert.Equal(t, "some value", props["keep"])
+			assert.NotContains(t, props, "remove")
+		}
+	})
+
+	t.Run("add the prop again and import new values", func(t *testing.T) {
+		removeProp := &models.Property{
+			Name:         "remove",
+			DataType:     []string{string(schema.DataTypeText)},
+			Tokenization: "word",
+		}
+		class.Properties = append(class.Properties, removeProp)
+		err := migrator.AddProperty(context.Background(), class.Class, removeProp)
+		require.Nil(t, err)
+
+		for _, id := range ids {
+			putObject(t, id, "a new value")
+		}
+	})
+
+	assertNewValues := func(t *testing.T) {
+		for _, id := range ids {
+			res, err := repo.ObjectByID(context.Background(), id, nil,
+				additional.Properties{})
+			require.Nil(t, err)
+			require.NotNil(t, res)
+
+			props := res.Object().Properties.(map[string]interface{})
+			assert.Equal(t, "a new value", props["remove"])
+		}
+
+		res, err := repo.ClassSearch(context.Background(), traverser.GetParams{
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: 10},
+			Filters: &filters.LocalFilter{
+				Root: &filters.Clause{
+					Operator: filters.OperatorEqual,
+					On: &filters.Path{
+						Class:    schema.ClassName(class.Class),
+						Property: "remove",
+					},
+					Value: &filters.Value{
+						Value: "new",
+						Type:  schema.DataTypeText,
+					},
+				},
+			},
+		})
+		require.Nil(t, err)
+		assert.Len(t, res, len(ids))
+
+		// only "a new value" is tracked, otherwise BM25 would still take the
+		// lengths of the deleted values into account
+		assert.Equal(t, float32(3), propLengthMean(t))
+	}
+
+	t.Run("verify the new values survive", func(t *testing.T) {
+		assertNewValues(t)
+	})
+
+	t.Run("verify the new values survive a restart", func(t *testing.T) {
+		require.Nil(t, repo.Shutdown(context.Background()))
+		repo = newRepo()
+
+		// the finished deletion is not persisted, so it is not resumed
+		assert.Empty(t, shard().propertyDeletions.list())
+		assertNewValues(t)
+	})
+}
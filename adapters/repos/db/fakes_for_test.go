@@ -62,7 +62,8 @@ func singleShardState() *sharding.State {
 		panic(err)
 	}
 
-	s, err := sharding.InitState("test-index", config, fakeNodes{[]string{"node1"}})
+	s, err := sharding.InitState("test-index", config,
+		fakeNodes{[]string{"node1"}}, 1, false)
 	if err != nil {
 		panic(err)
 	}
@@ -79,7 +80,7 @@ func multiShardState() *sharding.State {
 	}
 
 	s, err := sharding.InitState("multi-shard-test-index", config,
-		fakeNodes{[]string{"node1"}})
+		fakeNodes{[]string{"node1"}}, 1, false)
 	if err != nil {
 		panic(err)
 	}
@@ -97,7 +98,7 @@ func fixedMultiShardState() *sharding.State {
 	}
 
 	for name, shard := range s.Physical {
-		shard.BelongsToNode = "node1"
+		shard.BelongsToNodes = []string{"node1"}
 		s.Physical[name] = shard
 	}
 	return s
@@ -161,9 +162,9 @@ func (f *fakeRemoteClient) MultiGetObjects(ctx context.Context, hostName, indexN
 }
 
 func (f *fakeRemoteClient) SearchShard(ctx context.Context, hostName, indexName,
-	shardName string, vector []float32, limit int,
+	shardName string, vector []float32, targetVector string, limit int,
 	filters *filters.LocalFilter, _ *searchparams.KeywordRanking, sort []filters.Sort,
-	additional additional.Properties,
+	cursor *filters.Cursor, additional additional.Properties,
 ) ([]*storobj.Object, []float32, error) {
 	return nil, nil, nil
 }
@@ -192,6 +193,12 @@ func (f *fakeRemoteClient) DeleteObjectBatch(ctx context.Context, hostName, inde
 	return nil
 }
 
+func (f *fakeRemoteClient) DeleteObjects(ctx context.Context, hostName, indexName,
+	shardName string, ids []strfmt.UUID,
+) []error {
+	return nil
+}
+
 func (f *fakeRemoteClient) GetShardStatus(ctx context.Context,
 	hostName, indexName, shardName string,
 ) (string, error) {
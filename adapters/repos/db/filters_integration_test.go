@@ -26,6 +26,7 @@ import (
 	"github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/search"
 	enthnsw "github.com/semi-technologies/weaviate/entities/vectorindex/hnsw"
 	"github.com/semi-technologies/weaviate/usecases/traverser"
 	"github.com/sirupsen/logrus/hooks/test"
@@ -63,6 +64,9 @@ func TestFilters(t *testing.T) {
 	t.Run("chained primitive props",
 		testChainedPrimitiveProps(repo, migrator))
 
+	t.Run("composed length and null state filters",
+		testComposedLengthAndNullStateFilters(repo))
+
 	t.Run("sort props",
 		testSortProperties(repo))
 }
@@ -81,6 +85,10 @@ var (
 	or   = filters.OperatorOr
 	null = filters.OperatorIsNull
 
+	containsAny = filters.OperatorContainsAny
+	containsAll = filters.OperatorContainsAll
+	in          = filters.OperatorIn
+
 	// datatypes
 	dtInt            = schema.DataTypeInt
 	dtBool           = schema.DataTypeBoolean
@@ -89,6 +97,10 @@ var (
 	dtText           = schema.DataTypeText
 	dtDate           = schema.DataTypeDate
 	dtGeoCoordinates = schema.DataTypeGeoCoordinates
+	dtIntArray       = schema.DataTypeIntArray
+	dtNumberArray    = schema.DataTypeNumberArray
+	dtStringArray    = schema.DataTypeStringArray
+	dtDateArray      = schema.DataTypeDateArray
 )
 
 func prepareCarTestSchemaAndData(repo *DB,
@@ -108,7 +120,7 @@ func prepareCarTestSchemaAndData(repo *DB,
 		for i, fixture := range cars {
 			t.Run(fmt.Sprintf("importing car %d", i), func(t *testing.T) {
 				require.Nil(t,
-					repo.PutObject(context.Background(), &fixture, carVectors[i]))
+					repo.PutObject(context.Background(), &fixture, carVectors[i], nil))
 			})
 		}
 	}
@@ -454,6 +466,43 @@ func testPrimitiveProps(repo *DB) func(t *testing.T) {
 				filter:      buildFilter("len(description)", 109, eq, dtInt),
 				expectedIDs: []strfmt.UUID{carPoloID},
 			},
+			{
+				name:        "colorArrayField contains any of [dark, grey]",
+				filter:      buildFilter("colorArrayField", []string{"dark", "grey"}, containsAny, dtStringArray),
+				expectedIDs: []strfmt.UUID{carE63sID, carPoloID},
+			},
+			{
+				name:        "colorArrayField contains all of [dark, grey]",
+				filter:      buildFilter("colorArrayField", []string{"dark", "grey"}, containsAll, dtStringArray),
+				expectedIDs: []strfmt.UUID{carPoloID},
+			},
+			{
+				name:        "colorArrayField contains all of [dark, light grey]",
+				filter:      buildFilter("colorArrayField", []string{"dark", "light grey"}, containsAll, dtStringArray),
+				expectedIDs: []strfmt.UUID{},
+			},
+			{
+				name:        "horsepower in [100, 612, 1000]",
+				filter:      buildFilter("horsepower", []int{100, 612, 1000}, in, dtIntArray),
+				expectedIDs: []strfmt.UUID{carE63sID, carPoloID},
+			},
+			{
+				name:        "weight in [3499.90]",
+				filter:      buildFilter("weight", []float64{3499.90}, in, dtNumberArray),
+				expectedIDs: []strfmt.UUID{carSprinterID},
+			},
+			{
+				name: "released in [1975, 2017]",
+				filter: buildFilter("released", []string{
+					"1975-01-01T10:12:00+02:00", "2017-02-17T09:47:00+02:00",
+				}, in, dtDateArray),
+				expectedIDs: []strfmt.UUID{carE63sID, carPoloID},
+			},
+			{
+				name:        "id in [sprinter, polo]",
+				filter:      buildFilter("id", []string{carSprinterID.String(), carPoloID.String()}, in, dtStringArray),
+				expectedIDs: []strfmt.UUID{carSprinterID, carPoloID},
+			},
 		}
 
 		for _, test := range tests {
@@ -607,6 +656,77 @@ func testChainedPrimitiveProps(repo *DB,
 	}
 }
 
+func testComposedLengthAndNullStateFilters(repo *DB) func(t *testing.T) {
+	return func(t *testing.T) {
+		type test struct {
+			name        string
+			filter      *filters.LocalFilter
+			expectedIDs []strfmt.UUID
+		}
+
+		tests := []test{
+			{
+				name: "len(colorArrayField) == 2 and horsepower > 200",
+				filter: filterAnd(
+					buildFilter("len(colorArrayField)", 2, eq, dtInt),
+					buildFilter("horsepower", 200, gt, dtInt)),
+				expectedIDs: []strfmt.UUID{carE63sID},
+			},
+			{
+				name: "colorArrayField is null or horsepower == 130",
+				filter: filterOr(
+					buildFilter("colorArrayField", true, null, dtBool),
+					buildFilter("horsepower", 130, eq, dtInt)),
+				expectedIDs: []strfmt.UUID{carNilID, carSprinterID},
+			},
+			{
+				name: "description is not null and len(description) < 100",
+				filter: filterAnd(
+					buildFilter("description", false, null, dtBool),
+					buildFilter("len(description)", 100, lt, dtInt)),
+				expectedIDs: []strfmt.UUID{carE63sID},
+			},
+			{
+				name:        "len(colorArrayField) in [0, 1]",
+				filter:      buildFilter("len(colorArrayField)", []int{0, 1}, in, dtIntArray),
+				expectedIDs: []strfmt.UUID{carNilID, carSprinterID},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				params := traverser.GetParams{
+					ClassName:  carClass.Class,
+					Pagination: &filters.Pagination{Limit: 100},
+					Filters:    test.filter,
+				}
+
+				t.Run("object search", func(t *testing.T) {
+					res, err := repo.ClassSearch(context.Background(), params)
+					require.Nil(t, err)
+					assert.ElementsMatch(t, test.expectedIDs, resultIDs(res))
+				})
+
+				// the vector search builds a cacheable allow list
+				t.Run("vector search", func(t *testing.T) {
+					params.SearchVector = []float32{0.1, 0.1, 0.1, 1.1, 0.1}
+					res, err := repo.VectorClassSearch(context.Background(), params)
+					require.Nil(t, err)
+					assert.ElementsMatch(t, test.expectedIDs, resultIDs(res))
+				})
+			})
+		}
+	}
+}
+
+func resultIDs(res []search.Result) []strfmt.UUID {
+	ids := make([]strfmt.UUID, len(res))
+	for i := range res {
+		ids[i] = res[i].ID
+	}
+	return ids
+}
+
 func buildFilter(propName string, value interface{}, operator filters.Operator, schemaType schema.DataType) *filters.LocalFilter {
 	return &filters.LocalFilter{
 		Root: &filters.Clause{
@@ -870,22 +990,34 @@ func TestGeoPropUpdateJourney(t *testing.T) {
 						Longitude: &coordinates[i][1],
 					},
 				},
-			}, []float32{0.5})
+			}, []float32{0.5}, nil)
 		}
 	}
 
 	t.Run("import items", upsert)
 
-	t.Run("verify original order", func(t *testing.T) {
+	nearbyQuery := filters.GeoRange{
+		GeoCoordinates: searchQuery.GeoCoordinates,
+		Distance:       1000000, // 1000km
+	}
+
+	searchIDs := func(t *testing.T, query filters.GeoRange) []strfmt.UUID {
 		res, err := repo.ClassSearch(context.Background(),
 			getParamsWithFilter("GeoUpdateTestClass", buildFilter(
-				"location", searchQuery, wgr, schema.DataTypeGeoCoordinates,
+				"location", query, wgr, schema.DataTypeGeoCoordinates,
 			)))
-
 		require.Nil(t, err)
-		require.Len(t, res, 2)
-		assert.Equal(t, ids[0], res[0].ID)
-		assert.Equal(t, ids[1], res[1].ID)
+
+		out := make([]strfmt.UUID, len(res))
+		for i := range res {
+			out[i] = res[i].ID
+		}
+		return out
+	}
+
+	t.Run("verify original results", func(t *testing.T) {
+		assert.ElementsMatch(t, ids, searchIDs(t, searchQuery))
+		assert.ElementsMatch(t, ids, searchIDs(t, nearbyQuery))
 	})
 
 	coordinates = [][]float32{
@@ -896,18 +1028,11 @@ func TestGeoPropUpdateJourney(t *testing.T) {
 
 	t.Run("import items", upsert)
 
-	t.Run("verify updated order", func(t *testing.T) {
-		res, err := repo.ClassSearch(context.Background(),
-			getParamsWithFilter("GeoUpdateTestClass", buildFilter(
-				"location", searchQuery, wgr, schema.DataTypeGeoCoordinates,
-			)))
-
-		require.Nil(t, err)
-		require.Len(t, res, 2)
+	t.Run("verify updated results", func(t *testing.T) {
+		assert.ElementsMatch(t, ids, searchIDs(t, searchQuery))
 
-		// notice the opposite order
-		assert.Equal(t, ids[1], res[0].ID)
-		assert.Equal(t, ids[0], res[1].ID)
+		// item 0 was moved out of the nearby range
+		assert.ElementsMatch(t, []strfmt.UUID{ids[1]}, searchIDs(t, nearbyQuery))
 	})
 }
 
@@ -1011,7 +1136,7 @@ func TestCasingOfOperatorCombinations(t *testing.T) {
 		for i, obj := range objects {
 			t.Run(fmt.Sprintf("importing object %d", i), func(t *testing.T) {
 				require.Nil(t,
-					repo.PutObject(context.Background(), obj, obj.Vector))
+					repo.PutObject(context.Background(), obj, obj.Vector, nil))
 			})
 		}
 	})
@@ -1426,7 +1551,7 @@ func TestFilteringAfterDeletion(t *testing.T) {
 		for i, obj := range objects {
 			t.Run(fmt.Sprintf("importing object %d", i), func(t *testing.T) {
 				require.Nil(t,
-					repo.PutObject(context.Background(), obj, obj.Vector))
+					repo.PutObject(context.Background(), obj, obj.Vector, nil))
 			})
 		}
 	})
@@ -1456,7 +1581,7 @@ func TestFilteringAfterDeletion(t *testing.T) {
 	})
 
 	t.Run("Delete object and filter again", func(t *testing.T) {
-		repo.DeleteObject(context.Background(), "DeletionClass", UUID2)
+		repo.DeleteObject(context.Background(), "DeletionClass", UUID2, nil, "")
 
 		filterNil := buildFilter("other", true, null, dtBool)
 		paramsNil := traverser.GetParams{
@@ -79,7 +79,7 @@ func Test_LimitsOnChainedFilters(t *testing.T) {
 			t.Run(fmt.Sprintf("importing product %d", i), func(t *testing.T) {
 				require.Nil(t,
 					repo.PutObject(context.Background(), company,
-						[]float32{0.1, 0.2, 0.01, 0.2}))
+						[]float32{0.1, 0.2, 0.01, 0.2}, nil))
 			})
 		}
 	})
@@ -175,7 +175,7 @@ func Test_FilterLimitsAfterUpdates(t *testing.T) {
 			t.Run(fmt.Sprintf("importing product %d", i), func(t *testing.T) {
 				require.Nil(t,
 					repo.PutObject(context.Background(), company,
-						[]float32{0.1, 0.2, 0.01, 0.2}))
+						[]float32{0.1, 0.2, 0.01, 0.2}, nil))
 			})
 		}
 	})
@@ -218,7 +218,7 @@ func Test_FilterLimitsAfterUpdates(t *testing.T) {
 			t.Run(fmt.Sprintf("importing product %d", i), func(t *testing.T) {
 				require.Nil(t,
 					repo.PutObject(context.Background(), company,
-						[]float32{0.1, 0.21, 0.01, 0.2}))
+						[]float32{0.1, 0.21, 0.01, 0.2}, nil))
 			})
 		}
 	})
@@ -301,7 +301,7 @@ func Test_AggregationsAfterUpdates(t *testing.T) {
 			t.Run(fmt.Sprintf("importing product %d", i), func(t *testing.T) {
 				require.Nil(t,
 					repo.PutObject(context.Background(), company,
-						[]float32{0.1, 0.2, 0.01, 0.2}))
+						[]float32{0.1, 0.2, 0.01, 0.2}, nil))
 			})
 		}
 	})
@@ -329,7 +329,7 @@ func Test_AggregationsAfterUpdates(t *testing.T) {
 			t.Run(fmt.Sprintf("importing product %d", i), func(t *testing.T) {
 				require.Nil(t,
 					repo.PutObject(context.Background(), company,
-						[]float32{0.1, 0.21, 0.01, 0.2}))
+						[]float32{0.1, 0.21, 0.01, 0.2}, nil))
 			})
 		}
 	})
@@ -11,44 +11,101 @@
 
 package helpers
 
+import (
+	"github.com/RoaringBitmap/roaring/roaring64"
+)
+
 // AllowList groups a list of possible indexIDs to be passed to a secondary
 // index. The secondary index must make sure that it only returns result
-// present on the AllowList
-type AllowList map[uint64]struct{}
+// present on the AllowList.
+//
+// The ids are held in a compressed bitmap, so that even a broad filter
+// matching millions of doc ids only takes up a fraction of the memory a plain
+// set would.
+type AllowList interface {
+	Insert(ids ...uint64)
+	Contains(id uint64) bool
+	DeepCopy() AllowList
+	Slice() []uint64
+
+	IsEmpty() bool
+	Len() int
+	Iterator() AllowListIterator
+
+	// Size is the estimated memory footprint of the list in bytes
+	Size() uint64
+}
+
+type AllowListIterator interface {
+	Next() (uint64, bool)
+}
+
+// NewAllowList creates an allow list containing the specified ids
+func NewAllowList(ids ...uint64) AllowList {
+	bm := roaring64.New()
+	bm.AddMany(ids)
+	return NewAllowListFromBitmap(bm)
+}
+
+// NewAllowListFromBitmap wraps an existing bitmap without copying it, the
+// caller must not alter the bitmap afterwards
+func NewAllowListFromBitmap(bm *roaring64.Bitmap) AllowList {
+	return &bitmapAllowList{bm: bm}
+}
+
+type bitmapAllowList struct {
+	bm *roaring64.Bitmap
+}
 
 // Inserting and reading is not thread-safe. However, if inserting has
 // completed, and the list can be considered read-only, it is safe to read from
 // it concurrently
-func (al AllowList) Insert(id uint64) {
-	// no need to check if it's already present, simply overwrite
-	al[id] = struct{}{}
+func (al *bitmapAllowList) Insert(ids ...uint64) {
+	al.bm.AddMany(ids)
 }
 
 // Contains is not thread-safe if the list is still being filled. However, if
 // you can guarantee that the list is no longer being inserted into and it
 // effectively becomes read-only, you can safely read concurrently
-func (al AllowList) Contains(id uint64) bool {
-	_, ok := al[id]
-	return ok
+func (al *bitmapAllowList) Contains(id uint64) bool {
+	return al.bm.Contains(id)
 }
 
-func (al AllowList) DeepCopy() AllowList {
-	out := AllowList{}
+func (al *bitmapAllowList) DeepCopy() AllowList {
+	return NewAllowListFromBitmap(al.bm.Clone())
+}
 
-	for id := range al {
-		out[id] = struct{}{}
-	}
+// Slice returns the ids in ascending order
+func (al *bitmapAllowList) Slice() []uint64 {
+	return al.bm.ToArray()
+}
+
+func (al *bitmapAllowList) IsEmpty() bool {
+	return al.bm.IsEmpty()
+}
+
+func (al *bitmapAllowList) Len() int {
+	return int(al.bm.GetCardinality())
+}
+
+func (al *bitmapAllowList) Iterator() AllowListIterator {
+	return &bitmapAllowListIterator{it: al.bm.Iterator()}
+}
+
+func (al *bitmapAllowList) Size() uint64 {
+	return al.bm.GetSizeInBytes()
+}
 
-	return out
+type bitmapAllowListIterator struct {
+	it roaring64.IntPeekable64
 }
 
-func (al AllowList) Slice() []uint64 {
-	out := make([]uint64, len(al))
-	i := 0
-	for id := range al {
-		out[i] = id
-		i++
+// Next returns the ids in ascending order, the second return value is false
+// once the list is exhausted
+func (i *bitmapAllowListIterator) Next() (uint64, bool) {
+	if !i.it.HasNext() {
+		return 0, false
 	}
 
-	return out
+	return i.it.Next(), true
 }
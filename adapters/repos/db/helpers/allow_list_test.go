@@ -0,0 +1,62 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package helpers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAllowList(t *testing.T) {
+	t.Run("empty list", func(t *testing.T) {
+		al := NewAllowList()
+
+		assert.True(t, al.IsEmpty())
+		assert.Equal(t, 0, al.Len())
+		assert.False(t, al.Contains(0))
+		assert.Empty(t, al.Slice())
+	})
+
+	t.Run("inserting and reading", func(t *testing.T) {
+		al := NewAllowList(7, 3)
+		al.Insert(17, 3, 1e12)
+
+		assert.False(t, al.IsEmpty())
+		assert.Equal(t, 4, al.Len())
+		assert.True(t, al.Contains(7))
+		assert.True(t, al.Contains(1e12))
+		assert.False(t, al.Contains(4))
+		assert.Equal(t, []uint64{3, 7, 17, 1e12}, al.Slice())
+	})
+
+	t.Run("iterating", func(t *testing.T) {
+		al := NewAllowList(5, 1, 3)
+
+		var ids []uint64
+		it := al.Iterator()
+		for id, ok := it.Next(); ok; id, ok = it.Next() {
+			ids = append(ids, id)
+		}
+
+		assert.Equal(t, []uint64{1, 3, 5}, ids)
+	})
+
+	t.Run("a deep copy is independent of the original", func(t *testing.T) {
+		al := NewAllowList(1, 2)
+		copied := al.DeepCopy()
+		copied.Insert(3)
+
+		assert.Equal(t, []uint64{1, 2}, al.Slice())
+		assert.Equal(t, []uint64{1, 2, 3}, copied.Slice())
+	})
+}
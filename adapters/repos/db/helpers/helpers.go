@@ -44,3 +44,15 @@ func BucketFromPropNameLSM(propName string) string {
 func HashBucketFromPropNameLSM(propName string) string {
 	return fmt.Sprintf("hash_property_%s", propName)
 }
+
+// BucketRangeableFromPropNameLSM creates the name of the bucket holding the
+// range-optimised index of a particular prop
+func BucketRangeableFromPropNameLSM(propName string) string {
+	return fmt.Sprintf("property_%s_rangeable", propName)
+}
+
+// HashBucketRangeableFromPropNameLSM creates the name of the bucket holding
+// the status information of the range-optimised index of a particular prop
+func HashBucketRangeableFromPropNameLSM(propName string) string {
+	return fmt.Sprintf("hash_property_%s_rangeable", propName)
+}
@@ -14,8 +14,48 @@ package helpers
 import (
 	"strings"
 	"unicode"
+
+	"github.com/semi-technologies/weaviate/entities/models"
 )
 
+// Tokenize splits the input according to the tokenization of a property. The
+// word tokenization differs between string and text props, so it is left to
+// the caller.
+func Tokenize(tokenization string, in string) []string {
+	switch tokenization {
+	case models.PropertyTokenizationField:
+		if trimmed := TrimString(in); trimmed != "" {
+			return []string{trimmed}
+		}
+		return nil
+	case models.PropertyTokenizationWhitespace:
+		return TokenizeString(in)
+	case models.PropertyTokenizationLowercase:
+		return TokenizeLowercase(in)
+	case models.PropertyTokenizationTrigram:
+		return TokenizeTrigram(in)
+	case models.PropertyTokenizationCjk:
+		return TokenizeCJK(in)
+	default:
+		return nil
+	}
+}
+
+// TokenizeWithWildcards is the Like-counterpart of Tokenize. The n-gram
+// tokenizers cannot keep wildcards inside their n-grams, instead each part
+// between the wildcards is split into n-grams on its own.
+func TokenizeWithWildcards(tokenization string, in string) []string {
+	switch tokenization {
+	case models.PropertyTokenizationTrigram:
+		return TokenizeTrigramKeepWildcards(in)
+	case models.PropertyTokenizationCjk:
+		return TokenizeCJKKeepWildcards(in)
+	default:
+		// none of the remaining tokenizers split on wildcard-symbols
+		return Tokenize(tokenization, in)
+	}
+}
+
 // TokenizeString only splits on white spaces, it does not alter casing
 func TokenizeString(in string) []string {
 	return strings.FieldsFunc(in, unicode.IsSpace)
@@ -47,6 +87,118 @@ func TokenizeTextKeepWildcards(in string) []string {
 	return parts
 }
 
+// TokenizeLowercase splits on white spaces and lowercases the words
+func TokenizeLowercase(in string) []string {
+	parts := TokenizeString(in)
+	for i, part := range parts {
+		parts[i] = strings.ToLower(part)
+	}
+
+	return parts
+}
+
+// TokenizeTrigram splits the lowercased words into overlapping sequences of
+// three characters, e.g. "Weaviate" into "wea", "eav", "avi", "via", "iat"
+// and "ate". Words shorter than three characters are kept as a whole.
+func TokenizeTrigram(in string) []string {
+	var parts []string
+	for _, word := range TokenizeText(in) {
+		parts = append(parts, ngrams([]rune(word), 3)...)
+	}
+
+	return parts
+}
+
+// TokenizeTrigramKeepWildcards splits the parts between wildcard-symbols
+// into trigrams, so that "*avia*" matches everything that contains "avi" and
+// "via"
+func TokenizeTrigramKeepWildcards(in string) []string {
+	var parts []string
+	for _, part := range strings.FieldsFunc(in, isWildcard) {
+		parts = append(parts, TokenizeTrigram(part)...)
+	}
+
+	return parts
+}
+
+// TokenizeCJK splits Chinese, Japanese and Korean text into overlapping
+// sequences of two characters, as these scripts do not separate words by
+// spaces. It does not need a dictionary, at the cost of a larger index.
+// Anything else is split like TokenizeText.
+func TokenizeCJK(in string) []string {
+	return tokenizeCJK(in, false)
+}
+
+// TokenizeCJKKeepWildcards is like TokenizeCJK, but keeps wildcard-symbols in
+// non-CJK words. CJK characters are split into bigrams on either side of a
+// wildcard.
+func TokenizeCJKKeepWildcards(in string) []string {
+	return tokenizeCJK(in, true)
+}
+
+func tokenizeCJK(in string, keepWildcards bool) []string {
+	var parts []string
+	var word, cjk []rune
+
+	flushWord := func() {
+		// a word made of wildcards only would match any value
+		if w := string(word); strings.TrimFunc(w, isWildcard) != "" {
+			parts = append(parts, strings.ToLower(w))
+		}
+		word = word[:0]
+	}
+	flushCJK := func() {
+		if len(cjk) > 0 {
+			parts = append(parts, ngrams(cjk, 2)...)
+		}
+		cjk = cjk[:0]
+	}
+
+	for _, r := range in {
+		switch {
+		case isCJK(r):
+			flushWord()
+			cjk = append(cjk, r)
+		case unicode.IsLetter(r) || unicode.IsNumber(r) || (keepWildcards && isWildcard(r)):
+			flushCJK()
+			word = append(word, r)
+		default:
+			flushWord()
+			flushCJK()
+		}
+	}
+	flushWord()
+	flushCJK()
+
+	return parts
+}
+
+func isCJK(r rune) bool {
+	// the prolonged sound mark is part of katakana words, but belongs to the
+	// common script
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana,
+		unicode.Hangul) || r == 'ー' || r == 'ｰ'
+}
+
+func isWildcard(r rune) bool {
+	return r == '*' || r == '?'
+}
+
+// ngrams returns the overlapping sequences of n runes, or the input as a
+// whole if it is not longer than n
+func ngrams(in []rune, n int) []string {
+	if len(in) <= n {
+		return []string{string(in)}
+	}
+
+	out := make([]string, 0, len(in)-n+1)
+	for i := 0; i+n <= len(in); i++ {
+		out = append(out, string(in[i:i+n]))
+	}
+
+	return out
+}
+
 // TrimString trims on white spaces
 func TrimString(in string) string {
 	return strings.TrimFunc(in, unicode.IsSpace)
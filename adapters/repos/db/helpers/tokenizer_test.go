@@ -0,0 +1,123 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package helpers
+
+import (
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenize(t *testing.T) {
+	type test struct {
+		tokenization string
+		in           string
+		expected     []string
+	}
+
+	tests := []test{
+		{
+			tokenization: models.PropertyTokenizationField,
+			in:           "  Hello World!  ",
+			expected:     []string{"Hello World!"},
+		},
+		{
+			tokenization: models.PropertyTokenizationField,
+			in:           "   ",
+			expected:     nil,
+		},
+		{
+			tokenization: models.PropertyTokenizationWhitespace,
+			in:           "Hello  World!",
+			expected:     []string{"Hello", "World!"},
+		},
+		{
+			tokenization: models.PropertyTokenizationLowercase,
+			in:           "Hello  World!",
+			expected:     []string{"hello", "world!"},
+		},
+		{
+			tokenization: models.PropertyTokenizationTrigram,
+			in:           "Weaviate, DB",
+			expected:     []string{"wea", "eav", "avi", "via", "iat", "ate", "db"},
+		},
+		{
+			tokenization: models.PropertyTokenizationCjk,
+			in:           "東京タワー",
+			expected:     []string{"東京", "京タ", "タワ", "ワー"},
+		},
+		{
+			tokenization: models.PropertyTokenizationCjk,
+			in:           "我爱Weaviate数据库, 한국어 1",
+			expected:     []string{"我爱", "weaviate", "数据", "据库", "한국", "국어", "1"},
+		},
+		{
+			tokenization: models.PropertyTokenizationCjk,
+			in:           "京",
+			expected:     []string{"京"},
+		},
+		{
+			tokenization: "unknown",
+			in:           "Hello World",
+			expected:     nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.tokenization+" "+test.in, func(t *testing.T) {
+			assert.Equal(t, test.expected, Tokenize(test.tokenization, test.in))
+		})
+	}
+}
+
+func TestTokenizeWithWildcards(t *testing.T) {
+	type test struct {
+		tokenization string
+		in           string
+		expected     []string
+	}
+
+	tests := []test{
+		{
+			tokenization: models.PropertyTokenizationLowercase,
+			in:           "Hell* W?rld",
+			expected:     []string{"hell*", "w?rld"},
+		},
+		{
+			tokenization: models.PropertyTokenizationTrigram,
+			in:           "*avia*",
+			expected:     []string{"avi", "via"},
+		},
+		{
+			tokenization: models.PropertyTokenizationTrigram,
+			in:           "wea*ate",
+			expected:     []string{"wea", "ate"},
+		},
+		{
+			tokenization: models.PropertyTokenizationCjk,
+			in:           "東京*タワー weav*",
+			expected:     []string{"東京", "タワ", "ワー", "weav*"},
+		},
+		{
+			tokenization: models.PropertyTokenizationCjk,
+			in:           "* 東京",
+			expected:     []string{"東京"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.tokenization+" "+test.in, func(t *testing.T) {
+			assert.Equal(t, test.expected, TokenizeWithWildcards(test.tokenization, test.in))
+		})
+	}
+}
@@ -0,0 +1,132 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/searchparams"
+	"github.com/semi-technologies/weaviate/entities/storobj"
+)
+
+// rankFusionK dampens the influence of the top ranks in a ranked fusion, 60
+// is the value proposed in the original reciprocal rank fusion paper
+const rankFusionK = 60
+
+// hybridFusion merges the ranked keyword (bm25) results with the vector
+// search results. The keyword results are expected to be sorted by score
+// (descending), the vector results by distance (ascending). Objects present in
+// both sets are deduplicated by their id. The fused results are sorted by
+// their fused score (descending).
+type hybridFusion struct {
+	alpha      float64
+	fusionType string
+}
+
+func newHybridFusion(alpha float64, fusionType string) *hybridFusion {
+	if fusionType == "" {
+		fusionType = searchparams.HybridRankedFusion
+	}
+
+	return &hybridFusion{alpha: alpha, fusionType: fusionType}
+}
+
+func (h *hybridFusion) fuse(keywordObjs []*storobj.Object, keywordScores []float32,
+	vectorObjs []*storobj.Object, vectorDists []float32,
+) ([]*storobj.Object, []float32, error) {
+	var keywordWeights, vectorWeights []float64
+
+	switch h.fusionType {
+	case searchparams.HybridRankedFusion:
+		keywordWeights = rankWeights(len(keywordObjs))
+		vectorWeights = rankWeights(len(vectorObjs))
+	case searchparams.HybridRelativeScoreFusion:
+		keywordWeights = normalizedWeights(keywordScores, true)
+		vectorWeights = normalizedWeights(vectorDists, false)
+	default:
+		return nil, nil, errors.Errorf("unsupported fusion type %q", h.fusionType)
+	}
+
+	fused := make(map[strfmt.UUID]int, len(keywordObjs)+len(vectorObjs))
+	outObjs := make([]*storobj.Object, 0, len(keywordObjs)+len(vectorObjs))
+	outScores := make([]float64, 0, len(keywordObjs)+len(vectorObjs))
+
+	add := func(objs []*storobj.Object, weights []float64, factor float64) {
+		for i, obj := range objs {
+			pos, ok := fused[obj.ID()]
+			if !ok {
+				pos = len(outObjs)
+				fused[obj.ID()] = pos
+				outObjs = append(outObjs, obj)
+				outScores = append(outScores, 0)
+			}
+			outScores[pos] += factor * weights[i]
+		}
+	}
+
+	add(keywordObjs, keywordWeights, 1-h.alpha)
+	add(vectorObjs, vectorWeights, h.alpha)
+
+	scores := make([]float32, len(outScores))
+	for i := range outScores {
+		scores[i] = float32(outScores[i])
+	}
+
+	objs, scores := newScoresSorter().sort(outObjs, scores)
+	return objs, scores, nil
+}
+
+// rankWeights assigns each position in a sorted result set its reciprocal
+// rank
+func rankWeights(count int) []float64 {
+	weights := make([]float64, count)
+	for rank := range weights {
+		weights[rank] = 1 / float64(rankFusionK+rank+1)
+	}
+
+	return weights
+}
+
+// normalizedWeights scales the input values to the range 0..1. If
+// higherIsBetter is false (e.g. for distances), the scale is inverted, so that
+// the best value always ends up with the highest weight.
+func normalizedWeights(values []float32, higherIsBetter bool) []float64 {
+	weights := make([]float64, len(values))
+	if len(values) == 0 {
+		return weights
+	}
+
+	lowest, highest := values[0], values[0]
+	for _, val := range values {
+		if val < lowest {
+			lowest = val
+		}
+		if val > highest {
+			highest = val
+		}
+	}
+
+	for i, val := range values {
+		if highest == lowest {
+			weights[i] = 1
+			continue
+		}
+
+		if higherIsBetter {
+			weights[i] = float64((val - lowest) / (highest - lowest))
+		} else {
+			weights[i] = float64((highest - val) / (highest - lowest))
+		}
+	}
+
+	return weights
+}
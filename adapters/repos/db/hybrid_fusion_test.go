@@ -0,0 +1,126 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/searchparams"
+	"github.com/semi-technologies/weaviate/entities/storobj"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_HybridFusion(t *testing.T) {
+	obj := func(id string) *storobj.Object {
+		return &storobj.Object{Object: models.Object{ID: strfmt.UUID(id)}}
+	}
+
+	ids := func(objs []*storobj.Object) []string {
+		out := make([]string, len(objs))
+		for i, obj := range objs {
+			out[i] = obj.ID().String()
+		}
+		return out
+	}
+
+	keywordObjs := []*storobj.Object{
+		obj("40d3be3e-2ecc-49c8-b37c-d8983164848b"),
+		obj("31bdf9ef-d1c0-4b43-8331-1a89a48c1d2b"),
+		obj("4432797a-ef18-429f-83dc-d971dd9e4dd0"),
+	}
+	keywordScores := []float32{9, 3, 1}
+
+	vectorObjs := []*storobj.Object{
+		obj("8ef8c6fd-93b5-4452-b3c3-cef1cd0a18ed"),
+		obj("4432797a-ef18-429f-83dc-d971dd9e4dd0"),
+		obj("40d3be3e-2ecc-49c8-b37c-d8983164848b"),
+	}
+	vectorDists := []float32{0.1, 0.2, 0.5}
+
+	type testcase struct {
+		name          string
+		alpha         float64
+		fusionType    string
+		expectedOrder []string
+	}
+
+	tests := []testcase{
+		{
+			name:       "pure keyword search with ranked fusion",
+			alpha:      0,
+			fusionType: searchparams.HybridRankedFusion,
+			expectedOrder: []string{
+				"40d3be3e-2ecc-49c8-b37c-d8983164848b",
+				"31bdf9ef-d1c0-4b43-8331-1a89a48c1d2b",
+				"4432797a-ef18-429f-83dc-d971dd9e4dd0",
+				"8ef8c6fd-93b5-4452-b3c3-cef1cd0a18ed",
+			},
+		},
+		{
+			name:       "pure vector search with ranked fusion",
+			alpha:      1,
+			fusionType: searchparams.HybridRankedFusion,
+			expectedOrder: []string{
+				"8ef8c6fd-93b5-4452-b3c3-cef1cd0a18ed",
+				"4432797a-ef18-429f-83dc-d971dd9e4dd0",
+				"40d3be3e-2ecc-49c8-b37c-d8983164848b",
+				"31bdf9ef-d1c0-4b43-8331-1a89a48c1d2b",
+			},
+		},
+		{
+			name:       "balanced ranked fusion prefers objects present in both sets",
+			alpha:      0.5,
+			fusionType: searchparams.HybridRankedFusion,
+			expectedOrder: []string{
+				"40d3be3e-2ecc-49c8-b37c-d8983164848b",
+				"4432797a-ef18-429f-83dc-d971dd9e4dd0",
+				"8ef8c6fd-93b5-4452-b3c3-cef1cd0a18ed",
+				"31bdf9ef-d1c0-4b43-8331-1a89a48c1d2b",
+			},
+		},
+		{
+			name:       "relative score fusion",
+			alpha:      0.6,
+			fusionType: searchparams.HybridRelativeScoreFusion,
+			// keyword weights: 1, 0.25, 0
+			// vector weights: 1, 0.75, 0
+			expectedOrder: []string{
+				"8ef8c6fd-93b5-4452-b3c3-cef1cd0a18ed",
+				"4432797a-ef18-429f-83dc-d971dd9e4dd0",
+				"40d3be3e-2ecc-49c8-b37c-d8983164848b",
+				"31bdf9ef-d1c0-4b43-8331-1a89a48c1d2b",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			objs, scores, err := newHybridFusion(test.alpha, test.fusionType).
+				fuse(keywordObjs, keywordScores, vectorObjs, vectorDists)
+			require.Nil(t, err)
+			assert.Equal(t, test.expectedOrder, ids(objs))
+			require.Len(t, scores, len(objs))
+			for i := 1; i < len(scores); i++ {
+				assert.GreaterOrEqual(t, scores[i-1], scores[i])
+			}
+		})
+	}
+
+	t.Run("with an unknown fusion type", func(t *testing.T) {
+		_, _, err := newHybridFusion(0.5, "unknown").
+			fuse(keywordObjs, keywordScores, vectorObjs, vectorDists)
+		assert.NotNil(t, err)
+	})
+}
@@ -12,7 +12,9 @@
 package db
 
 import (
+	"bytes"
 	"context"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -50,12 +52,15 @@ import (
 type Index struct {
 	classSearcher         inverted.ClassSearcher // to allow for nested by-references searches
 	Shards                map[string]*Shard
+	shardsLock            sync.RWMutex
 	Config                IndexConfig
 	vectorIndexUserConfig schema.VectorIndexConfig
-	getSchema             schemaUC.SchemaGetter
-	logger                logrus.FieldLogger
-	remote                *sharding.RemoteIndex
-	stopwords             *stopwords.Detector
+	// vectorIndexUserConfigs holds the index configs of the named vectors
+	vectorIndexUserConfigs map[string]schema.VectorIndexConfig
+	getSchema              schemaUC.SchemaGetter
+	logger                 logrus.FieldLogger
+	remote                 *sharding.RemoteIndex
+	stopwords              *stopwords.Detector
 
 	backupState     BackupState
 	backupStateLock sync.RWMutex
@@ -63,7 +68,27 @@ type Index struct {
 	invertedIndexConfig     schema.InvertedIndexConfig
 	invertedIndexConfigLock sync.Mutex
 
-	metrics *Metrics
+	// reindex is the spec of a running reindex job, shards which are
+	// created while it runs join the job
+	reindex     *reindexSpec
+	reindexLock sync.Mutex
+
+	metrics     *Metrics
+	promMetrics *monitoring.PrometheusMetrics
+}
+
+// namedVectorIndexConfigs returns the parsed index configs of the named
+// vectors of a class
+func namedVectorIndexConfigs(class *models.Class) map[string]schema.VectorIndexConfig {
+	if len(class.VectorConfig) == 0 {
+		return nil
+	}
+
+	out := make(map[string]schema.VectorIndexConfig, len(class.VectorConfig))
+	for name, vectorConfig := range class.VectorConfig {
+		out[name] = vectorConfig.VectorIndexConfig.(schema.VectorIndexConfig)
+	}
+	return out
 }
 
 func (i *Index) ID() string {
@@ -78,7 +103,8 @@ type nodeResolver interface {
 // the shards that are local to a node
 func NewIndex(ctx context.Context, config IndexConfig,
 	shardState *sharding.State, invertedIndexConfig schema.InvertedIndexConfig,
-	vectorIndexUserConfig schema.VectorIndexConfig, sg schemaUC.SchemaGetter,
+	vectorIndexUserConfig schema.VectorIndexConfig,
+	vectorIndexUserConfigs map[string]schema.VectorIndexConfig, sg schemaUC.SchemaGetter,
 	cs inverted.ClassSearcher, logger logrus.FieldLogger,
 	nodeResolver nodeResolver, remoteClient sharding.RemoteIndexClient,
 	promMetrics *monitoring.PrometheusMetrics,
@@ -89,17 +115,19 @@ func NewIndex(ctx context.Context, config IndexConfig,
 	}
 
 	index := &Index{
-		Config:                config,
-		Shards:                map[string]*Shard{},
-		getSchema:             sg,
-		logger:                logger,
-		classSearcher:         cs,
-		vectorIndexUserConfig: vectorIndexUserConfig,
-		invertedIndexConfig:   invertedIndexConfig,
-		stopwords:             sd,
+		Config:                 config,
+		Shards:                 map[string]*Shard{},
+		getSchema:              sg,
+		logger:                 logger,
+		classSearcher:          cs,
+		vectorIndexUserConfig:  vectorIndexUserConfig,
+		vectorIndexUserConfigs: vectorIndexUserConfigs,
+		invertedIndexConfig:    invertedIndexConfig,
+		stopwords:              sd,
 		remote: sharding.NewRemoteIndex(config.ClassName.String(), sg,
-			nodeResolver, remoteClient),
-		metrics: NewMetrics(logger, promMetrics, config.ClassName.String(), "n/a"),
+			nodeResolver, remoteClient, logger),
+		metrics:     NewMetrics(logger, promMetrics, config.ClassName.String(), "n/a"),
+		promMetrics: promMetrics,
 	}
 
 	if err := index.checkSingleShardMigration(shardState); err != nil {
@@ -124,7 +152,71 @@ func NewIndex(ctx context.Context, config IndexConfig,
 	return index, nil
 }
 
+// localShard returns the shard with the specified name or nil if it does not
+// exist on this node
+func (i *Index) localShard(name string) *Shard {
+	i.shardsLock.RLock()
+	defer i.shardsLock.RUnlock()
+
+	return i.Shards[name]
+}
+
+// addTenants creates the local shards for newly added tenants of a
+// multi-tenant class. Tenants owned by other nodes are skipped.
+func (i *Index) addTenants(ctx context.Context, tenants []string) error {
+	shardState := i.getSchema.ShardingState(i.Config.ClassName.String())
+
+	for _, name := range tenants {
+		if !shardState.IsShardLocal(name) || i.localShard(name) != nil {
+			continue
+		}
+
+		shard, err := NewShard(ctx, i.promMetrics, name, i)
+		if err != nil {
+			return errors.Wrapf(err, "init shard %s of index %s", name, i.ID())
+		}
+		shard.notifyReady()
+
+		if spec := i.runningReindex(); spec != nil {
+			shard.startReindex(ctx, spec)
+		}
+
+		i.shardsLock.Lock()
+		i.Shards[name] = shard
+		i.shardsLock.Unlock()
+	}
+
+	return nil
+}
+
+// dropTenants deletes the local shards of the specified tenants including
+// all of their data
+func (i *Index) dropTenants(tenants []string) error {
+	i.backupStateLock.RLock()
+	defer i.backupStateLock.RUnlock()
+
+	for _, name := range tenants {
+		i.shardsLock.Lock()
+		shard, ok := i.Shards[name]
+		delete(i.Shards, name)
+		i.shardsLock.Unlock()
+
+		if !ok {
+			continue
+		}
+
+		if err := shard.drop(false); err != nil {
+			return errors.Wrapf(err, "delete shard %s", shard.ID())
+		}
+	}
+
+	return nil
+}
+
 func (i *Index) IterateObjects(ctx context.Context, cb func(index *Index, shard *Shard, object *storobj.Object) error) error {
+	i.shardsLock.RLock()
+	defer i.shardsLock.RUnlock()
+
 	for _, shard := range i.Shards {
 		wrapper := func(object *storobj.Object) error {
 			return cb(i, shard, object)
@@ -138,6 +230,9 @@ func (i *Index) IterateObjects(ctx context.Context, cb func(index *Index, shard
 }
 
 func (i *Index) addProperty(ctx context.Context, prop *models.Property) error {
+	i.shardsLock.RLock()
+	defer i.shardsLock.RUnlock()
+
 	for name, shard := range i.Shards {
 		if err := shard.addProperty(ctx, prop); err != nil {
 			return errors.Wrapf(err, "add property to shard %q", name)
@@ -147,7 +242,23 @@ func (i *Index) addProperty(ctx context.Context, prop *models.Property) error {
 	return nil
 }
 
+func (i *Index) dropProperty(ctx context.Context, propName string) error {
+	i.shardsLock.RLock()
+	defer i.shardsLock.RUnlock()
+
+	for name, shard := range i.Shards {
+		if err := shard.dropProperty(ctx, propName); err != nil {
+			return errors.Wrapf(err, "drop property from shard %q", name)
+		}
+	}
+
+	return nil
+}
+
 func (i *Index) addUUIDProperty(ctx context.Context) error {
+	i.shardsLock.RLock()
+	defer i.shardsLock.RUnlock()
+
 	for name, shard := range i.Shards {
 		if err := shard.addIDProperty(ctx); err != nil {
 			return errors.Wrapf(err, "add id property to shard %q", name)
@@ -158,6 +269,9 @@ func (i *Index) addUUIDProperty(ctx context.Context) error {
 }
 
 func (i *Index) addDimensionsProperty(ctx context.Context) error {
+	i.shardsLock.RLock()
+	defer i.shardsLock.RUnlock()
+
 	for name, shard := range i.Shards {
 		if err := shard.addDimensionsProperty(ctx); err != nil {
 			return errors.Wrapf(err, "add dimensions property to shard %q", name)
@@ -168,6 +282,9 @@ func (i *Index) addDimensionsProperty(ctx context.Context) error {
 }
 
 func (i *Index) addTimestampProperties(ctx context.Context) error {
+	i.shardsLock.RLock()
+	defer i.shardsLock.RUnlock()
+
 	for name, shard := range i.Shards {
 		if err := shard.addTimestampProperties(ctx); err != nil {
 			return errors.Wrapf(err, "add timestamp properties to shard %q", name)
@@ -178,6 +295,9 @@ func (i *Index) addTimestampProperties(ctx context.Context) error {
 }
 
 func (i *Index) addNullStateProperty(ctx context.Context, prop *models.Property) error {
+	i.shardsLock.RLock()
+	defer i.shardsLock.RUnlock()
+
 	for name, shard := range i.Shards {
 		if err := shard.addNullState(ctx, prop); err != nil {
 			return errors.Wrapf(err, "add null state to shard %q", name)
@@ -188,6 +308,9 @@ func (i *Index) addNullStateProperty(ctx context.Context, prop *models.Property)
 }
 
 func (i *Index) addPropertyLength(ctx context.Context, prop *models.Property) error {
+	i.shardsLock.RLock()
+	defer i.shardsLock.RUnlock()
+
 	for name, shard := range i.Shards {
 		if err := shard.addPropertyLength(ctx, prop); err != nil {
 			return errors.Wrapf(err, "add property length to shard %q", name)
@@ -200,6 +323,9 @@ func (i *Index) addPropertyLength(ctx context.Context, prop *models.Property) er
 func (i *Index) updateVectorIndexConfig(ctx context.Context,
 	updated schema.VectorIndexConfig,
 ) error {
+	i.shardsLock.RLock()
+	defer i.shardsLock.RUnlock()
+
 	// an updated is not specific to one shard, but rather all
 	for name, shard := range i.Shards {
 		// At the moment, we don't do anything in an update that could fail, but
@@ -239,52 +365,110 @@ type IndexConfig struct {
 	ResourceUsage             config.ResourceUsage
 	MaxImportGoroutinesFactor float64
 	FlushIdleAfter            int
+	ObjectsCompression        string
 	TrackVectorDimensions     bool
+	IndexFilterableRoaringSet bool
 }
 
 func indexID(class schema.ClassName) string {
 	return strings.ToLower(string(class))
 }
 
-func (i *Index) shardFromUUID(in strfmt.UUID) (string, error) {
-	uuid, err := uuid.Parse(in.String())
+// determineObjectShard returns the shard an object belongs to. In a
+// multi-tenant class this is the shard of the tenant, otherwise the shard is
+// derived from the object's id.
+func (i *Index) determineObjectShard(id strfmt.UUID, tenant string) (string, error) {
+	shardState := i.getSchema.ShardingState(i.Config.ClassName.String())
+	if shardState.PartitioningEnabled {
+		return i.tenantShard(shardState, tenant)
+	}
+
+	if tenant != "" {
+		return "", objects.NewErrMultiTenancy(errors.Errorf(
+			"class %s does not have multi-tenancy enabled, but request was "+
+				"made with tenant %q", i.Config.ClassName, tenant))
+	}
+
+	uuid, err := uuid.Parse(id.String())
 	if err != nil {
 		return "", errors.Wrap(err, "parse id as uuid")
 	}
 
 	uuidBytes, _ := uuid.MarshalBinary() // cannot error
 
+	return shardState.PhysicalShard(uuidBytes), nil
+}
+
+// targetShardNames returns the shards a search has to cover. In a
+// multi-tenant class only the tenant's shard is searched, so that tenants
+// are fully isolated from each other.
+func (i *Index) targetShardNames(tenant string) ([]string, error) {
+	shardState := i.getSchema.ShardingState(i.Config.ClassName.String())
+	if shardState.PartitioningEnabled {
+		name, err := i.tenantShard(shardState, tenant)
+		if err != nil {
+			return nil, err
+		}
+		return []string{name}, nil
+	}
+
+	if tenant != "" {
+		return nil, objects.NewErrMultiTenancy(errors.Errorf(
+			"class %s does not have multi-tenancy enabled, but request was "+
+				"made with tenant %q", i.Config.ClassName, tenant))
+	}
+
+	return shardState.AllPhysicalShards(), nil
+}
+
+func (i *Index) tenantShard(shardState *sharding.State,
+	tenant string,
+) (string, error) {
+	if tenant == "" {
+		return "", objects.NewErrMultiTenancy(errors.Errorf(
+			"class %s has multi-tenancy enabled, but request was made "+
+				"without tenant", i.Config.ClassName))
+	}
+
+	if _, ok := shardState.Physical[tenant]; !ok {
+		return "", objects.NewErrMultiTenancy(errors.Errorf(
+			"tenant %q does not exist in class %s", tenant, i.Config.ClassName))
+	}
+
+	return tenant, nil
+}
+
+func (i *Index) partitioningEnabled() bool {
 	return i.getSchema.ShardingState(i.Config.ClassName.String()).
-		PhysicalShard(uuidBytes), nil
+		PartitioningEnabled
 }
 
-func (i *Index) putObject(ctx context.Context, object *storobj.Object) error {
+func (i *Index) putObject(ctx context.Context, object *storobj.Object,
+	repl *additional.ReplicationProperties,
+) error {
 	if i.Config.ClassName != object.Class() {
 		return errors.Errorf("cannot import object of class %s into index of class %s",
 			object.Class(), i.Config.ClassName)
 	}
 	i.backupStateLock.RLock()
 	defer i.backupStateLock.RUnlock()
-	shardName, err := i.shardFromUUID(object.ID())
+	shardName, err := i.determineObjectShard(object.ID(), object.Object.Tenant)
 	if err != nil {
 		return err
 	}
 
-	localShard, ok := i.Shards[shardName]
-	if !ok {
-		// this must be a remote shard, try sending it remotely
-		if err := i.remote.PutObject(ctx, shardName, object); err != nil {
-			return errors.Wrap(err, "send to remote shard")
+	return i.remote.PutObject(ctx, shardName, object, repl, func() error {
+		localShard := i.localShard(shardName)
+		if localShard == nil {
+			return errors.Errorf("shard %q does not exist locally", shardName)
 		}
 
-		return nil
-	}
-
-	if err := localShard.putObject(ctx, object); err != nil {
-		return errors.Wrapf(err, "shard %s", localShard.ID())
-	}
+		if err := localShard.putObject(ctx, object); err != nil {
+			return errors.Wrapf(err, "shard %s", localShard.ID())
+		}
 
-	return nil
+		return nil
+	})
 }
 
 func (i *Index) IncomingPutObject(ctx context.Context, shardName string,
@@ -292,8 +476,8 @@ func (i *Index) IncomingPutObject(ctx context.Context, shardName string,
 ) error {
 	i.backupStateLock.RLock()
 	defer i.backupStateLock.RUnlock()
-	localShard, ok := i.Shards[shardName]
-	if !ok {
+	localShard := i.localShard(shardName)
+	if localShard == nil {
 		return errors.Errorf("shard %q does not exist locally", shardName)
 	}
 
@@ -400,7 +584,7 @@ func parseAsStringToTime(in interface{}) (time.Time, error) {
 // return value []error gives the error for the index with the positions
 // matching the inputs
 func (i *Index) putObjectBatch(ctx context.Context,
-	objects []*storobj.Object,
+	objects []*storobj.Object, repl *additional.ReplicationProperties,
 ) []error {
 	i.backupStateLock.RLock()
 	defer i.backupStateLock.RUnlock()
@@ -413,7 +597,7 @@ func (i *Index) putObjectBatch(ctx context.Context,
 	out := make([]error, len(objects))
 
 	for pos, obj := range objects {
-		shardName, err := i.shardFromUUID(obj.ID())
+		shardName, err := i.determineObjectShard(obj.ID(), obj.Object.Tenant)
 		if err != nil {
 			out[pos] = err
 			continue
@@ -432,17 +616,11 @@ func (i *Index) putObjectBatch(ctx context.Context,
 		go func(shardName string, group objsAndPos) {
 			defer wg.Done()
 
-			local := i.getSchema.
-				ShardingState(i.Config.ClassName.String()).
-				IsShardLocal(shardName)
-
-			var errs []error
-			if !local {
-				errs = i.remote.BatchPutObjects(ctx, shardName, group.objects)
-			} else {
-				shard := i.Shards[shardName]
-				errs = shard.putObjectBatch(ctx, group.objects)
-			}
+			errs := i.remote.BatchPutObjects(ctx, shardName, group.objects, repl,
+				func() []error {
+					shard := i.localShard(shardName)
+					return shard.putObjectBatch(ctx, group.objects)
+				})
 			for i, err := range errs {
 				desiredPos := group.pos[i]
 				out[desiredPos] = err
@@ -469,8 +647,8 @@ func (i *Index) IncomingBatchPutObjects(ctx context.Context, shardName string,
 ) []error {
 	i.backupStateLock.RLock()
 	defer i.backupStateLock.RUnlock()
-	localShard, ok := i.Shards[shardName]
-	if !ok {
+	localShard := i.localShard(shardName)
+	if localShard == nil {
 		return duplicateErr(errors.Errorf("shard %q does not exist locally",
 			shardName), len(objects))
 	}
@@ -494,7 +672,7 @@ func (i *Index) IncomingBatchPutObjects(ctx context.Context, shardName string,
 
 // return value map[int]error gives the error for the index as it received it
 func (i *Index) addReferencesBatch(ctx context.Context,
-	refs objects.BatchReferences,
+	refs objects.BatchReferences, repl *additional.ReplicationProperties,
 ) []error {
 	i.backupStateLock.RLock()
 	defer i.backupStateLock.RUnlock()
@@ -507,7 +685,7 @@ func (i *Index) addReferencesBatch(ctx context.Context,
 	out := make([]error, len(refs))
 
 	for pos, ref := range refs {
-		shardName, err := i.shardFromUUID(ref.From.TargetID)
+		shardName, err := i.determineObjectShard(ref.From.TargetID, ref.Tenant)
 		if err != nil {
 			out[pos] = err
 			continue
@@ -520,17 +698,11 @@ func (i *Index) addReferencesBatch(ctx context.Context,
 	}
 
 	for shardName, group := range byShard {
-		local := i.getSchema.
-			ShardingState(i.Config.ClassName.String()).
-			IsShardLocal(shardName)
-
-		var errs []error
-		if !local {
-			errs = i.remote.BatchAddReferences(ctx, shardName, group.refs)
-		} else {
-			shard := i.Shards[shardName]
-			errs = shard.addReferencesBatch(ctx, group.refs)
-		}
+		errs := i.remote.BatchAddReferences(ctx, shardName, group.refs, repl,
+			func() []error {
+				shard := i.localShard(shardName)
+				return shard.addReferencesBatch(ctx, group.refs)
+			})
 		for i, err := range errs {
 			desiredPos := group.pos[i]
 			out[desiredPos] = err
@@ -545,8 +717,8 @@ func (i *Index) IncomingBatchAddReferences(ctx context.Context, shardName string
 ) []error {
 	i.backupStateLock.RLock()
 	defer i.backupStateLock.RUnlock()
-	localShard, ok := i.Shards[shardName]
-	if !ok {
+	localShard := i.localShard(shardName)
+	if localShard == nil {
 		return duplicateErr(errors.Errorf("shard %q does not exist locally",
 			shardName), len(refs))
 	}
@@ -556,8 +728,9 @@ func (i *Index) IncomingBatchAddReferences(ctx context.Context, shardName string
 
 func (i *Index) objectByID(ctx context.Context, id strfmt.UUID,
 	props search.SelectProperties, additional additional.Properties,
+	repl *additional.ReplicationProperties, tenant string,
 ) (*storobj.Object, error) {
-	shardName, err := i.shardFromUUID(id)
+	shardName, err := i.determineObjectShard(id, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -566,12 +739,17 @@ func (i *Index) objectByID(ctx context.Context, id strfmt.UUID,
 		ShardingState(i.Config.ClassName.String()).
 		IsShardLocal(shardName)
 
+	if required := i.requiredReplicas(shardName, repl); required > 1 {
+		return i.objectByIDFromReplicas(ctx, shardName, local, required, id,
+			props, additional)
+	}
+
 	if !local {
 		remote, err := i.remote.GetObject(ctx, shardName, id, props, additional)
 		return remote, err
 	}
 
-	shard := i.Shards[shardName]
+	shard := i.localShard(shardName)
 	obj, err := shard.objectByID(ctx, id, props, additional)
 	if err != nil {
 		return nil, errors.Wrapf(err, "shard %s", shard.ID())
@@ -580,12 +758,38 @@ func (i *Index) objectByID(ctx context.Context, id strfmt.UUID,
 	return obj, nil
 }
 
+// objectByIDFromReplicas reads the object from the required number of
+// replicas and returns the newest version, see newestObject
+func (i *Index) objectByIDFromReplicas(ctx context.Context, shardName string,
+	local bool, required int, id strfmt.UUID, props search.SelectProperties,
+	additional additional.Properties,
+) (*storobj.Object, error) {
+	var versions []*storobj.Object
+	if local {
+		shard := i.localShard(shardName)
+		obj, err := shard.objectByID(ctx, id, props, additional)
+		if err != nil {
+			return nil, errors.Wrapf(err, "shard %s", shard.ID())
+		}
+		versions = append(versions, obj)
+		required--
+	}
+
+	remote, err := i.remote.GetObjectFromReplicas(ctx, shardName, id, props,
+		additional, required)
+	if err != nil {
+		return nil, errors.Wrapf(err, "remote shard %s", shardName)
+	}
+
+	return newestObject(append(versions, remote...)), nil
+}
+
 func (i *Index) IncomingGetObject(ctx context.Context, shardName string,
 	id strfmt.UUID, props search.SelectProperties,
 	additional additional.Properties,
 ) (*storobj.Object, error) {
-	shard, ok := i.Shards[shardName]
-	if !ok {
+	shard := i.localShard(shardName)
+	if shard == nil {
 		return nil, errors.Errorf("shard %q does not exist locally", shardName)
 	}
 
@@ -600,8 +804,8 @@ func (i *Index) IncomingGetObject(ctx context.Context, shardName string,
 func (i *Index) IncomingMultiGetObjects(ctx context.Context, shardName string,
 	ids []strfmt.UUID,
 ) ([]*storobj.Object, error) {
-	shard, ok := i.Shards[shardName]
-	if !ok {
+	shard := i.localShard(shardName)
+	if shard == nil {
 		return nil, errors.Errorf("shard %q does not exist locally", shardName)
 	}
 
@@ -624,7 +828,7 @@ func (i *Index) multiObjectByID(ctx context.Context,
 	byShard := map[string]idsAndPos{}
 
 	for pos, id := range query {
-		shardName, err := i.shardFromUUID(strfmt.UUID(id.ID))
+		shardName, err := i.determineObjectShard(strfmt.UUID(id.ID), "")
 		if err != nil {
 			return nil, err
 		}
@@ -646,7 +850,7 @@ func (i *Index) multiObjectByID(ctx context.Context,
 		var err error
 
 		if local {
-			shard := i.Shards[shardName]
+			shard := i.localShard(shardName)
 			objects, err = shard.multiObjectByID(ctx, group.ids)
 			if err != nil {
 				return nil, errors.Wrapf(err, "shard %s", shard.ID())
@@ -687,8 +891,10 @@ func wrapIDsInMulti(in []strfmt.UUID) []multi.Identifier {
 	return out
 }
 
-func (i *Index) exists(ctx context.Context, id strfmt.UUID) (bool, error) {
-	shardName, err := i.shardFromUUID(id)
+func (i *Index) exists(ctx context.Context, id strfmt.UUID,
+	repl *additional.ReplicationProperties, tenant string,
+) (bool, error) {
+	shardName, err := i.determineObjectShard(id, tenant)
 	if err != nil {
 		return false, err
 	}
@@ -697,9 +903,13 @@ func (i *Index) exists(ctx context.Context, id strfmt.UUID) (bool, error) {
 		ShardingState(i.Config.ClassName.String()).
 		IsShardLocal(shardName)
 
+	if required := i.requiredReplicas(shardName, repl); required > 1 {
+		return i.existsOnReplicas(ctx, shardName, local, required, id)
+	}
+
 	var ok bool
 	if local {
-		shard := i.Shards[shardName]
+		shard := i.localShard(shardName)
 		ok, err = shard.exists(ctx, id)
 	} else {
 		ok, err = i.remote.Exists(ctx, shardName, id)
@@ -711,11 +921,42 @@ func (i *Index) exists(ctx context.Context, id strfmt.UUID) (bool, error) {
 	return ok, nil
 }
 
+// existsOnReplicas checks the required number of replicas for the object. It
+// exists if any of them holds it, see newestObject, so there is no need to
+// ask the remote replicas if the local one holds it.
+func (i *Index) existsOnReplicas(ctx context.Context, shardName string,
+	local bool, required int, id strfmt.UUID,
+) (bool, error) {
+	if local {
+		ok, err := i.localShard(shardName).exists(ctx, id)
+		if err != nil {
+			return false, errors.Wrapf(err, "shard %s", shardName)
+		}
+		if ok {
+			return true, nil
+		}
+		required--
+	}
+
+	results, err := i.remote.ExistsOnReplicas(ctx, shardName, id, required)
+	if err != nil {
+		return false, errors.Wrapf(err, "remote shard %s", shardName)
+	}
+
+	for _, ok := range results {
+		if ok {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
 func (i *Index) IncomingExists(ctx context.Context, shardName string,
 	id strfmt.UUID,
 ) (bool, error) {
-	shard, ok := i.Shards[shardName]
-	if !ok {
+	shard := i.localShard(shardName)
+	if shard == nil {
 		return false, errors.Errorf("shard %q does not exist locally", shardName)
 	}
 
@@ -729,10 +970,13 @@ func (i *Index) IncomingExists(ctx context.Context, shardName string,
 
 func (i *Index) objectSearch(ctx context.Context, limit int, filters *filters.LocalFilter,
 	keywordRanking *searchparams.KeywordRanking, sort []filters.Sort,
-	additional additional.Properties,
-) ([]*storobj.Object, error) {
-	shardNames := i.getSchema.ShardingState(i.Config.ClassName.String()).
-		AllPhysicalShards()
+	cursor *filters.Cursor, additional additional.Properties,
+	repl *additional.ReplicationProperties, tenant string,
+) ([]*storobj.Object, []float32, error) {
+	shardNames, err := i.targetShardNames(tenant)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	outObjects := make([]*storobj.Object, 0, len(shardNames)*limit)
 	outScores := make([]float32, 0, len(shardNames)*limit)
@@ -746,36 +990,53 @@ func (i *Index) objectSearch(ctx context.Context, limit int, filters *filters.Lo
 		var err error
 
 		if local {
-			shard := i.Shards[shardName]
-			objs, scores, err = shard.objectSearch(ctx, limit, filters, keywordRanking, sort, additional)
+			shard := i.localShard(shardName)
+			objs, scores, err = shard.objectSearch(ctx, limit, filters, keywordRanking,
+				sort, cursor, additional)
 			if err != nil {
-				return nil, errors.Wrapf(err, "shard %s", shard.ID())
+				return nil, nil, errors.Wrapf(err, "shard %s", shard.ID())
 			}
 
 		} else {
 			objs, scores, err = i.remote.SearchShard(
-				ctx, shardName, nil, limit, filters, keywordRanking, sort, additional)
+				ctx, shardName, nil, "", limit, filters, keywordRanking, sort, cursor, additional)
 			if err != nil {
-				return nil, errors.Wrapf(err, "remote shard %s", shardName)
+				return nil, nil, errors.Wrapf(err, "remote shard %s", shardName)
 			}
 		}
+
+		objs, err = i.refreshFromReplicas(ctx, shardName, local, objs, repl)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "shard %s: read from replicas", shardName)
+		}
+
 		outObjects = append(outObjects, objs...)
 		outScores = append(outScores, scores...)
 	}
 
+	if keywordRanking == nil {
+		// scores are only set by ranked (bm25) searches, for any other
+		// search they carry no meaning and might not line up with the objects
+		outScores = nil
+	}
+
+	if cursor != nil {
+		return i.mergeCursorResults(outObjects, cursor.Limit), nil, nil
+	}
+
 	if len(sort) > 0 {
 		if len(shardNames) > 1 {
-			sortedObjs, _, err := i.sort(outObjects, outScores, sort, limit)
+			sortedObjs, sortedScores, err := i.sort(outObjects, outScores, sort, limit)
 			if err != nil {
-				return nil, errors.Wrap(err, "sort")
+				return nil, nil, errors.Wrap(err, "sort")
 			}
-			return sortedObjs, nil
+			return sortedObjs, sortedScores, nil
 		}
-		return outObjects, nil
+		return outObjects, outScores, nil
 	}
 
 	if keywordRanking != nil {
-		outObjects, _ = i.sortKeywordRanking(outObjects, outScores)
+		outObjects, outScores = i.sortKeywordRanking(outObjects, outScores)
 	}
 
 	// if this search was caused by a reference property
@@ -789,9 +1050,34 @@ func (i *Index) objectSearch(ctx context.Context, limit int, filters *filters.Lo
 	// and return all referenced object properties.
 	if !additional.ReferenceQuery && len(outObjects) > limit {
 		outObjects = outObjects[:limit]
+		if outScores != nil {
+			outScores = outScores[:limit]
+		}
 	}
 
-	return outObjects, nil
+	return outObjects, outScores, nil
+}
+
+// mergeCursorResults combines the pages of all shards into a single page.
+// Each shard returns its objects ordered by uuid, so the first limit objects
+// of the combined list, ordered by uuid again, form the next page.
+func (i *Index) mergeCursorResults(objs []*storobj.Object,
+	limit int,
+) []*storobj.Object {
+	keys := make(map[*storobj.Object][]byte, len(objs))
+	for _, obj := range objs {
+		keys[obj], _ = uuid.MustParse(obj.ID().String()).MarshalBinary()
+	}
+
+	sort.Slice(objs, func(a, b int) bool {
+		return bytes.Compare(keys[objs[a]], keys[objs[b]]) < 0
+	})
+
+	if len(objs) > limit {
+		objs = objs[:limit]
+	}
+
+	return objs
 }
 
 func (i *Index) sortKeywordRanking(objects []*storobj.Object,
@@ -800,6 +1086,60 @@ func (i *Index) sortKeywordRanking(objects []*storobj.Object,
 	return newScoresSorter().sort(objects, scores)
 }
 
+// objectHybridSearch runs the keyword (bm25) and the vector search in
+// parallel and fuses both result sets into a single ranking. Filtered
+// hybrid searches are not supported, since the keyword search can't be
+// filtered yet.
+func (i *Index) objectHybridSearch(ctx context.Context, limit int,
+	hybrid *searchparams.HybridSearch, searchVector []float32, targetVector string,
+	additional additional.Properties, repl *additional.ReplicationProperties,
+	tenant string,
+) ([]*storobj.Object, []float32, error) {
+	var (
+		keywordObjs   []*storobj.Object
+		keywordScores []float32
+		vectorObjs    []*storobj.Object
+		vectorDists   []float32
+	)
+
+	errgrp := &errgroup.Group{}
+	errgrp.Go(func() error {
+		var err error
+		keywordObjs, keywordScores, err = i.objectSearch(ctx, limit, nil,
+			hybrid.KeywordRanking(), nil, nil, additional, repl, tenant)
+		if err != nil {
+			return errors.Wrap(err, "keyword search")
+		}
+		return nil
+	})
+	errgrp.Go(func() error {
+		var err error
+		vectorObjs, vectorDists, err = i.objectVectorSearch(ctx, searchVector, targetVector, 0,
+			limit, nil, nil, additional, repl, tenant)
+		if err != nil {
+			return errors.Wrap(err, "vector search")
+		}
+		return nil
+	})
+
+	if err := errgrp.Wait(); err != nil {
+		return nil, nil, err
+	}
+
+	objs, scores, err := newHybridFusion(hybrid.Alpha, hybrid.FusionType).
+		fuse(keywordObjs, keywordScores, vectorObjs, vectorDists)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "fuse results")
+	}
+
+	if len(objs) > limit {
+		objs = objs[:limit]
+		scores = scores[:limit]
+	}
+
+	return objs, scores, nil
+}
+
 func (i *Index) sort(objects []*storobj.Object, scores []float32,
 	sort []filters.Sort, limit int,
 ) ([]*storobj.Object, []float32, error) {
@@ -808,11 +1148,14 @@ func (i *Index) sort(objects []*storobj.Object, scores []float32,
 }
 
 func (i *Index) objectVectorSearch(ctx context.Context, searchVector []float32,
-	dist float32, limit int, filters *filters.LocalFilter,
+	targetVector string, dist float32, limit int, filters *filters.LocalFilter,
 	sort []filters.Sort, additional additional.Properties,
+	repl *additional.ReplicationProperties, tenant string,
 ) ([]*storobj.Object, []float32, error) {
-	shardNames := i.getSchema.ShardingState(i.Config.ClassName.String()).
-		AllPhysicalShards()
+	shardNames, err := i.targetShardNames(tenant)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	errgrp := &errgroup.Group{}
 	m := &sync.Mutex{}
@@ -840,20 +1183,25 @@ func (i *Index) objectVectorSearch(ctx context.Context, searchVector []float32,
 			var err error
 
 			if local {
-				shard := i.Shards[shardName]
+				shard := i.localShard(shardName)
 				res, resDists, err = shard.objectVectorSearch(
-					ctx, searchVector, dist, limit, filters, sort, additional)
+					ctx, searchVector, targetVector, dist, limit, filters, sort, additional)
 				if err != nil {
 					return errors.Wrapf(err, "shard %s", shard.ID())
 				}
 			} else {
 				res, resDists, err = i.remote.SearchShard(
-					ctx, shardName, searchVector, limit, filters, nil, sort, additional)
+					ctx, shardName, searchVector, targetVector, limit, filters, nil, sort, nil, additional)
 				if err != nil {
 					return errors.Wrapf(err, "remote shard %s", shardName)
 				}
 			}
 
+			res, err = i.refreshFromReplicas(ctx, shardName, local, res, repl)
+			if err != nil {
+				return errors.Wrapf(err, "shard %s: read from replicas", shardName)
+			}
+
 			m.Lock()
 			out = append(out, res...)
 			dists = append(dists, resDists...)
@@ -885,17 +1233,18 @@ func (i *Index) objectVectorSearch(ctx context.Context, searchVector []float32,
 }
 
 func (i *Index) IncomingSearch(ctx context.Context, shardName string,
-	searchVector []float32, distance float32, limit int, filters *filters.LocalFilter,
-	keywordRanking *searchparams.KeywordRanking, sort []filters.Sort,
-	additional additional.Properties,
+	searchVector []float32, targetVector string, distance float32, limit int,
+	filters *filters.LocalFilter, keywordRanking *searchparams.KeywordRanking,
+	sort []filters.Sort, cursor *filters.Cursor, additional additional.Properties,
 ) ([]*storobj.Object, []float32, error) {
-	shard, ok := i.Shards[shardName]
-	if !ok {
+	shard := i.localShard(shardName)
+	if shard == nil {
 		return nil, nil, errors.Errorf("shard %q does not exist locally", shardName)
 	}
 
 	if searchVector == nil {
-		res, scores, err := shard.objectSearch(ctx, limit, filters, keywordRanking, sort, additional)
+		res, scores, err := shard.objectSearch(ctx, limit, filters, keywordRanking,
+			sort, cursor, additional)
 		if err != nil {
 			return nil, nil, errors.Wrapf(err, "shard %s", shard.ID())
 		}
@@ -904,7 +1253,7 @@ func (i *Index) IncomingSearch(ctx context.Context, shardName string,
 	}
 
 	res, resDists, err := shard.objectVectorSearch(
-		ctx, searchVector, distance, limit, filters, sort, additional)
+		ctx, searchVector, targetVector, distance, limit, filters, sort, additional)
 	if err != nil {
 		return nil, nil, errors.Wrapf(err, "shard %s", shard.ID())
 	}
@@ -912,24 +1261,20 @@ func (i *Index) IncomingSearch(ctx context.Context, shardName string,
 	return res, resDists, nil
 }
 
-func (i *Index) deleteObject(ctx context.Context, id strfmt.UUID) error {
+func (i *Index) deleteObject(ctx context.Context, id strfmt.UUID,
+	repl *additional.ReplicationProperties, tenant string,
+) error {
 	i.backupStateLock.RLock()
 	defer i.backupStateLock.RUnlock()
-	shardName, err := i.shardFromUUID(id)
+	shardName, err := i.determineObjectShard(id, tenant)
 	if err != nil {
 		return err
 	}
 
-	local := i.getSchema.
-		ShardingState(i.Config.ClassName.String()).
-		IsShardLocal(shardName)
-
-	if local {
-		shard := i.Shards[shardName]
-		err = shard.deleteObject(ctx, id)
-	} else {
-		err = i.remote.DeleteObject(ctx, shardName, id)
-	}
+	err = i.remote.DeleteObject(ctx, shardName, id, repl, func() error {
+		shard := i.localShard(shardName)
+		return shard.deleteObject(ctx, id)
+	})
 	if err != nil {
 		return errors.Wrapf(err, "shard %s", shardName)
 	}
@@ -942,8 +1287,8 @@ func (i *Index) IncomingDeleteObject(ctx context.Context, shardName string,
 ) error {
 	i.backupStateLock.RLock()
 	defer i.backupStateLock.RUnlock()
-	shard, ok := i.Shards[shardName]
-	if !ok {
+	shard := i.localShard(shardName)
+	if shard == nil {
 		return errors.Errorf("shard %q does not exist locally", shardName)
 	}
 
@@ -955,24 +1300,20 @@ func (i *Index) IncomingDeleteObject(ctx context.Context, shardName string,
 	return nil
 }
 
-func (i *Index) mergeObject(ctx context.Context, merge objects.MergeDocument) error {
+func (i *Index) mergeObject(ctx context.Context, merge objects.MergeDocument,
+	repl *additional.ReplicationProperties,
+) error {
 	i.backupStateLock.RLock()
 	defer i.backupStateLock.RUnlock()
-	shardName, err := i.shardFromUUID(merge.ID)
+	shardName, err := i.determineObjectShard(merge.ID, merge.Tenant)
 	if err != nil {
 		return err
 	}
 
-	local := i.getSchema.
-		ShardingState(i.Config.ClassName.String()).
-		IsShardLocal(shardName)
-
-	if local {
-		shard := i.Shards[shardName]
-		err = shard.mergeObject(ctx, merge)
-	} else {
-		err = i.remote.MergeObject(ctx, shardName, merge)
-	}
+	err = i.remote.MergeObject(ctx, shardName, merge, repl, func() error {
+		shard := i.localShard(shardName)
+		return shard.mergeObject(ctx, merge)
+	})
 	if err != nil {
 		return errors.Wrapf(err, "shard %s", shardName)
 	}
@@ -985,8 +1326,8 @@ func (i *Index) IncomingMergeObject(ctx context.Context, shardName string,
 ) error {
 	i.backupStateLock.RLock()
 	defer i.backupStateLock.RUnlock()
-	shard, ok := i.Shards[shardName]
-	if !ok {
+	shard := i.localShard(shardName)
+	if shard == nil {
 		return errors.Errorf("shard %q does not exist locally", shardName)
 	}
 
@@ -1002,7 +1343,10 @@ func (i *Index) aggregate(ctx context.Context,
 	params aggregation.Params,
 ) (*aggregation.Result, error) {
 	shardState := i.getSchema.ShardingState(i.Config.ClassName.String())
-	shardNames := shardState.AllPhysicalShards()
+	shardNames, err := i.targetShardNames(params.Tenant)
+	if err != nil {
+		return nil, err
+	}
 
 	results := make([]*aggregation.Result, len(shardNames))
 	for j, shardName := range shardNames {
@@ -1013,7 +1357,7 @@ func (i *Index) aggregate(ctx context.Context,
 		if !local {
 			res, err = i.remote.Aggregate(ctx, shardName, params)
 		} else {
-			shard := i.Shards[shardName]
+			shard := i.localShard(shardName)
 			res, err = shard.aggregate(ctx, params)
 		}
 		if err != nil {
@@ -1029,8 +1373,8 @@ func (i *Index) aggregate(ctx context.Context,
 func (i *Index) IncomingAggregate(ctx context.Context, shardName string,
 	params aggregation.Params,
 ) (*aggregation.Result, error) {
-	shard, ok := i.Shards[shardName]
-	if !ok {
+	shard := i.localShard(shardName)
+	if shard == nil {
 		return nil, errors.Errorf("shard %q does not exist locally", shardName)
 	}
 
@@ -1047,8 +1391,8 @@ func (i *Index) drop() error {
 	defer i.backupStateLock.RUnlock()
 	for _, name := range i.getSchema.ShardingState(i.Config.ClassName.String()).
 		AllPhysicalShards() {
-		shard, ok := i.Shards[name]
-		if !ok {
+		shard := i.localShard(name)
+		if shard == nil {
 			// skip non-local, but do delete everything that exists - even if it
 			// shouldn't
 			continue
@@ -1065,6 +1409,9 @@ func (i *Index) drop() error {
 func (i *Index) Shutdown(ctx context.Context) error {
 	i.backupStateLock.RLock()
 	defer i.backupStateLock.RUnlock()
+	i.shardsLock.RLock()
+	defer i.shardsLock.RUnlock()
+
 	for id, shard := range i.Shards {
 		if err := shard.shutdown(ctx); err != nil {
 			return errors.Wrapf(err, "shutdown shard %q", id)
@@ -1088,8 +1435,8 @@ func (i *Index) getShardsStatus(ctx context.Context) (map[string]string, error)
 		if !local {
 			status, err = i.remote.GetShardStatus(ctx, shardName)
 		} else {
-			shard, ok := i.Shards[shardName]
-			if !ok {
+			shard := i.localShard(shardName)
+			if shard == nil {
 				err = errors.Errorf("shard %s does not exist", shardName)
 			} else {
 				status = shard.getStatus().String()
@@ -1106,28 +1453,21 @@ func (i *Index) getShardsStatus(ctx context.Context) (map[string]string, error)
 }
 
 func (i *Index) IncomingGetShardStatus(ctx context.Context, shardName string) (string, error) {
-	shard, ok := i.Shards[shardName]
-	if !ok {
+	shard := i.localShard(shardName)
+	if shard == nil {
 		return "", errors.Errorf("shard %q does not exist", shardName)
 	}
 	return shard.getStatus().String(), nil
 }
 
 func (i *Index) updateShardStatus(ctx context.Context, shardName, targetStatus string) error {
-	shardState := i.getSchema.ShardingState(i.Config.ClassName.String())
-
-	var err error
-	local := shardState.IsShardLocal(shardName)
-	if !local {
-		err = i.remote.UpdateShardStatus(ctx, shardName, targetStatus)
-	} else {
-		shard, ok := i.Shards[shardName]
-		if !ok {
-			err = errors.Errorf("shard %s does not exist", shardName)
-		} else {
-			err = shard.updateStatus(targetStatus)
+	err := i.remote.UpdateShardStatus(ctx, shardName, targetStatus, func() error {
+		shard := i.localShard(shardName)
+		if shard == nil {
+			return errors.Errorf("shard %s does not exist", shardName)
 		}
-	}
+		return shard.updateStatus(targetStatus)
+	})
 	if err != nil {
 		return errors.Wrapf(err, "shard %s", shardName)
 	}
@@ -1136,27 +1476,33 @@ func (i *Index) updateShardStatus(ctx context.Context, shardName, targetStatus s
 }
 
 func (i *Index) IncomingUpdateShardStatus(ctx context.Context, shardName, targetStatus string) error {
-	shard, ok := i.Shards[shardName]
-	if !ok {
+	shard := i.localShard(shardName)
+	if shard == nil {
 		return errors.Errorf("shard %s does not exist", shardName)
 	}
 	return shard.updateStatus(targetStatus)
 }
 
 func (i *Index) notifyReady() {
+	i.shardsLock.RLock()
+	defer i.shardsLock.RUnlock()
+
 	for _, shd := range i.Shards {
 		shd.notifyReady()
 	}
 }
 
 func (i *Index) findDocIDs(ctx context.Context,
-	filters *filters.LocalFilter,
+	filters *filters.LocalFilter, tenant string,
 ) (map[string][]uint64, error) {
 	before := time.Now()
 	defer i.metrics.BatchDelete(before, "filter_total")
 
 	shardState := i.getSchema.ShardingState(i.Config.ClassName.String())
-	shardNames := shardState.AllPhysicalShards()
+	shardNames, err := i.targetShardNames(tenant)
+	if err != nil {
+		return nil, err
+	}
 
 	results := make(map[string][]uint64)
 	for _, shardName := range shardNames {
@@ -1167,7 +1513,7 @@ func (i *Index) findDocIDs(ctx context.Context,
 		if !local {
 			res, err = i.remote.FindDocIDs(ctx, shardName, filters)
 		} else {
-			shard := i.Shards[shardName]
+			shard := i.localShard(shardName)
 			res, err = shard.findDocIDs(ctx, filters)
 		}
 		if err != nil {
@@ -1183,8 +1529,8 @@ func (i *Index) findDocIDs(ctx context.Context,
 func (i *Index) IncomingFindDocIDs(ctx context.Context, shardName string,
 	filters *filters.LocalFilter,
 ) ([]uint64, error) {
-	shard, ok := i.Shards[shardName]
-	if !ok {
+	shard := i.localShard(shardName)
+	if shard == nil {
 		return nil, errors.Errorf("shard %q does not exist locally", shardName)
 	}
 
@@ -1198,6 +1544,7 @@ func (i *Index) IncomingFindDocIDs(ctx context.Context, shardName string,
 
 func (i *Index) batchDeleteObjects(ctx context.Context,
 	shardDocIDs map[string][]uint64, dryRun bool,
+	repl *additional.ReplicationProperties,
 ) (objects.BatchSimpleObjects, error) {
 	i.backupStateLock.RLock()
 	defer i.backupStateLock.RUnlock()
@@ -1223,9 +1570,12 @@ func (i *Index) batchDeleteObjects(ctx context.Context,
 			if !local {
 				objs = i.remote.DeleteObjectBatch(ctx, shardName, docIDs, dryRun)
 			} else {
-				shard := i.Shards[shardName]
+				shard := i.localShard(shardName)
 				objs = shard.deleteObjectBatch(ctx, docIDs, dryRun)
 			}
+			if !dryRun {
+				i.replicateBatchDelete(ctx, shardName, local, objs, repl)
+			}
 			ch <- result{objs}
 		}(shardName, docIDs)
 	}
@@ -1241,13 +1591,34 @@ func (i *Index) batchDeleteObjects(ctx context.Context,
 	return out, nil
 }
 
+func (i *Index) IncomingDeleteObjects(ctx context.Context, shardName string,
+	ids []strfmt.UUID,
+) []error {
+	i.backupStateLock.RLock()
+	defer i.backupStateLock.RUnlock()
+	shard := i.localShard(shardName)
+	if shard == nil {
+		return duplicateErr(errors.Errorf("shard %q does not exist locally",
+			shardName), len(ids))
+	}
+
+	errs := make([]error, len(ids))
+	for pos, id := range ids {
+		if err := shard.deleteObject(ctx, id); err != nil {
+			errs[pos] = errors.Wrapf(err, "shard %s", shard.ID())
+		}
+	}
+
+	return errs
+}
+
 func (i *Index) IncomingDeleteObjectBatch(ctx context.Context, shardName string,
 	docIDs []uint64, dryRun bool,
 ) objects.BatchSimpleObjects {
 	i.backupStateLock.RLock()
 	defer i.backupStateLock.RUnlock()
-	shard, ok := i.Shards[shardName]
-	if !ok {
+	shard := i.localShard(shardName)
+	if shard == nil {
 		return objects.BatchSimpleObjects{
 			objects.BatchSimpleObject{Err: errors.Errorf("shard %q does not exist locally", shardName)},
 		}
@@ -0,0 +1,140 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/errorcompounder"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+)
+
+// startReindex starts to rebuild the indexes of the given props and, if
+// vectorConfig is set, the vector index in all local shards. The props are
+// the new definitions, the current ones are read from the schema.
+func (i *Index) startReindex(ctx context.Context, id string,
+	props []*models.Property, vectorConfig schema.VectorIndexConfig,
+) error {
+	sch := i.getSchema.GetSchemaSkipAuth()
+	class := sch.FindClassByName(i.Config.ClassName)
+	if class == nil {
+		return errors.Errorf("class %s not found", i.Config.ClassName)
+	}
+
+	spec := &reindexSpec{
+		id:           id,
+		sources:      map[string]*models.Property{},
+		targets:      props,
+		vectorConfig: vectorConfig,
+	}
+
+	for _, target := range props {
+		prop, err := schema.GetPropertyByName(class, target.Name)
+		if err != nil {
+			return err
+		}
+		source := *prop
+		spec.sources[target.Name] = &source
+	}
+
+	i.reindexLock.Lock()
+	i.reindex = spec
+	i.reindexLock.Unlock()
+
+	i.shardsLock.RLock()
+	defer i.shardsLock.RUnlock()
+
+	for _, shard := range i.Shards {
+		shard.startReindex(ctx, spec)
+	}
+
+	return nil
+}
+
+func (i *Index) runningReindex() *reindexSpec {
+	i.reindexLock.Lock()
+	defer i.reindexLock.Unlock()
+
+	return i.reindex
+}
+
+// finishReindex forgets the spec of the job, unless it has already been
+// replaced by another one
+func (i *Index) finishReindex(id string) *reindexSpec {
+	i.reindexLock.Lock()
+	defer i.reindexLock.Unlock()
+
+	spec := i.reindex
+	if spec == nil || spec.id != id {
+		return nil
+	}
+
+	i.reindex = nil
+	return spec
+}
+
+// commitReindex swaps in the rebuilt indexes in all local shards. A shard
+// which fails does not stop the others, the schema is saved with the new
+// settings afterwards and the shard finishes the swap on its next startup.
+func (i *Index) commitReindex(ctx context.Context, id string) error {
+	if spec := i.finishReindex(id); spec != nil && spec.vectorConfig != nil {
+		i.vectorIndexUserConfig = spec.vectorConfig
+	}
+
+	i.shardsLock.RLock()
+	defer i.shardsLock.RUnlock()
+
+	ec := &errorcompounder.ErrorCompounder{}
+	for name, shard := range i.Shards {
+		if err := shard.commitReindex(ctx, id); err != nil {
+			ec.AddWrap(err, "shard "+name)
+		}
+	}
+
+	return ec.ToError()
+}
+
+// cleanupReindex removes the indexes which were replaced by the job in all
+// local shards, once the schema has been saved with the new settings
+func (i *Index) cleanupReindex(ctx context.Context, id string) error {
+	i.shardsLock.RLock()
+	defer i.shardsLock.RUnlock()
+
+	ec := &errorcompounder.ErrorCompounder{}
+	for name, shard := range i.Shards {
+		if err := shard.cleanupReindex(ctx, id); err != nil {
+			ec.AddWrap(err, "shard "+name)
+		}
+	}
+
+	return ec.ToError()
+}
+
+// cancelReindex stops the job and removes its shadow indexes in all local
+// shards
+func (i *Index) cancelReindex(ctx context.Context, id string) error {
+	i.finishReindex(id)
+
+	i.shardsLock.RLock()
+	defer i.shardsLock.RUnlock()
+
+	ec := &errorcompounder.ErrorCompounder{}
+	for name, shard := range i.Shards {
+		if err := shard.cancelReindex(ctx, id); err != nil {
+			ec.AddWrap(err, "shard "+name)
+		}
+	}
+
+	return ec.ToError()
+}
@@ -0,0 +1,133 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/additional"
+	"github.com/semi-technologies/weaviate/entities/storobj"
+	"github.com/semi-technologies/weaviate/usecases/objects"
+)
+
+// replicateBatchDelete propagates a batch delete to the remaining replicas of
+// the shard. The doc ids the batch delete was based on are only valid on the
+// replica they were obtained from (see findDocIDs), so the remaining replicas
+// are sent a single delete by uuid each. A deleted object whose deletion did
+// not reach enough replicas for the consistency level is marked as failed.
+func (i *Index) replicateBatchDelete(ctx context.Context, shardName string,
+	local bool, objs objects.BatchSimpleObjects,
+	repl *additional.ReplicationProperties,
+) {
+	shardState := i.getSchema.ShardingState(i.Config.ClassName.String())
+	others := len(shardState.RemoteReplicas(shardName))
+	if !local {
+		// the first remote replica executed the batch
+		others--
+	}
+	if others <= 0 {
+		// the replica which executed the batch is the only one
+		return
+	}
+
+	ids := make([]strfmt.UUID, 0, len(objs))
+	pos := make([]int, 0, len(objs))
+	for j := range objs {
+		if objs[j].Err != nil {
+			continue
+		}
+		ids = append(ids, objs[j].UUID)
+		pos = append(pos, j)
+	}
+
+	if len(ids) == 0 {
+		return
+	}
+
+	errs := i.remote.DeleteObjects(ctx, shardName, ids, repl)
+	for j, err := range errs {
+		if err != nil {
+			objs[pos[j]].Err = err
+		}
+	}
+}
+
+// requiredReplicas returns how many replicas of the shard have to take part
+// in a request with the specified consistency level
+func (i *Index) requiredReplicas(shardName string,
+	repl *additional.ReplicationProperties,
+) int {
+	shardState := i.getSchema.ShardingState(i.Config.ClassName.String())
+	return repl.RequiredReplicas(len(shardState.Physical[shardName].BelongsToNodes))
+}
+
+// newestObject picks the most recently updated version of an object that was
+// read from multiple replicas. Replicas which do not hold the object are
+// ignored, as without tombstones a deletion can't be told apart from a write
+// which has not reached the replica. The result is nil if no replica holds
+// the object.
+func newestObject(versions []*storobj.Object) *storobj.Object {
+	var newest *storobj.Object
+	for _, obj := range versions {
+		if obj == nil {
+			continue
+		}
+
+		if newest == nil || obj.LastUpdateTimeUnix() > newest.LastUpdateTimeUnix() {
+			newest = obj
+		}
+	}
+
+	return newest
+}
+
+// refreshFromReplicas is used for searches with a consistency level above
+// ONE. The search itself runs on a single replica of the shard. Every object
+// it found is then read from as many other replicas as the consistency level
+// requires, and replaced by a newer version if one of them holds it. The
+// ranking of the search is not changed.
+func (i *Index) refreshFromReplicas(ctx context.Context, shardName string,
+	local bool, objs []*storobj.Object, repl *additional.ReplicationProperties,
+) ([]*storobj.Object, error) {
+	n := i.requiredReplicas(shardName, repl)
+	if local {
+		// the local replica ran the search
+		n--
+	}
+	if n <= 0 || len(objs) == 0 {
+		return objs, nil
+	}
+
+	ids := make([]strfmt.UUID, len(objs))
+	for j, obj := range objs {
+		ids[j] = obj.ID()
+	}
+
+	versions, err := i.remote.MultiGetObjectsFromReplicas(ctx, shardName, ids, n)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*storobj.Object, len(objs))
+	for j := range objs {
+		candidates := []*storobj.Object{objs[j]}
+		for _, replicaObjs := range versions {
+			if j < len(replicaObjs) {
+				candidates = append(candidates, replicaObjs[j])
+			}
+		}
+		out[j] = newestObject(candidates)
+	}
+
+	return out, nil
+}
@@ -56,10 +56,13 @@ func (d *DB) init(ctx context.Context) error {
 				QueryMaximumResults:       d.config.QueryMaximumResults,
 				MaxImportGoroutinesFactor: d.config.MaxImportGoroutinesFactor,
 				FlushIdleAfter:            d.config.FlushIdleAfter,
+				ObjectsCompression:        d.config.ObjectsCompression,
 				TrackVectorDimensions:     d.config.TrackVectorDimensions,
+				IndexFilterableRoaringSet: d.config.IndexFilterableRoaringSet,
 			}, d.schemaGetter.ShardingState(class.Class),
 				inverted.ConfigFromModel(invertedConfig),
 				class.VectorIndexConfig.(schema.VectorIndexConfig),
+				namedVectorIndexConfigs(class),
 				d.schemaGetter, d, d.logger, d.nodeResolver, d.remoteIndex, d.promMetrics)
 			if err != nil {
 				return errors.Wrap(err, "create index")
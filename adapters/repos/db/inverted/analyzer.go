@@ -16,6 +16,7 @@ import (
 	"encoding/binary"
 
 	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/inverted/stemmer"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/inverted/stopwords"
 	"github.com/semi-technologies/weaviate/entities/models"
 )
@@ -34,20 +35,36 @@ type Property struct {
 
 type Analyzer struct {
 	stopwords stopwords.StopwordDetector
+	stemmer   stemmer.Stemmer
+}
+
+// forProperty returns an analyzer which reduces the words to their stem, if
+// the property has a stemmer configured
+func (a *Analyzer) forProperty(prop *models.Property) (*Analyzer, error) {
+	s, err := stemmer.ForName(prop.Stemmer)
+	if err != nil {
+		return nil, err
+	}
+
+	if s == a.stemmer {
+		return a, nil
+	}
+
+	return &Analyzer{stopwords: a.stopwords, stemmer: s}, nil
 }
 
 // Text removes non alpha-numeric and splits into lowercased words, then aggregates
 // duplicates
 func (a *Analyzer) Text(tokenization, in string) []Countable {
 	parts := textArrayTokenize(tokenization, []string{in})
-	return a.countParts(parts)
+	return a.countParts(parts, stopwordsApply(tokenization))
 }
 
 // TextArray removes non alpha-numeric and splits into lowercased words, then aggregates
 // duplicates
 func (a *Analyzer) TextArray(tokenization string, in []string) []Countable {
 	parts := textArrayTokenize(tokenization, in)
-	return a.countParts(parts)
+	return a.countParts(parts, stopwordsApply(tokenization))
 }
 
 func textArrayTokenize(tokenization string, in []string) []string {
@@ -58,6 +75,11 @@ func textArrayTokenize(tokenization string, in []string) []string {
 		for _, value := range in {
 			parts = append(parts, helpers.TokenizeText(value)...)
 		}
+	case models.PropertyTokenizationWhitespace, models.PropertyTokenizationLowercase,
+		models.PropertyTokenizationTrigram, models.PropertyTokenizationCjk:
+		for _, value := range in {
+			parts = append(parts, helpers.Tokenize(tokenization, value)...)
+		}
 	}
 
 	return parts
@@ -67,42 +89,73 @@ func textArrayTokenize(tokenization string, in []string) []string {
 // duplicates
 func (a *Analyzer) String(tokenization, in string) []Countable {
 	parts := stringArrayTokenize(tokenization, []string{in})
-	return a.countParts(parts)
+	return a.countParts(parts, stopwordsApply(tokenization))
 }
 
 // StringArray splits only on spaces and does not lowercase, then aggregates
 // duplicates
 func (a *Analyzer) StringArray(tokenization string, in []string) []Countable {
 	parts := stringArrayTokenize(tokenization, in)
-	return a.countParts(parts)
+	return a.countParts(parts, stopwordsApply(tokenization))
 }
 
 func stringArrayTokenize(tokenization string, in []string) []string {
 	var parts []string
 
 	switch tokenization {
-	case models.PropertyTokenizationField:
-		for _, value := range in {
-			if trimmed := helpers.TrimString(value); trimmed != "" {
-				parts = append(parts, trimmed)
-			}
-		}
 	case models.PropertyTokenizationWord:
 		for _, value := range in {
 			parts = append(parts, helpers.TokenizeString(value)...)
 		}
+	case models.PropertyTokenizationField, models.PropertyTokenizationWhitespace,
+		models.PropertyTokenizationLowercase, models.PropertyTokenizationTrigram,
+		models.PropertyTokenizationCjk:
+		for _, value := range in {
+			parts = append(parts, helpers.Tokenize(tokenization, value)...)
+		}
 	}
 
 	return parts
 }
 
-func (a *Analyzer) countParts(parts []string) []Countable {
+// stemParts reduces the parts to their stem using the stemmer of the
+// property, so that queries match the stems in the inverted index
+func stemParts(prop *models.Property, parts []string) ([]string, error) {
+	s, err := stemmer.ForName(prop.Stemmer)
+	if err != nil || s == nil {
+		return parts, err
+	}
+
+	out := make([]string, len(parts))
+	for i, part := range parts {
+		out[i] = s.Stem(part)
+	}
+
+	return out, nil
+}
+
+// stopwordsApply is false for the n-gram tokenizations. An n-gram is not a
+// word, removing "the" would remove a trigram from the index.
+func stopwordsApply(tokenization string) bool {
+	switch tokenization {
+	case models.PropertyTokenizationTrigram, models.PropertyTokenizationCjk:
+		return false
+	default:
+		return true
+	}
+}
+
+func (a *Analyzer) countParts(parts []string, removeStopwords bool) []Countable {
 	terms := map[string]uint64{}
 	for _, word := range parts {
-		if a.stopwords.IsStopword(word) {
+		if removeStopwords && a.stopwords.IsStopword(word) {
 			continue
 		}
 
+		if a.stemmer != nil {
+			word = a.stemmer.Stem(word)
+		}
+
 		count, ok := terms[word]
 		if !ok {
 			terms[word] = 0
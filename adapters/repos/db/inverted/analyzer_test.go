@@ -562,6 +562,93 @@ func TestAnalyzer_DefaultEngPreset(t *testing.T) {
 	})
 }
 
+func TestAnalyzer_Tokenizations(t *testing.T) {
+	a := newTestAnalyzer(t, schema.StopwordConfig{Preset: "en"})
+
+	t.Run("lowercase removes stopwords", func(t *testing.T) {
+		res := a.Text(models.PropertyTokenizationLowercase, "The Hello, hello")
+		assert.ElementsMatch(t, res, []Countable{
+			{Data: []byte("hello,"), TermFrequency: float32(1)},
+			{Data: []byte("hello"), TermFrequency: float32(1)},
+		})
+	})
+
+	t.Run("whitespace keeps casing", func(t *testing.T) {
+		res := a.StringArray(models.PropertyTokenizationWhitespace, []string{"Hello", "hello"})
+		assert.ElementsMatch(t, res, []Countable{
+			{Data: []byte("Hello"), TermFrequency: float32(1)},
+			{Data: []byte("hello"), TermFrequency: float32(1)},
+		})
+	})
+
+	t.Run("trigrams are not removed as stopwords", func(t *testing.T) {
+		res := a.Text(models.PropertyTokenizationTrigram, "theme")
+		assert.ElementsMatch(t, res, []Countable{
+			{Data: []byte("the"), TermFrequency: float32(1)},
+			{Data: []byte("hem"), TermFrequency: float32(1)},
+			{Data: []byte("eme"), TermFrequency: float32(1)},
+		})
+	})
+
+	t.Run("cjk bigrams", func(t *testing.T) {
+		res := a.String(models.PropertyTokenizationCjk, "数据库数据")
+		assert.ElementsMatch(t, res, []Countable{
+			{Data: []byte("数据"), TermFrequency: float32(2)},
+			{Data: []byte("据库"), TermFrequency: float32(1)},
+			{Data: []byte("库数"), TermFrequency: float32(1)},
+		})
+	})
+}
+
+func TestAnalyzer_Stemmer(t *testing.T) {
+	a := newTestAnalyzer(t, schema.StopwordConfig{Preset: "en"})
+	prop := &models.Property{
+		Name:         "title",
+		DataType:     []string{string(schema.DataTypeText)},
+		Tokenization: models.PropertyTokenizationWord,
+		Stemmer:      models.PropertyStemmerEn,
+	}
+
+	pa, err := a.forProperty(prop)
+	require.Nil(t, err)
+
+	// stopwords are removed before stemming
+	res := pa.Text(prop.Tokenization, "The dog is running, the dogs run")
+	assert.ElementsMatch(t, res, []Countable{
+		{Data: []byte("dog"), TermFrequency: float32(2)},
+		{Data: []byte("run"), TermFrequency: float32(2)},
+	})
+
+	t.Run("without a stemmer", func(t *testing.T) {
+		pa, err := a.forProperty(&models.Property{Stemmer: "none"})
+		require.Nil(t, err)
+		assert.Equal(t, a, pa)
+	})
+
+	t.Run("with an unknown stemmer", func(t *testing.T) {
+		_, err := a.forProperty(&models.Property{Stemmer: "klingon"})
+		assert.NotNil(t, err)
+	})
+}
+
+func TestAnalyzer_LanguagePresets(t *testing.T) {
+	tests := map[string]string{
+		"de": "der Hund und die Katze",
+		"fr": "le chien et le chat",
+		"es": "el perro y el gato",
+		"it": "il cane e il gatto",
+		"nl": "de hond en de kat",
+		"pt": "o cão e o gato",
+	}
+
+	for preset, input := range tests {
+		t.Run(preset, func(t *testing.T) {
+			a := newTestAnalyzer(t, schema.StopwordConfig{Preset: preset})
+			assert.Len(t, a.Text(models.PropertyTokenizationWord, input), 2)
+		})
+	}
+}
+
 type fakeStopwordDetector struct{}
 
 func (fsd fakeStopwordDetector) IsStopword(word string) bool {
@@ -19,7 +19,6 @@ import (
 	"math"
 	"runtime/debug"
 	"sort"
-	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -28,6 +27,7 @@ import (
 	"github.com/semi-technologies/weaviate/adapters/repos/db/propertyspecific"
 	"github.com/semi-technologies/weaviate/entities/additional"
 	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/entities/searchparams"
 	"github.com/semi-technologies/weaviate/entities/storobj"
@@ -85,14 +85,21 @@ func (b *BM25Searcher) Object(ctx context.Context, limit int,
 		}
 	}()
 
-	// TODO: more complex pre-processing with proper split function
-	terms := strings.Split(keywordRanking.Query, " ")
+	class := b.schema.GetClass(className)
+	if class == nil {
+		return nil, nil, errors.Errorf("class %q not found in schema", className)
+	}
+
+	properties, err := b.propertiesWithQueryTerms(class, keywordRanking)
+	if err != nil {
+		return nil, nil, err
+	}
 
+	terms := uniqueQueryTerms(properties)
 	idLists := make([]docPointersWithScore, len(terms))
 
 	for i, term := range terms {
-		ids, err := b.retrieveScoreAndSortForSingleTerm(ctx,
-			keywordRanking.Properties[0], term)
+		ids, err := b.retrieveScoreAndSortForSingleTerm(ctx, properties, term)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -130,25 +137,127 @@ func (b *BM25Searcher) sort(ids docPointersWithScore) docPointersWithScore {
 	return ids
 }
 
+// bm25Property is a property taking part in a BM25F search. Each property
+// tokenizes the query the same way its values were tokenized at import time,
+// so the query terms may differ between properties.
+type bm25Property struct {
+	name  string
+	boost float64
+	terms []string
+}
+
+func (p bm25Property) hasTerm(term string) bool {
+	for _, t := range p.terms {
+		if t == term {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (b *BM25Searcher) propertiesWithQueryTerms(class *models.Class,
+	keywordRanking *searchparams.KeywordRanking,
+) ([]bm25Property, error) {
+	out := make([]bm25Property, len(keywordRanking.Properties))
+	seen := map[string]struct{}{}
+	for i, raw := range keywordRanking.Properties {
+		name, boost, err := searchparams.ParsePropertyBoost(raw)
+		if err != nil {
+			return nil, err
+		}
+
+		prop, err := schema.GetPropertyByName(class, name)
+		if err != nil {
+			return nil, err
+		}
+
+		// a property listed twice, e.g. "title" and "title^2", would count
+		// its term frequencies twice
+		if _, ok := seen[prop.Name]; ok {
+			return nil, errors.Errorf("property %q is set multiple times, "+
+				"set it once with the desired boost instead", prop.Name)
+		}
+		seen[prop.Name] = struct{}{}
+
+		terms, err := queryTermsForProperty(prop, keywordRanking.Query)
+		if err != nil {
+			return nil, err
+		}
+
+		out[i] = bm25Property{name: prop.Name, boost: boost, terms: terms}
+	}
+
+	return out, nil
+}
+
+// queryTermsForProperty splits the query using the same tokenizer and stemmer
+// that are used when indexing the property
+func queryTermsForProperty(prop *models.Property, query string) ([]string, error) {
+	switch schema.DataType(prop.DataType[0]) {
+	case schema.DataTypeText, schema.DataTypeTextArray:
+		return stemParts(prop, textArrayTokenize(prop.Tokenization, []string{query}))
+	case schema.DataTypeString, schema.DataTypeStringArray:
+		return stemParts(prop, stringArrayTokenize(prop.Tokenization, []string{query}))
+	default:
+		return nil, errors.Errorf("property %q is of type %q: keyword search (bm25) "+
+			"is only supported on text and string properties", prop.Name, prop.DataType[0])
+	}
+}
+
+func uniqueQueryTerms(properties []bm25Property) []string {
+	seen := map[string]struct{}{}
+	var terms []string
+	for _, prop := range properties {
+		for _, term := range prop.terms {
+			if _, ok := seen[term]; ok {
+				continue
+			}
+
+			seen[term] = struct{}{}
+			terms = append(terms, term)
+		}
+	}
+
+	return terms
+}
+
 func (b *BM25Searcher) retrieveScoreAndSortForSingleTerm(ctx context.Context,
-	property, term string,
+	properties []bm25Property, term string,
 ) (docPointersWithScore, error) {
 	before := time.Now()
-	ids, err := b.getIdsWithFrequenciesForTerm(ctx, property, term)
-	if err != nil {
-		return docPointersWithScore{}, errors.Wrap(err,
-			"read doc ids and their frequencies from inverted index")
+	var (
+		propLists []docPointersWithScore
+		propsUsed []bm25Property
+		count     int
+	)
+	for _, prop := range properties {
+		if !prop.hasTerm(term) {
+			continue
+		}
+
+		ids, err := b.getIdsWithFrequenciesForTerm(ctx, prop.name, term)
+		if err != nil {
+			return docPointersWithScore{}, errors.Wrapf(err,
+				"read doc ids and their frequencies from inverted index of prop %q", prop.name)
+		}
+
+		propLists = append(propLists, ids)
+		propsUsed = append(propsUsed, prop)
+		count += len(ids.docIDs)
 	}
 	took := time.Since(before)
 	b.logger.WithField("took", took).
 		WithField("event", "retrieve_doc_ids").
-		WithField("count", len(ids.docIDs)).
+		WithField("count", count).
 		WithField("term", term).
-		Debugf("retrieve %d doc ids for term %q took %s", len(ids.docIDs),
+		Debugf("retrieve %d doc ids for term %q took %s", count,
 			term, took)
 
 	before = time.Now()
-	if err := b.score(ids, property); err != nil {
+	objectCount := float64(b.store.Bucket(helpers.ObjectsBucketLSM).Count())
+	ids, err := b.score(propLists, propsUsed, objectCount)
+	if err != nil {
 		return docPointersWithScore{}, err
 	}
 	took = time.Since(before)
@@ -162,26 +271,53 @@ func (b *BM25Searcher) retrieveScoreAndSortForSingleTerm(ctx context.Context,
 	return ids, nil
 }
 
-func (bm *BM25Searcher) score(ids docPointersWithScore, propName string) error {
-	m, err := bm.propLengths.PropertyMean(propName)
-	if err != nil {
-		return err
-	}
-
-	averageDocLen := float64(m)
+// score implements BM25F: the term frequencies of all properties are
+// normalized by the respective property length, weighted by the property
+// boost and summed up, before the BM25 saturation and idf are applied once
+// per document. N is the total number of objects in the shard. The resulting
+// list is sorted by doc id as required by the score merger.
+func (bm *BM25Searcher) score(propLists []docPointersWithScore,
+	props []bm25Property, N float64,
+) (docPointersWithScore, error) {
 	k1 := bm.config.K1
 	b := bm.config.B
 
-	N := float64(bm.store.Bucket(helpers.ObjectsBucketLSM).Count())
-	n := float64(len(ids.docIDs))
+	weightedTfs := map[uint64]float64{}
+	for i, ids := range propLists {
+		m, err := bm.propLengths.PropertyMean(props[i].name)
+		if err != nil {
+			return docPointersWithScore{}, err
+		}
+
+		averagePropLen := float64(m)
+		for _, id := range ids.docIDs {
+			norm := float64(1)
+			if averagePropLen > 0 {
+				norm = 1 - b + b*id.propLength/averagePropLen
+			}
+			weightedTfs[id.id] += props[i].boost * id.frequency / norm
+		}
+	}
+
+	n := float64(len(weightedTfs))
 	idf := math.Log(float64(1) + (N-n+0.5)/(n+0.5))
-	for i, id := range ids.docIDs {
-		docLen := id.propLength
-		tf := id.frequency / (id.frequency + k1*(1-b+b*docLen/averageDocLen))
-		ids.docIDs[i].score = tf * idf
+
+	out := docPointersWithScore{
+		docIDs: make([]docPointerWithScore, 0, len(weightedTfs)),
 	}
+	for id, tf := range weightedTfs {
+		out.docIDs = append(out.docIDs, docPointerWithScore{
+			id:    id,
+			score: tf / (tf + k1) * idf,
+		})
+	}
+
+	sort.Slice(out.docIDs, func(a, b int) bool {
+		return out.docIDs[a].id < out.docIDs[b].id
+	})
+	out.count = uint64(len(out.docIDs))
 
-	return nil
+	return out, nil
 }
 
 func (b *BM25Searcher) getIdsWithFrequenciesForTerm(ctx context.Context,
@@ -0,0 +1,198 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package inverted
+
+import (
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/searchparams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBM25QueryTermsForProperty(t *testing.T) {
+	type testcase struct {
+		name          string
+		prop          *models.Property
+		query         string
+		expectedTerms []string
+		expectErr     bool
+	}
+
+	tests := []testcase{
+		{
+			name: "text prop with word tokenization",
+			prop: &models.Property{
+				Name:         "title",
+				DataType:     []string{string(schema.DataTypeText)},
+				Tokenization: models.PropertyTokenizationWord,
+			},
+			query:         "Hello, World! It's   me",
+			expectedTerms: []string{"hello", "world", "it", "s", "me"},
+		},
+		{
+			name: "string prop with word tokenization",
+			prop: &models.Property{
+				Name:         "title",
+				DataType:     []string{string(schema.DataTypeString)},
+				Tokenization: models.PropertyTokenizationWord,
+			},
+			query:         "Hello, World! It's   me",
+			expectedTerms: []string{"Hello,", "World!", "It's", "me"},
+		},
+		{
+			name: "string array prop with field tokenization",
+			prop: &models.Property{
+				Name:         "tags",
+				DataType:     []string{string(schema.DataTypeStringArray)},
+				Tokenization: models.PropertyTokenizationField,
+			},
+			query:         "  Hello World ",
+			expectedTerms: []string{"Hello World"},
+		},
+		{
+			name: "text prop with stemmer",
+			prop: &models.Property{
+				Name:         "title",
+				DataType:     []string{string(schema.DataTypeText)},
+				Tokenization: models.PropertyTokenizationWord,
+				Stemmer:      models.PropertyStemmerEn,
+			},
+			query:         "Running dogs",
+			expectedTerms: []string{"run", "dog"},
+		},
+		{
+			name: "int prop",
+			prop: &models.Property{
+				Name:     "count",
+				DataType: []string{string(schema.DataTypeInt)},
+			},
+			query:     "7",
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			terms, err := queryTermsForProperty(test.prop, test.query)
+			if test.expectErr {
+				assert.NotNil(t, err)
+				return
+			}
+
+			require.Nil(t, err)
+			assert.Equal(t, test.expectedTerms, terms)
+		})
+	}
+}
+
+func TestBM25UniqueQueryTerms(t *testing.T) {
+	props := []bm25Property{
+		{name: "title", boost: 3, terms: []string{"hello", "world", "hello"}},
+		{name: "name", boost: 1, terms: []string{"Hello", "world"}},
+	}
+
+	assert.Equal(t, []string{"hello", "world", "Hello"}, uniqueQueryTerms(props))
+	assert.True(t, props[1].hasTerm("Hello"))
+	assert.False(t, props[1].hasTerm("hello"))
+}
+
+func TestBM25PropertiesWithQueryTerms(t *testing.T) {
+	class := &models.Class{
+		Class: "MyClass",
+		Properties: []*models.Property{
+			{
+				Name:         "title",
+				DataType:     []string{string(schema.DataTypeText)},
+				Tokenization: models.PropertyTokenizationWord,
+			},
+			{
+				Name:         "description",
+				DataType:     []string{string(schema.DataTypeText)},
+				Tokenization: models.PropertyTokenizationWord,
+			},
+		},
+	}
+
+	t.Run("with boosted properties", func(t *testing.T) {
+		props, err := (&BM25Searcher{}).propertiesWithQueryTerms(class,
+			&searchparams.KeywordRanking{
+				Query:      "hello world",
+				Properties: []string{"title^2", "description"},
+			})
+		require.Nil(t, err)
+		assert.Equal(t, []bm25Property{
+			{name: "title", boost: 2, terms: []string{"hello", "world"}},
+			{name: "description", boost: 1, terms: []string{"hello", "world"}},
+		}, props)
+	})
+
+	t.Run("with a property set multiple times", func(t *testing.T) {
+		_, err := (&BM25Searcher{}).propertiesWithQueryTerms(class,
+			&searchparams.KeywordRanking{
+				Query:      "hello world",
+				Properties: []string{"title", "title^2"},
+			})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "property \"title\" is set multiple times")
+	})
+}
+
+func TestBM25FScore(t *testing.T) {
+	bm := &BM25Searcher{
+		config: schema.BM25Config{K1: 1.2, B: 0.75},
+		propLengths: fakePropLengths{
+			"title":       4,
+			"description": 10,
+		},
+	}
+
+	props := []bm25Property{
+		{name: "title", boost: 2},
+		{name: "description", boost: 1},
+	}
+	propLists := []docPointersWithScore{
+		{docIDs: []docPointerWithScore{
+			{id: 7, frequency: 1, propLength: 4},
+		}},
+		{docIDs: []docPointerWithScore{
+			{id: 7, frequency: 2, propLength: 10},
+			{id: 3, frequency: 1, propLength: 20},
+		}},
+	}
+
+	res, err := bm.score(propLists, props, 10)
+	require.Nil(t, err)
+
+	// both docs contain the term, so idf = ln(1 + (10-2+0.5)/(2+0.5))
+	//
+	// doc 7 has average lengths in both props, so its weighted tf is
+	// 2*1/1 + 1*2/1 = 4 and its score 4/(4+1.2)*idf.
+	//
+	// doc 3 is twice as long as the average description, so its tf is
+	// normalized by 1-0.75+0.75*2 = 1.75, its score is
+	// (1/1.75)/((1/1.75)+1.2)*idf
+	require.Len(t, res.docIDs, 2)
+	assert.Equal(t, uint64(2), res.count)
+	assert.Equal(t, uint64(3), res.docIDs[0].id)
+	assert.InDelta(t, 0.4779369, res.docIDs[0].score, 1e-6)
+	assert.Equal(t, uint64(7), res.docIDs[1].id)
+	assert.InDelta(t, 1.1396958, res.docIDs[1].score, 1e-6)
+}
+
+type fakePropLengths map[string]float32
+
+func (f fakePropLengths) PropertyMean(prop string) (float32, error) {
+	return f[prop], nil
+}
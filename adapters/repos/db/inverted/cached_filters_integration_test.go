@@ -296,14 +296,14 @@ func Test_CachedFilters_String(t *testing.T) {
 				res, err := searcher.DocIDs(context.Background(), test.filter,
 					additional.Properties{}, className)
 				assert.Nil(t, err)
-				assert.Equal(t, test.expectedListBeforeUpdate(), res)
+				assert.Equal(t, test.expectedListBeforeUpdate().Slice(), res.Slice())
 			})
 
 			t.Run("cache should be filled now", func(t *testing.T) {
 				assert.Equal(t, 1, rowCacher.count)
 				require.NotNil(t, rowCacher.lastEntry)
-				assert.Equal(t, test.expectedListBeforeUpdate(),
-					rowCacher.lastEntry.AllowList)
+				assert.Equal(t, test.expectedListBeforeUpdate().Slice(),
+					rowCacher.lastEntry.AllowList.Slice())
 				assert.Equal(t, 0, rowCacher.hitCount)
 			})
 
@@ -311,7 +311,7 @@ func Test_CachedFilters_String(t *testing.T) {
 				res, err := searcher.DocIDs(context.Background(), test.filter,
 					additional.Properties{}, className)
 				assert.Nil(t, err)
-				assert.Equal(t, test.expectedListBeforeUpdate(), res)
+				assert.Equal(t, test.expectedListBeforeUpdate().Slice(), res.Slice())
 			})
 
 			t.Run("cache should have received a hit", func(t *testing.T) {
@@ -345,7 +345,7 @@ func Test_CachedFilters_String(t *testing.T) {
 				res, err := searcher.DocIDs(context.Background(), test.filter,
 					additional.Properties{}, className)
 				assert.Nil(t, err)
-				assert.Equal(t, test.expectedListAfterUpdate(), res)
+				assert.Equal(t, test.expectedListAfterUpdate().Slice(), res.Slice())
 			})
 
 			t.Run("cache should have not have received another hit", func(t *testing.T) {
@@ -356,7 +356,7 @@ func Test_CachedFilters_String(t *testing.T) {
 				res, err := searcher.DocIDs(context.Background(), test.filter,
 					additional.Properties{}, className)
 				assert.Nil(t, err)
-				assert.Equal(t, test.expectedListAfterUpdate(), res)
+				assert.Equal(t, test.expectedListAfterUpdate().Slice(), res.Slice())
 			})
 
 			t.Run("cache should have received another hit", func(t *testing.T) {
@@ -667,14 +667,14 @@ func Test_CachedFilters_Int(t *testing.T) {
 				res, err := searcher.DocIDs(context.Background(), test.filter,
 					additional.Properties{}, className)
 				assert.Nil(t, err)
-				assert.Equal(t, test.expectedListBeforeUpdate(), res)
+				assert.Equal(t, test.expectedListBeforeUpdate().Slice(), res.Slice())
 			})
 
 			t.Run("cache should be filled now", func(t *testing.T) {
 				assert.Equal(t, 1, rowCacher.count)
 				require.NotNil(t, rowCacher.lastEntry)
-				assert.Equal(t, test.expectedListBeforeUpdate(),
-					rowCacher.lastEntry.AllowList)
+				assert.Equal(t, test.expectedListBeforeUpdate().Slice(),
+					rowCacher.lastEntry.AllowList.Slice())
 				assert.Equal(t, 0, rowCacher.hitCount)
 			})
 
@@ -682,7 +682,7 @@ func Test_CachedFilters_Int(t *testing.T) {
 				res, err := searcher.DocIDs(context.Background(), test.filter,
 					additional.Properties{}, className)
 				assert.Nil(t, err)
-				assert.Equal(t, test.expectedListBeforeUpdate(), res)
+				assert.Equal(t, test.expectedListBeforeUpdate().Slice(), res.Slice())
 			})
 
 			t.Run("cache should have received a hit", func(t *testing.T) {
@@ -703,7 +703,7 @@ func Test_CachedFilters_Int(t *testing.T) {
 				res, err := searcher.DocIDs(context.Background(), test.filter,
 					additional.Properties{}, className)
 				assert.Nil(t, err)
-				assert.Equal(t, test.expectedListAfterUpdate(), res)
+				assert.Equal(t, test.expectedListAfterUpdate().Slice(), res.Slice())
 			})
 
 			t.Run("cache should have not have received another hit", func(t *testing.T) {
@@ -714,7 +714,7 @@ func Test_CachedFilters_Int(t *testing.T) {
 				res, err := searcher.DocIDs(context.Background(), test.filter,
 					additional.Properties{}, className)
 				assert.Nil(t, err)
-				assert.Equal(t, test.expectedListAfterUpdate(), res)
+				assert.Equal(t, test.expectedListAfterUpdate().Slice(), res.Slice())
 			})
 
 			t.Run("cache should have received another hit", func(t *testing.T) {
@@ -791,12 +791,7 @@ func (s *rowCacherSpy) reset() {
 }
 
 func allowList(in ...uint64) helpers.AllowList {
-	list := helpers.AllowList{}
-	for _, elem := range in {
-		list.Insert(elem)
-	}
-
-	return list
+	return helpers.NewAllowList(in...)
 }
 
 // This prevents a regression on
@@ -905,14 +900,14 @@ func Test_DuplicateEntriesInAnd_String(t *testing.T) {
 				res, err := searcher.DocIDs(context.Background(), test.filter,
 					additional.Properties{}, className)
 				assert.Nil(t, err)
-				assert.Equal(t, test.expectedListBeforeUpdate(), res)
+				assert.Equal(t, test.expectedListBeforeUpdate().Slice(), res.Slice())
 			})
 
 			t.Run("cache should be filled now", func(t *testing.T) {
 				assert.Equal(t, 1, rowCacher.count)
 				require.NotNil(t, rowCacher.lastEntry)
-				assert.Equal(t, test.expectedListBeforeUpdate(),
-					rowCacher.lastEntry.AllowList)
+				assert.Equal(t, test.expectedListBeforeUpdate().Slice(),
+					rowCacher.lastEntry.AllowList.Slice())
 				assert.Equal(t, 0, rowCacher.hitCount)
 			})
 
@@ -920,7 +915,7 @@ func Test_DuplicateEntriesInAnd_String(t *testing.T) {
 				res, err := searcher.DocIDs(context.Background(), test.filter,
 					additional.Properties{}, className)
 				assert.Nil(t, err)
-				assert.Equal(t, test.expectedListBeforeUpdate(), res)
+				assert.Equal(t, test.expectedListBeforeUpdate().Slice(), res.Slice())
 			})
 
 			t.Run("cache should have received a hit", func(t *testing.T) {
@@ -953,7 +948,7 @@ func Test_DuplicateEntriesInAnd_String(t *testing.T) {
 				res, err := searcher.DocIDs(context.Background(), test.filter,
 					additional.Properties{}, className)
 				assert.Nil(t, err)
-				assert.Equal(t, test.expectedListAfterUpdate(), res)
+				assert.Equal(t, test.expectedListAfterUpdate().Slice(), res.Slice())
 			})
 
 			t.Run("cache should have not have received another hit", func(t *testing.T) {
@@ -964,7 +959,7 @@ func Test_DuplicateEntriesInAnd_String(t *testing.T) {
 				res, err := searcher.DocIDs(context.Background(), test.filter,
 					additional.Properties{}, className)
 				assert.Nil(t, err)
-				assert.Equal(t, test.expectedListAfterUpdate(), res)
+				assert.Equal(t, test.expectedListAfterUpdate().Slice(), res.Slice())
 			})
 
 			t.Run("cache should have received another hit", func(t *testing.T) {
@@ -20,93 +20,39 @@ import (
 	"github.com/semi-technologies/weaviate/entities/filters"
 )
 
-func BenchmarkAnd10k1m_Old(b *testing.B) {
+func BenchmarkAnd10k1m(b *testing.B) {
 	b.StopTimer()
 
 	list1 := propValuePair{
-		docIDs: docPointers{
-			docIDs:   randomIDs(1e4),
-			checksum: []byte{0x01},
-		},
+		docIDs:   docBitmapFromIDs([]byte{0x01}, randomIDs(1e4)...),
 		operator: filters.OperatorEqual,
 	}
 
 	list2 := propValuePair{
-		docIDs: docPointers{
-			docIDs:   randomIDs(1e6),
-			checksum: []byte{0x02},
-		},
+		docIDs:   docBitmapFromIDs([]byte{0x02}, randomIDs(1e6)...),
 		operator: filters.OperatorEqual,
 	}
 
 	b.StartTimer()
 	for i := 0; i < b.N; i++ {
-		mergeAnd([]*propValuePair{&list1, &list2}, false)
+		mergeAnd([]*propValuePair{&list1, &list2})
 	}
 }
 
-func BenchmarkAnd10k1m_Optimized(b *testing.B) {
-	b.StopTimer()
-
-	list1 := propValuePair{
-		docIDs: docPointers{
-			docIDs:   randomIDs(1e4),
-			checksum: []byte{0x01},
-		},
-		operator: filters.OperatorEqual,
-	}
-
-	list2 := propValuePair{
-		docIDs: docPointers{
-			docIDs:   randomIDs(1e6),
-			checksum: []byte{0x02},
-		},
-		operator: filters.OperatorEqual,
-	}
-
-	b.StartTimer()
-	for i := 0; i < b.N; i++ {
-		mergeAndOptimized([]*propValuePair{&list1, &list2}, false)
-	}
-}
-
-func BenchmarkMultipleListsOf20k_Old(b *testing.B) {
-	b.StopTimer()
-
-	lists := make([]*propValuePair, 10)
-	for i := range lists {
-		lists[i] = &propValuePair{
-			docIDs: docPointers{
-				docIDs:   randomIDs(2e4),
-				checksum: []byte{uint8(i)},
-			},
-			operator: filters.OperatorEqual,
-		}
-	}
-
-	b.StartTimer()
-	for i := 0; i < b.N; i++ {
-		mergeAnd(lists, false)
-	}
-}
-
-func BenchmarkMultipleListsOf20k_Optimized(b *testing.B) {
+func BenchmarkMultipleListsOf20k(b *testing.B) {
 	b.StopTimer()
 
 	lists := make([]*propValuePair, 10)
 	for i := range lists {
 		lists[i] = &propValuePair{
-			docIDs: docPointers{
-				docIDs:   randomIDs(2e4),
-				checksum: []byte{uint8(i)},
-			},
+			docIDs:   docBitmapFromIDs([]byte{uint8(i)}, randomIDs(2e4)...),
 			operator: filters.OperatorEqual,
 		}
 	}
 
 	b.StartTimer()
 	for i := 0; i < b.N; i++ {
-		mergeAndOptimized(lists, false)
+		mergeAnd(lists)
 	}
 }
 
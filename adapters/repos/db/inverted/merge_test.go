@@ -19,102 +19,90 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-func TestMergeAnd_Old(t *testing.T) {
+func TestMergeAnd(t *testing.T) {
 	list1 := propValuePair{
-		docIDs: docPointers{
-			docIDs: []uint64{
-				7, 8, 9, 10, 11,
-			},
-			checksum: []byte{0x01},
-		},
+		docIDs:   docBitmapFromIDs([]byte{0x01}, 7, 8, 9, 10, 11),
 		operator: filters.OperatorEqual,
 	}
 
 	list2 := propValuePair{
-		docIDs: docPointers{
-			docIDs: []uint64{
-				1, 3, 5, 7, 9, 11,
-			},
-			checksum: []byte{0x02},
-		},
+		docIDs:   docBitmapFromIDs([]byte{0x02}, 1, 3, 5, 7, 9, 11),
 		operator: filters.OperatorEqual,
 	}
 
 	list3 := propValuePair{
-		docIDs: docPointers{
-			docIDs: []uint64{
-				1, 3, 5, 7, 9,
-			},
-			checksum: []byte{0x03},
-		},
+		docIDs:   docBitmapFromIDs([]byte{0x03}, 1, 3, 5, 7, 9),
 		operator: filters.OperatorEqual,
 	}
 
 	list4 := propValuePair{
-		docIDs: docPointers{
-			docIDs: []uint64{
-				1, 3, 5, 7,
-			},
-			checksum: []byte{0x04},
-		},
+		docIDs:   docBitmapFromIDs([]byte{0x04}, 1, 3, 5, 7),
 		operator: filters.OperatorEqual,
 	}
 
-	res, err := mergeAnd([]*propValuePair{&list1, &list2, &list3, &list4}, false)
+	res, err := mergeAnd([]*propValuePair{&list1, &list2, &list3, &list4})
 	require.Nil(t, err)
 
-	expectedPointers := []uint64{
-		7,
-	}
+	assert.Equal(t, []uint64{7}, res.IDs())
 
-	assert.ElementsMatch(t, expectedPointers, res.docIDs)
+	t.Run("the children are not altered", func(t *testing.T) {
+		assert.Equal(t, []uint64{1, 3, 5, 7}, list4.docIDs.IDs())
+	})
 }
 
-func TestMergeAnd_Optimized(t *testing.T) {
+func TestMergeOr(t *testing.T) {
 	list1 := propValuePair{
-		docIDs: docPointers{
-			docIDs: []uint64{
-				7, 8, 9, 10, 11,
-			},
-			checksum: []byte{0x01},
-		},
+		docIDs:   docBitmapFromIDs([]byte{0x01}, 7, 8, 9),
 		operator: filters.OperatorEqual,
 	}
 
 	list2 := propValuePair{
-		docIDs: docPointers{
-			docIDs: []uint64{
-				1, 3, 5, 7, 9, 11,
-			},
-			checksum: []byte{0x02},
-		},
+		docIDs:   docBitmapFromIDs([]byte{0x02}, 1, 3, 7),
 		operator: filters.OperatorEqual,
 	}
 
-	list3 := propValuePair{
-		docIDs: docPointers{
-			docIDs: []uint64{
-				1, 3, 5, 7, 9,
+	res, err := mergeOr([]*propValuePair{&list1, &list2})
+	require.Nil(t, err)
+
+	assert.Equal(t, []uint64{1, 3, 7, 8, 9}, res.IDs())
+}
+
+func TestMergeNested(t *testing.T) {
+	// (a OR b) AND c
+	or := propValuePair{
+		operator: filters.OperatorOr,
+		children: []*propValuePair{
+			{
+				docIDs:   docBitmapFromIDs([]byte{0x01}, 1, 2),
+				operator: filters.OperatorEqual,
+			},
+			{
+				docIDs:   docBitmapFromIDs([]byte{0x02}, 3, 4),
+				operator: filters.OperatorEqual,
 			},
-			checksum: []byte{0x03},
 		},
-		operator: filters.OperatorEqual,
 	}
 
-	list4 := propValuePair{
-		docIDs: docPointers{
-			docIDs: []uint64{
-				1, 3, 5, 7,
+	and := propValuePair{
+		operator: filters.OperatorAnd,
+		children: []*propValuePair{
+			&or,
+			{
+				docIDs:   docBitmapFromIDs([]byte{0x03}, 2, 3, 5),
+				operator: filters.OperatorEqual,
 			},
-			checksum: []byte{0x04},
 		},
-		operator: filters.OperatorEqual,
 	}
 
-	res, err := mergeAndOptimized([]*propValuePair{&list1, &list2, &list3, &list4}, false)
+	res, err := and.mergeDocIDs()
 	require.Nil(t, err)
 
-	expectedPointers := []uint64{7}
+	assert.Equal(t, []uint64{2, 3}, res.IDs())
+}
 
-	assert.ElementsMatch(t, expectedPointers, res.docIDs)
+func docBitmapFromIDs(checksum []byte, ids ...uint64) docBitmap {
+	out := newDocBitmap()
+	out.docIDs.AddMany(ids)
+	out.checksum = checksum
+	return out
 }
@@ -216,14 +216,22 @@ func (a *Analyzer) analyzeArrayProp(prop *models.Property, values []interface{})
 		if err != nil {
 			return nil, err
 		}
-		items = a.TextArray(prop.Tokenization, in)
+		pa, err := a.forProperty(prop)
+		if err != nil {
+			return nil, err
+		}
+		items = pa.TextArray(prop.Tokenization, in)
 	case schema.DataTypeStringArray:
 		hasFrequency = HasFrequency(dt)
 		in, err := stringsFromValues(prop, values)
 		if err != nil {
 			return nil, err
 		}
-		items = a.StringArray(prop.Tokenization, in)
+		pa, err := a.forProperty(prop)
+		if err != nil {
+			return nil, err
+		}
+		items = pa.StringArray(prop.Tokenization, in)
 	case schema.DataTypeIntArray:
 		hasFrequency = HasFrequency(dt)
 		in := make([]int64, len(values))
@@ -266,6 +274,12 @@ func (a *Analyzer) analyzeArrayProp(prop *models.Property, values []interface{})
 				}
 			}
 
+			if asInt, ok := value.(int64); ok {
+				// an int prop which is changed to number is still written as int
+				// until the change is complete
+				value = float64(asInt)
+			}
+
 			asFloat, ok := value.(float64)
 			if !ok {
 				return nil, fmt.Errorf("expected property %s to be of type float64, but got %T", prop.Name, value)
@@ -355,7 +369,11 @@ func (a *Analyzer) analyzePrimitiveProp(prop *models.Property, value interface{}
 		if !ok {
 			return nil, fmt.Errorf("expected property %s to be of type string, but got %T", prop.Name, value)
 		}
-		items = a.Text(prop.Tokenization, asString)
+		pa, err := a.forProperty(prop)
+		if err != nil {
+			return nil, err
+		}
+		items = pa.Text(prop.Tokenization, asString)
 		propertyLength = utf8.RuneCountInString(asString)
 	case schema.DataTypeString:
 		hasFrequency = HasFrequency(dt)
@@ -363,7 +381,11 @@ func (a *Analyzer) analyzePrimitiveProp(prop *models.Property, value interface{}
 		if !ok {
 			return nil, fmt.Errorf("expected property %s to be of type string, but got %T", prop.Name, value)
 		}
-		items = a.String(prop.Tokenization, asString)
+		pa, err := a.forProperty(prop)
+		if err != nil {
+			return nil, err
+		}
+		items = pa.String(prop.Tokenization, asString)
 		propertyLength = utf8.RuneCountInString(asString)
 	case schema.DataTypeInt:
 		hasFrequency = HasFrequency(dt)
@@ -390,6 +412,12 @@ func (a *Analyzer) analyzePrimitiveProp(prop *models.Property, value interface{}
 		}
 	case schema.DataTypeNumber:
 		hasFrequency = HasFrequency(dt)
+		if asInt, ok := value.(int64); ok {
+			// an int prop which is changed to number is still written as int
+			// until the change is complete
+			value = float64(asInt)
+		}
+
 		asFloat, ok := value.(float64)
 		if !ok {
 			return nil, fmt.Errorf("expected property %s to be of type float64, but got %T", prop.Name, value)
@@ -629,6 +629,42 @@ func TestAnalyzeObject(t *testing.T) {
 			assert.ElementsMatch(t, expected[i].Items, res[i].Items)
 		}
 	})
+
+	t.Run("with int values of number props", func(t *testing.T) {
+		schema := map[string]interface{}{
+			"number":  int64(3),
+			"numbers": []interface{}{int64(1), float64(2.5)},
+		}
+
+		props := []*models.Property{
+			{
+				Name:     "number",
+				DataType: []string{"number"},
+			},
+			{
+				Name:     "numbers",
+				DataType: []string{"number[]"},
+			},
+		}
+		res, err := a.Object(schema, props, strfmt.UUID("2609f1bc-7693-48f3-b531-6ddc52cd2501"))
+		require.Nil(t, err)
+
+		var actualNumber, actualNumbers []Countable
+		for _, elem := range res {
+			switch elem.Name {
+			case "number":
+				actualNumber = elem.Items
+			case "numbers":
+				actualNumbers = elem.Items
+			}
+		}
+
+		assert.ElementsMatch(t, []Countable{{Data: mustGetByteFloatNumber(3)}}, actualNumber)
+		assert.ElementsMatch(t, []Countable{
+			{Data: mustGetByteFloatNumber(1)},
+			{Data: mustGetByteFloatNumber(2.5)},
+		}, actualNumbers)
+	})
 }
 
 func TestConvertSliceToUntyped(t *testing.T) {
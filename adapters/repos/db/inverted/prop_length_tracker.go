@@ -244,9 +244,31 @@ func (t *PropertyLengthTracker) PropertyMean(propName string) (float32, error) {
 		bucket++
 	}
 
+	if totalCount == 0 {
+		return 0, nil
+	}
+
 	return sum / totalCount, nil
 }
 
+// ResetProperty clears all tracked lengths of the prop, so that a prop of the
+// same name which is added later does not inherit them. The prop keeps its
+// place in the index.
+func (t *PropertyLengthTracker) ResetProperty(propName string) {
+	t.Lock()
+	defer t.Unlock()
+
+	page, offset, ok := t.propExists(propName)
+	if !ok {
+		return
+	}
+
+	offset = offset + page*4096
+	for o := offset; o < offset+256; o++ {
+		t.pages[o] = 0
+	}
+}
+
 func (t *PropertyLengthTracker) createPageIfNotExists(page uint16) {
 	if uint16(len(t.pages))/4096-1 < page {
 		// we need to grow the page buffer
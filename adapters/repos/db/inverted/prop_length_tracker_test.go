@@ -233,3 +233,43 @@ func Test_PropertyLengthTracker_Persistence(t *testing.T) {
 		assert.InEpsilon(t, actualMeanForProp20, res, 0.1)
 	})
 }
+
+func Test_PropertyLengthTracker_ResetProperty(t *testing.T) {
+	tracker, err := NewPropertyLengthTracker(path.Join(t.TempDir(), "my_test_shard"))
+	require.Nil(t, err)
+	defer tracker.Close()
+
+	create20PropsAndVerify(t, tracker)
+
+	t.Run("reset props on both pages", func(t *testing.T) {
+		tracker.ResetProperty("prop_0")
+		tracker.ResetProperty("prop_19")
+		// resetting an unknown prop is a no-op
+		tracker.ResetProperty("unknown")
+
+		for _, propName := range []string{"prop_0", "prop_19"} {
+			res, err := tracker.PropertyMean(propName)
+			require.Nil(t, err)
+			assert.Equal(t, float32(0), res)
+		}
+	})
+
+	t.Run("other props are not affected", func(t *testing.T) {
+		res, err := tracker.PropertyMean("prop_1")
+		require.Nil(t, err)
+		assert.InEpsilon(t, float32(1+4+3+17)/4.0, res, 0.1)
+
+		res, err = tracker.PropertyMean("prop_18")
+		require.Nil(t, err)
+		assert.InEpsilon(t, float32(1+4+3+17)/4.0, res, 0.1)
+	})
+
+	t.Run("a reset prop only tracks new lengths", func(t *testing.T) {
+		tracker.TrackProperty("prop_19", 2)
+		tracker.TrackProperty("prop_19", 4)
+
+		res, err := tracker.PropertyMean("prop_19")
+		require.Nil(t, err)
+		assert.InEpsilon(t, float32(3), res, 0.1)
+	})
+}
@@ -14,8 +14,7 @@ package inverted
 import (
 	"bytes"
 	"fmt"
-
-	"github.com/semi-technologies/weaviate/entities/schema"
+	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
@@ -34,13 +33,11 @@ type propValuePair struct {
 	// byte value from an inverted index
 	valueGeoRange *filters.GeoRange
 	hasFrequency  bool
-	docIDs        docPointers
+	docIDs        docBitmap
 	children      []*propValuePair
 }
 
-func (pv *propValuePair) fetchDocIDs(s *Searcher, limit int,
-	tolerateDuplicates bool,
-) error {
+func (pv *propValuePair) fetchDocIDs(s *Searcher, limit int) error {
 	if pv.operator.OnValue() {
 		id := helpers.BucketFromPropNameLSM(pv.prop)
 		if pv.prop == filters.InternalPropBackwardsCompatID {
@@ -51,44 +48,14 @@ func (pv *propValuePair) fetchDocIDs(s *Searcher, limit int,
 			pv.hasFrequency = false
 		}
 
-		if pv.operator == filters.OperatorIsNull {
-			id += filters.InternalNullIndex
-		}
-
-		// format of id for property with lengths is "property_len(*PROPNAME*)
-		propName, isPropLengthFilter := schema.IsPropertyLength(id, 9)
-		if isPropLengthFilter {
-			id = helpers.BucketFromPropNameLSM(propName + filters.InternalPropertyLength)
-			pv.prop = propName + filters.InternalPropertyLength
-		}
-
 		b := s.store.Bucket(id)
-
-		if b == nil && isPropLengthFilter {
-			return errors.Errorf("Property length must be indexed to be filterable! " +
-				"add `IndexPropertyLength: true` to the invertedIndexConfig." +
-				"Geo-coordinates, phone numbers and data blobs are not supported by property length.")
-		}
-
-		if b == nil && pv.operator == filters.OperatorIsNull {
-			return errors.Errorf("Nullstate must be indexed to be filterable! " +
-				"add `indexNullState: true` to the invertedIndexConfig")
-		}
-
-		if b == nil && (pv.prop == filters.InternalPropCreationTimeUnix ||
-			pv.prop == filters.InternalPropLastUpdateTimeUnix) {
-			return errors.Errorf("timestamps must be indexed to be filterable! " +
-				"add `indexTimestaps: true` to the invertedIndexConfig")
-		}
-
-		if b == nil && pv.operator != filters.OperatorWithinGeoRange {
-			// a nil bucket is ok for a WithinGeoRange filter, as this query is not
-			// served by the inverted index, but propagated to a secondary index in
-			// .docPointers()
-			return errors.Errorf("bucket for prop %s not found - is it indexed?", pv.prop)
+		if b == nil {
+			if err := pv.missingBucketErr(); err != nil {
+				return err
+			}
 		}
 
-		pointers, err := s.docPointers(id, b, limit, pv, tolerateDuplicates)
+		pointers, err := s.docBitmap(b, limit, pv)
 		if err != nil {
 			return err
 		}
@@ -100,7 +67,7 @@ func (pv *propValuePair) fetchDocIDs(s *Searcher, limit int,
 			// otherwise we run into situations where each subfilter on their own
 			// runs into the limit, possibly yielding in "less than limit" results
 			// after merging.
-			err := child.fetchDocIDs(s, 0, tolerateDuplicates)
+			err := child.fetchDocIDs(s, 0)
 			if err != nil {
 				return errors.Wrapf(err, "nested child %d", i)
 			}
@@ -110,123 +77,48 @@ func (pv *propValuePair) fetchDocIDs(s *Searcher, limit int,
 	return nil
 }
 
+// missingBucketErr explains why the bucket of an indexing option that is
+// turned off is missing. It returns nil for a WithinGeoRange filter, as this
+// query is not served by the inverted index, but propagated to a secondary
+// index in .docBitmap()
+func (pv *propValuePair) missingBucketErr() error {
+	switch {
+	case strings.HasSuffix(pv.prop, filters.InternalPropertyLength):
+		return errors.Errorf("Property length must be indexed to be filterable! " +
+			"add `IndexPropertyLength: true` to the invertedIndexConfig." +
+			"Geo-coordinates, phone numbers and data blobs are not supported by property length.")
+	case pv.operator == filters.OperatorIsNull:
+		return errors.Errorf("Nullstate must be indexed to be filterable! " +
+			"add `indexNullState: true` to the invertedIndexConfig")
+	case pv.prop == filters.InternalPropCreationTimeUnix ||
+		pv.prop == filters.InternalPropLastUpdateTimeUnix:
+		return errors.Errorf("timestamps must be indexed to be filterable! " +
+			"add `indexTimestaps: true` to the invertedIndexConfig")
+	case pv.operator == filters.OperatorWithinGeoRange:
+		return nil
+	default:
+		return errors.Errorf("bucket for prop %s not found - is it indexed?", pv.prop)
+	}
+}
+
 // if duplicates are acceptable, simpler (and faster) algorithms can be used
 // for merging
-func (pv *propValuePair) mergeDocIDs(acceptDuplicates bool) (*docPointers, error) {
+func (pv *propValuePair) mergeDocIDs() (*docBitmap, error) {
 	if pv.operator.OnValue() {
 		return &pv.docIDs, nil
 	}
 
 	switch pv.operator {
 	case filters.OperatorAnd:
-		return mergeAndOptimized(pv.children, acceptDuplicates)
+		return mergeAnd(pv.children)
 	case filters.OperatorOr:
-		return mergeOr(pv.children, acceptDuplicates)
+		return mergeOr(pv.children)
 	default:
 		return nil, fmt.Errorf("unsupported operator: %s", pv.operator.Name())
 	}
 }
 
-// TODO: Delete?
-// This is only left so we can use it as a control or baselines in tests and
-// benchmkarks against the newer optimized version.
-func mergeAnd(children []*propValuePair, acceptDuplicates bool) (*docPointers, error) {
-	sets := make([]*docPointers, len(children))
-
-	// retrieve child IDs
-	for i, child := range children {
-		docIDs, err := child.mergeDocIDs(acceptDuplicates)
-		if err != nil {
-			return nil, errors.Wrapf(err, "retrieve doc ids of child %d", i)
-		}
-
-		sets[i] = docIDs
-	}
-
-	if checksumsIdentical(sets) {
-		// all children are identical, no need to merge, simply return the first
-		// set
-		return sets[0], nil
-	}
-
-	// merge AND
-	found := map[uint64]uint64{} // map[id]count
-	for _, set := range sets {
-		for _, pointer := range set.docIDs {
-			count := found[pointer]
-			count++
-			found[pointer] = count
-		}
-	}
-
-	var out docPointers
-	var idsForChecksum []uint64
-	for id, count := range found {
-		if count != uint64(len(sets)) {
-			continue
-		}
-
-		// TODO: optimize to use fixed length slice and cut off (should be
-		// considerably cheaper on very long lists, such as we encounter during
-		// large classification cases
-		out.docIDs = append(out.docIDs, id)
-		idsForChecksum = append(idsForChecksum, id)
-	}
-
-	checksum, err := docPointerChecksum(idsForChecksum)
-	if err != nil {
-		return nil, errors.Wrapf(err, "calculate checksum")
-	}
-
-	out.checksum = checksum
-	return &out, nil
-}
-
-func mergeOr(children []*propValuePair, acceptDuplicates bool) (*docPointers, error) {
-	sets := make([]*docPointers, len(children))
-
-	// retrieve child IDs
-	for i, child := range children {
-		docIDs, err := child.mergeDocIDs(acceptDuplicates)
-		if err != nil {
-			return nil, errors.Wrapf(err, "retrieve doc ids of child %d", i)
-		}
-
-		sets[i] = docIDs
-	}
-
-	if checksumsIdentical(sets) {
-		// all children are identical, no need to merge, simply return the first
-		// set
-		return sets[0], nil
-	}
-
-	if acceptDuplicates {
-		return mergeOrAcceptDuplicates(sets)
-	}
-
-	// merge OR
-	var checksums [][]byte
-	found := map[uint64]uint64{} // map[id]count
-	for _, set := range sets {
-		for _, pointer := range set.docIDs {
-			count := found[pointer]
-			count++
-			found[pointer] = count
-		}
-		checksums = append(checksums, set.checksum)
-	}
-
-	var out docPointers
-	for id := range found {
-		out.docIDs = append(out.docIDs, id)
-	}
-
-	out.checksum = combineChecksums(checksums, filters.OperatorOr)
-	return &out, nil
-}
-
-func checksumsIdentical(sets []*docPointers) bool {
+func checksumsIdentical(sets []*docBitmap) bool {
 	if len(sets) == 0 {
 		return false
 	}
@@ -14,6 +14,7 @@ package inverted
 import (
 	"context"
 
+	"github.com/RoaringBitmap/roaring/roaring64"
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/lsmkv"
@@ -31,7 +32,7 @@ func (pv *propValuePair) cacheable() bool {
 	case filters.OperatorEqual, filters.OperatorAnd, filters.OperatorOr,
 		filters.OperatorGreaterThan, filters.OperatorGreaterThanEqual,
 		filters.OperatorLessThan, filters.OperatorLessThanEqual,
-		filters.OperatorNotEqual, filters.OperatorLike:
+		filters.OperatorNotEqual, filters.OperatorLike, filters.OperatorIsNull:
 		return true
 	default:
 		return false
@@ -50,12 +51,16 @@ func (pv *propValuePair) fetchHashes(s *Searcher) error {
 		bucketName := helpers.HashBucketFromPropNameLSM(pv.prop)
 		b := s.store.Bucket(bucketName)
 		if b == nil && pv.operator != filters.OperatorWithinGeoRange {
+			if s.store.Bucket(helpers.BucketFromPropNameLSM(pv.prop)) == nil {
+				return pv.missingBucketErr()
+			}
 			return errors.Errorf("hash bucket for prop %s not found - is it indexed?", pv.prop)
 		}
 
 		var hash []byte
 		var err error
-		if pv.operator == filters.OperatorEqual {
+		if pv.operator == filters.OperatorEqual || pv.operator == filters.OperatorIsNull {
+			// the null state is a single row, just like an equal
 			hash, err = b.Get(pv.value)
 			if err != nil {
 				return err
@@ -88,6 +93,11 @@ func (pv *propValuePair) fetchHashes(s *Searcher) error {
 func (pv *propValuePair) hashForNonEqualOp(store *lsmkv.Store,
 	hashBucket *lsmkv.Bucket, shardVersion uint16,
 ) ([]byte, error) {
+	if !pv.hasFrequency && IsRangeableOperator(pv.operator) &&
+		store.Bucket(helpers.BucketRangeableFromPropNameLSM(pv.prop)) != nil {
+		return pv.hashForRangeable(store)
+	}
+
 	bucketName := helpers.BucketFromPropNameLSM(pv.prop)
 	propBucket := store.Bucket(bucketName)
 	if propBucket == nil && pv.operator != filters.OperatorWithinGeoRange {
@@ -97,9 +107,45 @@ func (pv *propValuePair) hashForNonEqualOp(store *lsmkv.Store,
 	if pv.hasFrequency {
 		return pv.hashForNonEqualOpWithFrequency(propBucket, hashBucket, shardVersion)
 	}
+	if propBucket.Strategy() == lsmkv.StrategyRoaringSet {
+		return pv.hashForNonEqualOpRoaringSet(propBucket, hashBucket)
+	}
 	return pv.hashForNonEqualOpWithoutFrequency(propBucket, hashBucket)
 }
 
+// hashForRangeable uses the single hash of the rangeable index, as ranges
+// served by it do not read individual rows. The value and operator are part
+// of the checksum, as the hash alone is the same for any range on this prop.
+func (pv *propValuePair) hashForRangeable(store *lsmkv.Store) ([]byte, error) {
+	hashBucket := store.Bucket(helpers.HashBucketRangeableFromPropNameLSM(pv.prop))
+	if hashBucket == nil {
+		return nil, errors.Errorf("no rangeable hash bucket for prop '%s' found", pv.prop)
+	}
+
+	hash, err := hashBucket.Get(RangeableHashKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "get rangeable hash")
+	}
+
+	return rangeableChecksum(hash, pv.value, pv.operator), nil
+}
+
+func (pv *propValuePair) hashForNonEqualOpRoaringSet(propBucket,
+	hashBucket *lsmkv.Bucket,
+) ([]byte, error) {
+	rr := NewRowReaderRoaringSet(propBucket, pv.value, pv.operator, true)
+
+	var keys [][]byte
+	if err := rr.Read(context.TODO(), func(k []byte, v *roaring64.Bitmap) (bool, error) {
+		keys = append(keys, k)
+		return true, nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "read row")
+	}
+
+	return hashesForKeys(hashBucket, keys, pv.operator)
+}
+
 func (pv *propValuePair) hashForNonEqualOpWithoutFrequency(propBucket,
 	hashBucket *lsmkv.Bucket,
 ) ([]byte, error) {
@@ -113,6 +159,12 @@ func (pv *propValuePair) hashForNonEqualOpWithoutFrequency(propBucket,
 		return nil, errors.Wrap(err, "read row")
 	}
 
+	return hashesForKeys(hashBucket, keys, pv.operator)
+}
+
+func hashesForKeys(hashBucket *lsmkv.Bucket, keys [][]byte,
+	operator filters.Operator,
+) ([]byte, error) {
 	hashes := make([][]byte, len(keys))
 	for i, key := range keys {
 		h, err := hashBucket.Get(key)
@@ -122,7 +174,7 @@ func (pv *propValuePair) hashForNonEqualOpWithoutFrequency(propBucket,
 		hashes[i] = h
 	}
 
-	return combineChecksums(hashes, pv.operator), nil
+	return combineChecksums(hashes, operator), nil
 }
 
 func (pv *propValuePair) hashForNonEqualOpWithFrequency(propBucket,
@@ -138,14 +190,5 @@ func (pv *propValuePair) hashForNonEqualOpWithFrequency(propBucket,
 		return nil, errors.Wrap(err, "read row")
 	}
 
-	hashes := make([][]byte, len(keys))
-	for i, key := range keys {
-		h, err := hashBucket.Get(key)
-		if err != nil {
-			return nil, errors.Wrapf(err, "get hash for key %v", key)
-		}
-		hashes[i] = h
-	}
-
-	return combineChecksums(hashes, pv.operator), nil
+	return hashesForKeys(hashBucket, keys, pv.operator)
 }
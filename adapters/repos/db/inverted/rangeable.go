@@ -0,0 +1,239 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package inverted
+
+import (
+	"encoding/binary"
+
+	"github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/lsmkv"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/schema"
+)
+
+// The rangeable index is a bit-sliced index over the lexicographically
+// sortable 8-byte representation of int, number and date values (see
+// LexicographicallySortableInt64 and LexicographicallySortableFloat64). As
+// those representations preserve the order of the original values when
+// compared as unsigned big-endian integers, a range filter can be answered
+// with a fixed number of bitmap operations, regardless of how many distinct
+// values there are.
+//
+// The index is stored in a bucket with the roaringset strategy. There is one
+// row per bit, holding the doc ids that have the bit set in their value, and
+// an additional row holding all doc ids that have any value at all.
+const rangeableBits = 64
+
+var (
+	rangeableNonNullKey = []byte{rangeableBits}
+
+	// RangeableHashKey is the only key in the hash bucket of a rangeable index.
+	// Any write to the index must replace its hash to invalidate cached
+	// filters.
+	RangeableHashKey = []byte("rangeable")
+)
+
+func rangeableSliceKey(bit int) []byte {
+	return []byte{uint8(bit)}
+}
+
+// IsRangeableDataType indicates whether properties of this data type get a
+// rangeable index in addition to the regular filterable index. Array types
+// are excluded as the bit-sliced index can only hold one value per doc id.
+func IsRangeableDataType(dt schema.DataType) bool {
+	switch dt {
+	case schema.DataTypeInt, schema.DataTypeNumber, schema.DataTypeDate:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsRangeableOperator indicates whether an operator can be served by a
+// rangeable index
+func IsRangeableOperator(op filters.Operator) bool {
+	switch op {
+	case filters.OperatorGreaterThan, filters.OperatorGreaterThanEqual,
+		filters.OperatorLessThan, filters.OperatorLessThanEqual:
+		return true
+	default:
+		return false
+	}
+}
+
+func rangeableValue(value []byte) (uint64, error) {
+	if len(value) != 8 {
+		return 0, errors.Errorf("rangeable index requires 8-byte values, got %d bytes",
+			len(value))
+	}
+
+	return binary.BigEndian.Uint64(value), nil
+}
+
+// AddToRangeable adds the doc id with the specified value to the rangeable
+// index in b
+func AddToRangeable(b *lsmkv.Bucket, value []byte, docID uint64) error {
+	v, err := rangeableValue(value)
+	if err != nil {
+		return err
+	}
+
+	for bit := 0; bit < rangeableBits; bit++ {
+		if v&(1<<bit) == 0 {
+			continue
+		}
+
+		if err := b.RoaringSetAddOne(rangeableSliceKey(bit), docID); err != nil {
+			return errors.Wrapf(err, "add to bit slice %d", bit)
+		}
+	}
+
+	return b.RoaringSetAddOne(rangeableNonNullKey, docID)
+}
+
+// RemoveFromRangeable removes the doc id with the specified value from the
+// rangeable index in b. The value must be the one that was previously added
+// for this doc id.
+func RemoveFromRangeable(b *lsmkv.Bucket, value []byte, docID uint64) error {
+	v, err := rangeableValue(value)
+	if err != nil {
+		return err
+	}
+
+	for bit := 0; bit < rangeableBits; bit++ {
+		if v&(1<<bit) == 0 {
+			continue
+		}
+
+		if err := b.RoaringSetRemoveOne(rangeableSliceKey(bit), docID); err != nil {
+			return errors.Wrapf(err, "remove from bit slice %d", bit)
+		}
+	}
+
+	return b.RoaringSetRemoveOne(rangeableNonNullKey, docID)
+}
+
+// RangeableBuilder collects a complete rangeable index in memory, so it can
+// be written with a single write per row. This is used to build the index
+// from an existing filterable index.
+type RangeableBuilder struct {
+	slices  [rangeableBits]*roaring64.Bitmap
+	nonNull *roaring64.Bitmap
+}
+
+func NewRangeableBuilder() *RangeableBuilder {
+	rb := &RangeableBuilder{nonNull: roaring64.New()}
+	for i := range rb.slices {
+		rb.slices[i] = roaring64.New()
+	}
+	return rb
+}
+
+// Add adds all doc ids that share the specified value
+func (rb *RangeableBuilder) Add(value []byte, docIDs *roaring64.Bitmap) error {
+	v, err := rangeableValue(value)
+	if err != nil {
+		return err
+	}
+
+	for bit := 0; bit < rangeableBits; bit++ {
+		if v&(1<<bit) != 0 {
+			rb.slices[bit].Or(docIDs)
+		}
+	}
+	rb.nonNull.Or(docIDs)
+
+	return nil
+}
+
+// WriteTo writes the collected index to b
+func (rb *RangeableBuilder) WriteTo(b *lsmkv.Bucket) error {
+	for bit, slice := range rb.slices {
+		if slice.IsEmpty() {
+			continue
+		}
+
+		if err := b.RoaringSetAddBitmap(rangeableSliceKey(bit), slice); err != nil {
+			return errors.Wrapf(err, "write bit slice %d", bit)
+		}
+	}
+
+	if rb.nonNull.IsEmpty() {
+		return nil
+	}
+
+	return b.RoaringSetAddBitmap(rangeableNonNullKey, rb.nonNull)
+}
+
+// RangeableReader serves range filters from a rangeable index
+type RangeableReader struct {
+	bucket *lsmkv.Bucket
+}
+
+func NewRangeableReader(bucket *lsmkv.Bucket) *RangeableReader {
+	return &RangeableReader{bucket: bucket}
+}
+
+// Read returns the doc ids whose values match the range described by the
+// operator and value. Starting with all doc ids that have a value, the bit
+// slices are compared from the most significant bit downwards. At every bit
+// where the candidates still equal the value so far, those which differ at
+// the current bit are either greater or less than the value and are set
+// aside.
+func (rr *RangeableReader) Read(value []byte, operator filters.Operator) (*roaring64.Bitmap, error) {
+	if !IsRangeableOperator(operator) {
+		return nil, errors.Errorf("operator %s not supported by rangeable index",
+			operator.Name())
+	}
+
+	v, err := rangeableValue(value)
+	if err != nil {
+		return nil, err
+	}
+
+	eq, err := rr.bucket.RoaringSetGet(rangeableNonNullKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "read non-null row")
+	}
+
+	gt := roaring64.New()
+	lt := roaring64.New()
+
+	for bit := rangeableBits - 1; bit >= 0 && !eq.IsEmpty(); bit-- {
+		slice, err := rr.bucket.RoaringSetGet(rangeableSliceKey(bit))
+		if err != nil {
+			return nil, errors.Wrapf(err, "read bit slice %d", bit)
+		}
+
+		if v&(1<<bit) != 0 {
+			lt.Or(roaring64.AndNot(eq, slice))
+			eq.And(slice)
+		} else {
+			gt.Or(roaring64.And(eq, slice))
+			eq.AndNot(slice)
+		}
+	}
+
+	switch operator {
+	case filters.OperatorGreaterThan:
+		return gt, nil
+	case filters.OperatorGreaterThanEqual:
+		gt.Or(eq)
+		return gt, nil
+	case filters.OperatorLessThan:
+		return lt, nil
+	default:
+		lt.Or(eq)
+		return lt, nil
+	}
+}
@@ -0,0 +1,159 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package inverted
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/lsmkv"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Rangeable(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+
+	newBucket := func(t *testing.T) *lsmkv.Bucket {
+		b, err := lsmkv.NewBucket(ctx, t.TempDir(), "", logger, nil,
+			lsmkv.WithStrategy(lsmkv.StrategyRoaringSet))
+		require.Nil(t, err)
+		return b
+	}
+
+	operators := []filters.Operator{
+		filters.OperatorGreaterThan, filters.OperatorGreaterThanEqual,
+		filters.OperatorLessThan, filters.OperatorLessThanEqual,
+	}
+
+	// control evaluates the operator by comparing the sortable bytes, which is
+	// what the row-based readers do
+	control := func(values map[uint64]float64, value float64,
+		op filters.Operator,
+	) []uint64 {
+		out := []uint64{}
+		for docID, v := range values {
+			switch {
+			case op == filters.OperatorGreaterThan && v > value,
+				op == filters.OperatorGreaterThanEqual && v >= value,
+				op == filters.OperatorLessThan && v < value,
+				op == filters.OperatorLessThanEqual && v <= value:
+				out = append(out, docID)
+			}
+		}
+		return out
+	}
+
+	assertRanges := func(t *testing.T, b *lsmkv.Bucket, values map[uint64]float64,
+		queries []float64,
+	) {
+		rr := NewRangeableReader(b)
+		for _, query := range queries {
+			queryBytes, err := LexicographicallySortableFloat64(query)
+			require.Nil(t, err)
+
+			for _, op := range operators {
+				res, err := rr.Read(queryBytes, op)
+				require.Nil(t, err)
+				assert.ElementsMatch(t, control(values, query, op), res.ToArray(),
+					"%s %f", op.Name(), query)
+			}
+		}
+	}
+
+	values := map[uint64]float64{}
+	for docID := uint64(0); docID < 500; docID++ {
+		values[docID] = float64(rand.Intn(200)-100) + rand.Float64()
+	}
+	values[500] = 0
+	values[501] = -0.5
+	values[502] = 17
+
+	queries := []float64{-1000, -100, -0.5, 0, 17, 42.3, 100.1, 1000}
+	for i := 0; i < 20; i++ {
+		queries = append(queries, float64(rand.Intn(220)-110)+rand.Float64())
+	}
+
+	t.Run("adding and removing single values", func(t *testing.T) {
+		b := newBucket(t)
+		defer b.Shutdown(ctx)
+
+		for docID, v := range values {
+			vBytes, err := LexicographicallySortableFloat64(v)
+			require.Nil(t, err)
+			require.Nil(t, AddToRangeable(b, vBytes, docID))
+		}
+		assertRanges(t, b, values, queries)
+
+		require.Nil(t, b.FlushAndSwitch())
+
+		remaining := map[uint64]float64{}
+		for docID, v := range values {
+			if docID%3 != 0 {
+				remaining[docID] = v
+				continue
+			}
+
+			vBytes, err := LexicographicallySortableFloat64(v)
+			require.Nil(t, err)
+			require.Nil(t, RemoveFromRangeable(b, vBytes, docID))
+		}
+		assertRanges(t, b, remaining, queries)
+	})
+
+	t.Run("building the index at once", func(t *testing.T) {
+		b := newBucket(t)
+		defer b.Shutdown(ctx)
+
+		byValue := map[float64]*roaring64.Bitmap{}
+		for docID, v := range values {
+			if byValue[v] == nil {
+				byValue[v] = roaring64.New()
+			}
+			byValue[v].Add(docID)
+		}
+
+		builder := NewRangeableBuilder()
+		for v, docIDs := range byValue {
+			vBytes, err := LexicographicallySortableFloat64(v)
+			require.Nil(t, err)
+			require.Nil(t, builder.Add(vBytes, docIDs))
+		}
+		require.Nil(t, builder.WriteTo(b))
+
+		assertRanges(t, b, values, queries)
+	})
+
+	t.Run("empty index", func(t *testing.T) {
+		b := newBucket(t)
+		defer b.Shutdown(ctx)
+
+		assertRanges(t, b, map[uint64]float64{}, queries)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		b := newBucket(t)
+		defer b.Shutdown(ctx)
+
+		assert.NotNil(t, AddToRangeable(b, []byte{1, 2, 3}, 1))
+		_, err := NewRangeableReader(b).Read(make([]byte, 8), filters.OperatorEqual)
+		assert.NotNil(t, err)
+	})
+}
@@ -0,0 +1,134 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package inverted
+
+import (
+	"context"
+	"testing"
+
+	"github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/lsmkv"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRangeableReader(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+
+	newBucket := func(t *testing.T) *lsmkv.Bucket {
+		b, err := lsmkv.NewBucket(ctx, t.TempDir(), "", logger, nil,
+			lsmkv.WithStrategy(lsmkv.StrategyRoaringSet))
+		require.Nil(t, err)
+		t.Cleanup(func() { b.Shutdown(ctx) })
+		return b
+	}
+
+	sortable := func(t *testing.T, v int64) []byte {
+		b, err := LexicographicallySortableInt64(v)
+		require.Nil(t, err)
+		return b
+	}
+
+	// doc id -> value
+	values := map[uint64]int64{
+		1: -7,
+		2: -1,
+		3: 0,
+		4: 1,
+		5: 5,
+		6: 5,
+		7: 42,
+	}
+
+	tests := []struct {
+		name     string
+		operator filters.Operator
+		value    int64
+		expected []uint64
+	}{
+		{"greater than", filters.OperatorGreaterThan, 5, []uint64{7}},
+		{"greater than or equal", filters.OperatorGreaterThanEqual, 5, []uint64{5, 6, 7}},
+		{"less than", filters.OperatorLessThan, 5, []uint64{1, 2, 3, 4}},
+		{"less than or equal", filters.OperatorLessThanEqual, 5, []uint64{1, 2, 3, 4, 5, 6}},
+		{"greater than a negative value", filters.OperatorGreaterThan, -2, []uint64{2, 3, 4, 5, 6, 7}},
+		{"less than a negative value", filters.OperatorLessThan, -1, []uint64{1}},
+		{"less than or equal to zero", filters.OperatorLessThanEqual, 0, []uint64{1, 2, 3}},
+		{"greater than a missing value", filters.OperatorGreaterThan, 3, []uint64{5, 6, 7}},
+		{"greater than the maximum", filters.OperatorGreaterThan, 42, []uint64{}},
+		{"less than the minimum", filters.OperatorLessThan, -7, []uint64{}},
+		{"greater than or equal to the minimum", filters.OperatorGreaterThanEqual, -7, []uint64{1, 2, 3, 4, 5, 6, 7}},
+	}
+
+	assertReads := func(t *testing.T, rr *RangeableReader) {
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				res, err := rr.Read(sortable(t, test.value), test.operator)
+				require.Nil(t, err)
+				assert.ElementsMatch(t, test.expected, res.ToArray())
+			})
+		}
+	}
+
+	t.Run("with values added one by one", func(t *testing.T) {
+		b := newBucket(t)
+		for docID, v := range values {
+			require.Nil(t, AddToRangeable(b, sortable(t, v), docID))
+		}
+
+		assertReads(t, NewRangeableReader(b))
+	})
+
+	t.Run("with values written by the builder", func(t *testing.T) {
+		b := newBucket(t)
+		builder := NewRangeableBuilder()
+		for docID, v := range values {
+			require.Nil(t, builder.Add(sortable(t, v), roaring64.BitmapOf(docID)))
+		}
+		require.Nil(t, builder.WriteTo(b))
+
+		assertReads(t, NewRangeableReader(b))
+	})
+
+	t.Run("with a removed value", func(t *testing.T) {
+		b := newBucket(t)
+		for docID, v := range values {
+			require.Nil(t, AddToRangeable(b, sortable(t, v), docID))
+		}
+		require.Nil(t, RemoveFromRangeable(b, sortable(t, 42), 7))
+
+		res, err := NewRangeableReader(b).Read(sortable(t, 0), filters.OperatorGreaterThan)
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []uint64{4, 5, 6}, res.ToArray())
+	})
+
+	t.Run("on an empty index", func(t *testing.T) {
+		res, err := NewRangeableReader(newBucket(t)).
+			Read(sortable(t, 0), filters.OperatorLessThanEqual)
+		require.Nil(t, err)
+		assert.True(t, res.IsEmpty())
+	})
+
+	t.Run("with an unsupported operator", func(t *testing.T) {
+		_, err := NewRangeableReader(newBucket(t)).
+			Read(sortable(t, 0), filters.OperatorEqual)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("with a value of the wrong length", func(t *testing.T) {
+		_, err := NewRangeableReader(newBucket(t)).
+			Read([]byte{1, 2, 3}, filters.OperatorGreaterThan)
+		assert.EqualError(t, err, "rangeable index requires 8-byte values, got 3 bytes")
+	})
+}
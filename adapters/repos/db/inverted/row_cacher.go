@@ -36,17 +36,21 @@ func NewRowCacher(maxSize uint64) *RowCacher {
 type CacheEntry struct {
 	Type      CacheEntryType
 	Hash      []byte
-	Partial   *docPointers
+	Partial   *docBitmap
 	AllowList helpers.AllowList
 }
 
-// Size cannot be determined accurately since a golang map does not have fixed
-// size per elements. However, through experimentation we have found that a
-// map[uint64]struct{} rarely exceeds 25 bytes per entry, so we are using this
-// as an estimate. In addition, we know that the partial content uses an array
-// where we can assume full efficiency, i.e. 8 bytes per entry.
+// Size is the estimated memory footprint of the entry, as reported by the
+// compressed bitmaps backing both the allow list and the partial doc ids
 func (ce *CacheEntry) Size() uint64 {
-	return uint64(25*len(ce.AllowList) + 8*len(ce.Partial.docIDs))
+	var size uint64
+	if ce.AllowList != nil {
+		size += ce.AllowList.Size()
+	}
+	if ce.Partial != nil && ce.Partial.docIDs != nil {
+		size += ce.Partial.docIDs.GetSizeInBytes()
+	}
+	return size
 }
 
 type CacheEntryType uint8
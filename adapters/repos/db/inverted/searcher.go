@@ -17,10 +17,13 @@ import (
 	"encoding/binary"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/RoaringBitmap/roaring/roaring64"
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/inverted/stemmer"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/inverted/stopwords"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/lsmkv"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/notimplemented"
@@ -70,7 +73,8 @@ func NewSearcher(store *lsmkv.Store, schema schema.Schema,
 	}
 }
 
-// Object returns a list of full objects
+// Object returns a list of full objects. Unless they are sorted, the objects
+// are returned in the order of their doc ids
 func (f *Searcher) Object(ctx context.Context, limit int,
 	filter *filters.LocalFilter, sort []filters.Sort, additional additional.Properties,
 	className schema.ClassName,
@@ -80,22 +84,20 @@ func (f *Searcher) Object(ctx context.Context, limit int,
 		return nil, err
 	}
 
-	// we assume that when retrieving objects, we can not tolerate duplicates as
-	// they would have a direct impact on the user
-	if err := pv.fetchDocIDs(f, limit, false); err != nil {
+	if err := pv.fetchDocIDs(f, limit); err != nil {
 		return nil, errors.Wrap(err, "fetch doc ids for prop/value pair")
 	}
 
-	pointers, err := pv.mergeDocIDs(false)
+	dbm, err := pv.mergeDocIDs()
 	if err != nil {
 		return nil, errors.Wrap(err, "merge doc ids by operator")
 	}
 
 	if len(sort) > 0 {
-		return f.sortedObjectsByDocID(ctx, limit, sort, pointers.docIDs, additional, className)
+		return f.sortedObjectsByDocID(ctx, limit, sort, dbm.IDs(), additional, className)
 	}
 
-	return f.allObjectsByDocID(pointers.IDs(), limit, additional)
+	return f.allObjectsByDocID(dbm.IDs(), limit, additional)
 }
 
 func (f *Searcher) allObjectsByDocID(ids []uint64, limit int,
@@ -219,21 +221,16 @@ func (f *Searcher) docIDs(ctx context.Context, filter *filters.LocalFilter,
 		}
 	}
 
-	// when building an allow list (which is a set anyway) we can skip the costly
-	// deduplication, as it doesn't matter
-	if err := pv.fetchDocIDs(f, -1, true); err != nil {
+	if err := pv.fetchDocIDs(f, -1); err != nil {
 		return nil, errors.Wrap(err, "fetch doc ids for prop/value pair")
 	}
 
-	pointers, err := pv.mergeDocIDs(true)
+	dbm, err := pv.mergeDocIDs()
 	if err != nil {
 		return nil, errors.Wrap(err, "merge doc ids by operator")
 	}
 
-	out := make(helpers.AllowList, len(pointers.docIDs))
-	for _, p := range pointers.docIDs {
-		out.Insert(p)
-	}
+	out := helpers.NewAllowListFromBitmap(dbm.docIDs)
 
 	if cacheable && allowCaching {
 		f.rowCache.Store(pv.docIDs.checksum, &CacheEntry{
@@ -273,6 +270,19 @@ func (fs *Searcher) extractPropValuePair(filter *filters.Clause,
 	}
 	// we are on a value element
 
+	if filter.Operator.OnArrayValue() {
+		return fs.extractArrayValuePair(filter, className)
+	}
+
+	if filter.Operator == filters.OperatorIsNull {
+		return fs.extractNullState(props[0], filter.Value.Value)
+	}
+
+	if lengthPropName, ok := schema.IsPropertyLength(props[0], 0); ok {
+		return fs.extractPropertyLength(lengthPropName, filter.Value.Value,
+			filter.Operator)
+	}
+
 	if fs.onInternalProp(props[0]) {
 		return fs.extractInternalProp(props[0], filter.Value.Type, filter.Value.Value, filter.Operator)
 	}
@@ -295,13 +305,53 @@ func (fs *Searcher) extractPropValuePair(filter *filters.Clause,
 		}
 
 		return fs.extractTokenizableProp(props[0], filter.Value.Type, filter.Value.Value,
-			filter.Operator, property.Tokenization)
+			filter.Operator, property)
 	}
 
 	return fs.extractPrimitiveProp(props[0], filter.Value.Type, filter.Value.Value,
 		filter.Operator)
 }
 
+// extractArrayValuePair turns an operator on a list of values into an Equal
+// per value. ContainsAny and In match if any of them matches, ContainsAll
+// only if all of them match. Merging the children is cheap, as each of them
+// is a single bitmap.
+func (fs *Searcher) extractArrayValuePair(filter *filters.Clause,
+	className schema.ClassName,
+) (*propValuePair, error) {
+	values, err := filter.Value.ArrayValues()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(values) == 0 {
+		return nil, errors.Errorf("operator %s requires at least one value",
+			filter.Operator.Name())
+	}
+
+	out := propValuePair{
+		operator: filters.OperatorOr,
+		children: make([]*propValuePair, len(values)),
+	}
+	if filter.Operator == filters.OperatorContainsAll {
+		out.operator = filters.OperatorAnd
+	}
+
+	for i, value := range values {
+		child, err := fs.extractPropValuePair(&filters.Clause{
+			Operator: filters.OperatorEqual,
+			On:       filter.On,
+			Value:    value,
+		}, className)
+		if err != nil {
+			return nil, errors.Wrapf(err, "value at pos %d", i)
+		}
+		out.children[i] = child
+	}
+
+	return &out, nil
+}
+
 func (fs *Searcher) extractReferenceFilter(filter *filters.Clause,
 	className schema.ClassName,
 ) (*propValuePair, error) {
@@ -347,6 +397,41 @@ func (fs *Searcher) extractPrimitiveProp(propName string, dt schema.DataType,
 	}, nil
 }
 
+// extractNullState is served by the null state index of the property, which
+// holds a row for both the null and the non-null state
+func (fs *Searcher) extractNullState(propName string, value interface{},
+) (*propValuePair, error) {
+	byteValue, err := fs.extractBoolValue(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &propValuePair{
+		value:        byteValue,
+		hasFrequency: false,
+		prop:         propName + filters.InternalNullIndex,
+		operator:     filters.OperatorIsNull,
+	}, nil
+}
+
+// extractPropertyLength is served by the property length index, which holds
+// a row per length, so that any operator on an int can be used on it
+func (fs *Searcher) extractPropertyLength(propName string, value interface{},
+	operator filters.Operator,
+) (*propValuePair, error) {
+	byteValue, err := fs.extractIntValue(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &propValuePair{
+		value:        byteValue,
+		hasFrequency: false,
+		prop:         propName + filters.InternalPropertyLength,
+		operator:     operator,
+	}, nil
+}
+
 func (fs *Searcher) extractReferenceCount(propName string, value interface{},
 	operator filters.Operator,
 ) (*propValuePair, error) {
@@ -452,9 +537,10 @@ func extractTimestampProp(propName string, propType schema.DataType, value inter
 }
 
 func (fs *Searcher) extractTokenizableProp(propName string, dt schema.DataType, value interface{},
-	operator filters.Operator, tokenization string,
+	operator filters.Operator, property *models.Property,
 ) (*propValuePair, error) {
 	var parts []string
+	tokenization := property.Tokenization
 
 	switch dt {
 	case schema.DataTypeString:
@@ -463,6 +549,9 @@ func (fs *Searcher) extractTokenizableProp(propName string, dt schema.DataType,
 			parts = helpers.TokenizeString(value.(string))
 		case models.PropertyTokenizationField:
 			parts = []string{helpers.TrimString(value.(string))}
+		case models.PropertyTokenizationWhitespace, models.PropertyTokenizationLowercase,
+			models.PropertyTokenizationTrigram, models.PropertyTokenizationCjk:
+			parts = tokenizeForOperator(tokenization, value.(string), operator)
 		default:
 			return nil, fmt.Errorf("unsupported tokenization '%v' configured for data type '%v'", tokenization, dt)
 		}
@@ -476,6 +565,9 @@ func (fs *Searcher) extractTokenizableProp(propName string, dt schema.DataType,
 			} else {
 				parts = helpers.TokenizeText(value.(string))
 			}
+		case models.PropertyTokenizationWhitespace, models.PropertyTokenizationLowercase,
+			models.PropertyTokenizationTrigram, models.PropertyTokenizationCjk:
+			parts = tokenizeForOperator(tokenization, value.(string), operator)
 		default:
 			return nil, fmt.Errorf("unsupported tokenization '%v' configured for data type '%v'", tokenization, dt)
 		}
@@ -483,11 +575,21 @@ func (fs *Searcher) extractTokenizableProp(propName string, dt schema.DataType,
 		return nil, fmt.Errorf("expected value type to be string or text, got %v", dt)
 	}
 
+	stem, err := stemmer.ForName(property.Stemmer)
+	if err != nil {
+		return nil, err
+	}
+
 	propValuePairs := make([]*propValuePair, 0, len(parts))
 	for _, part := range parts {
-		if fs.stopwords.IsStopword(part) {
+		if stopwordsApply(tokenization) && fs.stopwords.IsStopword(part) {
 			continue
 		}
+
+		// a pattern with wildcards already matches other forms of the word
+		if stem != nil && !strings.ContainsAny(part, "*?") {
+			part = stem.Stem(part)
+		}
 		propValuePairs = append(propValuePairs, &propValuePair{
 			value:        []byte(part),
 			hasFrequency: true,
@@ -505,6 +607,18 @@ func (fs *Searcher) extractTokenizableProp(propName string, dt schema.DataType,
 	return nil, errors.Errorf("invalid search term, only stopwords provided. Stopwords can be configured in class.invertedIndexConfig.stopwords")
 }
 
+// tokenizeForOperator uses the same tokenizer as the analyzer, except for
+// Like, which must not lose its wildcards
+func tokenizeForOperator(tokenization, value string,
+	operator filters.Operator,
+) []string {
+	if operator == filters.OperatorLike {
+		return helpers.TokenizeWithWildcards(tokenization, value)
+	}
+
+	return helpers.Tokenize(tokenization, value)
+}
+
 // TODO: repeated calls to on... aren't too efficient because we iterate over
 // the schema each time, might be smarter to have a single method that
 // determines the type and then we switch based on the result. However, the
@@ -544,12 +658,33 @@ func (fs *Searcher) onTokenizablePropValue(valueType schema.DataType) bool {
 	}
 }
 
-type docPointers struct {
-	count    uint64
-	docIDs   []uint64
+// docBitmap holds the doc ids matching a (partial) filter. The compressed
+// bitmap makes set operations, such as merging AND and OR filters, cheap
+// even on very broad filters
+type docBitmap struct {
+	docIDs   *roaring64.Bitmap
 	checksum []byte // helps us judge if a cached read is still fresh
 }
 
+func newDocBitmap() docBitmap {
+	return docBitmap{docIDs: roaring64.New()}
+}
+
+func (d docBitmap) count() int {
+	if d.docIDs == nil {
+		return 0
+	}
+	return int(d.docIDs.GetCardinality())
+}
+
+// IDs returns the doc ids in ascending order
+func (d docBitmap) IDs() []uint64 {
+	if d.docIDs == nil {
+		return []uint64{}
+	}
+	return d.docIDs.ToArray()
+}
+
 type docPointersWithScore struct {
 	count    uint64
 	docIDs   []docPointerWithScore
@@ -563,10 +698,6 @@ type docPointerWithScore struct {
 	score      float64
 }
 
-func (d docPointers) IDs() []uint64 {
-	return d.docIDs
-}
-
 func (d docPointersWithScore) IDs() []uint64 {
 	out := make([]uint64, len(d.docIDs))
 	for i, elem := range d.docIDs {
@@ -574,23 +705,3 @@ func (d docPointersWithScore) IDs() []uint64 {
 	}
 	return out
 }
-
-func (d *docPointers) removeDuplicates() {
-	counts := make(map[uint64]uint16, len(d.docIDs))
-	for _, id := range d.docIDs {
-		counts[id]++
-	}
-
-	updated := make([]uint64, len(d.docIDs))
-	i := 0
-	for _, id := range d.docIDs {
-		if counts[id] == 1 {
-			updated[i] = id
-			i++
-		}
-
-		counts[id]--
-	}
-
-	d.docIDs = updated[:i]
-}
@@ -17,54 +17,129 @@ import (
 	"encoding/binary"
 	"hash/crc64"
 
+	"github.com/RoaringBitmap/roaring/roaring64"
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/lsmkv"
 	"github.com/semi-technologies/weaviate/entities/filters"
 )
 
-func (fs *Searcher) docPointers(prop string, b *lsmkv.Bucket, limit int,
-	pv *propValuePair, tolerateDuplicates bool,
-) (docPointers, error) {
+func (fs *Searcher) docBitmap(b *lsmkv.Bucket, limit int,
+	pv *propValuePair,
+) (docBitmap, error) {
 	if pv.operator == filters.OperatorWithinGeoRange {
 		// geo props cannot be served by the inverted index and they require an
 		// external index. So, instead of trying to serve this chunk of the filter
 		// request internally, we can pass it to an external geo index
-		return fs.docPointersGeo(pv)
+		return fs.docBitmapGeo(pv)
 	} else {
 		// all other operators perform operations on the inverted index which we
 		// can serve directly
-		return fs.docPointersInverted(prop, b, limit, pv, tolerateDuplicates)
+		return fs.docBitmapInverted(b, limit, pv)
 	}
 }
 
-func (fs *Searcher) docPointersInverted(prop string, b *lsmkv.Bucket, limit int,
-	pv *propValuePair, tolerateDuplicates bool,
-) (docPointers, error) {
+func (fs *Searcher) docBitmapInverted(b *lsmkv.Bucket, limit int,
+	pv *propValuePair,
+) (docBitmap, error) {
 	if pv.hasFrequency {
-		return fs.docPointersInvertedFrequency(prop, b, limit, pv, tolerateDuplicates)
+		return fs.docBitmapInvertedFrequency(b, limit, pv)
 	}
 
-	return fs.docPointersInvertedNoFrequency(prop, b, limit, pv, tolerateDuplicates)
+	if IsRangeableOperator(pv.operator) {
+		rangeBucket := fs.store.Bucket(helpers.BucketRangeableFromPropNameLSM(pv.prop))
+		if rangeBucket != nil {
+			return fs.docBitmapRangeable(rangeBucket, pv)
+		}
+	}
+
+	if b.Strategy() == lsmkv.StrategyRoaringSet {
+		return fs.docBitmapInvertedRoaringSet(b, limit, pv)
+	}
+
+	return fs.docBitmapInvertedNoFrequency(b, limit, pv)
+}
+
+func (fs *Searcher) docBitmapInvertedRoaringSet(b *lsmkv.Bucket, limit int,
+	pv *propValuePair,
+) (docBitmap, error) {
+	rr := NewRowReaderRoaringSet(b, pv.value, pv.operator, false)
+
+	out := newDocBitmap()
+	var hashes [][]byte
+
+	if err := rr.Read(context.TODO(), func(k []byte, v *roaring64.Bitmap) (bool, error) {
+		out.docIDs.Or(v)
+
+		hashBucket := fs.store.Bucket(helpers.HashBucketFromPropNameLSM(pv.prop))
+		if hashBucket == nil {
+			return false, errors.Errorf("no hash bucket for prop '%s' found", pv.prop)
+		}
+
+		// use retrieved k instead of pv.value - they are typically the same, but
+		// not on a like operator with wildcard where we only had a partial match
+		currHash, err := hashBucket.Get(k)
+		if err != nil {
+			return false, errors.Wrap(err, "get hash")
+		}
+
+		// see docBitmapInvertedNoFrequency for why the hash needs to be copied
+		hashes = append(hashes, copyBytes(currHash))
+		if limit > 0 && out.count() >= limit {
+			return false, nil
+		}
+
+		return true, nil
+	}); err != nil {
+		return out, errors.Wrap(err, "read row")
+	}
+
+	out.checksum = combineChecksums(hashes, pv.operator)
+	return out, nil
 }
 
-func (fs *Searcher) docPointersInvertedNoFrequency(prop string, b *lsmkv.Bucket, limit int,
-	pv *propValuePair, tolerateDuplicates bool,
-) (docPointers, error) {
+// docBitmapRangeable serves range operators from the rangeable index. Unlike
+// the row-based readers it does not respect the limit, as the bit slices
+// always produce the complete result.
+func (fs *Searcher) docBitmapRangeable(b *lsmkv.Bucket,
+	pv *propValuePair,
+) (docBitmap, error) {
+	hashBucket := fs.store.Bucket(helpers.HashBucketRangeableFromPropNameLSM(pv.prop))
+	if hashBucket == nil {
+		return docBitmap{}, errors.Errorf("no rangeable hash bucket for prop '%s' found", pv.prop)
+	}
+
+	// read the hash first, so a write in between leads to an outdated
+	// checksum rather than an outdated cache entry
+	hash, err := hashBucket.Get(RangeableHashKey)
+	if err != nil {
+		return docBitmap{}, errors.Wrap(err, "get rangeable hash")
+	}
+
+	docIDs, err := NewRangeableReader(b).Read(pv.value, pv.operator)
+	if err != nil {
+		return docBitmap{}, errors.Wrap(err, "read rangeable index")
+	}
+
+	return docBitmap{
+		docIDs:   docIDs,
+		checksum: rangeableChecksum(hash, pv.value, pv.operator),
+	}, nil
+}
+
+func (fs *Searcher) docBitmapInvertedNoFrequency(b *lsmkv.Bucket, limit int,
+	pv *propValuePair,
+) (docBitmap, error) {
 	rr := NewRowReader(b, pv.value, pv.operator, false)
 
-	var pointers docPointers
+	out := newDocBitmap()
 	var hashes [][]byte
 
 	if err := rr.Read(context.TODO(), func(k []byte, ids [][]byte) (bool, error) {
-		currentDocIDs := make([]uint64, len(ids))
-		for i, asBytes := range ids {
-			currentDocIDs[i] = binary.LittleEndian.Uint64(asBytes)
+		for _, asBytes := range ids {
+			out.docIDs.Add(binary.LittleEndian.Uint64(asBytes))
 		}
 
-		pointers.count += uint64(len(ids))
-		pointers.docIDs = append(pointers.docIDs, currentDocIDs...)
-
 		hashBucket := fs.store.Bucket(helpers.HashBucketFromPropNameLSM(pv.prop))
 		if hashBucket == nil {
 			return false, errors.Errorf("no hash bucket for prop '%s' found", pv.prop)
@@ -82,54 +157,40 @@ func (fs *Searcher) docPointersInvertedNoFrequency(prop string, b *lsmkv.Bucket,
 		// to segfault crashes. Now is the time to safely copy it, creating a new
 		// and immutable slice.
 		hashes = append(hashes, copyBytes(currHash))
-		if limit > 0 && pointers.count >= uint64(limit) {
+		if limit > 0 && out.count() >= limit {
 			return false, nil
 		}
 
 		return true, nil
 	}); err != nil {
-		return pointers, errors.Wrap(err, "read row")
-	}
-
-	pointers.checksum = combineChecksums(hashes, pv.operator)
-	if !tolerateDuplicates {
-		pointers.removeDuplicates()
+		return out, errors.Wrap(err, "read row")
 	}
 
-	return pointers, nil
+	out.checksum = combineChecksums(hashes, pv.operator)
+	return out, nil
 }
 
-func (fs *Searcher) docPointersInvertedFrequency(prop string, b *lsmkv.Bucket, limit int,
-	pv *propValuePair, tolerateDuplicates bool,
-) (docPointers, error) {
+func (fs *Searcher) docBitmapInvertedFrequency(b *lsmkv.Bucket, limit int,
+	pv *propValuePair,
+) (docBitmap, error) {
 	rr := NewRowReaderFrequency(b, pv.value, pv.operator, false, fs.shardVersion)
 
-	var pointers docPointers
+	out := newDocBitmap()
 	var hashes [][]byte
 
 	if err := rr.Read(context.TODO(), func(k []byte, pairs []lsmkv.MapPair) (bool, error) {
-		currentDocIDs := make([]uint64, len(pairs))
-		// beforePairs := time.Now()
-		for i, pair := range pairs {
+		for _, pair := range pairs {
 			// this entry has a frequency, but that's only used for bm25, not for
 			// pure filtering, so we can ignore it here
 			if fs.shardVersion < 2 {
-				currentDocIDs[i] = binary.LittleEndian.Uint64(pair.Key)
+				out.docIDs.Add(binary.LittleEndian.Uint64(pair.Key))
 			} else {
-				currentDocIDs[i] = binary.BigEndian.Uint64(pair.Key)
+				out.docIDs.Add(binary.BigEndian.Uint64(pair.Key))
 			}
 		}
-		// fmt.Printf("loop through pairs took %s\n", time.Since(beforePairs))
-
-		pointers.count += uint64(len(pairs))
-		if len(pointers.docIDs) > 0 {
-			pointers.docIDs = append(pointers.docIDs, currentDocIDs...)
-		} else {
-			pointers.docIDs = currentDocIDs
-		}
 
 		hashBucket := fs.store.Bucket(helpers.HashBucketFromPropNameLSM(pv.prop))
-		if b == nil {
+		if hashBucket == nil {
 			return false, errors.Errorf("no hash bucket for prop '%s' found", pv.prop)
 		}
 
@@ -147,26 +208,22 @@ func (fs *Searcher) docPointersInvertedFrequency(prop string, b *lsmkv.Bucket, l
 		// to segfault crashes. Now is the time to safely copy it, creating a new
 		// and immutable slice.
 		hashes = append(hashes, copyBytes(currHash))
-		if limit > 0 && pointers.count >= uint64(limit) {
+		if limit > 0 && out.count() >= limit {
 			return false, nil
 		}
 
 		return true, nil
 	}); err != nil {
-		return pointers, errors.Wrap(err, "read row")
+		return out, errors.Wrap(err, "read row")
 	}
 
-	pointers.checksum = combineChecksums(hashes, pv.operator)
-
-	if !tolerateDuplicates {
-		pointers.removeDuplicates()
-	}
-	return pointers, nil
+	out.checksum = combineChecksums(hashes, pv.operator)
+	return out, nil
 }
 
-func (fs *Searcher) docPointersGeo(pv *propValuePair) (docPointers, error) {
+func (fs *Searcher) docBitmapGeo(pv *propValuePair) (docBitmap, error) {
+	out := newDocBitmap()
 	propIndex, ok := fs.propIndices.ByProp(pv.prop)
-	out := docPointers{}
 	if !ok {
 		return out, nil
 	}
@@ -177,8 +234,7 @@ func (fs *Searcher) docPointersGeo(pv *propValuePair) (docPointers, error) {
 		return out, errors.Wrapf(err, "geo index range search on prop %q", pv.prop)
 	}
 
-	out.docIDs = res
-	out.count = uint64(len(res))
+	out.docIDs.AddMany(res)
 
 	// we can not use the checksum in the same fashion as with the inverted
 	// index, i.e. it can not prevent a search as the underlying index does not
@@ -196,15 +252,6 @@ func (fs *Searcher) docPointersGeo(pv *propValuePair) (docPointers, error) {
 	return out, nil
 }
 
-// why is there a need to combine checksums prior to merging?
-// on Operators GreaterThan (Equal) & LessThan (Equal), we don't just read a
-// single row in the inverted index, but several (e.g. for greater than 5, we
-// right read the row containing 5, 6, 7 and so on. Since a field contains just
-// one value the docIDs are guaranteed to be unique, we can simply append them.
-// But to be able to recognize this read operation further down the line (e.g.
-// when merging independent filter) we need a new checksum describing exactly
-// this request. Thus we simply treat the existing checksums as an input string
-// (appended) and caculate a new one
 func combineChecksums(checksums [][]byte, operator filters.Operator) []byte {
 	if len(checksums) == 1 {
 		return checksums[0]
@@ -222,7 +269,19 @@ func combineChecksums(checksums [][]byte, operator filters.Operator) []byte {
 	return buf
 }
 
-func combineSetChecksums(sets []*docPointers, operator filters.Operator) []byte {
+func rangeableChecksum(hash, value []byte, operator filters.Operator) []byte {
+	total := make([]byte, len(hash)+len(value)+1) // one extra byte for operator encoding
+	copy(total, hash)
+	copy(total[len(hash):], value)
+	total[len(total)-1] = uint8(operator)
+
+	newChecksum := crc64.Checksum(total, crc64.MakeTable(crc64.ISO))
+	buf := make([]byte, 8)
+	binary.LittleEndian.PutUint64(buf, newChecksum)
+	return buf
+}
+
+func combineSetChecksums(sets []*docBitmap, operator filters.Operator) []byte {
 	if len(sets) == 1 {
 		return sets[0].checksum
 	}
@@ -0,0 +1,406 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package stemmer
+
+import "strings"
+
+// english is the English (Porter2) stemmer of the Snowball project, see
+// https://snowballstem.org/algorithms/english/stemmer.html. Words which
+// contain anything other than lowercase ASCII letters are not stemmed.
+type english struct{}
+
+var englishExceptions = map[string]string{
+	"skis":   "ski",
+	"skies":  "sky",
+	"dying":  "die",
+	"lying":  "lie",
+	"tying":  "tie",
+	"idly":   "idl",
+	"gently": "gentl",
+	"ugly":   "ugli",
+	"early":  "earli",
+	"only":   "onli",
+	"singly": "singl",
+	"sky":    "sky",
+	"news":   "news",
+	"howe":   "howe",
+	"atlas":  "atlas",
+	"cosmos": "cosmos",
+	"bias":   "bias",
+	"andes":  "andes",
+}
+
+// stems which are left alone after step 1a
+var englishExceptionsAfterStep1a = map[string]struct{}{
+	"inning":  {},
+	"outing":  {},
+	"canning": {},
+	"herring": {},
+	"earring": {},
+	"proceed": {},
+	"exceed":  {},
+	"succeed": {},
+}
+
+func (english) Stem(word string) string {
+	if len(word) <= 2 || !isLowercaseASCII(word) {
+		return word
+	}
+
+	if stem, ok := englishExceptions[word]; ok {
+		return stem
+	}
+
+	w := &englishWord{b: []byte(strings.TrimPrefix(word, "'"))}
+	w.prelude()
+	w.markRegions()
+
+	w.step0()
+	w.step1a()
+	if _, ok := englishExceptionsAfterStep1a[string(w.b)]; ok {
+		return w.String()
+	}
+
+	w.step1b()
+	w.step1c()
+	w.step2()
+	w.step3()
+	w.step4()
+	w.step5()
+
+	return w.String()
+}
+
+func isLowercaseASCII(word string) bool {
+	for i := 0; i < len(word); i++ {
+		if (word[i] < 'a' || word[i] > 'z') && word[i] != '\'' {
+			return false
+		}
+	}
+
+	return true
+}
+
+type englishWord struct {
+	b []byte
+
+	// r1 and r2 are the start positions of the regions R1 and R2, they are
+	// len(b) if the region is empty
+	r1, r2 int
+}
+
+// String reverts the consonant-y marker of the prelude
+func (w *englishWord) String() string {
+	return strings.ReplaceAll(string(w.b), "Y", "y")
+}
+
+// prelude marks an initial y and every y after a vowel as a consonant
+func (w *englishWord) prelude() {
+	for i := range w.b {
+		if w.b[i] == 'y' && (i == 0 || isVowel(w.b[i-1])) {
+			w.b[i] = 'Y'
+		}
+	}
+}
+
+func (w *englishWord) markRegions() {
+	w.r1 = -1
+	for _, prefix := range []string{"gener", "commun", "arsen"} {
+		if w.hasPrefix(prefix) {
+			w.r1 = len(prefix)
+			break
+		}
+	}
+	if w.r1 == -1 {
+		w.r1 = w.regionAfter(0)
+	}
+
+	w.r2 = w.regionAfter(w.r1)
+}
+
+// regionAfter is the position after the first non-vowel that follows a
+// vowel at or after start
+func (w *englishWord) regionAfter(start int) int {
+	for i := start + 1; i < len(w.b); i++ {
+		if isVowel(w.b[i-1]) && !isVowel(w.b[i]) {
+			return i + 1
+		}
+	}
+
+	return len(w.b)
+}
+
+func (w *englishWord) step0() {
+	for _, suffix := range []string{"'s'", "'s", "'"} {
+		if w.hasSuffix(suffix) {
+			w.trim(len(suffix))
+			return
+		}
+	}
+}
+
+func (w *englishWord) step1a() {
+	switch w.longestSuffix("sses", "ied", "ies", "us", "ss", "s") {
+	case "sses":
+		w.trim(2)
+	case "ied", "ies":
+		if len(w.b) > 4 {
+			w.trim(2)
+		} else {
+			w.trim(1)
+		}
+	case "s":
+		if len(w.b) > 2 && containsVowel(w.b[:len(w.b)-2]) {
+			w.trim(1)
+		}
+	}
+}
+
+func (w *englishWord) step1b() {
+	suffix := w.longestSuffix("eed", "eedly", "ed", "edly", "ing", "ingly")
+	switch suffix {
+	case "":
+		return
+	case "eed", "eedly":
+		if w.inR1(suffix) {
+			w.replace(suffix, "ee")
+		}
+		return
+	}
+
+	if !containsVowel(w.b[:len(w.b)-len(suffix)]) {
+		return
+	}
+
+	w.trim(len(suffix))
+	switch {
+	case w.hasSuffix("at"), w.hasSuffix("bl"), w.hasSuffix("iz"):
+		w.b = append(w.b, 'e')
+	case w.endsWithDouble():
+		w.trim(1)
+	case w.isShort():
+		w.b = append(w.b, 'e')
+	}
+}
+
+func (w *englishWord) step1c() {
+	n := len(w.b)
+	if n > 2 && (w.b[n-1] == 'y' || w.b[n-1] == 'Y') && !isVowel(w.b[n-2]) {
+		w.b[n-1] = 'i'
+	}
+}
+
+var englishStep2 = map[string]string{
+	"tional":  "tion",
+	"enci":    "ence",
+	"anci":    "ance",
+	"abli":    "able",
+	"entli":   "ent",
+	"izer":    "ize",
+	"ization": "ize",
+	"ational": "ate",
+	"ation":   "ate",
+	"ator":    "ate",
+	"alism":   "al",
+	"aliti":   "al",
+	"alli":    "al",
+	"fulness": "ful",
+	"ousli":   "ous",
+	"ousness": "ous",
+	"iveness": "ive",
+	"iviti":   "ive",
+	"biliti":  "ble",
+	"bli":     "ble",
+	"ogi":     "og",
+	"fulli":   "ful",
+	"lessli":  "less",
+	"li":      "",
+}
+
+func (w *englishWord) step2() {
+	suffix := w.longestSuffixOf(englishStep2)
+	if suffix == "" || !w.inR1(suffix) {
+		return
+	}
+
+	switch suffix {
+	case "ogi":
+		if !w.precededBy(suffix, "l") {
+			return
+		}
+	case "li":
+		if !w.precededBy(suffix, "cdeghkmnrt") {
+			return
+		}
+	}
+
+	w.replace(suffix, englishStep2[suffix])
+}
+
+var englishStep3 = map[string]string{
+	"tional":  "tion",
+	"ational": "ate",
+	"alize":   "al",
+	"icate":   "ic",
+	"iciti":   "ic",
+	"ical":    "ic",
+	"ful":     "",
+	"ness":    "",
+	"ative":   "",
+}
+
+func (w *englishWord) step3() {
+	suffix := w.longestSuffixOf(englishStep3)
+	if suffix == "" || !w.inR1(suffix) {
+		return
+	}
+
+	if suffix == "ative" && !w.inR2(suffix) {
+		return
+	}
+
+	w.replace(suffix, englishStep3[suffix])
+}
+
+func (w *englishWord) step4() {
+	suffix := w.longestSuffix("al", "ance", "ence", "er", "ic", "able", "ible",
+		"ant", "ement", "ment", "ent", "ism", "ate", "iti", "ous", "ive", "ize",
+		"ion")
+	if suffix == "" || !w.inR2(suffix) {
+		return
+	}
+
+	if suffix == "ion" && !w.precededBy(suffix, "st") {
+		return
+	}
+
+	w.trim(len(suffix))
+}
+
+func (w *englishWord) step5() {
+	switch {
+	case w.hasSuffix("e"):
+		if w.inR2("e") ||
+			(w.inR1("e") && !endsWithShortSyllable(w.b[:len(w.b)-1])) {
+			w.trim(1)
+		}
+	case w.hasSuffix("l"):
+		if w.inR2("l") && w.precededBy("l", "l") {
+			w.trim(1)
+		}
+	}
+}
+
+func (w *englishWord) hasPrefix(prefix string) bool {
+	return strings.HasPrefix(string(w.b), prefix)
+}
+
+func (w *englishWord) hasSuffix(suffix string) bool {
+	return strings.HasSuffix(string(w.b), suffix)
+}
+
+// longestSuffix returns the longest of the suffixes the word ends with, or
+// an empty string if there is none
+func (w *englishWord) longestSuffix(suffixes ...string) string {
+	longest := ""
+	for _, suffix := range suffixes {
+		if len(suffix) > len(longest) && w.hasSuffix(suffix) {
+			longest = suffix
+		}
+	}
+
+	return longest
+}
+
+func (w *englishWord) longestSuffixOf(replacements map[string]string) string {
+	longest := ""
+	for suffix := range replacements {
+		if len(suffix) > len(longest) && w.hasSuffix(suffix) {
+			longest = suffix
+		}
+	}
+
+	return longest
+}
+
+func (w *englishWord) inR1(suffix string) bool {
+	return len(w.b)-len(suffix) >= w.r1
+}
+
+func (w *englishWord) inR2(suffix string) bool {
+	return len(w.b)-len(suffix) >= w.r2
+}
+
+// precededBy tells whether the letter before the suffix is one of letters
+func (w *englishWord) precededBy(suffix, letters string) bool {
+	pos := len(w.b) - len(suffix) - 1
+	return pos >= 0 && strings.IndexByte(letters, w.b[pos]) != -1
+}
+
+func (w *englishWord) trim(n int) {
+	w.b = w.b[:len(w.b)-n]
+}
+
+func (w *englishWord) replace(suffix, replacement string) {
+	w.trim(len(suffix))
+	w.b = append(w.b, replacement...)
+}
+
+func (w *englishWord) endsWithDouble() bool {
+	for _, double := range []string{"bb", "dd", "ff", "gg", "mm", "nn", "pp", "rr", "tt"} {
+		if w.hasSuffix(double) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isShort tells whether the word ends in a short syllable and R1 is empty
+func (w *englishWord) isShort() bool {
+	return w.r1 >= len(w.b) && endsWithShortSyllable(w.b)
+}
+
+// endsWithShortSyllable tells whether the word ends with a vowel followed by
+// a non-vowel other than w, x or Y and preceded by a non-vowel, or consists
+// of a vowel followed by a non-vowel only
+func endsWithShortSyllable(b []byte) bool {
+	n := len(b)
+	switch {
+	case n == 2:
+		return isVowel(b[0]) && !isVowel(b[1])
+	case n > 2:
+		return !isVowel(b[n-3]) && isVowel(b[n-2]) && !isVowel(b[n-1]) &&
+			b[n-1] != 'w' && b[n-1] != 'x' && b[n-1] != 'Y'
+	default:
+		return false
+	}
+}
+
+func isVowel(c byte) bool {
+	switch c {
+	case 'a', 'e', 'i', 'o', 'u', 'y':
+		return true
+	default:
+		return false
+	}
+}
+
+func containsVowel(b []byte) bool {
+	for _, c := range b {
+		if isVowel(c) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,109 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package stemmer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnglishStemmer(t *testing.T) {
+	// taken from the sample vocabulary of the Snowball project
+	expected := map[string]string{
+		"consign":         "consign",
+		"consigned":       "consign",
+		"consigning":      "consign",
+		"consignment":     "consign",
+		"consistency":     "consist",
+		"consistent":      "consist",
+		"consistently":    "consist",
+		"consolation":     "consol",
+		"consolatory":     "consolatori",
+		"consoles":        "consol",
+		"consolidated":    "consolid",
+		"consolingly":     "consol",
+		"conspicuously":   "conspicu",
+		"conspiracy":      "conspiraci",
+		"conspirators":    "conspir",
+		"constables":      "constabl",
+		"constance":       "constanc",
+		"knackeries":      "knackeri",
+		"knaves":          "knave",
+		"kneeled":         "kneel",
+		"knightly":        "knight",
+		"knitting":        "knit",
+		"knives":          "knive",
+		"knocker":         "knocker",
+		"running":         "run",
+		"runs":            "run",
+		"run":             "run",
+		"caresses":        "caress",
+		"ponies":          "poni",
+		"ties":            "tie",
+		"cries":           "cri",
+		"gas":             "gas",
+		"gaps":            "gap",
+		"kiwis":           "kiwi",
+		"agreed":          "agre",
+		"hoping":          "hope",
+		"luxuriating":     "luxuri",
+		"generously":      "generous",
+		"communism":       "communism",
+		"skies":           "sky",
+		"news":            "news",
+		"succeeding":      "succeed",
+		"exceed":          "exceed",
+		"cry":             "cri",
+		"say":             "say",
+		"by":              "by",
+		"youth":           "youth",
+		"playing":         "play",
+		"controlling":     "control",
+		"rationalization": "ration",
+		"hopeful":         "hope",
+		"goodness":        "good",
+		"electrical":      "electr",
+		"adjustable":      "adjust",
+		"adoption":        "adopt",
+		"dog's":           "dog",
+		// not stemmed
+		"2022":    "2022",
+		"東京":      "東京",
+		"naïve":   "naïve",
+		"Running": "Running",
+	}
+
+	for in, stem := range expected {
+		assert.Equal(t, stem, english{}.Stem(in), in)
+	}
+}
+
+func TestForName(t *testing.T) {
+	for _, name := range []string{"", NoStemmer} {
+		s, err := ForName(name)
+		assert.Nil(t, err)
+		assert.Nil(t, s)
+	}
+
+	s, err := ForName(EnglishStemmer)
+	assert.Nil(t, err)
+	assert.Equal(t, "run", s.Stem("running"))
+
+	_, err = ForName("klingon")
+	assert.NotNil(t, err)
+
+	// stopword presets of other languages have no stemmer
+	_, err = ForName("de")
+	assert.EqualError(t, err, `stemmer "de" does not exist, stemming is only `+
+		`supported for "en", the "de" stopword preset can be used without a stemmer`)
+}
@@ -0,0 +1,53 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package stemmer
+
+import (
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/inverted/stopwords"
+)
+
+const (
+	EnglishStemmer = "en"
+	NoStemmer      = "none"
+)
+
+// Stemmer reduces a lowercased word to its stem, e.g. "running" to "run"
+type Stemmer interface {
+	Stem(word string) string
+}
+
+// Stemmers holds all available stemmers. Only English is supported, there
+// are no stemmers for the other languages with a stopword preset.
+var Stemmers = map[string]Stemmer{
+	EnglishStemmer: english{},
+}
+
+// ForName returns the stemmer with the given name. It returns nil if the
+// name does not configure any stemming.
+func ForName(name string) (Stemmer, error) {
+	if name == "" || name == NoStemmer {
+		return nil, nil
+	}
+
+	s, ok := Stemmers[name]
+	if !ok {
+		if _, isPreset := stopwords.Presets[name]; isPreset {
+			return nil, errors.Errorf("stemmer %q does not exist, stemming is only "+
+				"supported for %q, the %q stopword preset can be used without a stemmer",
+				name, EnglishStemmer, name)
+		}
+		return nil, errors.Errorf("stemmer %q does not exist", name)
+	}
+
+	return s, nil
+}
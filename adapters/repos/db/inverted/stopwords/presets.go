@@ -12,8 +12,14 @@
 package stopwords
 
 const (
-	EnglishPreset = "en"
-	NoPreset      = "none"
+	EnglishPreset    = "en"
+	GermanPreset     = "de"
+	FrenchPreset     = "fr"
+	SpanishPreset    = "es"
+	ItalianPreset    = "it"
+	DutchPreset      = "nl"
+	PortuguesePreset = "pt"
+	NoPreset         = "none"
 )
 
 var Presets = map[string][]string{
@@ -23,5 +29,58 @@ var Presets = map[string][]string{
 		"the", "their", "then", "there", "these", "they", "this", "to", "was", "will",
 		"with",
 	},
+	GermanPreset: {
+		"als", "am", "auf", "aus", "bei", "bin", "bis", "da", "dann", "das", "dass",
+		"daß", "dem", "den", "der", "des", "die", "dich", "dir", "doch", "du",
+		"durch", "ein", "eine", "einem", "einen", "einer", "eines", "er", "es",
+		"für", "hat", "ich", "ihr", "ihre", "ihres", "im", "in", "ist", "kein",
+		"mein", "mich", "mir", "mit", "nicht", "noch", "oder", "ohne", "sein",
+		"sich", "sie", "sind", "so", "um", "und", "uns", "vom", "von", "vor",
+		"war", "was", "wegen", "wer", "wie", "wir", "wird", "zu", "zum", "zur",
+	},
+	FrenchPreset: {
+		"à", "au", "aux", "avec", "c", "ce", "ces", "d", "dans", "de", "des", "du",
+		"elle", "en", "est", "et", "eux", "il", "j", "je", "l", "la", "le", "les",
+		"leur", "lui", "m", "ma", "mais", "me", "même", "mes", "moi", "mon", "n",
+		"ne", "nos", "notre", "nous", "on", "ou", "par", "pas", "pour", "qu",
+		"que", "qui", "s", "sa", "se", "ses", "son", "sur", "t", "ta", "te",
+		"tes", "toi", "ton", "tu", "un", "une", "vos", "votre", "vous", "y",
+	},
+	SpanishPreset: {
+		"a", "al", "algo", "ante", "antes", "como", "con", "contra", "cual",
+		"cuando", "de", "del", "desde", "donde", "durante", "e", "el", "él",
+		"ella", "ellos", "en", "entre", "era", "es", "esa", "ese", "eso", "esta",
+		"este", "esto", "fue", "ha", "hay", "la", "las", "le", "les", "lo", "los",
+		"más", "me", "mi", "muy", "ni", "no", "nos", "o", "para", "pero", "por",
+		"porque", "que", "qué", "se", "sí", "sin", "sobre", "su", "sus",
+		"también", "te", "tu", "un", "una", "uno", "unos", "y", "ya", "yo",
+	},
+	ItalianPreset: {
+		"a", "ad", "agli", "ai", "al", "alla", "alle", "allo", "anche", "che",
+		"chi", "ci", "come", "con", "cui", "da", "dai", "dal", "dalla", "dalle",
+		"dallo", "degli", "dei", "del", "della", "delle", "dello", "di", "e",
+		"ed", "gli", "i", "il", "in", "io", "l", "la", "le", "lei", "li", "lo",
+		"loro", "lui", "ma", "mi", "ne", "negli", "nei", "nel", "nella", "nelle",
+		"nello", "noi", "non", "o", "per", "più", "se", "si", "sono", "su",
+		"sua", "sue", "sugli", "sui", "sul", "sulla", "sulle", "sullo", "suo",
+		"suoi", "ti", "tra", "tu", "un", "una", "uno", "vi", "voi",
+	},
+	DutchPreset: {
+		"aan", "al", "als", "bij", "dan", "dat", "de", "der", "deze", "die",
+		"dit", "door", "een", "en", "er", "had", "heb", "heeft", "hem", "het",
+		"hij", "hoe", "hun", "ik", "in", "is", "je", "kan", "maar", "me", "met",
+		"mij", "mijn", "na", "naar", "niet", "nog", "nu", "of", "om", "ons",
+		"ook", "op", "over", "te", "tot", "u", "uit", "van", "voor", "was",
+		"wat", "werd", "wie", "wordt", "ze", "zich", "zij", "zijn", "zo", "zou",
+	},
+	PortuguesePreset: {
+		"a", "à", "ao", "aos", "as", "às", "com", "como", "da", "das", "de",
+		"dela", "dele", "do", "dos", "e", "é", "ela", "elas", "ele", "eles",
+		"em", "entre", "era", "essa", "esse", "esta", "este", "eu", "foi", "isso",
+		"isto", "já", "lhe", "mais", "mas", "me", "meu", "minha", "na", "nas",
+		"não", "nem", "no", "nos", "num", "numa", "o", "os", "ou", "para", "pela",
+		"pelo", "por", "quando", "que", "se", "sem", "seu", "só", "sua", "também",
+		"te", "um", "uma", "você",
+	},
 	NoPreset: {},
 }
@@ -47,6 +47,9 @@ type Bucket struct {
 	strategy          string
 	secondaryIndices  uint16
 
+	// compression of newly written segments, see WithCompression
+	compression string
+
 	// for backward compatibility
 	legacyMapSortingBeforeCompaction bool
 
@@ -91,6 +94,7 @@ func NewBucket(ctx context.Context, dir, rootDir string, logger logrus.FieldLogg
 		walThreshold:      defaultWalThreshold,
 		flushAfterIdle:    defaultFlushAfterIdle,
 		strategy:          defaultStrategy,
+		compression:       CompressionNone,
 		logger:            logger,
 		metrics:           metrics,
 	}
@@ -102,7 +106,8 @@ func NewBucket(ctx context.Context, dir, rootDir string, logger logrus.FieldLogg
 	}
 
 	sg, err := newSegmentGroup(dir, cyclemanager.DefaultLSMCompactionInterval, logger,
-		b.legacyMapSortingBeforeCompaction, metrics, b.strategy, b.monitorCount)
+		b.legacyMapSortingBeforeCompaction, metrics, b.strategy, b.compression,
+		b.monitorCount)
 	if err != nil {
 		return nil, errors.Wrap(err, "init disk segments")
 	}
@@ -542,7 +547,8 @@ func (b *Bucket) Delete(key []byte, opts ...SecondaryKeyOption) error {
 // lock on its own
 func (b *Bucket) setNewActiveMemtable() error {
 	mt, err := newMemtable(filepath.Join(b.dir, fmt.Sprintf("segment-%d",
-		time.Now().UnixNano())), b.strategy, b.secondaryIndices, b.metrics)
+		time.Now().UnixNano())), b.strategy, b.compression, b.secondaryIndices,
+		b.metrics)
 	if err != nil {
 		return err
 	}
@@ -759,6 +765,12 @@ func (b *Bucket) Strategy() string {
 	return b.strategy
 }
 
+// Compression returns the compression newly written segments use, see
+// WithCompression
+func (b *Bucket) Compression() string {
+	return b.compression
+}
+
 // the WAL uses a buffer and isn't written until the buffer size is crossed or
 // this function explicitly called. This allows to avoid unnecessary disk
 // writes in larger operations, such as batches. It is sufficient to call write
@@ -22,7 +22,8 @@ type BucketOption func(b *Bucket) error
 func WithStrategy(strategy string) BucketOption {
 	return func(b *Bucket) error {
 		switch strategy {
-		case StrategyReplace, StrategyMapCollection, StrategySetCollection:
+		case StrategyReplace, StrategyMapCollection, StrategySetCollection,
+			StrategyRoaringSet:
 		default:
 			return errors.Errorf("unrecognized strategy %q", strategy)
 		}
@@ -32,6 +33,25 @@ func WithStrategy(strategy string) BucketOption {
 	}
 }
 
+// WithCompression enables block compression for all segments that are
+// written from now on, either by flushing a memtable or by compacting two
+// segments. Existing segments stay readable regardless of the compression
+// they were written with, so the option can be changed at any time. An empty
+// compression is the same as CompressionNone.
+func WithCompression(compression string) BucketOption {
+	return func(b *Bucket) error {
+		if _, err := blockCodecFromCompression(compression); err != nil {
+			return err
+		}
+		if compression == "" {
+			compression = CompressionNone
+		}
+
+		b.compression = compression
+		return nil
+	}
+}
+
 func WithMemtableThreshold(threshold uint64) BucketOption {
 	return func(b *Bucket) error {
 		b.memTableThreshold = threshold
@@ -0,0 +1,90 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package lsmkv
+
+import "github.com/RoaringBitmap/roaring/roaring64"
+
+// RoaringSetAddOne adds a single value to the set at the given key. Like
+// [Bucket.SetAdd] it is agnostic of whether the key or the value already
+// exist.
+//
+// RoaringSetAddOne is specific to the RoaringSet strategy.
+func (b *Bucket) RoaringSetAddOne(key []byte, value uint64) error {
+	return b.RoaringSetAddList(key, []uint64{value})
+}
+
+// RoaringSetAddList adds one or more values to the set at the given key.
+//
+// RoaringSetAddList is specific to the RoaringSet strategy.
+func (b *Bucket) RoaringSetAddList(key []byte, values []uint64) error {
+	b.flushLock.RLock()
+	defer b.flushLock.RUnlock()
+
+	return b.active.roaringSetAddList(key, values)
+}
+
+// RoaringSetAddBitmap adds all values contained in the bitmap to the set at
+// the given key.
+//
+// RoaringSetAddBitmap is specific to the RoaringSet strategy.
+func (b *Bucket) RoaringSetAddBitmap(key []byte, bm *roaring64.Bitmap) error {
+	return b.RoaringSetAddList(key, bm.ToArray())
+}
+
+// RoaringSetRemoveOne removes a single value from the set at the given key.
+// As with [Bucket.SetDeleteSingle], the deletion is only recorded at this
+// point. It will shadow the value in all previous segments, but the value is
+// not guaranteed to be physically removed until a compaction reaches the
+// lowest segment.
+//
+// RoaringSetRemoveOne is specific to the RoaringSet strategy.
+func (b *Bucket) RoaringSetRemoveOne(key []byte, value uint64) error {
+	b.flushLock.RLock()
+	defer b.flushLock.RUnlock()
+
+	return b.active.roaringSetRemoveList(key, []uint64{value})
+}
+
+// RoaringSetGet returns the set at the given key as a bitmap. The bitmap is a
+// copy which the caller is free to modify. If the key does not exist, an
+// empty bitmap is returned.
+//
+// RoaringSetGet is specific to the RoaringSet strategy, for Sets use
+// [Bucket.SetList].
+func (b *Bucket) RoaringSetGet(key []byte) (*roaring64.Bitmap, error) {
+	b.flushLock.RLock()
+	defer b.flushLock.RUnlock()
+
+	layers, err := b.disk.roaringSetGet(key)
+	if err != nil {
+		return nil, err
+	}
+
+	memtables := []*Memtable{b.flushing, b.active}
+	for _, memtable := range memtables {
+		if memtable == nil {
+			continue
+		}
+
+		layer, err := memtable.roaringSetGet(key)
+		if err != nil {
+			if err == NotFound {
+				continue
+			}
+			return nil, err
+		}
+
+		layers = append(layers, layer)
+	}
+
+	return layers.Flatten(), nil
+}
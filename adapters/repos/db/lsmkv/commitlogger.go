@@ -17,6 +17,7 @@ import (
 	"os"
 
 	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/lsmkv/roaringset"
 )
 
 type commitLogger struct {
@@ -37,6 +38,10 @@ const (
 	// collection strategy - this can handle all cases as updates and deletes are
 	// only appends in a collection strategy
 	CommitTypeCollection
+
+	// roaringset strategy - every entry is a full roaringset.SegmentNode
+	// containing both additions and deletions
+	CommitTypeRoaringSet
 )
 
 func newCommitLogger(path string) (*commitLogger, error) {
@@ -89,6 +94,22 @@ func (cl *commitLogger) append(node segmentCollectionNode) error {
 	return nil
 }
 
+func (cl *commitLogger) add(node *roaringset.SegmentNode) error {
+	if cl.paused {
+		return nil
+	}
+
+	if err := binary.Write(cl.writer, binary.LittleEndian, CommitTypeRoaringSet); err != nil {
+		return err
+	}
+
+	if _, err := cl.writer.Write(node.ToBuffer()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (cl *commitLogger) close() error {
 	if cl.paused {
 		return errors.Errorf("attempting to close a paused commit logger")
@@ -43,8 +43,11 @@ func newCommitLoggerParser(path string, activeMemtable *Memtable,
 }
 
 func (p *commitloggerParser) Do() error {
-	if p.strategy == StrategyReplace {
+	switch p.strategy {
+	case StrategyReplace:
 		return p.doReplace()
+	case StrategyRoaringSet:
+		return p.doRoaringSet()
 	}
 
 	return p.doCollection()
@@ -82,6 +85,9 @@ func (p *commitloggerParser) doReplace() error {
 		case CommitTypeCollection:
 			f.Close()
 			return errors.Errorf("found a collection commit on a replace bucket")
+		case CommitTypeRoaringSet:
+			f.Close()
+			return errors.Errorf("found a roaringset commit on a replace bucket")
 		case CommitTypeReplace:
 			if err := p.parseReplaceNode(); err != nil {
 				errUnexpectedLength = errors.Wrap(err, "read replace node")
@@ -47,6 +47,9 @@ func (p *commitloggerParser) doCollection() error {
 		case CommitTypeReplace:
 			f.Close()
 			return errors.Errorf("found a replace commit on collection bucket")
+		case CommitTypeRoaringSet:
+			f.Close()
+			return errors.Errorf("found a roaringset commit on collection bucket")
 		case CommitTypeCollection:
 			if err := p.parseCollectionNode(); err != nil {
 				f.Close()
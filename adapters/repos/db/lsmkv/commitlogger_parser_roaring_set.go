@@ -0,0 +1,88 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package lsmkv
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/lsmkv/roaringset"
+	"github.com/semi-technologies/weaviate/entities/diskio"
+)
+
+func (p *commitloggerParser) doRoaringSet() error {
+	f, err := os.Open(p.path)
+	if err != nil {
+		return err
+	}
+
+	metered := diskio.NewMeteredReader(f, p.metrics.TrackStartupReadWALDiskIO)
+	p.reader = bufio.NewReaderSize(metered, 1*1024*1024)
+
+	for {
+		var commitType CommitType
+
+		err := binary.Read(p.reader, binary.LittleEndian, &commitType)
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			f.Close()
+			return errors.Wrap(err, "read commit type")
+		}
+
+		if commitType != CommitTypeRoaringSet {
+			f.Close()
+			return errors.Errorf("found a %d commit on a roaringset bucket", commitType)
+		}
+
+		if err := p.parseRoaringSetNode(); err != nil {
+			f.Close()
+			return errors.Wrap(err, "read roaringset node")
+		}
+	}
+
+	return f.Close()
+}
+
+func (p *commitloggerParser) parseRoaringSetNode() error {
+	n, err := roaringset.ParseSegmentNode(p.reader)
+	if err != nil {
+		return err
+	}
+
+	layer, err := n.Layer()
+	if err != nil {
+		return err
+	}
+
+	// the additions and deletions of a single node are disjoint, so the order
+	// in which they are applied does not matter
+	key := n.PrimaryKey()
+	if !layer.Additions.IsEmpty() {
+		if err := p.memtable.roaringSetAddList(key, layer.Additions.ToArray()); err != nil {
+			return err
+		}
+	}
+
+	if !layer.Deletions.IsEmpty() {
+		if err := p.memtable.roaringSetRemoveList(key, layer.Deletions.ToArray()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
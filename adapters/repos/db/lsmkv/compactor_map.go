@@ -30,8 +30,10 @@ type compactorMap struct {
 	currentLevel        uint16
 	secondaryIndexCount uint16
 
-	w    io.WriteSeeker
-	bufw *bufio.Writer
+	w           io.WriteSeeker
+	bufw        *bufio.Writer
+	nodes       *segmentBlockWriter
+	compression string
 
 	scratchSpacePath string
 
@@ -42,7 +44,7 @@ type compactorMap struct {
 
 func newCompactorMapCollection(w io.WriteSeeker,
 	c1, c2 *segmentCursorCollectionReusable, level, secondaryIndexCount uint16,
-	scratchSpacePath string, requiresSorting bool,
+	scratchSpacePath, compression string, requiresSorting bool,
 ) *compactorMap {
 	return &compactorMap{
 		c1:                  c1,
@@ -52,6 +54,7 @@ func newCompactorMapCollection(w io.WriteSeeker,
 		currentLevel:        level,
 		secondaryIndexCount: secondaryIndexCount,
 		scratchSpacePath:    scratchSpacePath,
+		compression:         compression,
 		requiresSorting:     requiresSorting,
 	}
 }
@@ -77,7 +80,7 @@ func (c *compactorMap) do() error {
 
 	dataEnd := uint64(kis[len(kis)-1].valueEnd)
 
-	if err := c.writeHeader(c.currentLevel+1, 0, c.secondaryIndexCount,
+	if err := c.writeHeader(c.currentLevel+1, c.nodes.version(), c.secondaryIndexCount,
 		dataEnd); err != nil {
 		return errors.Wrap(err, "write header")
 	}
@@ -94,6 +97,12 @@ func (c *compactorMap) init() error {
 		return errors.Wrap(err, "write empty header")
 	}
 
+	nodes, err := newSegmentBlockWriter(c.bufw, c.compression)
+	if err != nil {
+		return errors.Wrap(err, "init node writer")
+	}
+	c.nodes = nodes
+
 	return nil
 }
 
@@ -196,11 +205,11 @@ func (c *compactorMap) writeKeys() ([]keyIndex, error) {
 func (c *compactorMap) writeIndividualNode(offset int, key []byte,
 	values []value,
 ) (keyIndex, error) {
-	return segmentCollectionNode{
+	return c.nodes.writeNode(offset, segmentCollectionNode{
 		values:     values,
 		primaryKey: key,
 		offset:     offset,
-	}.KeyIndexAndWriteTo(c.bufw)
+	}.KeyIndexAndWriteTo)
 }
 
 func (c *compactorMap) writeIndices(keys []keyIndex) error {
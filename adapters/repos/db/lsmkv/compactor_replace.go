@@ -32,12 +32,14 @@ type compactorReplace struct {
 
 	w                io.WriteSeeker
 	bufw             *bufio.Writer
+	nodes            *segmentBlockWriter
+	compression      string
 	scratchSpacePath string
 }
 
 func newCompactorReplace(w io.WriteSeeker,
 	c1, c2 *segmentCursorReplace, level, secondaryIndexCount uint16,
-	scratchSpacePath string,
+	scratchSpacePath, compression string,
 ) *compactorReplace {
 	return &compactorReplace{
 		c1:                  c1,
@@ -47,6 +49,7 @@ func newCompactorReplace(w io.WriteSeeker,
 		currentLevel:        level,
 		secondaryIndexCount: secondaryIndexCount,
 		scratchSpacePath:    scratchSpacePath,
+		compression:         compression,
 	}
 }
 
@@ -71,7 +74,7 @@ func (c *compactorReplace) do() error {
 
 	dataEnd := uint64(kis[len(kis)-1].valueEnd)
 
-	if err := c.writeHeader(c.currentLevel+1, 0, c.secondaryIndexCount, dataEnd); err != nil {
+	if err := c.writeHeader(c.currentLevel+1, c.nodes.version(), c.secondaryIndexCount, dataEnd); err != nil {
 		return errors.Wrap(err, "write header")
 	}
 
@@ -87,6 +90,12 @@ func (c *compactorReplace) init() error {
 		return errors.Wrap(err, "write empty header")
 	}
 
+	nodes, err := newSegmentBlockWriter(c.bufw, c.compression)
+	if err != nil {
+		return errors.Wrap(err, "init node writer")
+	}
+	c.nodes = nodes
+
 	return nil
 }
 
@@ -160,7 +169,7 @@ func (c *compactorReplace) writeIndividualNode(offset int, key, value []byte,
 		secondaryKeys:       secondaryKeys,
 	}
 
-	return segNode.KeyIndexAndWriteTo(c.bufw)
+	return c.nodes.writeNode(offset, segNode.KeyIndexAndWriteTo)
 }
 
 func (c *compactorReplace) writeIndices(keys []keyIndex) error {
@@ -0,0 +1,226 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package lsmkv
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+
+	"github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/lsmkv/roaringset"
+)
+
+type compactorRoaringSet struct {
+	// c1 is always the older segment, so when there is a conflict c2 wins
+	// (because of the replace strategy)
+	c1 *segmentCursorRoaringSet
+	c2 *segmentCursorRoaringSet
+
+	// the level matching those of the cursors
+	currentLevel        uint16
+	secondaryIndexCount uint16
+
+	// if the older segment is the lowest segment of the bucket, there is
+	// nothing left that deletions could act on, so they can be discarded
+	cleanupDeletions bool
+
+	w           io.WriteSeeker
+	bufw        *bufio.Writer
+	nodes       *segmentBlockWriter
+	compression string
+
+	scratchSpacePath string
+}
+
+func newCompactorRoaringSet(w io.WriteSeeker,
+	c1, c2 *segmentCursorRoaringSet, level, secondaryIndexCount uint16,
+	scratchSpacePath, compression string, cleanupDeletions bool,
+) *compactorRoaringSet {
+	return &compactorRoaringSet{
+		c1:                  c1,
+		c2:                  c2,
+		w:                   w,
+		bufw:                bufio.NewWriterSize(w, 256*1024),
+		currentLevel:        level,
+		secondaryIndexCount: secondaryIndexCount,
+		cleanupDeletions:    cleanupDeletions,
+		scratchSpacePath:    scratchSpacePath,
+		compression:         compression,
+	}
+}
+
+func (c *compactorRoaringSet) do() error {
+	if err := c.init(); err != nil {
+		return errors.Wrap(err, "init")
+	}
+
+	kis, err := c.writeKeys()
+	if err != nil {
+		return errors.Wrap(err, "write keys")
+	}
+
+	if err := c.writeIndices(kis); err != nil {
+		return errors.Wrap(err, "write index")
+	}
+
+	// flush buffered, so we can safely seek on underlying writer
+	if err := c.bufw.Flush(); err != nil {
+		return errors.Wrap(err, "flush buffered")
+	}
+
+	dataEnd := uint64(kis[len(kis)-1].valueEnd)
+
+	if err := c.writeHeader(c.currentLevel+1, c.nodes.version(), c.secondaryIndexCount,
+		dataEnd); err != nil {
+		return errors.Wrap(err, "write header")
+	}
+
+	return nil
+}
+
+func (c *compactorRoaringSet) init() error {
+	// write a dummy header, we don't know the contents of the actual header yet,
+	// we will seek to the beginning and overwrite the actual header at the very
+	// end
+
+	if _, err := c.bufw.Write(make([]byte, SegmentHeaderSize)); err != nil {
+		return errors.Wrap(err, "write empty header")
+	}
+
+	nodes, err := newSegmentBlockWriter(c.bufw, c.compression)
+	if err != nil {
+		return errors.Wrap(err, "init node writer")
+	}
+	c.nodes = nodes
+
+	return nil
+}
+
+func (c *compactorRoaringSet) writeKeys() ([]keyIndex, error) {
+	key1, layer1, err := c.c1.first()
+	if err != nil && err != NotFound {
+		return nil, errors.Wrap(err, "read first node of segment 1")
+	}
+	key2, layer2, err := c.c2.first()
+	if err != nil && err != NotFound {
+		return nil, errors.Wrap(err, "read first node of segment 2")
+	}
+
+	// the (dummy) header was already written, this is our initial offset
+	offset := SegmentHeaderSize
+
+	var kis []keyIndex
+
+	for {
+		if key1 == nil && key2 == nil {
+			break
+		}
+
+		var (
+			key   []byte
+			layer roaringset.BitmapLayer
+		)
+
+		if bytes.Equal(key1, key2) {
+			merged, err := roaringset.BitmapLayers{layer1, layer2}.Merge()
+			if err != nil {
+				return nil, errors.Wrap(err, "merge layers (equal keys)")
+			}
+
+			key, layer = key2, merged
+
+			// advance both!
+			key1, layer1, err = c.c1.next()
+			if err != nil && err != NotFound {
+				return nil, errors.Wrap(err, "advance segment 1")
+			}
+			key2, layer2, err = c.c2.next()
+			if err != nil && err != NotFound {
+				return nil, errors.Wrap(err, "advance segment 2")
+			}
+		} else if (key1 != nil && bytes.Compare(key1, key2) == -1) || key2 == nil {
+			// key 1 is smaller
+			key, layer = key1, layer1
+			key1, layer1, err = c.c1.next()
+			if err != nil && err != NotFound {
+				return nil, errors.Wrap(err, "advance segment 1")
+			}
+		} else {
+			// key 2 is smaller
+			key, layer = key2, layer2
+			key2, layer2, err = c.c2.next()
+			if err != nil && err != NotFound {
+				return nil, errors.Wrap(err, "advance segment 2")
+			}
+		}
+
+		deletions := layer.Deletions
+		if c.cleanupDeletions {
+			deletions = roaring64.New()
+		}
+
+		sn, err := roaringset.NewSegmentNode(key, layer.Additions, deletions)
+		if err != nil {
+			return nil, errors.Wrap(err, "create node")
+		}
+
+		ki, err := c.nodes.writeNode(offset, func(w io.Writer) (keyIndex, error) {
+			return roaringSetNodeKeyIndexAndWriteTo(w, sn, offset)
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "write individual node")
+		}
+
+		offset = ki.valueEnd
+		kis = append(kis, ki)
+	}
+
+	return kis, nil
+}
+
+func (c *compactorRoaringSet) writeIndices(keys []keyIndex) error {
+	indices := &segmentIndices{
+		keys:                keys,
+		secondaryIndexCount: c.secondaryIndexCount,
+		scratchSpacePath:    c.scratchSpacePath,
+	}
+
+	_, err := indices.WriteTo(c.bufw)
+	return err
+}
+
+// writeHeader assumes that everything has been written to the underlying
+// writer and it is now safe to seek to the beginning and override the initial
+// header
+func (c *compactorRoaringSet) writeHeader(level, version, secondaryIndices uint16,
+	startOfIndex uint64,
+) error {
+	if _, err := c.w.Seek(0, io.SeekStart); err != nil {
+		return errors.Wrap(err, "seek to beginning to write header")
+	}
+
+	h := &segmentHeader{
+		level:            level,
+		version:          version,
+		secondaryIndices: secondaryIndices,
+		strategy:         SegmentStrategyRoaringSet,
+		indexStart:       startOfIndex,
+	}
+
+	if _, err := h.WriteTo(c.w); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -29,15 +29,17 @@ type compactorSet struct {
 	currentLevel        uint16
 	secondaryIndexCount uint16
 
-	w    io.WriteSeeker
-	bufw *bufio.Writer
+	w           io.WriteSeeker
+	bufw        *bufio.Writer
+	nodes       *segmentBlockWriter
+	compression string
 
 	scratchSpacePath string
 }
 
 func newCompactorSetCollection(w io.WriteSeeker,
 	c1, c2 *segmentCursorCollection, level, secondaryIndexCount uint16,
-	scratchSpacePath string,
+	scratchSpacePath, compression string,
 ) *compactorSet {
 	return &compactorSet{
 		c1:                  c1,
@@ -47,6 +49,7 @@ func newCompactorSetCollection(w io.WriteSeeker,
 		currentLevel:        level,
 		secondaryIndexCount: secondaryIndexCount,
 		scratchSpacePath:    scratchSpacePath,
+		compression:         compression,
 	}
 }
 
@@ -71,7 +74,7 @@ func (c *compactorSet) do() error {
 
 	dataEnd := uint64(kis[len(kis)-1].valueEnd)
 
-	if err := c.writeHeader(c.currentLevel+1, 0, c.secondaryIndexCount,
+	if err := c.writeHeader(c.currentLevel+1, c.nodes.version(), c.secondaryIndexCount,
 		dataEnd); err != nil {
 		return errors.Wrap(err, "write header")
 	}
@@ -88,6 +91,12 @@ func (c *compactorSet) init() error {
 		return errors.Wrap(err, "write empty header")
 	}
 
+	nodes, err := newSegmentBlockWriter(c.bufw, c.compression)
+	if err != nil {
+		return errors.Wrap(err, "init node writer")
+	}
+	c.nodes = nodes
+
 	return nil
 }
 
@@ -152,11 +161,11 @@ func (c *compactorSet) writeKeys() ([]keyIndex, error) {
 func (c *compactorSet) writeIndividualNode(offset int, key []byte,
 	values []value,
 ) (keyIndex, error) {
-	return (&segmentCollectionNode{
+	return c.nodes.writeNode(offset, (&segmentCollectionNode{
 		values:     values,
 		primaryKey: key,
 		offset:     offset,
-	}).KeyIndexAndWriteTo(c.bufw)
+	}).KeyIndexAndWriteTo)
 }
 
 func (c *compactorSet) writeIndices(keys []keyIndex) error {
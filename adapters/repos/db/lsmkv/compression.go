@@ -0,0 +1,272 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package lsmkv
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"sync"
+
+	"github.com/klauspost/compress/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pkg/errors"
+)
+
+const (
+	// CompressionNone writes segments without any compression, this is the
+	// default
+	CompressionNone = "none"
+	// CompressionSnappy is fast, but typically leads to lower compression
+	// ratios than CompressionZstd
+	CompressionSnappy = "snappy"
+	CompressionZstd   = "zstd"
+)
+
+const (
+	segmentVersionUncompressed uint16 = 0
+
+	// segments with this version have their data area split into blocks, see
+	// segmentBlockWriter for details. Header and indexes are unaffected.
+	segmentVersionBlockCompressed uint16 = 1
+)
+
+type blockCodec uint8
+
+const (
+	blockCodecNone blockCodec = iota
+	blockCodecSnappy
+	blockCodecZstd
+)
+
+// 1 byte codec, 8 bytes uncompressed length, 8 bytes block payload length
+const blockHeaderSize = 17
+
+func blockCodecFromCompression(compression string) (blockCodec, error) {
+	switch compression {
+	case CompressionNone, "":
+		return blockCodecNone, nil
+	case CompressionSnappy:
+		return blockCodecSnappy, nil
+	case CompressionZstd:
+		return blockCodecZstd, nil
+	default:
+		return 0, errors.Errorf("unrecognized compression %q", compression)
+	}
+}
+
+var (
+	zstdInit    sync.Once
+	zstdEncoder *zstd.Encoder
+	zstdDecoder *zstd.Decoder
+	zstdInitErr error
+)
+
+// the zstd encoder and decoder are safe to use concurrently when used
+// through EncodeAll and DecodeAll, so they are shared across all buckets
+func initZstd() error {
+	zstdInit.Do(func() {
+		zstdEncoder, zstdInitErr = zstd.NewWriter(nil,
+			zstd.WithEncoderConcurrency(1))
+		if zstdInitErr != nil {
+			return
+		}
+		zstdDecoder, zstdInitErr = zstd.NewReader(nil,
+			zstd.WithDecoderConcurrency(1))
+	})
+
+	return zstdInitErr
+}
+
+// appendBlock appends the block for raw to dst. If the codec cannot make the
+// block smaller, as is typical for very short nodes, the block is stored
+// uncompressed.
+func appendBlock(dst []byte, codec blockCodec, raw []byte) ([]byte, error) {
+	headerPos := len(dst)
+	dst = append(dst, make([]byte, blockHeaderSize)...)
+
+	switch codec {
+	case blockCodecSnappy:
+		dst = append(dst, make([]byte, snappy.MaxEncodedLen(len(raw)))...)
+		enc := snappy.Encode(dst[headerPos+blockHeaderSize:], raw)
+		dst = dst[:headerPos+blockHeaderSize+len(enc)]
+	case blockCodecZstd:
+		if err := initZstd(); err != nil {
+			return nil, errors.Wrap(err, "init zstd")
+		}
+		dst = zstdEncoder.EncodeAll(raw, dst)
+	case blockCodecNone:
+		dst = append(dst, raw...)
+	default:
+		return nil, errors.Errorf("unrecognized block codec %d", codec)
+	}
+
+	payloadLen := len(dst) - headerPos - blockHeaderSize
+	if codec != blockCodecNone && payloadLen >= len(raw) {
+		return appendBlock(dst[:headerPos], blockCodecNone, raw)
+	}
+
+	dst[headerPos] = uint8(codec)
+	binary.LittleEndian.PutUint64(dst[headerPos+1:headerPos+9], uint64(len(raw)))
+	binary.LittleEndian.PutUint64(dst[headerPos+9:headerPos+17], uint64(payloadLen))
+	return dst, nil
+}
+
+// decodeBlock returns the node contained in the block at the start of in, as
+// well as the length of the block. The node is always a newly allocated
+// slice that does not point into in, unless the block was stored
+// uncompressed.
+func decodeBlock(in []byte) ([]byte, uint64, error) {
+	if len(in) < blockHeaderSize {
+		return nil, 0, errors.Errorf("block header exceeds contents (%d bytes)", len(in))
+	}
+
+	codec := blockCodec(in[0])
+	rawLen := binary.LittleEndian.Uint64(in[1:9])
+	payloadLen := binary.LittleEndian.Uint64(in[9:17])
+	blockLen := blockHeaderSize + payloadLen
+	if uint64(len(in)) < blockLen {
+		return nil, 0, errors.Errorf("block of %d bytes exceeds contents (%d bytes)",
+			blockLen, len(in))
+	}
+
+	payload := in[blockHeaderSize:blockLen]
+
+	switch codec {
+	case blockCodecNone:
+		return payload, blockLen, nil
+	case blockCodecSnappy:
+		out, err := snappy.Decode(make([]byte, rawLen), payload)
+		if err != nil {
+			return nil, 0, errors.Wrap(err, "decode snappy block")
+		}
+		return out, blockLen, nil
+	case blockCodecZstd:
+		if err := initZstd(); err != nil {
+			return nil, 0, errors.Wrap(err, "init zstd")
+		}
+		out, err := zstdDecoder.DecodeAll(payload, make([]byte, 0, rawLen))
+		if err != nil {
+			return nil, 0, errors.Wrap(err, "decode zstd block")
+		}
+		return out, blockLen, nil
+	default:
+		return nil, 0, errors.Errorf("unrecognized block codec %d", codec)
+	}
+}
+
+// segmentBlockWriter writes the nodes of a segment. Without compression, the
+// nodes are written as they are. With compression, every node is compressed
+// into its own block, so that a single node can still be read without
+// touching its neighbors. The index positions of a node then point to the
+// start and end of its block.
+type segmentBlockWriter struct {
+	w     io.Writer
+	codec blockCodec
+
+	rawBuf bytes.Buffer
+	block  []byte
+
+	// to calculate the compression ratio of the segment
+	rawBytes     int
+	writtenBytes int
+}
+
+func newSegmentBlockWriter(w io.Writer, compression string) (*segmentBlockWriter, error) {
+	codec, err := blockCodecFromCompression(compression)
+	if err != nil {
+		return nil, err
+	}
+
+	return &segmentBlockWriter{w: w, codec: codec}, nil
+}
+
+func (bw *segmentBlockWriter) compressed() bool {
+	return bw.codec != blockCodecNone
+}
+
+func (bw *segmentBlockWriter) version() uint16 {
+	if bw.compressed() {
+		return segmentVersionBlockCompressed
+	}
+
+	return segmentVersionUncompressed
+}
+
+// writeNode writes a single node at the specified offset, writeTo is
+// typically the KeyIndexAndWriteTo method of the node
+func (bw *segmentBlockWriter) writeNode(offset int,
+	writeTo func(w io.Writer) (keyIndex, error),
+) (keyIndex, error) {
+	if !bw.compressed() {
+		ki, err := writeTo(bw.w)
+		if err != nil {
+			return ki, err
+		}
+
+		bw.rawBytes += ki.valueEnd - ki.valueStart
+		bw.writtenBytes += ki.valueEnd - ki.valueStart
+		return ki, nil
+	}
+
+	bw.rawBuf.Reset()
+	ki, err := writeTo(&bw.rawBuf)
+	if err != nil {
+		return ki, err
+	}
+
+	bw.block, err = appendBlock(bw.block[:0], bw.codec, bw.rawBuf.Bytes())
+	if err != nil {
+		return ki, errors.Wrap(err, "compress node")
+	}
+
+	if _, err := bw.w.Write(bw.block); err != nil {
+		return ki, err
+	}
+
+	bw.rawBytes += bw.rawBuf.Len()
+	bw.writtenBytes += len(bw.block)
+
+	ki.valueStart = offset
+	ki.valueEnd = offset + len(bw.block)
+	return ki, nil
+}
+
+// nodeContents returns the node that the index places between start and end
+func (s *segment) nodeContents(start, end uint64) ([]byte, error) {
+	if !s.compressed {
+		return s.contents[start:end], nil
+	}
+
+	node, _, err := decodeBlock(s.contents[start:end])
+	return node, err
+}
+
+// nodeContentsAt is used for sequential reads of the node at offset. The
+// length of an uncompressed node is only known after parsing it, so the
+// returned contents extend beyond the node and the returned block length is
+// 0. Use nextNodeOffset after parsing to find the start of the following node.
+func (s *segment) nodeContentsAt(offset uint64) ([]byte, uint64, error) {
+	if !s.compressed {
+		return s.contents[offset:], 0, nil
+	}
+
+	return decodeBlock(s.contents[offset:s.dataEndPos])
+}
+
+func (s *segment) nextNodeOffset(offset, blockLen uint64, parsedLen int) uint64 {
+	if s.compressed {
+		return offset + blockLen
+	}
+
+	return offset + uint64(parsedLen)
+}
@@ -0,0 +1,294 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package lsmkv
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompression_AllStrategies(t *testing.T) {
+	for _, compression := range []string{CompressionNone, CompressionSnappy, CompressionZstd} {
+		t.Run(compression, func(t *testing.T) {
+			t.Run("replace", func(t *testing.T) {
+				compressionReplaceJourney(t, compression)
+			})
+			t.Run("set", func(t *testing.T) {
+				compressionSetJourney(t, compression)
+			})
+			t.Run("map", func(t *testing.T) {
+				compressionMapJourney(t, compression)
+			})
+			t.Run("roaringset", func(t *testing.T) {
+				compressionRoaringSetJourney(t, compression)
+			})
+		})
+	}
+}
+
+func compressibleValue(i int) []byte {
+	return []byte(strings.Repeat(fmt.Sprintf("value-%03d;", i), 20))
+}
+
+// compactAll compacts until only a single segment is left
+func compactAll(t *testing.T, b *Bucket) {
+	require.True(t, b.disk.eligibleForCompaction())
+	for b.disk.eligibleForCompaction() {
+		require.Nil(t, b.disk.compactOnce())
+	}
+}
+
+func compressionReplaceJourney(t *testing.T, compression string) {
+	b, err := NewBucket(testCtx(), t.TempDir(), "", nullLogger(), nil,
+		WithStrategy(StrategyReplace), WithSecondaryIndices(1),
+		WithCompression(compression))
+	require.Nil(t, err)
+	b.SetMemtableThreshold(1e9)
+
+	key := func(i int) []byte { return []byte(fmt.Sprintf("key-%03d", i)) }
+	secondary := func(i int) []byte { return []byte(fmt.Sprintf("secondary-%03d", i)) }
+
+	for i := 0; i < 100; i++ {
+		require.Nil(t, b.Put(key(i), compressibleValue(i),
+			WithSecondaryKey(0, secondary(i))))
+	}
+	require.Nil(t, b.FlushAndSwitch())
+
+	for i := 50; i < 150; i++ {
+		require.Nil(t, b.Put(key(i), compressibleValue(i+1000),
+			WithSecondaryKey(0, secondary(i))))
+	}
+	require.Nil(t, b.Delete(key(0), WithSecondaryKey(0, secondary(0))))
+	require.Nil(t, b.FlushAndSwitch())
+
+	verify := func(t *testing.T) {
+		res, err := b.Get(key(0))
+		require.Nil(t, err)
+		assert.Nil(t, res)
+
+		res, err = b.Get(key(10))
+		require.Nil(t, err)
+		assert.Equal(t, compressibleValue(10), res)
+
+		res, err = b.Get(key(60))
+		require.Nil(t, err)
+		assert.Equal(t, compressibleValue(1060), res)
+
+		res, err = b.GetBySecondary(0, secondary(120))
+		require.Nil(t, err)
+		assert.Equal(t, compressibleValue(1120), res)
+
+		c := b.Cursor()
+		defer c.Close()
+		count := 0
+		for k, _ := c.First(); k != nil; k, _ = c.Next() {
+			assert.NotEqual(t, key(0), k)
+			count++
+		}
+		assert.Equal(t, 149, count)
+
+		k, v := c.Seek(key(75))
+		assert.Equal(t, key(75), k)
+		assert.Equal(t, compressibleValue(1075), v)
+
+		assert.Equal(t, 149, b.Count())
+	}
+
+	t.Run("before compaction", verify)
+	compactAll(t, b)
+	t.Run("after compaction", verify)
+}
+
+func compressionSetJourney(t *testing.T, compression string) {
+	b, err := NewBucket(testCtx(), t.TempDir(), "", nullLogger(), nil,
+		WithStrategy(StrategySetCollection), WithCompression(compression))
+	require.Nil(t, err)
+	b.SetMemtableThreshold(1e9)
+
+	key := []byte("row")
+	require.Nil(t, b.SetAdd(key, [][]byte{compressibleValue(1), compressibleValue(2)}))
+	require.Nil(t, b.FlushAndSwitch())
+	require.Nil(t, b.SetAdd(key, [][]byte{compressibleValue(3)}))
+	require.Nil(t, b.SetDeleteSingle(key, compressibleValue(1)))
+	require.Nil(t, b.FlushAndSwitch())
+
+	expected := [][]byte{compressibleValue(2), compressibleValue(3)}
+	verify := func(t *testing.T) {
+		res, err := b.SetList(key)
+		require.Nil(t, err)
+		assert.Equal(t, expected, res)
+
+		c := b.SetCursor()
+		defer c.Close()
+		k, v := c.First()
+		assert.Equal(t, key, k)
+		assert.Equal(t, expected, v)
+	}
+
+	t.Run("before compaction", verify)
+	compactAll(t, b)
+	t.Run("after compaction", verify)
+}
+
+func compressionMapJourney(t *testing.T, compression string) {
+	b, err := NewBucket(testCtx(), t.TempDir(), "", nullLogger(), nil,
+		WithStrategy(StrategyMapCollection), WithCompression(compression))
+	require.Nil(t, err)
+	b.SetMemtableThreshold(1e9)
+
+	key := []byte("row")
+	require.Nil(t, b.MapSet(key, MapPair{Key: []byte("a"), Value: compressibleValue(1)}))
+	require.Nil(t, b.MapSet(key, MapPair{Key: []byte("b"), Value: compressibleValue(2)}))
+	require.Nil(t, b.FlushAndSwitch())
+	require.Nil(t, b.MapSet(key, MapPair{Key: []byte("b"), Value: compressibleValue(3)}))
+	require.Nil(t, b.MapDeleteKey(key, []byte("a")))
+	require.Nil(t, b.FlushAndSwitch())
+
+	expected := []MapPair{{Key: []byte("b"), Value: compressibleValue(3)}}
+	verify := func(t *testing.T) {
+		res, err := b.MapList(key)
+		require.Nil(t, err)
+		assert.Equal(t, expected, res)
+
+		c := b.MapCursor()
+		defer c.Close()
+		k, v := c.First()
+		assert.Equal(t, key, k)
+		assert.Equal(t, expected, v)
+	}
+
+	t.Run("before compaction", verify)
+	compactAll(t, b)
+	t.Run("after compaction", verify)
+}
+
+func compressionRoaringSetJourney(t *testing.T, compression string) {
+	b, err := NewBucket(testCtx(), t.TempDir(), "", nullLogger(), nil,
+		WithStrategy(StrategyRoaringSet), WithCompression(compression))
+	require.Nil(t, err)
+	b.SetMemtableThreshold(1e9)
+
+	key := []byte("row")
+	values := make([]uint64, 1000)
+	for i := range values {
+		values[i] = uint64(i * 3)
+	}
+	require.Nil(t, b.RoaringSetAddList(key, values))
+	require.Nil(t, b.FlushAndSwitch())
+	require.Nil(t, b.RoaringSetRemoveOne(key, 0))
+	require.Nil(t, b.RoaringSetAddOne(key, 1))
+	require.Nil(t, b.FlushAndSwitch())
+
+	verify := func(t *testing.T) {
+		res, err := b.RoaringSetGet(key)
+		require.Nil(t, err)
+		assert.Equal(t, uint64(1000), res.GetCardinality())
+		assert.False(t, res.Contains(0))
+		assert.True(t, res.Contains(1))
+		assert.True(t, res.Contains(2997))
+
+		c := b.CursorRoaringSet()
+		defer c.Close()
+		k, bm := c.First()
+		assert.Equal(t, key, k)
+		assert.Equal(t, uint64(1000), bm.GetCardinality())
+	}
+
+	t.Run("before compaction", verify)
+	compactAll(t, b)
+	t.Run("after compaction", verify)
+}
+
+func TestCompression_MixedSegments(t *testing.T) {
+	dir := t.TempDir()
+	key := func(i int) []byte { return []byte(fmt.Sprintf("key-%03d", i)) }
+
+	b, err := NewBucket(testCtx(), dir, "", nullLogger(), nil,
+		WithStrategy(StrategyReplace))
+	require.Nil(t, err)
+	for i := 0; i < 100; i++ {
+		require.Nil(t, b.Put(key(i), compressibleValue(i)))
+	}
+	require.Nil(t, b.Shutdown(testCtx()))
+
+	// the existing uncompressed segment must still be readable after
+	// compression is turned on
+	b, err = NewBucket(testCtx(), dir, "", nullLogger(), nil,
+		WithStrategy(StrategyReplace), WithCompression(CompressionZstd))
+	require.Nil(t, err)
+	for i := 50; i < 150; i++ {
+		require.Nil(t, b.Put(key(i), compressibleValue(i+1000)))
+	}
+	require.Nil(t, b.FlushAndSwitch())
+
+	verify := func(t *testing.T) {
+		res, err := b.Get(key(10))
+		require.Nil(t, err)
+		assert.Equal(t, compressibleValue(10), res)
+
+		res, err = b.Get(key(100))
+		require.Nil(t, err)
+		assert.Equal(t, compressibleValue(1100), res)
+
+		assert.Equal(t, 150, b.Count())
+	}
+
+	t.Run("before compaction", verify)
+	compactAll(t, b)
+	t.Run("after compaction", verify)
+
+	require.Len(t, b.disk.segments, 1)
+	assert.True(t, b.disk.segments[0].compressed)
+	require.Nil(t, b.Shutdown(testCtx()))
+}
+
+func TestCompression_ReducesSegmentSize(t *testing.T) {
+	segmentSize := func(t *testing.T, compression string) int64 {
+		dir := t.TempDir()
+		b, err := NewBucket(testCtx(), dir, "", nullLogger(), nil,
+			WithStrategy(StrategyReplace), WithCompression(compression))
+		require.Nil(t, err)
+
+		for i := 0; i < 100; i++ {
+			require.Nil(t, b.Put([]byte(fmt.Sprintf("key-%03d", i)), compressibleValue(i)))
+		}
+		require.Nil(t, b.Shutdown(testCtx()))
+
+		segments, err := filepath.Glob(filepath.Join(dir, "*.db"))
+		require.Nil(t, err)
+		require.Len(t, segments, 1)
+
+		info, err := os.Stat(segments[0])
+		require.Nil(t, err)
+		return info.Size()
+	}
+
+	uncompressed := segmentSize(t, CompressionNone)
+	assert.Less(t, segmentSize(t, CompressionSnappy), uncompressed)
+	assert.Less(t, segmentSize(t, CompressionZstd), uncompressed)
+}
+
+func TestCompression_InvalidOption(t *testing.T) {
+	_, err := NewBucket(testCtx(), t.TempDir(), "", nullLogger(), nil,
+		WithCompression("gzip"))
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "gzip")
+}
@@ -0,0 +1,77 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package lsmkv
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockRoundTrip(t *testing.T) {
+	compressible := bytes.Repeat([]byte("weaviate"), 100)
+	short := []byte("a")
+
+	for _, codec := range []blockCodec{blockCodecNone, blockCodecSnappy, blockCodecZstd} {
+		for _, raw := range [][]byte{compressible, short, {}} {
+			// start with existing data to make sure the block is appended
+			prefix := []byte("prefix")
+			block, err := appendBlock(append([]byte{}, prefix...), codec, raw)
+			require.Nil(t, err)
+			require.Equal(t, prefix, block[:len(prefix)])
+
+			node, blockLen, err := decodeBlock(block[len(prefix):])
+			require.Nil(t, err)
+			assert.Equal(t, uint64(len(block)-len(prefix)), blockLen)
+			assert.Equal(t, len(raw), len(node))
+			assert.True(t, bytes.Equal(raw, node))
+		}
+	}
+}
+
+func TestBlockFallsBackToUncompressed(t *testing.T) {
+	block, err := appendBlock(nil, blockCodecZstd, []byte("a"))
+	require.Nil(t, err)
+	assert.Equal(t, uint8(blockCodecNone), block[0])
+
+	block, err = appendBlock(nil, blockCodecZstd, bytes.Repeat([]byte("a"), 1000))
+	require.Nil(t, err)
+	assert.Equal(t, uint8(blockCodecZstd), block[0])
+	assert.Less(t, len(block), 1000)
+}
+
+func TestDecodeBlockExceedingContents(t *testing.T) {
+	block, err := appendBlock(nil, blockCodecSnappy, bytes.Repeat([]byte("a"), 1000))
+	require.Nil(t, err)
+
+	_, _, err = decodeBlock(block[:len(block)-1])
+	assert.NotNil(t, err)
+
+	_, _, err = decodeBlock(block[:3])
+	assert.NotNil(t, err)
+}
+
+func TestWithCompression(t *testing.T) {
+	for _, compression := range []string{"", CompressionNone} {
+		b := &Bucket{compression: CompressionZstd}
+		require.Nil(t, WithCompression(compression)(b))
+		assert.Equal(t, CompressionNone, b.Compression())
+	}
+
+	b := &Bucket{}
+	require.Nil(t, WithCompression(CompressionSnappy)(b))
+	assert.Equal(t, CompressionSnappy, b.Compression())
+
+	assert.NotNil(t, WithCompression("lz4")(b))
+}
@@ -0,0 +1,177 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package lsmkv
+
+import (
+	"bytes"
+
+	"github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/lsmkv/roaringset"
+)
+
+type CursorRoaringSet struct {
+	innerCursors []innerCursorRoaringSet
+	state        []cursorStateRoaringSet
+	unlock       func()
+	keyOnly      bool
+}
+
+type innerCursorRoaringSet interface {
+	first() ([]byte, roaringset.BitmapLayer, error)
+	next() ([]byte, roaringset.BitmapLayer, error)
+	seek([]byte) ([]byte, roaringset.BitmapLayer, error)
+}
+
+type cursorStateRoaringSet struct {
+	key   []byte
+	layer roaringset.BitmapLayer
+	err   error
+}
+
+// CursorRoaringSet holds a RLock for the flushing state. It needs to be closed
+// using the .Close() methods or otherwise the lock will never be released.
+// Each key is served together with the flattened bitmap of all layers.
+func (b *Bucket) CursorRoaringSet() *CursorRoaringSet {
+	return b.cursorRoaringSet(false)
+}
+
+// CursorRoaringSetKeyOnly returns nil for all bitmaps. As opposed to
+// SetCursorKeyOnly, the bitmaps are not even deserialized, which makes this
+// considerably cheaper if only keys are required.
+//
+// The same locking rules as for CursorRoaringSet apply.
+func (b *Bucket) CursorRoaringSetKeyOnly() *CursorRoaringSet {
+	return b.cursorRoaringSet(true)
+}
+
+func (b *Bucket) cursorRoaringSet(keyOnly bool) *CursorRoaringSet {
+	b.flushLock.RLock()
+
+	if b.strategy != StrategyRoaringSet {
+		panic("CursorRoaringSet() called on strategy other than 'roaringset'")
+	}
+
+	innerCursors, unlockSegmentGroup := b.disk.newRoaringSetCursors(keyOnly)
+
+	// we have a flush-RLock, so we have the guarantee that the flushing state
+	// will not change for the lifetime of the cursor, thus there can only be two
+	// states: either a flushing memtable currently exists - or it doesn't
+	if b.flushing != nil {
+		innerCursors = append(innerCursors, b.flushing.newRoaringSetCursor(keyOnly))
+	}
+
+	innerCursors = append(innerCursors, b.active.newRoaringSetCursor(keyOnly))
+
+	return &CursorRoaringSet{
+		unlock: func() {
+			unlockSegmentGroup()
+			b.flushLock.RUnlock()
+		},
+		// cursor are in order from oldest to newest, with the memtable cursor
+		// being at the very top
+		innerCursors: innerCursors,
+		keyOnly:      keyOnly,
+	}
+}
+
+func (c *CursorRoaringSet) Seek(key []byte) ([]byte, *roaring64.Bitmap) {
+	c.initAll(func(cur innerCursorRoaringSet) ([]byte, roaringset.BitmapLayer, error) {
+		return cur.seek(key)
+	})
+	return c.serveCurrentStateAndAdvance()
+}
+
+func (c *CursorRoaringSet) Next() ([]byte, *roaring64.Bitmap) {
+	return c.serveCurrentStateAndAdvance()
+}
+
+func (c *CursorRoaringSet) First() ([]byte, *roaring64.Bitmap) {
+	c.initAll(func(cur innerCursorRoaringSet) ([]byte, roaringset.BitmapLayer, error) {
+		return cur.first()
+	})
+	return c.serveCurrentStateAndAdvance()
+}
+
+func (c *CursorRoaringSet) Close() {
+	c.unlock()
+}
+
+func (c *CursorRoaringSet) initAll(
+	init func(cur innerCursorRoaringSet) ([]byte, roaringset.BitmapLayer, error),
+) {
+	state := make([]cursorStateRoaringSet, len(c.innerCursors))
+	for i, cur := range c.innerCursors {
+		key, layer, err := init(cur)
+		if err == NotFound {
+			state[i].err = err
+			continue
+		}
+
+		if err != nil {
+			panic(errors.Wrap(err, "unexpected error in seek"))
+		}
+
+		state[i].key = key
+		state[i].layer = layer
+	}
+
+	c.state = state
+}
+
+func (c *CursorRoaringSet) serveCurrentStateAndAdvance() ([]byte, *roaring64.Bitmap) {
+	var lowest []byte
+	for _, res := range c.state {
+		if res.err == NotFound {
+			continue
+		}
+
+		if lowest == nil || bytes.Compare(res.key, lowest) < 0 {
+			lowest = res.key
+		}
+	}
+
+	if lowest == nil {
+		return nil, nil
+	}
+
+	// all inner cursors are ordered from oldest to newest, so are the layers
+	var layers roaringset.BitmapLayers
+	for id, res := range c.state {
+		if res.err == NotFound || !bytes.Equal(res.key, lowest) {
+			continue
+		}
+
+		layers = append(layers, res.layer)
+		c.advanceInner(id)
+	}
+
+	if c.keyOnly {
+		return lowest, nil
+	}
+
+	return lowest, layers.Flatten()
+}
+
+func (c *CursorRoaringSet) advanceInner(id int) {
+	k, layer, err := c.innerCursors[id].next()
+	if err == NotFound {
+		c.state[id] = cursorStateRoaringSet{err: err}
+		return
+	}
+
+	if err != nil {
+		panic(errors.Wrap(err, "unexpected error in advance"))
+	}
+
+	c.state[id] = cursorStateRoaringSet{key: k, layer: layer}
+}
@@ -0,0 +1,78 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package lsmkv
+
+import (
+	"bytes"
+
+	"github.com/semi-technologies/weaviate/adapters/repos/db/lsmkv/roaringset"
+)
+
+type memtableCursorRoaringSet struct {
+	keys    [][]byte
+	layers  []roaringset.BitmapLayer
+	current int
+}
+
+func (l *Memtable) newRoaringSetCursor(keyOnly bool) innerCursorRoaringSet {
+	// Same approach as the collection cursor: flatten the entire memtable
+	// upfront. The layers are cloned, as the memtable could still receive
+	// writes while the cursor is open.
+
+	l.RLock()
+	defer l.RUnlock()
+
+	nodes := l.roaringSet.FlattenInOrder()
+	c := &memtableCursorRoaringSet{
+		keys:   make([][]byte, len(nodes)),
+		layers: make([]roaringset.BitmapLayer, len(nodes)),
+	}
+
+	for i, node := range nodes {
+		c.keys[i] = node.Key
+		if !keyOnly {
+			c.layers[i] = node.Value.Clone()
+		}
+	}
+
+	return c
+}
+
+func (c *memtableCursorRoaringSet) first() ([]byte, roaringset.BitmapLayer, error) {
+	c.current = 0
+	return c.serveCurrent()
+}
+
+func (c *memtableCursorRoaringSet) seek(key []byte) ([]byte, roaringset.BitmapLayer, error) {
+	c.current = len(c.keys)
+	for i, k := range c.keys {
+		if bytes.Compare(k, key) >= 0 {
+			c.current = i
+			break
+		}
+	}
+
+	return c.serveCurrent()
+}
+
+func (c *memtableCursorRoaringSet) next() ([]byte, roaringset.BitmapLayer, error) {
+	c.current++
+	return c.serveCurrent()
+}
+
+func (c *memtableCursorRoaringSet) serveCurrent() ([]byte, roaringset.BitmapLayer, error) {
+	if c.current >= len(c.keys) {
+		return nil, roaringset.BitmapLayer{}, NotFound
+	}
+
+	return c.keys[c.current], c.layers[c.current], nil
+}
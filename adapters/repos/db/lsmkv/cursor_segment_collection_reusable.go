@@ -37,8 +37,12 @@ func (s *segmentCursorCollectionReusable) seek(key []byte) ([]byte, []value, err
 		return nil, nil, err
 	}
 
-	err = s.segment.collectionStratParseDataWithKeyInto(
-		s.segment.contents[node.Start:node.End], &s.nodeBuf)
+	contents, err := s.segment.nodeContents(node.Start, node.End)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = s.segment.collectionStratParseDataWithKeyInto(contents, &s.nodeBuf)
 	if err != nil {
 		return s.nodeBuf.primaryKey, nil, err
 	}
@@ -53,13 +57,17 @@ func (s *segmentCursorCollectionReusable) next() ([]byte, []value, error) {
 		return nil, nil, NotFound
 	}
 
-	err := s.segment.collectionStratParseDataWithKeyInto(
-		s.segment.contents[s.nextOffset:], &s.nodeBuf)
+	contents, blockLen, err := s.segment.nodeContentsAt(s.nextOffset)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = s.segment.collectionStratParseDataWithKeyInto(contents, &s.nodeBuf)
 
 	// make sure to set the next offset before checking the error. The error
 	// could be 'Deleted' which would require that the offset is still advanced
 	// for the next cycle
-	s.nextOffset = s.nextOffset + uint64(s.nodeBuf.offset)
+	s.nextOffset = s.segment.nextNodeOffset(s.nextOffset, blockLen, s.nodeBuf.offset)
 	if err != nil {
 		return s.nodeBuf.primaryKey, nil, err
 	}
@@ -69,13 +77,17 @@ func (s *segmentCursorCollectionReusable) next() ([]byte, []value, error) {
 
 func (s *segmentCursorCollectionReusable) first() ([]byte, []value, error) {
 	s.nextOffset = s.segment.dataStartPos
-	err := s.segment.collectionStratParseDataWithKeyInto(
-		s.segment.contents[s.nextOffset:], &s.nodeBuf)
+	contents, blockLen, err := s.segment.nodeContentsAt(s.nextOffset)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = s.segment.collectionStratParseDataWithKeyInto(contents, &s.nodeBuf)
 	if err != nil {
 		return s.nodeBuf.primaryKey, nil, err
 	}
 
-	s.nextOffset = s.nextOffset + uint64(s.nodeBuf.offset)
+	s.nextOffset = s.segment.nextNodeOffset(s.nextOffset, blockLen, s.nodeBuf.offset)
 
 	return s.nodeBuf.primaryKey, s.nodeBuf.values, nil
 }
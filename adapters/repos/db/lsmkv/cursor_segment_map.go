@@ -47,8 +47,12 @@ func (s *segmentCursorMap) seek(key []byte) ([]byte, []MapPair, error) {
 		return nil, nil, err
 	}
 
-	parsed, err := s.segment.collectionStratParseDataWithKey(
-		s.segment.contents[node.Start:node.End])
+	contents, err := s.segment.nodeContents(node.Start, node.End)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	parsed, err := s.segment.collectionStratParseDataWithKey(contents)
 
 	// make sure to set the next offset before checking the error. The error
 	// could be 'Deleted' which would require that the offset is still advanced
@@ -74,13 +78,17 @@ func (s *segmentCursorMap) next() ([]byte, []MapPair, error) {
 		return nil, nil, NotFound
 	}
 
-	parsed, err := s.segment.collectionStratParseDataWithKey(
-		s.segment.contents[s.nextOffset:])
+	contents, blockLen, err := s.segment.nodeContentsAt(s.nextOffset)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	parsed, err := s.segment.collectionStratParseDataWithKey(contents)
 
 	// make sure to set the next offset before checking the error. The error
 	// could be 'Deleted' which would require that the offset is still advanced
 	// for the next cycle
-	s.nextOffset = s.nextOffset + uint64(parsed.offset)
+	s.nextOffset = s.segment.nextNodeOffset(s.nextOffset, blockLen, parsed.offset)
 	if err != nil {
 		return parsed.primaryKey, nil, err
 	}
@@ -98,13 +106,17 @@ func (s *segmentCursorMap) next() ([]byte, []MapPair, error) {
 
 func (s *segmentCursorMap) first() ([]byte, []MapPair, error) {
 	s.nextOffset = s.segment.dataStartPos
-	parsed, err := s.segment.collectionStratParseDataWithKey(
-		s.segment.contents[s.nextOffset:])
+	contents, blockLen, err := s.segment.nodeContentsAt(s.nextOffset)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	parsed, err := s.segment.collectionStratParseDataWithKey(contents)
 
 	// make sure to set the next offset before checking the error. The error
 	// could be 'Deleted' which would require that the offset is still advanced
 	// for the next cycle
-	s.nextOffset = s.nextOffset + uint64(parsed.offset)
+	s.nextOffset = s.segment.nextNodeOffset(s.nextOffset, blockLen, parsed.offset)
 	if err != nil {
 		return parsed.primaryKey, nil, err
 	}
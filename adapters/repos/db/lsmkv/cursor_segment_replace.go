@@ -49,8 +49,12 @@ func (s *segmentCursorReplace) seek(key []byte) ([]byte, []byte, error) {
 		return nil, nil, err
 	}
 
-	err = s.segment.replaceStratParseDataWithKeyInto(
-		s.segment.contents[node.Start:node.End], s.reusableNode)
+	contents, err := s.segment.nodeContents(node.Start, node.End)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = s.segment.replaceStratParseDataWithKeyInto(contents, s.reusableNode)
 
 	// make sure to set the next offset before checking the error. The error
 	// could be 'Deleted' which would require that the offset is still advanced
@@ -69,13 +73,18 @@ func (s *segmentCursorReplace) next() ([]byte, []byte, error) {
 		return nil, nil, NotFound
 	}
 
-	err := s.segment.replaceStratParseDataWithKeyInto(
-		s.segment.contents[s.nextOffset:], s.reusableNode)
+	contents, blockLen, err := s.segment.nodeContentsAt(s.nextOffset)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = s.segment.replaceStratParseDataWithKeyInto(contents, s.reusableNode)
 
 	// make sure to set the next offset before checking the error. The error
 	// could be 'Deleted' which would require that the offset is still advanced
 	// for the next cycle
-	s.nextOffset = s.nextOffset + uint64(s.reusableNode.offset)
+	s.nextOffset = s.segment.nextNodeOffset(s.nextOffset, blockLen,
+		s.reusableNode.offset)
 	if err != nil {
 		return s.reusableNode.primaryKey, nil, err
 	}
@@ -85,13 +94,18 @@ func (s *segmentCursorReplace) next() ([]byte, []byte, error) {
 
 func (s *segmentCursorReplace) first() ([]byte, []byte, error) {
 	s.nextOffset = s.segment.dataStartPos
-	err := s.segment.replaceStratParseDataWithKeyInto(
-		s.segment.contents[s.nextOffset:], s.reusableNode)
+	contents, blockLen, err := s.segment.nodeContentsAt(s.nextOffset)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = s.segment.replaceStratParseDataWithKeyInto(contents, s.reusableNode)
 
 	// make sure to set the next offset before checking the error. The error
 	// could be 'Deleted' which would require that the offset is still advanced
 	// for the next cycle
-	s.nextOffset = s.nextOffset + uint64(s.reusableNode.offset)
+	s.nextOffset = s.segment.nextNodeOffset(s.nextOffset, blockLen,
+		s.reusableNode.offset)
 	if err != nil {
 		return s.reusableNode.primaryKey, nil, err
 	}
@@ -105,13 +119,17 @@ func (s *segmentCursorReplace) nextWithAllKeys() (segmentReplaceNode, error) {
 		return out, NotFound
 	}
 
-	parsed, err := s.segment.replaceStratParseDataWithKey(
-		s.segment.contents[s.nextOffset:])
+	contents, blockLen, err := s.segment.nodeContentsAt(s.nextOffset)
+	if err != nil {
+		return segmentReplaceNode{}, err
+	}
+
+	parsed, err := s.segment.replaceStratParseDataWithKey(contents)
 
 	// make sure to set the next offset before checking the error. The error
 	// could be 'Deleted' which would require that the offset is still advanced
 	// for the next cycle
-	s.nextOffset = s.nextOffset + uint64(parsed.offset)
+	s.nextOffset = s.segment.nextNodeOffset(s.nextOffset, blockLen, parsed.offset)
 	if err != nil {
 		return parsed, err
 	}
@@ -121,13 +139,17 @@ func (s *segmentCursorReplace) nextWithAllKeys() (segmentReplaceNode, error) {
 
 func (s *segmentCursorReplace) firstWithAllKeys() (segmentReplaceNode, error) {
 	s.nextOffset = s.segment.dataStartPos
-	parsed, err := s.segment.replaceStratParseDataWithKey(
-		s.segment.contents[s.nextOffset:])
+	contents, blockLen, err := s.segment.nodeContentsAt(s.nextOffset)
+	if err != nil {
+		return segmentReplaceNode{}, err
+	}
+
+	parsed, err := s.segment.replaceStratParseDataWithKey(contents)
 
 	// make sure to set the next offset before checking the error. The error
 	// could be 'Deleted' which would require that the offset is still advanced
 	// for the next cycle
-	s.nextOffset = s.nextOffset + uint64(parsed.offset)
+	s.nextOffset = s.segment.nextNodeOffset(s.nextOffset, blockLen, parsed.offset)
 	if err != nil {
 		return parsed, err
 	}
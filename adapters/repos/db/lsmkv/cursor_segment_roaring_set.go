@@ -0,0 +1,100 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package lsmkv
+
+import (
+	"github.com/semi-technologies/weaviate/adapters/repos/db/lsmkv/roaringset"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/lsmkv/segmentindex"
+)
+
+type segmentCursorRoaringSet struct {
+	segment    *segment
+	nextOffset uint64
+	keyOnly    bool
+}
+
+func (s *segment) newRoaringSetCursor(keyOnly bool) *segmentCursorRoaringSet {
+	return &segmentCursorRoaringSet{
+		segment: s,
+		keyOnly: keyOnly,
+	}
+}
+
+func (sg *SegmentGroup) newRoaringSetCursors(keyOnly bool) ([]innerCursorRoaringSet, func()) {
+	sg.maintenanceLock.RLock()
+	out := make([]innerCursorRoaringSet, len(sg.segments))
+
+	for i, segment := range sg.segments {
+		out[i] = segment.newRoaringSetCursor(keyOnly)
+	}
+
+	return out, sg.maintenanceLock.RUnlock
+}
+
+func (s *segmentCursorRoaringSet) seek(key []byte) ([]byte, roaringset.BitmapLayer, error) {
+	node, err := s.segment.index.Seek(key)
+	if err != nil {
+		if err == segmentindex.NotFound {
+			return nil, roaringset.BitmapLayer{}, NotFound
+		}
+
+		return nil, roaringset.BitmapLayer{}, err
+	}
+
+	return s.parseAt(node.Start)
+}
+
+func (s *segmentCursorRoaringSet) next() ([]byte, roaringset.BitmapLayer, error) {
+	if s.nextOffset >= s.segment.dataEndPos {
+		return nil, roaringset.BitmapLayer{}, NotFound
+	}
+
+	return s.parseAt(s.nextOffset)
+}
+
+func (s *segmentCursorRoaringSet) first() ([]byte, roaringset.BitmapLayer, error) {
+	if s.segment.dataStartPos >= s.segment.dataEndPos {
+		return nil, roaringset.BitmapLayer{}, NotFound
+	}
+
+	return s.parseAt(s.segment.dataStartPos)
+}
+
+func (s *segmentCursorRoaringSet) parseAt(offset uint64) ([]byte, roaringset.BitmapLayer, error) {
+	contents, blockLen, err := s.segment.nodeContentsAt(offset)
+	if err != nil {
+		return nil, roaringset.BitmapLayer{}, err
+	}
+
+	sn, err := roaringset.NewSegmentNodeFromBuffer(contents)
+	if err != nil {
+		return nil, roaringset.BitmapLayer{}, err
+	}
+
+	s.nextOffset = s.segment.nextNodeOffset(offset, blockLen, int(sn.Len()))
+
+	// the key points to the segment contents, copy it so it stays valid after
+	// the cursor has been closed
+	key := make([]byte, len(sn.PrimaryKey()))
+	copy(key, sn.PrimaryKey())
+
+	if s.keyOnly {
+		return key, roaringset.BitmapLayer{}, nil
+	}
+
+	layer, err := sn.Layer()
+	if err != nil {
+		return nil, roaringset.BitmapLayer{}, err
+	}
+
+	return key, layer, nil
+}
@@ -17,6 +17,7 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/lsmkv/roaringset"
 )
 
 type Memtable struct {
@@ -24,18 +25,20 @@ type Memtable struct {
 	key                *binarySearchTree
 	keyMulti           *binarySearchTreeMulti
 	keyMap             *binarySearchTreeMap
+	roaringSet         *roaringset.BinarySearchTree
 	primaryIndex       *binarySearchTree
 	commitlog          *commitLogger
 	size               uint64
 	path               string
 	strategy           string
+	compression        string
 	secondaryIndices   uint16
 	secondaryToPrimary []map[string][]byte
 	lastWrite          time.Time
 	metrics            *memtableMetrics
 }
 
-func newMemtable(path string, strategy string,
+func newMemtable(path string, strategy, compression string,
 	secondaryIndices uint16, metrics *Metrics,
 ) (*Memtable, error) {
 	cl, err := newCommitLogger(path)
@@ -47,10 +50,12 @@ func newMemtable(path string, strategy string,
 		key:              &binarySearchTree{},
 		keyMulti:         &binarySearchTreeMulti{},
 		keyMap:           &binarySearchTreeMap{},
+		roaringSet:       &roaringset.BinarySearchTree{},
 		primaryIndex:     &binarySearchTree{}, // todo, sort upfront
 		commitlog:        cl,
 		path:             path,
 		strategy:         strategy,
+		compression:      compression,
 		secondaryIndices: secondaryIndices,
 		lastWrite:        time.Now(),
 		metrics:          newMemtableMetrics(metrics, filepath.Dir(path), strategy),
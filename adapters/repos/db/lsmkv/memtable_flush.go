@@ -13,10 +13,13 @@ package lsmkv
 
 import (
 	"bufio"
+	"bytes"
+	"encoding/binary"
 	"io"
 	"os"
 
 	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/lsmkv/roaringset"
 )
 
 func (l *Memtable) flush() error {
@@ -44,23 +47,53 @@ func (l *Memtable) flush() error {
 
 	w := bufio.NewWriterSize(f, int(float64(l.size)*1.3)) // calculate 30% overhead for disk representation
 
+	// The size of compressed data is not known upfront, so it is buffered
+	// until the start of the index - which is part of the header - is known
+	var compressedData *bytes.Buffer
+	var dataWriter io.Writer = w
+	if l.compression != CompressionNone {
+		compressedData = &bytes.Buffer{}
+		dataWriter = compressedData
+	}
+
+	bw, err := newSegmentBlockWriter(dataWriter, l.compression)
+	if err != nil {
+		return err
+	}
+
 	var keys []keyIndex
 	switch l.strategy {
 	case StrategyReplace:
-		if keys, err = l.flushDataReplace(w); err != nil {
+		if keys, err = l.flushDataReplace(bw); err != nil {
 			return err
 		}
 
 	case StrategySetCollection:
-		if keys, err = l.flushDataSet(w); err != nil {
+		if keys, err = l.flushDataSet(bw); err != nil {
 			return err
 		}
 
 	case StrategyMapCollection:
-		if keys, err = l.flushDataMap(w); err != nil {
+		if keys, err = l.flushDataMap(bw); err != nil {
+			return err
+		}
+
+	case StrategyRoaringSet:
+		if keys, err = l.flushDataRoaringSet(bw); err != nil {
+			return err
+		}
+
+	}
+
+	if bw.compressed() {
+		data := compressedData.Bytes()
+		binary.LittleEndian.PutUint64(data[segmentHeaderIndexStartPos:SegmentHeaderSize],
+			uint64(SegmentHeaderSize+bw.writtenBytes))
+		if _, err := w.Write(data); err != nil {
 			return err
 		}
 
+		l.metrics.compression(bw.rawBytes, bw.writtenBytes)
 	}
 
 	indices := &segmentIndices{
@@ -93,7 +126,11 @@ func (l *Memtable) flush() error {
 // for the pointer to the index part
 const SegmentHeaderSize = 16
 
-func (l *Memtable) flushDataReplace(f io.Writer) ([]keyIndex, error) {
+// segmentHeaderIndexStartPos is the position of the pointer to the index
+// part within the header
+const segmentHeaderIndexStartPos = 8
+
+func (l *Memtable) flushDataReplace(f *segmentBlockWriter) ([]keyIndex, error) {
 	flat := l.key.flattenInOrder()
 
 	totalDataLength := totalKeyAndValueSize(flat)
@@ -102,12 +139,12 @@ func (l *Memtable) flushDataReplace(f io.Writer) ([]keyIndex, error) {
 	header := segmentHeader{
 		indexStart:       uint64(totalDataLength + perObjectAdditions + headerSize),
 		level:            0, // always level zero on a new one
-		version:          0, // always version 0 for now
+		version:          f.version(),
 		secondaryIndices: l.secondaryIndices,
 		strategy:         SegmentStrategyFromString(l.strategy),
 	}
 
-	n, err := header.WriteTo(f)
+	n, err := header.WriteTo(f.w)
 	if err != nil {
 		return nil, err
 	}
@@ -125,7 +162,7 @@ func (l *Memtable) flushDataReplace(f io.Writer) ([]keyIndex, error) {
 			secondaryIndexCount: l.secondaryIndices,
 		}
 
-		ki, err := segNode.KeyIndexAndWriteTo(f)
+		ki, err := f.writeNode(totalWritten, segNode.KeyIndexAndWriteTo)
 		if err != nil {
 			return nil, errors.Wrapf(err, "write node %d", i)
 		}
@@ -137,12 +174,12 @@ func (l *Memtable) flushDataReplace(f io.Writer) ([]keyIndex, error) {
 	return keys, nil
 }
 
-func (l *Memtable) flushDataSet(f io.Writer) ([]keyIndex, error) {
+func (l *Memtable) flushDataSet(f *segmentBlockWriter) ([]keyIndex, error) {
 	flat := l.keyMulti.flattenInOrder()
 	return l.flushDataCollection(f, flat)
 }
 
-func (l *Memtable) flushDataMap(f io.Writer) ([]keyIndex, error) {
+func (l *Memtable) flushDataMap(f *segmentBlockWriter) ([]keyIndex, error) {
 	l.RLock()
 	flat := l.keyMap.flattenInOrder()
 	l.RUnlock()
@@ -172,19 +209,19 @@ func (l *Memtable) flushDataMap(f io.Writer) ([]keyIndex, error) {
 	return l.flushDataCollection(f, asMulti)
 }
 
-func (l *Memtable) flushDataCollection(f io.Writer,
+func (l *Memtable) flushDataCollection(f *segmentBlockWriter,
 	flat []*binarySearchNodeMulti,
 ) ([]keyIndex, error) {
 	totalDataLength := totalValueSizeCollection(flat)
 	header := segmentHeader{
 		indexStart:       uint64(totalDataLength + SegmentHeaderSize),
 		level:            0, // always level zero on a new one
-		version:          0, // always version 0 for now
+		version:          f.version(),
 		secondaryIndices: l.secondaryIndices,
 		strategy:         SegmentStrategyFromString(l.strategy),
 	}
 
-	n, err := header.WriteTo(f)
+	n, err := header.WriteTo(f.w)
 	if err != nil {
 		return nil, err
 	}
@@ -193,11 +230,59 @@ func (l *Memtable) flushDataCollection(f io.Writer,
 
 	totalWritten := headerSize
 	for i, node := range flat {
-		ki, err := (&segmentCollectionNode{
+		ki, err := f.writeNode(totalWritten, (&segmentCollectionNode{
 			values:     node.values,
 			primaryKey: node.key,
 			offset:     totalWritten,
-		}).KeyIndexAndWriteTo(f)
+		}).KeyIndexAndWriteTo)
+		if err != nil {
+			return nil, errors.Wrapf(err, "write node %d", i)
+		}
+
+		keys[i] = ki
+		totalWritten = ki.valueEnd
+	}
+
+	return keys, nil
+}
+
+func (l *Memtable) flushDataRoaringSet(f *segmentBlockWriter) ([]keyIndex, error) {
+	flat := l.roaringSet.FlattenInOrder()
+
+	// the serialized size of a bitmap is only known after serializing it, so
+	// all nodes need to be built before the header can be written
+	nodes := make([]*roaringset.SegmentNode, len(flat))
+	totalDataLength := 0
+	for i, node := range flat {
+		sn, err := roaringset.NewSegmentNode(node.Key, node.Value.Additions,
+			node.Value.Deletions)
+		if err != nil {
+			return nil, errors.Wrapf(err, "create node %d", i)
+		}
+
+		nodes[i] = sn
+		totalDataLength += int(sn.Len())
+	}
+
+	header := segmentHeader{
+		indexStart:       uint64(totalDataLength + SegmentHeaderSize),
+		level:            0, // always level zero on a new one
+		version:          f.version(),
+		secondaryIndices: l.secondaryIndices,
+		strategy:         SegmentStrategyFromString(l.strategy),
+	}
+
+	n, err := header.WriteTo(f.w)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]keyIndex, len(nodes))
+
+	totalWritten := int(n)
+	for i, sn := range nodes {
+		ki, err := f.writeNode(totalWritten, func(w io.Writer) (keyIndex, error) {
+			return roaringSetNodeKeyIndexAndWriteTo(w, sn, totalWritten)
+		})
 		if err != nil {
 			return nil, errors.Wrapf(err, "write node %d", i)
 		}
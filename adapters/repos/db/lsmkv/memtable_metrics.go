@@ -12,15 +12,19 @@
 package lsmkv
 
 type memtableMetrics struct {
-	put             NsObserver
-	setTombstone    NsObserver
-	append          NsObserver
-	appendMapSorted NsObserver
-	get             NsObserver
-	getBySecondary  NsObserver
-	getMap          NsObserver
-	getCollection   NsObserver
-	size            Setter
+	put              NsObserver
+	setTombstone     NsObserver
+	append           NsObserver
+	appendMapSorted  NsObserver
+	get              NsObserver
+	getBySecondary   NsObserver
+	getMap           NsObserver
+	getCollection    NsObserver
+	roaringSetAdd    NsObserver
+	roaringSetRemove NsObserver
+	roaringSetGet    NsObserver
+	size             Setter
+	compression      CompressionObserver
 }
 
 // newMemtableMetrics curries the prometheus-functions just once to make sure
@@ -28,14 +32,18 @@ type memtableMetrics struct {
 // lot of allocations.
 func newMemtableMetrics(metrics *Metrics, path, strategy string) *memtableMetrics {
 	return &memtableMetrics{
-		put:             metrics.MemtableOpObserver(path, strategy, "put"),
-		setTombstone:    metrics.MemtableOpObserver(path, strategy, "setTombstone"),
-		append:          metrics.MemtableOpObserver(path, strategy, "append"),
-		appendMapSorted: metrics.MemtableOpObserver(path, strategy, "appendMapSorted"),
-		get:             metrics.MemtableOpObserver(path, strategy, "get"),
-		getBySecondary:  metrics.MemtableOpObserver(path, strategy, "getBySecondary"),
-		getMap:          metrics.MemtableOpObserver(path, strategy, "getMap"),
-		getCollection:   metrics.MemtableOpObserver(path, strategy, "getCollection"),
-		size:            metrics.MemtableSizeSetter(path, strategy),
+		put:              metrics.MemtableOpObserver(path, strategy, "put"),
+		setTombstone:     metrics.MemtableOpObserver(path, strategy, "setTombstone"),
+		append:           metrics.MemtableOpObserver(path, strategy, "append"),
+		appendMapSorted:  metrics.MemtableOpObserver(path, strategy, "appendMapSorted"),
+		get:              metrics.MemtableOpObserver(path, strategy, "get"),
+		getBySecondary:   metrics.MemtableOpObserver(path, strategy, "getBySecondary"),
+		getMap:           metrics.MemtableOpObserver(path, strategy, "getMap"),
+		getCollection:    metrics.MemtableOpObserver(path, strategy, "getCollection"),
+		roaringSetAdd:    metrics.MemtableOpObserver(path, strategy, "roaringSetAdd"),
+		roaringSetRemove: metrics.MemtableOpObserver(path, strategy, "roaringSetRemove"),
+		roaringSetGet:    metrics.MemtableOpObserver(path, strategy, "roaringSetGet"),
+		size:             metrics.MemtableSizeSetter(path, strategy),
+		compression:      metrics.CompressionObserver(path, strategy, "flush"),
 	}
 }
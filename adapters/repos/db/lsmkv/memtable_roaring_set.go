@@ -0,0 +1,85 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package lsmkv
+
+import (
+	"time"
+
+	"github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/lsmkv/roaringset"
+)
+
+func (l *Memtable) roaringSetAddList(key []byte, values []uint64) error {
+	start := time.Now()
+	defer l.metrics.roaringSetAdd(start.UnixNano())
+
+	return l.roaringSetInsert(key, roaringset.Insert{Additions: values})
+}
+
+func (l *Memtable) roaringSetRemoveList(key []byte, values []uint64) error {
+	start := time.Now()
+	defer l.metrics.roaringSetRemove(start.UnixNano())
+
+	return l.roaringSetInsert(key, roaringset.Insert{Deletions: values})
+}
+
+func (l *Memtable) roaringSetInsert(key []byte, values roaringset.Insert) error {
+	if l.strategy != StrategyRoaringSet {
+		return errors.Errorf("roaring set operations only possible with strategy %q",
+			StrategyRoaringSet)
+	}
+
+	l.Lock()
+	defer l.Unlock()
+
+	node, err := roaringset.NewSegmentNode(key,
+		roaring64.BitmapOf(values.Additions...),
+		roaring64.BitmapOf(values.Deletions...))
+	if err != nil {
+		return errors.Wrap(err, "serialize commit log entry")
+	}
+
+	if err := l.commitlog.add(node); err != nil {
+		return errors.Wrap(err, "write into commit log")
+	}
+
+	l.roaringSet.Insert(key, values)
+	l.size += uint64(len(key) + 8*(len(values.Additions)+len(values.Deletions)))
+	l.metrics.size(l.size)
+	l.lastWrite = time.Now()
+
+	return nil
+}
+
+func (l *Memtable) roaringSetGet(key []byte) (roaringset.BitmapLayer, error) {
+	start := time.Now()
+	defer l.metrics.roaringSetGet(start.UnixNano())
+
+	if l.strategy != StrategyRoaringSet {
+		return roaringset.BitmapLayer{}, errors.Errorf(
+			"roaring set operations only possible with strategy %q", StrategyRoaringSet)
+	}
+
+	l.RLock()
+	defer l.RUnlock()
+
+	layer, err := l.roaringSet.Get(key)
+	if err != nil {
+		if err == roaringset.NotFound {
+			return layer, NotFound
+		}
+		return layer, err
+	}
+
+	return layer, nil
+}
@@ -23,7 +23,7 @@ import (
 // https://www.youtube.com/watch?v=OS8taasZl8k
 func Test_MemtableSecondaryKeyBug(t *testing.T) {
 	dir := t.TempDir()
-	m, err := newMemtable(path.Join(dir, "will-never-flush"), StrategyReplace, 1, nil)
+	m, err := newMemtable(path.Join(dir, "will-never-flush"), StrategyReplace, CompressionNone, 1, nil)
 	require.Nil(t, err)
 
 	t.Run("add initial value", func(t *testing.T) {
@@ -19,26 +19,30 @@ import (
 )
 
 type (
-	NsObserver   func(ns int64)
-	Setter       func(val uint64)
-	TimeObserver func(start time.Time)
+	NsObserver          func(ns int64)
+	Setter              func(val uint64)
+	TimeObserver        func(start time.Time)
+	CompressionObserver func(raw, compressed int)
 )
 
 type Metrics struct {
-	CompactionReplace *prometheus.GaugeVec
-	CompactionSet     *prometheus.GaugeVec
-	CompactionMap     *prometheus.GaugeVec
-	ActiveSegments    *prometheus.GaugeVec
-	bloomFilters      prometheus.ObserverVec
-	SegmentObjects    *prometheus.GaugeVec
-	SegmentSize       *prometheus.GaugeVec
-	SegmentCount      *prometheus.GaugeVec
-	startupDurations  prometheus.ObserverVec
-	startupDiskIO     prometheus.ObserverVec
-	objectCount       prometheus.Gauge
-	memtableDurations prometheus.ObserverVec
-	memtableSize      *prometheus.GaugeVec
-	DimensionSum      *prometheus.GaugeVec
+	CompactionReplace    *prometheus.GaugeVec
+	CompactionSet        *prometheus.GaugeVec
+	CompactionMap        *prometheus.GaugeVec
+	CompactionRoaringSet *prometheus.GaugeVec
+	ActiveSegments       *prometheus.GaugeVec
+	bloomFilters         prometheus.ObserverVec
+	SegmentObjects       *prometheus.GaugeVec
+	SegmentSize          *prometheus.GaugeVec
+	SegmentCount         *prometheus.GaugeVec
+	startupDurations     prometheus.ObserverVec
+	startupDiskIO        prometheus.ObserverVec
+	objectCount          prometheus.Gauge
+	memtableDurations    prometheus.ObserverVec
+	memtableSize         *prometheus.GaugeVec
+	compressionBytes     *prometheus.CounterVec
+	compressionRatio     *prometheus.GaugeVec
+	DimensionSum         *prometheus.GaugeVec
 }
 
 func NewMetrics(promMetrics *monitoring.PrometheusMetrics, className,
@@ -62,10 +66,17 @@ func NewMetrics(promMetrics *monitoring.PrometheusMetrics, className,
 		"shard_name": shardName,
 	})
 
+	roaringSet := promMetrics.AsyncOperations.MustCurryWith(prometheus.Labels{
+		"operation":  "compact_lsm_segments_stratroaringset",
+		"class_name": className,
+		"shard_name": shardName,
+	})
+
 	return &Metrics{
-		CompactionReplace: replace,
-		CompactionSet:     set,
-		CompactionMap:     stratMap,
+		CompactionReplace:    replace,
+		CompactionRoaringSet: roaringSet,
+		CompactionSet:        set,
+		CompactionMap:        stratMap,
 		ActiveSegments: promMetrics.LSMSegmentCount.MustCurryWith(prometheus.Labels{
 			"class_name": className,
 			"shard_name": shardName,
@@ -106,6 +117,14 @@ func NewMetrics(promMetrics *monitoring.PrometheusMetrics, className,
 			"class_name": className,
 			"shard_name": shardName,
 		}),
+		compressionBytes: promMetrics.LSMCompressionBytes.MustCurryWith(prometheus.Labels{
+			"class_name": className,
+			"shard_name": shardName,
+		}),
+		compressionRatio: promMetrics.LSMCompressionRatio.MustCurryWith(prometheus.Labels{
+			"class_name": className,
+			"shard_name": shardName,
+		}),
 		DimensionSum: promMetrics.VectorDimensionsSum.MustCurryWith(prometheus.Labels{
 			"class_name": className,
 			"shard_name": shardName,
@@ -125,6 +144,10 @@ func noOpSetter(val uint64) {
 	// do nothing
 }
 
+func noOpCompressionObserver(raw, compressed int) {
+	// do nothing
+}
+
 func (m *Metrics) MemtableOpObserver(path, strategy, op string) NsObserver {
 	if m == nil {
 		return noOpNsObserver
@@ -157,6 +180,39 @@ func (m *Metrics) MemtableSizeSetter(path, strategy string) Setter {
 	}
 }
 
+// CompressionObserver tracks the data size of compressed segments before and
+// after compression. operation is either "flush" or "compaction"
+func (m *Metrics) CompressionObserver(path, strategy, operation string) CompressionObserver {
+	if m == nil {
+		return noOpCompressionObserver
+	}
+
+	raw := m.compressionBytes.With(prometheus.Labels{
+		"path":      path,
+		"strategy":  strategy,
+		"operation": operation,
+		"unit":      "raw",
+	})
+	compressed := m.compressionBytes.With(prometheus.Labels{
+		"path":      path,
+		"strategy":  strategy,
+		"operation": operation,
+		"unit":      "compressed",
+	})
+	ratio := m.compressionRatio.With(prometheus.Labels{
+		"path":     path,
+		"strategy": strategy,
+	})
+
+	return func(rawBytes, compressedBytes int) {
+		raw.Add(float64(rawBytes))
+		compressed.Add(float64(compressedBytes))
+		if compressedBytes > 0 {
+			ratio.Set(float64(rawBytes) / float64(compressedBytes))
+		}
+	}
+}
+
 func (m *Metrics) BloomFilterObserver(strategy, operation string) TimeObserver {
 	if m == nil {
 		return noOpTimeObserver
@@ -0,0 +1,247 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package roaringset
+
+import (
+	"bytes"
+
+	"github.com/semi-technologies/weaviate/adapters/repos/db/lsmkv/rbtree"
+)
+
+// BinarySearchTree is the memtable representation of a roaringset bucket. It
+// is a red-black tree which holds exactly one BitmapLayer per key.
+type BinarySearchTree struct {
+	root *BinarySearchNode
+}
+
+// Insert describes a single write on a key. Elements that appear in
+// Additions are removed from the deletions of the key and vice versa.
+type Insert struct {
+	Additions []uint64
+	Deletions []uint64
+}
+
+func (t *BinarySearchTree) Insert(key []byte, values Insert) {
+	if t.root == nil {
+		t.root = &BinarySearchNode{
+			Key:         key,
+			Value:       NewBitmapLayer(),
+			colourIsRed: false, // root node is always black
+		}
+		t.root.Value.apply(values)
+		return
+	}
+
+	if newRoot := t.root.insert(key, values); newRoot != nil {
+		t.root = newRoot
+	}
+	t.root.colourIsRed = false // Can be flipped in the process of balancing, but root is always black
+}
+
+// Get returns a copy of the layer stored at the given key, or NotFound
+func (t *BinarySearchTree) Get(key []byte) (BitmapLayer, error) {
+	if t.root == nil {
+		return BitmapLayer{}, NotFound
+	}
+
+	return t.root.get(key)
+}
+
+// FlattenInOrder returns all nodes sorted by key. The nodes are not copied,
+// the caller needs to hold the lock protecting the tree while accessing them.
+func (t *BinarySearchTree) FlattenInOrder() []*BinarySearchNode {
+	if t.root == nil {
+		return nil
+	}
+
+	return t.root.flattenInOrder()
+}
+
+type BinarySearchNode struct {
+	Key         []byte
+	Value       BitmapLayer
+	left        *BinarySearchNode
+	right       *BinarySearchNode
+	parent      *BinarySearchNode
+	colourIsRed bool
+}
+
+func (l *BitmapLayer) apply(values Insert) {
+	for _, v := range values.Additions {
+		l.Deletions.Remove(v)
+		l.Additions.Add(v)
+	}
+
+	for _, v := range values.Deletions {
+		l.Additions.Remove(v)
+		l.Deletions.Add(v)
+	}
+}
+
+func (n *BinarySearchNode) Parent() rbtree.Node {
+	if n == nil {
+		return nil
+	}
+	return n.parent
+}
+
+func (n *BinarySearchNode) SetParent(parent rbtree.Node) {
+	if n == nil {
+		addNewSearchNodeReceiver(&n)
+	}
+
+	if parent == nil {
+		n.parent = nil
+		return
+	}
+
+	n.parent = parent.(*BinarySearchNode)
+}
+
+func (n *BinarySearchNode) Left() rbtree.Node {
+	if n == nil {
+		return nil
+	}
+	return n.left
+}
+
+func (n *BinarySearchNode) SetLeft(left rbtree.Node) {
+	if n == nil {
+		addNewSearchNodeReceiver(&n)
+	}
+
+	if left == nil {
+		n.left = nil
+		return
+	}
+
+	n.left = left.(*BinarySearchNode)
+}
+
+func (n *BinarySearchNode) Right() rbtree.Node {
+	if n == nil {
+		return nil
+	}
+	return n.right
+}
+
+func (n *BinarySearchNode) SetRight(right rbtree.Node) {
+	if n == nil {
+		addNewSearchNodeReceiver(&n)
+	}
+
+	if right == nil {
+		n.right = nil
+		return
+	}
+
+	n.right = right.(*BinarySearchNode)
+}
+
+func (n *BinarySearchNode) IsRed() bool {
+	if n == nil {
+		return false
+	}
+	return n.colourIsRed
+}
+
+func (n *BinarySearchNode) SetRed(isRed bool) {
+	n.colourIsRed = isRed
+}
+
+func (n *BinarySearchNode) IsNil() bool {
+	return n == nil
+}
+
+func addNewSearchNodeReceiver(nodePtr **BinarySearchNode) {
+	*nodePtr = &BinarySearchNode{}
+}
+
+func (n *BinarySearchNode) insert(key []byte, values Insert) *BinarySearchNode {
+	if bytes.Equal(key, n.Key) {
+		n.Value.apply(values)
+		return nil
+	}
+
+	if bytes.Compare(key, n.Key) < 0 {
+		if n.left != nil {
+			return n.left.insert(key, values)
+		} else {
+			n.left = &BinarySearchNode{
+				Key:         key,
+				Value:       NewBitmapLayer(),
+				parent:      n,
+				colourIsRed: true,
+			}
+			n.left.Value.apply(values)
+			return binarySearchNodeFromRB(rbtree.Rebalance(n.left))
+		}
+	} else {
+		if n.right != nil {
+			return n.right.insert(key, values)
+		} else {
+			n.right = &BinarySearchNode{
+				Key:         key,
+				Value:       NewBitmapLayer(),
+				parent:      n,
+				colourIsRed: true,
+			}
+			n.right.Value.apply(values)
+			return binarySearchNodeFromRB(rbtree.Rebalance(n.right))
+		}
+	}
+}
+
+func (n *BinarySearchNode) get(key []byte) (BitmapLayer, error) {
+	if bytes.Equal(n.Key, key) {
+		return n.Value.Clone(), nil
+	}
+
+	if bytes.Compare(key, n.Key) < 0 {
+		if n.left == nil {
+			return BitmapLayer{}, NotFound
+		}
+
+		return n.left.get(key)
+	} else {
+		if n.right == nil {
+			return BitmapLayer{}, NotFound
+		}
+
+		return n.right.get(key)
+	}
+}
+
+func binarySearchNodeFromRB(rbNode rbtree.Node) (bsNode *BinarySearchNode) {
+	if rbNode == nil {
+		bsNode = nil
+		return
+	}
+	bsNode = rbNode.(*BinarySearchNode)
+	return
+}
+
+func (n *BinarySearchNode) flattenInOrder() []*BinarySearchNode {
+	var left []*BinarySearchNode
+	var right []*BinarySearchNode
+
+	if n.left != nil {
+		left = n.left.flattenInOrder()
+	}
+
+	if n.right != nil {
+		right = n.right.flattenInOrder()
+	}
+
+	right = append([]*BinarySearchNode{n}, right...)
+	return append(left, right...)
+}
@@ -0,0 +1,108 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// Package roaringset contains the building blocks of the "roaringset" LSM
+// strategy. Instead of storing every element of a set individually, each key
+// holds a roaring bitmap of additions and a roaring bitmap of deletions. Such
+// a pair is called a layer. The memtable and every disk segment hold exactly
+// one layer per key, and a read flattens the layers from oldest to newest.
+package roaringset
+
+import (
+	"github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/pkg/errors"
+)
+
+// NotFound is returned when a key is not present in a memtable or segment
+var NotFound = errors.Errorf("not found")
+
+// BitmapLayer is the state of a single key in a single memtable or segment.
+// Additions and Deletions are always disjoint.
+type BitmapLayer struct {
+	Additions *roaring64.Bitmap
+	Deletions *roaring64.Bitmap
+}
+
+// NewBitmapLayer creates a layer with empty additions and deletions
+func NewBitmapLayer() BitmapLayer {
+	return BitmapLayer{
+		Additions: roaring64.New(),
+		Deletions: roaring64.New(),
+	}
+}
+
+// Clone creates a deep copy of the layer, so it can safely be used outside of
+// the lock that protects the original
+func (l BitmapLayer) Clone() BitmapLayer {
+	return BitmapLayer{
+		Additions: cloneOrEmpty(l.Additions),
+		Deletions: cloneOrEmpty(l.Deletions),
+	}
+}
+
+// BitmapLayers are ordered from oldest to newest, i.e. the last layer takes
+// precedence over all previous layers
+type BitmapLayers []BitmapLayer
+
+// Flatten reduces all layers into a single bitmap containing only the
+// elements that are present after applying every layer in order. Deletions of
+// the lowest layer have nothing to act on and are discarded.
+func (bml BitmapLayers) Flatten() *roaring64.Bitmap {
+	if len(bml) == 0 {
+		return roaring64.New()
+	}
+
+	merged := cloneOrEmpty(bml[0].Additions)
+	for _, layer := range bml[1:] {
+		if layer.Deletions != nil {
+			merged.AndNot(layer.Deletions)
+		}
+		if layer.Additions != nil {
+			merged.Or(layer.Additions)
+		}
+	}
+
+	return merged
+}
+
+// Merge reduces exactly two layers into a single layer. Unlike Flatten, the
+// deletions are retained, as there could be additional layers underneath the
+// two that are merged. This is the operation a compaction performs on every
+// key that is present in both segments.
+func (bml BitmapLayers) Merge() (BitmapLayer, error) {
+	if len(bml) != 2 {
+		return BitmapLayer{}, errors.Errorf("merge requires exactly 2 layers, got %d",
+			len(bml))
+	}
+
+	left, right := bml[0].Clone(), bml[1].Clone()
+
+	additions := left.Additions
+	additions.AndNot(right.Deletions)
+	additions.Or(right.Additions)
+
+	deletions := left.Deletions
+	deletions.AndNot(right.Additions)
+	deletions.Or(right.Deletions)
+
+	return BitmapLayer{
+		Additions: additions,
+		Deletions: deletions,
+	}, nil
+}
+
+func cloneOrEmpty(bm *roaring64.Bitmap) *roaring64.Bitmap {
+	if bm == nil {
+		return roaring64.New()
+	}
+
+	return bm.Clone()
+}
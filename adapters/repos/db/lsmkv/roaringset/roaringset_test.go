@@ -0,0 +1,162 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package roaringset
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBitmapLayers_Flatten(t *testing.T) {
+	type test struct {
+		name     string
+		layers   BitmapLayers
+		expected []uint64
+	}
+
+	tests := []test{
+		{
+			name:     "no layers",
+			layers:   BitmapLayers{},
+			expected: []uint64{},
+		},
+		{
+			name: "single layer, deletions are ignored",
+			layers: BitmapLayers{
+				{Additions: roaring64.BitmapOf(1, 2), Deletions: roaring64.BitmapOf(3)},
+			},
+			expected: []uint64{1, 2},
+		},
+		{
+			name: "later layer removes and re-adds",
+			layers: BitmapLayers{
+				{Additions: roaring64.BitmapOf(1, 2, 3)},
+				{Additions: roaring64.BitmapOf(4), Deletions: roaring64.BitmapOf(2, 3)},
+				{Additions: roaring64.BitmapOf(3)},
+			},
+			expected: []uint64{1, 3, 4},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.layers.Flatten().ToArray())
+		})
+	}
+}
+
+func TestBitmapLayers_Merge(t *testing.T) {
+	t.Run("retains deletions for lower layers", func(t *testing.T) {
+		layers := BitmapLayers{
+			{Additions: roaring64.BitmapOf(1, 2), Deletions: roaring64.BitmapOf(3, 4)},
+			{Additions: roaring64.BitmapOf(4), Deletions: roaring64.BitmapOf(2, 5)},
+		}
+
+		merged, err := layers.Merge()
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{1, 4}, merged.Additions.ToArray())
+		assert.Equal(t, []uint64{2, 3, 5}, merged.Deletions.ToArray())
+	})
+
+	t.Run("does not modify the input", func(t *testing.T) {
+		layers := BitmapLayers{
+			{Additions: roaring64.BitmapOf(1), Deletions: roaring64.New()},
+			{Additions: roaring64.BitmapOf(2), Deletions: roaring64.BitmapOf(1)},
+		}
+
+		_, err := layers.Merge()
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{1}, layers[0].Additions.ToArray())
+	})
+
+	t.Run("requires exactly two layers", func(t *testing.T) {
+		_, err := BitmapLayers{NewBitmapLayer()}.Merge()
+		assert.NotNil(t, err)
+	})
+}
+
+func TestBinarySearchTree(t *testing.T) {
+	tree := &BinarySearchTree{}
+	tree.Insert([]byte("b"), Insert{Additions: []uint64{1, 2}})
+	tree.Insert([]byte("a"), Insert{Additions: []uint64{3}})
+	tree.Insert([]byte("c"), Insert{Deletions: []uint64{4}})
+	tree.Insert([]byte("b"), Insert{Additions: []uint64{5}, Deletions: []uint64{1}})
+
+	t.Run("get", func(t *testing.T) {
+		layer, err := tree.Get([]byte("b"))
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{2, 5}, layer.Additions.ToArray())
+		assert.Equal(t, []uint64{1}, layer.Deletions.ToArray())
+
+		_, err = tree.Get([]byte("d"))
+		assert.Equal(t, NotFound, err)
+	})
+
+	t.Run("flatten in order", func(t *testing.T) {
+		nodes := tree.FlattenInOrder()
+		require.Len(t, nodes, 3)
+		for i := 1; i < len(nodes); i++ {
+			assert.True(t, bytes.Compare(nodes[i-1].Key, nodes[i].Key) < 0)
+		}
+		assert.Equal(t, []uint64{4}, nodes[2].Value.Deletions.ToArray())
+	})
+}
+
+func TestSegmentNode(t *testing.T) {
+	additions := roaring64.BitmapOf(1, 2, 1<<40)
+	deletions := roaring64.BitmapOf(7)
+	key := []byte("my-key")
+
+	sn, err := NewSegmentNode(key, additions, deletions)
+	require.Nil(t, err)
+
+	check := func(t *testing.T, sn *SegmentNode) {
+		assert.Equal(t, key, sn.PrimaryKey())
+
+		layer, err := sn.Layer()
+		require.Nil(t, err)
+		assert.Equal(t, additions.ToArray(), layer.Additions.ToArray())
+		assert.Equal(t, deletions.ToArray(), layer.Deletions.ToArray())
+	}
+
+	t.Run("serialized node", func(t *testing.T) {
+		check(t, sn)
+	})
+
+	t.Run("from a longer buffer", func(t *testing.T) {
+		buf := append(append([]byte{}, sn.ToBuffer()...), 0xff, 0xff)
+		parsed, err := NewSegmentNodeFromBuffer(buf)
+		require.Nil(t, err)
+		assert.Equal(t, sn.Len(), parsed.Len())
+		check(t, parsed)
+	})
+
+	t.Run("from a reader", func(t *testing.T) {
+		parsed, err := ParseSegmentNode(bytes.NewReader(sn.ToBuffer()))
+		require.Nil(t, err)
+		check(t, parsed)
+	})
+
+	t.Run("nil bitmaps", func(t *testing.T) {
+		sn, err := NewSegmentNode(key, nil, nil)
+		require.Nil(t, err)
+
+		layer, err := sn.Layer()
+		require.Nil(t, err)
+		assert.True(t, layer.Additions.IsEmpty())
+		assert.True(t, layer.Deletions.IsEmpty())
+	})
+}
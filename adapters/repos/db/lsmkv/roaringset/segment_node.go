@@ -0,0 +1,185 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package roaringset
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/pkg/errors"
+)
+
+// SegmentNode is the on-disk representation of a single key of a roaringset
+// segment. The same representation is used in the write-ahead-log. The layout
+// is as follows:
+//
+//	8 bytes   total length of the node, including this field
+//	8 bytes   length of the serialized additions bitmap
+//	n bytes   serialized additions bitmap
+//	8 bytes   length of the serialized deletions bitmap
+//	n bytes   serialized deletions bitmap
+//	4 bytes   length of the key
+//	n bytes   key
+type SegmentNode struct {
+	data []byte
+}
+
+// NewSegmentNode serializes the key and both bitmaps into a new node. A nil
+// bitmap is treated as an empty bitmap.
+func NewSegmentNode(key []byte, additions,
+	deletions *roaring64.Bitmap,
+) (*SegmentNode, error) {
+	addBytes, err := cloneOrEmpty(additions).ToBytes()
+	if err != nil {
+		return nil, errors.Wrap(err, "serialize additions")
+	}
+
+	delBytes, err := cloneOrEmpty(deletions).ToBytes()
+	if err != nil {
+		return nil, errors.Wrap(err, "serialize deletions")
+	}
+
+	total := 8 + 8 + len(addBytes) + 8 + len(delBytes) + 4 + len(key)
+	data := make([]byte, total)
+
+	offset := 0
+	binary.LittleEndian.PutUint64(data[offset:offset+8], uint64(total))
+	offset += 8
+
+	binary.LittleEndian.PutUint64(data[offset:offset+8], uint64(len(addBytes)))
+	offset += 8
+	offset += copy(data[offset:], addBytes)
+
+	binary.LittleEndian.PutUint64(data[offset:offset+8], uint64(len(delBytes)))
+	offset += 8
+	offset += copy(data[offset:], delBytes)
+
+	binary.LittleEndian.PutUint32(data[offset:offset+4], uint32(len(key)))
+	offset += 4
+	copy(data[offset:], key)
+
+	return &SegmentNode{data: data}, nil
+}
+
+// NewSegmentNodeFromBuffer wraps an existing buffer without copying it. The
+// buffer may be longer than the node, in which case it is truncated to the
+// length encoded in the node.
+func NewSegmentNodeFromBuffer(buf []byte) (*SegmentNode, error) {
+	if len(buf) < 8 {
+		return nil, errors.Errorf("buffer of length %d too short for a node", len(buf))
+	}
+
+	total := binary.LittleEndian.Uint64(buf[0:8])
+	if uint64(len(buf)) < total {
+		return nil, errors.Errorf("node of length %d exceeds buffer of length %d",
+			total, len(buf))
+	}
+
+	return &SegmentNode{data: buf[:total]}, nil
+}
+
+// ParseSegmentNode reads a single node from the reader, e.g. when parsing a
+// write-ahead-log
+func ParseSegmentNode(r io.Reader) (*SegmentNode, error) {
+	lenBuf := make([]byte, 8)
+	if _, err := io.ReadFull(r, lenBuf); err != nil {
+		return nil, err
+	}
+
+	total := binary.LittleEndian.Uint64(lenBuf)
+	if total < 8 {
+		return nil, errors.Errorf("invalid node length %d", total)
+	}
+
+	data := make([]byte, total)
+	copy(data, lenBuf)
+	if _, err := io.ReadFull(r, data[8:]); err != nil {
+		return nil, err
+	}
+
+	return &SegmentNode{data: data}, nil
+}
+
+// Len is the length of the entire node in bytes
+func (sn *SegmentNode) Len() uint64 {
+	return uint64(len(sn.data))
+}
+
+// ToBuffer returns the underlying buffer without copying it
+func (sn *SegmentNode) ToBuffer() []byte {
+	return sn.data
+}
+
+// Additions deserializes the additions bitmap. The returned bitmap does not
+// share any memory with the node.
+func (sn *SegmentNode) Additions() (*roaring64.Bitmap, error) {
+	start, end := sn.additionsRange()
+	return unmarshalBitmap(sn.data[start:end])
+}
+
+// Deletions deserializes the deletions bitmap. The returned bitmap does not
+// share any memory with the node.
+func (sn *SegmentNode) Deletions() (*roaring64.Bitmap, error) {
+	start, end := sn.deletionsRange()
+	return unmarshalBitmap(sn.data[start:end])
+}
+
+// Layer deserializes both bitmaps
+func (sn *SegmentNode) Layer() (BitmapLayer, error) {
+	additions, err := sn.Additions()
+	if err != nil {
+		return BitmapLayer{}, errors.Wrap(err, "parse additions")
+	}
+
+	deletions, err := sn.Deletions()
+	if err != nil {
+		return BitmapLayer{}, errors.Wrap(err, "parse deletions")
+	}
+
+	return BitmapLayer{Additions: additions, Deletions: deletions}, nil
+}
+
+// PrimaryKey returns the key without copying it
+func (sn *SegmentNode) PrimaryKey() []byte {
+	_, offset := sn.deletionsRange()
+	keyLen := binary.LittleEndian.Uint32(sn.data[offset : offset+4])
+	offset += 4
+	return sn.data[offset : offset+uint64(keyLen)]
+}
+
+func (sn *SegmentNode) additionsRange() (uint64, uint64) {
+	addLen := binary.LittleEndian.Uint64(sn.data[8:16])
+	return 16, 16 + addLen
+}
+
+func (sn *SegmentNode) deletionsRange() (uint64, uint64) {
+	_, offset := sn.additionsRange()
+	delLen := binary.LittleEndian.Uint64(sn.data[offset : offset+8])
+	offset += 8
+	return offset, offset + delLen
+}
+
+func unmarshalBitmap(in []byte) (*roaring64.Bitmap, error) {
+	bm := roaring64.New()
+	if len(in) == 0 {
+		return bm, nil
+	}
+
+	// UnmarshalBinary copies the data, so the bitmap stays valid even if the
+	// underlying (possibly mmapped) buffer goes away
+	if err := bm.UnmarshalBinary(in); err != nil {
+		return nil, err
+	}
+
+	return bm, nil
+}
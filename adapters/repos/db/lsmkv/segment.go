@@ -33,6 +33,7 @@ type segment struct {
 	level                 uint16
 	secondaryIndexCount   uint16
 	version               uint16
+	compressed            bool
 	segmentStartPos       uint64
 	segmentEndPos         uint64
 	dataStartPos          uint64
@@ -92,7 +93,7 @@ func newSegment(path string, logger logrus.FieldLogger, metrics *Metrics,
 
 	switch header.strategy {
 	case SegmentStrategyReplace, SegmentStrategySetCollection,
-		SegmentStrategyMapCollection:
+		SegmentStrategyMapCollection, SegmentStrategyRoaringSet:
 	default:
 		return nil, errors.Errorf("unsupported strategy in segment")
 	}
@@ -109,6 +110,7 @@ func newSegment(path string, logger logrus.FieldLogger, metrics *Metrics,
 		path:                path,
 		contents:            content,
 		version:             header.version,
+		compressed:          header.version == segmentVersionBlockCompressed,
 		secondaryIndexCount: header.secondaryIndices,
 		segmentStartPos:     header.indexStart,
 		segmentEndPos:       uint64(len(content)),
@@ -182,10 +184,22 @@ func (ind *segment) initCountNetAdditions(exists existsOnLowerSegmentsFn) error
 		}
 	}
 
-	extr := newBufferedKeyAndTombstoneExtractor(ind.contents, ind.dataStartPos,
-		ind.dataEndPos, 10e6, ind.secondaryIndexCount, cb)
+	if ind.compressed {
+		// the extractor reads the raw contents, so the nodes of compressed
+		// segments need to be decompressed by a cursor instead
+		c := ind.newCursor()
+		for node, err := c.firstWithAllKeys(); err != NotFound; node, err = c.nextWithAllKeys() {
+			if err != nil && err != Deleted {
+				return errors.Wrap(err, "read node")
+			}
+			cb(node.primaryKey, err == Deleted)
+		}
+	} else {
+		extr := newBufferedKeyAndTombstoneExtractor(ind.contents, ind.dataStartPos,
+			ind.dataEndPos, 10e6, ind.secondaryIndexCount, cb)
 
-	extr.do()
+		extr.do()
+	}
 
 	ind.countNetAdditions = netCount
 
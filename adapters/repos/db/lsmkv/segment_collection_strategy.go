@@ -52,8 +52,13 @@ func (i *segment) getCollection(key []byte) ([]value, error) {
 	// active/flushing memtable), not against removing the disk segment. If a
 	// compaction completes and the old segment is removed, we would be accessing
 	// invalid memory without the copy, thus leading to a SEGFAULT.
-	contentsCopy := make([]byte, node.End-node.Start)
-	copy(contentsCopy, i.contents[node.Start:node.End])
+	contents, err := i.nodeContents(node.Start, node.End)
+	if err != nil {
+		return nil, err
+	}
+
+	contentsCopy := make([]byte, len(contents))
+	copy(contentsCopy, contents)
 
 	return i.collectionStratParseData(contentsCopy)
 }
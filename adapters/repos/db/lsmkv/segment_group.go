@@ -37,6 +37,10 @@ type SegmentGroup struct {
 
 	strategy string
 
+	// compression applies to segments written by compactions, existing
+	// segments keep the compression they were written with
+	compression string
+
 	compactionCycle *cyclemanager.CycleManager
 
 	logger logrus.FieldLogger
@@ -57,7 +61,7 @@ type SegmentGroup struct {
 
 func newSegmentGroup(dir string,
 	compactionInterval time.Duration, logger logrus.FieldLogger,
-	mapRequiresSorting bool, metrics *Metrics, strategy string,
+	mapRequiresSorting bool, metrics *Metrics, strategy, compression string,
 	monitorCount bool,
 ) (*SegmentGroup, error) {
 	list, err := os.ReadDir(dir)
@@ -73,6 +77,7 @@ func newSegmentGroup(dir string,
 		monitorCount:       monitorCount,
 		mapRequiresSorting: mapRequiresSorting,
 		strategy:           strategy,
+		compression:        compression,
 	}
 
 	segmentIndex := 0
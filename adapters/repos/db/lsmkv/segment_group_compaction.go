@@ -130,13 +130,17 @@ func (sg *SegmentGroup) compactOnce() error {
 
 	strategy := sg.segmentAtPos(pair[0]).strategy
 
+	// the node writer of the compactor, to report the compression ratio
+	var nodes *segmentBlockWriter
+
 	switch strategy {
 
 	// TODO: call metrics just once with variable strategy label
 
 	case SegmentStrategyReplace:
 		c := newCompactorReplace(f, sg.segmentAtPos(pair[0]).newCursor(),
-			sg.segmentAtPos(pair[1]).newCursor(), level, secondaryIndices, scratchSpacePath)
+			sg.segmentAtPos(pair[1]).newCursor(), level, secondaryIndices, scratchSpacePath,
+			sg.compression)
 
 		if sg.metrics != nil {
 			sg.metrics.CompactionReplace.With(prometheus.Labels{"path": sg.dir}).Set(1)
@@ -146,10 +150,11 @@ func (sg *SegmentGroup) compactOnce() error {
 		if err := c.do(); err != nil {
 			return err
 		}
+		nodes = c.nodes
 	case SegmentStrategySetCollection:
 		c := newCompactorSetCollection(f, sg.segmentAtPos(pair[0]).newCollectionCursor(),
 			sg.segmentAtPos(pair[1]).newCollectionCursor(), level, secondaryIndices,
-			scratchSpacePath)
+			scratchSpacePath, sg.compression)
 
 		if sg.metrics != nil {
 			sg.metrics.CompactionSet.With(prometheus.Labels{"path": sg.dir}).Set(1)
@@ -159,11 +164,13 @@ func (sg *SegmentGroup) compactOnce() error {
 		if err := c.do(); err != nil {
 			return err
 		}
+		nodes = c.nodes
 	case SegmentStrategyMapCollection:
 		c := newCompactorMapCollection(f,
 			sg.segmentAtPos(pair[0]).newCollectionCursorReusable(),
 			sg.segmentAtPos(pair[1]).newCollectionCursorReusable(),
-			level, secondaryIndices, scratchSpacePath, sg.mapRequiresSorting)
+			level, secondaryIndices, scratchSpacePath, sg.compression,
+			sg.mapRequiresSorting)
 
 		if sg.metrics != nil {
 			sg.metrics.CompactionMap.With(prometheus.Labels{"path": sg.dir}).Set(1)
@@ -173,6 +180,23 @@ func (sg *SegmentGroup) compactOnce() error {
 		if err := c.do(); err != nil {
 			return err
 		}
+		nodes = c.nodes
+	case SegmentStrategyRoaringSet:
+		c := newCompactorRoaringSet(f,
+			sg.segmentAtPos(pair[0]).newRoaringSetCursor(false),
+			sg.segmentAtPos(pair[1]).newRoaringSetCursor(false),
+			level, secondaryIndices, scratchSpacePath, sg.compression,
+			pair[0] == 0)
+
+		if sg.metrics != nil {
+			sg.metrics.CompactionRoaringSet.With(prometheus.Labels{"path": sg.dir}).Set(1)
+			defer sg.metrics.CompactionRoaringSet.With(prometheus.Labels{"path": sg.dir}).Set(0)
+		}
+
+		if err := c.do(); err != nil {
+			return err
+		}
+		nodes = c.nodes
 
 	default:
 		return errors.Errorf("unrecognized strategy %v", strategy)
@@ -182,6 +206,11 @@ func (sg *SegmentGroup) compactOnce() error {
 		return errors.Wrap(err, "close compacted segment file")
 	}
 
+	if nodes.compressed() {
+		sg.metrics.CompressionObserver(sg.dir, sg.strategy, "compaction")(
+			nodes.rawBytes, nodes.writtenBytes)
+	}
+
 	if err := sg.replaceCompactedSegments(pair[0], pair[1], path); err != nil {
 		return errors.Wrap(err, "replace compacted segments")
 	}
@@ -43,7 +43,12 @@ func (i *segment) get(key []byte) ([]byte, error) {
 	}
 
 	defer i.bloomFilterMetrics.truePositive(before)
-	return i.replaceStratParseData(i.contents[node.Start:node.End])
+	contents, err := i.nodeContents(node.Start, node.End)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.replaceStratParseData(contents)
 }
 
 func (i *segment) getBySecondary(pos int, key []byte) ([]byte, error) {
@@ -68,7 +73,12 @@ func (i *segment) getBySecondary(pos int, key []byte) ([]byte, error) {
 		}
 	}
 
-	return i.replaceStratParseData(i.contents[node.Start:node.End])
+	contents, err := i.nodeContents(node.Start, node.End)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.replaceStratParseData(contents)
 }
 
 func (i *segment) replaceStratParseData(in []byte) ([]byte, error) {
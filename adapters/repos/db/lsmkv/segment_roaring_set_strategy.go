@@ -0,0 +1,97 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package lsmkv
+
+import (
+	"io"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/lsmkv/roaringset"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/lsmkv/segmentindex"
+)
+
+func roaringSetNodeKeyIndexAndWriteTo(w io.Writer, node *roaringset.SegmentNode,
+	offset int,
+) (keyIndex, error) {
+	n, err := w.Write(node.ToBuffer())
+	if err != nil {
+		return keyIndex{}, err
+	}
+
+	return keyIndex{
+		valueStart: offset,
+		valueEnd:   offset + n,
+		key:        node.PrimaryKey(),
+	}, nil
+}
+
+func (i *segment) roaringSetGet(key []byte) (roaringset.BitmapLayer, error) {
+	if i.strategy != SegmentStrategyRoaringSet {
+		return roaringset.BitmapLayer{}, errors.Errorf(
+			"get only possible for strategy %q", StrategyRoaringSet)
+	}
+
+	if !i.bloomFilter.Test(key) {
+		return roaringset.BitmapLayer{}, NotFound
+	}
+
+	node, err := i.index.Get(key)
+	if err != nil {
+		if err == segmentindex.NotFound {
+			return roaringset.BitmapLayer{}, NotFound
+		} else {
+			return roaringset.BitmapLayer{}, err
+		}
+	}
+
+	// Parsing the bitmaps copies the data, so unlike in getCollection there is
+	// no need for an explicit copy to protect against a compaction removing
+	// the underlying segment
+	contents, err := i.nodeContents(node.Start, node.End)
+	if err != nil {
+		return roaringset.BitmapLayer{}, err
+	}
+
+	return i.roaringSetParseNode(contents)
+}
+
+func (i *segment) roaringSetParseNode(in []byte) (roaringset.BitmapLayer, error) {
+	sn, err := roaringset.NewSegmentNodeFromBuffer(in)
+	if err != nil {
+		return roaringset.BitmapLayer{}, err
+	}
+
+	return sn.Layer()
+}
+
+func (sg *SegmentGroup) roaringSetGet(key []byte) (roaringset.BitmapLayers, error) {
+	sg.maintenanceLock.RLock()
+	defer sg.maintenanceLock.RUnlock()
+
+	var out roaringset.BitmapLayers
+
+	// start with first and do not exit
+	for _, segment := range sg.segments {
+		layer, err := segment.roaringSetGet(key)
+		if err != nil {
+			if err == NotFound {
+				continue
+			}
+
+			return nil, err
+		}
+
+		out = append(out, layer)
+	}
+
+	return out, nil
+}
@@ -120,7 +120,8 @@ func parseSegmentHeader(r io.Reader) (*segmentHeader, error) {
 		return nil, err
 	}
 
-	if out.version != 0 {
+	if out.version != segmentVersionUncompressed &&
+		out.version != segmentVersionBlockCompressed {
 		return nil, errors.Errorf("unsupported version %d", out.version)
 	}
 
@@ -122,6 +122,80 @@ func (s *Store) CreateOrLoadBucket(ctx context.Context, bucketName string,
 	return nil
 }
 
+// DropBucket shuts down the bucket with the given name and removes it
+// including all of its files from disk. Dropping a bucket which does not exist
+// is a no-op.
+func (s *Store) DropBucket(ctx context.Context, bucketName string) error {
+	s.bucketAccessLock.Lock()
+	b, ok := s.bucketsByName[bucketName]
+	delete(s.bucketsByName, bucketName)
+	s.bucketAccessLock.Unlock()
+
+	if ok && b != nil {
+		if err := b.Shutdown(ctx); err != nil {
+			return errors.Wrapf(err, "shutdown bucket %q", bucketName)
+		}
+	}
+
+	if err := os.RemoveAll(s.bucketDir(bucketName)); err != nil {
+		return errors.Wrapf(err, "remove bucket %q", bucketName)
+	}
+
+	return nil
+}
+
+// RenameBucket shuts down the bucket with the given name and moves its files
+// to newName. The bucket is not loaded again under its new name. Renaming a
+// bucket which does not exist on disk is a no-op.
+func (s *Store) RenameBucket(ctx context.Context, bucketName, newName string) error {
+	if _, err := os.Stat(s.bucketDir(newName)); err == nil {
+		return errors.Errorf("move bucket %q to %q: target exists", bucketName, newName)
+	}
+
+	s.bucketAccessLock.Lock()
+	b, ok := s.bucketsByName[bucketName]
+	delete(s.bucketsByName, bucketName)
+	s.bucketAccessLock.Unlock()
+
+	if ok && b != nil {
+		if err := b.Shutdown(ctx); err != nil {
+			return errors.Wrapf(err, "shutdown bucket %q", bucketName)
+		}
+	}
+
+	if err := os.Rename(s.bucketDir(bucketName), s.bucketDir(newName)); err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return errors.Wrapf(err, "move bucket %q to %q", bucketName, newName)
+	}
+
+	return nil
+}
+
+// ReplaceBucket moves the bucket replacementName into the place of bucketName.
+// The previous contents of bucketName are moved to previousName rather than
+// removed, so that the replacement can still be undone. Both buckets are shut
+// down in the process, the caller needs to load bucketName again with the
+// options it requires.
+//
+// Each of the two steps is a single rename. A crash in between leaves no
+// bucket under bucketName, but both the previous contents and the replacement
+// are still complete on disk under their new names.
+func (s *Store) ReplaceBucket(ctx context.Context, bucketName,
+	replacementName, previousName string,
+) error {
+	if s.Bucket(replacementName) == nil {
+		return errors.Errorf("bucket %q not found", replacementName)
+	}
+
+	if err := s.RenameBucket(ctx, bucketName, previousName); err != nil {
+		return err
+	}
+
+	return s.RenameBucket(ctx, replacementName, bucketName)
+}
+
 func (s *Store) setBucket(name string, b *Bucket) {
 	s.bucketAccessLock.Lock()
 	defer s.bucketAccessLock.Unlock()
@@ -17,6 +17,7 @@ package lsmkv
 import (
 	"context"
 	"math/rand"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -82,3 +83,112 @@ func TestStoreLifecycle(t *testing.T) {
 		require.Nil(t, err)
 	})
 }
+
+func TestStoreDropBucket(t *testing.T) {
+	dirName := t.TempDir()
+
+	store, err := New(dirName, "", nullLogger(), nil)
+	require.Nil(t, err)
+
+	err = store.CreateOrLoadBucket(testCtx(), "bucket1", WithStrategy(StrategyReplace))
+	require.Nil(t, err)
+
+	err = store.Bucket("bucket1").Put([]byte("name"), []byte("Jane Doe"))
+	require.Nil(t, err)
+
+	t.Run("drop the bucket", func(t *testing.T) {
+		err := store.DropBucket(context.Background(), "bucket1")
+		require.Nil(t, err)
+
+		assert.Nil(t, store.Bucket("bucket1"))
+		assert.NoDirExists(t, filepath.Join(dirName, "bucket1"))
+	})
+
+	t.Run("drop a bucket which does not exist", func(t *testing.T) {
+		err := store.DropBucket(context.Background(), "bucket2")
+		require.Nil(t, err)
+	})
+
+	t.Run("recreating the bucket starts out empty", func(t *testing.T) {
+		err := store.CreateOrLoadBucket(testCtx(), "bucket1", WithStrategy(StrategyReplace))
+		require.Nil(t, err)
+
+		res, err := store.Bucket("bucket1").Get([]byte("name"))
+		require.Nil(t, err)
+		assert.Nil(t, res)
+	})
+
+	require.Nil(t, store.Shutdown(context.Background()))
+}
+
+func TestStoreReplaceBucket(t *testing.T) {
+	dirName := t.TempDir()
+
+	store, err := New(dirName, "", nullLogger(), nil)
+	require.Nil(t, err)
+
+	for _, name := range []string{"bucket1", "bucket1_replacement"} {
+		err = store.CreateOrLoadBucket(testCtx(), name, WithStrategy(StrategyReplace))
+		require.Nil(t, err)
+	}
+
+	err = store.Bucket("bucket1").Put([]byte("name"), []byte("Jane Doe"))
+	require.Nil(t, err)
+	err = store.Bucket("bucket1").Put([]byte("age"), []byte("42"))
+	require.Nil(t, err)
+	err = store.Bucket("bucket1_replacement").Put([]byte("name"), []byte("John Doe"))
+	require.Nil(t, err)
+
+	t.Run("replace the bucket", func(t *testing.T) {
+		err := store.ReplaceBucket(context.Background(), "bucket1",
+			"bucket1_replacement", "bucket1_previous")
+		require.Nil(t, err)
+
+		assert.Nil(t, store.Bucket("bucket1"))
+		assert.Nil(t, store.Bucket("bucket1_replacement"))
+		assert.NoDirExists(t, filepath.Join(dirName, "bucket1_replacement"))
+		assert.DirExists(t, filepath.Join(dirName, "bucket1_previous"))
+	})
+
+	t.Run("replace with a bucket which does not exist", func(t *testing.T) {
+		err := store.ReplaceBucket(context.Background(), "bucket1", "bucket2",
+			"bucket1_previous2")
+		assert.NotNil(t, err)
+	})
+
+	t.Run("reloading the bucket contains the replacement", func(t *testing.T) {
+		err := store.CreateOrLoadBucket(testCtx(), "bucket1", WithStrategy(StrategyReplace))
+		require.Nil(t, err)
+
+		res, err := store.Bucket("bucket1").Get([]byte("name"))
+		require.Nil(t, err)
+		assert.Equal(t, []byte("John Doe"), res)
+
+		res, err = store.Bucket("bucket1").Get([]byte("age"))
+		require.Nil(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("the previous contents are kept", func(t *testing.T) {
+		err := store.CreateOrLoadBucket(testCtx(), "bucket1_previous",
+			WithStrategy(StrategyReplace))
+		require.Nil(t, err)
+
+		res, err := store.Bucket("bucket1_previous").Get([]byte("name"))
+		require.Nil(t, err)
+		assert.Equal(t, []byte("Jane Doe"), res)
+	})
+
+	t.Run("a bucket is not moved onto an existing one", func(t *testing.T) {
+		err := store.RenameBucket(context.Background(), "bucket1", "bucket1_previous")
+		assert.NotNil(t, err)
+	})
+
+	t.Run("renaming a bucket which does not exist", func(t *testing.T) {
+		err := store.RenameBucket(context.Background(), "bucket3", "bucket4")
+		require.Nil(t, err)
+		assert.NoDirExists(t, filepath.Join(dirName, "bucket4"))
+	})
+
+	require.Nil(t, store.Shutdown(context.Background()))
+}
@@ -11,11 +11,18 @@
 
 package lsmkv
 
+import "fmt"
+
 const (
 	// StrategyReplace allows for idem-potent PUT where the latest takes presence
 	StrategyReplace       = "replace"
 	StrategySetCollection = "setcollection"
 	StrategyMapCollection = "mapcollection"
+
+	// StrategyRoaringSet stores sets of uint64 values (e.g. doc ids) as roaring
+	// bitmaps. It is a more compact and faster alternative to
+	// StrategySetCollection for the posting lists of filterable properties
+	StrategyRoaringSet = "roaringset"
 )
 
 type SegmentStrategy uint16
@@ -24,6 +31,7 @@ const (
 	SegmentStrategyReplace SegmentStrategy = iota
 	SegmentStrategySetCollection
 	SegmentStrategyMapCollection
+	SegmentStrategyRoaringSet
 )
 
 func SegmentStrategyFromString(in string) SegmentStrategy {
@@ -34,7 +42,24 @@ func SegmentStrategyFromString(in string) SegmentStrategy {
 		return SegmentStrategySetCollection
 	case StrategyMapCollection:
 		return SegmentStrategyMapCollection
+	case StrategyRoaringSet:
+		return SegmentStrategyRoaringSet
 	default:
 		panic("unsupported strategy")
 	}
 }
+
+func (s SegmentStrategy) String() string {
+	switch s {
+	case SegmentStrategyReplace:
+		return StrategyReplace
+	case SegmentStrategySetCollection:
+		return StrategySetCollection
+	case SegmentStrategyMapCollection:
+		return StrategyMapCollection
+	case SegmentStrategyRoaringSet:
+		return StrategyRoaringSet
+	default:
+		return fmt.Sprintf("unknown(%d)", uint16(s))
+	}
+}
@@ -0,0 +1,245 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package lsmkv
+
+import (
+	"testing"
+
+	"github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoaringSetStrategy_InsertAndRemove(t *testing.T) {
+	type test struct {
+		name        string
+		flushBefore bool
+		flushAfter  bool
+	}
+
+	tests := []test{
+		{name: "memtable-only"},
+		{name: "flush before updates", flushBefore: true},
+		{name: "flush before and after updates", flushBefore: true, flushAfter: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			b, err := NewBucket(testCtx(), t.TempDir(), "", nullLogger(), nil,
+				WithStrategy(StrategyRoaringSet))
+			require.Nil(t, err)
+
+			// so big it effectively never triggers as part of this test
+			b.SetMemtableThreshold(1e9)
+
+			key1 := []byte("key-1")
+			key2 := []byte("key-2")
+			key3 := []byte("key-3")
+
+			require.Nil(t, b.RoaringSetAddList(key1, []uint64{1, 2}))
+			require.Nil(t, b.RoaringSetAddList(key2, []uint64{3, 4}))
+			require.Nil(t, b.RoaringSetAddOne(key3, 5))
+
+			if test.flushBefore {
+				require.Nil(t, b.FlushAndSwitch())
+			}
+
+			require.Nil(t, b.RoaringSetRemoveOne(key1, 2))
+			require.Nil(t, b.RoaringSetAddOne(key2, 7))
+			require.Nil(t, b.RoaringSetRemoveOne(key3, 5))
+			require.Nil(t, b.RoaringSetAddBitmap(key3, roaring64.BitmapOf(5, 6)))
+
+			if test.flushAfter {
+				require.Nil(t, b.FlushAndSwitch())
+			}
+
+			res, err := b.RoaringSetGet(key1)
+			require.Nil(t, err)
+			assert.Equal(t, []uint64{1}, res.ToArray())
+
+			res, err = b.RoaringSetGet(key2)
+			require.Nil(t, err)
+			assert.Equal(t, []uint64{3, 4, 7}, res.ToArray())
+
+			res, err = b.RoaringSetGet(key3)
+			require.Nil(t, err)
+			assert.Equal(t, []uint64{5, 6}, res.ToArray())
+
+			res, err = b.RoaringSetGet([]byte("not-present"))
+			require.Nil(t, err)
+			assert.True(t, res.IsEmpty())
+		})
+	}
+}
+
+func TestRoaringSetStrategy_RecoverFromWAL(t *testing.T) {
+	dirName := t.TempDir()
+	key := []byte("key")
+
+	t.Run("write without flushing", func(t *testing.T) {
+		b, err := NewBucket(testCtx(), dirName, "", nullLogger(), nil,
+			WithStrategy(StrategyRoaringSet))
+		require.Nil(t, err)
+
+		// so big it effectively never triggers as part of this test
+		b.SetMemtableThreshold(1e9)
+
+		require.Nil(t, b.RoaringSetAddList(key, []uint64{1, 2, 3}))
+		require.Nil(t, b.RoaringSetRemoveOne(key, 2))
+		require.Nil(t, b.WriteWAL())
+	})
+
+	t.Run("detect the strategy from the log", func(t *testing.T) {
+		strategy, err := StrategyOnDisk(dirName)
+		require.Nil(t, err)
+		assert.Equal(t, StrategyRoaringSet, strategy)
+	})
+
+	t.Run("recover in a new bucket", func(t *testing.T) {
+		b, err := NewBucket(testCtx(), dirName, "", nullLogger(), nil,
+			WithStrategy(StrategyRoaringSet))
+		require.Nil(t, err)
+
+		res, err := b.RoaringSetGet(key)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{1, 3}, res.ToArray())
+	})
+
+	t.Run("detect the strategy from the recovered segment", func(t *testing.T) {
+		strategy, err := StrategyOnDisk(dirName)
+		require.Nil(t, err)
+		assert.Equal(t, StrategyRoaringSet, strategy)
+	})
+}
+
+func TestRoaringSetStrategy_Cursors(t *testing.T) {
+	b, err := NewBucket(testCtx(), t.TempDir(), "", nullLogger(), nil,
+		WithStrategy(StrategyRoaringSet))
+	require.Nil(t, err)
+
+	// so big it effectively never triggers as part of this test
+	b.SetMemtableThreshold(1e9)
+
+	require.Nil(t, b.RoaringSetAddList([]byte("b"), []uint64{1, 2}))
+	require.Nil(t, b.RoaringSetAddList([]byte("d"), []uint64{3}))
+	require.Nil(t, b.FlushAndSwitch())
+	require.Nil(t, b.RoaringSetAddList([]byte("a"), []uint64{4}))
+	require.Nil(t, b.RoaringSetRemoveOne([]byte("b"), 1))
+	require.Nil(t, b.FlushAndSwitch())
+	require.Nil(t, b.RoaringSetAddList([]byte("c"), []uint64{5}))
+	require.Nil(t, b.RoaringSetAddList([]byte("d"), []uint64{6}))
+
+	type kv struct {
+		key    string
+		values []uint64
+	}
+
+	collect := func(c *CursorRoaringSet, k []byte, bm *roaring64.Bitmap) []kv {
+		var out []kv
+		for ; k != nil; k, bm = c.Next() {
+			var values []uint64
+			if bm != nil {
+				values = bm.ToArray()
+			}
+			out = append(out, kv{key: string(k), values: values})
+		}
+		return out
+	}
+
+	t.Run("from the beginning", func(t *testing.T) {
+		c := b.CursorRoaringSet()
+		defer c.Close()
+
+		k, bm := c.First()
+		expected := []kv{
+			{key: "a", values: []uint64{4}},
+			{key: "b", values: []uint64{2}},
+			{key: "c", values: []uint64{5}},
+			{key: "d", values: []uint64{3, 6}},
+		}
+		assert.Equal(t, expected, collect(c, k, bm))
+	})
+
+	t.Run("seek to a key that is not present", func(t *testing.T) {
+		c := b.CursorRoaringSet()
+		defer c.Close()
+
+		k, bm := c.Seek([]byte("bb"))
+		expected := []kv{
+			{key: "c", values: []uint64{5}},
+			{key: "d", values: []uint64{3, 6}},
+		}
+		assert.Equal(t, expected, collect(c, k, bm))
+	})
+
+	t.Run("keys only", func(t *testing.T) {
+		c := b.CursorRoaringSetKeyOnly()
+		defer c.Close()
+
+		k, bm := c.Seek([]byte("b"))
+		expected := []kv{{key: "b"}, {key: "c"}, {key: "d"}}
+		assert.Equal(t, expected, collect(c, k, bm))
+	})
+}
+
+func TestRoaringSetStrategy_Compaction(t *testing.T) {
+	// every segment removes the value added by the previous segment, after
+	// compacting everything into a single segment only the last value must be
+	// left, without any deletions
+	size := 20
+	key := []byte("my-key")
+	otherKey := []byte("other-key")
+
+	b, err := NewBucket(testCtx(), t.TempDir(), "", nullLogger(), nil,
+		WithStrategy(StrategyRoaringSet))
+	require.Nil(t, err)
+
+	// so big it effectively never triggers as part of this test
+	b.SetMemtableThreshold(1e9)
+
+	t.Run("write segments", func(t *testing.T) {
+		for i := 0; i < size; i++ {
+			if i != 0 {
+				require.Nil(t, b.RoaringSetRemoveOne(key, uint64(i-1)))
+			}
+			require.Nil(t, b.RoaringSetAddOne(key, uint64(i)))
+			require.Nil(t, b.RoaringSetAddOne(otherKey, uint64(i)))
+			require.Nil(t, b.FlushAndSwitch())
+		}
+	})
+
+	t.Run("compact until no longer eligible", func(t *testing.T) {
+		require.True(t, b.disk.eligibleForCompaction())
+		for b.disk.eligibleForCompaction() {
+			require.Nil(t, b.disk.compactOnce())
+		}
+	})
+
+	t.Run("verify after compaction", func(t *testing.T) {
+		res, err := b.RoaringSetGet(key)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{uint64(size - 1)}, res.ToArray())
+
+		res, err = b.RoaringSetGet(otherKey)
+		require.Nil(t, err)
+		assert.Equal(t, uint64(size), res.GetCardinality())
+	})
+
+	t.Run("deletions were removed from the lowest segment", func(t *testing.T) {
+		layer, err := b.disk.segments[0].roaringSetGet(key)
+		require.Nil(t, err)
+		assert.True(t, layer.Deletions.IsEmpty())
+	})
+}
@@ -0,0 +1,126 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package lsmkv
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+)
+
+// StrategyOnDisk inspects the state of a bucket on disk without loading it
+// and returns the strategy it was written with. This can be used to detect
+// buckets that need to be migrated to a different strategy before they are
+// loaded.
+//
+// Disk segments are inspected first. If there are none, the first entry of a
+// write-ahead-log is used instead. A log cannot tell a set and a map bucket
+// apart, in this case StrategySetCollection is returned. An empty string is
+// returned if there is no state on disk.
+func StrategyOnDisk(dir string) (string, error) {
+	list, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	for _, entry := range list {
+		if filepath.Ext(entry.Name()) != ".db" {
+			continue
+		}
+
+		header, ok, err := readSegmentHeaderFromFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return "", errors.Wrapf(err, "read header of segment %s", entry.Name())
+		}
+
+		if ok {
+			return header.strategy.String(), nil
+		}
+	}
+
+	for _, entry := range list {
+		if filepath.Ext(entry.Name()) != ".wal" {
+			continue
+		}
+
+		commitType, ok, err := readFirstCommitTypeFromFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return "", errors.Wrapf(err, "read commit log %s", entry.Name())
+		}
+
+		if !ok {
+			continue
+		}
+
+		switch commitType {
+		case CommitTypeReplace:
+			return StrategyReplace, nil
+		case CommitTypeRoaringSet:
+			return StrategyRoaringSet, nil
+		default:
+			return StrategySetCollection, nil
+		}
+	}
+
+	return "", nil
+}
+
+// readSegmentHeaderFromFile returns false if the file is too short to contain
+// a header
+func readSegmentHeaderFromFile(path string) (*segmentHeader, bool, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, false, err
+	}
+	defer f.Close()
+
+	buf := make([]byte, SegmentHeaderSize)
+	if _, err := io.ReadFull(f, buf); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	header, err := parseSegmentHeader(bytes.NewReader(buf))
+	if err != nil {
+		return nil, false, err
+	}
+
+	return header, true, nil
+}
+
+// readFirstCommitTypeFromFile returns false if the log is empty
+func readFirstCommitTypeFromFile(path string) (CommitType, bool, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, false, err
+	}
+	defer f.Close()
+
+	var commitType CommitType
+	if err := binary.Read(f, binary.LittleEndian, &commitType); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return 0, false, nil
+		}
+		return 0, false, err
+	}
+
+	return commitType, true, nil
+}
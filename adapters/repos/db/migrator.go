@@ -17,10 +17,12 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/inverted"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/flat"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/entities/storobj"
+	flatent "github.com/semi-technologies/weaviate/entities/vectorindex/flat"
 	"github.com/semi-technologies/weaviate/usecases/sharding"
 	"github.com/sirupsen/logrus"
 )
@@ -41,13 +43,16 @@ func (m *Migrator) AddClass(ctx context.Context, class *models.Class,
 			QueryMaximumResults:       m.db.config.QueryMaximumResults,
 			MaxImportGoroutinesFactor: m.db.config.MaxImportGoroutinesFactor,
 			FlushIdleAfter:            m.db.config.FlushIdleAfter,
+			ObjectsCompression:        m.db.config.ObjectsCompression,
 			TrackVectorDimensions:     m.db.config.TrackVectorDimensions,
+			IndexFilterableRoaringSet: m.db.config.IndexFilterableRoaringSet,
 		},
 		shardState,
 		// no backward-compatibility check required, since newly added classes will
 		// always have the field set
 		inverted.ConfigFromModel(class.InvertedIndexConfig),
 		class.VectorIndexConfig.(schema.VectorIndexConfig),
+		namedVectorIndexConfigs(class),
 		m.db.schemaGetter, m.db, m.logger, m.db.nodeResolver, m.db.remoteIndex,
 		m.db.promMetrics)
 	if err != nil {
@@ -135,13 +140,127 @@ func (m *Migrator) AddProperty(ctx context.Context, className string, prop *mode
 		return errors.Errorf("cannot add property to a non-existing index for %s", className)
 	}
 
-	return idx.addProperty(ctx, prop)
+	if err := idx.addProperty(ctx, prop); err != nil {
+		return err
+	}
+
+	// without these buckets, imports into a prop added to an existing class
+	// would fail until the next restart
+	invertedConfig := idx.getInvertedIndexConfig()
+	if invertedConfig.IndexNullState {
+		if err := idx.addNullStateProperty(ctx, prop); err != nil {
+			return errors.Wrapf(err, "extend idx '%s' with nullstate properties", idx.ID())
+		}
+	}
+
+	if invertedConfig.IndexPropertyLength {
+		if err := idx.addPropertyLength(ctx, prop); err != nil {
+			return errors.Wrapf(err, "extend idx '%s' with property length", idx.ID())
+		}
+	}
+
+	return nil
 }
 
-// DropProperty is ignored, API compliant change
+// DropProperty removes the indexes of the property from all local shards.
+// The values are removed from the stored objects in the background.
 func (m *Migrator) DropProperty(ctx context.Context, className string, propertyName string) error {
-	// ignore but don't error
-	return nil
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return errors.Errorf("cannot drop property from a non-existing index for %s", className)
+	}
+
+	return idx.dropProperty(ctx, propertyName)
+}
+
+// StartReindex starts to rebuild the indexes of the given props and, if
+// vectorConfig is set, the vector index of the class in all local shards.
+// The new indexes are only swapped in by CommitReindex.
+func (m *Migrator) StartReindex(ctx context.Context, className, id string,
+	props []*models.Property, vectorConfig schema.VectorIndexConfig,
+) error {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return errors.Errorf("cannot reindex a non-existing index for %s", className)
+	}
+
+	return idx.startReindex(ctx, id, props, vectorConfig)
+}
+
+func (m *Migrator) CommitReindex(ctx context.Context, className, id string) error {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return errors.Errorf("cannot commit reindex of a non-existing index for %s", className)
+	}
+
+	return idx.commitReindex(ctx, id)
+}
+
+// CleanupReindex removes the indexes which were replaced by CommitReindex.
+// It is called once the schema has been saved with the new settings, until
+// then a restart undoes the swap.
+func (m *Migrator) CleanupReindex(ctx context.Context, className, id string) error {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return errors.Errorf("cannot clean up reindex of a non-existing index for %s", className)
+	}
+
+	return idx.cleanupReindex(ctx, id)
+}
+
+func (m *Migrator) CancelReindex(ctx context.Context, className, id string) error {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return errors.Errorf("cannot cancel reindex of a non-existing index for %s", className)
+	}
+
+	return idx.cancelReindex(ctx, id)
+}
+
+// ReindexStatus returns the status of the current or last reindex job of
+// every shard of the class across the cluster
+func (m *Migrator) ReindexStatus(ctx context.Context,
+	className string,
+) ([]*models.ReindexShardStatus, error) {
+	nodes, err := m.db.GetNodeStatuses(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []*models.ReindexShardStatus
+	for _, node := range nodes {
+		for _, shard := range node.Shards {
+			if shard.Class != className || shard.Reindex == nil {
+				continue
+			}
+			out = append(out, shard.Reindex)
+		}
+	}
+
+	return out, nil
+}
+
+// PropertyDeletionStatus returns the status of the property deletions of
+// every shard of the class across all nodes
+func (m *Migrator) PropertyDeletionStatus(ctx context.Context,
+	className string,
+) ([]*models.PropertyDeletionStatus, error) {
+	nodes, err := m.db.GetNodeStatuses(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []*models.PropertyDeletionStatus
+	for _, node := range nodes {
+		for _, shard := range node.Shards {
+			if shard.Class != className {
+				continue
+			}
+			out = append(out, shard.PropertyDeletions...)
+		}
+	}
+
+	return out, nil
 }
 
 func (m *Migrator) UpdateProperty(ctx context.Context, className string, propName string, newName *string) error {
@@ -170,6 +289,31 @@ func (m *Migrator) UpdateShardStatus(ctx context.Context, className, shardName,
 	return idx.updateShardStatus(ctx, shardName, targetStatus)
 }
 
+// NewTenants creates the shards of newly added tenants which are owned by
+// this node
+func (m *Migrator) NewTenants(ctx context.Context, className string,
+	tenants []string,
+) error {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return errors.Errorf("cannot add tenants to a non-existing index for %s", className)
+	}
+
+	return idx.addTenants(ctx, tenants)
+}
+
+// DeleteTenants drops the local shards of the specified tenants
+func (m *Migrator) DeleteTenants(ctx context.Context, className string,
+	tenants []string,
+) error {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return errors.Errorf("cannot delete tenants from a non-existing index for %s", className)
+	}
+
+	return idx.dropTenants(tenants)
+}
+
 func NewMigrator(db *DB, logger logrus.FieldLogger) *Migrator {
 	return &Migrator{db: db, logger: logger}
 }
@@ -188,9 +332,10 @@ func (m *Migrator) UpdateVectorIndexConfig(ctx context.Context,
 func (m *Migrator) ValidateVectorIndexConfigUpdate(ctx context.Context,
 	old, updated schema.VectorIndexConfig,
 ) error {
-	// hnsw is the only supported vector index type at the moment, so no need
-	// to check, we can always use that an hnsw-specific validation should be
-	// used for now.
+	if old.IndexType() == flatent.IndexType {
+		return flat.ValidateUserConfigUpdate(old, updated)
+	}
+
 	return hnsw.ValidateUserConfigUpdate(old, updated)
 }
 
@@ -58,7 +58,7 @@ func Test_MultiShardJourneys_IndividualImports(t *testing.T) {
 
 	t.Run("import all individually", func(t *testing.T) {
 		for _, obj := range data {
-			require.Nil(t, repo.PutObject(context.Background(), obj, obj.Vector))
+			require.Nil(t, repo.PutObject(context.Background(), obj, obj.Vector, nil))
 		}
 	})
 
@@ -66,12 +66,14 @@ func Test_MultiShardJourneys_IndividualImports(t *testing.T) {
 
 	t.Run("sorting objects", makeTestSortingClass(repo))
 
+	t.Run("iterating with a cursor", makeTestCursorIteration(repo, data))
+
 	t.Run("verify objects", makeTestRetrievingBaseClass(repo, data, queryVec,
 		groundTruth))
 
 	t.Run("import refs individually", func(t *testing.T) {
 		for _, obj := range refData {
-			require.Nil(t, repo.PutObject(context.Background(), obj, obj.Vector))
+			require.Nil(t, repo.PutObject(context.Background(), obj, obj.Vector, nil))
 		}
 	})
 
@@ -104,7 +106,7 @@ func Test_MultiShardJourneys_BatchedImports(t *testing.T) {
 			}
 		}
 
-		_, err := repo.BatchPutObjects(context.Background(), batch)
+		_, err := repo.BatchPutObjects(context.Background(), batch, nil)
 		require.Nil(t, err)
 	})
 
@@ -126,7 +128,7 @@ func Test_MultiShardJourneys_BatchedImports(t *testing.T) {
 			}
 
 			require.Nil(t, repo.PutObject(context.Background(), withoutRef,
-				withoutRef.Vector))
+				withoutRef.Vector, nil))
 		}
 
 		index := 0
@@ -143,7 +145,7 @@ func Test_MultiShardJourneys_BatchedImports(t *testing.T) {
 			}
 		}
 
-		_, err := repo.AddBatchReferences(context.Background(), refBatch)
+		_, err := repo.AddBatchReferences(context.Background(), refBatch, nil)
 		require.Nil(t, err)
 	})
 
@@ -173,6 +175,11 @@ func Test_MultiShardJourneys_BM25_Search(t *testing.T) {
 					DataType:     []string{string(schema.DataTypeText)},
 					Tokenization: "word",
 				},
+				{
+					Name:         "title",
+					DataType:     []string{string(schema.DataTypeText)},
+					Tokenization: "word",
+				},
 				{
 					Name:     "stringProp",
 					DataType: []string{string(schema.DataTypeString)},
@@ -196,6 +203,7 @@ func Test_MultiShardJourneys_BM25_Search(t *testing.T) {
 					Class: className,
 					Properties: map[string]interface{}{
 						"contents": "Team Lotus was a domineering force in the early 90s",
+						"title":    "Lotus",
 					},
 				},
 			},
@@ -206,6 +214,7 @@ func Test_MultiShardJourneys_BM25_Search(t *testing.T) {
 					Class: className,
 					Properties: map[string]interface{}{
 						"contents": "When a car becomes unserviceable, the driver must retire early from the race",
+						"title":    "Retiring early",
 					},
 				},
 			},
@@ -216,6 +225,7 @@ func Test_MultiShardJourneys_BM25_Search(t *testing.T) {
 					Class: className,
 					Properties: map[string]interface{}{
 						"contents": "A young driver is better than an old driver",
+						"title":    "Young drivers",
 					},
 				},
 			},
@@ -226,12 +236,13 @@ func Test_MultiShardJourneys_BM25_Search(t *testing.T) {
 					Class: className,
 					Properties: map[string]interface{}{
 						"contents": "an old driver doesn't retire early",
+						"title":    "Old driver",
 					},
 				},
 			},
 		}
 
-		_, err := repo.BatchPutObjects(context.Background(), objs)
+		_, err := repo.BatchPutObjects(context.Background(), objs, nil)
 		require.Nil(t, err)
 	})
 
@@ -253,6 +264,39 @@ func Test_MultiShardJourneys_BM25_Search(t *testing.T) {
 					"5d034311-06e1-476e-b446-1306db91d906",
 				},
 			},
+			{
+				// the query is tokenized like the property values
+				rankingParams: &searchparams.KeywordRanking{
+					Query:      "Driver!",
+					Properties: []string{"contents"},
+				},
+				expectedResults: []string{
+					"01989a8c-e37f-471d-89ca-9a787dbbf5f2",
+					"392614c5-4ca4-4630-a014-61fe868a20fd",
+					"5d034311-06e1-476e-b446-1306db91d906",
+				},
+			},
+			{
+				// a match in the boosted title outweighs two matches in the contents
+				rankingParams: &searchparams.KeywordRanking{
+					Query:      "driver",
+					Properties: []string{"title^3", "contents"},
+				},
+				expectedResults: []string{
+					"392614c5-4ca4-4630-a014-61fe868a20fd",
+					"01989a8c-e37f-471d-89ca-9a787dbbf5f2",
+					"5d034311-06e1-476e-b446-1306db91d906",
+				},
+			},
+			{
+				rankingParams: &searchparams.KeywordRanking{
+					Query:      "lotus",
+					Properties: []string{"title", "contents"},
+				},
+				expectedResults: []string{
+					"c39751ed-ddc2-4c9f-a45b-8b5732ddde56",
+				},
+			},
 		}
 
 		for _, test := range tests {
@@ -481,6 +525,42 @@ func makeTestRetrieveRefClass(repo *DB, data, refData []*models.Object) func(t *
 	}
 }
 
+func makeTestCursorIteration(repo *DB, data []*models.Object) func(t *testing.T) {
+	return func(t *testing.T) {
+		expected := make([]string, len(data))
+		for i, obj := range data {
+			expected[i] = obj.ID.String()
+		}
+		sort.Strings(expected)
+
+		for _, limit := range []int{1, 3, 7, len(data) + 5} {
+			t.Run(fmt.Sprintf("with a page size of %d", limit), func(t *testing.T) {
+				var found []string
+				after := ""
+				for {
+					res, err := repo.Query(context.Background(), &objects.QueryInput{
+						Class:  "TestClass",
+						Limit:  limit,
+						Cursor: &filters.Cursor{After: after, Limit: limit},
+					})
+					require.Nil(t, err)
+					require.LessOrEqual(t, len(res), limit)
+					if len(res) == 0 {
+						break
+					}
+
+					for _, obj := range res {
+						found = append(found, obj.ID.String())
+					}
+					after = found[len(found)-1]
+				}
+
+				assert.Equal(t, expected, found)
+			})
+		}
+	}
+}
+
 func makeTestSortingClass(repo *DB) func(t *testing.T) {
 	return func(t *testing.T) {
 		t.Run("sort by property", func(t *testing.T) {
@@ -710,7 +790,7 @@ func makeTestBatchDeleteAllObjects(repo *DB) func(t *testing.T) {
 			require.True(t, beforeDelete > 0)
 			// dryRun == true
 			batchDeleteRes, err := repo.BatchDeleteObjects(context.Background(),
-				getParams(className, true))
+				getParams(className, true), nil)
 			require.Nil(t, err)
 			require.Equal(t, int64(beforeDelete), batchDeleteRes.Matches)
 			require.Equal(t, beforeDelete, len(batchDeleteRes.Objects))
@@ -723,7 +803,7 @@ func makeTestBatchDeleteAllObjects(repo *DB) func(t *testing.T) {
 			require.Equal(t, beforeDelete, len(res))
 			// dryRun == false, perform actual delete
 			batchDeleteRes, err = repo.BatchDeleteObjects(context.Background(),
-				getParams(className, false))
+				getParams(className, false), nil)
 			require.Nil(t, err)
 			require.Equal(t, int64(beforeDelete), batchDeleteRes.Matches)
 			require.Equal(t, beforeDelete, len(batchDeleteRes.Objects))
@@ -0,0 +1,203 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/additional"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	enthnsw "github.com/semi-technologies/weaviate/entities/vectorindex/hnsw"
+	"github.com/semi-technologies/weaviate/usecases/objects"
+	"github.com/semi-technologies/weaviate/usecases/sharding"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCRUD_MultiTenancy(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		Class:               "MultiTenantClass",
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		MultiTenancyConfig:  &models.MultiTenancyConfig{Enabled: true},
+		Properties: []*models.Property{{
+			Name:     "name",
+			DataType: []string{string(schema.DataTypeString)},
+		}},
+	}
+	schemaGetter := &fakeSchemaGetter{shardState: partitionedShardState()}
+	repo := New(logger, Config{
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+		FlushIdleAfter:            60,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+	migrator := NewMigrator(repo, logger)
+
+	t.Run("creating the class and its tenants", func(t *testing.T) {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+
+		schemaGetter.schema = schema.Schema{
+			Objects: &models.Schema{
+				Classes: []*models.Class{class},
+			},
+		}
+
+		partitions, err := schemaGetter.shardState.AllocatePartitions(
+			[]string{"tenant1", "tenant2"}, fakeNodes{[]string{"node1"}}, 1)
+		require.Nil(t, err)
+		for _, p := range partitions {
+			schemaGetter.shardState.AddPartition(p)
+		}
+
+		require.Nil(t, migrator.NewTenants(context.Background(), class.Class,
+			[]string{"tenant1", "tenant2"}))
+	})
+
+	id1 := strfmt.UUID("8d5a3aa2-3c8d-4589-9ae1-3f638f506001")
+	id2 := strfmt.UUID("8d5a3aa2-3c8d-4589-9ae1-3f638f506002")
+
+	t.Run("importing an object for each tenant", func(t *testing.T) {
+		require.Nil(t, repo.PutObject(context.Background(), &models.Object{
+			ID:         id1,
+			Class:      class.Class,
+			Properties: map[string]interface{}{"name": "first"},
+			Tenant:     "tenant1",
+		}, []float32{1, 2, 3}, nil))
+		require.Nil(t, repo.PutObject(context.Background(), &models.Object{
+			ID:         id2,
+			Class:      class.Class,
+			Properties: map[string]interface{}{"name": "second"},
+			Tenant:     "tenant2",
+		}, []float32{1, 2, 3}, nil))
+	})
+
+	t.Run("importing without a tenant", func(t *testing.T) {
+		err := repo.PutObject(context.Background(), &models.Object{
+			ID:    id1,
+			Class: class.Class,
+		}, []float32{1, 2, 3}, nil)
+		require.NotNil(t, err)
+		assert.True(t, errors.As(err, &objects.ErrMultiTenancy{}))
+	})
+
+	t.Run("importing for a tenant that does not exist", func(t *testing.T) {
+		err := repo.PutObject(context.Background(), &models.Object{
+			ID:     id1,
+			Class:  class.Class,
+			Tenant: "tenant3",
+		}, []float32{1, 2, 3}, nil)
+		require.NotNil(t, err)
+		assert.True(t, errors.As(err, &objects.ErrMultiTenancy{}))
+	})
+
+	t.Run("objects are only found within their own tenant", func(t *testing.T) {
+		res, err := repo.Object(context.Background(), class.Class, id1, nil,
+			additional.Properties{}, nil, "tenant1")
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		assert.Equal(t, id1, res.ID)
+
+		res, err = repo.Object(context.Background(), class.Class, id1, nil,
+			additional.Properties{}, nil, "tenant2")
+		require.Nil(t, err)
+		assert.Nil(t, res)
+
+		ok, err := repo.Exists(context.Background(), class.Class, id2, nil, "tenant1")
+		require.Nil(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("searches are isolated per tenant", func(t *testing.T) {
+		for tenant, expected := range map[string]strfmt.UUID{
+			"tenant1": id1,
+			"tenant2": id2,
+		} {
+			res, err := repo.ClassSearch(context.Background(), traverser.GetParams{
+				ClassName:  class.Class,
+				Pagination: &filters.Pagination{Limit: 10},
+				Tenant:     tenant,
+			})
+			require.Nil(t, err)
+			require.Len(t, res, 1)
+			assert.Equal(t, expected, res[0].ID)
+
+			res, err = repo.VectorClassSearch(context.Background(), traverser.GetParams{
+				ClassName:    class.Class,
+				SearchVector: []float32{1, 2, 3},
+				Pagination:   &filters.Pagination{Limit: 10},
+				Tenant:       tenant,
+			})
+			require.Nil(t, err)
+			require.Len(t, res, 1)
+			assert.Equal(t, expected, res[0].ID)
+		}
+	})
+
+	t.Run("searching without a tenant", func(t *testing.T) {
+		_, err := repo.ClassSearch(context.Background(), traverser.GetParams{
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: 10},
+		})
+		require.NotNil(t, err)
+		assert.True(t, errors.As(err, &objects.ErrMultiTenancy{}))
+	})
+
+	t.Run("deleting a tenant removes its data", func(t *testing.T) {
+		schemaGetter.shardState.DeletePartition("tenant1")
+		require.Nil(t, migrator.DeleteTenants(context.Background(), class.Class,
+			[]string{"tenant1"}))
+
+		_, err := repo.Object(context.Background(), class.Class, id1, nil,
+			additional.Properties{}, nil, "tenant1")
+		require.NotNil(t, err)
+		assert.True(t, errors.As(err, &objects.ErrMultiTenancy{}))
+
+		res, err := repo.Object(context.Background(), class.Class, id2, nil,
+			additional.Properties{}, nil, "tenant2")
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		assert.Equal(t, id2, res.ID)
+	})
+}
+
+func partitionedShardState() *sharding.State {
+	config, err := sharding.ParseConfig(nil, 1)
+	if err != nil {
+		panic(err)
+	}
+
+	s, err := sharding.InitState("multi-tenant-test-index", config,
+		fakeNodes{[]string{"node1"}}, 1, true)
+	if err != nil {
+		panic(err)
+	}
+
+	return s
+}
@@ -65,17 +65,22 @@ func (db *DB) localNodeStatus() *models.NodeStatus {
 	shards := []*models.NodeShardStatus{}
 	db.indexLock.Lock()
 	for _, index := range db.indices {
+		index.shardsLock.RLock()
 		for shardName, shard := range index.Shards {
 			objectCount := int64(shard.counter.Get())
 			shardStatus := &models.NodeShardStatus{
 				Name:        shardName,
 				Class:       shard.index.Config.ClassName.String(),
 				ObjectCount: objectCount,
+
+				PropertyDeletions: shard.propertyDeletions.list(),
+				Reindex:           shard.reindexStatus(),
 			}
 			totalObjectCount += objectCount
 			shardCount++
 			shards = append(shards, shardStatus)
 		}
+		index.shardsLock.RUnlock()
 	}
 	db.indexLock.Unlock()
 
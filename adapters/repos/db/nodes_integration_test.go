@@ -113,7 +113,7 @@ func TestNodesAPI_Journey(t *testing.T) {
 			UUID: "86a380e9-cb60-4b2a-bc48-51f52acd72d6",
 		},
 	}
-	batchRes, err := repo.BatchPutObjects(context.Background(), batch)
+	batchRes, err := repo.BatchPutObjects(context.Background(), batch, nil)
 	require.Nil(t, err)
 
 	assert.Nil(t, batchRes[0].Err)
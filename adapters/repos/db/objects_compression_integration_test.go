@@ -0,0 +1,130 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/lsmkv"
+	"github.com/semi-technologies/weaviate/entities/additional"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	enthnsw "github.com/semi-technologies/weaviate/entities/vectorindex/hnsw"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObjectsCompressionJourney(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Class:               "CompressedClass",
+		Properties: []*models.Property{
+			{
+				Name:     "text",
+				DataType: []string{string(schema.DataTypeText)},
+			},
+		},
+	}
+	shardState := singleShardState()
+	schemaGetter := &fakeSchemaGetter{shardState: shardState}
+
+	newRepo := func(compression string) *DB {
+		repo := New(logger, Config{
+			FlushIdleAfter:            60,
+			ObjectsCompression:        compression,
+			RootPath:                  dirName,
+			QueryMaximumResults:       10000,
+			MaxImportGoroutinesFactor: 1,
+		}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil)
+		repo.SetSchemaGetter(schemaGetter)
+		require.Nil(t, repo.WaitForStartup(testCtx()))
+		return repo
+	}
+
+	objectsBucket := func(t *testing.T, repo *DB) *lsmkv.Bucket {
+		idx := repo.GetIndex(schema.ClassName(class.Class))
+		require.NotNil(t, idx)
+		require.Len(t, idx.Shards, 1)
+		for _, shard := range idx.Shards {
+			bucket := shard.store.Bucket(helpers.ObjectsBucketLSM)
+			require.NotNil(t, bucket)
+			return bucket
+		}
+		return nil
+	}
+
+	text := func(i int) string {
+		return strings.Repeat(fmt.Sprintf("a long text of object %d ", i), 100)
+	}
+
+	assertObjects := func(t *testing.T, repo *DB, ids []strfmt.UUID) {
+		for i, id := range ids {
+			res, err := repo.ObjectByID(context.Background(), id, nil,
+				additional.Properties{})
+			require.Nil(t, err)
+			require.NotNil(t, res)
+			assert.Equal(t, text(i), res.Schema.(map[string]interface{})["text"])
+		}
+	}
+
+	ids := make([]strfmt.UUID, 20)
+	for i := range ids {
+		ids[i] = strfmt.UUID(uuid.New().String())
+	}
+
+	repo := newRepo(lsmkv.CompressionZstd)
+
+	t.Run("import into a class with compressed objects", func(t *testing.T) {
+		require.Nil(t, NewMigrator(repo, logger).AddClass(context.Background(),
+			class, shardState))
+		schemaGetter.schema = schema.Schema{
+			Objects: &models.Schema{Classes: []*models.Class{class}},
+		}
+
+		for i, id := range ids {
+			err := repo.PutObject(context.Background(), &models.Object{
+				Class:      class.Class,
+				ID:         id,
+				Properties: map[string]interface{}{"text": text(i)},
+			}, []float32{0.1, 0.2, 0.3}, nil)
+			require.Nil(t, err)
+		}
+
+		bucket := objectsBucket(t, repo)
+		assert.Equal(t, lsmkv.CompressionZstd, bucket.Compression())
+		require.Nil(t, bucket.FlushAndSwitch())
+		assertObjects(t, repo, ids)
+		require.Nil(t, repo.Shutdown(context.Background()))
+	})
+
+	t.Run("restart without compression reads the compressed segments", func(t *testing.T) {
+		repo = newRepo(lsmkv.CompressionNone)
+		defer repo.Shutdown(context.Background())
+
+		assert.Equal(t, lsmkv.CompressionNone, objectsBucket(t, repo).Compression())
+		assertObjects(t, repo, ids)
+	})
+}
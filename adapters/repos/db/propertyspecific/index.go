@@ -39,6 +39,27 @@ func (i Indices) ByProp(propName string) (Index, bool) {
 	return index, ok
 }
 
+// Drop removes the property-specific index of a single prop. It is a no-op if
+// the prop has no such index.
+func (i Indices) Drop(ctx context.Context, propName string) error {
+	index, ok := i[propName]
+	if !ok {
+		return nil
+	}
+
+	if index.Type != schema.DataTypeGeoCoordinates {
+		return errors.Errorf("no implementation to delete property %s index of type %v",
+			propName, index.Type)
+	}
+
+	if err := index.GeoIndex.Drop(ctx); err != nil {
+		return errors.Wrapf(err, "drop property %s", propName)
+	}
+
+	delete(i, propName)
+	return nil
+}
+
 func (i Indices) DropAll(ctx context.Context) error {
 	for propName, index := range i {
 		if index.Type != schema.DataTypeGeoCoordinates {
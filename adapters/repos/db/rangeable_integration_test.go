@@ -0,0 +1,212 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/entities/additional"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	enthnsw "github.com/semi-technologies/weaviate/entities/vectorindex/hnsw"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRangeableIndexJourney(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Class:               "RangeableClass",
+		Properties: []*models.Property{
+			{
+				Name:     "count",
+				DataType: []string{string(schema.DataTypeInt)},
+			},
+			{
+				Name:     "price",
+				DataType: []string{string(schema.DataTypeNumber)},
+			},
+			{
+				Name:     "published",
+				DataType: []string{string(schema.DataTypeDate)},
+			},
+			{
+				Name:     "counts",
+				DataType: []string{string(schema.DataTypeIntArray)},
+			},
+		},
+	}
+	shardState := singleShardState()
+	schemaGetter := &fakeSchemaGetter{shardState: shardState}
+
+	newRepo := func() *DB {
+		repo := New(logger, Config{
+			FlushIdleAfter:            60,
+			RootPath:                  dirName,
+			QueryMaximumResults:       10000,
+			MaxImportGoroutinesFactor: 1,
+		}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil)
+		repo.SetSchemaGetter(schemaGetter)
+		require.Nil(t, repo.WaitForStartup(testCtx()))
+		return repo
+	}
+
+	getShard := func(t *testing.T, repo *DB) *Shard {
+		idx := repo.GetIndex(schema.ClassName(class.Class))
+		require.NotNil(t, idx)
+		require.Len(t, idx.Shards, 1)
+		for _, shard := range idx.Shards {
+			return shard
+		}
+		return nil
+	}
+
+	search := func(t *testing.T, repo *DB, prop string, op filters.Operator,
+		value interface{}, dt schema.DataType,
+	) []int {
+		res, err := repo.ObjectSearch(context.Background(), 0, 100,
+			&filters.LocalFilter{
+				Root: &filters.Clause{
+					Operator: op,
+					Value: &filters.Value{
+						Value: value,
+						Type:  dt,
+					},
+					On: &filters.Path{
+						Class:    schema.ClassName(class.Class),
+						Property: schema.PropertyName(prop),
+					},
+				},
+			}, nil, additional.Properties{})
+		require.Nil(t, err)
+
+		counts := make([]int, len(res))
+		for i, obj := range res {
+			counts[i] = int(obj.Schema.(map[string]interface{})["count"].(float64))
+		}
+		return counts
+	}
+
+	baseTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	ids := make([]strfmt.UUID, 10)
+	for i := range ids {
+		ids[i] = strfmt.UUID(uuid.New().String())
+	}
+
+	assertRanges := func(t *testing.T, repo *DB) {
+		assert.ElementsMatch(t, []int{-3, -4, -5},
+			search(t, repo, "count", filters.OperatorLessThan, -2, schema.DataTypeInt))
+		assert.ElementsMatch(t, []int{2, 3, 4},
+			search(t, repo, "count", filters.OperatorGreaterThanEqual, 2, schema.DataTypeInt))
+		assert.ElementsMatch(t, []int{-5, -4, -3, -2, -1, 0},
+			search(t, repo, "price", filters.OperatorLessThanEqual, 0.5, schema.DataTypeNumber))
+		assert.ElementsMatch(t, []int{3, 4},
+			search(t, repo, "published", filters.OperatorGreaterThan,
+				baseTime.Add(7*time.Hour).Format(time.RFC3339), schema.DataTypeDate))
+	}
+
+	repo := newRepo()
+
+	t.Run("create class and import", func(t *testing.T) {
+		require.Nil(t, NewMigrator(repo, logger).AddClass(context.Background(),
+			class, shardState))
+		schemaGetter.schema = schema.Schema{
+			Objects: &models.Schema{Classes: []*models.Class{class}},
+		}
+
+		for i, id := range ids {
+			err := repo.PutObject(context.Background(), &models.Object{
+				Class: class.Class,
+				ID:    id,
+				Properties: map[string]interface{}{
+					"count":     i - 5,
+					"price":     float64(i-5) + 0.5,
+					"published": baseTime.Add(time.Duration(i) * time.Hour),
+					"counts":    []interface{}{float64(i), float64(i + 1)},
+				},
+			}, []float32{0.1, 0.2, 0.3}, nil)
+			require.Nil(t, err)
+		}
+	})
+
+	t.Run("only single value numeric props have a rangeable index", func(t *testing.T) {
+		shard := getShard(t, repo)
+		for _, prop := range []string{"count", "price", "published"} {
+			assert.NotNil(t, shard.store.Bucket(helpers.BucketRangeableFromPropNameLSM(prop)))
+		}
+		assert.Nil(t, shard.store.Bucket(helpers.BucketRangeableFromPropNameLSM("counts")))
+	})
+
+	t.Run("range filters", func(t *testing.T) {
+		assertRanges(t, repo)
+
+		assert.ElementsMatch(t, []int{3, 4},
+			search(t, repo, "counts", filters.OperatorGreaterThan, 8, schema.DataTypeInt))
+	})
+
+	t.Run("remove the rangeable indexes to simulate existing data", func(t *testing.T) {
+		lsmPath := getShard(t, repo).DBPathLSM()
+		require.Nil(t, repo.Shutdown(context.Background()))
+
+		for _, prop := range []string{"count", "price", "published"} {
+			require.Nil(t, os.RemoveAll(filepath.Join(lsmPath,
+				helpers.BucketRangeableFromPropNameLSM(prop))))
+			require.Nil(t, os.RemoveAll(filepath.Join(lsmPath,
+				helpers.HashBucketRangeableFromPropNameLSM(prop))))
+		}
+	})
+
+	t.Run("restart rebuilds the rangeable indexes", func(t *testing.T) {
+		repo = newRepo()
+		assertRanges(t, repo)
+	})
+
+	t.Run("updates and deletes", func(t *testing.T) {
+		require.Nil(t, repo.DeleteObject(context.Background(), class.Class,
+			ids[0], nil, ""))
+		err := repo.PutObject(context.Background(), &models.Object{
+			Class: class.Class,
+			ID:    ids[9],
+			Properties: map[string]interface{}{
+				"count":     -10,
+				"price":     -9.5,
+				"published": baseTime.Add(-time.Hour),
+			},
+		}, []float32{0.1, 0.2, 0.3}, nil)
+		require.Nil(t, err)
+
+		assert.ElementsMatch(t, []int{-10, -4, -3},
+			search(t, repo, "count", filters.OperatorLessThan, -2, schema.DataTypeInt))
+		assert.ElementsMatch(t, []int{2, 3},
+			search(t, repo, "count", filters.OperatorGreaterThanEqual, 2, schema.DataTypeInt))
+		assert.ElementsMatch(t, []int{-10},
+			search(t, repo, "published", filters.OperatorLessThan,
+				baseTime.Format(time.RFC3339), schema.DataTypeDate))
+		require.Nil(t, repo.Shutdown(context.Background()))
+	})
+}
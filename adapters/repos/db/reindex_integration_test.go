@@ -0,0 +1,537 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/flat"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	flatent "github.com/semi-technologies/weaviate/entities/vectorindex/flat"
+	enthnsw "github.com/semi-technologies/weaviate/entities/vectorindex/hnsw"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReindex(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo := New(logger, Config{
+		FlushIdleAfter:            60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+	migrator := NewMigrator(repo, logger)
+
+	class := &models.Class{
+		Class:               "ReindexTest",
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:         "title",
+				DataType:     []string{string(schema.DataTypeText)},
+				Tokenization: "whitespace",
+			},
+		},
+	}
+
+	titles := map[strfmt.UUID]string{
+		"8d5a3aa2-3c8d-4589-9ae1-3f638f506970": "Hello World",
+		"86a380e9-cb60-4b2a-bc48-51f52acd72d6": "hello there",
+		"c7b7a3b4-3e7e-4c0d-8a0b-0c6a1a3f2b11": "Goodbye",
+	}
+
+	putObject := func(t *testing.T, id strfmt.UUID, title string) {
+		err := repo.PutObject(context.Background(), &models.Object{
+			Class:      class.Class,
+			ID:         id,
+			Properties: map[string]interface{}{"title": title},
+		}, []float32{1, 2, 3}, nil)
+		require.Nil(t, err)
+	}
+
+	t.Run("import objects", func(t *testing.T) {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+		schemaGetter.schema.Objects = &models.Schema{
+			Classes: []*models.Class{class},
+		}
+
+		for id, title := range titles {
+			putObject(t, id, title)
+		}
+	})
+
+	shard := func() *Shard {
+		idx := repo.GetIndex(schema.ClassName(class.Class))
+		require.NotNil(t, idx)
+		require.Len(t, idx.Shards, 1)
+		for _, shard := range idx.Shards {
+			return shard
+		}
+		return nil
+	}
+
+	reindexStatus := func(t *testing.T) *models.ReindexShardStatus {
+		statuses, err := migrator.ReindexStatus(context.Background(), class.Class)
+		require.Nil(t, err)
+		require.Len(t, statuses, 1)
+		return statuses[0]
+	}
+
+	searchHello := func(t *testing.T) []strfmt.UUID {
+		res, err := repo.ClassSearch(context.Background(), traverser.GetParams{
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: 10},
+			Filters: &filters.LocalFilter{
+				Root: &filters.Clause{
+					Operator: filters.OperatorEqual,
+					On: &filters.Path{
+						Class:    schema.ClassName(class.Class),
+						Property: "title",
+					},
+					Value: &filters.Value{
+						Value: "hello",
+						Type:  schema.DataTypeText,
+					},
+				},
+			},
+		})
+		require.Nil(t, err)
+
+		ids := make([]strfmt.UUID, len(res))
+		for i := range res {
+			ids[i] = res[i].ID
+		}
+		return ids
+	}
+
+	shadowBucket := helpers.BucketFromPropNameLSM(reindexShadowName("title"))
+
+	t.Run("the whitespace tokenization is case sensitive", func(t *testing.T) {
+		assert.Equal(t, []strfmt.UUID{"86a380e9-cb60-4b2a-bc48-51f52acd72d6"}, searchHello(t))
+	})
+
+	wordProp := &models.Property{
+		Name:         "title",
+		DataType:     []string{string(schema.DataTypeText)},
+		Tokenization: "word",
+	}
+
+	t.Run("start a job to tokenize by word and to switch to a flat index", func(t *testing.T) {
+		err := migrator.StartReindex(context.Background(), class.Class, "job-1",
+			[]*models.Property{wordProp}, flatent.NewDefaultUserConfig())
+		require.Nil(t, err)
+
+		assert.NotNil(t, shard().store.Bucket(shadowBucket))
+	})
+
+	t.Run("wait for the shard to be ready", func(t *testing.T) {
+		require.Eventually(t, func() bool {
+			return reindexStatus(t).Status == models.ReindexShardStatusStatusREADY
+		}, 5*time.Second, 10*time.Millisecond)
+
+		assert.Equal(t, &models.ReindexShardStatus{
+			JobID:            "job-1",
+			Status:           models.ReindexShardStatusStatusREADY,
+			ObjectCount:      int64(len(titles)),
+			ObjectsProcessed: int64(len(titles)),
+		}, reindexStatus(t))
+	})
+
+	t.Run("import an object while the job is ready", func(t *testing.T) {
+		titles["f6b2c1d3-6a0e-4b5f-9d3c-2e7a8b9c0d1e"] = "hello again"
+		putObject(t, "f6b2c1d3-6a0e-4b5f-9d3c-2e7a8b9c0d1e", "hello again")
+
+		// the current index still uses the whitespace tokenization
+		assert.ElementsMatch(t, []strfmt.UUID{
+			"86a380e9-cb60-4b2a-bc48-51f52acd72d6",
+			"f6b2c1d3-6a0e-4b5f-9d3c-2e7a8b9c0d1e",
+		}, searchHello(t))
+	})
+
+	t.Run("commit the job", func(t *testing.T) {
+		err := migrator.CommitReindex(context.Background(), class.Class, "job-1")
+		require.Nil(t, err)
+
+		assert.Equal(t, models.ReindexShardStatusStatusSUCCESS, reindexStatus(t).Status)
+		assert.Nil(t, shard().store.Bucket(shadowBucket))
+
+		// the replaced index is kept until the schema has been saved
+		previousDir := filepath.Join(shard().DBPathLSM(),
+			reindexPreviousName(helpers.BucketFromPropNameLSM("title")))
+		assert.DirExists(t, previousDir)
+
+		// until then, writes use the new settings regardless of the schema
+		titles["0b9f3c1e-5d2a-4e7b-8c6f-1a2b3c4d5e6f"] = "HELLO before the schema was saved"
+		putObject(t, "0b9f3c1e-5d2a-4e7b-8c6f-1a2b3c4d5e6f", "HELLO before the schema was saved")
+
+		class.Properties = []*models.Property{wordProp}
+		class.VectorIndexType = "flat"
+		class.VectorIndexConfig = flatent.NewDefaultUserConfig()
+
+		err = migrator.CleanupReindex(context.Background(), class.Class, "job-1")
+		require.Nil(t, err)
+		assert.NoDirExists(t, previousDir)
+		assert.NoFileExists(t, shard().reindexCommitPath())
+	})
+
+	t.Run("the rebuilt inverted index is case insensitive", func(t *testing.T) {
+		assert.ElementsMatch(t, []strfmt.UUID{
+			"8d5a3aa2-3c8d-4589-9ae1-3f638f506970",
+			"86a380e9-cb60-4b2a-bc48-51f52acd72d6",
+			"f6b2c1d3-6a0e-4b5f-9d3c-2e7a8b9c0d1e",
+			"0b9f3c1e-5d2a-4e7b-8c6f-1a2b3c4d5e6f",
+		}, searchHello(t))
+	})
+
+	t.Run("the rebuilt vector index is flat and contains all objects", func(t *testing.T) {
+		assert.IsType(t, &flat.Index{}, shard().defaultVectorIndex())
+
+		res, err := repo.VectorClassSearch(context.Background(), traverser.GetParams{
+			ClassName:    class.Class,
+			SearchVector: []float32{1, 2, 3},
+			Pagination:   &filters.Pagination{Limit: 10},
+		})
+		require.Nil(t, err)
+		assert.Len(t, res, len(titles))
+	})
+
+	t.Run("cancel a job", func(t *testing.T) {
+		whitespaceProp := *wordProp
+		whitespaceProp.Tokenization = "whitespace"
+
+		err := migrator.StartReindex(context.Background(), class.Class, "job-2",
+			[]*models.Property{&whitespaceProp}, nil)
+		require.Nil(t, err)
+		assert.NotNil(t, shard().store.Bucket(shadowBucket))
+
+		err = migrator.CancelReindex(context.Background(), class.Class, "job-2")
+		require.Nil(t, err)
+
+		status := reindexStatus(t)
+		assert.Equal(t, "job-2", status.JobID)
+		assert.Contains(t, []string{
+			models.ReindexShardStatusStatusCANCELLED,
+			// the job might have been done before it was cancelled
+			models.ReindexShardStatusStatusREADY,
+		}, status.Status)
+		assert.Nil(t, shard().store.Bucket(shadowBucket))
+	})
+
+	t.Run("the current indexes are not affected by the cancelled job", func(t *testing.T) {
+		assert.Len(t, searchHello(t), 4)
+	})
+}
+
+func TestReindexDataType(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo := New(logger, Config{
+		FlushIdleAfter:            60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+	migrator := NewMigrator(repo, logger)
+
+	class := &models.Class{
+		Class:               "ReindexDataTypeTest",
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:     "count",
+				DataType: []string{string(schema.DataTypeInt)},
+			},
+		},
+	}
+
+	counts := map[strfmt.UUID]int64{
+		"8d5a3aa2-3c8d-4589-9ae1-3f638f506970": 1,
+		"86a380e9-cb60-4b2a-bc48-51f52acd72d6": 5,
+		"c7b7a3b4-3e7e-4c0d-8a0b-0c6a1a3f2b11": 10,
+	}
+
+	t.Run("import objects", func(t *testing.T) {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+		schemaGetter.schema.Objects = &models.Schema{
+			Classes: []*models.Class{class},
+		}
+
+		for id, count := range counts {
+			err := repo.PutObject(context.Background(), &models.Object{
+				Class:      class.Class,
+				ID:         id,
+				Properties: map[string]interface{}{"count": count},
+			}, []float32{1, 2, 3}, nil)
+			require.Nil(t, err)
+		}
+	})
+
+	numberProp := &models.Property{
+		Name:     "count",
+		DataType: []string{string(schema.DataTypeNumber)},
+	}
+
+	t.Run("change the data type to number", func(t *testing.T) {
+		err := migrator.StartReindex(context.Background(), class.Class, "job-1",
+			[]*models.Property{numberProp}, nil)
+		require.Nil(t, err)
+
+		require.Eventually(t, func() bool {
+			statuses, err := migrator.ReindexStatus(context.Background(), class.Class)
+			require.Nil(t, err)
+			require.Len(t, statuses, 1)
+			return statuses[0].Status == models.ReindexShardStatusStatusREADY
+		}, 5*time.Second, 10*time.Millisecond)
+
+		class.Properties = []*models.Property{numberProp}
+		err = migrator.CommitReindex(context.Background(), class.Class, "job-1")
+		require.Nil(t, err)
+	})
+
+	t.Run("filter by a number", func(t *testing.T) {
+		res, err := repo.ClassSearch(context.Background(), traverser.GetParams{
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: 10},
+			Filters: &filters.LocalFilter{
+				Root: &filters.Clause{
+					Operator: filters.OperatorGreaterThan,
+					On: &filters.Path{
+						Class:    schema.ClassName(class.Class),
+						Property: "count",
+					},
+					Value: &filters.Value{
+						Value: 2.5,
+						Type:  schema.DataTypeNumber,
+					},
+				},
+			},
+		})
+		require.Nil(t, err)
+
+		ids := make([]strfmt.UUID, len(res))
+		for i := range res {
+			ids[i] = res[i].ID
+		}
+		assert.ElementsMatch(t, []strfmt.UUID{
+			"86a380e9-cb60-4b2a-bc48-51f52acd72d6",
+			"c7b7a3b4-3e7e-4c0d-8a0b-0c6a1a3f2b11",
+		}, ids)
+	})
+}
+
+func TestReindexInterruptedSwap(t *testing.T) {
+	wordProp := &models.Property{
+		Name:         "title",
+		DataType:     []string{string(schema.DataTypeText)},
+		Tokenization: "word",
+	}
+
+	titles := map[strfmt.UUID]string{
+		"8d5a3aa2-3c8d-4589-9ae1-3f638f506970": "Hello World",
+		"86a380e9-cb60-4b2a-bc48-51f52acd72d6": "hello there",
+		"c7b7a3b4-3e7e-4c0d-8a0b-0c6a1a3f2b11": "Goodbye",
+	}
+
+	tests := []struct {
+		name        string
+		schemaSaved bool
+		// expected results of a search for "hello"
+		expected    []strfmt.UUID
+		flatVectors bool
+	}{
+		{
+			name:        "the schema was not saved, so the swap is undone",
+			schemaSaved: false,
+			expected:    []strfmt.UUID{"86a380e9-cb60-4b2a-bc48-51f52acd72d6"},
+			flatVectors: false,
+		},
+		{
+			name:        "the schema was saved, so the swap is finished",
+			schemaSaved: true,
+			expected: []strfmt.UUID{
+				"8d5a3aa2-3c8d-4589-9ae1-3f638f506970",
+				"86a380e9-cb60-4b2a-bc48-51f52acd72d6",
+			},
+			flatVectors: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dirName := t.TempDir()
+
+			logger := logrus.New()
+			schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+			newRepo := func() *DB {
+				repo := New(logger, Config{
+					FlushIdleAfter:            60,
+					RootPath:                  dirName,
+					QueryMaximumResults:       10000,
+					MaxImportGoroutinesFactor: 1,
+				}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil)
+				repo.SetSchemaGetter(schemaGetter)
+				require.Nil(t, repo.WaitForStartup(testCtx()))
+				return repo
+			}
+			repo := newRepo()
+			defer func() { repo.Shutdown(context.Background()) }()
+			migrator := NewMigrator(repo, logger)
+
+			class := &models.Class{
+				Class:               "ReindexInterruptedTest",
+				VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+				InvertedIndexConfig: invertedConfig(),
+				Properties: []*models.Property{
+					{
+						Name:         "title",
+						DataType:     []string{string(schema.DataTypeText)},
+						Tokenization: "whitespace",
+					},
+				},
+			}
+
+			require.Nil(t,
+				migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+			schemaGetter.schema.Objects = &models.Schema{
+				Classes: []*models.Class{class},
+			}
+
+			for id, title := range titles {
+				err := repo.PutObject(context.Background(), &models.Object{
+					Class:      class.Class,
+					ID:         id,
+					Properties: map[string]interface{}{"title": title},
+				}, []float32{1, 2, 3}, nil)
+				require.Nil(t, err)
+			}
+
+			shard := func() *Shard {
+				idx := repo.GetIndex(schema.ClassName(class.Class))
+				require.NotNil(t, idx)
+				require.Len(t, idx.Shards, 1)
+				for _, shard := range idx.Shards {
+					return shard
+				}
+				return nil
+			}
+
+			err := migrator.StartReindex(context.Background(), class.Class, "job-1",
+				[]*models.Property{wordProp}, flatent.NewDefaultUserConfig())
+			require.Nil(t, err)
+
+			require.Eventually(t, func() bool {
+				statuses, err := migrator.ReindexStatus(context.Background(), class.Class)
+				require.Nil(t, err)
+				require.Len(t, statuses, 1)
+				return statuses[0].Status == models.ReindexShardStatusStatusREADY
+			}, 5*time.Second, 10*time.Millisecond)
+
+			require.Nil(t,
+				migrator.CommitReindex(context.Background(), class.Class, "job-1"))
+
+			lsmDir := shard().DBPathLSM()
+			commitPath := shard().reindexCommitPath()
+			require.Nil(t, repo.Shutdown(context.Background()))
+
+			// move the rebuilt bucket back to its shadow, which leaves the
+			// files as they are after a crash between the two renames of
+			// ReplaceBucket: no bucket in place, the previous one moved aside
+			bucket := helpers.BucketFromPropNameLSM("title")
+			require.Nil(t, os.Rename(filepath.Join(lsmDir, bucket),
+				filepath.Join(lsmDir, helpers.BucketFromPropNameLSM(reindexShadowName("title")))))
+			require.DirExists(t, filepath.Join(lsmDir, reindexPreviousName(bucket)))
+			require.FileExists(t, commitPath)
+
+			if test.schemaSaved {
+				class.Properties = []*models.Property{wordProp}
+				class.VectorIndexType = "flat"
+				class.VectorIndexConfig = flatent.NewDefaultUserConfig()
+			}
+
+			repo = newRepo()
+
+			res, err := repo.ClassSearch(context.Background(), traverser.GetParams{
+				ClassName:  class.Class,
+				Pagination: &filters.Pagination{Limit: 10},
+				Filters: &filters.LocalFilter{
+					Root: &filters.Clause{
+						Operator: filters.OperatorEqual,
+						On: &filters.Path{
+							Class:    schema.ClassName(class.Class),
+							Property: "title",
+						},
+						Value: &filters.Value{
+							Value: "hello",
+							Type:  schema.DataTypeText,
+						},
+					},
+				},
+			})
+			require.Nil(t, err)
+
+			ids := make([]strfmt.UUID, len(res))
+			for i := range res {
+				ids[i] = res[i].ID
+			}
+			assert.ElementsMatch(t, test.expected, ids)
+
+			_, isFlat := shard().defaultVectorIndex().(*flat.Index)
+			assert.Equal(t, test.flatVectors, isFlat)
+
+			res, err = repo.VectorClassSearch(context.Background(), traverser.GetParams{
+				ClassName:    class.Class,
+				SearchVector: []float32{1, 2, 3},
+				Pagination:   &filters.Pagination{Limit: 10},
+			})
+			require.Nil(t, err)
+			assert.Len(t, res, len(titles))
+
+			entries, err := os.ReadDir(lsmDir)
+			require.Nil(t, err)
+			for _, entry := range entries {
+				assert.False(t, strings.HasSuffix(entry.Name(), reindexPreviousSuffix) ||
+					strings.Contains(entry.Name(), reindexShadowSuffix), entry.Name())
+			}
+			assert.NoFileExists(t, commitPath)
+		})
+	}
+}
@@ -77,8 +77,10 @@ type Config struct {
 	ResourceUsage                    config.ResourceUsage
 	MaxImportGoroutinesFactor        float64
 	FlushIdleAfter                   int
+	ObjectsCompression               string
 	TrackVectorDimensions            bool
 	ReindexVectorDimensionsAtStartup bool
+	IndexFilterableRoaringSet        bool
 	ServerVersion                    string
 	GitHash                          string
 }
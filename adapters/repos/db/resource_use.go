@@ -56,6 +56,7 @@ func (d *DB) scanResourceUsage() {
 			case <-t.C:
 				d.indexLock.Lock()
 				for _, i := range d.indices {
+					i.shardsLock.RLock()
 					for _, s := range i.Shards {
 						if !s.isReadOnly() {
 							diskPath := i.Config.RootPath
@@ -65,6 +66,7 @@ func (d *DB) scanResourceUsage() {
 							s.resourceUseReadonly(memMonitor, du)
 						}
 					}
+					i.shardsLock.RUnlock()
 				}
 				d.indexLock.Unlock()
 			}
@@ -82,7 +82,7 @@ func TestRestartJourney(t *testing.T) {
 				"description": "the band is just fantastic that is really what I think",
 			},
 		},
-			[]float32{0.1, 0.2, 0.3})
+			[]float32{0.1, 0.2, 0.3}, nil)
 		require.Nil(t, err)
 
 		err = repo.PutObject(context.Background(), &models.Object{
@@ -92,7 +92,7 @@ func TestRestartJourney(t *testing.T) {
 				"description": "oh by the way, which one's pink?",
 			},
 		},
-			[]float32{-0.1, 0.2, -0.3})
+			[]float32{-0.1, 0.2, -0.3}, nil)
 		require.Nil(t, err)
 	})
 
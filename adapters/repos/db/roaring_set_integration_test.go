@@ -0,0 +1,164 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/lsmkv"
+	"github.com/semi-technologies/weaviate/entities/additional"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	enthnsw "github.com/semi-technologies/weaviate/entities/vectorindex/hnsw"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoaringSetMigrationJourney(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Class:               "RoaringSetClass",
+		Properties: []*models.Property{
+			{
+				Name:     "count",
+				DataType: []string{string(schema.DataTypeInt)},
+			},
+		},
+	}
+	shardState := singleShardState()
+	schemaGetter := &fakeSchemaGetter{shardState: shardState}
+
+	newRepo := func(roaringSet bool) *DB {
+		repo := New(logger, Config{
+			FlushIdleAfter:            60,
+			RootPath:                  dirName,
+			QueryMaximumResults:       10000,
+			MaxImportGoroutinesFactor: 1,
+			IndexFilterableRoaringSet: roaringSet,
+		}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil)
+		repo.SetSchemaGetter(schemaGetter)
+		require.Nil(t, repo.WaitForStartup(testCtx()))
+		return repo
+	}
+
+	bucketStrategy := func(t *testing.T, repo *DB) string {
+		idx := repo.GetIndex(schema.ClassName(class.Class))
+		require.NotNil(t, idx)
+		require.Len(t, idx.Shards, 1)
+		for _, shard := range idx.Shards {
+			bucket := shard.store.Bucket(helpers.BucketFromPropNameLSM("count"))
+			require.NotNil(t, bucket)
+			return bucket.Strategy()
+		}
+		return ""
+	}
+
+	search := func(t *testing.T, repo *DB, op filters.Operator, value int) []int {
+		res, err := repo.ObjectSearch(context.Background(), 0, 100,
+			&filters.LocalFilter{
+				Root: &filters.Clause{
+					Operator: op,
+					Value: &filters.Value{
+						Value: value,
+						Type:  schema.DataTypeInt,
+					},
+					On: &filters.Path{
+						Class:    schema.ClassName(class.Class),
+						Property: "count",
+					},
+				},
+			}, nil, additional.Properties{})
+		require.Nil(t, err)
+
+		counts := make([]int, len(res))
+		for i, obj := range res {
+			counts[i] = int(obj.Schema.(map[string]interface{})["count"].(float64))
+		}
+		return counts
+	}
+
+	ids := make([]strfmt.UUID, 10)
+	for i := range ids {
+		ids[i] = strfmt.UUID(uuid.New().String())
+	}
+
+	repo := newRepo(false)
+
+	t.Run("create class and import with the set strategy", func(t *testing.T) {
+		require.Nil(t, NewMigrator(repo, logger).AddClass(context.Background(),
+			class, shardState))
+		schemaGetter.schema = schema.Schema{
+			Objects: &models.Schema{Classes: []*models.Class{class}},
+		}
+
+		for i, id := range ids {
+			err := repo.PutObject(context.Background(), &models.Object{
+				Class:      class.Class,
+				ID:         id,
+				Properties: map[string]interface{}{"count": i % 5},
+			}, []float32{0.1, 0.2, 0.3}, nil)
+			require.Nil(t, err)
+		}
+
+		assert.Equal(t, lsmkv.StrategySetCollection, bucketStrategy(t, repo))
+		assert.ElementsMatch(t, []int{3, 3}, search(t, repo, filters.OperatorEqual, 3))
+		require.Nil(t, repo.Shutdown(context.Background()))
+	})
+
+	t.Run("restart with roaring sets enabled migrates the bucket", func(t *testing.T) {
+		repo = newRepo(true)
+
+		assert.Equal(t, lsmkv.StrategyRoaringSet, bucketStrategy(t, repo))
+		assert.ElementsMatch(t, []int{3, 3}, search(t, repo, filters.OperatorEqual, 3))
+		assert.ElementsMatch(t, []int{0, 0, 1, 1, 2, 2, 3, 3},
+			search(t, repo, filters.OperatorNotEqual, 4))
+		assert.ElementsMatch(t, []int{3, 3, 4, 4},
+			search(t, repo, filters.OperatorGreaterThanEqual, 3))
+	})
+
+	t.Run("updates and deletes after the migration", func(t *testing.T) {
+		require.Nil(t, repo.DeleteObject(context.Background(), class.Class,
+			ids[3], nil, ""))
+		err := repo.PutObject(context.Background(), &models.Object{
+			Class:      class.Class,
+			ID:         ids[8],
+			Properties: map[string]interface{}{"count": 1},
+		}, []float32{0.1, 0.2, 0.3}, nil)
+		require.Nil(t, err)
+
+		assert.ElementsMatch(t, []int{1, 1, 1}, search(t, repo, filters.OperatorEqual, 1))
+		assert.ElementsMatch(t, []int(nil), search(t, repo, filters.OperatorEqual, 3))
+		require.Nil(t, repo.Shutdown(context.Background()))
+	})
+
+	t.Run("restart with roaring sets disabled keeps the roaring set", func(t *testing.T) {
+		repo = newRepo(false)
+		defer repo.Shutdown(context.Background())
+
+		assert.Equal(t, lsmkv.StrategyRoaringSet, bucketStrategy(t, repo))
+		assert.ElementsMatch(t, []int{1, 1, 1}, search(t, repo, filters.OperatorEqual, 1))
+		assert.ElementsMatch(t, []int{4, 4}, search(t, repo, filters.OperatorGreaterThan, 3))
+	})
+}
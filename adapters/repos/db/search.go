@@ -62,12 +62,26 @@ func (db *DB) ClassSearch(ctx context.Context,
 		return nil, errors.Wrapf(err, "invalid pagination params")
 	}
 
-	res, err := idx.objectSearch(ctx, totalLimit, params.Filters,
-		params.KeywordRanking, params.Sort, params.AdditionalProperties)
+	// the page size of a cursor is the resolved limit, which has the default
+	// limit applied if the user did not set one
+	cursor := params.Cursor
+	if cursor != nil {
+		cursor = &filters.Cursor{After: cursor.After, Limit: totalLimit}
+	}
+
+	res, scores, err := idx.objectSearch(ctx, totalLimit, params.Filters,
+		params.KeywordRanking, params.Sort, cursor, params.AdditionalProperties,
+		params.ReplicationProperties, params.Tenant)
 	if err != nil {
 		return nil, errors.Wrapf(err, "object search at index %s", idx.ID())
 	}
 
+	if scores != nil {
+		return db.enrichRefsForList(ctx,
+			storobj.SearchResultsWithScore(db.getStoreObjects(res, params.Pagination), params.AdditionalProperties,
+				db.getDists(scores, params.Pagination)), params.Properties, params.AdditionalProperties)
+	}
+
 	return db.enrichRefsForList(ctx,
 		storobj.SearchResults(db.getStoreObjects(res, params.Pagination), params.AdditionalProperties),
 		params.Properties, params.AdditionalProperties)
@@ -91,8 +105,10 @@ func (db *DB) VectorClassSearch(ctx context.Context,
 	}
 
 	targetDist := extractDistanceFromParams(params)
-	res, dists, err := idx.objectVectorSearch(ctx, params.SearchVector, targetDist,
-		totalLimit, params.Filters, params.Sort, params.AdditionalProperties)
+	res, dists, err := idx.objectVectorSearch(ctx, params.SearchVector,
+		params.TargetVector, targetDist,
+		totalLimit, params.Filters, params.Sort, params.AdditionalProperties,
+		params.ReplicationProperties, params.Tenant)
 	if err != nil {
 		return nil, errors.Wrapf(err, "object vector search at index %s", idx.ID())
 	}
@@ -106,6 +122,35 @@ func (db *DB) VectorClassSearch(ctx context.Context,
 			db.getDists(dists, params.Pagination)), params.Properties, params.AdditionalProperties)
 }
 
+func (db *DB) HybridClassSearch(ctx context.Context,
+	params traverser.GetParams,
+) ([]search.Result, error) {
+	if params.HybridSearch == nil {
+		return nil, fmt.Errorf("invalid params, hybrid search params are nil")
+	}
+
+	totalLimit, err := db.getTotalLimit(params.Pagination, params.AdditionalProperties)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid pagination params")
+	}
+
+	idx := db.GetIndex(schema.ClassName(params.ClassName))
+	if idx == nil {
+		return nil, fmt.Errorf("tried to browse non-existing index for %s", params.ClassName)
+	}
+
+	res, scores, err := idx.objectHybridSearch(ctx, totalLimit, params.HybridSearch,
+		params.SearchVector, params.TargetVector, params.AdditionalProperties,
+		params.ReplicationProperties, params.Tenant)
+	if err != nil {
+		return nil, errors.Wrapf(err, "object hybrid search at index %s", idx.ID())
+	}
+
+	return db.enrichRefsForList(ctx,
+		storobj.SearchResultsWithScore(db.getStoreObjects(res, params.Pagination), params.AdditionalProperties,
+			db.getDists(scores, params.Pagination)), params.Properties, params.AdditionalProperties)
+}
+
 func extractDistanceFromParams(params traverser.GetParams) float32 {
 	certainty := traverser.ExtractCertaintyFromParams(params)
 	if certainty != 0 {
@@ -128,12 +173,17 @@ func (db *DB) VectorSearch(ctx context.Context, vector []float32, offset, limit
 
 	db.indexLock.Lock()
 	for _, index := range db.indices {
+		if index.partitioningEnabled() {
+			// a multi-tenant class can only be searched with a tenant
+			continue
+		}
+
 		wg.Add(1)
 		go func(index *Index, wg *sync.WaitGroup) {
 			defer wg.Done()
 
 			objs, dist, err := index.objectVectorSearch(
-				ctx, vector, 0, totalLimit, filters, nil, additional.Properties{})
+				ctx, vector, "", 0, totalLimit, filters, nil, additional.Properties{}, nil, "")
 			if err != nil {
 				mutex.Lock()
 				searchErrors = append(searchErrors, errors.Wrapf(err, "search index %s", index.ID()))
@@ -191,11 +241,20 @@ func (d *DB) Query(ctx context.Context, q *objects.QueryInput) (search.Results,
 	if idx == nil {
 		return nil, &objects.Error{Msg: "class not found " + q.Class, Code: objects.StatusNotFound}
 	}
-	res, err := idx.objectSearch(ctx, totalLimit, q.Filters, nil, q.Sort, q.Additional)
+	res, _, err := idx.objectSearch(ctx, totalLimit, q.Filters, nil, q.Sort, q.Cursor,
+		q.Additional, nil, q.Tenant)
 	if err != nil {
-		return nil, &objects.Error{Msg: "search index " + idx.ID(), Code: objects.StatusInternalServerError, Err: err}
+		code := objects.StatusInternalServerError
+		if errors.As(err, &objects.ErrMultiTenancy{}) {
+			code = objects.StatusBadRequest
+		}
+		return nil, &objects.Error{Msg: "search index " + idx.ID(), Code: code, Err: err}
 	}
-	return d.getSearchResults(storobj.SearchResults(res, q.Additional), q.Offset, q.Limit), nil
+	results := d.getSearchResults(storobj.SearchResults(res, q.Additional), q.Offset, q.Limit)
+	for i := range results {
+		results[i].Tenant = q.Tenant
+	}
+	return results, nil
 }
 
 // ObjectSearch search each index.
@@ -221,8 +280,13 @@ func (d *DB) objectSearch(ctx context.Context, offset, limit int,
 	// painfully slow on large schemas
 	d.indexLock.Lock()
 	for _, index := range d.indices {
+		if index.partitioningEnabled() {
+			// a multi-tenant class can only be searched with a tenant
+			continue
+		}
+
 		// TODO support all additional props
-		res, err := index.objectSearch(ctx, totalLimit, filters, nil, sort, additional)
+		res, _, err := index.objectSearch(ctx, totalLimit, filters, nil, sort, nil, additional, nil, "")
 		if err != nil {
 			d.indexLock.Unlock()
 			return nil, errors.Wrapf(err, "search index %s", index.ID())
@@ -28,6 +28,7 @@ import (
 	"github.com/semi-technologies/weaviate/adapters/repos/db/inverted"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/lsmkv"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/propertyspecific"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/flat"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw/distancer"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/noop"
@@ -36,6 +37,7 @@ import (
 	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/entities/storagestate"
 	"github.com/semi-technologies/weaviate/entities/storobj"
+	flatent "github.com/semi-technologies/weaviate/entities/vectorindex/flat"
 	hnswent "github.com/semi-technologies/weaviate/entities/vectorindex/hnsw"
 	"github.com/semi-technologies/weaviate/usecases/monitoring"
 	"github.com/sirupsen/logrus"
@@ -52,6 +54,7 @@ type Shard struct {
 	store             *lsmkv.Store
 	counter           *indexcounter.Counter
 	vectorIndex       VectorIndex
+	vectorIndexes     map[string]VectorIndex // named vectors, see initNamedVectorIndexes
 	invertedRowCache  *inverted.RowCacher
 	metrics           *Metrics
 	promMetrics       *monitoring.PrometheusMetrics
@@ -59,10 +62,20 @@ type Shard struct {
 	deletedDocIDs     *docid.InMemDeletedTracker
 	cleanupInterval   time.Duration
 	propLengths       *inverted.PropertyLengthTracker
+	propertyDeletions *propertyDeletions
 	randomSource      *bufferedRandomGen
 	versioner         *shardVersioner
 	resourceScanState *resourceScanState
 
+	// reindex is the current or last reindex job of the shard. Reads and
+	// writes hold the reindexLock for reading, so that the indexes cannot be
+	// swapped or dropped while they are in use.
+	reindex     *reindexJob
+	reindexLock sync.RWMutex
+	// vectorIndexLock guards the vectorIndex, which is replaced when a
+	// reindex job is committed
+	vectorIndexLock sync.RWMutex
+
 	numActiveBatches    int
 	activeBatchesLock   sync.Mutex
 	jobQueueCh          chan job
@@ -120,67 +133,21 @@ func NewShard(ctx context.Context, promMetrics *monitoring.PrometheusMetrics,
 
 	defer s.metrics.ShardStartup(before)
 
-	hnswUserConfig, ok := index.vectorIndexUserConfig.(hnswent.UserConfig)
-	if !ok {
-		return nil, errors.Errorf("hnsw vector index: config is not hnsw.UserConfig: %T",
-			index.vectorIndexUserConfig)
+	if err := s.recoverReindex(); err != nil {
+		return nil, errors.Wrapf(err, "init shard %q: recover reindex job", s.ID())
 	}
 
-	if hnswUserConfig.Skip {
-		s.vectorIndex = noop.NewIndex()
-	} else {
-		var distProv distancer.Provider
-
-		switch hnswUserConfig.Distance {
-		case "", hnswent.DistanceCosine:
-			distProv = distancer.NewCosineDistanceProvider()
-		case hnswent.DistanceDot:
-			distProv = distancer.NewDotProductProvider()
-		case hnswent.DistanceL2Squared:
-			distProv = distancer.NewL2SquaredProvider()
-		case hnswent.DistanceManhattan:
-			distProv = distancer.NewManhattanProvider()
-		case hnswent.DistanceHamming:
-			distProv = distancer.NewHammingProvider()
-		default:
-			return nil, errors.Errorf("unrecognized distance metric %q,"+
-				"choose one of [\"cosine\", \"dot\", \"l2-squared\", \"manhattan\",\"hamming\"]", hnswUserConfig.Distance)
-		}
-
-		vi, err := hnsw.New(hnsw.Config{
-			Logger:            index.logger,
-			RootPath:          s.index.Config.RootPath,
-			ID:                s.ID(),
-			ShardName:         s.name,
-			ClassName:         s.index.Config.ClassName.String(),
-			PrometheusMetrics: s.promMetrics,
-			MakeCommitLoggerThunk: func() (hnsw.CommitLogger, error) {
-				// Previously we had an interval of 10s in here, which was changed to
-				// 0.5s as part of gh-1867. There's really no way to wait so long in
-				// between checks: If you are running on a low-powered machine, the
-				// interval will simply find that there is no work and do nothing in
-				// each iteration. However, if you are running on a very powerful
-				// machine within 10s you could have potentially created two units of
-				// work, but we'll only be handling one every 10s. This means
-				// uncombined/uncondensed hnsw commit logs will keep piling up can only
-				// be processes long after the initial insert is complete. This also
-				// means that if there is a crash during importing a lot of work needs
-				// to be done at startup, since the commit logs still contain too many
-				// redundancies. So as of now it seems there are only advantages to
-				// running the cleanup checks and work much more often.
-				return hnsw.NewCommitLogger(s.index.Config.RootPath, s.ID(), 500*time.Millisecond,
-					index.logger)
-			},
-			VectorForIDThunk: s.vectorByIndexID,
-			DistanceProvider: distProv,
-		}, hnswUserConfig)
-		if err != nil {
-			return nil, errors.Wrapf(err, "init shard %q: hnsw index", s.ID())
-		}
-		s.vectorIndex = vi
+	vi, err := s.initVectorIndex(s.ID(), index.vectorIndexUserConfig,
+		s.vectorByIndexID, s.iterateVectors)
+	if err != nil {
+		return nil, err
+	}
+	s.vectorIndex = vi
 
-		defer vi.PostStartup()
+	if err := s.initNamedVectorIndexes(); err != nil {
+		return nil, err
 	}
+	defer s.postStartupVectorIndexes()
 
 	err = s.initDBFile(ctx)
 	if err != nil {
@@ -212,11 +179,111 @@ func NewShard(ctx context.Context, promMetrics *monitoring.PrometheusMetrics,
 		return nil, errors.Wrapf(err, "init shard %q: init per property indices", s.ID())
 	}
 
+	pdPath := path.Join(index.Config.RootPath, s.ID()+".propdeletions")
+	propertyDeletions, err := newPropertyDeletions(pdPath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "init shard %q: property deletions", s.ID())
+	}
+	s.propertyDeletions = propertyDeletions
+	s.startPropertyDeletions()
+
 	s.initDimensionTracking()
 
 	return s, nil
 }
 
+func distancerProvider(distance string) (distancer.Provider, error) {
+	switch distance {
+	case "", hnswent.DistanceCosine:
+		return distancer.NewCosineDistanceProvider(), nil
+	case hnswent.DistanceDot:
+		return distancer.NewDotProductProvider(), nil
+	case hnswent.DistanceL2Squared:
+		return distancer.NewL2SquaredProvider(), nil
+	case hnswent.DistanceManhattan:
+		return distancer.NewManhattanProvider(), nil
+	case hnswent.DistanceHamming:
+		return distancer.NewHammingProvider(), nil
+	default:
+		return nil, errors.Errorf("unrecognized distance metric %q,"+
+			"choose one of [\"cosine\", \"dot\", \"l2-squared\", \"manhattan\",\"hamming\"]", distance)
+	}
+}
+
+// initVectorIndex creates the vector index with the given id, which is
+// also used for the paths of its commit logs. The thunks give the index
+// access to the vectors stored in the objects bucket.
+func (s *Shard) initVectorIndex(id string, cfg schema.VectorIndexConfig,
+	vectorForID func(ctx context.Context, id uint64) ([]float32, error),
+	iterateVectors func(ctx context.Context,
+		fn func(docID uint64, vector []float32) error) error,
+) (VectorIndex, error) {
+	switch uc := cfg.(type) {
+	case hnswent.UserConfig:
+		if uc.Skip {
+			return noop.NewIndex(), nil
+		}
+
+		distProv, err := distancerProvider(uc.Distance)
+		if err != nil {
+			return nil, err
+		}
+
+		vi, err := hnsw.New(hnsw.Config{
+			Logger:            s.index.logger,
+			RootPath:          s.index.Config.RootPath,
+			ID:                id,
+			ShardName:         s.name,
+			ClassName:         s.index.Config.ClassName.String(),
+			PrometheusMetrics: s.promMetrics,
+			MakeCommitLoggerThunk: func() (hnsw.CommitLogger, error) {
+				// Previously we had an interval of 10s in here, which was changed to
+				// 0.5s as part of gh-1867. There's really no way to wait so long in
+				// between checks: If you are running on a low-powered machine, the
+				// interval will simply find that there is no work and do nothing in
+				// each iteration. However, if you are running on a very powerful
+				// machine within 10s you could have potentially created two units of
+				// work, but we'll only be handling one every 10s. This means
+				// uncombined/uncondensed hnsw commit logs will keep piling up can only
+				// be processes long after the initial insert is complete. This also
+				// means that if there is a crash during importing a lot of work needs
+				// to be done at startup, since the commit logs still contain too many
+				// redundancies. So as of now it seems there are only advantages to
+				// running the cleanup checks and work much more often.
+				return hnsw.NewCommitLogger(s.index.Config.RootPath, id, 500*time.Millisecond,
+					s.index.logger)
+			},
+			VectorForIDThunk: vectorForID,
+			DistanceProvider: distProv,
+		}, uc)
+		if err != nil {
+			return nil, errors.Wrapf(err, "init shard %q: hnsw index", s.ID())
+		}
+
+		return vi, nil
+	case flatent.UserConfig:
+		distProv, err := distancerProvider(uc.Distance)
+		if err != nil {
+			return nil, err
+		}
+
+		vi, err := flat.New(flat.Config{
+			ID:                  id,
+			Logger:              s.index.logger,
+			DistanceProvider:    distProv,
+			VectorForIDThunk:    vectorForID,
+			IterateVectorsThunk: iterateVectors,
+		}, uc)
+		if err != nil {
+			return nil, errors.Wrapf(err, "init shard %q: flat index", s.ID())
+		}
+
+		return vi, nil
+	default:
+		return nil, errors.Errorf("unsupported vector index config: %T", cfg)
+	}
+}
+
 func (s *Shard) ID() string {
 	return fmt.Sprintf("%s_%s", s.index.ID(), s.name)
 }
@@ -252,6 +319,7 @@ func (s *Shard) initDBFile(ctx context.Context) error {
 		lsmkv.WithSecondaryIndices(1),
 		lsmkv.WithMonitorCount(),
 		lsmkv.WithIdleThreshold(time.Duration(s.index.Config.FlushIdleAfter)*time.Second),
+		lsmkv.WithCompression(s.index.Config.ObjectsCompression),
 	)
 	if err != nil {
 		return errors.Wrap(err, "create objects bucket")
@@ -277,9 +345,15 @@ func (s *Shard) drop(force bool) error {
 		}
 	}
 
+	s.propertyDeletions.stop()
+
 	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
 	defer cancel()
 
+	if err := s.dropReindex(ctx); err != nil {
+		return errors.Wrap(err, "drop reindex job")
+	}
+
 	if err := s.store.Shutdown(ctx); err != nil {
 		return errors.Wrap(err, "stop lsmkv store")
 	}
@@ -302,7 +376,9 @@ func (s *Shard) drop(force bool) error {
 		return errors.Wrapf(err, "remove indexcount at %s", s.DBPathLSM())
 	}
 	// remove vector index
-	err = s.vectorIndex.Drop(ctx)
+	err = s.forEachVectorIndex(func(name string, vi VectorIndex) error {
+		return vi.Drop(ctx)
+	})
 	if err != nil {
 		return errors.Wrapf(err, "remove vector index at %s", s.DBPathLSM())
 	}
@@ -321,6 +397,11 @@ func (s *Shard) drop(force bool) error {
 		return errors.Wrapf(err, "remove property specific indices at %s", s.DBPathLSM())
 	}
 
+	err = s.propertyDeletions.drop()
+	if err != nil {
+		return errors.Wrapf(err, "remove property deletions at %s", s.DBPathLSM())
+	}
+
 	return nil
 }
 
@@ -499,7 +580,12 @@ func (s *Shard) addProperty(ctx context.Context, prop *models.Property) error {
 			mapOpts = append(mapOpts, lsmkv.WithLegacyMapSorting())
 		}
 	} else {
-		mapOpts = append(mapOpts, lsmkv.WithStrategy(lsmkv.StrategySetCollection))
+		strategy, err := s.setStrategyForFilterableBucket(ctx,
+			helpers.BucketFromPropNameLSM(prop.Name))
+		if err != nil {
+			return err
+		}
+		mapOpts = append(mapOpts, lsmkv.WithStrategy(strategy))
 	}
 
 	err := s.store.CreateOrLoadBucket(ctx, helpers.BucketFromPropNameLSM(prop.Name),
@@ -516,6 +602,12 @@ func (s *Shard) addProperty(ctx context.Context, prop *models.Property) error {
 		return err
 	}
 
+	if inverted.IsRangeableDataType(schema.DataType(prop.DataType[0])) {
+		if err := s.addRangeableProperty(ctx, prop); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -526,10 +618,16 @@ func (s *Shard) updateVectorIndexConfig(ctx context.Context,
 		return storagestate.ErrStatusReadOnly
 	}
 
-	return s.vectorIndex.UpdateUserConfig(updated)
+	return s.defaultVectorIndex().UpdateUserConfig(updated)
 }
 
 func (s *Shard) shutdown(ctx context.Context) error {
+	s.propertyDeletions.stop()
+
+	if err := s.stopReindex(ctx); err != nil {
+		return errors.Wrap(err, "stop reindex job")
+	}
+
 	if s.index.Config.TrackVectorDimensions {
 		// tracking vector dimensions goroutine only works when tracking is enabled
 		// that's why we are trying to stop it only in this case
@@ -545,11 +643,14 @@ func (s *Shard) shutdown(ctx context.Context) error {
 	// 'RemoveTombstone' entry is not picked up on restarts
 	// resulting in perpetually attempting to remove a tombstone
 	// which doesn't actually exist anymore
-	if err := s.vectorIndex.Flush(); err != nil {
+	if err := s.flushVectorIndexes(); err != nil {
 		return errors.Wrap(err, "flush vector index commitlog")
 	}
 
-	if err := s.vectorIndex.Shutdown(ctx); err != nil {
+	err := s.forEachVectorIndex(func(name string, vi VectorIndex) error {
+		return vi.Shutdown(ctx)
+	})
+	if err != nil {
 		return errors.Wrap(err, "shut down vector index")
 	}
 
@@ -21,8 +21,16 @@ import (
 func (s *Shard) aggregate(ctx context.Context,
 	params aggregation.Params,
 ) (*aggregation.Result, error) {
+	s.reindexLock.RLock()
+	defer s.reindexLock.RUnlock()
+
+	vectorIndex, err := s.namedVectorIndex(params.TargetVector)
+	if err != nil {
+		return nil, err
+	}
+
 	return aggregator.New(s.store, params, s.index.getSchema, s.invertedRowCache,
 		s.index.classSearcher, s.deletedDocIDs, s.index.stopwords, s.versioner.Version(),
-		s.vectorIndex).
+		vectorIndex).
 		Do(ctx)
 }
@@ -38,13 +38,15 @@ func (s *Shard) beginBackup(ctx context.Context) (err error) {
 	if err = s.store.FlushMemtables(ctx); err != nil {
 		return errors.Wrap(err, "flush memtables")
 	}
-	if err = s.vectorIndex.PauseMaintenance(ctx); err != nil {
-		return errors.Wrap(err, "pause maintenance")
-	}
-	if err = s.vectorIndex.SwitchCommitLogs(ctx); err != nil {
-		return errors.Wrap(err, "switch commit logs")
-	}
-	return nil
+	return s.forEachVectorIndex(func(name string, vi VectorIndex) error {
+		if err := vi.PauseMaintenance(ctx); err != nil {
+			return errors.Wrap(err, "pause maintenance")
+		}
+		if err := vi.SwitchCommitLogs(ctx); err != nil {
+			return errors.Wrap(err, "switch commit logs")
+		}
+		return nil
+	})
 }
 
 // listBackupFiles lists all files used to backup a shard
@@ -56,12 +58,14 @@ func (s *Shard) listBackupFiles(ctx context.Context, ret *backup.ShardDescriptor
 	if ret.Files, err = s.store.ListFiles(ctx); err != nil {
 		return err
 	}
-	files2, err := s.vectorIndex.ListFiles(ctx)
-	if err != nil {
-		return err
-	}
-	ret.Files = append(ret.Files, files2...)
-	return nil
+	return s.forEachVectorIndex(func(name string, vi VectorIndex) error {
+		files2, err := vi.ListFiles(ctx)
+		if err != nil {
+			return err
+		}
+		ret.Files = append(ret.Files, files2...)
+		return nil
+	})
 }
 
 func (s *Shard) resumeMaintenanceCycles(ctx context.Context) error {
@@ -71,8 +75,11 @@ func (s *Shard) resumeMaintenanceCycles(ctx context.Context) error {
 		return s.store.ResumeCompaction(ctx)
 	})
 
-	g.Go(func() error {
-		return s.vectorIndex.ResumeMaintenance(ctx)
+	s.forEachVectorIndex(func(name string, vi VectorIndex) error {
+		g.Go(func() error {
+			return vi.ResumeMaintenance(ctx)
+		})
+		return nil
 	})
 
 	if err := g.Wait(); err != nil {
@@ -106,8 +113,8 @@ func (s *Shard) readBackupMetadata(d *backup.ShardDescriptor) (err error) {
 	return nil
 }
 
+// nodeName is the node storing the backup of the shard. Every replica is
+// backed up separately, so this is always the local node.
 func (s *Shard) nodeName() string {
-	ss := s.index.getSchema.ShardingState(s.index.Config.ClassName.String())
-	node := ss.Physical[s.name].BelongsToNode
-	return node
+	return s.index.getSchema.NodeName()
 }
@@ -0,0 +1,133 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/storobj"
+)
+
+// initNamedVectorIndexes creates one vector index per named vector of the
+// class. The indexes are independent of the default vector index and of each
+// other, each one is persisted in its own commit logs.
+func (s *Shard) initNamedVectorIndexes() error {
+	s.vectorIndexes = make(map[string]VectorIndex, len(s.index.vectorIndexUserConfigs))
+
+	for name, cfg := range s.index.vectorIndexUserConfigs {
+		name := name
+		extract := func(in []byte) ([]float32, error) {
+			return storobj.NamedVectorFromBinary(in, name)
+		}
+
+		vi, err := s.initVectorIndex(s.namedVectorIndexID(name), cfg,
+			func(ctx context.Context, id uint64) ([]float32, error) {
+				return s.readVectorByIndexID(ctx, id, extract)
+			},
+			func(ctx context.Context, fn func(docID uint64, vector []float32) error) error {
+				return s.iterateVectorsWith(ctx, fn, extract)
+			})
+		if err != nil {
+			return errors.Wrapf(err, "named vector %q", name)
+		}
+
+		s.vectorIndexes[name] = vi
+	}
+
+	return nil
+}
+
+func (s *Shard) namedVectorIndexID(name string) string {
+	return fmt.Sprintf("%s_vectors_%s", s.ID(), name)
+}
+
+// namedVectorIndex returns the index of the given named vector, an empty name
+// refers to the default vector index
+func (s *Shard) namedVectorIndex(name string) (VectorIndex, error) {
+	if name == "" {
+		return s.defaultVectorIndex(), nil
+	}
+
+	vi, ok := s.vectorIndexes[name]
+	if !ok {
+		return nil, errors.Errorf("class %s has no named vector %q",
+			s.index.Config.ClassName, name)
+	}
+
+	return vi, nil
+}
+
+func (s *Shard) defaultVectorIndex() VectorIndex {
+	s.vectorIndexLock.RLock()
+	defer s.vectorIndexLock.RUnlock()
+
+	return s.vectorIndex
+}
+
+// forEachVectorIndex calls fn for the default vector index, which is passed
+// with an empty name, followed by the named vector indexes in the order of
+// their names
+func (s *Shard) forEachVectorIndex(fn func(name string, vi VectorIndex) error) error {
+	if err := fn("", s.defaultVectorIndex()); err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(s.vectorIndexes))
+	for name := range s.vectorIndexes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if err := fn(name, s.vectorIndexes[name]); err != nil {
+			return errors.Wrapf(err, "named vector %q", name)
+		}
+	}
+
+	return nil
+}
+
+func (s *Shard) flushVectorIndexes() error {
+	return s.forEachVectorIndex(func(name string, vi VectorIndex) error {
+		return vi.Flush()
+	})
+}
+
+func (s *Shard) deleteFromVectorIndexes(docID uint64) error {
+	s.reindexLock.RLock()
+	defer s.reindexLock.RUnlock()
+
+	err := s.forEachVectorIndex(func(name string, vi VectorIndex) error {
+		return vi.Delete(docID)
+	})
+	if err != nil {
+		return err
+	}
+
+	if job := s.runningReindex(); job != nil {
+		return job.deleteVector(docID)
+	}
+
+	return nil
+}
+
+func (s *Shard) postStartupVectorIndexes() {
+	s.forEachVectorIndex(func(name string, vi VectorIndex) error {
+		if ps, ok := vi.(interface{ PostStartup() }); ok {
+			ps.PostStartup()
+		}
+		return nil
+	})
+}
@@ -0,0 +1,434 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/lsmkv"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/storagestate"
+	"github.com/semi-technologies/weaviate/entities/storobj"
+)
+
+// propertyDeletionBatchSize is the number of object keys which are read
+// before the cursor is closed again. Objects can only be rewritten while no
+// cursor is open on the objects bucket.
+const propertyDeletionBatchSize = 1000
+
+// dropProperty removes all indexes of the prop right away. The values of the
+// prop are stripped from the stored objects by a background job, so that a
+// large shard does not block the schema change.
+func (s *Shard) dropProperty(ctx context.Context, propName string) error {
+	if s.isReadOnly() {
+		return storagestate.ErrStatusReadOnly
+	}
+
+	if err := s.cancelReindexOfProperty(ctx, propName); err != nil {
+		return errors.Wrapf(err, "cancel reindex of prop %q", propName)
+	}
+
+	for _, bucketName := range propertyBucketNames(propName) {
+		if err := s.store.DropBucket(ctx, bucketName); err != nil {
+			return errors.Wrapf(err, "drop bucket of prop %q", propName)
+		}
+	}
+
+	if err := s.propertyIndices.Drop(ctx, propName); err != nil {
+		return errors.Wrapf(err, "drop property specific index of prop %q", propName)
+	}
+
+	s.propLengths.ResetProperty(propName)
+	if err := s.propLengths.Flush(); err != nil {
+		return errors.Wrapf(err, "reset lengths of prop %q", propName)
+	}
+
+	if err := s.propertyDeletions.add(propName); err != nil {
+		return errors.Wrapf(err, "track deletion of prop %q", propName)
+	}
+
+	s.startPropertyDeletions()
+	return nil
+}
+
+// propertyBucketNames lists every bucket a prop can possibly own, depending
+// on its data type and the inverted index config of the class
+func propertyBucketNames(propName string) []string {
+	return []string{
+		helpers.BucketFromPropNameLSM(propName),
+		helpers.HashBucketFromPropNameLSM(propName),
+		helpers.BucketRangeableFromPropNameLSM(propName),
+		helpers.HashBucketRangeableFromPropNameLSM(propName),
+		helpers.BucketFromPropNameLSM(propName + filters.InternalPropertyLength),
+		helpers.HashBucketFromPropNameLSM(propName + filters.InternalPropertyLength),
+		helpers.BucketFromPropNameLSM(propName + filters.InternalNullIndex),
+		helpers.HashBucketFromPropNameLSM(propName + filters.InternalNullIndex),
+		helpers.BucketFromPropNameLSM(helpers.MetaCountProp(propName)),
+		helpers.HashBucketFromPropNameLSM(helpers.MetaCountProp(propName)),
+	}
+}
+
+// startPropertyDeletions starts the background job which strips the values
+// of deleted props from the stored objects, unless it is already running or
+// there is nothing to do
+func (s *Shard) startPropertyDeletions() {
+	ctx, ok := s.propertyDeletions.start()
+	if !ok {
+		return
+	}
+
+	go s.runPropertyDeletions(ctx)
+}
+
+func (s *Shard) runPropertyDeletions(ctx context.Context) {
+	for {
+		status := s.propertyDeletions.next()
+		if status == nil {
+			return
+		}
+
+		err := s.stripProperty(ctx, status)
+		if ctx.Err() != nil {
+			// the shard is shutting down, the deletion stays pending and is
+			// resumed on the next startup
+			s.propertyDeletions.stopped()
+			return
+		}
+
+		if err != nil {
+			s.index.logger.WithField("action", "delete_property").
+				WithField("shard", s.ID()).
+				WithField("property", status.Property).
+				WithError(err).
+				Error("failed to remove property from stored objects")
+		}
+
+		if err := s.propertyDeletions.finish(status, err); err != nil {
+			s.index.logger.WithField("action", "delete_property").
+				WithField("shard", s.ID()).
+				WithError(err).
+				Error("failed to persist property deletions")
+		}
+	}
+}
+
+// stripProperty removes the prop from every stored object of the shard
+func (s *Shard) stripProperty(ctx context.Context,
+	status *models.PropertyDeletionStatus,
+) error {
+	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+	if bucket == nil {
+		return errors.Errorf("objects bucket not found")
+	}
+
+	var after []byte
+	for {
+		keys := nextObjectKeys(bucket, after, propertyDeletionBatchSize)
+		if len(keys) == 0 {
+			return nil
+		}
+
+		for _, key := range keys {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			if err := s.stripPropertyFromObject(bucket, key, status.Property); err != nil {
+				return errors.Wrapf(err, "object %x", key)
+			}
+
+			s.propertyDeletions.processed(status)
+		}
+
+		after = keys[len(keys)-1]
+	}
+}
+
+// nextObjectKeys returns up to limit keys which follow after. If after is
+// nil, it starts at the first key.
+func nextObjectKeys(bucket *lsmkv.Bucket, after []byte, limit int) [][]byte {
+	c := bucket.Cursor()
+	defer c.Close()
+
+	var k []byte
+	if after == nil {
+		k, _ = c.First()
+	} else {
+		k, _ = c.Seek(after)
+		if bytes.Equal(k, after) {
+			k, _ = c.Next()
+		}
+	}
+
+	keys := make([][]byte, 0, limit)
+	for ; k != nil && len(keys) < limit; k, _ = c.Next() {
+		// the cursor reuses its buffers, the key needs to outlive it
+		keys = append(keys, append([]byte(nil), k...))
+	}
+
+	return keys
+}
+
+func (s *Shard) stripPropertyFromObject(bucket *lsmkv.Bucket, idBytes []byte,
+	propName string,
+) error {
+	// the object must not be changed by an import in the meantime, otherwise
+	// either the import or the deletion would be lost
+	lock := &s.docIdLock[s.uuidToIdLockPoolId(idBytes)]
+	lock.Lock()
+	defer lock.Unlock()
+
+	data, err := bucket.Get(idBytes)
+	if err != nil {
+		return err
+	}
+	if data == nil {
+		// deleted in the meantime
+		return nil
+	}
+
+	obj, err := storobj.FromBinary(data)
+	if err != nil {
+		return errors.Wrap(err, "unmarshal object")
+	}
+
+	props, ok := obj.Properties().(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	if _, ok := props[propName]; !ok {
+		return nil
+	}
+
+	delete(props, propName)
+	obj.SetProperties(props)
+
+	data, err = obj.MarshalBinary()
+	if err != nil {
+		return errors.Wrap(err, "marshal object")
+	}
+
+	return s.upsertObjectDataLSM(bucket, idBytes, data, obj.DocID())
+}
+
+// propertyDeletions tracks the props which were deleted from the schema, but
+// whose values might still be present in the stored objects of a shard. All
+// unfinished deletions are persisted, so that they are resumed after a
+// restart.
+type propertyDeletions struct {
+	sync.Mutex
+	path     string
+	statuses []*models.PropertyDeletionStatus
+	cancel   context.CancelFunc
+	done     chan struct{}
+}
+
+func newPropertyDeletions(path string) (*propertyDeletions, error) {
+	d := &propertyDeletions{path: path}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return d, nil
+		}
+		return nil, err
+	}
+
+	var pending []string
+	if err := json.Unmarshal(data, &pending); err != nil {
+		return nil, errors.Wrapf(err, "unmarshal %s", path)
+	}
+
+	for _, propName := range pending {
+		d.statuses = append(d.statuses, &models.PropertyDeletionStatus{
+			Property: propName,
+			Status:   models.PropertyDeletionStatusStatusINPROGRESS,
+		})
+	}
+
+	return d, nil
+}
+
+// add registers a new deletion. An earlier finished deletion of the same prop
+// is replaced.
+func (d *propertyDeletions) add(propName string) error {
+	d.Lock()
+	defer d.Unlock()
+
+	for i, status := range d.statuses {
+		if status.Property != propName {
+			continue
+		}
+
+		if status.Status == models.PropertyDeletionStatusStatusINPROGRESS {
+			return nil
+		}
+
+		d.statuses = append(d.statuses[:i], d.statuses[i+1:]...)
+		break
+	}
+
+	d.statuses = append(d.statuses, &models.PropertyDeletionStatus{
+		Property: propName,
+		Status:   models.PropertyDeletionStatusStatusINPROGRESS,
+	})
+
+	return d.persist()
+}
+
+// start returns false if the job is already running or nothing is pending
+func (d *propertyDeletions) start() (context.Context, bool) {
+	d.Lock()
+	defer d.Unlock()
+
+	if d.done != nil || d.nextUnlocked() == nil {
+		return nil, false
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	d.cancel = cancel
+	d.done = make(chan struct{})
+	return ctx, true
+}
+
+func (d *propertyDeletions) stopped() {
+	d.Lock()
+	defer d.Unlock()
+
+	d.stoppedUnlocked()
+}
+
+func (d *propertyDeletions) stoppedUnlocked() {
+	d.cancel()
+	close(d.done)
+	d.cancel = nil
+	d.done = nil
+}
+
+// stop cancels a running job and waits until it has exited
+func (d *propertyDeletions) stop() {
+	d.Lock()
+	if d.done == nil {
+		d.Unlock()
+		return
+	}
+	d.cancel()
+	done := d.done
+	d.Unlock()
+
+	<-done
+}
+
+// next returns the next pending deletion. If there is none, the job is marked
+// as stopped in the same step, so that a concurrently added deletion is
+// guaranteed to start a new job.
+func (d *propertyDeletions) next() *models.PropertyDeletionStatus {
+	d.Lock()
+	defer d.Unlock()
+
+	status := d.nextUnlocked()
+	if status == nil {
+		d.stoppedUnlocked()
+	}
+
+	return status
+}
+
+func (d *propertyDeletions) nextUnlocked() *models.PropertyDeletionStatus {
+	for _, status := range d.statuses {
+		if status.Status == models.PropertyDeletionStatusStatusINPROGRESS {
+			return status
+		}
+	}
+
+	return nil
+}
+
+func (d *propertyDeletions) processed(status *models.PropertyDeletionStatus) {
+	d.Lock()
+	defer d.Unlock()
+
+	status.ObjectsProcessed++
+}
+
+func (d *propertyDeletions) finish(status *models.PropertyDeletionStatus,
+	err error,
+) error {
+	d.Lock()
+	defer d.Unlock()
+
+	if err != nil {
+		status.Status = models.PropertyDeletionStatusStatusFAILED
+		status.Error = err.Error()
+	} else {
+		status.Status = models.PropertyDeletionStatusStatusDONE
+	}
+
+	return d.persist()
+}
+
+// list returns copies of all statuses, so they can be read without holding
+// the lock
+func (d *propertyDeletions) list() []*models.PropertyDeletionStatus {
+	d.Lock()
+	defer d.Unlock()
+
+	if len(d.statuses) == 0 {
+		return nil
+	}
+
+	out := make([]*models.PropertyDeletionStatus, len(d.statuses))
+	for i, status := range d.statuses {
+		statusCopy := *status
+		out[i] = &statusCopy
+	}
+
+	return out
+}
+
+// persist writes all unfinished deletions to disk. Failed deletions are
+// retried on the next startup.
+func (d *propertyDeletions) persist() error {
+	var pending []string
+	for _, status := range d.statuses {
+		if status.Status != models.PropertyDeletionStatusStatusDONE {
+			pending = append(pending, status.Property)
+		}
+	}
+
+	if len(pending) == 0 {
+		return d.drop()
+	}
+
+	data, err := json.Marshal(pending)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(d.path, data, 0o600)
+}
+
+func (d *propertyDeletions) drop() error {
+	if err := os.Remove(d.path); err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "remove %s", d.path)
+	}
+
+	return nil
+}
@@ -0,0 +1,221 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/inverted"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/lsmkv"
+	"github.com/semi-technologies/weaviate/entities/models"
+)
+
+const rangeableBuildTmpSuffix = ".rangeable.tmp"
+
+// addRangeableProperty creates the range-optimised index of a prop next to
+// its filterable index. If the index does not exist yet, but the prop
+// already holds data - for example because it was imported before the
+// rangeable index was introduced - the index is built from the filterable
+// index first, so that range filters never see an incomplete index.
+func (s *Shard) addRangeableProperty(ctx context.Context, prop *models.Property) error {
+	bucketName := helpers.BucketRangeableFromPropNameLSM(prop.Name)
+	dir := filepath.Join(s.DBPathLSM(), bucketName)
+
+	// a leftover temporary bucket is from an interrupted build and therefore
+	// always incomplete
+	if err := os.RemoveAll(dir + rangeableBuildTmpSuffix); err != nil {
+		return errors.Wrap(err, "remove incomplete rangeable index")
+	}
+
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		if err := s.buildRangeableFromFilterable(ctx, prop.Name, dir); err != nil {
+			return errors.Wrapf(err, "build rangeable index for prop %q", prop.Name)
+		}
+	} else if err != nil {
+		return err
+	}
+
+	err := s.store.CreateOrLoadBucket(ctx, bucketName,
+		lsmkv.WithStrategy(lsmkv.StrategyRoaringSet),
+		lsmkv.WithIdleThreshold(time.Duration(s.index.Config.FlushIdleAfter)*time.Second),
+	)
+	if err != nil {
+		return err
+	}
+
+	return s.store.CreateOrLoadBucket(ctx,
+		helpers.HashBucketRangeableFromPropNameLSM(prop.Name),
+		lsmkv.WithStrategy(lsmkv.StrategyReplace),
+		lsmkv.WithIdleThreshold(time.Duration(s.index.Config.FlushIdleAfter)*time.Second),
+	)
+}
+
+// buildRangeableFromFilterable writes the rangeable index for all values in
+// the filterable index of the prop into a temporary bucket, which is only
+// moved to dir once it is complete.
+func (s *Shard) buildRangeableFromFilterable(ctx context.Context, propName,
+	dir string,
+) error {
+	filterable := s.store.Bucket(helpers.BucketFromPropNameLSM(propName))
+	if filterable == nil {
+		return errors.Errorf("no bucket for prop '%s' found", propName)
+	}
+
+	builder := inverted.NewRangeableBuilder()
+	empty := true
+
+	if filterable.Strategy() == lsmkv.StrategyRoaringSet {
+		c := filterable.CursorRoaringSet()
+		defer c.Close()
+
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			if err := builder.Add(k, v); err != nil {
+				return errors.Wrapf(err, "add doc ids of key %v", k)
+			}
+			empty = false
+		}
+	} else {
+		c := filterable.SetCursor()
+		defer c.Close()
+
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			docIDs := roaring64.New()
+			for _, docIDBytes := range v {
+				docIDs.Add(binary.LittleEndian.Uint64(docIDBytes))
+			}
+
+			if err := builder.Add(k, docIDs); err != nil {
+				return errors.Wrapf(err, "add doc ids of key %v", k)
+			}
+			empty = false
+		}
+	}
+
+	if empty {
+		// nothing to build, the bucket can simply be created
+		return nil
+	}
+
+	logger := s.index.logger.WithField("action", "lsm_build_rangeable_index").
+		WithField("path", dir)
+	logger.Info("building rangeable index from filterable index")
+
+	tmpDir := dir + rangeableBuildTmpSuffix
+
+	var b *lsmkv.Bucket
+	moved := false
+	// the bucket is shut down explicitly on success, on any error path it
+	// still needs to release its segments and stop its flush cycle before the
+	// incomplete index is removed
+	defer func() {
+		if b != nil {
+			b.Shutdown(ctx)
+		}
+		if !moved {
+			os.RemoveAll(tmpDir)
+		}
+	}()
+
+	b, err := lsmkv.NewBucket(ctx, tmpDir, s.index.Config.RootPath, logger, nil,
+		lsmkv.WithStrategy(lsmkv.StrategyRoaringSet))
+	if err != nil {
+		return errors.Wrap(err, "create rangeable bucket")
+	}
+
+	if err := builder.WriteTo(b); err != nil {
+		return errors.Wrap(err, "write rangeable bucket")
+	}
+
+	err = b.Shutdown(ctx)
+	b = nil
+	if err != nil {
+		return errors.Wrap(err, "shutdown rangeable bucket")
+	}
+
+	if err := os.Rename(tmpDir, dir); err != nil {
+		return errors.Wrap(err, "move rangeable bucket into place")
+	}
+	moved = true
+
+	logger.Info("successfully built rangeable index")
+	return nil
+}
+
+func (s *Shard) extendRangeableIndexLSM(prop inverted.Property, docID uint64) error {
+	b, hashBucket, err := s.rangeableBuckets(prop.Name)
+	if err != nil || b == nil {
+		return err
+	}
+
+	hash, err := s.generateRowHash()
+	if err != nil {
+		return err
+	}
+
+	if err := hashBucket.Put(inverted.RangeableHashKey, hash); err != nil {
+		return err
+	}
+
+	for _, item := range prop.Items {
+		if err := inverted.AddToRangeable(b, item.Data, docID); err != nil {
+			return errors.Wrapf(err, "extend rangeable index with item '%v'", item.Data)
+		}
+	}
+
+	return nil
+}
+
+func (s *Shard) deleteFromRangeableIndexLSM(prop inverted.Property, docID uint64) error {
+	b, hashBucket, err := s.rangeableBuckets(prop.Name)
+	if err != nil || b == nil {
+		return err
+	}
+
+	hash, err := s.generateRowHash()
+	if err != nil {
+		return err
+	}
+
+	if err := hashBucket.Put(inverted.RangeableHashKey, hash); err != nil {
+		return err
+	}
+
+	for _, item := range prop.Items {
+		if err := inverted.RemoveFromRangeable(b, item.Data, docID); err != nil {
+			return errors.Wrapf(err, "delete item '%v' from rangeable index", item.Data)
+		}
+	}
+
+	return nil
+}
+
+// rangeableBuckets returns nil buckets if the prop has no rangeable index
+func (s *Shard) rangeableBuckets(propName string) (*lsmkv.Bucket, *lsmkv.Bucket, error) {
+	b := s.store.Bucket(helpers.BucketRangeableFromPropNameLSM(propName))
+	if b == nil {
+		return nil, nil, nil
+	}
+
+	hashBucket := s.store.Bucket(helpers.HashBucketRangeableFromPropNameLSM(propName))
+	if hashBucket == nil {
+		return nil, nil, errors.Errorf("no rangeable hash bucket for prop '%s' found", propName)
+	}
+
+	return b, hashBucket, nil
+}
@@ -144,6 +144,12 @@ func (s *Shard) objectByIndexID(ctx context.Context,
 }
 
 func (s *Shard) vectorByIndexID(ctx context.Context, indexID uint64) ([]float32, error) {
+	return s.readVectorByIndexID(ctx, indexID, storobj.VectorFromBinary)
+}
+
+func (s *Shard) readVectorByIndexID(ctx context.Context, indexID uint64,
+	extract func(in []byte) ([]float32, error),
+) ([]float32, error) {
 	keyBuf := make([]byte, 8)
 	binary.LittleEndian.PutUint64(keyBuf, indexID)
 
@@ -158,13 +164,64 @@ func (s *Shard) vectorByIndexID(ctx context.Context, indexID uint64) ([]float32,
 			"no object for doc id, it could have been deleted")
 	}
 
-	return storobj.VectorFromBinary(bytes)
+	return extract(bytes)
+}
+
+// iterateVectors calls fn with the doc id and vector of every object in the
+// shard, it is used by the flat vector index to scan all vectors
+func (s *Shard) iterateVectors(ctx context.Context,
+	fn func(docID uint64, vector []float32) error,
+) error {
+	return s.iterateVectorsWith(ctx, fn, storobj.VectorFromBinary)
+}
+
+func (s *Shard) iterateVectorsWith(ctx context.Context,
+	fn func(docID uint64, vector []float32) error,
+	extract func(in []byte) ([]float32, error),
+) error {
+	cursor := s.store.Bucket(helpers.ObjectsBucketLSM).Cursor()
+	defer cursor.Close()
+
+	for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		docID, err := storobj.DocIDFromBinary(v)
+		if err != nil {
+			return errors.Wrap(err, "unmarshal doc id")
+		}
+
+		vector, err := extract(v)
+		if err != nil {
+			return errors.Wrapf(err, "unmarshal vector of docID %d", docID)
+		}
+
+		if len(vector) == 0 {
+			// objects without a vector can't be part of a vector search
+			continue
+		}
+
+		if err := fn(docID, vector); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func (s *Shard) objectSearch(ctx context.Context, limit int,
 	filters *filters.LocalFilter, keywordRanking *searchparams.KeywordRanking,
-	sort []filters.Sort, additional additional.Properties,
+	sort []filters.Sort, cursor *filters.Cursor, additional additional.Properties,
 ) ([]*storobj.Object, []float32, error) {
+	s.reindexLock.RLock()
+	defer s.reindexLock.RUnlock()
+
+	if cursor != nil {
+		objs, err := s.cursorObjectList(ctx, cursor, additional)
+		return objs, nil, err
+	}
+
 	if keywordRanking != nil {
 		if v := s.versioner.Version(); v < 2 {
 			return nil, nil, errors.Errorf("shard was built with an older version of " +
@@ -192,9 +249,12 @@ func (s *Shard) objectSearch(ctx context.Context, limit int,
 }
 
 func (s *Shard) objectVectorSearch(ctx context.Context,
-	searchVector []float32, targetDist float32, limit int, filters *filters.LocalFilter,
+	searchVector []float32, targetVector string, targetDist float32, limit int, filters *filters.LocalFilter,
 	sort []filters.Sort, additional additional.Properties,
 ) ([]*storobj.Object, []float32, error) {
+	s.reindexLock.RLock()
+	defer s.reindexLock.RUnlock()
+
 	var (
 		ids       []uint64
 		dists     []float32
@@ -204,6 +264,11 @@ func (s *Shard) objectVectorSearch(ctx context.Context,
 
 	beforeAll := time.Now()
 
+	vectorIndex, err := s.namedVectorIndex(targetVector)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	if filters != nil {
 		list, err := s.buildAllowList(ctx, filters, additional)
 		if err != nil {
@@ -213,13 +278,13 @@ func (s *Shard) objectVectorSearch(ctx context.Context,
 	}
 
 	if limit < 0 {
-		ids, dists, err = s.vectorIndex.SearchByVectorDistance(
+		ids, dists, err = vectorIndex.SearchByVectorDistance(
 			searchVector, targetDist, s.index.Config.QueryMaximumResults, allowList)
 		if err != nil {
 			return nil, nil, errors.Wrap(err, "vector search by distance")
 		}
 	} else {
-		ids, dists, err = s.vectorIndex.SearchByVector(searchVector, limit, allowList)
+		ids, dists, err = vectorIndex.SearchByVector(searchVector, limit, allowList)
 		if err != nil {
 			return nil, nil, errors.Wrap(err, "vector search")
 		}
@@ -339,6 +404,51 @@ func (s *Shard) allObjectList(ctx context.Context, limit int,
 	return out[:i], nil
 }
 
+// cursorObjectList walks the objects bucket in key order, i.e. ordered by
+// uuid, and returns the objects directly following the cursor position
+func (s *Shard) cursorObjectList(ctx context.Context, c *filters.Cursor,
+	additional additional.Properties,
+) ([]*storobj.Object, error) {
+	cursor := s.store.Bucket(helpers.ObjectsBucketLSM).Cursor()
+	defer cursor.Close()
+
+	var k, v []byte
+	if c.After == "" {
+		k, v = cursor.First()
+	} else {
+		parsed, err := uuid.Parse(c.After)
+		if err != nil {
+			return nil, errors.Wrap(err, "after argument is not a valid uuid")
+		}
+		uuidBytes, err := parsed.MarshalBinary()
+		if err != nil {
+			return nil, errors.Wrap(err, "marshal after argument")
+		}
+
+		// the object the cursor points to was part of the previous page
+		k, v = cursor.Seek(uuidBytes)
+		if bytes.Equal(k, uuidBytes) {
+			k, v = cursor.Next()
+		}
+	}
+
+	out := make([]*storobj.Object, 0, c.Limit)
+	for ; k != nil && len(out) < c.Limit; k, v = cursor.Next() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		obj, err := storobj.FromBinaryOptional(v, additional)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unmarhsal item %d", len(out))
+		}
+
+		out = append(out, obj)
+	}
+
+	return out, nil
+}
+
 func (s *Shard) sortedObjectList(ctx context.Context, limit int, sort []filters.Sort,
 	className schema.ClassName,
 ) ([]uint64, error) {
@@ -411,9 +521,9 @@ func (s *Shard) batchDeleteObject(ctx context.Context, id strfmt.UUID) error {
 
 	var docID uint64
 	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
-	existing, err := bucket.Get([]byte(idBytes))
+	existing, err := s.deleteObjectData(bucket, idBytes)
 	if err != nil {
-		return errors.Wrap(err, "unexpected error on previous lookup")
+		return err
 	}
 
 	if existing == nil {
@@ -428,11 +538,6 @@ func (s *Shard) batchDeleteObject(ctx context.Context, id strfmt.UUID) error {
 		return errors.Wrap(err, "get existing doc id from object binary")
 	}
 
-	err = bucket.Delete(idBytes)
-	if err != nil {
-		return errors.Wrap(err, "delete object from bucket")
-	}
-
 	err = s.cleanupInvertedIndexOnDelete(existing, docID)
 	if err != nil {
 		return errors.Wrap(err, "delete object from bucket")
@@ -442,7 +547,7 @@ func (s *Shard) batchDeleteObject(ctx context.Context, id strfmt.UUID) error {
 	// TODO: do we still need this?
 	s.deletedDocIDs.Add(docID)
 
-	if err := s.vectorIndex.Delete(docID); err != nil {
+	if err := s.deleteFromVectorIndexes(docID); err != nil {
 		return errors.Wrap(err, "delete from vector index")
 	}
 
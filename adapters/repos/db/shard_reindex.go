@@ -0,0 +1,1079 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/inverted"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/lsmkv"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/storagestate"
+	"github.com/semi-technologies/weaviate/entities/storobj"
+	flatent "github.com/semi-technologies/weaviate/entities/vectorindex/flat"
+	hnswent "github.com/semi-technologies/weaviate/entities/vectorindex/hnsw"
+)
+
+// reindexShadowSuffix is appended to the names of the props whose indexes
+// are rebuilt, so that the new indexes are built next to the ones which are
+// still in use. Prop names cannot contain a dot, so a shadow never collides
+// with an actual prop.
+const reindexShadowSuffix = ".reindex"
+
+// reindexBatchSize is the number of object keys which are read before the
+// cursor is closed again, see propertyDeletionBatchSize
+const reindexBatchSize = 1000
+
+func reindexShadowName(propName string) string {
+	return propName + reindexShadowSuffix
+}
+
+// reindexPreviousSuffix is appended to the names of the buckets which are
+// replaced by a committed job. They are kept until the schema has been saved
+// with the new settings, so that the swap can still be undone.
+const reindexPreviousSuffix = ".previous"
+
+func reindexPreviousName(bucketName string) string {
+	return bucketName + reindexPreviousSuffix
+}
+
+// reindexCommit is written to disk before a shard swaps in the rebuilt
+// indexes of a job and removed once the schema has been saved with the new
+// settings. If the shard is restarted in between, the swap is finished or
+// undone on startup depending on the schema, see recoverReindex.
+type reindexCommit struct {
+	ID         string             `json:"id"`
+	Properties []*models.Property `json:"properties"`
+	// Replaced are the buckets of the props which are replaced by a shadow,
+	// all other buckets of the props are no longer needed
+	Replaced []string `json:"replaced"`
+	// VectorIndexType is empty if the vector index is kept
+	VectorIndexType string `json:"vectorIndexType,omitempty"`
+}
+
+// newReindexCommit describes the swap of the job, callers need to hold the
+// reindexLock
+func (s *Shard) newReindexCommit(job *reindexJob) *reindexCommit {
+	commit := &reindexCommit{
+		ID:         job.spec.id,
+		Properties: job.spec.targets,
+	}
+
+	commit.forEachBucket(func(name, shadowName string, _ bool) error {
+		if s.store.Bucket(shadowName) != nil {
+			commit.Replaced = append(commit.Replaced, name)
+		}
+		return nil
+	})
+
+	if job.spec.vectorConfig != nil {
+		commit.VectorIndexType = job.spec.vectorConfig.IndexType()
+	}
+
+	return commit
+}
+
+// forEachBucket calls fn with every bucket of the props of the job, the name
+// of its shadow and whether it is replaced by the shadow
+func (c *reindexCommit) forEachBucket(
+	fn func(name, shadowName string, replaced bool) error,
+) error {
+	for _, prop := range c.Properties {
+		names := propertyBucketNames(prop.Name)
+		shadowNames := propertyBucketNames(reindexShadowName(prop.Name))
+
+		for i := range names {
+			replaced := false
+			for _, name := range c.Replaced {
+				if name == names[i] {
+					replaced = true
+					break
+				}
+			}
+
+			if err := fn(names[i], shadowNames[i], replaced); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// savedIn is true if the class has the settings the indexes were rebuilt
+// with, i.e. if the schema was saved after the swap
+func (c *reindexCommit) savedIn(class *models.Class,
+	vectorConfig schema.VectorIndexConfig,
+) bool {
+	for _, target := range c.Properties {
+		prop, err := schema.GetPropertyByName(class, target.Name)
+		if err != nil || !sameIndexSettings(prop, target) {
+			return false
+		}
+	}
+
+	return c.VectorIndexType == "" || vectorConfig.IndexType() == c.VectorIndexType
+}
+
+// sameIndexSettings compares the settings of two props which a reindex job
+// can change
+func sameIndexSettings(a, b *models.Property) bool {
+	indexInverted := func(prop *models.Property) bool {
+		return prop.IndexInverted == nil || *prop.IndexInverted
+	}
+
+	return reflect.DeepEqual(a.DataType, b.DataType) &&
+		a.Tokenization == b.Tokenization &&
+		a.Stemmer == b.Stemmer &&
+		indexInverted(a) == indexInverted(b)
+}
+
+func (s *Shard) reindexCommitPath() string {
+	return path.Join(s.index.Config.RootPath, s.ID()+".reindexcommit")
+}
+
+// writeReindexCommit syncs the commit to disk, since the buckets are only
+// moved afterwards
+func (s *Shard) writeReindexCommit(commit *reindexCommit) error {
+	data, err := json.Marshal(commit)
+	if err != nil {
+		return err
+	}
+
+	tmpPath := s.reindexCommitPath() + ".tmp"
+	f, err := os.Create(tmpPath)
+	if err != nil {
+		return errors.Wrap(err, "create reindex commit")
+	}
+
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return errors.Wrap(err, "write reindex commit")
+	}
+
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return errors.Wrap(err, "sync reindex commit")
+	}
+
+	if err := f.Close(); err != nil {
+		return errors.Wrap(err, "close reindex commit")
+	}
+
+	return os.Rename(tmpPath, s.reindexCommitPath())
+}
+
+// readReindexCommit returns nil if no commit was interrupted
+func (s *Shard) readReindexCommit() (*reindexCommit, error) {
+	data, err := os.ReadFile(s.reindexCommitPath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	commit := &reindexCommit{}
+	if err := json.Unmarshal(data, commit); err != nil {
+		return nil, errors.Wrapf(err, "unmarshal %s", s.reindexCommitPath())
+	}
+
+	return commit, nil
+}
+
+func (s *Shard) removeReindexCommit() error {
+	if err := os.Remove(s.reindexCommitPath()); err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "remove %s", s.reindexCommitPath())
+	}
+
+	return nil
+}
+
+// reindexSpec describes the indexes a reindex job rebuilds. It is shared by
+// all shards of an index.
+type reindexSpec struct {
+	id string
+	// sources are the definitions of the changed props at the start of the
+	// job, targets the ones the indexes are rebuilt with
+	sources map[string]*models.Property
+	targets []*models.Property
+	// vectorConfig is nil if the vector index is kept
+	vectorConfig schema.VectorIndexConfig
+}
+
+// indexedTargets returns the targets which are part of the inverted index
+func (spec *reindexSpec) indexedTargets() []*models.Property {
+	var out []*models.Property
+	for _, prop := range spec.targets {
+		if prop.IndexInverted != nil && !*prop.IndexInverted {
+			continue
+		}
+		out = append(out, prop)
+	}
+
+	return out
+}
+
+func (spec *reindexSpec) hasTarget(propName string) bool {
+	for _, prop := range spec.targets {
+		if prop.Name == propName {
+			return true
+		}
+	}
+
+	return false
+}
+
+// reindexJob is the state of a reindex job in a single shard. The shadow
+// indexes are filled from the stored objects in the background, while all
+// writes to the shard are applied to them as well. Once every shard is
+// READY, the shadows are swapped in.
+type reindexJob struct {
+	sync.Mutex
+	spec   *reindexSpec
+	status models.ReindexShardStatus
+	// vectorIndex is the shadow of the vector index, nil if it is kept.
+	// vectorDocIDs tracks its contents, so that an object which is indexed
+	// by both the job and a concurrent write is only added once.
+	vectorIndex  VectorIndex
+	vectorDocIDs *roaring64.Bitmap
+	cancel       context.CancelFunc
+	done         chan struct{}
+	// swapped is set once the shadows are swapped in and unset once the
+	// schema has been saved with the new settings, it is guarded by the
+	// reindexLock of the shard
+	swapped bool
+}
+
+func newReindexJob(spec *reindexSpec) *reindexJob {
+	return &reindexJob{
+		spec: spec,
+		status: models.ReindexShardStatus{
+			JobID:  spec.id,
+			Status: models.ReindexShardStatusStatusINPROGRESS,
+		},
+	}
+}
+
+// running is true as long as the shadow indexes need to be maintained
+func (j *reindexJob) running() bool {
+	j.Lock()
+	defer j.Unlock()
+
+	return j.status.Status == models.ReindexShardStatusStatusINPROGRESS ||
+		j.status.Status == models.ReindexShardStatusStatusREADY
+}
+
+func (j *reindexJob) getStatus() string {
+	j.Lock()
+	defer j.Unlock()
+
+	return j.status.Status
+}
+
+func (j *reindexJob) setStatus(status string, err error) {
+	j.Lock()
+	defer j.Unlock()
+
+	j.status.Status = status
+	if err != nil {
+		j.status.Error = err.Error()
+	}
+}
+
+func (j *reindexJob) processed() {
+	j.Lock()
+	defer j.Unlock()
+
+	j.status.ObjectsProcessed++
+}
+
+// stop cancels the background walk and waits until it has exited
+func (j *reindexJob) stop() {
+	j.Lock()
+	if j.done == nil {
+		j.Unlock()
+		return
+	}
+	j.cancel()
+	done := j.done
+	j.Unlock()
+
+	<-done
+}
+
+func (j *reindexJob) addVector(docID uint64, vector []float32) error {
+	if j.vectorIndex == nil || len(vector) == 0 {
+		return nil
+	}
+
+	j.Lock()
+	if j.vectorDocIDs.Contains(docID) {
+		j.Unlock()
+		return nil
+	}
+	j.vectorDocIDs.Add(docID)
+	j.Unlock()
+
+	if err := j.vectorIndex.Add(docID, vector); err != nil {
+		return errors.Wrapf(err, "insert doc id %d to shadow vector index", docID)
+	}
+
+	return nil
+}
+
+func (j *reindexJob) deleteVector(docID uint64) error {
+	if j.vectorIndex == nil {
+		return nil
+	}
+
+	j.Lock()
+	if !j.vectorDocIDs.Contains(docID) {
+		j.Unlock()
+		return nil
+	}
+	j.vectorDocIDs.Remove(docID)
+	j.Unlock()
+
+	if err := j.vectorIndex.Delete(docID); err != nil {
+		return errors.Wrapf(err, "delete doc id %d from shadow vector index", docID)
+	}
+
+	return nil
+}
+
+// startReindex creates the shadow indexes of the job and starts to fill them
+// in the background. A job which is still running is cancelled first. Errors
+// are not returned, but reported as the status of the job, so that they reach
+// the node which coordinates the job.
+func (s *Shard) startReindex(ctx context.Context, spec *reindexSpec) {
+	s.reindexLock.RLock()
+	previous := s.reindex
+	s.reindexLock.RUnlock()
+
+	if previous != nil && previous.running() {
+		if err := s.cancelReindex(ctx, previous.spec.id); err != nil {
+			s.index.logger.WithField("action", "reindex").
+				WithField("shard", s.ID()).
+				WithError(err).
+				Error("failed to cancel previous reindex job")
+		}
+	}
+
+	job := newReindexJob(spec)
+
+	// writes which started before the job are not applied to the shadows,
+	// they need to be visible to the walk through the objects bucket
+	s.reindexLock.Lock()
+	defer s.reindexLock.Unlock()
+
+	s.reindex = job
+
+	if s.isReadOnly() {
+		job.setStatus(models.ReindexShardStatusStatusFAILED, storagestate.ErrStatusReadOnly)
+		return
+	}
+
+	if err := s.createReindexShadows(ctx, job); err != nil {
+		job.setStatus(models.ReindexShardStatusStatusFAILED, err)
+		return
+	}
+
+	job.status.ObjectCount = int64(s.store.Bucket(helpers.ObjectsBucketLSM).Count())
+
+	walkCtx, cancel := context.WithCancel(context.Background())
+	job.cancel = cancel
+	job.done = make(chan struct{})
+	go s.runReindex(walkCtx, job)
+}
+
+func (s *Shard) createReindexShadows(ctx context.Context, job *reindexJob) error {
+	for _, target := range job.spec.indexedTargets() {
+		shadow := *target
+		shadow.Name = reindexShadowName(target.Name)
+
+		if err := s.addProperty(ctx, &shadow); err != nil {
+			return errors.Wrapf(err, "create shadow of prop %q", target.Name)
+		}
+
+		if s.index.invertedIndexConfig.IndexNullState {
+			if err := s.addNullState(ctx, &shadow); err != nil {
+				return errors.Wrapf(err, "create null state shadow of prop %q", target.Name)
+			}
+		}
+
+		if s.index.invertedIndexConfig.IndexPropertyLength {
+			if err := s.addPropertyLength(ctx, &shadow); err != nil {
+				return errors.Wrapf(err, "create property length shadow of prop %q", target.Name)
+			}
+		}
+	}
+
+	if job.spec.vectorConfig == nil {
+		return nil
+	}
+
+	if _, ok := job.spec.vectorConfig.(hnswent.UserConfig); ok {
+		// the vector index is currently flat, so any hnsw files with the id
+		// of the shard are leftovers of an earlier job
+		if err := hnsw.DropFiles(s.index.Config.RootPath, s.ID()); err != nil {
+			return errors.Wrap(err, "remove leftovers of shadow vector index")
+		}
+	}
+
+	vi, err := s.initVectorIndex(s.ID(), job.spec.vectorConfig,
+		s.vectorByIndexID, s.iterateVectors)
+	if err != nil {
+		return errors.Wrap(err, "create shadow vector index")
+	}
+	job.vectorIndex = vi
+	job.vectorDocIDs = roaring64.New()
+
+	return nil
+}
+
+func (s *Shard) dropReindexShadows(ctx context.Context, job *reindexJob) error {
+	for _, target := range job.spec.targets {
+		for _, bucketName := range propertyBucketNames(reindexShadowName(target.Name)) {
+			if err := s.store.DropBucket(ctx, bucketName); err != nil {
+				return errors.Wrapf(err, "drop shadow of prop %q", target.Name)
+			}
+		}
+	}
+
+	if job.vectorIndex != nil {
+		if err := job.vectorIndex.Drop(ctx); err != nil {
+			return errors.Wrap(err, "drop shadow vector index")
+		}
+		job.vectorIndex = nil
+	}
+
+	return nil
+}
+
+func (s *Shard) runReindex(ctx context.Context, job *reindexJob) {
+	defer func() {
+		job.Lock()
+		job.cancel()
+		close(job.done)
+		job.done = nil
+		job.Unlock()
+	}()
+
+	err := s.walkReindex(ctx, job)
+	if ctx.Err() != nil {
+		// the status is set by whoever stopped the job
+		return
+	}
+
+	if err != nil {
+		s.index.logger.WithField("action", "reindex").
+			WithField("shard", s.ID()).
+			WithError(err).
+			Error("failed to rebuild indexes")
+		job.setStatus(models.ReindexShardStatusStatusFAILED, err)
+		return
+	}
+
+	job.setStatus(models.ReindexShardStatusStatusREADY, nil)
+}
+
+// walkReindex adds every stored object to the shadow indexes
+func (s *Shard) walkReindex(ctx context.Context, job *reindexJob) error {
+	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+	if bucket == nil {
+		return errors.Errorf("objects bucket not found")
+	}
+
+	var after []byte
+	for {
+		keys := nextObjectKeys(bucket, after, reindexBatchSize)
+		if len(keys) == 0 {
+			return nil
+		}
+
+		for _, key := range keys {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			if err := s.reindexObject(bucket, key, job); err != nil {
+				return errors.Wrapf(err, "object %x", key)
+			}
+
+			job.processed()
+		}
+
+		after = keys[len(keys)-1]
+	}
+}
+
+func (s *Shard) reindexObject(bucket *lsmkv.Bucket, idBytes []byte,
+	job *reindexJob,
+) error {
+	// the object must not be replaced or deleted in the meantime, otherwise
+	// its previous version could end up in the shadows after the cleanup of
+	// the write has already happened
+	lock := &s.docIdLock[s.uuidToIdLockPoolId(idBytes)]
+	lock.Lock()
+	defer lock.Unlock()
+
+	data, err := bucket.Get(idBytes)
+	if err != nil {
+		return err
+	}
+	if data == nil {
+		// deleted in the meantime
+		return nil
+	}
+
+	obj, err := storobj.FromBinary(data)
+	if err != nil {
+		return errors.Wrap(err, "unmarshal object")
+	}
+
+	if err := s.extendReindexShadows(job, obj, obj.DocID()); err != nil {
+		return err
+	}
+
+	return job.addVector(obj.DocID(), obj.Vector)
+}
+
+// runningReindex returns the job whose shadow indexes need to be written
+// along with the actual indexes, callers need to hold the reindexLock
+func (s *Shard) runningReindex() *reindexJob {
+	if s.reindex == nil || !s.reindex.running() {
+		return nil
+	}
+
+	return s.reindex
+}
+
+// analyzeReindexShadows analyzes the object for the shadow indexes of the
+// job, the returned props are named after the shadows
+func (s *Shard) analyzeReindexShadows(job *reindexJob,
+	obj *storobj.Object,
+) ([]inverted.Property, []nilProp, error) {
+	targets := job.spec.indexedTargets()
+	if len(targets) == 0 {
+		return nil, nil, nil
+	}
+
+	schemaMap, ok := obj.Properties().(map[string]interface{})
+	if !ok {
+		schemaMap = map[string]interface{}{}
+	}
+
+	props, err := inverted.NewAnalyzer(s.index.stopwords).Object(schemaMap,
+		targets, obj.ID())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	out := make([]inverted.Property, 0, len(props))
+	for _, prop := range props {
+		if !job.spec.hasTarget(prop.Name) {
+			continue
+		}
+		prop.Name = reindexShadowName(prop.Name)
+		out = append(out, prop)
+	}
+
+	var nilProps []nilProp
+	if s.index.invertedIndexConfig.IndexNullState {
+		for _, target := range targets {
+			if _, ok := schemaMap[target.Name]; ok {
+				continue
+			}
+			nilProps = append(nilProps, nilProp{
+				Name:                reindexShadowName(target.Name),
+				AddToPropertyLength: isPropertyForLength(schema.DataType(target.DataType[0])),
+			})
+		}
+	}
+
+	return out, nilProps, nil
+}
+
+func (s *Shard) extendReindexShadows(job *reindexJob, obj *storobj.Object,
+	docID uint64,
+) error {
+	props, nilProps, err := s.analyzeReindexShadows(job, obj)
+	if err != nil {
+		return errors.Wrap(err, "analyze object for shadow indexes")
+	}
+
+	if err := s.extendInvertedIndicesLSM(props, nilProps, docID); err != nil {
+		return errors.Wrap(err, "extend shadow indexes")
+	}
+
+	return nil
+}
+
+func (s *Shard) deleteFromReindexShadows(job *reindexJob, obj *storobj.Object,
+	docID uint64,
+) error {
+	props, _, err := s.analyzeReindexShadows(job, obj)
+	if err != nil {
+		return errors.Wrap(err, "analyze object for shadow indexes")
+	}
+
+	if err := s.deleteFromInvertedIndicesLSM(props, docID); err != nil {
+		return errors.Wrap(err, "delete from shadow indexes")
+	}
+
+	return nil
+}
+
+// updateReindexShadows applies a write to the shadow indexes of the job
+func (s *Shard) updateReindexShadows(job *reindexJob, object *storobj.Object,
+	status objectInsertStatus, previous []byte,
+) error {
+	if status.docIDChanged {
+		previousObject, err := storobj.FromBinary(previous)
+		if err != nil {
+			return errors.Wrap(err, "unmarshal previous object")
+		}
+
+		if err := s.deleteFromReindexShadows(job, previousObject, status.oldDocID); err != nil {
+			return err
+		}
+	}
+
+	return s.extendReindexShadows(job, object, status.docID)
+}
+
+// reindexProperties replaces the props of a job by the definitions their
+// actual indexes are built with. While the job is running, these are the
+// definitions at the start of the job. Once the shadows are swapped in, these
+// are the new definitions, until the schema has been saved with them.
+func (s *Shard) reindexProperties(props []*models.Property) []*models.Property {
+	job := s.reindex
+	if job == nil {
+		return props
+	}
+
+	var defs map[string]*models.Property
+	switch {
+	case job.running():
+		defs = job.spec.sources
+	case job.swapped:
+		defs = map[string]*models.Property{}
+		for _, target := range job.spec.targets {
+			defs[target.Name] = target
+		}
+	default:
+		return props
+	}
+
+	out := make([]*models.Property, len(props))
+	for i, prop := range props {
+		if def, ok := defs[prop.Name]; ok {
+			out[i] = def
+			continue
+		}
+		out[i] = prop
+	}
+
+	return out
+}
+
+// commitReindex swaps the shadow indexes of the job in place of the actual
+// ones. All reads and writes are blocked while the buckets are moved. The
+// replaced indexes are kept until the schema has been saved with the new
+// settings, see cleanupReindex.
+func (s *Shard) commitReindex(ctx context.Context, id string) error {
+	s.reindexLock.Lock()
+	defer s.reindexLock.Unlock()
+
+	job := s.reindex
+	if job == nil || job.spec.id != id {
+		return errors.Errorf("no reindex job %q", id)
+	}
+
+	if status := job.getStatus(); status != models.ReindexShardStatusStatusREADY {
+		return errors.Errorf("reindex job %q is %s", id, status)
+	}
+
+	// the replaced indexes of an earlier job are still around if its cleanup
+	// failed, they would be in the way of the ones replaced now
+	stale, err := s.readReindexCommit()
+	if err != nil {
+		job.setStatus(models.ReindexShardStatusStatusFAILED, err)
+		return err
+	}
+	if stale != nil {
+		if err := s.removeReplacedIndexes(ctx, stale); err != nil {
+			job.setStatus(models.ReindexShardStatusStatusFAILED, err)
+			return errors.Wrapf(err, "remove indexes replaced by job %q", stale.ID)
+		}
+	}
+
+	if err := s.writeReindexCommit(s.newReindexCommit(job)); err != nil {
+		job.setStatus(models.ReindexShardStatusStatusFAILED, err)
+		return err
+	}
+
+	for _, target := range job.spec.targets {
+		if err := s.swapReindexShadow(ctx, target); err != nil {
+			job.setStatus(models.ReindexShardStatusStatusFAILED, err)
+			return errors.Wrapf(err, "swap indexes of prop %q", target.Name)
+		}
+	}
+
+	if job.vectorIndex != nil {
+		s.vectorIndexLock.Lock()
+		previous := s.vectorIndex
+		s.vectorIndex = job.vectorIndex
+		s.vectorIndexLock.Unlock()
+		job.vectorIndex = nil
+
+		// the files of the previous index are only removed by cleanupReindex
+		if err := previous.Shutdown(ctx); err != nil {
+			job.setStatus(models.ReindexShardStatusStatusFAILED, err)
+			return errors.Wrap(err, "shutdown previous vector index")
+		}
+	}
+
+	job.swapped = true
+	job.setStatus(models.ReindexShardStatusStatusSUCCESS, nil)
+	return nil
+}
+
+// swapReindexShadow moves the shadow buckets of the prop in place of the
+// actual ones, which are moved aside, see reindexPreviousName. A bucket
+// without a shadow is no longer needed with the new settings.
+func (s *Shard) swapReindexShadow(ctx context.Context, target *models.Property) error {
+	names := propertyBucketNames(target.Name)
+	shadowNames := propertyBucketNames(reindexShadowName(target.Name))
+
+	for i := range names {
+		if s.store.Bucket(shadowNames[i]) == nil {
+			if err := s.store.RenameBucket(ctx, names[i],
+				reindexPreviousName(names[i])); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := s.store.ReplaceBucket(ctx, names[i], shadowNames[i],
+			reindexPreviousName(names[i])); err != nil {
+			return err
+		}
+	}
+
+	if target.IndexInverted != nil && !*target.IndexInverted {
+		return nil
+	}
+
+	// load the moved buckets again
+	if err := s.addProperty(ctx, target); err != nil {
+		return err
+	}
+
+	if s.index.invertedIndexConfig.IndexNullState {
+		if err := s.addNullState(ctx, target); err != nil {
+			return err
+		}
+	}
+
+	if s.index.invertedIndexConfig.IndexPropertyLength {
+		if err := s.addPropertyLength(ctx, target); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// cleanupReindex removes the indexes which were replaced by the job, once the
+// schema has been saved with the new settings. A shard which failed to swap
+// in the rebuilt indexes keeps them, it finishes the swap on its next
+// startup, see recoverReindex.
+func (s *Shard) cleanupReindex(ctx context.Context, id string) error {
+	s.reindexLock.Lock()
+	defer s.reindexLock.Unlock()
+
+	job := s.reindex
+	if job == nil || job.spec.id != id ||
+		job.getStatus() != models.ReindexShardStatusStatusSUCCESS {
+		return nil
+	}
+
+	job.swapped = false
+
+	commit, err := s.readReindexCommit()
+	if err != nil {
+		return err
+	}
+	if commit == nil || commit.ID != id {
+		return nil
+	}
+
+	return s.removeReplacedIndexes(ctx, commit)
+}
+
+// removeReplacedIndexes removes the indexes which were moved aside by the
+// commit of a job, and the commit itself
+func (s *Shard) removeReplacedIndexes(ctx context.Context, commit *reindexCommit) error {
+	err := commit.forEachBucket(func(name, shadowName string, replaced bool) error {
+		return s.store.DropBucket(ctx, reindexPreviousName(name))
+	})
+	if err != nil {
+		return err
+	}
+
+	if _, ok := s.index.vectorIndexUserConfig.(flatent.UserConfig); ok &&
+		commit.VectorIndexType != "" {
+		if err := hnsw.DropFiles(s.index.Config.RootPath, s.ID()); err != nil {
+			return errors.Wrap(err, "drop previous vector index")
+		}
+	}
+
+	return s.removeReindexCommit()
+}
+
+// cancelReindex stops the job and removes its shadow indexes. Cancelling a
+// job which is not known or has already been committed is a no-op.
+func (s *Shard) cancelReindex(ctx context.Context, id string) error {
+	s.reindexLock.RLock()
+	job := s.reindex
+	s.reindexLock.RUnlock()
+
+	if job == nil || job.spec.id != id {
+		return nil
+	}
+
+	job.stop()
+
+	s.reindexLock.Lock()
+	defer s.reindexLock.Unlock()
+
+	if job.getStatus() == models.ReindexShardStatusStatusSUCCESS {
+		return nil
+	}
+
+	if job.running() {
+		job.setStatus(models.ReindexShardStatusStatusCANCELLED, nil)
+	}
+
+	return s.dropReindexShadows(ctx, job)
+}
+
+// cancelReindexOfProperty cancels a running job which rebuilds the indexes
+// of the prop, because they are about to be dropped
+func (s *Shard) cancelReindexOfProperty(ctx context.Context, propName string) error {
+	s.reindexLock.RLock()
+	job := s.runningReindex()
+	s.reindexLock.RUnlock()
+
+	if job == nil || !job.spec.hasTarget(propName) {
+		return nil
+	}
+
+	return s.cancelReindex(ctx, job.spec.id)
+}
+
+// stopReindex stops a running job when the shard shuts down. The shadow
+// indexes are removed on the next startup, see recoverReindex.
+func (s *Shard) stopReindex(ctx context.Context) error {
+	s.reindexLock.RLock()
+	job := s.reindex
+	s.reindexLock.RUnlock()
+
+	if job == nil {
+		return nil
+	}
+
+	job.stop()
+
+	s.reindexLock.Lock()
+	defer s.reindexLock.Unlock()
+
+	if job.running() {
+		job.setStatus(models.ReindexShardStatusStatusCANCELLED,
+			errors.New("shard was shut down"))
+	}
+
+	if job.vectorIndex != nil {
+		return job.vectorIndex.Shutdown(ctx)
+	}
+
+	return nil
+}
+
+// dropReindex stops a running job and removes its shadow indexes, the shadow
+// buckets are removed along with the shard anyway
+func (s *Shard) dropReindex(ctx context.Context) error {
+	if err := s.removeReindexCommit(); err != nil {
+		return err
+	}
+
+	s.reindexLock.RLock()
+	job := s.reindex
+	s.reindexLock.RUnlock()
+
+	if job == nil {
+		return nil
+	}
+
+	job.stop()
+
+	s.reindexLock.Lock()
+	defer s.reindexLock.Unlock()
+
+	if job.vectorIndex != nil {
+		if err := job.vectorIndex.Drop(ctx); err != nil {
+			return err
+		}
+		job.vectorIndex = nil
+	}
+
+	return nil
+}
+
+// recoverReindex finishes or undoes the swap of a job which was interrupted
+// by a shutdown, depending on whether the schema was saved with the new
+// settings. It then removes the shadow indexes of a job which was not
+// committed.
+func (s *Shard) recoverReindex() error {
+	commit, err := s.readReindexCommit()
+	if err != nil {
+		return err
+	}
+
+	if commit != nil {
+		if err := s.recoverReindexCommit(commit); err != nil {
+			return errors.Wrapf(err, "recover commit of reindex job %q", commit.ID)
+		}
+	}
+
+	entries, err := os.ReadDir(s.DBPathLSM())
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	for _, entry := range entries {
+		if !strings.Contains(entry.Name(), reindexShadowSuffix) {
+			continue
+		}
+
+		if err := os.RemoveAll(path.Join(s.DBPathLSM(), entry.Name())); err != nil {
+			return err
+		}
+	}
+
+	if _, ok := s.index.vectorIndexUserConfig.(flatent.UserConfig); ok {
+		return hnsw.DropFiles(s.index.Config.RootPath, s.ID())
+	}
+
+	return nil
+}
+
+// recoverReindexCommit moves the buckets of the props of the job into place,
+// before the store is loaded. The files of the vector index are either the
+// ones of the previous or the rebuilt index, which one is loaded is already
+// decided by the schema.
+func (s *Shard) recoverReindexCommit(commit *reindexCommit) error {
+	sch := s.index.getSchema.GetSchemaSkipAuth()
+	class := sch.FindClassByName(s.index.Config.ClassName)
+	finish := class != nil && commit.savedIn(class, s.index.vectorIndexUserConfig)
+
+	err := commit.forEachBucket(func(name, shadowName string, replaced bool) error {
+		dir := path.Join(s.DBPathLSM(), name)
+		shadowDir := path.Join(s.DBPathLSM(), shadowName)
+		previousDir := path.Join(s.DBPathLSM(), reindexPreviousName(name))
+
+		if finish {
+			return finishBucketSwap(dir, shadowDir, previousDir, replaced)
+		}
+		return undoBucketSwap(dir, shadowDir, previousDir, replaced)
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.removeReindexCommit()
+}
+
+// finishBucketSwap completes the swap of a single bucket. If the shadow still
+// exists, the bucket has not been replaced yet and dir holds the previous
+// contents, if anything.
+func finishBucketSwap(dir, shadowDir, previousDir string, replaced bool) error {
+	if !replaced || dirExists(shadowDir) {
+		if err := os.RemoveAll(dir); err != nil {
+			return err
+		}
+	}
+
+	if replaced && dirExists(shadowDir) {
+		if err := os.Rename(shadowDir, dir); err != nil {
+			return err
+		}
+	}
+
+	return os.RemoveAll(previousDir)
+}
+
+// undoBucketSwap restores the previous contents of a single bucket. Once they
+// have been moved aside, dir holds the rebuilt bucket, if anything.
+func undoBucketSwap(dir, shadowDir, previousDir string, replaced bool) error {
+	if dirExists(previousDir) {
+		if err := os.RemoveAll(dir); err != nil {
+			return err
+		}
+		if err := os.Rename(previousDir, dir); err != nil {
+			return err
+		}
+	} else if replaced && !dirExists(shadowDir) {
+		// the rebuilt bucket was moved in, but there was no previous one
+		if err := os.RemoveAll(dir); err != nil {
+			return err
+		}
+	}
+
+	return os.RemoveAll(shadowDir)
+}
+
+func dirExists(dir string) bool {
+	_, err := os.Stat(dir)
+	return err == nil
+}
+
+// reindexStatus returns a copy of the status of the current or last job, nil
+// if no job ran since the shard was started
+func (s *Shard) reindexStatus() *models.ReindexShardStatus {
+	s.reindexLock.RLock()
+	job := s.reindex
+	s.reindexLock.RUnlock()
+
+	if job == nil {
+		return nil
+	}
+
+	job.Lock()
+	defer job.Unlock()
+
+	status := job.status
+	return &status
+}
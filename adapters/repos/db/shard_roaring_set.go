@@ -0,0 +1,165 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/lsmkv"
+)
+
+const (
+	roaringSetMigrationTmpSuffix    = ".roaringset.tmp"
+	roaringSetMigrationBackupSuffix = ".setcollection.bak"
+)
+
+// setStrategyForFilterableBucket determines the strategy of a bucket that
+// holds doc ids without frequencies. If roaring sets are enabled, an existing
+// set bucket is migrated before it is loaded. A bucket that was already
+// written as a roaring set stays a roaring set, even if the option was
+// switched off in the meantime, as there is no migration in the other
+// direction.
+func (s *Shard) setStrategyForFilterableBucket(ctx context.Context,
+	bucketName string,
+) (string, error) {
+	dir := filepath.Join(s.DBPathLSM(), bucketName)
+
+	if err := s.cleanupRoaringSetMigration(dir); err != nil {
+		return "", errors.Wrapf(err, "clean up previous migration of bucket %q",
+			bucketName)
+	}
+
+	onDisk, err := lsmkv.StrategyOnDisk(dir)
+	if err != nil {
+		return "", errors.Wrapf(err, "detect strategy of bucket %q", bucketName)
+	}
+
+	if onDisk == lsmkv.StrategyRoaringSet {
+		return lsmkv.StrategyRoaringSet, nil
+	}
+
+	if !s.index.Config.IndexFilterableRoaringSet {
+		return lsmkv.StrategySetCollection, nil
+	}
+
+	if onDisk == lsmkv.StrategySetCollection {
+		if err := s.migrateSetBucketToRoaringSet(ctx, dir); err != nil {
+			return "", errors.Wrapf(err, "migrate bucket %q to %q", bucketName,
+				lsmkv.StrategyRoaringSet)
+		}
+	}
+
+	return lsmkv.StrategyRoaringSet, nil
+}
+
+// migrateSetBucketToRoaringSet rewrites the set bucket at dir into a new
+// roaring set bucket next to it. Only once the new bucket has been written
+// completely, the two directories are swapped. Crashes at any point of the
+// migration are handled by cleanupRoaringSetMigration.
+func (s *Shard) migrateSetBucketToRoaringSet(ctx context.Context, dir string) error {
+	logger := s.index.logger.WithField("action", "lsm_migrate_set_to_roaring_set").
+		WithField("path", dir)
+	logger.Info("migrating filterable index to roaring set")
+
+	tmpDir := dir + roaringSetMigrationTmpSuffix
+	src, err := lsmkv.NewBucket(ctx, dir, s.index.Config.RootPath, logger, nil,
+		lsmkv.WithStrategy(lsmkv.StrategySetCollection))
+	if err != nil {
+		return errors.Wrap(err, "load set bucket")
+	}
+
+	var dst *lsmkv.Bucket
+	// both buckets are shut down explicitly on success, on any error path
+	// they still need to release their segments and stop their flush cycles
+	// before the leftovers are removed by cleanupRoaringSetMigration
+	defer func() {
+		if src != nil {
+			src.Shutdown(ctx)
+		}
+		if dst != nil {
+			dst.Shutdown(ctx)
+		}
+	}()
+
+	dst, err = lsmkv.NewBucket(ctx, tmpDir, s.index.Config.RootPath, logger, nil,
+		lsmkv.WithStrategy(lsmkv.StrategyRoaringSet))
+	if err != nil {
+		return errors.Wrap(err, "create roaring set bucket")
+	}
+
+	c := src.SetCursor()
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		docIDs := make([]uint64, len(v))
+		for i, docIDBytes := range v {
+			docIDs[i] = binary.LittleEndian.Uint64(docIDBytes)
+		}
+
+		if err := dst.RoaringSetAddList(k, docIDs); err != nil {
+			c.Close()
+			return errors.Wrapf(err, "add doc ids of key %v", k)
+		}
+	}
+	c.Close()
+
+	err = src.Shutdown(ctx)
+	src = nil
+	if err != nil {
+		return errors.Wrap(err, "shutdown set bucket")
+	}
+
+	err = dst.Shutdown(ctx)
+	dst = nil
+	if err != nil {
+		return errors.Wrap(err, "shutdown roaring set bucket")
+	}
+
+	if err := os.Rename(dir, dir+roaringSetMigrationBackupSuffix); err != nil {
+		return errors.Wrap(err, "move set bucket out of the way")
+	}
+
+	if err := os.Rename(tmpDir, dir); err != nil {
+		return errors.Wrap(err, "move roaring set bucket into place")
+	}
+
+	if err := os.RemoveAll(dir + roaringSetMigrationBackupSuffix); err != nil {
+		return errors.Wrap(err, "remove set bucket")
+	}
+
+	logger.Info("successfully migrated filterable index to roaring set")
+	return nil
+}
+
+// cleanupRoaringSetMigration makes sure that an interrupted migration leaves
+// exactly one valid bucket behind. A leftover temporary bucket is always
+// incomplete and discarded. A leftover backup is either restored, if the
+// crash happened between the two renames, or it is removed because the new
+// bucket is already in place.
+func (s *Shard) cleanupRoaringSetMigration(dir string) error {
+	backupDir := dir + roaringSetMigrationBackupSuffix
+	if _, err := os.Stat(backupDir); err == nil {
+		if _, err := os.Stat(dir); os.IsNotExist(err) {
+			if err := os.Rename(backupDir, dir); err != nil {
+				return errors.Wrap(err, "restore set bucket")
+			}
+		} else if err := os.RemoveAll(backupDir); err != nil {
+			return errors.Wrap(err, "remove set bucket")
+		}
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+
+	return os.RemoveAll(dir + roaringSetMigrationTmpSuffix)
+}
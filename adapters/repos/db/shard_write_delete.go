@@ -18,6 +18,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/lsmkv"
 	"github.com/semi-technologies/weaviate/entities/storagestate"
 	"github.com/semi-technologies/weaviate/entities/storobj"
 )
@@ -34,9 +35,9 @@ func (s *Shard) deleteObject(ctx context.Context, id strfmt.UUID) error {
 
 	var docID uint64
 	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
-	existing, err := bucket.Get([]byte(idBytes))
+	existing, err := s.deleteObjectData(bucket, idBytes)
 	if err != nil {
-		return errors.Wrap(err, "unexpected error on previous lookup")
+		return err
 	}
 
 	if existing == nil {
@@ -51,11 +52,6 @@ func (s *Shard) deleteObject(ctx context.Context, id strfmt.UUID) error {
 		return errors.Wrap(err, "get existing doc id from object binary")
 	}
 
-	err = bucket.Delete(idBytes)
-	if err != nil {
-		return errors.Wrap(err, "delete object from bucket")
-	}
-
 	err = s.cleanupInvertedIndexOnDelete(existing, docID)
 	if err != nil {
 		return errors.Wrap(err, "delete object from bucket")
@@ -65,7 +61,7 @@ func (s *Shard) deleteObject(ctx context.Context, id strfmt.UUID) error {
 	// TODO: do we still need this?
 	s.deletedDocIDs.Add(docID)
 
-	if err := s.vectorIndex.Delete(docID); err != nil {
+	if err := s.deleteFromVectorIndexes(docID); err != nil {
 		return errors.Wrap(err, "delete from vector index")
 	}
 
@@ -73,14 +69,41 @@ func (s *Shard) deleteObject(ctx context.Context, id strfmt.UUID) error {
 		return errors.Wrap(err, "flush all buffered WALs")
 	}
 
-	if err := s.vectorIndex.Flush(); err != nil {
+	if err := s.flushVectorIndexes(); err != nil {
 		return errors.Wrap(err, "flush all vector index buffered WALs")
 	}
 
 	return nil
 }
 
+// deleteObjectData removes the object from the objects bucket and returns its
+// previous contents, which are nil if it did not exist. A reindex job must not
+// pick up the object while it is being deleted, see reindexObject.
+func (s *Shard) deleteObjectData(bucket *lsmkv.Bucket, idBytes []byte) ([]byte, error) {
+	lock := &s.docIdLock[s.uuidToIdLockPoolId(idBytes)]
+	lock.Lock()
+	defer lock.Unlock()
+
+	existing, err := bucket.Get(idBytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "unexpected error on previous lookup")
+	}
+
+	if existing == nil {
+		return nil, nil
+	}
+
+	if err := bucket.Delete(idBytes); err != nil {
+		return nil, errors.Wrap(err, "delete object from bucket")
+	}
+
+	return existing, nil
+}
+
 func (s *Shard) cleanupInvertedIndexOnDelete(previous []byte, docID uint64) error {
+	s.reindexLock.RLock()
+	defer s.reindexLock.RUnlock()
+
 	previousObject, err := storobj.FromBinary(previous)
 	if err != nil {
 		return errors.Wrap(err, "unmarshal previous object")
@@ -103,5 +126,11 @@ func (s *Shard) cleanupInvertedIndexOnDelete(previous []byte, docID uint64) erro
 		}
 	}
 
+	if job := s.runningReindex(); job != nil {
+		if err := s.deleteFromReindexShadows(job, previousObject, docID); err != nil {
+			return errors.Wrap(err, "delete from reindex shadow indexes")
+		}
+	}
+
 	return nil
 }
@@ -52,6 +52,10 @@ func (s *Shard) extendInvertedIndicesLSM(props []inverted.Property, nilProps []n
 						string(item.Data))
 				}
 			}
+
+			if err := s.extendRangeableIndexLSM(prop, docID); err != nil {
+				return err
+			}
 		}
 
 		// add non-nil properties to the null-state inverted index, but skip internal properties (__meta_count, _id etc)
@@ -133,7 +137,7 @@ func (s *Shard) addIndexedNullStateToProps(docID uint64, propName string, isNil
 	}
 
 	hashBucketNullState := s.store.Bucket(helpers.HashBucketFromPropNameLSM(propName + filters.InternalNullIndex))
-	if bNullState == nil {
+	if hashBucketNullState == nil {
 		return errors.Errorf("no nil-hash bucket for prop '%s' found", propName+filters.InternalNullIndex)
 	}
 
@@ -201,8 +205,9 @@ func (s *Shard) extendInvertedIndexItemWithFrequencyLSM(b, hashBucket *lsmkv.Buc
 func (s *Shard) extendInvertedIndexItemLSM(b, hashBucket *lsmkv.Bucket,
 	item inverted.Countable, docID uint64,
 ) error {
-	if b.Strategy() != lsmkv.StrategySetCollection {
-		panic("prop has no frequency, but bucket does not have 'Set' strategy")
+	if b.Strategy() != lsmkv.StrategySetCollection &&
+		b.Strategy() != lsmkv.StrategyRoaringSet {
+		panic("prop has no frequency, but bucket does not have 'Set' or 'RoaringSet' strategy")
 	}
 
 	hash, err := s.generateRowHash()
@@ -214,6 +219,10 @@ func (s *Shard) extendInvertedIndexItemLSM(b, hashBucket *lsmkv.Bucket,
 		return err
 	}
 
+	if b.Strategy() == lsmkv.StrategyRoaringSet {
+		return b.RoaringSetAddOne(item.Data, docID)
+	}
+
 	docIDBytes := make([]byte, 8)
 	binary.LittleEndian.PutUint64(docIDBytes, docID)
 
@@ -223,8 +232,9 @@ func (s *Shard) extendInvertedIndexItemLSM(b, hashBucket *lsmkv.Bucket,
 func (s *Shard) batchExtendInvertedIndexItemsLSMNoFrequency(b, hashBucket *lsmkv.Bucket,
 	item inverted.MergeItem,
 ) error {
-	if b.Strategy() != lsmkv.StrategySetCollection {
-		panic("prop has no frequency, but bucket does not have 'Set' strategy")
+	if b.Strategy() != lsmkv.StrategySetCollection &&
+		b.Strategy() != lsmkv.StrategyRoaringSet {
+		panic("prop has no frequency, but bucket does not have 'Set' or 'RoaringSet' strategy")
 	}
 
 	hash, err := s.generateRowHash()
@@ -236,6 +246,14 @@ func (s *Shard) batchExtendInvertedIndexItemsLSMNoFrequency(b, hashBucket *lsmkv
 		return err
 	}
 
+	if b.Strategy() == lsmkv.StrategyRoaringSet {
+		docIDs := make([]uint64, len(item.DocIDs))
+		for i, idTuple := range item.DocIDs {
+			docIDs[i] = idTuple.DocID
+		}
+		return b.RoaringSetAddList(item.Data, docIDs)
+	}
+
 	docIDs := make([][]byte, len(item.DocIDs))
 	for i, idTuple := range item.DocIDs {
 		docIDs[i] = make([]byte, 8)
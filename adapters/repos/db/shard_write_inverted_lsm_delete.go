@@ -50,6 +50,10 @@ func (s *Shard) deleteFromInvertedIndicesLSM(props []inverted.Property,
 						string(item.Data))
 				}
 			}
+
+			if err := s.deleteFromRangeableIndexLSM(prop, docID); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -87,8 +91,9 @@ func (s *Shard) deleteInvertedIndexItemWithFrequencyLSM(b, hashBucket *lsmkv.Buc
 func (s *Shard) deleteInvertedIndexItemLSM(b, hashBucket *lsmkv.Bucket,
 	item inverted.Countable, docID uint64,
 ) error {
-	if b.Strategy() != lsmkv.StrategySetCollection {
-		panic("prop has no frequency, but bucket does not have 'Set' strategy")
+	if b.Strategy() != lsmkv.StrategySetCollection &&
+		b.Strategy() != lsmkv.StrategyRoaringSet {
+		panic("prop has no frequency, but bucket does not have 'Set' or 'RoaringSet' strategy")
 	}
 
 	hash, err := s.generateRowHash()
@@ -100,6 +105,10 @@ func (s *Shard) deleteInvertedIndexItemLSM(b, hashBucket *lsmkv.Bucket,
 		return err
 	}
 
+	if b.Strategy() == lsmkv.StrategyRoaringSet {
+		return b.RoaringSetRemoveOne(item.Data, docID)
+	}
+
 	docIDBytes := make([]byte, 8)
 	binary.LittleEndian.PutUint64(docIDBytes, docID)
 
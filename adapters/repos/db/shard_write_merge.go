@@ -38,7 +38,7 @@ func (s *Shard) mergeObject(ctx context.Context, merge objects.MergeDocument) er
 		return err
 	}
 
-	if err := s.updateVectorIndex(next.Vector, status); err != nil {
+	if err := s.updateVectorIndexes(next, status); err != nil {
 		return errors.Wrap(err, "update vector index")
 	}
 
@@ -50,7 +50,7 @@ func (s *Shard) mergeObject(ctx context.Context, merge objects.MergeDocument) er
 		return errors.Wrap(err, "flush all buffered WALs")
 	}
 
-	if err := s.vectorIndex.Flush(); err != nil {
+	if err := s.flushVectorIndexes(); err != nil {
 		return errors.Wrap(err, "flush all vector index buffered WALs")
 	}
 
@@ -219,6 +219,10 @@ func mergeProps(previous *storobj.Object,
 		next.Vector = merge.Vector
 	}
 
+	if merge.Vectors != nil {
+		next.Vectors = merge.Vectors
+	}
+
 	next.Object.LastUpdateTimeUnix = merge.UpdateTime
 	next.SetProperties(properties)
 
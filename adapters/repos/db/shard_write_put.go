@@ -45,7 +45,7 @@ func (s *Shard) putObject(ctx context.Context, object *storobj.Object) error {
 		return errors.Wrap(err, "store object in LSM store")
 	}
 
-	if err := s.updateVectorIndex(object.Vector, status); err != nil {
+	if err := s.updateVectorIndexes(object, status); err != nil {
 		return errors.Wrap(err, "update vector index")
 	}
 
@@ -61,14 +61,45 @@ func (s *Shard) putObject(ctx context.Context, object *storobj.Object) error {
 		return errors.Wrap(err, "flush prop length tracker to disk")
 	}
 
-	if err := s.vectorIndex.Flush(); err != nil {
+	if err := s.flushVectorIndexes(); err != nil {
 		return errors.Wrap(err, "flush all vector index buffered WALs")
 	}
 
 	return nil
 }
 
-func (s *Shard) updateVectorIndex(vector []float32,
+// updateVectorIndexes updates the default vector index as well as the
+// indexes of all named vectors
+func (s *Shard) updateVectorIndexes(object *storobj.Object,
+	status objectInsertStatus,
+) error {
+	s.reindexLock.RLock()
+	defer s.reindexLock.RUnlock()
+
+	err := s.forEachVectorIndex(func(name string, vi VectorIndex) error {
+		vector := object.Vector
+		if name != "" {
+			vector = object.Vectors[name]
+		}
+		return updateVectorIndex(vi, vector, status)
+	})
+	if err != nil {
+		return err
+	}
+
+	if job := s.runningReindex(); job != nil {
+		if status.docIDChanged {
+			if err := job.deleteVector(status.oldDocID); err != nil {
+				return err
+			}
+		}
+		return job.addVector(status.docID, object.Vector)
+	}
+
+	return nil
+}
+
+func updateVectorIndex(vectorIndex VectorIndex, vector []float32,
 	status objectInsertStatus,
 ) error {
 	// even if no vector is provided in an update, we still need
@@ -76,7 +107,7 @@ func (s *Shard) updateVectorIndex(vector []float32,
 	// exists. otherwise, the associated doc id is left dangling,
 	// resulting in failed attempts to merge an object on restarts.
 	if status.docIDChanged {
-		if err := s.vectorIndex.Delete(status.oldDocID); err != nil {
+		if err := vectorIndex.Delete(status.oldDocID); err != nil {
 			return errors.Wrapf(err, "delete doc id %d from vector index", status.oldDocID)
 		}
 	}
@@ -87,7 +118,7 @@ func (s *Shard) updateVectorIndex(vector []float32,
 		return nil
 	}
 
-	if err := s.vectorIndex.Add(status.docID, vector); err != nil {
+	if err := vectorIndex.Add(status.docID, vector); err != nil {
 		return errors.Wrapf(err, "insert doc id %d to vector index", status.docID)
 	}
 
@@ -224,6 +255,9 @@ func (s *Shard) upsertObjectDataLSM(bucket *lsmkv.Bucket, id []byte, data []byte
 func (s *Shard) updateInvertedIndexLSM(object *storobj.Object,
 	status objectInsertStatus, previous []byte,
 ) error {
+	s.reindexLock.RLock()
+	defer s.reindexLock.RUnlock()
+
 	props, nilprops, err := s.analyzeObject(object)
 	if err != nil {
 		return errors.Wrap(err, "analyze next object")
@@ -261,6 +295,12 @@ func (s *Shard) updateInvertedIndexLSM(object *storobj.Object,
 		}
 	}
 
+	if job := s.runningReindex(); job != nil {
+		if err := s.updateReindexShadows(job, object, status, previous); err != nil {
+			return errors.Wrap(err, "update reindex shadow indexes")
+		}
+	}
+
 	return nil
 }
 
@@ -0,0 +1,303 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/searchparams"
+	enthnsw "github.com/semi-technologies/weaviate/entities/vectorindex/hnsw"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenizations(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger, _ := test.NewNullLogger()
+	invertedConfig := invertedConfig()
+	// stopwords must not remove trigrams such as "the"
+	invertedConfig.Stopwords.Preset = "en"
+	class := &models.Class{
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig,
+		Class:               "TokenizationClass",
+		Properties: []*models.Property{
+			{
+				Name:         "code",
+				DataType:     []string{string(schema.DataTypeString)},
+				Tokenization: models.PropertyTokenizationWhitespace,
+			},
+			{
+				Name:         "tags",
+				DataType:     []string{string(schema.DataTypeStringArray)},
+				Tokenization: models.PropertyTokenizationLowercase,
+			},
+			{
+				Name:         "name",
+				DataType:     []string{string(schema.DataTypeText)},
+				Tokenization: models.PropertyTokenizationTrigram,
+			},
+			{
+				Name:         "description",
+				DataType:     []string{string(schema.DataTypeText)},
+				Tokenization: models.PropertyTokenizationCjk,
+			},
+			{
+				Name:         "summary",
+				DataType:     []string{string(schema.DataTypeText)},
+				Tokenization: models.PropertyTokenizationWord,
+				Stemmer:      models.PropertyStemmerEn,
+			},
+		},
+	}
+	shardState := singleShardState()
+	schemaGetter := &fakeSchemaGetter{shardState: shardState}
+	repo := New(logger, Config{
+		FlushIdleAfter:            60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+
+	ids := []strfmt.UUID{
+		"7b8b6ad1-2d32-4c43-9f24-6c0c1fc3b1a1",
+		"7b8b6ad1-2d32-4c43-9f24-6c0c1fc3b1a2",
+		"7b8b6ad1-2d32-4c43-9f24-6c0c1fc3b1a3",
+	}
+
+	t.Run("create class and import", func(t *testing.T) {
+		require.Nil(t, NewMigrator(repo, logger).AddClass(context.Background(),
+			class, shardState))
+		schemaGetter.schema = schema.Schema{
+			Objects: &models.Schema{Classes: []*models.Class{class}},
+		}
+
+		props := []map[string]interface{}{
+			{
+				"code":        "AbC-1 x",
+				"tags":        []interface{}{"Hello!", "World"},
+				"name":        "Weaviate Database",
+				"description": "東京タワーに行きました",
+				"summary":     "I was running home",
+			},
+			{
+				"code":        "abc-1",
+				"tags":        []interface{}{"hello"},
+				"name":        "Elastic Search",
+				"description": "大阪城を見ました, great",
+				"summary":     "The runner runs",
+			},
+			{
+				"code":        "Z",
+				"tags":        []interface{}{"other"},
+				"name":        "The Theatre",
+				"description": "서울타워",
+				"summary":     "Nothing to see here",
+			},
+		}
+
+		for i, id := range ids {
+			err := repo.PutObject(context.Background(), &models.Object{
+				Class:      class.Class,
+				ID:         id,
+				Properties: props[i],
+			}, []float32{0.1, 0.2, 0.3}, nil)
+			require.Nil(t, err)
+		}
+	})
+
+	t.Run("filters", func(t *testing.T) {
+		type testCase struct {
+			name     string
+			prop     string
+			operator filters.Operator
+			value    string
+			dt       schema.DataType
+			expected []strfmt.UUID
+		}
+
+		tests := []testCase{
+			{
+				name:     "whitespace keeps casing and punctuation",
+				prop:     "code",
+				operator: filters.OperatorEqual,
+				value:    "AbC-1",
+				dt:       schema.DataTypeString,
+				expected: []strfmt.UUID{ids[0]},
+			},
+			{
+				name:     "whitespace with like",
+				prop:     "code",
+				operator: filters.OperatorLike,
+				value:    "*C-1",
+				dt:       schema.DataTypeString,
+				expected: []strfmt.UUID{ids[0]},
+			},
+			{
+				name:     "lowercase ignores casing, but keeps punctuation",
+				prop:     "tags",
+				operator: filters.OperatorEqual,
+				value:    "HELLO",
+				dt:       schema.DataTypeString,
+				expected: []strfmt.UUID{ids[1]},
+			},
+			{
+				name:     "lowercase with like",
+				prop:     "tags",
+				operator: filters.OperatorLike,
+				value:    "HELL*",
+				dt:       schema.DataTypeString,
+				expected: []strfmt.UUID{ids[0], ids[1]},
+			},
+			{
+				name:     "trigram matches substrings",
+				prop:     "name",
+				operator: filters.OperatorEqual,
+				value:    "aviat",
+				dt:       schema.DataTypeText,
+				expected: []strfmt.UUID{ids[0]},
+			},
+			{
+				name:     "trigram with like",
+				prop:     "name",
+				operator: filters.OperatorLike,
+				value:    "*sear*",
+				dt:       schema.DataTypeText,
+				expected: []strfmt.UUID{ids[1]},
+			},
+			{
+				name:     "trigram is not affected by stopwords",
+				prop:     "name",
+				operator: filters.OperatorEqual,
+				value:    "the",
+				dt:       schema.DataTypeText,
+				expected: []strfmt.UUID{ids[2]},
+			},
+			{
+				name:     "cjk matches words in japanese",
+				prop:     "description",
+				operator: filters.OperatorEqual,
+				value:    "東京タワー",
+				dt:       schema.DataTypeText,
+				expected: []strfmt.UUID{ids[0]},
+			},
+			{
+				name:     "cjk matches words in korean",
+				prop:     "description",
+				operator: filters.OperatorEqual,
+				value:    "타워",
+				dt:       schema.DataTypeText,
+				expected: []strfmt.UUID{ids[2]},
+			},
+			{
+				name:     "cjk with like",
+				prop:     "description",
+				operator: filters.OperatorLike,
+				value:    "大阪* gre*",
+				dt:       schema.DataTypeText,
+				expected: []strfmt.UUID{ids[1]},
+			},
+			{
+				name:     "stemmed words match other forms",
+				prop:     "summary",
+				operator: filters.OperatorEqual,
+				value:    "Runs",
+				dt:       schema.DataTypeText,
+				expected: []strfmt.UUID{ids[0], ids[1]},
+			},
+			{
+				name:     "stemmed with like",
+				prop:     "summary",
+				operator: filters.OperatorLike,
+				value:    "runn*",
+				dt:       schema.DataTypeText,
+				expected: []strfmt.UUID{ids[1]},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				params := traverser.GetParams{
+					ClassName:  class.Class,
+					Pagination: &filters.Pagination{Limit: 10},
+					Filters: &filters.LocalFilter{
+						Root: &filters.Clause{
+							Operator: test.operator,
+							Value: &filters.Value{
+								Value: test.value,
+								Type:  test.dt,
+							},
+							On: &filters.Path{
+								Class:    schema.ClassName(class.Class),
+								Property: schema.PropertyName(test.prop),
+							},
+						},
+					},
+				}
+
+				res, err := repo.ClassSearch(context.Background(), params)
+				require.Nil(t, err)
+				assert.ElementsMatch(t, test.expected, resultIDs(res))
+
+				// the vector search builds a cacheable allow list
+				params.SearchVector = []float32{0.1, 0.2, 0.3}
+				res, err = repo.VectorClassSearch(context.Background(), params)
+				require.Nil(t, err)
+				assert.ElementsMatch(t, test.expected, resultIDs(res))
+			})
+		}
+	})
+
+	t.Run("bm25", func(t *testing.T) {
+		type testCase struct {
+			query    string
+			prop     string
+			expected []strfmt.UUID
+		}
+
+		tests := []testCase{
+			{query: "hello", prop: "tags", expected: []strfmt.UUID{ids[1]}},
+			{query: "databases", prop: "name", expected: []strfmt.UUID{ids[0]}},
+			{query: "大阪城", prop: "description", expected: []strfmt.UUID{ids[1]}},
+			{query: "runs", prop: "summary", expected: []strfmt.UUID{ids[0], ids[1]}},
+		}
+
+		for _, test := range tests {
+			t.Run(test.query, func(t *testing.T) {
+				res, err := repo.ClassSearch(context.Background(), traverser.GetParams{
+					ClassName:  class.Class,
+					Pagination: &filters.Pagination{Limit: 10},
+					KeywordRanking: &searchparams.KeywordRanking{
+						Query:      test.query,
+						Properties: []string{test.prop},
+					},
+				})
+				require.Nil(t, err)
+				assert.ElementsMatch(t, test.expected, resultIDs(res))
+			})
+		}
+	})
+}
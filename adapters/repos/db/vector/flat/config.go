@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package flat
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/semi-technologies/weaviate/entities/errorcompounder"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	ent "github.com/semi-technologies/weaviate/entities/vectorindex/flat"
+	"github.com/sirupsen/logrus"
+)
+
+type (
+	VectorForID func(ctx context.Context, id uint64) ([]float32, error)
+
+	// IterateVectors calls fn for every vector in the object store. It stops
+	// on the first error returned by fn.
+	IterateVectors func(ctx context.Context,
+		fn func(id uint64, vector []float32) error) error
+)
+
+// Config for a new flat index, this contains information that is derived
+// internally, e.g. by the shard. All User-settable config is specified in
+// UserConfig
+type Config struct {
+	ID                  string
+	Logger              logrus.FieldLogger
+	DistanceProvider    distancer.Provider
+	VectorForIDThunk    VectorForID
+	IterateVectorsThunk IterateVectors
+}
+
+func (c Config) Validate() error {
+	ec := &errorcompounder.ErrorCompounder{}
+
+	if c.ID == "" {
+		ec.Addf("id cannot be empty")
+	}
+
+	if c.VectorForIDThunk == nil {
+		ec.Addf("vectorForIDThunk cannot be nil")
+	}
+
+	if c.IterateVectorsThunk == nil {
+		ec.Addf("iterateVectorsThunk cannot be nil")
+	}
+
+	if c.DistanceProvider == nil {
+		ec.Addf("distancerProvider cannot be nil")
+	}
+
+	return ec.ToError()
+}
+
+func ValidateUserConfigUpdate(initial, updated schema.VectorIndexConfig) error {
+	initialParsed, ok := initial.(ent.UserConfig)
+	if !ok {
+		return errors.Errorf("initial is not UserConfig, but %T", initial)
+	}
+
+	updatedParsed, ok := updated.(ent.UserConfig)
+	if !ok {
+		return errors.Errorf("updated is not UserConfig, but %T", updated)
+	}
+
+	if initialParsed.Distance != updatedParsed.Distance {
+		return errors.Errorf("distance is immutable: attempted change from \"%s\" to \"%s\"",
+			initialParsed.Distance, updatedParsed.Distance)
+	}
+
+	return nil
+}
@@ -0,0 +1,256 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package flat
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw/priorityqueue"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/storobj"
+	ent "github.com/semi-technologies/weaviate/entities/vectorindex/flat"
+	"github.com/sirupsen/logrus"
+)
+
+// Index is a brute-force vector index. It does not hold any state of its own,
+// but scans the vectors straight from the object store on every search. This
+// makes it a good fit for small classes, where the memory and commit logs of
+// an HNSW graph are not worth it.
+type Index struct {
+	id                string
+	logger            logrus.FieldLogger
+	distancerProvider distancer.Provider
+	vectorForID       VectorForID
+	iterateVectors    IterateVectors
+	normalizeOnRead   bool
+}
+
+func New(cfg Config, uc ent.UserConfig) (*Index, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid config")
+	}
+
+	if cfg.Logger == nil {
+		logger := logrus.New()
+		logger.Out = io.Discard
+		cfg.Logger = logger
+	}
+
+	return &Index{
+		id:                cfg.ID,
+		logger:            cfg.Logger,
+		distancerProvider: cfg.DistanceProvider,
+		vectorForID:       cfg.VectorForIDThunk,
+		iterateVectors:    cfg.IterateVectorsThunk,
+		normalizeOnRead:   cfg.DistanceProvider.Type() == "cosine-dot",
+	}, nil
+}
+
+// Add is a no-op, the vector is already part of the object in the object
+// store which is where searches read it from
+func (i *Index) Add(id uint64, vector []float32) error {
+	if len(vector) == 0 {
+		return errors.Errorf("insert called with nil-vector")
+	}
+
+	return nil
+}
+
+// Delete is a no-op, deleted objects are no longer present in the object store
+func (i *Index) Delete(id uint64) error {
+	return nil
+}
+
+func (i *Index) SearchByVector(vector []float32, k int,
+	allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	if k <= 0 {
+		return nil, nil, nil
+	}
+
+	results := priorityqueue.NewMax(k)
+	err := i.scan(vector, allow, func(id uint64, dist float32) {
+		if results.Len() < k {
+			results.Insert(id, dist)
+		} else if results.Top().Dist > dist {
+			results.Pop()
+			results.Insert(id, dist)
+		}
+	})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "flat search")
+	}
+
+	return resultsInAscendingOrder(results)
+}
+
+// SearchByVectorDistance returns all vectors within the target distance. A
+// maxLimit of less than 0 returns all results, otherwise the closest maxLimit
+// results are returned.
+func (i *Index) SearchByVectorDistance(vector []float32, targetDistance float32,
+	maxLimit int64, allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	limit := int(maxLimit)
+	if maxLimit < 0 || maxLimit > math.MaxInt32 {
+		limit = math.MaxInt32
+	}
+
+	if limit == 0 {
+		return nil, nil, nil
+	}
+
+	results := priorityqueue.NewMax(0)
+	err := i.scan(vector, allow, func(id uint64, dist float32) {
+		if dist > targetDistance {
+			return
+		}
+
+		if results.Len() < limit {
+			results.Insert(id, dist)
+		} else if results.Top().Dist > dist {
+			results.Pop()
+			results.Insert(id, dist)
+		}
+	})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "flat search by distance")
+	}
+
+	return resultsInAscendingOrder(results)
+}
+
+// scan calculates the distance between the query and either every vector in
+// the allow list or, without an allow list, every vector in the object store
+func (i *Index) scan(query []float32, allow helpers.AllowList,
+	fn func(id uint64, dist float32),
+) error {
+	if i.normalizeOnRead {
+		// cosine-dot requires normalized vectors, as the dot product and cosine
+		// similarity are only identical if the vector is normalized
+		query = distancer.Normalize(query)
+	}
+	dist := i.distancerProvider.New(query)
+
+	visit := func(id uint64, vector []float32) error {
+		if i.normalizeOnRead {
+			vector = distancer.Normalize(vector)
+		}
+
+		d, ok, err := dist.Distance(vector)
+		if err != nil {
+			return errors.Wrapf(err, "calculate distance to docID %d", id)
+		}
+
+		if ok {
+			fn(id, d)
+		}
+		return nil
+	}
+
+	ctx := context.Background()
+	if allow == nil {
+		return i.iterateVectors(ctx, visit)
+	}
+
+	it := allow.Iterator()
+	for id, ok := it.Next(); ok; id, ok = it.Next() {
+		vector, err := i.vectorForID(ctx, id)
+		if err != nil {
+			var e storobj.ErrNotFound
+			if errors.As(err, &e) {
+				// the object was deleted in the meantime
+				continue
+			}
+			return errors.Wrapf(err, "get vector of docID %d", id)
+		}
+
+		if len(vector) == 0 {
+			continue
+		}
+
+		if err := visit(id, vector); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resultsInAscendingOrder(results *priorityqueue.Queue) ([]uint64, []float32, error) {
+	ids := make([]uint64, results.Len())
+	dists := make([]float32, results.Len())
+
+	// results is ordered in reverse, we need to flip the order before presenting
+	// to the user!
+	for i := len(ids) - 1; i >= 0; i-- {
+		res := results.Pop()
+		ids[i] = res.ID
+		dists[i] = res.Dist
+	}
+
+	return ids, dists, nil
+}
+
+func (i *Index) UpdateUserConfig(updated schema.VectorIndexConfig) error {
+	if _, ok := updated.(ent.UserConfig); !ok {
+		return errors.Errorf("config is not UserConfig, but %T", updated)
+	}
+
+	// the distance is the only setting and it is immutable, so there is
+	// nothing to apply
+	return nil
+}
+
+func (i *Index) Drop(context.Context) error {
+	// nothing to drop, the vectors are part of the object store
+	return nil
+}
+
+func (i *Index) Flush() error {
+	return nil
+}
+
+func (i *Index) Shutdown(context.Context) error {
+	return nil
+}
+
+// PauseMaintenance, SwitchCommitLogs, ListFiles and ResumeMaintenance make the
+// index take part in backups. Since the index has no files of its own, there
+// is nothing to pause or list, the vectors are backed up as part of the
+// object store.
+func (i *Index) PauseMaintenance(context.Context) error {
+	return nil
+}
+
+func (i *Index) SwitchCommitLogs(context.Context) error {
+	return nil
+}
+
+func (i *Index) ListFiles(context.Context) ([]string, error) {
+	return nil, nil
+}
+
+func (i *Index) ResumeMaintenance(context.Context) error {
+	return nil
+}
+
+func (i *Index) Dump(labels ...string) {
+	fmt.Printf("--------------------------------------------------\n")
+	fmt.Printf("--  flat index %s, labels: %v\n", i.id, labels)
+	fmt.Printf("--------------------------------------------------\n")
+}
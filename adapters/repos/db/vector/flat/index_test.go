@@ -0,0 +1,135 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package flat
+
+import (
+	"context"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/semi-technologies/weaviate/entities/storobj"
+	ent "github.com/semi-technologies/weaviate/entities/vectorindex/flat"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestIndex(t *testing.T, provider distancer.Provider,
+	vectors map[uint64][]float32,
+) *Index {
+	index, err := New(Config{
+		ID:               "flat-test",
+		DistanceProvider: provider,
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			vec, ok := vectors[id]
+			if !ok {
+				return nil, storobj.NewErrNotFoundf(id, "not found")
+			}
+			return vec, nil
+		},
+		IterateVectorsThunk: func(ctx context.Context,
+			fn func(id uint64, vector []float32) error,
+		) error {
+			for id, vec := range vectors {
+				if err := fn(id, vec); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}, ent.NewDefaultUserConfig())
+	require.Nil(t, err)
+	return index
+}
+
+func TestFlatIndex(t *testing.T) {
+	vectors := map[uint64][]float32{
+		0: {0, 0},
+		1: {1, 0},
+		2: {2, 0},
+		3: {3, 0},
+		4: {4, 0},
+	}
+	index := newTestIndex(t, distancer.NewL2SquaredProvider(), vectors)
+
+	t.Run("search without allow list", func(t *testing.T) {
+		ids, dists, err := index.SearchByVector([]float32{2.9, 0}, 3, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{3, 2, 4}, ids)
+		assert.InDeltaSlice(t, []float32{0.01, 0.81, 1.21}, dists, 1e-5)
+	})
+
+	t.Run("search with allow list", func(t *testing.T) {
+		allow := helpers.NewAllowList(0, 1, 4, 17)
+		ids, _, err := index.SearchByVector([]float32{2.9, 0}, 2, allow)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{4, 1}, ids, "ids not in the store are skipped")
+	})
+
+	t.Run("search with more results requested than present", func(t *testing.T) {
+		ids, _, err := index.SearchByVector([]float32{0, 0}, 10, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{0, 1, 2, 3, 4}, ids)
+	})
+
+	t.Run("search by distance", func(t *testing.T) {
+		ids, _, err := index.SearchByVectorDistance([]float32{0, 0}, 4, -1, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{0, 1, 2}, ids)
+	})
+
+	t.Run("search by distance with a limit", func(t *testing.T) {
+		ids, _, err := index.SearchByVectorDistance([]float32{0, 0}, 4, 2, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{0, 1}, ids)
+	})
+
+	t.Run("backups have no files of the index", func(t *testing.T) {
+		ctx := context.Background()
+		require.Nil(t, index.PauseMaintenance(ctx))
+		require.Nil(t, index.SwitchCommitLogs(ctx))
+		files, err := index.ListFiles(ctx)
+		require.Nil(t, err)
+		assert.Empty(t, files)
+		require.Nil(t, index.ResumeMaintenance(ctx))
+	})
+}
+
+func TestFlatIndexCosine(t *testing.T) {
+	vectors := map[uint64][]float32{
+		0: {1, 0},
+		1: {10, 10},
+		2: {0, 3},
+	}
+	index := newTestIndex(t, distancer.NewCosineDistanceProvider(), vectors)
+
+	// stored and query vectors are normalized, so the length is irrelevant
+	ids, dists, err := index.SearchByVector([]float32{5, 4}, 3, nil)
+	require.Nil(t, err)
+	assert.Equal(t, []uint64{1, 0, 2}, ids)
+	assert.InDelta(t, 0.0061, dists[0], 1e-3)
+}
+
+func TestFlatIndexConfigUpdate(t *testing.T) {
+	initial := ent.UserConfig{Distance: "cosine"}
+
+	t.Run("unchanged", func(t *testing.T) {
+		assert.Nil(t, ValidateUserConfigUpdate(initial, ent.UserConfig{Distance: "cosine"}))
+	})
+
+	t.Run("changing the distance", func(t *testing.T) {
+		err := ValidateUserConfigUpdate(initial, ent.UserConfig{Distance: "dot"})
+		require.NotNil(t, err)
+		assert.Equal(t, "distance is immutable: attempted change from \"cosine\" to \"dot\"",
+			err.Error())
+	})
+}
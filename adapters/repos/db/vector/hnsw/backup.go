@@ -132,6 +132,16 @@ func (h *hnsw) ListFiles(ctx context.Context) ([]string, error) {
 	}
 	delete(found, path)
 
+	// the trained product quantizer is only present for compressed indexes
+	pqFile := pqFileName(h.commitLog.RootPath(), h.commitLog.ID())
+	if _, err := os.Stat(pqFile); err == nil {
+		rel, err := filepath.Rel(h.commitLog.RootPath(), pqFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "product quantizer file")
+		}
+		found[rel] = struct{}{}
+	}
+
 	files, i := make([]string, len(found)), 0
 	for file := range found {
 		files[i] = file
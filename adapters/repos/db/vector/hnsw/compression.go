@@ -0,0 +1,528 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package hnsw
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw/priorityqueue"
+	"github.com/semi-technologies/weaviate/entities/storobj"
+)
+
+// Product quantization (PQ) is optional and configured through the pq
+// section of the user config. Until the index has seen pq.trainingLimit
+// vectors, it behaves exactly like an uncompressed index. Once the limit is
+// reached, the quantizer is trained on the existing vectors in the
+// background and the index switches to compressed mode:
+//
+// - only the compressed vectors (one byte per segment) are held in memory
+// - distances during graph traversal are approximated on the compressed
+//   vectors, for searches through a per-query lookup table
+// - the top ef candidates of a search are rescored with the full vectors
+//   read from the object store before the top k are returned
+//
+// The trained quantizer is persisted next to the commit logs, so the index
+// restarts in compressed mode.
+
+func pqFileName(rootPath, id string) string {
+	return filepath.Join(rootPath, fmt.Sprintf("%s.hnsw.pq", id))
+}
+
+func (h *hnsw) isCompressed() bool {
+	return atomic.LoadInt32(&h.compressed) == 1
+}
+
+// normalizedVectorForID makes sure vectors read from the object store are
+// comparable to the vectors in the index if the distance requires normalized
+// vectors
+func normalizedVectorForID(vectorForID VectorForID,
+	normalize bool,
+) VectorForID {
+	if !normalize {
+		return vectorForID
+	}
+
+	return func(ctx context.Context, id uint64) ([]float32, error) {
+		vec, err := vectorForID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		return distancer.Normalize(vec), nil
+	}
+}
+
+// compressedCache holds the compressed vectors. Contrary to the
+// shardedLockCache it never evicts, the compressed vectors are small enough
+// to keep all of them in memory. On a miss, the full vector is read from the
+// object store and compressed.
+type compressedCache struct {
+	shardedLocks []sync.RWMutex
+	cache        [][]byte
+	vectorForID  VectorForID
+	quantizer    *distancer.ProductQuantizer
+
+	// The maintenanceLock makes sure that only one maintenance operation, such
+	// as growing the cache happens at the same time.
+	maintenanceLock sync.Mutex
+}
+
+func newCompressedCache(vecForID VectorForID,
+	quantizer *distancer.ProductQuantizer, size int,
+) *compressedCache {
+	return &compressedCache{
+		shardedLocks: make([]sync.RWMutex, shardFactor),
+		cache:        make([][]byte, size),
+		vectorForID:  vecForID,
+		quantizer:    quantizer,
+	}
+}
+
+func (c *compressedCache) get(ctx context.Context, id uint64) ([]byte, error) {
+	c.shardedLocks[id%shardFactor].RLock()
+	code := c.cache[id]
+	c.shardedLocks[id%shardFactor].RUnlock()
+
+	if code != nil {
+		return code, nil
+	}
+
+	return c.handleCacheMiss(ctx, id)
+}
+
+func (c *compressedCache) handleCacheMiss(ctx context.Context,
+	id uint64,
+) ([]byte, error) {
+	vec, err := c.vectorForID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	code, err := c.quantizer.Encode(vec)
+	if err != nil {
+		return nil, errors.Wrapf(err, "compress vector of docID %d", id)
+	}
+
+	c.preload(id, code)
+	return code, nil
+}
+
+func (c *compressedCache) multiGet(ctx context.Context,
+	ids []uint64,
+) ([][]byte, []error) {
+	out := make([][]byte, len(ids))
+	errs := make([]error, len(ids))
+
+	for i, id := range ids {
+		out[i], errs[i] = c.get(ctx, id)
+	}
+
+	return out, errs
+}
+
+func (c *compressedCache) preload(id uint64, code []byte) {
+	c.shardedLocks[id%shardFactor].Lock()
+	defer c.shardedLocks[id%shardFactor].Unlock()
+
+	c.cache[id] = code
+}
+
+func (c *compressedCache) delete(id uint64) {
+	c.shardedLocks[id%shardFactor].Lock()
+	defer c.shardedLocks[id%shardFactor].Unlock()
+
+	if int(id) >= len(c.cache) {
+		return
+	}
+
+	c.cache[id] = nil
+}
+
+func (c *compressedCache) grow(node uint64) {
+	c.maintenanceLock.Lock()
+	defer c.maintenanceLock.Unlock()
+
+	for i := range c.shardedLocks {
+		c.shardedLocks[i].Lock()
+	}
+	defer func() {
+		for i := range c.shardedLocks {
+			c.shardedLocks[i].Unlock()
+		}
+	}()
+
+	if node < uint64(len(c.cache)) {
+		return
+	}
+
+	newCache := make([][]byte, node+minimumIndexGrowthDelta)
+	copy(newCache, c.cache)
+	c.cache = newCache
+}
+
+// compressIfDue starts the training of the quantizer in the background once
+// enough vectors have been imported. Doc ids are assigned incrementally, so
+// the id of the latest insert is a cheap approximation of the vector count.
+func (h *hnsw) compressIfDue(id uint64) {
+	if !h.pqConfig.Enabled || h.isCompressed() ||
+		id+1 < uint64(h.pqConfig.TrainingLimit) {
+		return
+	}
+
+	if !atomic.CompareAndSwapInt32(&h.compressing, 0, 1) {
+		// training is already running or has failed before
+		return
+	}
+
+	go func() {
+		if err := h.compress(); err != nil {
+			h.logger.WithField("action", "hnsw_compress").
+				WithField("id", h.id).
+				WithError(err).
+				Error("product quantization failed, index stays uncompressed")
+		}
+	}()
+}
+
+// compress trains the quantizer on up to pq.trainingLimit vectors of the
+// index, persists it and switches the index to compressed mode. Vectors
+// imported concurrently are compressed lazily on their first access.
+func (h *hnsw) compress() error {
+	ctx := context.Background()
+
+	h.RLock()
+	ids := make([]uint64, 0, h.pqConfig.TrainingLimit)
+	for _, node := range h.nodes {
+		if len(ids) == h.pqConfig.TrainingLimit {
+			break
+		}
+		if node != nil && !h.hasTombstone(node.id) {
+			ids = append(ids, node.id)
+		}
+	}
+	h.RUnlock()
+
+	data := make([][]float32, 0, len(ids))
+	for _, id := range ids {
+		vec, err := h.vectorForID(ctx, id)
+		if err != nil {
+			var e storobj.ErrNotFound
+			if errors.As(err, &e) {
+				continue
+			}
+			return errors.Wrapf(err, "get vector of docID %d", id)
+		}
+		data = append(data, vec)
+	}
+
+	if len(data) == 0 {
+		return errors.Errorf("no vectors to train product quantizer on")
+	}
+
+	pq, err := distancer.NewProductQuantizer(h.pqConfig.Segments,
+		h.pqConfig.Centroids, h.distancerProvider)
+	if err != nil {
+		return errors.Wrap(err, "create product quantizer")
+	}
+
+	if err := pq.Fit(data); err != nil {
+		return errors.Wrap(err, "train product quantizer")
+	}
+
+	if err := h.persistQuantizer(pq); err != nil {
+		return err
+	}
+
+	h.Lock()
+	h.pq = pq
+	h.compressedCache = newCompressedCache(h.fullVectorForID, pq, len(h.nodes))
+	atomic.StoreInt32(&h.compressed, 1)
+	length := len(h.nodes)
+	h.Unlock()
+
+	// compress the vectors which are still in the uncompressed cache, all
+	// others are read from disk on their first access
+	for id := 0; id < length; id++ {
+		if h.nodeByID(uint64(id)) == nil {
+			continue
+		}
+
+		vec, err := h.vectorForID(ctx, uint64(id))
+		if err != nil {
+			continue
+		}
+
+		code, err := pq.Encode(vec)
+		if err != nil {
+			return errors.Wrapf(err, "compress vector of docID %d", id)
+		}
+		h.compressedCache.preload(uint64(id), code)
+	}
+
+	h.cache.deleteAllVectors()
+
+	h.logger.WithField("action", "hnsw_compress").
+		WithField("id", h.id).
+		WithField("segments", pq.Segments()).
+		Info("vectors were compressed using product quantization")
+
+	return nil
+}
+
+func (h *hnsw) persistQuantizer(pq *distancer.ProductQuantizer) error {
+	bytes, err := pq.Marshal()
+	if err != nil {
+		return errors.Wrap(err, "marshal product quantizer")
+	}
+
+	fileName := pqFileName(h.rootPath, h.id)
+	tmpName := fileName + ".tmp"
+	if err := os.WriteFile(tmpName, bytes, 0o666); err != nil {
+		return errors.Wrap(err, "write product quantizer")
+	}
+
+	if err := os.Rename(tmpName, fileName); err != nil {
+		return errors.Wrap(err, "write product quantizer")
+	}
+
+	return nil
+}
+
+// restoreQuantizer switches the index to compressed mode on startup if a
+// quantizer was trained before
+func (h *hnsw) restoreQuantizer() error {
+	bytes, err := os.ReadFile(pqFileName(h.rootPath, h.id))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return errors.Wrap(err, "read product quantizer")
+	}
+
+	pq, err := distancer.RestoreProductQuantizer(bytes, h.distancerProvider)
+	if err != nil {
+		return errors.Wrap(err, "restore product quantizer")
+	}
+
+	h.pq = pq
+	h.compressedCache = newCompressedCache(h.fullVectorForID, pq, len(h.nodes))
+	atomic.StoreInt32(&h.compressing, 1)
+	atomic.StoreInt32(&h.compressed, 1)
+
+	return nil
+}
+
+func (h *hnsw) prefillCompressedCache() {
+	go func() {
+		ctx := context.Background()
+
+		h.RLock()
+		length := len(h.nodes)
+		h.RUnlock()
+
+		for id := 0; id < length; id++ {
+			if h.nodeByID(uint64(id)) == nil {
+				continue
+			}
+
+			if _, err := h.compressedCache.get(ctx, uint64(id)); err != nil {
+				var e storobj.ErrNotFound
+				if errors.As(err, &e) {
+					continue
+				}
+
+				h.logger.WithField("action", "hnsw_prefill_compressed_cache").
+					WithError(err).Error("prefill compressed vector cache")
+				return
+			}
+		}
+	}()
+}
+
+// preloadVector makes sure a newly inserted vector is present in memory, so
+// it does not have to be read from disk again
+func (h *hnsw) preloadVector(id uint64, vec []float32) error {
+	if !h.isCompressed() {
+		h.cache.preload(id, vec)
+		return nil
+	}
+
+	code, err := h.pq.Encode(vec)
+	if err != nil {
+		return errors.Wrapf(err, "compress vector of docID %d", id)
+	}
+
+	h.compressedCache.preload(id, code)
+	return nil
+}
+
+// compressedVectorForID wraps the compressed cache with the same handling of
+// deleted objects as the uncompressed lookups, ok is false if the object no
+// longer exists
+func (h *hnsw) compressedVectorForID(id uint64) ([]byte, bool, error) {
+	code, err := h.compressedCache.get(context.Background(), id)
+	if err != nil {
+		var e storobj.ErrNotFound
+		if errors.As(err, &e) {
+			h.handleDeletedNode(e.DocID)
+			return nil, false, nil
+		}
+
+		// not a typed error, we can recover from, return with err
+		return nil, false, errors.Wrapf(err,
+			"could not get vector of object at docID %d", id)
+	}
+
+	return code, true, nil
+}
+
+// nodeDistancer calculates the distances between a query vector and the nodes
+// of the graph, on the compressed vectors if the index is compressed
+type nodeDistancer struct {
+	full       distancer.Distancer
+	compressed *distancer.PQDistancer
+}
+
+func (h *hnsw) newNodeDistancer(queryVector []float32) (*nodeDistancer, error) {
+	if !h.isCompressed() {
+		return &nodeDistancer{full: h.distancerProvider.New(queryVector)}, nil
+	}
+
+	compressed, err := h.pq.NewDistancer(queryVector)
+	if err != nil {
+		return nil, err
+	}
+
+	return &nodeDistancer{compressed: compressed}, nil
+}
+
+// rescore calculates the exact distances of the candidates using the full
+// vectors from the object store and returns the k closest in ascending order
+func (h *hnsw) rescore(queryVector []float32, candidates []uint64,
+	k int,
+) ([]uint64, []float32, error) {
+	if k <= 0 {
+		return nil, nil, nil
+	}
+
+	results := priorityqueue.NewMax(k)
+	for _, id := range candidates {
+		vec, err := h.fullVectorForID(context.Background(), id)
+		if err != nil {
+			var e storobj.ErrNotFound
+			if errors.As(err, &e) {
+				h.handleDeletedNode(e.DocID)
+				continue
+			}
+
+			return nil, nil, errors.Wrapf(err, "get full vector of docID %d", id)
+		}
+
+		dist, _, err := h.distancerProvider.SingleDist(queryVector, vec)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "rescore docID %d", id)
+		}
+
+		if results.Len() < k {
+			results.Insert(id, dist)
+		} else if results.Top().Dist > dist {
+			results.Pop()
+			results.Insert(id, dist)
+		}
+	}
+
+	ids := make([]uint64, results.Len())
+	dists := make([]float32, results.Len())
+
+	// results is ordered in reverse, we need to flip the order
+	for i := len(ids) - 1; i >= 0; i-- {
+		res := results.Pop()
+		ids[i] = res.ID
+		dists[i] = res.Dist
+	}
+
+	return ids, dists, nil
+}
+
+func (h *hnsw) distBetweenCompressedNodes(a, b uint64) (float32, bool, error) {
+	codeA, ok, err := h.compressedVectorForID(a)
+	if err != nil || !ok {
+		return 0, ok, err
+	}
+
+	codeB, ok, err := h.compressedVectorForID(b)
+	if err != nil || !ok {
+		return 0, ok, err
+	}
+
+	return h.pq.DistanceBetweenCodes(codeA, codeB), true, nil
+}
+
+func (h *hnsw) distBetweenCompressedNodeAndVec(node uint64,
+	vecB []float32,
+) (float32, bool, error) {
+	if len(vecB) == 0 {
+		return 0, false, fmt.Errorf(
+			"got a nil or zero-length vector as search vector")
+	}
+
+	codeA, ok, err := h.compressedVectorForID(node)
+	if err != nil || !ok {
+		return 0, ok, err
+	}
+
+	return h.pq.DistanceToCode(vecB, codeA)
+}
+
+func (h *hnsw) rescoreItems(queryVector []float32,
+	items []priorityqueue.Item,
+) ([]priorityqueue.Item, error) {
+	candidates := make([]uint64, len(items))
+	for i, item := range items {
+		candidates[i] = item.ID
+	}
+
+	ids, dists, err := h.rescore(queryVector, candidates, len(candidates))
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]priorityqueue.Item, len(ids))
+	for i := range ids {
+		out[i] = priorityqueue.Item{ID: ids[i], Dist: dists[i]}
+	}
+
+	return out, nil
+}
+
+// vectorForNode returns the uncompressed vector of a node. If the index is
+// compressed, it is reconstructed from the compressed vector instead of
+// loading the full vector into the uncompressed cache.
+func (h *hnsw) vectorForNode(ctx context.Context, id uint64) ([]float32, error) {
+	if !h.isCompressed() {
+		return h.vectorForID(ctx, id)
+	}
+
+	code, err := h.compressedCache.get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.pq.Decode(code), nil
+}
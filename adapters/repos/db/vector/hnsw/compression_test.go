@@ -0,0 +1,183 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package hnsw
+
+import (
+	"context"
+	"math/rand"
+	"os"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	ent "github.com/semi-technologies/weaviate/entities/vectorindex/hnsw"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func randomClusteredVectors(n, dims int, seed int64) [][]float32 {
+	rnd := rand.New(rand.NewSource(seed))
+	centers := make([][]float32, 20)
+	for i := range centers {
+		centers[i] = make([]float32, dims)
+		for j := range centers[i] {
+			centers[i][j] = rnd.Float32()*2 - 1
+		}
+	}
+
+	out := make([][]float32, n)
+	for i := range out {
+		center := centers[rnd.Intn(len(centers))]
+		out[i] = make([]float32, dims)
+		for j := range out[i] {
+			out[i][j] = center[j] + (rnd.Float32()*2-1)*0.2
+		}
+	}
+
+	return out
+}
+
+func bruteForceTopK(vectors [][]float32, query []float32, k int) []uint64 {
+	provider := distancer.NewL2SquaredProvider()
+	ids := make([]uint64, len(vectors))
+	dists := make([]float32, len(vectors))
+	for i := range vectors {
+		ids[i] = uint64(i)
+		dists[i], _, _ = provider.SingleDist(query, vectors[i])
+	}
+
+	sort.Slice(ids, func(a, b int) bool { return dists[ids[a]] < dists[ids[b]] })
+	return ids[:k]
+}
+
+func TestCompressedIndex(t *testing.T) {
+	rootPath := t.TempDir()
+	vectors := randomClusteredVectors(1500, 32, 1)
+	queries := randomClusteredVectors(20, 32, 2)
+	vectorForID := func(ctx context.Context, id uint64) ([]float32, error) {
+		return vectors[int(id)], nil
+	}
+
+	cfg := Config{
+		RootPath:              rootPath,
+		ID:                    "compressed",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      distancer.NewL2SquaredProvider(),
+		VectorForIDThunk:      vectorForID,
+	}
+	uc := ent.UserConfig{
+		MaxConnections: 30,
+		EFConstruction: 64,
+		EF:             64,
+
+		// make sure filtered searches below use the flat search
+		FlatSearchCutoff: 1000,
+		PQ: ent.PQConfig{
+			Enabled:       true,
+			Segments:      16,
+			Centroids:     64,
+			TrainingLimit: 1000,
+		},
+	}
+
+	index, err := New(cfg, uc)
+	require.Nil(t, err)
+
+	t.Run("stays uncompressed before the training limit", func(t *testing.T) {
+		for i := 0; i < 999; i++ {
+			require.Nil(t, index.Add(uint64(i), vectors[i]))
+		}
+
+		assert.False(t, index.isCompressed())
+	})
+
+	t.Run("compresses once the training limit is reached", func(t *testing.T) {
+		require.Nil(t, index.Add(999, vectors[999]))
+
+		assert.Eventually(t, index.isCompressed, 30*time.Second, 10*time.Millisecond)
+		assert.Equal(t, 16, index.pq.Segments())
+
+		_, err := os.Stat(pqFileName(rootPath, "compressed"))
+		assert.Nil(t, err, "quantizer must be persisted")
+	})
+
+	t.Run("imports further vectors into the compressed index", func(t *testing.T) {
+		// wait for the background compression to finish with the uncompressed
+		// cache, so that the assertion below is not racy
+		require.Eventually(t, func() bool {
+			return index.cache.countVectors() == 0
+		}, 30*time.Second, 10*time.Millisecond)
+
+		for i := 1000; i < len(vectors); i++ {
+			require.Nil(t, index.Add(uint64(i), vectors[i]))
+		}
+
+		assert.Equal(t, int64(0), index.cache.countVectors(),
+			"uncompressed vectors must not be cached anymore")
+	})
+
+	t.Run("searches with rescoring", func(t *testing.T) {
+		provider := distancer.NewL2SquaredProvider()
+		k := 10
+		found := 0
+		for _, query := range queries {
+			ids, dists, err := index.SearchByVector(query, k, nil)
+			require.Nil(t, err)
+			require.Len(t, ids, k)
+
+			for i, id := range ids {
+				// distances are calculated on the full vectors
+				control, _, err := provider.SingleDist(query, vectors[id])
+				require.Nil(t, err)
+				assert.Equal(t, control, dists[i])
+			}
+
+			truths := bruteForceTopK(vectors, query, k)
+			for _, id := range ids {
+				for _, truth := range truths {
+					if id == truth {
+						found++
+					}
+				}
+			}
+		}
+
+		recall := float32(found) / float32(k*len(queries))
+		assert.GreaterOrEqual(t, recall, float32(0.9))
+	})
+
+	t.Run("flat searches with rescoring", func(t *testing.T) {
+		allow := helpers.NewAllowList()
+		for i := uint64(0); i < 100; i++ {
+			allow.Insert(i)
+		}
+
+		ids, _, err := index.SearchByVector(queries[0], 5, allow)
+		require.Nil(t, err)
+
+		truths := bruteForceTopK(vectors[:100], queries[0], 5)
+		assert.Equal(t, truths, ids)
+	})
+
+	t.Run("restores the compression on startup", func(t *testing.T) {
+		require.Nil(t, index.Shutdown(context.Background()))
+
+		restarted, err := New(cfg, uc)
+		require.Nil(t, err)
+
+		assert.True(t, restarted.isCompressed())
+		assert.Equal(t, 16, restarted.pq.Segments())
+		require.Nil(t, restarted.Shutdown(context.Background()))
+	})
+}
@@ -55,6 +55,13 @@ func ValidateUserConfigUpdate(initial, updated schema.VectorIndexConfig) error {
 		}
 	}
 
+	// Changing the product quantization would require retraining the
+	// quantizer and recompressing all vectors
+	if initialParsed.PQ != updatedParsed.PQ {
+		return errors.Errorf("pq is immutable: attempted change from \"%+v\" to \"%+v\"",
+			initialParsed.PQ, updatedParsed.PQ)
+	}
+
 	return nil
 }
 
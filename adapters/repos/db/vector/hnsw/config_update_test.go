@@ -55,6 +55,15 @@ func TestUserConfigUpdates(t *testing.T) {
 					"cleanupIntervalSeconds is immutable: " +
 						"attempted change from \"60\" to \"90\""),
 			},
+			{
+				name:    "attempting to enable product quantization",
+				initial: ent.UserConfig{PQ: ent.PQConfig{Enabled: false, Centroids: 256}},
+				update:  ent.UserConfig{PQ: ent.PQConfig{Enabled: true, Centroids: 256}},
+				expectedError: errors.Errorf(
+					"pq is immutable: " +
+						"attempted change from \"{Enabled:false Segments:0 Centroids:256 TrainingLimit:0}\" " +
+						"to \"{Enabled:true Segments:0 Centroids:256 TrainingLimit:0}\""),
+			},
 			{
 				name:          "changing ef",
 				initial:       ent.UserConfig{EF: 100},
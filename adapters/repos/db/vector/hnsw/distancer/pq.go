@@ -0,0 +1,443 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package distancer
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math/rand"
+	"runtime"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// pqKMeansIterations is the upper limit of iterations when training the
+// centroids of a segment. Training stops early once no assignment changes.
+const pqKMeansIterations = 25
+
+// ProductQuantizer compresses vectors by splitting them into equally sized
+// segments and replacing each segment with the id of its closest centroid. A
+// compressed vector therefore takes up a single byte per segment. Distances
+// to and between compressed vectors are approximated by summing up the
+// distances of the individual segments, which is why only distances that
+// are a sum over the dimensions are supported.
+type ProductQuantizer struct {
+	distance   string
+	segments   int
+	centroids  int
+	dimensions int
+	segmentLen int
+
+	// segmentDist is the distance between two segments, the total distance is
+	// the sum of all segment distances plus the offset
+	segmentDist func(a, b []float32) float32
+	offset      float32
+
+	// codebook holds the centroids per segment, i.e. [segment][centroid]
+	codebook [][][]float32
+}
+
+// NewProductQuantizer creates an untrained quantizer. If segments is 0, every
+// dimension becomes its own segment. The quantizer needs to be trained with
+// Fit before vectors can be encoded.
+func NewProductQuantizer(segments, centroids int,
+	provider Provider,
+) (*ProductQuantizer, error) {
+	if segments < 0 {
+		return nil, errors.Errorf("segments must be 0 or greater, got %d", segments)
+	}
+
+	if centroids < 1 || centroids > 256 {
+		return nil, errors.Errorf("centroids must be between 1 and 256, got %d",
+			centroids)
+	}
+
+	pq := &ProductQuantizer{
+		distance:  provider.Type(),
+		segments:  segments,
+		centroids: centroids,
+	}
+
+	if err := pq.initSegmentDist(); err != nil {
+		return nil, err
+	}
+
+	return pq, nil
+}
+
+func (pq *ProductQuantizer) initSegmentDist() error {
+	switch pq.distance {
+	case "l2-squared":
+		pq.segmentDist = l2SquaredImpl
+	case "dot":
+		pq.segmentDist = func(a, b []float32) float32 {
+			return -dotProductImplementation(a, b)
+		}
+	case "cosine-dot":
+		// vectors are normalized, so the cosine distance is 1-dot, however the
+		// 1 must only be added once, not per segment
+		pq.segmentDist = func(a, b []float32) float32 {
+			return -dotProductImplementation(a, b)
+		}
+		pq.offset = 1
+	case "manhattan":
+		pq.segmentDist = manhattanImpl
+	case "hamming":
+		pq.segmentDist = hammingImpl
+	default:
+		return errors.Errorf("product quantization is not supported for distance %q",
+			pq.distance)
+	}
+
+	return nil
+}
+
+// Segments returns the number of segments, i.e. the length of a compressed
+// vector. It is only known once the quantizer is trained.
+func (pq *ProductQuantizer) Segments() int {
+	return pq.segments
+}
+
+// Dimensions of the vectors the quantizer was trained on
+func (pq *ProductQuantizer) Dimensions() int {
+	return pq.dimensions
+}
+
+// Fit trains the centroids of every segment on the provided vectors using
+// k-means. All vectors must have the same length. Segments are trained
+// concurrently.
+func (pq *ProductQuantizer) Fit(data [][]float32) error {
+	if len(data) < pq.centroids {
+		return errors.Errorf("need at least %d vectors to train %d centroids, got %d",
+			pq.centroids, pq.centroids, len(data))
+	}
+
+	dims := len(data[0])
+	if dims == 0 {
+		return errors.Errorf("cannot train on empty vectors")
+	}
+
+	for i, vec := range data {
+		if len(vec) != dims {
+			return errors.Errorf("vector lengths don't match: vector %d has %d "+
+				"dimensions, expected %d", i, len(vec), dims)
+		}
+	}
+
+	segments := pq.segments
+	if segments == 0 {
+		segments = dims
+	}
+
+	if dims%segments != 0 {
+		return errors.Errorf("vector dimensions (%d) must be divisible by the "+
+			"number of segments (%d)", dims, segments)
+	}
+
+	pq.dimensions = dims
+	pq.segments = segments
+	pq.segmentLen = dims / segments
+	pq.codebook = make([][][]float32, segments)
+
+	jobs := make(chan int)
+	wg := &sync.WaitGroup{}
+	for w := 0; w < runtime.GOMAXPROCS(0); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for segment := range jobs {
+				pq.codebook[segment] = pq.kMeans(data, segment)
+			}
+		}()
+	}
+
+	for segment := 0; segment < segments; segment++ {
+		jobs <- segment
+	}
+	close(jobs)
+	wg.Wait()
+
+	return nil
+}
+
+func (pq *ProductQuantizer) subVector(vec []float32, segment int) []float32 {
+	return vec[segment*pq.segmentLen : (segment+1)*pq.segmentLen]
+}
+
+// kMeans trains the centroids of a single segment. The random source is
+// seeded per segment, so that training is reproducible.
+func (pq *ProductQuantizer) kMeans(data [][]float32, segment int) [][]float32 {
+	rnd := rand.New(rand.NewSource(int64(segment) + 1))
+
+	centers := make([][]float32, pq.centroids)
+	for i, pos := range rnd.Perm(len(data))[:pq.centroids] {
+		centers[i] = make([]float32, pq.segmentLen)
+		copy(centers[i], pq.subVector(data[pos], segment))
+	}
+
+	assignments := make([]int, len(data))
+	for i := range assignments {
+		assignments[i] = -1
+	}
+
+	sums := make([]float32, pq.centroids*pq.segmentLen)
+	counts := make([]int, pq.centroids)
+
+	for iteration := 0; iteration < pqKMeansIterations; iteration++ {
+		for i := range sums {
+			sums[i] = 0
+		}
+		for i := range counts {
+			counts[i] = 0
+		}
+
+		changed := false
+		for i, vec := range data {
+			sub := pq.subVector(vec, segment)
+			closest := nearestCentroid(centers, sub)
+			if closest != assignments[i] {
+				assignments[i] = closest
+				changed = true
+			}
+
+			counts[closest]++
+			for j, v := range sub {
+				sums[closest*pq.segmentLen+j] += v
+			}
+		}
+
+		if !changed {
+			break
+		}
+
+		for c := range centers {
+			if counts[c] == 0 {
+				// keep the previous position of an empty cluster
+				continue
+			}
+
+			for j := range centers[c] {
+				centers[c][j] = sums[c*pq.segmentLen+j] / float32(counts[c])
+			}
+		}
+	}
+
+	return centers
+}
+
+// nearestCentroid uses the euclidean distance regardless of the configured
+// distance metric, as centroids are the means of their clusters
+func nearestCentroid(centers [][]float32, sub []float32) int {
+	closest := 0
+	closestDist := l2SquaredImpl(centers[0], sub)
+	for c := 1; c < len(centers); c++ {
+		if dist := l2SquaredImpl(centers[c], sub); dist < closestDist {
+			closest = c
+			closestDist = dist
+		}
+	}
+
+	return closest
+}
+
+func (pq *ProductQuantizer) checkDimensions(vec []float32) error {
+	if pq.codebook == nil {
+		return errors.Errorf("product quantizer has not been trained")
+	}
+
+	if len(vec) != pq.dimensions {
+		return errors.Errorf("vector lengths don't match: %d vs %d",
+			len(vec), pq.dimensions)
+	}
+
+	return nil
+}
+
+// Encode compresses the vector to one centroid id per segment
+func (pq *ProductQuantizer) Encode(vec []float32) ([]byte, error) {
+	if err := pq.checkDimensions(vec); err != nil {
+		return nil, err
+	}
+
+	code := make([]byte, pq.segments)
+	for segment := range code {
+		code[segment] = byte(nearestCentroid(pq.codebook[segment],
+			pq.subVector(vec, segment)))
+	}
+
+	return code, nil
+}
+
+// Decode reconstructs an approximation of the original vector by
+// concatenating the centroids of all segments
+func (pq *ProductQuantizer) Decode(code []byte) []float32 {
+	vec := make([]float32, 0, pq.dimensions)
+	for segment, c := range code {
+		vec = append(vec, pq.codebook[segment][c]...)
+	}
+
+	return vec
+}
+
+// DistanceBetweenCodes approximates the distance between two compressed
+// vectors
+func (pq *ProductQuantizer) DistanceBetweenCodes(a, b []byte) float32 {
+	dist := pq.offset
+	for segment := range a {
+		dist += pq.segmentDist(pq.codebook[segment][a[segment]],
+			pq.codebook[segment][b[segment]])
+	}
+
+	return dist
+}
+
+// DistanceToCode approximates the distance between an uncompressed and a
+// compressed vector. Only the latter is approximated.
+func (pq *ProductQuantizer) DistanceToCode(vec []float32,
+	code []byte,
+) (float32, bool, error) {
+	if err := pq.checkDimensions(vec); err != nil {
+		return 0, false, err
+	}
+
+	dist := pq.offset
+	for segment, c := range code {
+		dist += pq.segmentDist(pq.subVector(vec, segment), pq.codebook[segment][c])
+	}
+
+	return dist, true, nil
+}
+
+// PQDistancer calculates the distances between a query vector and many
+// compressed vectors. The distances between the query segments and all
+// centroids are calculated once upfront, so that the distance to a compressed
+// vector is just a sum of lookups.
+type PQDistancer struct {
+	centroids   int
+	offset      float32
+	lookupTable []float32 // [segment*centroids+centroid]
+}
+
+func (pq *ProductQuantizer) NewDistancer(query []float32) (*PQDistancer, error) {
+	if err := pq.checkDimensions(query); err != nil {
+		return nil, err
+	}
+
+	lookupTable := make([]float32, pq.segments*pq.centroids)
+	for segment := 0; segment < pq.segments; segment++ {
+		sub := pq.subVector(query, segment)
+		for c, center := range pq.codebook[segment] {
+			lookupTable[segment*pq.centroids+c] = pq.segmentDist(sub, center)
+		}
+	}
+
+	return &PQDistancer{
+		centroids:   pq.centroids,
+		offset:      pq.offset,
+		lookupTable: lookupTable,
+	}, nil
+}
+
+func (d *PQDistancer) Distance(code []byte) (float32, bool, error) {
+	if len(code)*d.centroids != len(d.lookupTable) {
+		return 0, false, errors.Errorf("code lengths don't match: %d vs %d",
+			len(code), len(d.lookupTable)/d.centroids)
+	}
+
+	dist := d.offset
+	for segment, c := range code {
+		dist += d.lookupTable[segment*d.centroids+int(c)]
+	}
+
+	return dist, true, nil
+}
+
+// Marshal the trained quantizer, so it can be restored with
+// RestoreProductQuantizer
+func (pq *ProductQuantizer) Marshal() ([]byte, error) {
+	if pq.codebook == nil {
+		return nil, errors.Errorf("product quantizer has not been trained")
+	}
+
+	buf := &bytes.Buffer{}
+	header := []uint32{
+		uint32(len(pq.distance)),
+		uint32(pq.segments),
+		uint32(pq.centroids),
+		uint32(pq.dimensions),
+	}
+	if err := binary.Write(buf, binary.LittleEndian, header); err != nil {
+		return nil, err
+	}
+
+	buf.WriteString(pq.distance)
+
+	for _, segment := range pq.codebook {
+		for _, center := range segment {
+			if err := binary.Write(buf, binary.LittleEndian, center); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return buf.Bytes(), nil
+}
+
+// RestoreProductQuantizer from the output of Marshal. The provider must match
+// the one the quantizer was trained with.
+func RestoreProductQuantizer(data []byte,
+	provider Provider,
+) (*ProductQuantizer, error) {
+	r := bytes.NewReader(data)
+	header := make([]uint32, 4)
+	if err := binary.Read(r, binary.LittleEndian, header); err != nil {
+		return nil, errors.Wrap(err, "read header")
+	}
+
+	distance := make([]byte, header[0])
+	if _, err := r.Read(distance); err != nil {
+		return nil, errors.Wrap(err, "read distance")
+	}
+
+	if string(distance) != provider.Type() {
+		return nil, errors.Errorf("product quantizer was trained for distance %q, "+
+			"but index uses %q", distance, provider.Type())
+	}
+
+	pq, err := NewProductQuantizer(int(header[1]), int(header[2]), provider)
+	if err != nil {
+		return nil, err
+	}
+
+	pq.dimensions = int(header[3])
+	if pq.segments == 0 || pq.dimensions%pq.segments != 0 {
+		return nil, errors.Errorf("invalid dimensions %d for %d segments",
+			pq.dimensions, pq.segments)
+	}
+	pq.segmentLen = pq.dimensions / pq.segments
+
+	pq.codebook = make([][][]float32, pq.segments)
+	for segment := range pq.codebook {
+		pq.codebook[segment] = make([][]float32, pq.centroids)
+		for c := range pq.codebook[segment] {
+			center := make([]float32, pq.segmentLen)
+			if err := binary.Read(r, binary.LittleEndian, center); err != nil {
+				return nil, errors.Wrapf(err, "read centroid %d of segment %d",
+					c, segment)
+			}
+			pq.codebook[segment][c] = center
+		}
+	}
+
+	return pq, nil
+}
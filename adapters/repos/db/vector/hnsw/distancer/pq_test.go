@@ -0,0 +1,212 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package distancer
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func randomVectors(n, dims int, normalize bool, seed int64) [][]float32 {
+	rnd := rand.New(rand.NewSource(seed))
+	out := make([][]float32, n)
+	for i := range out {
+		vec := make([]float32, dims)
+		for j := range vec {
+			vec[j] = rnd.Float32()*2 - 1
+		}
+		if normalize {
+			vec = Normalize(vec)
+		}
+		out[i] = vec
+	}
+
+	return out
+}
+
+func argMin(dists []float32) int {
+	pos := 0
+	for i, dist := range dists {
+		if dist < dists[pos] {
+			pos = i
+		}
+	}
+
+	return pos
+}
+
+// rankOf returns how many elements are closer than the element at pos
+func rankOf(dists []float32, pos int) int {
+	rank := 0
+	for _, dist := range dists {
+		if dist < dists[pos] {
+			rank++
+		}
+	}
+
+	return rank
+}
+
+func TestProductQuantizer(t *testing.T) {
+	providers := []Provider{
+		NewL2SquaredProvider(),
+		NewDotProductProvider(),
+		NewCosineDistanceProvider(),
+		NewManhattanProvider(),
+	}
+
+	for _, provider := range providers {
+		t.Run(provider.Type(), func(t *testing.T) {
+			data := randomVectors(1000, 16, provider.Type() == "cosine-dot", 7)
+			pq, err := NewProductQuantizer(8, 64, provider)
+			require.Nil(t, err)
+			require.Nil(t, pq.Fit(data))
+			assert.Equal(t, 8, pq.Segments())
+			assert.Equal(t, 16, pq.Dimensions())
+
+			query := data[0]
+			lookup, err := pq.NewDistancer(query)
+			require.Nil(t, err)
+
+			codeA, err := pq.Encode(data[1])
+			require.Nil(t, err)
+			require.Len(t, codeA, 8)
+			codeB, err := pq.Encode(data[2])
+			require.Nil(t, err)
+
+			decodedA := pq.Decode(codeA)
+			decodedB := pq.Decode(codeB)
+
+			t.Run("distance to code matches the decoded vector", func(t *testing.T) {
+				control, _, err := provider.SingleDist(query, decodedA)
+				require.Nil(t, err)
+
+				dist, ok, err := pq.DistanceToCode(query, codeA)
+				require.Nil(t, err)
+				require.True(t, ok)
+				assert.InDelta(t, control, dist, 1e-4)
+
+				dist, ok, err = lookup.Distance(codeA)
+				require.Nil(t, err)
+				require.True(t, ok)
+				assert.InDelta(t, control, dist, 1e-4)
+			})
+
+			t.Run("distance between codes matches the decoded vectors", func(t *testing.T) {
+				control, _, err := provider.SingleDist(decodedA, decodedB)
+				require.Nil(t, err)
+				assert.InDelta(t, control, pq.DistanceBetweenCodes(codeA, codeB), 1e-4)
+			})
+
+			t.Run("nearest neighbors are found on the compressed vectors", func(t *testing.T) {
+				codes := make([][]byte, len(data))
+				for i, vec := range data {
+					codes[i], err = pq.Encode(vec)
+					require.Nil(t, err)
+				}
+
+				queries := randomVectors(20, 16, provider.Type() == "cosine-dot", 8)
+				found := 0
+				for _, query := range queries {
+					lookup, err := pq.NewDistancer(query)
+					require.Nil(t, err)
+
+					actual := make([]float32, len(data))
+					approx := make([]float32, len(data))
+					for i := range data {
+						actual[i], _, err = provider.SingleDist(query, data[i])
+						require.Nil(t, err)
+						approx[i], _, err = lookup.Distance(codes[i])
+						require.Nil(t, err)
+					}
+
+					nearest := argMin(actual)
+					if rankOf(approx, nearest) < 10 {
+						found++
+					}
+				}
+
+				// the actual nearest neighbor should usually be within the
+				// top 10 of the approximated distances
+				assert.GreaterOrEqual(t, found, 16)
+			})
+
+			t.Run("restoring a marshalled quantizer", func(t *testing.T) {
+				bytes, err := pq.Marshal()
+				require.Nil(t, err)
+
+				restored, err := RestoreProductQuantizer(bytes, provider)
+				require.Nil(t, err)
+
+				code, err := restored.Encode(data[1])
+				require.Nil(t, err)
+				assert.Equal(t, codeA, code)
+				assert.Equal(t, pq.DistanceBetweenCodes(codeA, codeB),
+					restored.DistanceBetweenCodes(codeA, codeB))
+			})
+		})
+	}
+}
+
+func TestProductQuantizerDefaultSegments(t *testing.T) {
+	data := randomVectors(300, 8, false, 7)
+	pq, err := NewProductQuantizer(0, 256, NewL2SquaredProvider())
+	require.Nil(t, err)
+	require.Nil(t, pq.Fit(data))
+
+	assert.Equal(t, 8, pq.Segments())
+}
+
+func TestProductQuantizerErrors(t *testing.T) {
+	t.Run("unsupported distance", func(t *testing.T) {
+		_, err := NewProductQuantizer(4, 16, NewGeoProvider())
+		assert.NotNil(t, err)
+	})
+
+	t.Run("too many centroids", func(t *testing.T) {
+		_, err := NewProductQuantizer(4, 257, NewL2SquaredProvider())
+		assert.NotNil(t, err)
+	})
+
+	t.Run("dimensions not divisible by segments", func(t *testing.T) {
+		pq, err := NewProductQuantizer(3, 16, NewL2SquaredProvider())
+		require.Nil(t, err)
+		assert.NotNil(t, pq.Fit(randomVectors(100, 16, false, 7)))
+	})
+
+	t.Run("too few training vectors", func(t *testing.T) {
+		pq, err := NewProductQuantizer(4, 16, NewL2SquaredProvider())
+		require.Nil(t, err)
+		assert.NotNil(t, pq.Fit(randomVectors(10, 16, false, 7)))
+	})
+
+	t.Run("encoding before training", func(t *testing.T) {
+		pq, err := NewProductQuantizer(4, 16, NewL2SquaredProvider())
+		require.Nil(t, err)
+		_, err = pq.Encode(make([]float32, 16))
+		assert.NotNil(t, err)
+	})
+
+	t.Run("restoring with a different distance", func(t *testing.T) {
+		pq, err := NewProductQuantizer(4, 16, NewL2SquaredProvider())
+		require.Nil(t, err)
+		require.Nil(t, pq.Fit(randomVectors(100, 16, false, 7)))
+		bytes, err := pq.Marshal()
+		require.Nil(t, err)
+
+		_, err = RestoreProductQuantizer(bytes, NewDotProductProvider())
+		assert.NotNil(t, err)
+	})
+}
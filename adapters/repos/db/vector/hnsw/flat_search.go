@@ -19,9 +19,18 @@ import (
 func (h *hnsw) flatSearch(queryVector []float32, limit int,
 	allowList helpers.AllowList,
 ) ([]uint64, []float32, error) {
+	k := limit
+	compressed := h.isCompressed()
+	if compressed {
+		// the distances are approximations, so collect as many candidates as a
+		// regular search would, and rescore them with the full vectors
+		limit = h.searchTimeEF(k)
+	}
+
 	results := priorityqueue.NewMax(limit)
 
-	for candidate := range allowList {
+	it := allowList.Iterator()
+	for candidate, ok := it.Next(); ok; candidate, ok = it.Next() {
 		h.RLock()
 		// Hot fix for https://github.com/semi-technologies/weaviate/issues/1937
 		// this if statement mitigates the problem but it doesn't resolve the issue
@@ -69,5 +78,9 @@ func (h *hnsw) flatSearch(queryVector []float32, limit int,
 		i--
 	}
 
+	if compressed {
+		return h.rescore(queryVector, ids, k)
+	}
+
 	return ids, dists, nil
 }
@@ -29,7 +29,6 @@ func (h *hnsw) selectNeighborsHeuristic(input *priorityqueue.Queue,
 
 	// TODO, if this solution stays we might need something with fewer allocs
 	ids := make([]uint64, input.Len())
-	var vecs [][]float32
 
 	closestFirst := h.pools.pqHeuristic.GetMin(input.Len())
 	i := uint64(0)
@@ -40,7 +39,18 @@ func (h *hnsw) selectNeighborsHeuristic(input *priorityqueue.Queue,
 		i++
 	}
 
-	vecs, errs := h.multiVectorForID(context.TODO(), ids)
+	var (
+		vecs  [][]float32
+		codes [][]byte
+		errs  []error
+	)
+
+	compressed := h.isCompressed()
+	if compressed {
+		codes, errs = h.compressedCache.multiGet(context.TODO(), ids)
+	} else {
+		vecs, errs = h.multiVectorForID(context.TODO(), ids)
+	}
 
 	returnList := h.pools.pqItemSlice.Get().([]priorityqueue.ItemWithIndex)
 
@@ -51,7 +61,6 @@ func (h *hnsw) selectNeighborsHeuristic(input *priorityqueue.Queue,
 		}
 		distToQuery := curr.Dist
 
-		currVec := vecs[curr.Index]
 		if err := errs[curr.Index]; err != nil {
 			var e storobj.ErrNotFound
 			if errors.As(err, &e) {
@@ -65,8 +74,14 @@ func (h *hnsw) selectNeighborsHeuristic(input *priorityqueue.Queue,
 		}
 		good := true
 		for _, item := range returnList {
-			peerDist, _, _ := h.distancerProvider.SingleDist(currVec,
-				vecs[item.Index])
+			var peerDist float32
+			if compressed {
+				peerDist = h.pq.DistanceBetweenCodes(codes[curr.Index],
+					codes[item.Index])
+			} else {
+				peerDist, _, _ = h.distancerProvider.SingleDist(vecs[curr.Index],
+					vecs[item.Index])
+			}
 
 			if peerDist < distToQuery {
 				good = false
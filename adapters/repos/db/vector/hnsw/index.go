@@ -17,6 +17,7 @@ import (
 	"io"
 	"math"
 	"math/rand"
+	"os"
 	"sync"
 	"time"
 
@@ -92,6 +93,18 @@ type hnsw struct {
 
 	cache cache
 
+	// product quantization, see compression.go for details. pq and
+	// compressedCache are only set once compressed is set to 1.
+	pqConfig        ent.PQConfig
+	compressed      int32
+	compressing     int32
+	pq              *distancer.ProductQuantizer
+	compressedCache *compressedCache
+
+	// fullVectorForID always reads the uncompressed vector from the object
+	// store, it is used to rescore search results if the index is compressed
+	fullVectorForID VectorForID
+
 	commitLog CommitLogger
 
 	// a lookup of current tombstones (i.e. nodes that have received a tombstone,
@@ -217,6 +230,8 @@ func New(cfg Config, uc ent.UserConfig) (*hnsw, error) {
 		cache:             vectorCache,
 		vectorForID:       vectorCache.get,
 		multiVectorForID:  vectorCache.multiGet,
+		fullVectorForID:   normalizedVectorForID(cfg.VectorForIDThunk, normalizeOnRead),
+		pqConfig:          uc.PQ,
 		id:                cfg.ID,
 		rootPath:          cfg.RootPath,
 		tombstones:        map[uint64]struct{}{},
@@ -396,6 +411,10 @@ func min(a, b int) int {
 }
 
 func (h *hnsw) distBetweenNodes(a, b uint64) (float32, bool, error) {
+	if h.isCompressed() {
+		return h.distBetweenCompressedNodes(a, b)
+	}
+
 	// TODO: introduce single search/transaction context instead of spawning new
 	// ones
 	vecA, err := h.vectorForID(context.Background(), a)
@@ -436,6 +455,10 @@ func (h *hnsw) distBetweenNodes(a, b uint64) (float32, bool, error) {
 }
 
 func (h *hnsw) distBetweenNodeAndVec(node uint64, vecB []float32) (float32, bool, error) {
+	if h.isCompressed() {
+		return h.distBetweenCompressedNodeAndVec(node, vecB)
+	}
+
 	// TODO: introduce single search/transaction context instead of spawning new
 	// ones
 	vecA, err := h.vectorForID(context.Background(), node)
@@ -539,6 +562,11 @@ func (h *hnsw) Drop(ctx context.Context) error {
 	// cancel vector cache goroutine
 	h.cache.drop()
 
+	if err := os.Remove(pqFileName(h.rootPath, h.id)); err != nil &&
+		!os.IsNotExist(err) {
+		return errors.Wrap(err, "remove product quantizer")
+	}
+
 	// cancel commit logger last, as the tombstone cleanup cycle might still
 	// write while it's still running
 	err := h.commitLog.Drop(ctx)
@@ -549,6 +577,22 @@ func (h *hnsw) Drop(ctx context.Context) error {
 	return nil
 }
 
+// DropFiles removes all files an index with the given id keeps in rootPath
+// without loading the index first. This is meant for leftovers of an index
+// which was never completed, for example because of a crash.
+func DropFiles(rootPath, id string) error {
+	if err := os.Remove(pqFileName(rootPath, id)); err != nil &&
+		!os.IsNotExist(err) {
+		return errors.Wrap(err, "remove product quantizer")
+	}
+
+	if err := os.RemoveAll(commitLogDirectory(rootPath, id)); err != nil {
+		return errors.Wrap(err, "remove commit logs")
+	}
+
+	return nil
+}
+
 func (h *hnsw) Shutdown(ctx context.Context) error {
 	if err := h.tombstoneCleanupCycle.StopAndWait(ctx); err != nil {
 		return errors.Wrap(err, "hnsw shutdown")
@@ -38,7 +38,12 @@ func (h *hnsw) Add(id uint64, vector []float32) error {
 		vector = distancer.Normalize(vector)
 	}
 
-	return h.insert(node, vector)
+	if err := h.insert(node, vector); err != nil {
+		return err
+	}
+
+	h.compressIfDue(id)
+	return nil
 }
 
 func (h *hnsw) insertInitialElement(node *vertex, nodeVec []float32) error {
@@ -65,7 +70,9 @@ func (h *hnsw) insertInitialElement(node *vertex, nodeVec []float32) error {
 	}
 
 	h.nodes[node.id] = node
-	h.cache.preload(node.id, nodeVec)
+	if err := h.preloadVector(node.id, nodeVec); err != nil {
+		return err
+	}
 
 	// go h.insertHook(node.id, 0, node.connections)
 	return nil
@@ -134,7 +141,9 @@ func (h *hnsw) insert(node *vertex, nodeVec []float32) error {
 
 	// // make sure this new vec is immediately present in the cache, so we don't
 	// // have to read it from disk again
-	h.cache.preload(node.id, nodeVec)
+	if err := h.preloadVector(node.id, nodeVec); err != nil {
+		return err
+	}
 
 	h.Lock()
 	h.nodes[nodeId] = node
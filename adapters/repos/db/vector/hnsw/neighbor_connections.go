@@ -245,7 +245,10 @@ func (n *neighborFinderConnector) pickEntrypoint() error {
 	//
 	// 3. we need to be able to obtain a vector for it
 
-	localDeny := n.denyList.DeepCopy()
+	localDeny := helpers.NewAllowList()
+	if n.denyList != nil {
+		localDeny = n.denyList.DeepCopy()
+	}
 	candidate := n.entryPointID
 
 	// make sure the loop cannot block forever. In most cases, results should be
@@ -68,7 +68,7 @@ func (h *hnsw) SearchByVector(vector []float32, k int, allowList helpers.AllowLi
 	}
 
 	flatSearchCutoff := int(atomic.LoadInt64(&h.flatSearchCutoff))
-	if allowList != nil && !h.forbidFlat && len(allowList) < flatSearchCutoff {
+	if allowList != nil && !h.forbidFlat && allowList.Len() < flatSearchCutoff {
 		return h.flatSearch(vector, k, allowList)
 	}
 	return h.knnSearchByVector(vector, k, h.searchTimeEF(k), allowList)
@@ -163,7 +163,10 @@ func (h *hnsw) searchLayerByVector(queryVector []float32,
 
 	candidates := h.pools.pqCandidates.GetMin(ef)
 	results := h.pools.pqResults.GetMax(ef)
-	distancer := h.distancerProvider.New(queryVector)
+	distancer, err := h.newNodeDistancer(queryVector)
+	if err != nil {
+		return nil, errors.Wrap(err, "create distancer for query")
+	}
 
 	h.insertViableEntrypointsAsCandidatesAndResults(entrypoints, candidates,
 		results, level, visited, allowList)
@@ -321,7 +324,7 @@ func (h *hnsw) insertViableEntrypointsAsCandidatesAndResults(
 }
 
 func (h *hnsw) currentWorstResultDistance(results *priorityqueue.Queue,
-	distancer distancer.Distancer,
+	distancer *nodeDistancer,
 ) (float32, error) {
 	if results.Len() > 0 {
 		id := results.Top().ID
@@ -344,9 +347,18 @@ func (h *hnsw) currentWorstResultDistance(results *priorityqueue.Queue,
 	}
 }
 
-func (h *hnsw) distanceToNode(distancer distancer.Distancer,
+func (h *hnsw) distanceToNode(distancer *nodeDistancer,
 	nodeID uint64,
 ) (float32, bool, error) {
+	if distancer.compressed != nil {
+		code, ok, err := h.compressedVectorForID(nodeID)
+		if err != nil || !ok {
+			return 0, ok, err
+		}
+
+		return distancer.compressed.Distance(code)
+	}
+
 	candidateVec, err := h.vectorForID(context.Background(), nodeID)
 	if err != nil {
 		var e storobj.ErrNotFound
@@ -359,7 +371,7 @@ func (h *hnsw) distanceToNode(distancer distancer.Distancer,
 		}
 	}
 
-	dist, _, err := distancer.Distance(candidateVec)
+	dist, _, err := distancer.full.Distance(candidateVec)
 	if err != nil {
 		return 0, false, errors.Wrap(err, "calculate distance between candidate and query")
 	}
@@ -454,6 +466,18 @@ func (h *hnsw) knnSearchByVector(searchVec []float32, k int,
 		return nil, nil, errors.Wrapf(err, "knn search: search layer at level %d", 0)
 	}
 
+	if h.isCompressed() {
+		// the distances are approximations, so rescore all ef candidates with
+		// the full vectors to find the actual top k
+		candidates := make([]uint64, 0, res.Len())
+		for res.Len() > 0 {
+			candidates = append(candidates, res.Pop().ID)
+		}
+		h.pools.pqResults.Put(res)
+
+		return h.rescore(searchVec, candidates, k)
+	}
+
 	for res.Len() > k {
 		res.Pop()
 	}
@@ -65,6 +65,15 @@ func (h *hnsw) KnnSearchByVectorMaxDist(searchVec []float32, dist float32,
 		i--
 	}
 
+	if h.isCompressed() {
+		// compare the exact distances against the threshold, not the
+		// approximations
+		all, err = h.rescoreItems(searchVec, all)
+		if err != nil {
+			return nil, errors.Wrap(err, "knn search: rescore")
+		}
+	}
+
 	out := make([]uint64, len(all))
 	i = 0
 	for _, elem := range all {
@@ -31,6 +31,10 @@ func (h *hnsw) init(cfg Config) error {
 		return errors.Wrapf(err, "restore hnsw index %q", cfg.ID)
 	}
 
+	if err := h.restoreQuantizer(); err != nil {
+		return errors.Wrapf(err, "restore hnsw index %q", cfg.ID)
+	}
+
 	// init commit logger for future writes
 	cl, err := cfg.MakeCommitLoggerThunk()
 	if err != nil {
@@ -147,6 +151,12 @@ func (h *hnsw) PostStartup() {
 }
 
 func (h *hnsw) prefillCache() {
+	if h.isCompressed() {
+		// the uncompressed cache is only used until the index is compressed
+		h.prefillCompressedCache()
+		return
+	}
+
 	limit := int(h.cache.copyMaxSize())
 
 	go func() {
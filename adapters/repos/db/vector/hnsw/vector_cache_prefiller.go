@@ -33,6 +33,7 @@ type cache interface {
 	prefetch(id uint64)
 	grow(size uint64)
 	drop()
+	deleteAllVectors()
 	updateMaxSize(size int64)
 	copyMaxSize() int64
 }
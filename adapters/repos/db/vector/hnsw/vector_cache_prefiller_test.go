@@ -110,6 +110,10 @@ func (f *fakeCache) drop() {
 	panic("not implemented")
 }
 
+func (f *fakeCache) deleteAllVectors() {
+	panic("not implemented")
+}
+
 func (f *fakeCache) copyMaxSize() int64 {
 	return 1e6
 }
@@ -0,0 +1,129 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	flatent "github.com/semi-technologies/weaviate/entities/vectorindex/flat"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCRUD_FlatVectorIndex(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		Class:               "FlatIndexedClass",
+		VectorIndexType:     flatent.IndexType,
+		VectorIndexConfig:   flatent.UserConfig{Distance: "l2-squared"},
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{{
+			Name:     "position",
+			DataType: []string{string(schema.DataTypeInt)},
+		}},
+	}
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo := New(logger, Config{
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+		FlushIdleAfter:            60,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+	migrator := NewMigrator(repo, logger)
+
+	t.Run("creating the class", func(t *testing.T) {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+
+		schemaGetter.schema = schema.Schema{
+			Objects: &models.Schema{
+				Classes: []*models.Class{class},
+			},
+		}
+	})
+
+	ids := make([]strfmt.UUID, 10)
+	t.Run("importing objects", func(t *testing.T) {
+		for i := range ids {
+			ids[i] = strfmt.UUID(fmt.Sprintf("8d5a3aa2-3c8d-4589-9ae1-3f638f506%03d", i))
+			obj := &models.Object{
+				ID:         ids[i],
+				Class:      class.Class,
+				Properties: map[string]interface{}{"position": int64(i)},
+			}
+			require.Nil(t, repo.PutObject(context.Background(), obj,
+				[]float32{float32(i), 0}, nil))
+		}
+	})
+
+	t.Run("searching by vector scans all objects", func(t *testing.T) {
+		res, err := repo.VectorClassSearch(context.Background(), traverser.GetParams{
+			ClassName:    class.Class,
+			SearchVector: []float32{6.9, 0},
+			Pagination:   &filters.Pagination{Limit: 3},
+		})
+		require.Nil(t, err)
+		require.Len(t, res, 3)
+		assert.Equal(t, ids[7], res[0].ID)
+		assert.Equal(t, ids[6], res[1].ID)
+		assert.Equal(t, ids[8], res[2].ID)
+	})
+
+	t.Run("searching by vector with a filter", func(t *testing.T) {
+		res, err := repo.VectorClassSearch(context.Background(), traverser.GetParams{
+			ClassName:    class.Class,
+			SearchVector: []float32{6.9, 0},
+			Pagination:   &filters.Pagination{Limit: 3},
+			Filters:      buildFilter("position", 3, lt, dtInt),
+		})
+		require.Nil(t, err)
+		require.Len(t, res, 3)
+		assert.Equal(t, ids[2], res[0].ID)
+		assert.Equal(t, ids[1], res[1].ID)
+		assert.Equal(t, ids[0], res[2].ID)
+	})
+
+	t.Run("deleted objects are no longer found", func(t *testing.T) {
+		require.Nil(t, repo.DeleteObject(context.Background(), class.Class, ids[7], nil, ""))
+
+		res, err := repo.VectorClassSearch(context.Background(), traverser.GetParams{
+			ClassName:    class.Class,
+			SearchVector: []float32{6.9, 0},
+			Pagination:   &filters.Pagination{Limit: 1},
+		})
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, ids[6], res[0].ID)
+	})
+
+	t.Run("the distance is immutable", func(t *testing.T) {
+		err := migrator.ValidateVectorIndexConfigUpdate(context.Background(),
+			flatent.UserConfig{Distance: "l2-squared"}, flatent.UserConfig{Distance: "dot"})
+		assert.NotNil(t, err)
+	})
+}
@@ -42,6 +42,10 @@ type ClientService interface {
 
 	BackupsCreateStatus(params *BackupsCreateStatusParams, authInfo runtime.ClientAuthInfoWriter) (*BackupsCreateStatusOK, error)
 
+	BackupsDelete(params *BackupsDeleteParams, authInfo runtime.ClientAuthInfoWriter) (*BackupsDeleteNoContent, error)
+
+	BackupsList(params *BackupsListParams, authInfo runtime.ClientAuthInfoWriter) (*BackupsListOK, error)
+
 	BackupsRestore(params *BackupsRestoreParams, authInfo runtime.ClientAuthInfoWriter) (*BackupsRestoreOK, error)
 
 	BackupsRestoreStatus(params *BackupsRestoreStatusParams, authInfo runtime.ClientAuthInfoWriter) (*BackupsRestoreStatusOK, error)
@@ -119,6 +123,76 @@ func (a *Client) BackupsCreateStatus(params *BackupsCreateStatusParams, authInfo
 	panic(msg)
 }
 
+/*
+BackupsDelete Deletes a backup and all of its files from a backup backend
+*/
+func (a *Client) BackupsDelete(params *BackupsDeleteParams, authInfo runtime.ClientAuthInfoWriter) (*BackupsDeleteNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewBackupsDeleteParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "backups.delete",
+		Method:             "DELETE",
+		PathPattern:        "/backups/{backend}/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &BackupsDeleteReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*BackupsDeleteNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for backups.delete: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+BackupsList Returns the backups stored by a backup backend
+*/
+func (a *Client) BackupsList(params *BackupsListParams, authInfo runtime.ClientAuthInfoWriter) (*BackupsListOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewBackupsListParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "backups.list",
+		Method:             "GET",
+		PathPattern:        "/backups/{backend}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &BackupsListReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*BackupsListOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for backups.list: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 BackupsRestore Starts a process of restoring a backup for a set of classes
 */
@@ -76,6 +76,16 @@ type BatchObjectsDeleteParams struct {
 
 	/*Body*/
 	Body *models.BatchDelete
+	/*ConsistencyLevel
+	  Determines how many replicas must acknowledge a request before it is considered successful
+
+	*/
+	ConsistencyLevel *string
+	/*Tenant
+	  Specifies the tenant in a request targeting a multi-tenant class
+
+	*/
+	Tenant *string
 
 	timeout    time.Duration
 	Context    context.Context
@@ -126,6 +136,28 @@ func (o *BatchObjectsDeleteParams) SetBody(body *models.BatchDelete) {
 	o.Body = body
 }
 
+// WithConsistencyLevel adds the consistencyLevel to the batch objects delete params
+func (o *BatchObjectsDeleteParams) WithConsistencyLevel(consistencyLevel *string) *BatchObjectsDeleteParams {
+	o.SetConsistencyLevel(consistencyLevel)
+	return o
+}
+
+// SetConsistencyLevel adds the consistencyLevel to the batch objects delete params
+func (o *BatchObjectsDeleteParams) SetConsistencyLevel(consistencyLevel *string) {
+	o.ConsistencyLevel = consistencyLevel
+}
+
+// WithTenant adds the tenant to the batch objects delete params
+func (o *BatchObjectsDeleteParams) WithTenant(tenant *string) *BatchObjectsDeleteParams {
+	o.SetTenant(tenant)
+	return o
+}
+
+// SetTenant adds the tenant to the batch objects delete params
+func (o *BatchObjectsDeleteParams) SetTenant(tenant *string) {
+	o.Tenant = tenant
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *BatchObjectsDeleteParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -140,6 +172,38 @@ func (o *BatchObjectsDeleteParams) WriteToRequest(r runtime.ClientRequest, reg s
 		}
 	}
 
+	if o.ConsistencyLevel != nil {
+
+		// query param consistency_level
+		var qrConsistencyLevel string
+		if o.ConsistencyLevel != nil {
+			qrConsistencyLevel = *o.ConsistencyLevel
+		}
+		qConsistencyLevel := qrConsistencyLevel
+		if qConsistencyLevel != "" {
+			if err := r.SetQueryParam("consistency_level", qConsistencyLevel); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Tenant != nil {
+
+		// query param tenant
+		var qrTenant string
+		if o.Tenant != nil {
+			qrTenant = *o.Tenant
+		}
+		qTenant := qrTenant
+		if qTenant != "" {
+			if err := r.SetQueryParam("tenant", qTenant); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -79,6 +79,11 @@ type BatchReferencesCreateParams struct {
 
 	*/
 	Body []*models.BatchReference
+	/*ConsistencyLevel
+	  Determines how many replicas must acknowledge a request before it is considered successful
+
+	*/
+	ConsistencyLevel *string
 
 	timeout    time.Duration
 	Context    context.Context
@@ -129,6 +134,17 @@ func (o *BatchReferencesCreateParams) SetBody(body []*models.BatchReference) {
 	o.Body = body
 }
 
+// WithConsistencyLevel adds the consistencyLevel to the batch references create params
+func (o *BatchReferencesCreateParams) WithConsistencyLevel(consistencyLevel *string) *BatchReferencesCreateParams {
+	o.SetConsistencyLevel(consistencyLevel)
+	return o
+}
+
+// SetConsistencyLevel adds the consistencyLevel to the batch references create params
+func (o *BatchReferencesCreateParams) SetConsistencyLevel(consistencyLevel *string) {
+	o.ConsistencyLevel = consistencyLevel
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *BatchReferencesCreateParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -143,6 +159,22 @@ func (o *BatchReferencesCreateParams) WriteToRequest(r runtime.ClientRequest, re
 		}
 	}
 
+	if o.ConsistencyLevel != nil {
+
+		// query param consistency_level
+		var qrConsistencyLevel string
+		if o.ConsistencyLevel != nil {
+			qrConsistencyLevel = *o.ConsistencyLevel
+		}
+		qConsistencyLevel := qrConsistencyLevel
+		if qConsistencyLevel != "" {
+			if err := r.SetQueryParam("consistency_level", qConsistencyLevel); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
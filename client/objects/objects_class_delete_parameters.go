@@ -74,11 +74,21 @@ type ObjectsClassDeleteParams struct {
 
 	/*ClassName*/
 	ClassName string
+	/*ConsistencyLevel
+	  Determines how many replicas must acknowledge a request before it is considered successful
+
+	*/
+	ConsistencyLevel *string
 	/*ID
 	  Unique ID of the Object.
 
 	*/
 	ID strfmt.UUID
+	/*Tenant
+	  Specifies the tenant in a request targeting a multi-tenant class
+
+	*/
+	Tenant *string
 
 	timeout    time.Duration
 	Context    context.Context
@@ -129,6 +139,17 @@ func (o *ObjectsClassDeleteParams) SetClassName(className string) {
 	o.ClassName = className
 }
 
+// WithConsistencyLevel adds the consistencyLevel to the objects class delete params
+func (o *ObjectsClassDeleteParams) WithConsistencyLevel(consistencyLevel *string) *ObjectsClassDeleteParams {
+	o.SetConsistencyLevel(consistencyLevel)
+	return o
+}
+
+// SetConsistencyLevel adds the consistencyLevel to the objects class delete params
+func (o *ObjectsClassDeleteParams) SetConsistencyLevel(consistencyLevel *string) {
+	o.ConsistencyLevel = consistencyLevel
+}
+
 // WithID adds the id to the objects class delete params
 func (o *ObjectsClassDeleteParams) WithID(id strfmt.UUID) *ObjectsClassDeleteParams {
 	o.SetID(id)
@@ -140,6 +161,17 @@ func (o *ObjectsClassDeleteParams) SetID(id strfmt.UUID) {
 	o.ID = id
 }
 
+// WithTenant adds the tenant to the objects class delete params
+func (o *ObjectsClassDeleteParams) WithTenant(tenant *string) *ObjectsClassDeleteParams {
+	o.SetTenant(tenant)
+	return o
+}
+
+// SetTenant adds the tenant to the objects class delete params
+func (o *ObjectsClassDeleteParams) SetTenant(tenant *string) {
+	o.Tenant = tenant
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *ObjectsClassDeleteParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -153,11 +185,43 @@ func (o *ObjectsClassDeleteParams) WriteToRequest(r runtime.ClientRequest, reg s
 		return err
 	}
 
+	if o.ConsistencyLevel != nil {
+
+		// query param consistency_level
+		var qrConsistencyLevel string
+		if o.ConsistencyLevel != nil {
+			qrConsistencyLevel = *o.ConsistencyLevel
+		}
+		qConsistencyLevel := qrConsistencyLevel
+		if qConsistencyLevel != "" {
+			if err := r.SetQueryParam("consistency_level", qConsistencyLevel); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	// path param id
 	if err := r.SetPathParam("id", o.ID.String()); err != nil {
 		return err
 	}
 
+	if o.Tenant != nil {
+
+		// query param tenant
+		var qrTenant string
+		if o.Tenant != nil {
+			qrTenant = *o.Tenant
+		}
+		qTenant := qrTenant
+		if qTenant != "" {
+			if err := r.SetQueryParam("tenant", qTenant); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -58,6 +58,12 @@ func (o *ObjectsClassDeleteReader) ReadResponse(response runtime.ClientResponse,
 			return nil, err
 		}
 		return nil, result
+	case 422:
+		result := NewObjectsClassDeleteUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewObjectsClassDeleteInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -170,6 +176,40 @@ func (o *ObjectsClassDeleteNotFound) readResponse(response runtime.ClientRespons
 	return nil
 }
 
+// NewObjectsClassDeleteUnprocessableEntity creates a ObjectsClassDeleteUnprocessableEntity with default headers values
+func NewObjectsClassDeleteUnprocessableEntity() *ObjectsClassDeleteUnprocessableEntity {
+	return &ObjectsClassDeleteUnprocessableEntity{}
+}
+
+/*
+ObjectsClassDeleteUnprocessableEntity handles this case with default header values.
+
+Request is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the tenant exists and the class has multi-tenancy enabled?
+*/
+type ObjectsClassDeleteUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *ObjectsClassDeleteUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[DELETE /objects/{className}/{id}][%d] objectsClassDeleteUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *ObjectsClassDeleteUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ObjectsClassDeleteUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewObjectsClassDeleteInternalServerError creates a ObjectsClassDeleteInternalServerError with default headers values
 func NewObjectsClassDeleteInternalServerError() *ObjectsClassDeleteInternalServerError {
 	return &ObjectsClassDeleteInternalServerError{}
@@ -74,6 +74,11 @@ type ObjectsClassGetParams struct {
 
 	/*ClassName*/
 	ClassName string
+	/*ConsistencyLevel
+	  Determines how many replicas must acknowledge a request before it is considered successful
+
+	*/
+	ConsistencyLevel *string
 	/*ID
 	  Unique ID of the Object.
 
@@ -84,6 +89,11 @@ type ObjectsClassGetParams struct {
 
 	*/
 	Include *string
+	/*Tenant
+	  Specifies the tenant in a request targeting a multi-tenant class
+
+	*/
+	Tenant *string
 
 	timeout    time.Duration
 	Context    context.Context
@@ -134,6 +144,17 @@ func (o *ObjectsClassGetParams) SetClassName(className string) {
 	o.ClassName = className
 }
 
+// WithConsistencyLevel adds the consistencyLevel to the objects class get params
+func (o *ObjectsClassGetParams) WithConsistencyLevel(consistencyLevel *string) *ObjectsClassGetParams {
+	o.SetConsistencyLevel(consistencyLevel)
+	return o
+}
+
+// SetConsistencyLevel adds the consistencyLevel to the objects class get params
+func (o *ObjectsClassGetParams) SetConsistencyLevel(consistencyLevel *string) {
+	o.ConsistencyLevel = consistencyLevel
+}
+
 // WithID adds the id to the objects class get params
 func (o *ObjectsClassGetParams) WithID(id strfmt.UUID) *ObjectsClassGetParams {
 	o.SetID(id)
@@ -156,6 +177,17 @@ func (o *ObjectsClassGetParams) SetInclude(include *string) {
 	o.Include = include
 }
 
+// WithTenant adds the tenant to the objects class get params
+func (o *ObjectsClassGetParams) WithTenant(tenant *string) *ObjectsClassGetParams {
+	o.SetTenant(tenant)
+	return o
+}
+
+// SetTenant adds the tenant to the objects class get params
+func (o *ObjectsClassGetParams) SetTenant(tenant *string) {
+	o.Tenant = tenant
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *ObjectsClassGetParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -169,6 +201,22 @@ func (o *ObjectsClassGetParams) WriteToRequest(r runtime.ClientRequest, reg strf
 		return err
 	}
 
+	if o.ConsistencyLevel != nil {
+
+		// query param consistency_level
+		var qrConsistencyLevel string
+		if o.ConsistencyLevel != nil {
+			qrConsistencyLevel = *o.ConsistencyLevel
+		}
+		qConsistencyLevel := qrConsistencyLevel
+		if qConsistencyLevel != "" {
+			if err := r.SetQueryParam("consistency_level", qConsistencyLevel); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	// path param id
 	if err := r.SetPathParam("id", o.ID.String()); err != nil {
 		return err
@@ -190,6 +238,22 @@ func (o *ObjectsClassGetParams) WriteToRequest(r runtime.ClientRequest, reg strf
 
 	}
 
+	if o.Tenant != nil {
+
+		// query param tenant
+		var qrTenant string
+		if o.Tenant != nil {
+			qrTenant = *o.Tenant
+		}
+		qTenant := qrTenant
+		if qTenant != "" {
+			if err := r.SetQueryParam("tenant", qTenant); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -64,6 +64,12 @@ func (o *ObjectsClassGetReader) ReadResponse(response runtime.ClientResponse, co
 			return nil, err
 		}
 		return nil, result
+	case 422:
+		result := NewObjectsClassGetUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewObjectsClassGetInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -222,6 +228,40 @@ func (o *ObjectsClassGetNotFound) readResponse(response runtime.ClientResponse,
 	return nil
 }
 
+// NewObjectsClassGetUnprocessableEntity creates a ObjectsClassGetUnprocessableEntity with default headers values
+func NewObjectsClassGetUnprocessableEntity() *ObjectsClassGetUnprocessableEntity {
+	return &ObjectsClassGetUnprocessableEntity{}
+}
+
+/*
+ObjectsClassGetUnprocessableEntity handles this case with default header values.
+
+Request is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the tenant exists and the class has multi-tenancy enabled?
+*/
+type ObjectsClassGetUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *ObjectsClassGetUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[GET /objects/{className}/{id}][%d] objectsClassGetUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *ObjectsClassGetUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ObjectsClassGetUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewObjectsClassGetInternalServerError creates a ObjectsClassGetInternalServerError with default headers values
 func NewObjectsClassGetInternalServerError() *ObjectsClassGetInternalServerError {
 	return &ObjectsClassGetInternalServerError{}
@@ -58,6 +58,12 @@ func (o *ObjectsClassHeadReader) ReadResponse(response runtime.ClientResponse, c
 			return nil, err
 		}
 		return nil, result
+	case 422:
+		result := NewObjectsClassHeadUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewObjectsClassHeadInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -170,6 +176,40 @@ func (o *ObjectsClassHeadNotFound) readResponse(response runtime.ClientResponse,
 	return nil
 }
 
+// NewObjectsClassHeadUnprocessableEntity creates a ObjectsClassHeadUnprocessableEntity with default headers values
+func NewObjectsClassHeadUnprocessableEntity() *ObjectsClassHeadUnprocessableEntity {
+	return &ObjectsClassHeadUnprocessableEntity{}
+}
+
+/*
+ObjectsClassHeadUnprocessableEntity handles this case with default header values.
+
+Request is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the tenant exists and the class has multi-tenancy enabled?
+*/
+type ObjectsClassHeadUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *ObjectsClassHeadUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[HEAD /objects/{className}/{id}][%d] objectsClassHeadUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *ObjectsClassHeadUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ObjectsClassHeadUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewObjectsClassHeadInternalServerError creates a ObjectsClassHeadInternalServerError with default headers values
 func NewObjectsClassHeadInternalServerError() *ObjectsClassHeadInternalServerError {
 	return &ObjectsClassHeadInternalServerError{}
@@ -85,6 +85,11 @@ for the objects list operation typically these are written to a http.Request
 */
 type ObjectsListParams struct {
 
+	/*After
+	  The starting ID of the result window. Objects are returned in ID order, starting after the given ID. Requires the class parameter and can't be combined with offset, sort or order.
+
+	*/
+	After *string
 	/*Class
 	  Class parameter specifies the class from which to query objects
 
@@ -115,6 +120,11 @@ type ObjectsListParams struct {
 
 	*/
 	Sort *string
+	/*Tenant
+	  Specifies the tenant in a request targeting a multi-tenant class
+
+	*/
+	Tenant *string
 
 	timeout    time.Duration
 	Context    context.Context
@@ -154,6 +164,17 @@ func (o *ObjectsListParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithAfter adds the after to the objects list params
+func (o *ObjectsListParams) WithAfter(after *string) *ObjectsListParams {
+	o.SetAfter(after)
+	return o
+}
+
+// SetAfter adds the after to the objects list params
+func (o *ObjectsListParams) SetAfter(after *string) {
+	o.After = after
+}
+
 // WithClass adds the class to the objects list params
 func (o *ObjectsListParams) WithClass(class *string) *ObjectsListParams {
 	o.SetClass(class)
@@ -220,6 +241,17 @@ func (o *ObjectsListParams) SetSort(sort *string) {
 	o.Sort = sort
 }
 
+// WithTenant adds the tenant to the objects list params
+func (o *ObjectsListParams) WithTenant(tenant *string) *ObjectsListParams {
+	o.SetTenant(tenant)
+	return o
+}
+
+// SetTenant adds the tenant to the objects list params
+func (o *ObjectsListParams) SetTenant(tenant *string) {
+	o.Tenant = tenant
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *ObjectsListParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -228,6 +260,22 @@ func (o *ObjectsListParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.R
 	}
 	var res []error
 
+	if o.After != nil {
+
+		// query param after
+		var qrAfter string
+		if o.After != nil {
+			qrAfter = *o.After
+		}
+		qAfter := qrAfter
+		if qAfter != "" {
+			if err := r.SetQueryParam("after", qAfter); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	if o.Class != nil {
 
 		// query param class
@@ -324,6 +372,22 @@ func (o *ObjectsListParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.R
 
 	}
 
+	if o.Tenant != nil {
+
+		// query param tenant
+		var qrTenant string
+		if o.Tenant != nil {
+			qrTenant = *o.Tenant
+		}
+		qTenant := qrTenant
+		if qTenant != "" {
+			if err := r.SetQueryParam("tenant", qTenant); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
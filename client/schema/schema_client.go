@@ -48,12 +48,26 @@ type ClientService interface {
 
 	SchemaObjectsPropertiesAdd(params *SchemaObjectsPropertiesAddParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaObjectsPropertiesAddOK, error)
 
+	SchemaObjectsPropertiesDelete(params *SchemaObjectsPropertiesDeleteParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaObjectsPropertiesDeleteOK, error)
+
+	SchemaObjectsReindexCancel(params *SchemaObjectsReindexCancelParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaObjectsReindexCancelOK, error)
+
+	SchemaObjectsReindexCreate(params *SchemaObjectsReindexCreateParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaObjectsReindexCreateOK, error)
+
+	SchemaObjectsReindexGet(params *SchemaObjectsReindexGetParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaObjectsReindexGetOK, error)
+
 	SchemaObjectsShardsGet(params *SchemaObjectsShardsGetParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaObjectsShardsGetOK, error)
 
 	SchemaObjectsShardsUpdate(params *SchemaObjectsShardsUpdateParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaObjectsShardsUpdateOK, error)
 
 	SchemaObjectsUpdate(params *SchemaObjectsUpdateParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaObjectsUpdateOK, error)
 
+	SchemaTenantsCreate(params *SchemaTenantsCreateParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaTenantsCreateOK, error)
+
+	SchemaTenantsDelete(params *SchemaTenantsDeleteParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaTenantsDeleteOK, error)
+
+	SchemaTenantsGet(params *SchemaTenantsGetParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaTenantsGetOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
@@ -232,6 +246,152 @@ func (a *Client) SchemaObjectsPropertiesAdd(params *SchemaObjectsPropertiesAddPa
 	panic(msg)
 }
 
+/*
+SchemaObjectsPropertiesDelete removes a property from an object class
+
+The property is removed from the schema and its indexes are dropped right away. Its values are removed from the stored objects in the background, the progress is part of the nodes status.
+*/
+func (a *Client) SchemaObjectsPropertiesDelete(params *SchemaObjectsPropertiesDeleteParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaObjectsPropertiesDeleteOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsPropertiesDeleteParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "schema.objects.properties.delete",
+		Method:             "DELETE",
+		PathPattern:        "/schema/{className}/properties/{propertyName}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsPropertiesDeleteReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsPropertiesDeleteOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.properties.delete: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaObjectsReindexCancel cancels a reindex job
+
+Stops the job in every shard and removes the partially built indexes. The existing indexes and settings of the class remain unchanged.
+*/
+func (a *Client) SchemaObjectsReindexCancel(params *SchemaObjectsReindexCancelParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaObjectsReindexCancelOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsReindexCancelParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "schema.objects.reindex.cancel",
+		Method:             "DELETE",
+		PathPattern:        "/schema/{className}/reindex/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsReindexCancelReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsReindexCancelOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.reindex.cancel: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaObjectsReindexCreate rebuilds indexes of an object class with new settings
+
+Starts a job which rebuilds the inverted index of the given properties and/or the vector index of the class from the stored objects with the new settings. The new indexes are built next to the existing ones, which keep serving queries and writes, and are swapped in once they are complete in every shard.
+*/
+func (a *Client) SchemaObjectsReindexCreate(params *SchemaObjectsReindexCreateParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaObjectsReindexCreateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsReindexCreateParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "schema.objects.reindex.create",
+		Method:             "POST",
+		PathPattern:        "/schema/{className}/reindex",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsReindexCreateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsReindexCreateOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.reindex.create: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaObjectsReindexGet gets the status of a reindex job
+*/
+func (a *Client) SchemaObjectsReindexGet(params *SchemaObjectsReindexGetParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaObjectsReindexGetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsReindexGetParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "schema.objects.reindex.get",
+		Method:             "GET",
+		PathPattern:        "/schema/{className}/reindex/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsReindexGetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsReindexGetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.reindex.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 SchemaObjectsShardsGet gets the shards status of an object class
 */
@@ -339,6 +499,111 @@ func (a *Client) SchemaObjectsUpdate(params *SchemaObjectsUpdateParams, authInfo
 	panic(msg)
 }
 
+/*
+SchemaTenantsCreate create new tenants in a class with multi-tenancy enabled. Each tenant gets its own shard.
+*/
+func (a *Client) SchemaTenantsCreate(params *SchemaTenantsCreateParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaTenantsCreateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaTenantsCreateParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "schema.tenants.create",
+		Method:             "POST",
+		PathPattern:        "/schema/{className}/tenants",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaTenantsCreateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaTenantsCreateOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.tenants.create: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaTenantsDelete delete tenants from a class with multi-tenancy enabled. All data of the deleted tenants is removed.
+*/
+func (a *Client) SchemaTenantsDelete(params *SchemaTenantsDeleteParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaTenantsDeleteOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaTenantsDeleteParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "schema.tenants.delete",
+		Method:             "DELETE",
+		PathPattern:        "/schema/{className}/tenants",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaTenantsDeleteReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaTenantsDeleteOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.tenants.delete: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaTenantsGet list the tenants of a class with multi-tenancy enabled
+*/
+func (a *Client) SchemaTenantsGet(params *SchemaTenantsGetParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaTenantsGetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaTenantsGetParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "schema.tenants.get",
+		Method:             "GET",
+		PathPattern:        "/schema/{className}/tenants",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaTenantsGetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaTenantsGetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.tenants.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
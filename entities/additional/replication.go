@@ -0,0 +1,67 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package additional
+
+import "fmt"
+
+const (
+	// ConsistencyLevelOne requires a single replica to acknowledge a write or
+	// to answer a read
+	ConsistencyLevelOne = "ONE"
+	// ConsistencyLevelQuorum requires the majority of replicas
+	ConsistencyLevelQuorum = "QUORUM"
+	// ConsistencyLevelAll requires every replica
+	ConsistencyLevelAll = "ALL"
+
+	DefaultConsistencyLevel = ConsistencyLevelQuorum
+)
+
+// ReplicationProperties are set per request and control how the request is
+// handled by the replicas of a shard. A nil value uses the defaults.
+type ReplicationProperties struct {
+	ConsistencyLevel string
+}
+
+// Validate checks that the consistency level, if set, is a known one
+func (r *ReplicationProperties) Validate() error {
+	switch r.Level() {
+	case ConsistencyLevelOne, ConsistencyLevelQuorum, ConsistencyLevelAll:
+		return nil
+	default:
+		return fmt.Errorf("invalid consistency level %q, must be one of %s, %s, %s",
+			r.ConsistencyLevel, ConsistencyLevelOne, ConsistencyLevelQuorum,
+			ConsistencyLevelAll)
+	}
+}
+
+// Level returns the consistency level or the default one if none is set
+func (r *ReplicationProperties) Level() string {
+	if r == nil || r.ConsistencyLevel == "" {
+		return DefaultConsistencyLevel
+	}
+
+	return r.ConsistencyLevel
+}
+
+// RequiredReplicas returns how many out of the specified number of replicas
+// have to acknowledge a write or answer a read to satisfy the consistency
+// level
+func (r *ReplicationProperties) RequiredReplicas(replicas int) int {
+	switch r.Level() {
+	case ConsistencyLevelOne:
+		return 1
+	case ConsistencyLevelQuorum:
+		return replicas/2 + 1
+	default:
+		return replicas
+	}
+}
@@ -0,0 +1,60 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package additional
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplicationProperties(t *testing.T) {
+	t.Run("validation", func(t *testing.T) {
+		var unset *ReplicationProperties
+		assert.Nil(t, unset.Validate())
+		assert.Nil(t, (&ReplicationProperties{}).Validate())
+		assert.Nil(t, (&ReplicationProperties{ConsistencyLevel: "ONE"}).Validate())
+		assert.Nil(t, (&ReplicationProperties{ConsistencyLevel: "QUORUM"}).Validate())
+		assert.Nil(t, (&ReplicationProperties{ConsistencyLevel: "ALL"}).Validate())
+		assert.NotNil(t, (&ReplicationProperties{ConsistencyLevel: "one"}).Validate())
+		assert.NotNil(t, (&ReplicationProperties{ConsistencyLevel: "TWO"}).Validate())
+	})
+
+	t.Run("required replicas", func(t *testing.T) {
+		type testcase struct {
+			level    string
+			replicas int
+			expected int
+		}
+
+		tests := []testcase{
+			{level: "ONE", replicas: 1, expected: 1},
+			{level: "ONE", replicas: 3, expected: 1},
+			{level: "QUORUM", replicas: 1, expected: 1},
+			{level: "QUORUM", replicas: 2, expected: 2},
+			{level: "QUORUM", replicas: 3, expected: 2},
+			{level: "QUORUM", replicas: 4, expected: 3},
+			{level: "QUORUM", replicas: 5, expected: 3},
+			{level: "ALL", replicas: 3, expected: 3},
+			{level: "", replicas: 3, expected: 2},
+		}
+
+		for _, test := range tests {
+			repl := &ReplicationProperties{ConsistencyLevel: test.level}
+			assert.Equal(t, test.expected, repl.RequiredReplicas(test.replicas),
+				"%s of %d", test.level, test.replicas)
+		}
+
+		var unset *ReplicationProperties
+		assert.Equal(t, 2, unset.RequiredReplicas(3))
+	})
+}
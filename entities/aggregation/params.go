@@ -28,10 +28,12 @@ type Params struct {
 	Limit            *int                 `json:"limit"`
 	ObjectLimit      *int                 `json:"objectLimit"`
 	SearchVector     []float32
+	TargetVector     string
 	Certainty        float64
 	NearVector       *searchparams.NearVector
 	NearObject       *searchparams.NearObject
 	ModuleParams     map[string]interface{}
+	Tenant           string `json:"tenant"`
 }
 
 type ParamProperty struct {
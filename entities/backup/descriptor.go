@@ -13,6 +13,7 @@ package backup
 
 import (
 	"fmt"
+	"sort"
 	"time"
 )
 
@@ -33,6 +34,7 @@ type DistributedBackupDescriptor struct {
 	Version       string                     `json:"version"` //
 	ServerVersion string                     `json:"serverVersion"`
 	Error         string                     `json:"error"`
+	BaseBackupID  string                     `json:"baseBackupId,omitempty"` // backup this one is incremental to
 }
 
 // Len returns how many nodes exist in d
@@ -186,6 +188,21 @@ type ShardDescriptor struct {
 	PropLengthTracker     []byte `json:"propLengthTracker"`
 	ShardVersionPath      string `json:"shardVersionPath"`
 	Version               []byte `json:"version"`
+
+	// Checksums maps each file to the hex encoded SHA-256 of its content
+	Checksums map[string]string `json:"checksums,omitempty"`
+	// BaseFiles maps the files which are unchanged since a previous backup
+	// to the ID of the backup actually storing them. These files are not
+	// stored in this backup.
+	BaseFiles map[string]string `json:"baseFiles,omitempty"`
+}
+
+// ChunkDescriptor describes a compressed tar archive holding a subset of
+// the shard files of a class
+type ChunkDescriptor struct {
+	Key      string `json:"key"`      // object key relative to the node's backup folder
+	Size     int64  `json:"size"`     // size of the compressed archive in bytes
+	Checksum string `json:"checksum"` // hex encoded SHA-256 of the compressed archive
 }
 
 // ClassDescriptor contains everything needed to completely restore a class
@@ -194,7 +211,11 @@ type ClassDescriptor struct {
 	Shards        []ShardDescriptor `json:"shards"`
 	ShardingState []byte            `json:"shardingState"`
 	Schema        []byte            `json:"schema"`
-	Error         error             `json:"-"`
+	// Chunks is only set if the class has been backed up in archive mode.
+	// The shard files are then contained in these chunks instead of being
+	// stored individually.
+	Chunks []ChunkDescriptor `json:"chunks,omitempty"`
+	Error  error             `json:"-"`
 }
 
 // BackupDescriptor contains everything needed to completely restore a list of classes
@@ -207,6 +228,7 @@ type BackupDescriptor struct {
 	Version       string            `json:"version"` //
 	ServerVersion string            `json:"serverVersion"`
 	Error         string            `json:"error"`
+	BaseBackupID  string            `json:"baseBackupId,omitempty"` // backup this one is incremental to
 }
 
 // List all existing classes in d
@@ -306,11 +328,40 @@ func (d *BackupDescriptor) Validate() error {
 					return fmt.Errorf("invalid shard %q.%q: file number %d", c.Name, s.Name, i)
 				}
 			}
+			for fpath, id := range s.BaseFiles {
+				if id == "" || id == d.ID {
+					return fmt.Errorf("invalid shard %q.%q: base of file %q", c.Name, s.Name, fpath)
+				}
+			}
+		}
+		for i, chunk := range c.Chunks {
+			if chunk.Key == "" || chunk.Checksum == "" {
+				return fmt.Errorf("invalid class %q: chunk number %d", c.Name, i)
+			}
 		}
 	}
 	return nil
 }
 
+// BaseBackups returns the IDs of all previous backups which store files
+// referenced by d
+func (d *BackupDescriptor) BaseBackups() []string {
+	set := make(map[string]struct{}, 4)
+	for _, c := range d.Classes {
+		for _, s := range c.Shards {
+			for _, id := range s.BaseFiles {
+				set[id] = struct{}{}
+			}
+		}
+	}
+	lst := make([]string, 0, len(set))
+	for id := range set {
+		lst = append(lst, id)
+	}
+	sort.Strings(lst)
+	return lst
+}
+
 // ToDistributed is used just for backward compatibility with the old version.
 func (d *BackupDescriptor) ToDistributed() *DistributedBackupDescriptor {
 	node, cs := "", d.List()
@@ -327,6 +378,7 @@ func (d *BackupDescriptor) ToDistributed() *DistributedBackupDescriptor {
 		Version:       d.Version,
 		ServerVersion: d.ServerVersion,
 		Error:         d.Error,
+		BaseBackupID:  d.BaseBackupID,
 	}
 	if node != "" && len(cs) > 0 {
 		result.Nodes = map[string]*NodeDescriptor{node: {Classes: cs}}
@@ -178,6 +178,44 @@ func TestValidateBackup(t *testing.T) {
 				}},
 			}},
 		}, success: true},
+		{desc: BackupDescriptor{
+			ID: "1", Version: "1", ServerVersion: "1", StartedAt: timept,
+			Classes: []ClassDescriptor{{
+				Name: "n", Schema: bytes, ShardingState: bytes,
+				Chunks: []ChunkDescriptor{{Key: "n/chunk-0.tar.gz"}},
+			}},
+		}},
+		{desc: BackupDescriptor{
+			ID: "1", Version: "1", ServerVersion: "1", StartedAt: timept,
+			Classes: []ClassDescriptor{{
+				Name: "n", Schema: bytes, ShardingState: bytes,
+				Chunks: []ChunkDescriptor{{Key: "n/chunk-0.tar.gz", Size: 5, Checksum: "abc"}},
+			}},
+		}, success: true},
+		{desc: BackupDescriptor{
+			ID: "1", Version: "1", ServerVersion: "1", StartedAt: timept,
+			Classes: []ClassDescriptor{{
+				Name: "n", Schema: bytes, ShardingState: bytes,
+				Shards: []ShardDescriptor{{
+					Name: "n", Node: "n",
+					PropLengthTrackerPath: "n", DocIDCounterPath: "n", ShardVersionPath: "n",
+					DocIDCounter: bytes, Version: bytes, PropLengthTracker: bytes, Files: []string{"file"},
+					BaseFiles: map[string]string{"file": "1"},
+				}},
+			}},
+		}},
+		{desc: BackupDescriptor{
+			ID: "1", Version: "1", ServerVersion: "1", StartedAt: timept,
+			Classes: []ClassDescriptor{{
+				Name: "n", Schema: bytes, ShardingState: bytes,
+				Shards: []ShardDescriptor{{
+					Name: "n", Node: "n",
+					PropLengthTrackerPath: "n", DocIDCounterPath: "n", ShardVersionPath: "n",
+					DocIDCounter: bytes, Version: bytes, PropLengthTracker: bytes, Files: []string{"file"},
+					BaseFiles: map[string]string{"file": "0"},
+				}},
+			}},
+		}, success: true},
 	}
 	for i, tc := range tests {
 		err := tc.desc.Validate()
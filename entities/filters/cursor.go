@@ -0,0 +1,80 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package filters
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Cursor walks all objects of a class in the order of their ids. A page
+// contains up to Limit objects whose ids come strictly after After, an
+// empty After starts at the very first object.
+type Cursor struct {
+	After string `json:"after"`
+	Limit int    `json:"limit"`
+}
+
+// ExtractCursorFromArgs gets the cursor parameters out of a map. Not specific
+// to GQL, but can be used from GQL. nil is returned if no cursor is present.
+func ExtractCursorFromArgs(args map[string]interface{}) *Cursor {
+	after, ok := args["after"]
+	if !ok {
+		return nil
+	}
+
+	limit, ok := args["limit"]
+	if !ok || limit.(int) < 0 {
+		limit = LimitFlagNotSet
+	}
+
+	return &Cursor{
+		After: after.(string),
+		Limit: limit.(int),
+	}
+}
+
+// ValidateCursor makes sure a cursor is only combined with parameters that
+// do not break the id order, i.e. no offset, filters or sorting
+func ValidateCursor(className string, cursor *Cursor, offset int,
+	filters *LocalFilter, sort []Sort,
+) error {
+	if className == "" {
+		return fmt.Errorf("class must be set when using the after parameter")
+	}
+
+	var conflicting []string
+	if offset > 0 {
+		conflicting = append(conflicting, "offset")
+	}
+	if filters != nil {
+		conflicting = append(conflicting, "where")
+	}
+	if len(sort) > 0 {
+		conflicting = append(conflicting, "sort")
+	}
+	if len(conflicting) > 0 {
+		return fmt.Errorf("%s cannot be combined with the after parameter",
+			strings.Join(conflicting, ", "))
+	}
+
+	if cursor.After != "" {
+		if _, err := uuid.Parse(cursor.After); err != nil {
+			return fmt.Errorf("after parameter %q is not a valid uuid: %v",
+				cursor.After, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package filters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractCursor(t *testing.T) {
+	t.Run("without an after present", func(t *testing.T) {
+		c := ExtractCursorFromArgs(map[string]interface{}{
+			"limit": 25,
+		})
+		assert.Nil(t, c)
+	})
+
+	t.Run("with an after present", func(t *testing.T) {
+		c := ExtractCursorFromArgs(map[string]interface{}{
+			"after": "8d5a3aa2-3c8d-4589-9ae1-3f638f506970",
+		})
+		require.NotNil(t, c)
+		assert.Equal(t, "8d5a3aa2-3c8d-4589-9ae1-3f638f506970", c.After)
+		assert.Equal(t, LimitFlagNotSet, c.Limit)
+	})
+
+	t.Run("with after and limit present", func(t *testing.T) {
+		c := ExtractCursorFromArgs(map[string]interface{}{
+			"after": "",
+			"limit": 25,
+		})
+		require.NotNil(t, c)
+		assert.Equal(t, "", c.After)
+		assert.Equal(t, 25, c.Limit)
+	})
+}
+
+func TestValidateCursor(t *testing.T) {
+	validID := "8d5a3aa2-3c8d-4589-9ae1-3f638f506970"
+
+	t.Run("with a valid cursor", func(t *testing.T) {
+		err := ValidateCursor("MyClass", &Cursor{After: validID, Limit: 10}, 0, nil, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("with an empty after to start from the beginning", func(t *testing.T) {
+		err := ValidateCursor("MyClass", &Cursor{Limit: 10}, 0, nil, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("without a class", func(t *testing.T) {
+		err := ValidateCursor("", &Cursor{After: validID, Limit: 10}, 0, nil, nil)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "class must be set")
+	})
+
+	t.Run("with an invalid uuid", func(t *testing.T) {
+		err := ValidateCursor("MyClass", &Cursor{After: "foo", Limit: 10}, 0, nil, nil)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "not a valid uuid")
+	})
+
+	t.Run("with conflicting params", func(t *testing.T) {
+		err := ValidateCursor("MyClass", &Cursor{After: validID, Limit: 10}, 5,
+			&LocalFilter{}, []Sort{{Path: []string{"name"}, Order: "asc"}})
+		require.NotNil(t, err)
+		assert.Equal(t, "offset, where, sort cannot be combined with the after parameter",
+			err.Error())
+	})
+}
@@ -77,7 +77,7 @@ func TestValidatePropertyLength(t *testing.T) {
 		schemaType schema.DataType
 		valid      bool
 		operator   Operator
-		value      int
+		value      interface{}
 	}{
 		{
 			name:       "Valid datatype and operator",
@@ -114,6 +114,34 @@ func TestValidatePropertyLength(t *testing.T) {
 			operator:   OperatorEqual,
 			value:      -5,
 		},
+		{
+			name:       "Valid operator (In)",
+			schemaType: schema.DataTypeIntArray,
+			valid:      true,
+			operator:   OperatorIn,
+			value:      []int{0, 1},
+		},
+		{
+			name:       "Invalid value in In (negative)",
+			schemaType: schema.DataTypeIntArray,
+			valid:      false,
+			operator:   OperatorIn,
+			value:      []int{1, -1},
+		},
+		{
+			name:       "Invalid operator (IsNull)",
+			schemaType: schema.DataTypeInt,
+			valid:      false,
+			operator:   OperatorIsNull,
+			value:      1,
+		},
+		{
+			name:       "Invalid operator (ContainsAll)",
+			schemaType: schema.DataTypeIntArray,
+			valid:      false,
+			operator:   OperatorContainsAll,
+			value:      []int{1, 2},
+		},
 	}
 
 	for _, tt := range tests {
@@ -129,7 +157,7 @@ func TestValidatePropertyLength(t *testing.T) {
 				},
 			}}
 			cl := Clause{
-				Operator: OperatorEqual,
+				Operator: tt.operator,
 				Value:    &Value{Value: tt.value, Type: tt.schemaType},
 				On:       &Path{Class: "Car", Property: "len(horsepower)"},
 			}
@@ -142,3 +170,111 @@ func TestValidatePropertyLength(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateArrayOperators(t *testing.T) {
+	tests := []struct {
+		name     string
+		operator Operator
+		value    *Value
+		property string
+		valid    bool
+	}{
+		{
+			name:     "ContainsAny on an array prop",
+			operator: OperatorContainsAny,
+			value:    &Value{Value: []string{"a", "b"}, Type: schema.DataTypeStringArray},
+			property: "tags",
+			valid:    true,
+		},
+		{
+			name:     "ContainsAll on an array prop",
+			operator: OperatorContainsAll,
+			value:    &Value{Value: []string{"a", "b"}, Type: schema.DataTypeStringArray},
+			property: "tags",
+			valid:    true,
+		},
+		{
+			name:     "ContainsAny on a scalar prop",
+			operator: OperatorContainsAny,
+			value:    &Value{Value: []int{100, 200}, Type: schema.DataTypeIntArray},
+			property: "horsepower",
+			valid:    true,
+		},
+		{
+			name:     "In on a scalar prop",
+			operator: OperatorIn,
+			value:    &Value{Value: []int{100, 200}, Type: schema.DataTypeIntArray},
+			property: "horsepower",
+			valid:    true,
+		},
+		{
+			name:     "In on the id",
+			operator: OperatorIn,
+			value:    &Value{Value: []string{"id1", "id2"}, Type: schema.DataTypeStringArray},
+			property: "id",
+			valid:    true,
+		},
+		{
+			name:     "In on an array prop",
+			operator: OperatorIn,
+			value:    &Value{Value: []string{"a", "b"}, Type: schema.DataTypeStringArray},
+			property: "tags",
+			valid:    false,
+		},
+		{
+			name:     "mismatching value type",
+			operator: OperatorContainsAny,
+			value:    &Value{Value: []int{1}, Type: schema.DataTypeIntArray},
+			property: "tags",
+			valid:    false,
+		},
+		{
+			name:     "array operator with scalar value",
+			operator: OperatorContainsAny,
+			value:    &Value{Value: "a", Type: schema.DataTypeString},
+			property: "tags",
+			valid:    false,
+		},
+		{
+			name:     "scalar operator with array value",
+			operator: OperatorEqual,
+			value:    &Value{Value: []string{"a"}, Type: schema.DataTypeStringArray},
+			property: "tags",
+			valid:    false,
+		},
+		{
+			name:     "no values",
+			operator: OperatorContainsAny,
+			value:    &Value{Value: []string{}, Type: schema.DataTypeStringArray},
+			property: "tags",
+			valid:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sch := schema.Schema{Objects: &models.Schema{
+				Classes: []*models.Class{
+					{
+						Class: "Car",
+						Properties: []*models.Property{
+							{Name: "tags", DataType: []string{"string[]"}},
+							{Name: "horsepower", DataType: []string{"int"}},
+						},
+					},
+				},
+			}}
+			cl := Clause{
+				Operator: tt.operator,
+				Value:    tt.value,
+				On:       &Path{Class: "Car", Property: schema.PropertyName(tt.property)},
+			}
+			err := validateClause(sch, &cl)
+			if tt.valid {
+				require.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+			}
+		})
+	}
+}
@@ -13,6 +13,7 @@ package filters
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema"
@@ -33,6 +34,9 @@ const (
 	OperatorWithinGeoRange
 	OperatorLike
 	OperatorIsNull
+	OperatorContainsAny
+	OperatorContainsAll
+	OperatorIn
 )
 
 func (o Operator) OnValue() bool {
@@ -45,7 +49,23 @@ func (o Operator) OnValue() bool {
 		OperatorLessThanEqual,
 		OperatorWithinGeoRange,
 		OperatorLike,
-		OperatorIsNull:
+		OperatorIsNull,
+		OperatorContainsAny,
+		OperatorContainsAll,
+		OperatorIn:
+		return true
+	default:
+		return false
+	}
+}
+
+// OnArrayValue is true for operators that compare the property against a
+// list of values, such as a valueIntArray
+func (o Operator) OnArrayValue() bool {
+	switch o {
+	case OperatorContainsAny,
+		OperatorContainsAll,
+		OperatorIn:
 		return true
 	default:
 		return false
@@ -78,6 +98,12 @@ func (o Operator) Name() string {
 		return "Like"
 	case OperatorIsNull:
 		return "IsNull"
+	case OperatorContainsAny:
+		return "ContainsAny"
+	case OperatorContainsAll:
+		return "ContainsAll"
+	case OperatorIn:
+		return "In"
 	default:
 		panic("Unknown operator")
 	}
@@ -110,9 +136,86 @@ func (v *Value) UnmarshalJSON(data []byte) error {
 		v.Value = int(asFloat)
 	}
 
+	if asSlice, ok := v.Value.([]interface{}); ok {
+		v.Value = typedArrayValue(v.Type, asSlice)
+	}
+
 	return nil
 }
 
+// typedArrayValue restores the typed slice of an array value, which turns
+// into a []interface{} when going through JSON
+func typedArrayValue(dt schema.DataType, in []interface{}) interface{} {
+	switch dt {
+	case schema.DataTypeIntArray:
+		out := make([]int, len(in))
+		for i, elem := range in {
+			asFloat, _ := elem.(float64)
+			out[i] = int(asFloat)
+		}
+		return out
+	case schema.DataTypeNumberArray:
+		out := make([]float64, len(in))
+		for i, elem := range in {
+			out[i], _ = elem.(float64)
+		}
+		return out
+	case schema.DataTypeBooleanArray:
+		out := make([]bool, len(in))
+		for i, elem := range in {
+			out[i], _ = elem.(bool)
+		}
+		return out
+	case schema.DataTypeStringArray, schema.DataTypeTextArray,
+		schema.DataTypeDateArray:
+		out := make([]string, len(in))
+		for i, elem := range in {
+			out[i], _ = elem.(string)
+		}
+		return out
+	default:
+		return in
+	}
+}
+
+// ArrayValues splits the value of an array operator into one value per
+// element, each using the base type of the array
+func (v *Value) ArrayValues() ([]*Value, error) {
+	baseType, ok := schema.IsArrayType(v.Type)
+	if !ok {
+		return nil, fmt.Errorf("expected an array value, got type %q", v.Type)
+	}
+
+	var values []interface{}
+	switch typed := v.Value.(type) {
+	case []int:
+		for _, elem := range typed {
+			values = append(values, elem)
+		}
+	case []float64:
+		for _, elem := range typed {
+			values = append(values, elem)
+		}
+	case []bool:
+		for _, elem := range typed {
+			values = append(values, elem)
+		}
+	case []string:
+		for _, elem := range typed {
+			values = append(values, elem)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported array value %T", v.Value)
+	}
+
+	out := make([]*Value, len(values))
+	for i, value := range values {
+		out[i] = &Value{Value: value, Type: baseType}
+	}
+
+	return out, nil
+}
+
 type Clause struct {
 	Operator Operator `json:"operator"`
 	On       *Path    `json:"on"`
@@ -52,4 +52,23 @@ func TestSerializeValue(t *testing.T) {
 
 		assert.Equal(t, before, after)
 	})
+
+	t.Run("with array values", func(t *testing.T) {
+		for _, before := range []Value{
+			{Value: []int{1, 2}, Type: schema.DataTypeIntArray},
+			{Value: []float64{1.5, 2}, Type: schema.DataTypeNumberArray},
+			{Value: []bool{true}, Type: schema.DataTypeBooleanArray},
+			{Value: []string{"foo", "bar"}, Type: schema.DataTypeStringArray},
+			{Value: []string{"2022-01-01T00:00:00Z"}, Type: schema.DataTypeDateArray},
+		} {
+			bytes, err := json.Marshal(before)
+			require.Nil(t, err)
+
+			var after Value
+			err = json.Unmarshal(bytes, &after)
+			require.Nil(t, err)
+
+			assert.Equal(t, before, after)
+		}
+	})
 }
@@ -48,8 +48,15 @@ func validateClause(sch schema.Schema, clause *Clause) error {
 	className := clause.On.GetInnerMost().Class
 	propName := clause.On.GetInnerMost().Property
 
+	// the type of the individual values, which is the base type for array
+	// values, so that the checks below apply to both
+	valueType, err := validateArrayValue(clause)
+	if err != nil {
+		return err
+	}
+
 	if IsInternalProperty(propName) {
-		return validateInternalPropertyClause(propName, clause)
+		return validateInternalPropertyClause(propName, valueType)
 	}
 
 	class := sch.FindClassByName(className)
@@ -69,36 +76,39 @@ func validateClause(sch schema.Schema, clause *Clause) error {
 		return err
 	}
 
-	if clause.Operator == OperatorIsNull {
-		if clause.Value.Type == schema.DataTypeBoolean {
+	if clause.Operator == OperatorIn && !isPropLengthFilter {
+		if _, ok := schema.IsArrayType(schema.DataType(prop.DataType[0])); ok {
+			return errors.Errorf("operator In cannot be used on array property %q, "+
+				"use ContainsAny instead", propName)
+		}
+	}
+
+	if clause.Operator == OperatorIsNull && !isPropLengthFilter {
+		if valueType == schema.DataTypeBoolean {
 			return nil
-		} else {
-			errors.Errorf("operator IsNull requires a booleanValue, got %q instead",
-				valueNameFromDataType(clause.Value.Type))
 		}
+		return errors.Errorf("operator IsNull requires a valueBoolean, got %q instead",
+			valueNameFromDataType(valueType))
 	}
 
 	if isPropLengthFilter {
 		op := clause.Operator
-		if clause.Value.Type != schema.DataTypeInt {
+		if valueType != schema.DataTypeInt {
 			return errors.Errorf("Filtering for property length requires IntValue, got %q instead",
-				valueNameFromDataType(clause.Value.Type))
+				valueNameFromDataType(valueType))
 		} else if op != OperatorEqual && op != OperatorNotEqual &&
 			op != OperatorGreaterThan && op != OperatorGreaterThanEqual &&
-			op != OperatorLessThan && op != OperatorLessThanEqual {
-			return errors.Errorf("Filtering for property length supports operators (not) equal and greater/less than (equal), got %q instead",
+			op != OperatorLessThan && op != OperatorLessThanEqual && op != OperatorIn {
+			return errors.Errorf("Filtering for property length supports operators (not) equal, greater/less than (equal) and in, got %q instead",
 				op)
-		} else if clause.Value.Value.(int) < 0 {
-			return errors.Errorf("Can only filter for positive property length got %v instead", clause.Value.Value)
-		} else {
-			return nil
 		}
+		return validatePropertyLengthValue(clause)
 	}
 
 	if schema.IsRefDataType(prop.DataType) {
 		// bit of an edge case, directly on refs (i.e. not on a primitive prop of a
 		// ref) we only allow valueInt which is what's used to count references
-		if clause.Value.Type == schema.DataTypeInt {
+		if valueType == schema.DataTypeInt {
 			return nil
 		}
 
@@ -109,15 +119,15 @@ func validateClause(sch schema.Schema, clause *Clause) error {
 			"[<propName>, <ClassNameOfReferencedClass>, <primitvePropOnClass>]",
 			propName, prop.DataType[0])
 	} else if baseType, ok := schema.IsArrayType(schema.DataType(prop.DataType[0])); ok {
-		if baseType != clause.Value.Type {
+		if baseType != valueType {
 			return errors.Errorf("data type filter cannot use %q on type %q, use %q instead",
-				valueNameFromDataType(clause.Value.Type),
+				valueNameFromDataType(valueType),
 				schema.DataType(prop.DataType[0]),
 				valueNameFromDataType(baseType))
 		}
-	} else if prop.DataType[0] != string(clause.Value.Type) {
+	} else if prop.DataType[0] != string(valueType) {
 		return errors.Errorf("data type filter cannot use %q on type %q, use %q instead",
-			valueNameFromDataType(clause.Value.Type),
+			valueNameFromDataType(valueType),
 			schema.DataType(prop.DataType[0]),
 			valueNameFromDataType(schema.DataType(prop.DataType[0])))
 	}
@@ -125,7 +135,60 @@ func validateClause(sch schema.Schema, clause *Clause) error {
 	return nil
 }
 
+func validatePropertyLengthValue(clause *Clause) error {
+	values := []*Value{clause.Value}
+	if clause.Operator.OnArrayValue() {
+		var err error
+		if values, err = clause.Value.ArrayValues(); err != nil {
+			return err
+		}
+	}
+
+	for _, value := range values {
+		if value.Value.(int) < 0 {
+			return errors.Errorf("Can only filter for positive property length got %v instead", value.Value)
+		}
+	}
+
+	return nil
+}
+
+// validateArrayValue makes sure that array values are used with array
+// operators only and vice versa. It returns the type of the individual values.
+func validateArrayValue(clause *Clause) (schema.DataType, error) {
+	baseType, isArray := schema.IsArrayType(clause.Value.Type)
+	if !clause.Operator.OnArrayValue() {
+		if isArray {
+			return "", errors.Errorf("operator %q cannot be used with %q, "+
+				"use ContainsAny, ContainsAll or In instead",
+				clause.Operator.Name(), valueNameFromDataType(clause.Value.Type))
+		}
+		return clause.Value.Type, nil
+	}
+
+	if !isArray {
+		return "", errors.Errorf("operator %q requires an array value, got %q instead",
+			clause.Operator.Name(), valueNameFromDataType(clause.Value.Type))
+	}
+
+	values, err := clause.Value.ArrayValues()
+	if err != nil {
+		return "", err
+	}
+
+	if len(values) == 0 {
+		return "", errors.Errorf("operator %q requires at least one value",
+			clause.Operator.Name())
+	}
+
+	return baseType, nil
+}
+
 func valueNameFromDataType(dt schema.DataType) string {
+	if baseType, ok := schema.IsArrayType(dt); ok {
+		return valueNameFromDataType(baseType) + "Array"
+	}
+
 	return "value" + strings.ToUpper(string(dt[0])) + string(dt[1:])
 }
 
@@ -150,17 +213,17 @@ func IsInternalProperty(propName schema.PropertyName) bool {
 	}
 }
 
-func validateInternalPropertyClause(propName schema.PropertyName, clause *Clause) error {
+func validateInternalPropertyClause(propName schema.PropertyName, valueType schema.DataType) error {
 	switch propName {
 	case InternalPropBackwardsCompatID, InternalPropID:
-		if clause.Value.Type == schema.DataTypeString {
+		if valueType == schema.DataTypeString {
 			return nil
 		}
 		return errors.Errorf(
 			`using ["_id"] to filter by uuid: must use "valueString" to specify the id`)
 	case InternalPropCreationTimeUnix, InternalPropLastUpdateTimeUnix:
-		if clause.Value.Type == schema.DataTypeDate ||
-			clause.Value.Type == schema.DataTypeString {
+		if valueType == schema.DataTypeDate ||
+			valueType == schema.DataTypeString {
 			return nil
 		}
 		return errors.Errorf(
@@ -115,7 +115,7 @@ func ParsePath(pathElements []interface{}, rootClass string) (*Path, error) {
 			return nil, fmt.Errorf("Expected a valid class name in 'path' field for the filter but got '%s'", rawClassName)
 		}
 
-		propertyName, err := schema.ValidatePropertyName(rawPropertyName)
+		propertyName, err := parsePropertyName(rawPropertyName)
 		// Invalid property name?
 		// Try to parse it as as a reference.
 		if err != nil {
@@ -137,3 +137,18 @@ func ParsePath(pathElements []interface{}, rootClass string) (*Path, error) {
 
 	return sentinel.Child, nil
 }
+
+// parsePropertyName also accepts the length of a property in the form of
+// len(propName), the length index is then used instead of the property itself
+func parsePropertyName(rawPropertyName string) (schema.PropertyName, error) {
+	lengthPropName, ok := schema.IsPropertyLength(rawPropertyName, 0)
+	if !ok {
+		return schema.ValidatePropertyName(rawPropertyName)
+	}
+
+	if _, err := schema.ValidatePropertyName(lengthPropName); err != nil {
+		return "", err
+	}
+
+	return schema.PropertyName(rawPropertyName), nil
+}
@@ -33,6 +33,25 @@ func Test_ParsePath(t *testing.T) {
 		assert.Equal(t, expectedPath, path, "should parse the path correctly")
 	})
 
+	t.Run("with the length of a prop", func(t *testing.T) {
+		rootClass := "City"
+		segments := []interface{}{"len(name)"}
+		expectedPath := &Path{
+			Class:    "City",
+			Property: "len(name)",
+		}
+
+		path, err := ParsePath(segments, rootClass)
+
+		require.Nil(t, err, "should not error")
+		assert.Equal(t, expectedPath, path, "should parse the path correctly")
+	})
+
+	t.Run("with the length of an invalid prop", func(t *testing.T) {
+		_, err := ParsePath([]interface{}{"len(na-me)"}, "City")
+		assert.NotNil(t, err)
+	})
+
 	t.Run("with nested refs", func(t *testing.T) {
 		rootClass := "City"
 		segments := []interface{}{"inCountry", "Country", "inContinent", "Continent", "onPlanet", "Planet", "name"}
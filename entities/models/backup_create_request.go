@@ -26,7 +26,10 @@ import (
 // swagger:model BackupCreateRequest
 type BackupCreateRequest struct {
 
-	// Custom configuration for the backup creation process
+	// The ID of an earlier successful backup on the same backend. Files which are unchanged since then are referenced instead of being copied again.
+	BaseBackupID string `json:"baseBackupId,omitempty"`
+
+	// Custom configuration for the backup creation process. Set 'archive' to true to store the shard files in compressed, checksummed chunks of at most 'chunkSize' MB (default 128) using the 'compressionLevel' DefaultCompression, BestSpeed or BestCompression.
 	Config interface{} `json:"config,omitempty"`
 
 	// List of classes to exclude from the backup creation process
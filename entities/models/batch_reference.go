@@ -32,6 +32,9 @@ type BatchReference struct {
 	// Format: uri
 	From strfmt.URI `json:"from,omitempty"`
 
+	// Name of the tenant the source object belongs to. Required if the source class has multi-tenancy enabled.
+	Tenant string `json:"tenant,omitempty"`
+
 	// Short-form URI to point to the cross-ref. Should be in the form of weaviate://localhost/<uuid> for the example of a local cross-ref to an object
 	// Format: uri
 	To strfmt.URI `json:"to,omitempty"`
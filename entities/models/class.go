@@ -22,6 +22,7 @@ import (
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // Class class
@@ -41,16 +42,25 @@ type Class struct {
 	// Configuration specific to modules this Weaviate instance has installed
 	ModuleConfig interface{} `json:"moduleConfig,omitempty"`
 
+	// multi tenancy config
+	MultiTenancyConfig *MultiTenancyConfig `json:"multiTenancyConfig,omitempty"`
+
 	// The properties of the class.
 	Properties []*Property `json:"properties"`
 
+	// replication config
+	ReplicationConfig *ReplicationConfig `json:"replicationConfig,omitempty"`
+
 	// Manage how the index should be sharded and distributed in the cluster
 	ShardingConfig interface{} `json:"shardingConfig,omitempty"`
 
+	// Configure named vector spaces in addition to the default vector of the class. Each named vector has its own vectorizer and vector index. The name can be used to select the target vector in vector searches.
+	VectorConfig map[string]VectorConfig `json:"vectorConfig,omitempty"`
+
 	// Vector-index config, that is specific to the type of index selected in vectorIndexType
 	VectorIndexConfig interface{} `json:"vectorIndexConfig,omitempty"`
 
-	// Name of the vector index to use, eg. (HNSW)
+	// Name of the vector index to use, either "hnsw" (default) or "flat"
 	VectorIndexType string `json:"vectorIndexType,omitempty"`
 
 	// Specify how the vectors for this class should be determined. The options are either 'none' - this means you have to import a vector with each object yourself - or the name of a module that provides vectorization capabilities, such as 'text2vec-contextionary'. If left empty, it will use the globally configured default which can itself either be 'none' or a specific module.
@@ -65,10 +75,22 @@ func (m *Class) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateMultiTenancyConfig(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateProperties(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateReplicationConfig(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateVectorConfig(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -93,6 +115,24 @@ func (m *Class) validateInvertedIndexConfig(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Class) validateMultiTenancyConfig(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.MultiTenancyConfig) { // not required
+		return nil
+	}
+
+	if m.MultiTenancyConfig != nil {
+		if err := m.MultiTenancyConfig.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("multiTenancyConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Class) validateProperties(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.Properties) { // not required
@@ -118,6 +158,46 @@ func (m *Class) validateProperties(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Class) validateReplicationConfig(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ReplicationConfig) { // not required
+		return nil
+	}
+
+	if m.ReplicationConfig != nil {
+		if err := m.ReplicationConfig.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("replicationConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Class) validateVectorConfig(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.VectorConfig) { // not required
+		return nil
+	}
+
+	for k := range m.VectorConfig {
+
+		if err := validate.Required("vectorConfig"+"."+k, "body", m.VectorConfig[k]); err != nil {
+			return err
+		}
+		if val, ok := m.VectorConfig[k]; ok {
+			if err := val.Validate(formats); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *Class) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -17,6 +17,9 @@ package models
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"strconv"
+
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 )
@@ -34,10 +37,72 @@ type NodeShardStatus struct {
 
 	// The number of objects in shard.
 	ObjectCount int64 `json:"objectCount"`
+
+	// The progress of removing the values of deleted properties from the objects in shard.
+	PropertyDeletions []*PropertyDeletionStatus `json:"propertyDeletions"`
+
+	// The progress of the latest reindex job of the shard.
+	Reindex *ReindexShardStatus `json:"reindex,omitempty"`
 }
 
 // Validate validates this node shard status
 func (m *NodeShardStatus) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validatePropertyDeletions(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateReindex(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *NodeShardStatus) validatePropertyDeletions(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.PropertyDeletions) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.PropertyDeletions); i++ {
+		if swag.IsZero(m.PropertyDeletions[i]) { // not required
+			continue
+		}
+
+		if m.PropertyDeletions[i] != nil {
+			if err := m.PropertyDeletions[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("propertyDeletions" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *NodeShardStatus) validateReindex(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Reindex) { // not required
+		return nil
+	}
+
+	if m.Reindex != nil {
+		if err := m.Reindex.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("reindex")
+			}
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -45,8 +45,12 @@ type Property struct {
 	// Name of the property as URI relative to the schema URL.
 	Name string `json:"name,omitempty"`
 
-	// Determines tokenization of the property as separate words or whole field. Optional. Applies to string, string[], text and text[] data types. Allowed values are `word` (default) and `field` for string and string[], `word` (default) for text and text[]. Not supported for remaining data types
-	// Enum: [word field]
+	// Reduces the words of the property to their stem in the inverted index, so that filters and keyword search (bm25) for `running` also match `run`. Optional. Applies to text and text[] with `word` or `lowercase` tokenization and to string and string[] with `lowercase` tokenization. Allowed values are `none` (default) and `en`. Stemming is only available for English, the stopword presets of the other languages can be used without a stemmer
+	// Enum: [none en]
+	Stemmer string `json:"stemmer,omitempty"`
+
+	// Determines tokenization of the property as separate words or whole field. Optional. Applies to string, string[], text and text[] data types. Allowed values are `word` (default), `field`, `whitespace`, `lowercase`, `trigram` and `cjk` for string and string[], `word` (default), `whitespace`, `lowercase`, `trigram` and `cjk` for text and text[]. Not supported for remaining data types
+	// Enum: [word field whitespace lowercase trigram cjk]
 	Tokenization string `json:"tokenization,omitempty"`
 }
 
@@ -54,6 +58,10 @@ type Property struct {
 func (m *Property) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateStemmer(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateTokenization(formats); err != nil {
 		res = append(res, err)
 	}
@@ -64,11 +72,54 @@ func (m *Property) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+var propertyTypeStemmerPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["none","en"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		propertyTypeStemmerPropEnum = append(propertyTypeStemmerPropEnum, v)
+	}
+}
+
+const (
+
+	// PropertyStemmerNone captures enum value "none"
+	PropertyStemmerNone string = "none"
+
+	// PropertyStemmerEn captures enum value "en"
+	PropertyStemmerEn string = "en"
+)
+
+// prop value enum
+func (m *Property) validateStemmerEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, propertyTypeStemmerPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Property) validateStemmer(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Stemmer) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateStemmerEnum("stemmer", "body", m.Stemmer); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 var propertyTypeTokenizationPropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["word","field"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["word","field","whitespace","lowercase","trigram","cjk"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -83,6 +134,18 @@ const (
 
 	// PropertyTokenizationField captures enum value "field"
 	PropertyTokenizationField string = "field"
+
+	// PropertyTokenizationWhitespace captures enum value "whitespace"
+	PropertyTokenizationWhitespace string = "whitespace"
+
+	// PropertyTokenizationLowercase captures enum value "lowercase"
+	PropertyTokenizationLowercase string = "lowercase"
+
+	// PropertyTokenizationTrigram captures enum value "trigram"
+	PropertyTokenizationTrigram string = "trigram"
+
+	// PropertyTokenizationCjk captures enum value "cjk"
+	PropertyTokenizationCjk string = "cjk"
 )
 
 // prop value enum
@@ -29,7 +29,7 @@ type StopwordConfig struct {
 	// stopwords to be considered additionally
 	Additions []string `json:"additions"`
 
-	// pre-existing list of common words by language
+	// pre-existing list of common words by language. Allowed values are `en` (default), `de`, `fr`, `es`, `it`, `nl`, `pt` and `none`
 	Preset string `json:"preset,omitempty"`
 
 	// stopwords to be removed from consideration
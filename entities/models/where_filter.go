@@ -35,7 +35,7 @@ type WhereFilter struct {
 	Operands []*WhereFilter `json:"operands"`
 
 	// operator to use
-	// Enum: [And Or Equal Like Not NotEqual GreaterThan GreaterThanEqual LessThan LessThanEqual WithinGeoRange IsNull]
+	// Enum: [And Or Equal Like Not NotEqual GreaterThan GreaterThanEqual LessThan LessThanEqual WithinGeoRange IsNull ContainsAny ContainsAll In]
 	Operator string `json:"operator,omitempty"`
 
 	// path to the property currently being filtered
@@ -44,23 +44,41 @@ type WhereFilter struct {
 	// value as boolean
 	ValueBoolean *bool `json:"valueBoolean,omitempty"`
 
+	// value as boolean array, requires 'ContainsAny', 'ContainsAll' or 'In' operator
+	ValueBooleanArray []bool `json:"valueBooleanArray,omitempty"`
+
 	// value as date (as string)
 	ValueDate *string `json:"valueDate,omitempty"`
 
+	// value as date array (as strings), requires 'ContainsAny', 'ContainsAll' or 'In' operator
+	ValueDateArray []string `json:"valueDateArray,omitempty"`
+
 	// value as geo coordinates and distance
 	ValueGeoRange *WhereFilterGeoRange `json:"valueGeoRange,omitempty"`
 
 	// value as integer
 	ValueInt *int64 `json:"valueInt,omitempty"`
 
+	// value as integer array, requires 'ContainsAny', 'ContainsAll' or 'In' operator
+	ValueIntArray []int64 `json:"valueIntArray,omitempty"`
+
 	// value as number/float
 	ValueNumber *float64 `json:"valueNumber,omitempty"`
 
+	// value as number/float array, requires 'ContainsAny', 'ContainsAll' or 'In' operator
+	ValueNumberArray []float64 `json:"valueNumberArray,omitempty"`
+
 	// value as string
 	ValueString *string `json:"valueString,omitempty"`
 
+	// value as string array, requires 'ContainsAny', 'ContainsAll' or 'In' operator
+	ValueStringArray []string `json:"valueStringArray,omitempty"`
+
 	// value as text (on text props)
 	ValueText *string `json:"valueText,omitempty"`
+
+	// value as text array (on text props), requires 'ContainsAny', 'ContainsAll' or 'In' operator
+	ValueTextArray []string `json:"valueTextArray,omitempty"`
 }
 
 // Validate validates this where filter
@@ -114,7 +132,7 @@ var whereFilterTypeOperatorPropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["And","Or","Equal","Like","Not","NotEqual","GreaterThan","GreaterThanEqual","LessThan","LessThanEqual","WithinGeoRange","IsNull"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["And","Or","Equal","Like","Not","NotEqual","GreaterThan","GreaterThanEqual","LessThan","LessThanEqual","WithinGeoRange","IsNull","ContainsAny","ContainsAll","In"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -159,6 +177,15 @@ const (
 
 	// WhereFilterOperatorIsNull captures enum value "IsNull"
 	WhereFilterOperatorIsNull string = "IsNull"
+
+	// WhereFilterOperatorContainsAny captures enum value "ContainsAny"
+	WhereFilterOperatorContainsAny string = "ContainsAny"
+
+	// WhereFilterOperatorContainsAll captures enum value "ContainsAll"
+	WhereFilterOperatorContainsAll string = "ContainsAll"
+
+	// WhereFilterOperatorIn captures enum value "In"
+	WhereFilterOperatorIn string = "In"
 )
 
 // prop value enum
@@ -40,4 +40,9 @@ type BackupBackend interface {
 	PutObject(ctx context.Context, backupID, key string, byes []byte) error
 	// Initialize initializes backup provider and make sure that app have access rights to write into the object store.
 	Initialize(ctx context.Context, backupID string) error
+
+	// ListBackups returns the IDs of all backups stored by this backend
+	ListBackups(ctx context.Context) ([]string, error)
+	// DeleteBackup removes all objects of the backup with the given ID
+	DeleteBackup(ctx context.Context, backupID string) error
 }
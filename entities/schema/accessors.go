@@ -76,3 +76,10 @@ func extractAllOfPropType(classes []*models.Class, propType string) []ClassAndPr
 
 	return result
 }
+
+// MultiTenancyEnabled returns true if the class has been created with
+// multi-tenancy turned on, i.e. each tenant is stored in its own shard
+func MultiTenancyEnabled(class *models.Class) bool {
+	return class != nil && class.MultiTenancyConfig != nil &&
+		class.MultiTenancyConfig.Enabled
+}
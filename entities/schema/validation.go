@@ -20,6 +20,8 @@ var (
 	validateClassNameRegex    *regexp.Regexp
 	validatePropertyNameRegex *regexp.Regexp
 	validateNetworkClassRegex *regexp.Regexp
+	validateTenantNameRegex   *regexp.Regexp
+	validateVectorNameRegex   *regexp.Regexp
 	reservedPropertyNames     []string
 )
 
@@ -27,6 +29,8 @@ func init() {
 	validateClassNameRegex = regexp.MustCompile(`^[A-Z][_0-9A-Za-z]*$`)
 	validatePropertyNameRegex = regexp.MustCompile(`^[_A-Za-z][_0-9A-Za-z]*$`)
 	validateNetworkClassRegex = regexp.MustCompile(`^([A-Za-z]+)+/([A-Z][a-z]+)+$`)
+	validateTenantNameRegex = regexp.MustCompile(`^[A-Za-z0-9\-_]{1,64}$`)
+	validateVectorNameRegex = regexp.MustCompile(`^[_A-Za-z][_0-9A-Za-z]{0,63}$`)
 	reservedPropertyNames = []string{"_additional", "_id", "id"}
 }
 
@@ -49,6 +53,29 @@ func ValidatePropertyName(name string) (PropertyName, error) {
 		"which must be “/[_A-Za-z][_0-9A-Za-z]*/”.", name)
 }
 
+// ValidateTenantName validates that this string is a valid tenant name. As
+// every tenant is stored in a shard of the same name, the name has to be safe
+// to use as part of a file name.
+func ValidateTenantName(name string) error {
+	if validateTenantNameRegex.MatchString(name) {
+		return nil
+	}
+	return fmt.Errorf("'%s' is not a valid tenant name. Tenant names must "+
+		"consist of 1 to 64 characters out of “[A-Za-z0-9_-]”", name)
+}
+
+// ValidateVectorName validates that this string is a valid name for a named
+// vector. Every named vector has its own vector index inside each shard, so
+// the name has to be safe to use as part of a file name, too.
+func ValidateVectorName(name string) error {
+	if validateVectorNameRegex.MatchString(name) {
+		return nil
+	}
+	return fmt.Errorf("'%s' is not a valid vector name. Vector names must "+
+		"consist of 1 to 64 characters out of “[_0-9A-Za-z]” and must not "+
+		"start with a number", name)
+}
+
 // ValidateReservedPropertyName validates that a string is not a reserved property name
 func ValidateReservedPropertyName(name string) error {
 	for i := range reservedPropertyNames {
@@ -188,3 +188,33 @@ func TestValidateReservedPropertyName(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateTenantName(t *testing.T) {
+	for _, name := range []string{"tenant", "Tenant-1", "tenant_2", "123"} {
+		if err := ValidateTenantName(name); err != nil {
+			t.Errorf("expected %q to be valid, got %v", name, err)
+		}
+	}
+
+	for _, name := range []string{"", "with space", "../escape", "tenant/1",
+		"thisnameiswaytoolongtobeusedasatenantnamebecauseitexceedssixtyfourchars"} {
+		if err := ValidateTenantName(name); err == nil {
+			t.Errorf("expected %q to be invalid", name)
+		}
+	}
+}
+
+func TestValidateVectorName(t *testing.T) {
+	for _, name := range []string{"title", "Title_Vector", "_body", "vec2"} {
+		if err := ValidateVectorName(name); err != nil {
+			t.Errorf("expected %q to be valid, got %v", name, err)
+		}
+	}
+
+	for _, name := range []string{"", "2vec", "with-dash", "../escape",
+		"thisnameiswaytoolongtobeusedasavectornamebecauseitexceedssixtyfourchars"} {
+		if err := ValidateVectorName(name); err == nil {
+			t.Errorf("expected %q to be invalid", name)
+		}
+	}
+}
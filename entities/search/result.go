@@ -25,6 +25,7 @@ type Result struct {
 	Score                float32
 	Dist                 float32
 	Vector               []float32
+	Vectors              map[string][]float32
 	Beacon               string
 	Certainty            float32
 	Schema               models.PropertySchema
@@ -35,6 +36,9 @@ type Result struct {
 
 	// Dimensions in case search was vector-based, 0 otherwise
 	Dims int
+
+	// Tenant the object belongs to, only set for multi-tenant classes
+	Tenant string
 }
 
 type Results []Result
@@ -56,6 +60,7 @@ func (r Result) ObjectWithVector(includeVector bool) *models.Object {
 		CreationTimeUnix:   r.Created,
 		LastUpdateTimeUnix: r.Updated,
 		VectorWeights:      r.VectorWeights,
+		Tenant:             r.Tenant,
 	}
 
 	if r.AdditionalProperties != nil {
@@ -64,6 +69,12 @@ func (r Result) ObjectWithVector(includeVector bool) *models.Object {
 
 	if includeVector {
 		t.Vector = r.Vector
+		if len(r.Vectors) > 0 {
+			t.Vectors = make(models.Vectors, len(r.Vectors))
+			for name, vec := range r.Vectors {
+				t.Vectors[name] = vec
+			}
+		}
 	}
 
 	return t
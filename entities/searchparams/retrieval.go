@@ -11,11 +11,18 @@
 
 package searchparams
 
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
 type NearVector struct {
 	Vector       []float32 `json:"vector"`
 	Certainty    float64   `json:"certainty"`
 	Distance     float64   `json:"distance"`
 	WithDistance bool      `json:"-"`
+	TargetVector string    `json:"targetVector"`
 }
 
 type KeywordRanking struct {
@@ -24,10 +31,66 @@ type KeywordRanking struct {
 	Query      string   `json:"query"`
 }
 
+// ParsePropertyBoost splits a keyword ranking property in the form of
+// "title^3" into the property name and its boost. A property without an
+// explicit boost has a boost of 1.
+func ParsePropertyBoost(property string) (string, float64, error) {
+	pos := strings.LastIndex(property, "^")
+	if pos == -1 {
+		return property, 1, nil
+	}
+
+	name := property[:pos]
+	if name == "" {
+		return "", 0, fmt.Errorf("property %q: missing property name", property)
+	}
+
+	boost, err := strconv.ParseFloat(property[pos+1:], 64)
+	if err != nil {
+		return "", 0, fmt.Errorf("property %q: boost must be a number", property)
+	}
+
+	if boost <= 0 {
+		return "", 0, fmt.Errorf("property %q: boost must be greater than 0", property)
+	}
+
+	return name, boost, nil
+}
+
+const (
+	// HybridRankedFusion combines the results of the keyword and the vector
+	// search based on the rank each object has in either result set
+	HybridRankedFusion = "rankedFusion"
+	// HybridRelativeScoreFusion normalizes the bm25 scores and vector
+	// distances of both result sets and combines the normalized values
+	HybridRelativeScoreFusion = "relativeScoreFusion"
+
+	// DefaultHybridAlpha leans towards the vector search, an alpha of 1 is a
+	// pure vector search, an alpha of 0 a pure keyword (bm25) search
+	DefaultHybridAlpha = 0.75
+)
+
+type HybridSearch struct {
+	Query      string    `json:"query"`
+	Properties []string  `json:"properties"`
+	Vector     []float32 `json:"vector"`
+	Alpha      float64   `json:"alpha"`
+	FusionType string    `json:"fusionType"`
+}
+
+// KeywordRanking returns the keyword (bm25) part of the hybrid search
+func (h HybridSearch) KeywordRanking() *KeywordRanking {
+	return &KeywordRanking{
+		Properties: h.Properties,
+		Query:      h.Query,
+	}
+}
+
 type NearObject struct {
 	ID           string  `json:"id"`
 	Beacon       string  `json:"beacon"`
 	Certainty    float64 `json:"certainty"`
 	Distance     float64 `json:"distance"`
 	WithDistance bool    `json:"-"`
+	TargetVector string  `json:"targetVector"`
 }
@@ -0,0 +1,53 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package searchparams
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePropertyBoost(t *testing.T) {
+	type testcase struct {
+		input         string
+		expectedName  string
+		expectedBoost float64
+		expectErr     bool
+	}
+
+	tests := []testcase{
+		{input: "title", expectedName: "title", expectedBoost: 1},
+		{input: "title^3", expectedName: "title", expectedBoost: 3},
+		{input: "description^0.5", expectedName: "description", expectedBoost: 0.5},
+		{input: "title^", expectErr: true},
+		{input: "title^abc", expectErr: true},
+		{input: "title^0", expectErr: true},
+		{input: "title^-2", expectErr: true},
+		{input: "^2", expectErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			name, boost, err := ParsePropertyBoost(test.input)
+			if test.expectErr {
+				assert.NotNil(t, err)
+				return
+			}
+
+			require.Nil(t, err)
+			assert.Equal(t, test.expectedName, name)
+			assert.Equal(t, test.expectedBoost, boost)
+		})
+	}
+}
@@ -17,6 +17,7 @@ import (
 	"encoding/json"
 	"io"
 	"math"
+	"sort"
 
 	"github.com/buger/jsonparser"
 
@@ -36,7 +37,10 @@ type Object struct {
 	Object            models.Object `json:"object"`
 	Vector            []float32     `json:"vector"`
 	VectorLen         int           `json:"-"`
-	docID             uint64
+	// Vectors holds the named vectors of the object, the default vector is
+	// held in Vector
+	Vectors map[string][]float32 `json:"vectors"`
+	docID   uint64
 }
 
 func New(docID uint64) *Object {
@@ -58,9 +62,22 @@ func FromObject(object *models.Object, vector []float32) *Object {
 		object.Properties = properties
 	}
 
+	// the named vectors are held in Vectors only, so that an object is
+	// identical before and after marshalling
+	var vectors map[string][]float32
+	if len(object.Vectors) > 0 {
+		vectors = make(map[string][]float32, len(object.Vectors))
+		for name, vec := range object.Vectors {
+			vectors[name] = vec
+		}
+	}
+	obj := *object
+	obj.Vectors = nil
+
 	return &Object{
-		Object:            *object,
+		Object:            obj,
 		Vector:            vector,
+		Vectors:           vectors,
 		MarshallerVersion: 1,
 		VectorLen:         len(vector),
 	}
@@ -143,6 +160,21 @@ func FromBinaryOptional(data []byte,
 	_, err = r.Read(vectorWeights)
 	ec.AddWrap(err, "vector weights")
 
+	// the named vectors segment is optional, objects without named vectors
+	// end after the vector weights
+	if r.Len() > 0 {
+		var namedVectorsLength uint32
+		ec.AddWrap(binary.Read(r, le, &namedVectorsLength), "named vectors length")
+		if addProp.Vector {
+			namedVectors := make([]byte, namedVectorsLength)
+			_, err = r.Read(namedVectors)
+			ec.AddWrap(err, "named vectors")
+			ko.Vectors = unmarshalNamedVectors(namedVectors)
+		} else {
+			io.CopyN(io.Discard, r, int64(namedVectorsLength))
+		}
+	}
+
 	if err := ec.ToError(); err != nil {
 		return nil, errors.Wrap(err, "compound err")
 	}
@@ -274,6 +306,7 @@ func (ko *Object) SearchResult(additional additional.Properties) *search.Result
 		ClassName: ko.Class().String(),
 		Schema:    ko.Properties(),
 		Vector:    ko.Vector,
+		Vectors:   ko.Vectors,
 		Dims:      ko.VectorLen,
 		// VectorWeights: ko.VectorWeights(), // TODO: add vector weights
 		Created:              ko.CreationTimeUnix(),
@@ -312,6 +345,19 @@ func SearchResultsWithDists(in []*Object, additional additional.Properties,
 	return out
 }
 
+func SearchResultsWithScore(in []*Object, additional additional.Properties,
+	scores []float32,
+) search.Results {
+	out := make(search.Results, len(in))
+
+	for i, elem := range in {
+		out[i] = *(elem.SearchResult(additional))
+		out[i].Score = scores[i]
+	}
+
+	return out
+}
+
 func DocIDFromBinary(in []byte) (uint64, error) {
 	var version uint8
 	r := bytes.NewReader(in)
@@ -352,6 +398,8 @@ func DocIDFromBinary(in []byte) (uint64, error) {
 // n          | []byte    | meta as json
 // 2          | uint32    | length of vectorweights json
 // n          | []byte    | vectorweights as json
+// 4          | uint32    | length of named vectors, only present if the object has named vectors
+// n          | []byte    | named vectors, see marshalNamedVectors
 func (ko *Object) MarshalBinary() ([]byte, error) {
 	if ko.MarshallerVersion != 1 {
 		return nil, errors.Errorf("unsupported marshaller version %d", ko.MarshallerVersion)
@@ -387,8 +435,13 @@ func (ko *Object) MarshalBinary() ([]byte, error) {
 		return nil, err
 	}
 	vectorWeightsLength := uint32(len(vectorWeights))
+	namedVectors := marshalNamedVectors(ko.Vectors)
+	namedVectorsLength := uint32(len(namedVectors))
 
 	totalBufferLength := 1 + 8 + 1 + 16 + 8 + 8 + 2 + vectorLength*4 + 2 + classNameLength + 4 + schemaLength + 4 + metaLength + 4 + vectorWeightsLength
+	if namedVectorsLength > 0 {
+		totalBufferLength += 4 + namedVectorsLength
+	}
 	byteBuffer := make([]byte, totalBufferLength)
 	byteOps := byte_operations.ByteOperations{Buffer: byteBuffer}
 	byteOps.WriteByte(ko.MarshallerVersion)
@@ -428,9 +481,81 @@ func (ko *Object) MarshalBinary() ([]byte, error) {
 		return byteBuffer, errors.Wrap(err, "Could not copy vectorWeights")
 	}
 
+	if namedVectorsLength > 0 {
+		byteOps.WriteUint32(namedVectorsLength)
+		err = byteOps.CopyBytesToBuffer(namedVectors)
+		if err != nil {
+			return byteBuffer, errors.Wrap(err, "Could not copy named vectors")
+		}
+	}
+
 	return byteBuffer, nil
 }
 
+// marshalNamedVectors creates the binary representation of the named
+// vectors. The names are sorted, so the same vectors always lead to the same
+// bytes.
+//
+// No. of B   | Type      | Content
+// ------------------------------------------------
+// 2          | uint16    | number of named vectors
+// repeated for every named vector:
+// 2          | uint16    | length of name
+// n          | []byte    | name
+// 2          | uint16    | VectorLength
+// n*4        | []float32 | vector of length n
+func marshalNamedVectors(vectors map[string][]float32) []byte {
+	if len(vectors) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(vectors))
+	length := 2
+	for name, vec := range vectors {
+		names = append(names, name)
+		length += 2 + len(name) + 2 + len(vec)*4
+	}
+	sort.Strings(names)
+
+	byteOps := byte_operations.ByteOperations{Buffer: make([]byte, length)}
+	byteOps.WriteUint16(uint16(len(names)))
+	for _, name := range names {
+		vec := vectors[name]
+		byteOps.WriteUint16(uint16(len(name)))
+		byteOps.CopyBytesToBuffer([]byte(name))
+		byteOps.WriteUint16(uint16(len(vec)))
+		for _, v := range vec {
+			byteOps.WriteUint32(math.Float32bits(v))
+		}
+	}
+
+	return byteOps.Buffer
+}
+
+func unmarshalNamedVectors(data []byte) map[string][]float32 {
+	if len(data) == 0 {
+		return nil
+	}
+
+	byteOps := byte_operations.ByteOperations{Buffer: data}
+	count := int(byteOps.ReadUint16())
+	out := make(map[string][]float32, count)
+	for i := 0; i < count; i++ {
+		nameLength := uint64(byteOps.ReadUint16())
+		name := string(data[byteOps.Position : byteOps.Position+nameLength])
+		byteOps.MoveBufferPositionForward(nameLength)
+
+		vectorLength := int(byteOps.ReadUint16())
+		vec := make([]float32, vectorLength)
+		for j := range vec {
+			vec[j] = math.Float32frombits(byteOps.ReadUint32())
+		}
+		out[name] = vec
+	}
+
+	return out
+}
+
 // UnmarshalPropertiesFromObject only unmarshals and returns the properties part of the object
 //
 // Check MarshalBinary for the order of elements in the input array
@@ -570,6 +695,14 @@ func (ko *Object) UnmarshalBinary(data []byte) error {
 		return errors.Wrap(err, "Could not copy vectorWeights")
 	}
 
+	// the named vectors segment is optional, see MarshalBinary
+	if int(byteOps.Position) < len(data) {
+		namedVectorsLength := uint64(byteOps.ReadUint32())
+		ko.Vectors = unmarshalNamedVectors(
+			data[byteOps.Position : byteOps.Position+namedVectorsLength])
+		byteOps.MoveBufferPositionForward(namedVectorsLength)
+	}
+
 	return ko.parseObject(
 		strfmt.UUID(uuidParsed.String()),
 		createTime,
@@ -611,6 +744,42 @@ func VectorFromBinary(in []byte) ([]float32, error) {
 	return out, nil
 }
 
+// NamedVectorFromBinary returns the named vector of the given name without
+// unmarshalling the remaining object. Nil is returned, if the object does not
+// have a named vector of that name.
+func NamedVectorFromBinary(in []byte, name string) ([]float32, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+
+	version := in[0]
+	if version != 1 {
+		return nil, errors.Errorf("unsupported marshaller version %d", version)
+	}
+
+	// skip to the end of the vector weights, see MarshalBinary for the layout
+	pos := 42
+	vecLen := int(binary.LittleEndian.Uint16(in[pos : pos+2]))
+	pos += 2 + vecLen*4
+	classNameLen := int(binary.LittleEndian.Uint16(in[pos : pos+2]))
+	pos += 2 + classNameLen
+	for i := 0; i < 3; i++ {
+		// schema, meta and vector weights
+		segmentLen := int(binary.LittleEndian.Uint32(in[pos : pos+4]))
+		pos += 4 + segmentLen
+	}
+
+	if pos >= len(in) {
+		// the object has no named vectors
+		return nil, nil
+	}
+
+	namedVectorsLen := int(binary.LittleEndian.Uint32(in[pos : pos+4]))
+	pos += 4
+
+	return unmarshalNamedVectors(in[pos : pos+namedVectorsLen])[name], nil
+}
+
 func (ko *Object) parseObject(uuid strfmt.UUID, create, update int64, className string,
 	schemaB []byte, additionalB []byte, vectorWeightsB []byte,
 ) error {
@@ -675,9 +844,22 @@ func (ko *Object) DeepCopyDangerous() *Object {
 		docID:             ko.docID,
 		Object:            deepCopyObject(ko.Object),
 		Vector:            deepCopyVector(ko.Vector),
+		Vectors:           deepCopyVectors(ko.Vectors),
 	}
 }
 
+func deepCopyVectors(orig map[string][]float32) map[string][]float32 {
+	if orig == nil {
+		return nil
+	}
+
+	out := make(map[string][]float32, len(orig))
+	for name, vec := range orig {
+		out[name] = deepCopyVector(vec)
+	}
+	return out
+}
+
 func deepCopyVector(orig []float32) []float32 {
 	out := make([]float32, len(orig))
 	copy(out, orig)
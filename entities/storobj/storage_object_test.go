@@ -85,6 +85,76 @@ func TestStorageObjectMarshalling(t *testing.T) {
 	})
 }
 
+func TestStorageObjectMarshallingNamedVectors(t *testing.T) {
+	before := FromObject(
+		&models.Object{
+			Class:              "MyFavoriteClass",
+			CreationTimeUnix:   123456,
+			LastUpdateTimeUnix: 56789,
+			ID:                 strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247"),
+			Properties: map[string]interface{}{
+				"name": "MyName",
+			},
+			Vectors: models.Vectors{
+				"title":   []float32{1, 2, 3},
+				"content": []float32{4, 5},
+			},
+		},
+		[]float32{1, 2, 0.7},
+	)
+	before.SetDocID(7)
+
+	asBinary, err := before.MarshalBinary()
+	require.Nil(t, err)
+
+	t.Run("compare", func(t *testing.T) {
+		after, err := FromBinary(asBinary)
+		require.Nil(t, err)
+		assert.Equal(t, before, after)
+	})
+
+	t.Run("with the vector", func(t *testing.T) {
+		after, err := FromBinaryOptional(asBinary, additional.Properties{Vector: true})
+		require.Nil(t, err)
+		assert.Equal(t, before.Vectors, after.Vectors)
+		assert.Equal(t, before.Vectors, after.SearchResult(additional.Properties{}).Vectors)
+	})
+
+	t.Run("without the vector", func(t *testing.T) {
+		after, err := FromBinaryOptional(asBinary, additional.Properties{})
+		require.Nil(t, err)
+		assert.Nil(t, after.Vectors)
+		assert.Equal(t, "MyName", after.Properties().(map[string]interface{})["name"])
+	})
+
+	t.Run("extract a single named vector", func(t *testing.T) {
+		vec, err := NamedVectorFromBinary(asBinary, "content")
+		require.Nil(t, err)
+		assert.Equal(t, []float32{4, 5}, vec)
+
+		vec, err = NamedVectorFromBinary(asBinary, "IDoNotExist")
+		require.Nil(t, err)
+		assert.Nil(t, vec)
+	})
+
+	t.Run("without named vectors", func(t *testing.T) {
+		withoutVectors := FromObject(&models.Object{
+			Class: "MyFavoriteClass",
+			ID:    strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247"),
+		}, []float32{1, 2, 0.7})
+		asBinary, err := withoutVectors.MarshalBinary()
+		require.Nil(t, err)
+
+		vec, err := NamedVectorFromBinary(asBinary, "content")
+		require.Nil(t, err)
+		assert.Nil(t, vec)
+
+		after, err := FromBinary(asBinary)
+		require.Nil(t, err)
+		assert.Nil(t, after.Vectors)
+	})
+}
+
 func TestFilteringNilProperty(t *testing.T) {
 	object := FromObject(
 		&models.Object{
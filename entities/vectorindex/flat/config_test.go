@@ -0,0 +1,69 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package flat
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_UserConfig(t *testing.T) {
+	type test struct {
+		name        string
+		input       interface{}
+		expected    UserConfig
+		expectedErr bool
+	}
+
+	tests := []test{
+		{
+			name:     "nothing specified, all defaults",
+			input:    nil,
+			expected: UserConfig{Distance: DefaultDistanceMetric},
+		},
+		{
+			name: "with distance",
+			input: map[string]interface{}{
+				"distance": "l2-squared",
+			},
+			expected: UserConfig{Distance: "l2-squared"},
+		},
+		{
+			name: "with settings that only apply to hnsw",
+			input: map[string]interface{}{
+				"efConstruction": float64(128),
+			},
+			expected: UserConfig{Distance: DefaultDistanceMetric},
+		},
+		{
+			name:        "with invalid input",
+			input:       "not a map",
+			expectedErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg, err := ParseUserConfig(test.input)
+			if test.expectedErr {
+				assert.NotNil(t, err)
+				return
+			}
+
+			require.Nil(t, err)
+			assert.Equal(t, test.expected, cfg)
+			assert.Equal(t, IndexType, cfg.IndexType())
+		})
+	}
+}
@@ -41,21 +41,41 @@ const (
 	DefaultSkip                   = false
 	DefaultFlatSearchCutoff       = 40000
 	DefaultDistanceMetric         = DistanceCosine
+	DefaultPQEnabled              = false
+	DefaultPQSegments             = 0 // indicates "one segment per dimension"
+	DefaultPQCentroids            = 256
+	DefaultPQTrainingLimit        = 100000
 )
 
+// MaxPQCentroids is the upper limit for centroids per segment, so that every
+// compressed segment fits into a single byte
+const MaxPQCentroids = 256
+
 // UserConfig bundles all values settable by a user in the per-class settings
 type UserConfig struct {
-	Skip                   bool   `json:"skip"`
-	CleanupIntervalSeconds int    `json:"cleanupIntervalSeconds"`
-	MaxConnections         int    `json:"maxConnections"`
-	EFConstruction         int    `json:"efConstruction"`
-	EF                     int    `json:"ef"`
-	DynamicEFMin           int    `json:"dynamicEfMin"`
-	DynamicEFMax           int    `json:"dynamicEfMax"`
-	DynamicEFFactor        int    `json:"dynamicEfFactor"`
-	VectorCacheMaxObjects  int    `json:"vectorCacheMaxObjects"`
-	FlatSearchCutoff       int    `json:"flatSearchCutoff"`
-	Distance               string `json:"distance"`
+	Skip                   bool     `json:"skip"`
+	CleanupIntervalSeconds int      `json:"cleanupIntervalSeconds"`
+	MaxConnections         int      `json:"maxConnections"`
+	EFConstruction         int      `json:"efConstruction"`
+	EF                     int      `json:"ef"`
+	DynamicEFMin           int      `json:"dynamicEfMin"`
+	DynamicEFMax           int      `json:"dynamicEfMax"`
+	DynamicEFFactor        int      `json:"dynamicEfFactor"`
+	VectorCacheMaxObjects  int      `json:"vectorCacheMaxObjects"`
+	FlatSearchCutoff       int      `json:"flatSearchCutoff"`
+	Distance               string   `json:"distance"`
+	PQ                     PQConfig `json:"pq"`
+}
+
+// PQConfig controls product quantization of the vectors held in memory. If
+// enabled, the index is trained once TrainingLimit vectors have been imported.
+// From then on only the compressed vectors are kept in memory, full vectors
+// are only read from disk to rescore the top candidates of a search.
+type PQConfig struct {
+	Enabled       bool `json:"enabled"`
+	Segments      int  `json:"segments"`
+	Centroids     int  `json:"centroids"`
+	TrainingLimit int  `json:"trainingLimit"`
 }
 
 // IndexType returns the type of the underlying vector index, thus making sure
@@ -77,6 +97,12 @@ func (c *UserConfig) SetDefaults() {
 	c.Skip = DefaultSkip
 	c.FlatSearchCutoff = DefaultFlatSearchCutoff
 	c.Distance = DefaultDistanceMetric
+	c.PQ = PQConfig{
+		Enabled:       DefaultPQEnabled,
+		Segments:      DefaultPQSegments,
+		Centroids:     DefaultPQCentroids,
+		TrainingLimit: DefaultPQTrainingLimit,
+	}
 }
 
 // ParseUserConfig from an unknown input value, as this is not further
@@ -160,9 +186,72 @@ func ParseUserConfig(input interface{}) (schema.VectorIndexConfig, error) {
 		return uc, err
 	}
 
+	if err := parsePQMap(asMap, &uc.PQ); err != nil {
+		return uc, err
+	}
+
 	return uc, nil
 }
 
+func parsePQMap(in map[string]interface{}, pq *PQConfig) error {
+	value, ok := in["pq"]
+	if !ok {
+		return nil
+	}
+
+	asMap, ok := value.(map[string]interface{})
+	if !ok || asMap == nil {
+		return nil
+	}
+
+	if err := optionalBoolFromMap(asMap, "enabled", func(v bool) {
+		pq.Enabled = v
+	}); err != nil {
+		return err
+	}
+
+	if err := optionalIntFromMap(asMap, "segments", func(v int) {
+		pq.Segments = v
+	}); err != nil {
+		return err
+	}
+
+	if err := optionalIntFromMap(asMap, "centroids", func(v int) {
+		pq.Centroids = v
+	}); err != nil {
+		return err
+	}
+
+	if err := optionalIntFromMap(asMap, "trainingLimit", func(v int) {
+		pq.TrainingLimit = v
+	}); err != nil {
+		return err
+	}
+
+	return pq.Validate()
+}
+
+// Validate the product quantization settings. Whether the number of segments
+// divides the vector dimensions can only be checked once the first vector is
+// known.
+func (pq PQConfig) Validate() error {
+	if pq.Segments < 0 {
+		return errors.Errorf("pq.segments must be 0 or greater, got %d", pq.Segments)
+	}
+
+	if pq.Centroids < 1 || pq.Centroids > MaxPQCentroids {
+		return errors.Errorf("pq.centroids must be between 1 and %d, got %d",
+			MaxPQCentroids, pq.Centroids)
+	}
+
+	if pq.TrainingLimit < pq.Centroids {
+		return errors.Errorf("pq.trainingLimit must be at least the number of "+
+			"centroids (%d), got %d", pq.Centroids, pq.TrainingLimit)
+	}
+
+	return nil
+}
+
 // Tries to parse the int value from the map, if it overflows math.MaxInt64, it
 // uses math.MaxInt64 instead. This is to protect from rounding errors from
 // json marshalling where the type may be assumed as float64
@@ -17,8 +17,16 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
+var defaultPQ = PQConfig{
+	Enabled:       DefaultPQEnabled,
+	Segments:      DefaultPQSegments,
+	Centroids:     DefaultPQCentroids,
+	TrainingLimit: DefaultPQTrainingLimit,
+}
+
 func Test_UserConfig(t *testing.T) {
 	type test struct {
 		name     string
@@ -42,6 +50,7 @@ func Test_UserConfig(t *testing.T) {
 				DynamicEFMax:           DefaultDynamicEFMax,
 				DynamicEFFactor:        DefaultDynamicEFFactor,
 				Distance:               DefaultDistanceMetric,
+				PQ:                     defaultPQ,
 			},
 		},
 
@@ -61,6 +70,7 @@ func Test_UserConfig(t *testing.T) {
 				DynamicEFMax:           DefaultDynamicEFMax,
 				DynamicEFFactor:        DefaultDynamicEFFactor,
 				Distance:               DefaultDistanceMetric,
+				PQ:                     defaultPQ,
 			},
 		},
 
@@ -91,6 +101,7 @@ func Test_UserConfig(t *testing.T) {
 				DynamicEFFactor:        19,
 				Skip:                   true,
 				Distance:               "l2-squared",
+				PQ:                     defaultPQ,
 			},
 		},
 
@@ -121,6 +132,7 @@ func Test_UserConfig(t *testing.T) {
 				DynamicEFFactor:        19,
 				Skip:                   true,
 				Distance:               "manhattan",
+				PQ:                     defaultPQ,
 			},
 		},
 
@@ -151,6 +163,7 @@ func Test_UserConfig(t *testing.T) {
 				DynamicEFFactor:        19,
 				Skip:                   true,
 				Distance:               "hamming",
+				PQ:                     defaultPQ,
 			},
 		},
 
@@ -179,6 +192,7 @@ func Test_UserConfig(t *testing.T) {
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
 				Distance:               DefaultDistanceMetric,
+				PQ:                     defaultPQ,
 			},
 		},
 		{
@@ -206,6 +220,7 @@ func Test_UserConfig(t *testing.T) {
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
 				Distance:               DefaultDistanceMetric,
+				PQ:                     defaultPQ,
 			},
 		},
 	}
@@ -219,3 +234,67 @@ func Test_UserConfig(t *testing.T) {
 		})
 	}
 }
+
+func Test_UserConfig_PQ(t *testing.T) {
+	t.Run("with product quantization", func(t *testing.T) {
+		cfg, err := ParseUserConfig(map[string]interface{}{
+			"pq": map[string]interface{}{
+				"enabled":       true,
+				"segments":      json.Number("96"),
+				"centroids":     float64(128),
+				"trainingLimit": json.Number("50000"),
+			},
+		})
+		require.Nil(t, err)
+
+		expected := PQConfig{
+			Enabled:       true,
+			Segments:      96,
+			Centroids:     128,
+			TrainingLimit: 50000,
+		}
+		assert.Equal(t, expected, cfg.(UserConfig).PQ)
+	})
+
+	t.Run("with only pq enabled", func(t *testing.T) {
+		cfg, err := ParseUserConfig(map[string]interface{}{
+			"pq": map[string]interface{}{
+				"enabled": true,
+			},
+		})
+		require.Nil(t, err)
+
+		expected := defaultPQ
+		expected.Enabled = true
+		assert.Equal(t, expected, cfg.(UserConfig).PQ)
+	})
+
+	invalid := []struct {
+		name  string
+		input map[string]interface{}
+	}{
+		{
+			name:  "negative segments",
+			input: map[string]interface{}{"segments": json.Number("-1")},
+		},
+		{
+			name:  "too many centroids",
+			input: map[string]interface{}{"centroids": json.Number("257")},
+		},
+		{
+			name:  "no centroids",
+			input: map[string]interface{}{"centroids": json.Number("0")},
+		},
+		{
+			name:  "training limit below centroids",
+			input: map[string]interface{}{"trainingLimit": json.Number("255")},
+		},
+	}
+
+	for _, test := range invalid {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ParseUserConfig(map[string]interface{}{"pq": test.input})
+			assert.NotNil(t, err)
+		})
+	}
+}
@@ -102,6 +102,44 @@ func (m *Module) WriteToFile(ctx context.Context, backupID, key, destPath string
 	return nil
 }
 
+func (m *Module) ListBackups(ctx context.Context) ([]string, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, backup.NewErrContextExpired(errors.Wrap(err, "list backups"))
+	}
+
+	entries, err := os.ReadDir(m.backupsPath)
+	if err != nil {
+		return nil, backup.NewErrInternal(errors.Wrapf(err, "read dir '%s'", m.backupsPath))
+	}
+
+	ids := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() {
+			ids = append(ids, entry.Name())
+		}
+	}
+	return ids, nil
+}
+
+func (m *Module) DeleteBackup(ctx context.Context, backupID string) error {
+	backupPath := m.makeBackupDirPath(backupID)
+
+	if err := ctx.Err(); err != nil {
+		return backup.NewErrContextExpired(errors.Wrapf(err, "delete backup '%s'", backupPath))
+	}
+
+	if _, err := os.Stat(backupPath); errors.Is(err, os.ErrNotExist) {
+		return backup.NewErrNotFound(errors.Wrapf(err, "delete backup '%s'", backupPath))
+	} else if err != nil {
+		return backup.NewErrInternal(errors.Wrapf(err, "delete backup '%s'", backupPath))
+	}
+
+	if err := os.RemoveAll(backupPath); err != nil {
+		return backup.NewErrInternal(errors.Wrapf(err, "delete backup '%s'", backupPath))
+	}
+	return nil
+}
+
 func (m *Module) SourceDataPath() string {
 	return m.dataPath
 }
@@ -17,7 +17,9 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/semi-technologies/weaviate/entities/backup"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestBackend_StoreBackup(t *testing.T) {
@@ -52,3 +54,35 @@ func TestBackend_StoreBackup(t *testing.T) {
 		assert.Nil(t, err)
 	})
 }
+
+func TestBackend_ListAndDeleteBackups(t *testing.T) {
+	ctx := context.Background()
+	module := New()
+	err := module.initBackupBackend(ctx, t.TempDir())
+	require.Nil(t, err)
+
+	for _, id := range []string{"backup1", "backup2"} {
+		err := module.PutObject(ctx, id, "node1/backup.json", []byte("{}"))
+		require.Nil(t, err)
+	}
+
+	t.Run("list backups", func(t *testing.T) {
+		ids, err := module.ListBackups(ctx)
+		assert.Nil(t, err)
+		assert.ElementsMatch(t, []string{"backup1", "backup2"}, ids)
+	})
+
+	t.Run("delete backup", func(t *testing.T) {
+		err := module.DeleteBackup(ctx, "backup1")
+		assert.Nil(t, err)
+
+		ids, err := module.ListBackups(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"backup2"}, ids)
+	})
+
+	t.Run("delete backup which does not exist", func(t *testing.T) {
+		err := module.DeleteBackup(ctx, "backup1")
+		assert.IsType(t, backup.ErrNotFound{}, err)
+	})
+}
@@ -16,12 +16,14 @@ import (
 	"io"
 	"os"
 	"path"
+	"strings"
 
 	"cloud.google.com/go/storage"
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/entities/backup"
 	"github.com/semi-technologies/weaviate/usecases/monitoring"
 	"golang.org/x/oauth2/google"
+	"google.golang.org/api/iterator"
 	"google.golang.org/api/option"
 )
 
@@ -207,6 +209,63 @@ func (g *gcsClient) WriteToFile(ctx context.Context, backupID, key, destPath str
 	return nil
 }
 
+func (g *gcsClient) ListBackups(ctx context.Context) ([]string, error) {
+	bucket, err := g.findBucket(ctx)
+	if err != nil {
+		return nil, backup.NewErrInternal(errors.Wrap(err, "find bucket"))
+	}
+
+	prefix := g.makeObjectName() + "/"
+	if prefix == "/" {
+		prefix = ""
+	}
+
+	var ids []string
+	it := bucket.Objects(ctx, &storage.Query{Prefix: prefix, Delimiter: "/"})
+	for {
+		attrs, err := it.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+		if err != nil {
+			return nil, backup.NewErrInternal(errors.Wrapf(err, "list objects '%s'", prefix))
+		}
+		// with a delimiter, backup folders are returned as synthetic prefixes
+		if attrs.Prefix != "" {
+			ids = append(ids, strings.TrimSuffix(strings.TrimPrefix(attrs.Prefix, prefix), "/"))
+		}
+	}
+	return ids, nil
+}
+
+func (g *gcsClient) DeleteBackup(ctx context.Context, backupID string) error {
+	bucket, err := g.findBucket(ctx)
+	if err != nil {
+		return backup.NewErrInternal(errors.Wrap(err, "find bucket"))
+	}
+
+	prefix := g.makeObjectName(backupID) + "/"
+	found := false
+	it := bucket.Objects(ctx, &storage.Query{Prefix: prefix})
+	for {
+		attrs, err := it.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+		if err != nil {
+			return backup.NewErrInternal(errors.Wrapf(err, "list objects '%s'", prefix))
+		}
+		found = true
+		if err := bucket.Object(attrs.Name).Delete(ctx); err != nil {
+			return backup.NewErrInternal(errors.Wrapf(err, "delete object '%s'", attrs.Name))
+		}
+	}
+	if !found {
+		return backup.NewErrNotFound(errors.Errorf("delete backup '%s': no objects found", prefix))
+	}
+	return nil
+}
+
 func (g *gcsClient) SourceDataPath() string {
 	return g.dataPath
 }
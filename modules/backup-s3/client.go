@@ -18,6 +18,7 @@ import (
 	"net/http"
 	"os"
 	"path"
+	"strings"
 
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
@@ -178,6 +179,47 @@ func (s *s3Client) WriteToFile(ctx context.Context, backupID, key, destPath stri
 	return nil
 }
 
+func (s *s3Client) ListBackups(ctx context.Context) ([]string, error) {
+	prefix := s.makeObjectName() + "/"
+	if prefix == "/" {
+		prefix = ""
+	}
+	opt := minio.ListObjectsOptions{Prefix: prefix}
+
+	var ids []string
+	for obj := range s.client.ListObjects(ctx, s.config.Bucket, opt) {
+		if obj.Err != nil {
+			return nil, backup.NewErrInternal(errors.Wrapf(obj.Err, "list objects '%s'", prefix))
+		}
+		// without recursion, backup folders are returned as common prefixes
+		if strings.HasSuffix(obj.Key, "/") {
+			ids = append(ids, strings.TrimSuffix(strings.TrimPrefix(obj.Key, prefix), "/"))
+		}
+	}
+	return ids, nil
+}
+
+func (s *s3Client) DeleteBackup(ctx context.Context, backupID string) error {
+	prefix := s.makeObjectName(backupID) + "/"
+	objects := s.client.ListObjects(ctx, s.config.Bucket,
+		minio.ListObjectsOptions{Prefix: prefix, Recursive: true})
+
+	found := false
+	for obj := range objects {
+		if obj.Err != nil {
+			return backup.NewErrInternal(errors.Wrapf(obj.Err, "list objects '%s'", prefix))
+		}
+		found = true
+		if err := s.client.RemoveObject(ctx, s.config.Bucket, obj.Key, minio.RemoveObjectOptions{}); err != nil {
+			return backup.NewErrInternal(errors.Wrapf(err, "remove object '%s'", obj.Key))
+		}
+	}
+	if !found {
+		return backup.NewErrNotFound(errors.Errorf("delete backup '%s': no objects found", prefix))
+	}
+	return nil
+}
+
 func (s *s3Client) SourceDataPath() string {
 	return s.dataPath
 }
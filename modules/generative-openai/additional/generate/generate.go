@@ -0,0 +1,58 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package generate
+
+import (
+	"context"
+	"errors"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/modules/generative-openai/ent"
+	"github.com/sirupsen/logrus"
+)
+
+type generativeClient interface {
+	Generate(ctx context.Context, prompt string) (*ent.CompletionResult, error)
+}
+
+type GenerateProvider struct {
+	client generativeClient
+	logger logrus.FieldLogger
+}
+
+func New(client generativeClient, logger logrus.FieldLogger) *GenerateProvider {
+	return &GenerateProvider{client, logger}
+}
+
+func (p *GenerateProvider) AdditionalPropertyDefaultValue() interface{} {
+	return &Params{}
+}
+
+func (p *GenerateProvider) ExtractAdditionalFn(param []*ast.Argument) interface{} {
+	return p.parseGenerateArguments(param)
+}
+
+func (p *GenerateProvider) AdditionalFieldFn(classname string) *graphql.Field {
+	return p.additionalGenerateField(classname)
+}
+
+func (p *GenerateProvider) AdditionalPropertyFn(ctx context.Context,
+	in []search.Result, params interface{}, limit *int,
+	argumentModuleParams map[string]interface{},
+) ([]search.Result, error) {
+	if parameters, ok := params.(*Params); ok {
+		return p.generateResult(ctx, in, parameters)
+	}
+	return nil, errors.New("wrong parameters")
+}
@@ -0,0 +1,73 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package generate
+
+import (
+	"fmt"
+
+	"github.com/graphql-go/graphql"
+	"github.com/semi-technologies/weaviate/modules/generative-openai/ent"
+)
+
+func (p *GenerateProvider) additionalGenerateField(classname string) *graphql.Field {
+	return &graphql.Field{
+		Args: graphql.FieldConfigArgument{
+			"singleResult": &graphql.ArgumentConfig{
+				Description: "Generates a result for each object, " +
+					"properties of the object are set in the prompt using {propertyName}",
+				Type: graphql.NewInputObject(graphql.InputObjectConfig{
+					Name: fmt.Sprintf("%sAdditionalGenerateSingleResultInpObj", classname),
+					Fields: graphql.InputObjectConfigFieldMap{
+						"prompt": &graphql.InputObjectFieldConfig{
+							Description: "Prompt template, e.g. \"Translate {title} into German\"",
+							Type:        graphql.String,
+						},
+					},
+				}),
+				DefaultValue: nil,
+			},
+			"groupedResult": &graphql.ArgumentConfig{
+				Description: "Generates a single result for all objects together",
+				Type: graphql.NewInputObject(graphql.InputObjectConfig{
+					Name: fmt.Sprintf("%sAdditionalGenerateGroupedResultInpObj", classname),
+					Fields: graphql.InputObjectConfigFieldMap{
+						"task": &graphql.InputObjectFieldConfig{
+							Description: "Task to perform on all objects",
+							Type:        graphql.String,
+						},
+						"properties": &graphql.InputObjectFieldConfig{
+							Description: "Properties of the objects to pass on, defaults to all",
+							Type:        graphql.NewList(graphql.String),
+						},
+					},
+				}),
+				DefaultValue: nil,
+			},
+		},
+		Type: graphql.NewObject(graphql.ObjectConfig{
+			Name: fmt.Sprintf("%sAdditionalGenerate", classname),
+			Fields: graphql.Fields{
+				"singleResult":  &graphql.Field{Type: graphql.String},
+				"groupedResult": &graphql.Field{Type: graphql.String},
+				"error": &graphql.Field{
+					Type: graphql.String,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						if res, ok := p.Source.(*ent.GenerateResult); ok && res.Error != nil {
+							return res.Error.Error(), nil
+						}
+						return nil, nil
+					},
+				},
+			},
+		}),
+	}
+}
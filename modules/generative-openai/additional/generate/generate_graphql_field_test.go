@@ -0,0 +1,65 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package generate
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/semi-technologies/weaviate/modules/generative-openai/ent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_additionalGenerateField(t *testing.T) {
+	// given
+	generateProvider := &GenerateProvider{}
+	classname := "Class"
+
+	// when
+	generate := generateProvider.additionalGenerateField(classname)
+
+	assert.NotNil(t, generate)
+	assert.Equal(t, "ClassAdditionalGenerate", generate.Type.Name())
+	generateObject, generateObjectOK := generate.Type.(*graphql.Object)
+	require.True(t, generateObjectOK)
+	assert.Equal(t, 3, len(generateObject.Fields()))
+	assert.NotNil(t, generateObject.Fields()["singleResult"])
+	assert.NotNil(t, generateObject.Fields()["groupedResult"])
+	assert.NotNil(t, generateObject.Fields()["error"])
+
+	assert.Equal(t, 2, len(generate.Args))
+	singleResult, ok := generate.Args["singleResult"].Type.(*graphql.InputObject)
+	require.True(t, ok)
+	assert.Equal(t, "ClassAdditionalGenerateSingleResultInpObj", singleResult.Name())
+	assert.NotNil(t, singleResult.Fields()["prompt"])
+	groupedResult, ok := generate.Args["groupedResult"].Type.(*graphql.InputObject)
+	require.True(t, ok)
+	assert.Equal(t, "ClassAdditionalGenerateGroupedResultInpObj", groupedResult.Name())
+	assert.NotNil(t, groupedResult.Fields()["task"])
+	assert.NotNil(t, groupedResult.Fields()["properties"])
+
+	t.Run("error is resolved as its message", func(t *testing.T) {
+		resolve := generateObject.Fields()["error"].Resolve
+
+		res, err := resolve(graphql.ResolveParams{
+			Source: &ent.GenerateResult{Error: errors.New("rate limited")},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, "rate limited", res)
+
+		res, err = resolve(graphql.ResolveParams{Source: &ent.GenerateResult{}})
+		require.Nil(t, err)
+		assert.Nil(t, res)
+	})
+}
@@ -0,0 +1,64 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package generate
+
+import (
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func (p *GenerateProvider) parseGenerateArguments(args []*ast.Argument) *Params {
+	out := &Params{}
+
+	for _, arg := range args {
+		switch arg.Name.Value {
+		case "singleResult":
+			obj := arg.Value.(*ast.ObjectValue)
+			for _, field := range obj.Fields {
+				switch field.Name.Value {
+				case "prompt":
+					prompt := field.Value.GetValue().(string)
+					out.Prompt = &prompt
+				default:
+					p.logger.WithField("action", "generate_parse_arguments").
+						Warnf("ignoring unrecognized argument: %s.%s", arg.Name.Value, field.Name.Value)
+				}
+			}
+
+		case "groupedResult":
+			obj := arg.Value.(*ast.ObjectValue)
+			for _, field := range obj.Fields {
+				switch field.Name.Value {
+				case "task":
+					task := field.Value.GetValue().(string)
+					out.Task = &task
+				case "properties":
+					inp := field.Value.GetValue().([]ast.Value)
+					out.Properties = make([]string, len(inp))
+
+					for i, value := range inp {
+						out.Properties[i] = value.(*ast.StringValue).Value
+					}
+				default:
+					p.logger.WithField("action", "generate_parse_arguments").
+						Warnf("ignoring unrecognized argument: %s.%s", arg.Name.Value, field.Name.Value)
+				}
+			}
+
+		default:
+			// ignore what we don't recognize
+			p.logger.WithField("action", "generate_parse_arguments").
+				Warnf("ignoring unrecognized argument: %s", arg.Name.Value)
+		}
+	}
+
+	return out
+}
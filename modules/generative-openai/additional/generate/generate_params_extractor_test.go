@@ -0,0 +1,128 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package generate
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseGenerateArguments(t *testing.T) {
+	prompt := "Summarize {title}"
+	task := "Summarize all"
+
+	tests := []struct {
+		name string
+		args []*ast.Argument
+		want *Params
+	}{
+		{
+			name: "Should create with no params",
+			want: &Params{},
+		},
+		{
+			name: "Should create with singleResult",
+			args: []*ast.Argument{
+				createObjectArg("singleResult", map[string]ast.Value{
+					"prompt": createStringValue(prompt),
+				}),
+			},
+			want: &Params{Prompt: &prompt},
+		},
+		{
+			name: "Should create with groupedResult",
+			args: []*ast.Argument{
+				createObjectArg("groupedResult", map[string]ast.Value{
+					"task":       createStringValue(task),
+					"properties": createListValue([]string{"title", "content"}),
+				}),
+			},
+			want: &Params{Task: &task, Properties: []string{"title", "content"}},
+		},
+		{
+			name: "Should create with all params",
+			args: []*ast.Argument{
+				createObjectArg("singleResult", map[string]ast.Value{
+					"prompt": createStringValue(prompt),
+				}),
+				createObjectArg("groupedResult", map[string]ast.Value{
+					"task": createStringValue(task),
+				}),
+			},
+			want: &Params{Prompt: &prompt, Task: &task},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &GenerateProvider{logger: nullLogger()}
+			actual := p.parseGenerateArguments(tt.args)
+			assert.Equal(t, tt.want, actual)
+		})
+	}
+}
+
+func Test_parseGenerateArguments_UnrecognizedArguments(t *testing.T) {
+	logger, hook := test.NewNullLogger()
+	p := New(nil, logger)
+	prompt := "Summarize {title}"
+
+	actual := p.parseGenerateArguments([]*ast.Argument{
+		createObjectArg("singleResult", map[string]ast.Value{
+			"prompt":  createStringValue(prompt),
+			"unknown": createStringValue("value"),
+		}),
+	})
+
+	assert.Equal(t, &Params{Prompt: &prompt}, actual)
+	require.Len(t, hook.AllEntries(), 1)
+	assert.Equal(t, "ignoring unrecognized argument: singleResult.unknown",
+		hook.LastEntry().Message)
+}
+
+func createObjectArg(name string, fields map[string]ast.Value) *ast.Argument {
+	objectFields := make([]*ast.ObjectField, 0, len(fields))
+	for fieldName, value := range fields {
+		objectFields = append(objectFields, ast.NewObjectField(&ast.ObjectField{
+			Name:  ast.NewName(&ast.Name{Value: fieldName}),
+			Kind:  "Kind",
+			Value: value,
+		}))
+	}
+
+	return ast.NewArgument(&ast.Argument{
+		Name:  ast.NewName(&ast.Name{Value: name}),
+		Kind:  "Kind",
+		Value: ast.NewObjectValue(&ast.ObjectValue{Kind: "Kind", Fields: objectFields}),
+	})
+}
+
+func createStringValue(value string) ast.Value {
+	return &ast.StringValue{
+		Kind:  "Kind",
+		Value: value,
+	}
+}
+
+func createListValue(valuesIn []string) ast.Value {
+	valuesAst := make([]ast.Value, len(valuesIn))
+	for i, value := range valuesIn {
+		valuesAst[i] = createStringValue(value)
+	}
+	return &ast.ListValue{
+		Kind:   "Kind",
+		Values: valuesAst,
+	}
+}
@@ -0,0 +1,177 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package generate
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/modules/generative-openai/ent"
+)
+
+// promptPropertyRegexp matches property placeholders such as {title} in a
+// prompt. Braces around anything else, e.g. json, are left untouched
+var promptPropertyRegexp = regexp.MustCompile(`\{([\w\s]*?)\}`)
+
+func (p *GenerateProvider) generateResult(ctx context.Context,
+	in []search.Result, params *Params,
+) ([]search.Result, error) {
+	if len(in) == 0 {
+		return in, nil
+	}
+	if params == nil {
+		return nil, fmt.Errorf("no params provided")
+	}
+
+	prompt, task := params.GetPrompt(), params.GetTask()
+	if prompt == nil && task == nil {
+		return in, errors.New("neither singleResult nor groupedResult provided")
+	}
+	if prompt != nil && strings.TrimSpace(*prompt) == "" {
+		return in, errors.New("singleResult: empty prompt provided")
+	}
+	if task != nil && strings.TrimSpace(*task) == "" {
+		return in, errors.New("groupedResult: empty task provided")
+	}
+
+	// a failing completion only affects the result it was made for, so it is
+	// returned as part of the result rather than failing the entire query
+	results := make([]*ent.GenerateResult, len(in))
+	for i := range results {
+		results[i] = &ent.GenerateResult{}
+	}
+
+	if prompt != nil {
+		for i := range in {
+			results[i].SingleResult, results[i].Error = p.generateSingleResult(ctx,
+				objectProperties(in[i]), *prompt)
+		}
+	}
+
+	if task != nil {
+		// the grouped result is about all objects, it is only set on the first one
+		grouped, err := p.generateGroupedResult(ctx, in, *task, params.GetProperties())
+		results[0].GroupedResult = grouped
+		if results[0].Error == nil {
+			results[0].Error = err
+		}
+	}
+
+	for i := range in {
+		ap := in[i].AdditionalProperties
+		if ap == nil {
+			ap = models.AdditionalProperties{}
+		}
+
+		ap["generate"] = results[i]
+
+		in[i].AdditionalProperties = ap
+	}
+
+	return in, nil
+}
+
+func (p *GenerateProvider) generateSingleResult(ctx context.Context,
+	props map[string]interface{}, prompt string,
+) (*string, error) {
+	prompt, err := p.interpolatePrompt(prompt, props)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.client.Generate(ctx, prompt)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Result, nil
+}
+
+func (p *GenerateProvider) generateGroupedResult(ctx context.Context,
+	in []search.Result, task string, properties []string,
+) (*string, error) {
+	objects := make([]map[string]interface{}, len(in))
+	for i := range in {
+		objects[i] = map[string]interface{}{}
+		for property, value := range objectProperties(in[i]) {
+			if p.containsProperty(property, properties) {
+				objects[i][property] = value
+			}
+		}
+	}
+
+	objectsJSON, err := json.Marshal(objects)
+	if err != nil {
+		return nil, fmt.Errorf("marshal objects: %w", err)
+	}
+
+	res, err := p.client.Generate(ctx, fmt.Sprintf("%s: %s", task, objectsJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Result, nil
+}
+
+func (p *GenerateProvider) interpolatePrompt(prompt string,
+	props map[string]interface{},
+) (string, error) {
+	var err error
+	out := promptPropertyRegexp.ReplaceAllStringFunc(prompt, func(match string) string {
+		property := strings.TrimSpace(match[1 : len(match)-1])
+		value, ok := props[property]
+		if !ok {
+			if err == nil {
+				err = fmt.Errorf("property %q used in prompt does not exist on object", property)
+			}
+			return match
+		}
+		return valueString(value)
+	})
+
+	return out, err
+}
+
+func (p *GenerateProvider) containsProperty(property string, properties []string) bool {
+	if len(properties) == 0 {
+		return true
+	}
+	for i := range properties {
+		if properties[i] == property {
+			return true
+		}
+	}
+	return false
+}
+
+func objectProperties(res search.Result) map[string]interface{} {
+	if props, ok := res.Schema.(map[string]interface{}); ok {
+		return props
+	}
+	return map[string]interface{}{}
+}
+
+func valueString(value interface{}) string {
+	if s, ok := value.(string); ok {
+		return s
+	}
+	if b, err := json.Marshal(value); err == nil {
+		return string(b)
+	}
+	return fmt.Sprint(value)
+}
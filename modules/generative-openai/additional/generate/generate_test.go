@@ -0,0 +1,251 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package generate
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/modules/generative-openai/ent"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdditionalGenerateProvider(t *testing.T) {
+	t.Run("should fail with empty params", func(t *testing.T) {
+		// given
+		client := &fakeGenerativeClient{}
+		generateProvider := New(client, nullLogger())
+		in := []search.Result{
+			{
+				ID: "some-uuid",
+				Schema: map[string]interface{}{
+					"content": "content",
+				},
+			},
+		}
+		fakeParams := &Params{}
+		limit := 1
+		argumentModuleParams := map[string]interface{}{}
+
+		// when
+		out, err := generateProvider.AdditionalPropertyFn(context.Background(), in, fakeParams, &limit, argumentModuleParams)
+
+		// then
+		require.NotNil(t, err)
+		require.NotEmpty(t, out)
+		assert.Equal(t, "neither singleResult nor groupedResult provided", err.Error())
+	})
+
+	t.Run("should fail with empty prompt", func(t *testing.T) {
+		client := &fakeGenerativeClient{}
+		generateProvider := New(client, nullLogger())
+		in := []search.Result{{ID: "some-uuid"}}
+		fakeParams := &Params{Prompt: ptString(" ")}
+
+		_, err := generateProvider.AdditionalPropertyFn(context.Background(), in, fakeParams, nil, nil)
+
+		require.NotNil(t, err)
+		assert.Equal(t, "singleResult: empty prompt provided", err.Error())
+	})
+
+	t.Run("should generate a single result per object", func(t *testing.T) {
+		client := &fakeGenerativeClient{}
+		generateProvider := New(client, nullLogger())
+		in := []search.Result{
+			{
+				ID: "some-uuid",
+				Schema: map[string]interface{}{
+					"title":    "Moby Dick",
+					"pages":    float64(635),
+					"keywords": []string{"whale", "sea"},
+				},
+			},
+			{
+				ID: "other-uuid",
+				Schema: map[string]interface{}{
+					"title":    "Dracula",
+					"pages":    float64(418),
+					"keywords": []string{"vampire"},
+				},
+			},
+		}
+		fakeParams := &Params{Prompt: ptString("Describe {title} ({ pages } pages) using {keywords}, as {\"json\": true}")}
+
+		out, err := generateProvider.AdditionalPropertyFn(context.Background(), in, fakeParams, nil, nil)
+
+		require.Nil(t, err)
+		require.Len(t, out, 2)
+		assert.Equal(t, []string{
+			"Describe Moby Dick (635 pages) using [\"whale\",\"sea\"], as {\"json\": true}",
+			"Describe Dracula (418 pages) using [\"vampire\"], as {\"json\": true}",
+		}, client.prompts)
+
+		first := generateResult(t, out[0])
+		require.Nil(t, first.Error)
+		assert.Equal(t, "generated: Describe Moby Dick (635 pages) using [\"whale\",\"sea\"], as {\"json\": true}",
+			*first.SingleResult)
+		assert.Nil(t, first.GroupedResult)
+		second := generateResult(t, out[1])
+		require.Nil(t, second.Error)
+		assert.Equal(t, "generated: Describe Dracula (418 pages) using [\"vampire\"], as {\"json\": true}",
+			*second.SingleResult)
+	})
+
+	t.Run("should report a missing property per object", func(t *testing.T) {
+		client := &fakeGenerativeClient{}
+		generateProvider := New(client, nullLogger())
+		in := []search.Result{
+			{
+				ID:     "some-uuid",
+				Schema: map[string]interface{}{"title": "Moby Dick"},
+			},
+			{
+				ID:     "other-uuid",
+				Schema: map[string]interface{}{},
+			},
+		}
+		fakeParams := &Params{Prompt: ptString("Summarize {title}")}
+
+		out, err := generateProvider.AdditionalPropertyFn(context.Background(), in, fakeParams, nil, nil)
+
+		require.Nil(t, err)
+		require.Len(t, out, 2)
+		first := generateResult(t, out[0])
+		assert.Nil(t, first.Error)
+		assert.Equal(t, "generated: Summarize Moby Dick", *first.SingleResult)
+		second := generateResult(t, out[1])
+		require.NotNil(t, second.Error)
+		assert.Equal(t, "property \"title\" used in prompt does not exist on object", second.Error.Error())
+		assert.Nil(t, second.SingleResult)
+		assert.Len(t, client.prompts, 1)
+	})
+
+	t.Run("should report a failing completion per object", func(t *testing.T) {
+		client := &fakeGenerativeClient{err: errors.New("rate limited")}
+		generateProvider := New(client, nullLogger())
+		in := []search.Result{
+			{
+				ID:     "some-uuid",
+				Schema: map[string]interface{}{"title": "Moby Dick"},
+			},
+		}
+		fakeParams := &Params{Prompt: ptString("Summarize {title}")}
+
+		out, err := generateProvider.AdditionalPropertyFn(context.Background(), in, fakeParams, nil, nil)
+
+		require.Nil(t, err)
+		res := generateResult(t, out[0])
+		require.NotNil(t, res.Error)
+		assert.Equal(t, "rate limited", res.Error.Error())
+	})
+
+	t.Run("should generate a grouped result on the first object", func(t *testing.T) {
+		client := &fakeGenerativeClient{}
+		generateProvider := New(client, nullLogger())
+		in := []search.Result{
+			{
+				ID: "some-uuid",
+				Schema: map[string]interface{}{
+					"title": "Moby Dick",
+					"pages": float64(635),
+				},
+			},
+			{
+				ID: "other-uuid",
+				Schema: map[string]interface{}{
+					"title": "Dracula",
+					"pages": float64(418),
+				},
+			},
+		}
+		fakeParams := &Params{
+			Task:       ptString("What do these books have in common?"),
+			Properties: []string{"title"},
+		}
+
+		out, err := generateProvider.AdditionalPropertyFn(context.Background(), in, fakeParams, nil, nil)
+
+		require.Nil(t, err)
+		require.Len(t, out, 2)
+		expectedPrompt := "What do these books have in common?: " +
+			"[{\"title\":\"Moby Dick\"},{\"title\":\"Dracula\"}]"
+		assert.Equal(t, []string{expectedPrompt}, client.prompts)
+
+		first := generateResult(t, out[0])
+		require.Nil(t, first.Error)
+		assert.Equal(t, "generated: "+expectedPrompt, *first.GroupedResult)
+		assert.Nil(t, first.SingleResult)
+		second := generateResult(t, out[1])
+		assert.Nil(t, second.GroupedResult)
+		assert.Nil(t, second.SingleResult)
+	})
+
+	t.Run("should generate single and grouped results together", func(t *testing.T) {
+		client := &fakeGenerativeClient{}
+		generateProvider := New(client, nullLogger())
+		in := []search.Result{
+			{
+				ID:     "some-uuid",
+				Schema: map[string]interface{}{"title": "Moby Dick"},
+			},
+		}
+		fakeParams := &Params{
+			Prompt: ptString("Summarize {title}"),
+			Task:   ptString("Summarize all"),
+		}
+
+		out, err := generateProvider.AdditionalPropertyFn(context.Background(), in, fakeParams, nil, nil)
+
+		require.Nil(t, err)
+		res := generateResult(t, out[0])
+		require.Nil(t, res.Error)
+		assert.Equal(t, "generated: Summarize Moby Dick", *res.SingleResult)
+		assert.Equal(t, "generated: Summarize all: [{\"title\":\"Moby Dick\"}]", *res.GroupedResult)
+	})
+}
+
+func generateResult(t *testing.T, res search.Result) *ent.GenerateResult {
+	generate, ok := res.AdditionalProperties["generate"]
+	require.True(t, ok)
+	generateResult, ok := generate.(*ent.GenerateResult)
+	require.True(t, ok)
+	return generateResult
+}
+
+func nullLogger() logrus.FieldLogger {
+	l, _ := test.NewNullLogger()
+	return l
+}
+
+func ptString(in string) *string {
+	return &in
+}
+
+type fakeGenerativeClient struct {
+	prompts []string
+	err     error
+}
+
+func (c *fakeGenerativeClient) Generate(ctx context.Context, prompt string,
+) (*ent.CompletionResult, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	c.prompts = append(c.prompts, prompt)
+	result := "generated: " + prompt
+	return &ent.CompletionResult{Result: &result}, nil
+}
@@ -0,0 +1,55 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package additional
+
+import (
+	"context"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/semi-technologies/weaviate/entities/modulecapabilities"
+	"github.com/semi-technologies/weaviate/entities/search"
+)
+
+type AdditionalProperty interface {
+	AdditionalPropertyFn(ctx context.Context,
+		in []search.Result, params interface{}, limit *int,
+		argumentModuleParams map[string]interface{}) ([]search.Result, error)
+	ExtractAdditionalFn(param []*ast.Argument) interface{}
+	AdditionalPropertyDefaultValue() interface{}
+	AdditionalFieldFn(classname string) *graphql.Field
+}
+
+type GraphQLAdditionalArgumentsProvider struct {
+	generateProvider AdditionalProperty
+}
+
+func New(generateProvider AdditionalProperty) *GraphQLAdditionalArgumentsProvider {
+	return &GraphQLAdditionalArgumentsProvider{generateProvider}
+}
+
+func (p *GraphQLAdditionalArgumentsProvider) AdditionalProperties() map[string]modulecapabilities.AdditionalProperty {
+	additionalProperties := map[string]modulecapabilities.AdditionalProperty{}
+	additionalProperties["generate"] = p.getGenerate()
+	return additionalProperties
+}
+
+func (p *GraphQLAdditionalArgumentsProvider) getGenerate() modulecapabilities.AdditionalProperty {
+	return modulecapabilities.AdditionalProperty{
+		GraphQLNames:           []string{"generate"},
+		GraphQLFieldFunction:   p.generateProvider.AdditionalFieldFn,
+		GraphQLExtractFunction: p.generateProvider.ExtractAdditionalFn,
+		SearchFunctions: modulecapabilities.AdditionalSearch{
+			ExploreGet: p.generateProvider.AdditionalPropertyFn,
+		},
+	}
+}
@@ -0,0 +1,159 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package clients
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/modules/generative-openai/ent"
+	"github.com/sirupsen/logrus"
+)
+
+type completionsRequest struct {
+	Model       string  `json:"model"`
+	Prompt      string  `json:"prompt"`
+	MaxTokens   int     `json:"max_tokens"`
+	Temperature float64 `json:"temperature"`
+}
+
+type completionsResponse struct {
+	Choices []completionChoice `json:"choices,omitempty"`
+	Error   *openAIApiError    `json:"error,omitempty"`
+}
+
+type completionChoice struct {
+	Text         string `json:"text"`
+	Index        int    `json:"index"`
+	FinishReason string `json:"finish_reason"`
+}
+
+type openAIApiError struct {
+	Message string `json:"message"`
+	Type    string `json:"type"`
+	Param   string `json:"param"`
+	Code    string `json:"code"`
+}
+
+type openai struct {
+	apiKey      string
+	httpClient  *http.Client
+	host        string
+	path        string
+	model       string
+	maxTokens   int
+	temperature float64
+	logger      logrus.FieldLogger
+}
+
+func New(apiKey, host, model string, logger logrus.FieldLogger) *openai {
+	if host == "" {
+		host = "https://api.openai.com"
+	}
+	if model == "" {
+		model = "text-davinci-003"
+	}
+	return &openai{
+		apiKey:      apiKey,
+		httpClient:  &http.Client{},
+		host:        host,
+		path:        "/v1/completions",
+		model:       model,
+		maxTokens:   1200,
+		temperature: 0,
+		logger:      logger,
+	}
+}
+
+func (v *openai) Generate(ctx context.Context, prompt string,
+) (*ent.CompletionResult, error) {
+	body, err := json.Marshal(completionsRequest{
+		Model:       v.model,
+		Prompt:      prompt,
+		MaxTokens:   v.maxTokens,
+		Temperature: v.temperature,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "marshal body")
+	}
+
+	oaiUrl, err := url.JoinPath(v.host, v.path)
+	if err != nil {
+		return nil, errors.Wrap(err, "join OpenAI API host and path")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", oaiUrl,
+		bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "create POST request")
+	}
+	apiKey, err := v.getApiKey(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "OpenAI API Key")
+	}
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", apiKey))
+	req.Header.Add("Content-Type", "application/json")
+
+	res, err := v.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "send POST request")
+	}
+	defer res.Body.Close()
+
+	bodyBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "read response body")
+	}
+
+	var resBody completionsResponse
+	if err := json.Unmarshal(bodyBytes, &resBody); err != nil {
+		return nil, errors.Wrap(err, "unmarshal response body")
+	}
+
+	if res.StatusCode > 399 {
+		if resBody.Error != nil {
+			return nil, errors.Errorf("failed with status: %d error: %v", res.StatusCode, resBody.Error.Message)
+		}
+		return nil, errors.Errorf("failed with status: %d", res.StatusCode)
+	}
+
+	if len(resBody.Choices) == 0 {
+		return nil, errors.Errorf("no completion returned")
+	}
+
+	// completions usually start with line breaks separating them from the prompt
+	text := strings.TrimSpace(resBody.Choices[0].Text)
+	return &ent.CompletionResult{
+		Result: &text,
+	}, nil
+}
+
+func (v *openai) getApiKey(ctx context.Context) (string, error) {
+	if len(v.apiKey) > 0 {
+		return v.apiKey, nil
+	}
+	apiKey := ctx.Value("X-Openai-Api-Key")
+	if apiKeyHeader, ok := apiKey.([]string); ok &&
+		len(apiKeyHeader) > 0 && len(apiKeyHeader[0]) > 0 {
+		return apiKeyHeader[0], nil
+	}
+	return "", errors.New("no api key found " +
+		"neither in request header: X-OpenAI-Api-Key " +
+		"nor in environment variable under OPENAI_APIKEY")
+}
@@ -0,0 +1,166 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient(t *testing.T) {
+	t.Run("when all is fine", func(t *testing.T) {
+		handler := &fakeHandler{t: t}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+		c := New("apiKey", server.URL, "", nullLogger())
+
+		res, err := c.Generate(context.Background(), "What is the answer?")
+
+		require.Nil(t, err)
+		require.NotNil(t, res.Result)
+		assert.Equal(t, "42", *res.Result)
+		assert.Equal(t, "text-davinci-003", handler.model)
+		assert.Equal(t, "What is the answer?", handler.prompt)
+	})
+
+	t.Run("when a model is configured", func(t *testing.T) {
+		handler := &fakeHandler{t: t}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+		c := New("apiKey", server.URL, "text-curie-001", nullLogger())
+
+		_, err := c.Generate(context.Background(), "What is the answer?")
+
+		require.Nil(t, err)
+		assert.Equal(t, "text-curie-001", handler.model)
+	})
+
+	t.Run("when the context is expired", func(t *testing.T) {
+		server := httptest.NewServer(&fakeHandler{t: t})
+		defer server.Close()
+		c := New("apiKey", server.URL, "", nullLogger())
+		ctx, cancel := context.WithDeadline(context.Background(), time.Now())
+		defer cancel()
+
+		_, err := c.Generate(ctx, "What is the answer?")
+
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "context deadline exceeded")
+	})
+
+	t.Run("when the server returns an error", func(t *testing.T) {
+		server := httptest.NewServer(&fakeHandler{
+			t:           t,
+			serverError: errors.Errorf("nope, not gonna happen"),
+		})
+		defer server.Close()
+		c := New("apiKey", server.URL, "", nullLogger())
+
+		_, err := c.Generate(context.Background(), "What is the answer?")
+
+		require.NotNil(t, err)
+		assert.Equal(t, "failed with status: 500 error: nope, not gonna happen", err.Error())
+	})
+
+	t.Run("when OpenAI key is passed using X-Openai-Api-Key header", func(t *testing.T) {
+		server := httptest.NewServer(&fakeHandler{t: t})
+		defer server.Close()
+		c := New("", server.URL, "", nullLogger())
+		ctxWithValue := context.WithValue(context.Background(),
+			"X-Openai-Api-Key", []string{"some-key"})
+
+		res, err := c.Generate(ctxWithValue, "What is the answer?")
+
+		require.Nil(t, err)
+		assert.Equal(t, "42", *res.Result)
+	})
+
+	t.Run("when OpenAI key is empty", func(t *testing.T) {
+		server := httptest.NewServer(&fakeHandler{t: t})
+		defer server.Close()
+		c := New("", server.URL, "", nullLogger())
+
+		_, err := c.Generate(context.Background(), "What is the answer?")
+
+		require.NotNil(t, err)
+		assert.Equal(t, "OpenAI API Key: no api key found "+
+			"neither in request header: X-OpenAI-Api-Key "+
+			"nor in environment variable under OPENAI_APIKEY", err.Error())
+	})
+}
+
+type fakeHandler struct {
+	t           *testing.T
+	serverError error
+	model       string
+	prompt      string
+}
+
+func (f *fakeHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	assert.Equal(f.t, http.MethodPost, r.Method)
+	assert.Equal(f.t, "/v1/completions", r.URL.Path)
+
+	if f.serverError != nil {
+		completion := map[string]interface{}{
+			"error": map[string]interface{}{
+				"message": f.serverError.Error(),
+				"type":    "invalid_request_error",
+			},
+		}
+		outBytes, err := json.Marshal(completion)
+		require.Nil(f.t, err)
+
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write(outBytes)
+		return
+	}
+
+	bodyBytes, err := io.ReadAll(r.Body)
+	require.Nil(f.t, err)
+	defer r.Body.Close()
+
+	var b map[string]interface{}
+	require.Nil(f.t, json.Unmarshal(bodyBytes, &b))
+	f.model = b["model"].(string)
+	f.prompt = b["prompt"].(string)
+
+	completion := map[string]interface{}{
+		"object": "text_completion",
+		"choices": []interface{}{
+			map[string]interface{}{
+				"text":          "\n\n42",
+				"index":         0,
+				"finish_reason": "stop",
+			},
+		},
+	}
+	outBytes, err := json.Marshal(completion)
+	require.Nil(f.t, err)
+
+	w.Write(outBytes)
+}
+
+func nullLogger() logrus.FieldLogger {
+	l, _ := test.NewNullLogger()
+	return l
+}
@@ -0,0 +1,97 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package modgenerativeopenai
+
+import (
+	"context"
+	"net/http"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/modulecapabilities"
+	"github.com/semi-technologies/weaviate/entities/moduletools"
+	generativeadditional "github.com/semi-technologies/weaviate/modules/generative-openai/additional"
+	generativeadditionalgenerate "github.com/semi-technologies/weaviate/modules/generative-openai/additional/generate"
+	"github.com/semi-technologies/weaviate/modules/generative-openai/clients"
+	"github.com/semi-technologies/weaviate/modules/generative-openai/ent"
+	"github.com/sirupsen/logrus"
+)
+
+const Name = "generative-openai"
+
+func New() *GenerativeOpenAIModule {
+	return &GenerativeOpenAIModule{}
+}
+
+type GenerativeOpenAIModule struct {
+	generative                   generativeClient
+	additionalPropertiesProvider modulecapabilities.AdditionalProperties
+}
+
+type generativeClient interface {
+	Generate(ctx context.Context, prompt string) (*ent.CompletionResult, error)
+	MetaInfo() (map[string]interface{}, error)
+}
+
+func (m *GenerativeOpenAIModule) Name() string {
+	return Name
+}
+
+func (m *GenerativeOpenAIModule) Type() modulecapabilities.ModuleType {
+	return modulecapabilities.Text2Text
+}
+
+func (m *GenerativeOpenAIModule) Init(ctx context.Context,
+	params moduletools.ModuleInitParams,
+) error {
+	if err := m.initAdditional(ctx, params.GetLogger()); err != nil {
+		return errors.Wrap(err, "init additional")
+	}
+	return nil
+}
+
+func (m *GenerativeOpenAIModule) initAdditional(ctx context.Context,
+	logger logrus.FieldLogger,
+) error {
+	apiKey := os.Getenv("OPENAI_APIKEY")
+	host := os.Getenv("GENERATIVE_OPENAI_BASE_URL")
+	model := os.Getenv("GENERATIVE_OPENAI_MODEL")
+
+	client := clients.New(apiKey, host, model, logger)
+
+	m.generative = client
+
+	generateProvider := generativeadditionalgenerate.New(m.generative, logger)
+	m.additionalPropertiesProvider = generativeadditional.New(generateProvider)
+
+	return nil
+}
+
+func (m *GenerativeOpenAIModule) RootHandler() http.Handler {
+	// TODO: remove once this is a capability interface
+	return nil
+}
+
+func (m *GenerativeOpenAIModule) MetaInfo() (map[string]interface{}, error) {
+	return m.generative.MetaInfo()
+}
+
+func (m *GenerativeOpenAIModule) AdditionalProperties() map[string]modulecapabilities.AdditionalProperty {
+	return m.additionalPropertiesProvider.AdditionalProperties()
+}
+
+// verify we implement the modules.Module interface
+var (
+	_ = modulecapabilities.Module(New())
+	_ = modulecapabilities.AdditionalProperties(New())
+	_ = modulecapabilities.MetaProvider(New())
+)
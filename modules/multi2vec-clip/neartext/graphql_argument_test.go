@@ -31,6 +31,7 @@ func TestNearTextGraphQLArgument(t *testing.T) {
 		// {
 		//   concepts: ["c1", "c2"],
 		//   certainty: 0.9,
+		//   targetVector: "title",
 		//   moveTo: {
 		//          concepts: ["c1", "c2"],
 		//          objects: [
@@ -53,7 +54,7 @@ func TestNearTextGraphQLArgument(t *testing.T) {
 		nearTextFields, ok := nearText.Type.(*graphql.InputObject)
 		assert.True(t, ok)
 		assert.NotNil(t, nearTextFields)
-		assert.Equal(t, 4, len(nearTextFields.Fields()))
+		assert.Equal(t, 5, len(nearTextFields.Fields()))
 		fields := nearTextFields.Fields()
 		concepts := fields["concepts"]
 		conceptsNonNull, conceptsNonNullOK := concepts.Type.(*graphql.NonNull)
@@ -66,6 +67,7 @@ func TestNearTextGraphQLArgument(t *testing.T) {
 		assert.True(t, conceptsTypeOK)
 		assert.NotNil(t, conceptsType)
 		assert.NotNil(t, fields["certainty"])
+		assert.NotNil(t, fields["targetVector"])
 		assert.NotNil(t, fields["moveTo"])
 		moveTo, moveToOK := fields["moveTo"].Type.(*graphql.InputObject)
 		assert.True(t, moveToOK)
@@ -117,6 +119,7 @@ func TestNearTextGraphQLArgumentWithAutocorrect(t *testing.T) {
 		// {
 		//   concepts: ["c1", "c2"],
 		//   certainty: 0.9,
+		//   targetVector: "title",
 		//   autocorrect: true,
 		//   moveTo: {
 		//          concepts: ["c1", "c2"],
@@ -140,7 +143,7 @@ func TestNearTextGraphQLArgumentWithAutocorrect(t *testing.T) {
 		nearTextFields, ok := nearText.Type.(*graphql.InputObject)
 		assert.True(t, ok)
 		assert.NotNil(t, nearTextFields)
-		assert.Equal(t, 5, len(nearTextFields.Fields()))
+		assert.Equal(t, 6, len(nearTextFields.Fields()))
 		fields := nearTextFields.Fields()
 		concepts := fields["concepts"]
 		conceptsNonNull, conceptsNonNullOK := concepts.Type.(*graphql.NonNull)
@@ -153,6 +156,7 @@ func TestNearTextGraphQLArgumentWithAutocorrect(t *testing.T) {
 		assert.True(t, conceptsTypeOK)
 		assert.NotNil(t, conceptsType)
 		assert.NotNil(t, fields["certainty"])
+		assert.NotNil(t, fields["targetVector"])
 		assert.NotNil(t, fields["autocorrect"])
 		assert.NotNil(t, fields["moveTo"])
 		moveTo, moveToOK := fields["moveTo"].Type.(*graphql.InputObject)
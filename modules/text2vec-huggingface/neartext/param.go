@@ -25,6 +25,7 @@ type NearTextParams struct {
 	WithDistance bool
 	Network      bool
 	Autocorrect  bool
+	TargetVector string
 }
 
 func (n NearTextParams) GetCertainty() float64 {
@@ -35,6 +36,10 @@ func (n NearTextParams) GetDistance() float64 {
 	return n.Distance
 }
 
+func (n NearTextParams) GetTargetVector() string {
+	return n.TargetVector
+}
+
 func (n NearTextParams) SimilarityMetricProvided() bool {
 	return n.Certainty != 0 || n.WithDistance
 }
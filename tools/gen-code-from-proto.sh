@@ -0,0 +1,29 @@
+#!/usr/bin/env bash
+
+set -eou pipefail
+
+# Versions of the protoc plugins to use.
+protocGenGoVersion=v1.28.0
+protocGenGoGRPCVersion=v1.2.0
+
+# Always points to the directory of this script.
+DIR="$( cd "$( dirname "${BASH_SOURCE[0]}" )" && pwd )"
+
+if ! command -v protoc &> /dev/null; then
+  echo "protoc is required, see https://grpc.io/docs/protoc-installation/"
+  exit 1
+fi
+
+go install google.golang.org/protobuf/cmd/protoc-gen-go@$protocGenGoVersion
+go install google.golang.org/grpc/cmd/protoc-gen-go-grpc@$protocGenGoGRPCVersion
+
+# Remove old stuff.
+(cd $DIR/..; rm -f grpc/*.pb.go)
+
+(cd $DIR/..; protoc --proto_path=grpc \
+  --go_out=grpc --go_opt=paths=source_relative \
+  --go-grpc_out=grpc --go-grpc_opt=paths=source_relative \
+  weaviate.proto)
+
+echo Now add the header to the generated code too.
+(cd $DIR/..; GO111MODULE=on go run ./tools/license_headers/main.go)
@@ -0,0 +1,95 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package apikey
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"fmt"
+
+	errors "github.com/go-openapi/errors"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/config"
+)
+
+// Client validates static API keys, which are sent as bearer tokens, and maps
+// them to the configured users
+type Client struct {
+	config config.APIKey
+	// keys holds the sha256 sum of every allowed key, so that all candidates
+	// have the same length and can be compared in constant time
+	keys [][sha256.Size]byte
+}
+
+// New API key client. Just like the OIDC client, the client is also created
+// if API keys are disabled, so that it can deny requests with a clear error
+// message.
+func New(cfg config.Config) (*Client, error) {
+	c := &Client{
+		config: cfg.Authentication.APIKey,
+	}
+
+	if !c.config.Enabled {
+		return c, nil
+	}
+
+	if err := c.config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid apikey config: %w", err)
+	}
+
+	c.keys = make([][sha256.Size]byte, len(c.config.AllowedKeys))
+	for i, key := range c.config.AllowedKeys {
+		c.keys[i] = sha256.Sum256([]byte(key))
+	}
+
+	return c, nil
+}
+
+// ValidateAndExtract can be used as a middleware for go-swagger
+func (c *Client) ValidateAndExtract(token string, scopes []string) (*models.Principal, error) {
+	if !c.config.Enabled {
+		return nil, errors.New(401, "apikey auth is not configured, please try another auth scheme or set up weaviate with apikey configured")
+	}
+
+	pos, ok := c.keyPosition(token)
+	if !ok {
+		return nil, errors.New(401, "invalid api key, please provide a valid api key")
+	}
+
+	return &models.Principal{
+		Username: c.userForKeyAt(pos),
+	}, nil
+}
+
+// keyPosition compares the token against every allowed key, even after a
+// match was found, so that the time taken does not depend on which key
+// matched
+func (c *Client) keyPosition(token string) (int, bool) {
+	candidate := sha256.Sum256([]byte(token))
+
+	pos := -1
+	for i := range c.keys {
+		if subtle.ConstantTimeCompare(candidate[:], c.keys[i][:]) == 1 && pos == -1 {
+			pos = i
+		}
+	}
+
+	return pos, pos != -1
+}
+
+func (c *Client) userForKeyAt(pos int) string {
+	if len(c.config.Users) == 1 {
+		return c.config.Users[0]
+	}
+
+	return c.config.Users[pos]
+}
@@ -0,0 +1,120 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package apikey
+
+import (
+	"testing"
+
+	errors "github.com/go-openapi/errors"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_APIKey_NotConfigured(t *testing.T) {
+	cfg := config.Config{
+		Authentication: config.Authentication{
+			APIKey: config.APIKey{
+				Enabled: false,
+			},
+		},
+	}
+	expectedErr := errors.New(401, "apikey auth is not configured, please try another auth scheme or set up weaviate with apikey configured")
+
+	client, err := New(cfg)
+	require.Nil(t, err)
+
+	principal, err := client.ValidateAndExtract("token-doesnt-matter", []string{})
+	assert.Nil(t, principal)
+	assert.Equal(t, expectedErr, err)
+}
+
+func Test_APIKey_InvalidConfiguration(t *testing.T) {
+	cfg := config.Config{
+		Authentication: config.Authentication{
+			APIKey: config.APIKey{
+				Enabled:     true,
+				AllowedKeys: []string{"key1"},
+			},
+		},
+	}
+
+	_, err := New(cfg)
+	assert.NotNil(t, err)
+}
+
+func Test_APIKey_SingleUser(t *testing.T) {
+	cfg := config.Config{
+		Authentication: config.Authentication{
+			APIKey: config.APIKey{
+				Enabled:     true,
+				AllowedKeys: []string{"key1", "key2"},
+				Users:       []string{"jane"},
+			},
+		},
+	}
+
+	client, err := New(cfg)
+	require.Nil(t, err)
+
+	t.Run("with the first key", func(t *testing.T) {
+		principal, err := client.ValidateAndExtract("key1", nil)
+		require.Nil(t, err)
+		assert.Equal(t, &models.Principal{Username: "jane"}, principal)
+	})
+
+	t.Run("with the second key", func(t *testing.T) {
+		principal, err := client.ValidateAndExtract("key2", nil)
+		require.Nil(t, err)
+		assert.Equal(t, &models.Principal{Username: "jane"}, principal)
+	})
+
+	t.Run("with an unknown key", func(t *testing.T) {
+		principal, err := client.ValidateAndExtract("key3", nil)
+		assert.Nil(t, principal)
+		assert.Equal(t, errors.New(401, "invalid api key, please provide a valid api key"), err)
+	})
+}
+
+func Test_APIKey_UserPerKey(t *testing.T) {
+	cfg := config.Config{
+		Authentication: config.Authentication{
+			APIKey: config.APIKey{
+				Enabled:     true,
+				AllowedKeys: []string{"key1", "key2"},
+				Users:       []string{"jane", "john"},
+			},
+		},
+	}
+
+	client, err := New(cfg)
+	require.Nil(t, err)
+
+	t.Run("with the first key", func(t *testing.T) {
+		principal, err := client.ValidateAndExtract("key1", nil)
+		require.Nil(t, err)
+		assert.Equal(t, &models.Principal{Username: "jane"}, principal)
+	})
+
+	t.Run("with the second key", func(t *testing.T) {
+		principal, err := client.ValidateAndExtract("key2", nil)
+		require.Nil(t, err)
+		assert.Equal(t, &models.Principal{Username: "john"}, principal)
+	})
+
+	t.Run("with a prefix of a key", func(t *testing.T) {
+		principal, err := client.ValidateAndExtract("key", nil)
+		assert.Nil(t, principal)
+		assert.NotNil(t, err)
+	})
+}
@@ -0,0 +1,60 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package composer
+
+import (
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/config"
+)
+
+// TokenFunc validates a bearer token and extracts the principal, it matches
+// the signature of the go-swagger auth middleware
+type TokenFunc func(token string, scopes []string) (*models.Principal, error)
+
+type tokenValidator interface {
+	ValidateAndExtract(token string, scopes []string) (*models.Principal, error)
+}
+
+// New composes the bearer token based auth schemes. If only one of them is
+// enabled, every token is passed to it. If both are enabled, tokens which are
+// JWTs are passed to OIDC, anything else is treated as an API key.
+func New(cfg config.Authentication, apikey, oidc tokenValidator) TokenFunc {
+	if cfg.APIKey.Enabled && cfg.OIDC.Enabled {
+		return pickByTokenFormat(apikey, oidc)
+	}
+
+	if cfg.APIKey.Enabled {
+		return apikey.ValidateAndExtract
+	}
+
+	// OIDC is also the fallback if no bearer token scheme is enabled at all, so
+	// that the user gets the same error message as before API keys existed
+	return oidc.ValidateAndExtract
+}
+
+func pickByTokenFormat(apikey, oidc tokenValidator) TokenFunc {
+	return func(token string, scopes []string) (*models.Principal, error) {
+		if isJWT(token) {
+			return oidc.ValidateAndExtract(token, scopes)
+		}
+
+		return apikey.ValidateAndExtract(token, scopes)
+	}
+}
+
+// isJWT only checks the format of the token, the signature is validated by
+// the OIDC client
+func isJWT(token string) bool {
+	_, _, err := (&jwt.Parser{}).ParseUnverified(token, jwt.MapClaims{})
+	return err == nil
+}
@@ -0,0 +1,116 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package composer
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_TokenAuthComposer(t *testing.T) {
+	jwtToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256,
+		jwt.MapClaims{"sub": "jane"}).SignedString([]byte("secret"))
+	require.Nil(t, err)
+
+	apikey := &fakeValidator{name: "apikey"}
+	oidc := &fakeValidator{name: "oidc"}
+
+	tests := []struct {
+		name          string
+		config        config.Authentication
+		token         string
+		expectedUser  string
+		expectedError bool
+	}{
+		{
+			name:         "nothing enabled",
+			token:        "any-token",
+			expectedUser: "oidc",
+		},
+		{
+			name: "only apikey enabled with a jwt",
+			config: config.Authentication{
+				APIKey: config.APIKey{Enabled: true},
+			},
+			token:        jwtToken,
+			expectedUser: "apikey",
+		},
+		{
+			name: "only oidc enabled with a plain token",
+			config: config.Authentication{
+				OIDC: config.OIDC{Enabled: true},
+			},
+			token:        "some-key",
+			expectedUser: "oidc",
+		},
+		{
+			name: "both enabled with a jwt",
+			config: config.Authentication{
+				APIKey: config.APIKey{Enabled: true},
+				OIDC:   config.OIDC{Enabled: true},
+			},
+			token:        jwtToken,
+			expectedUser: "oidc",
+		},
+		{
+			name: "both enabled with a plain token",
+			config: config.Authentication{
+				APIKey: config.APIKey{Enabled: true},
+				OIDC:   config.OIDC{Enabled: true},
+			},
+			token:        "some-key",
+			expectedUser: "apikey",
+		},
+		{
+			name: "both enabled with an invalid token",
+			config: config.Authentication{
+				APIKey: config.APIKey{Enabled: true},
+				OIDC:   config.OIDC{Enabled: true},
+			},
+			token:         "invalid",
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			principal, err := New(test.config, apikey, oidc)(test.token, nil)
+			if test.expectedError {
+				assert.NotNil(t, err)
+				return
+			}
+
+			require.Nil(t, err)
+			assert.Equal(t, test.expectedUser, principal.Username)
+		})
+	}
+}
+
+type fakeValidator struct {
+	name string
+}
+
+func (f *fakeValidator) ValidateAndExtract(token string,
+	scopes []string,
+) (*models.Principal, error) {
+	if token == "invalid" {
+		return nil, fmt.Errorf("%s: invalid token", f.name)
+	}
+
+	return &models.Principal{Username: f.name}, nil
+}
@@ -0,0 +1,290 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package backup
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/semi-technologies/weaviate/entities/backup"
+)
+
+const (
+	// DefaultChunkSize is the maximum size of a chunk in MB if none is configured
+	DefaultChunkSize = 128
+	minChunkSize     = 1
+	maxChunkSize     = 512
+)
+
+// Compression levels of an archived backup
+const (
+	DefaultCompression = "DefaultCompression"
+	BestSpeed          = "BestSpeed"
+	BestCompression    = "BestCompression"
+)
+
+// ArchiveConfig configures the archive mode of a backup. In archive mode the
+// shard files of a class are streamed into compressed tar chunks instead of
+// being uploaded one by one.
+type ArchiveConfig struct {
+	// Enabled turns the archive mode on
+	Enabled bool `json:"archive"`
+	// ChunkSize is the maximum size in MB of the files contained in one chunk.
+	// Files are never split, a single file larger than ChunkSize results in a
+	// larger chunk.
+	ChunkSize int `json:"chunkSize"`
+	// CompressionLevel is one of DefaultCompression, BestSpeed or BestCompression
+	CompressionLevel string `json:"compressionLevel"`
+}
+
+// ParseArchiveConfig parses the custom config of a backup create request
+func ParseArchiveConfig(cfg interface{}) (ArchiveConfig, error) {
+	var c ArchiveConfig
+	if cfg == nil {
+		return c, nil
+	}
+	bytes, err := json.Marshal(cfg)
+	if err != nil {
+		return c, fmt.Errorf("marshal config: %w", err)
+	}
+	if err := json.Unmarshal(bytes, &c); err != nil {
+		return c, fmt.Errorf("invalid config: %w", err)
+	}
+	return c, nil
+}
+
+// Validate checks chunk size and compression level if the archive mode is enabled
+func (c ArchiveConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.ChunkSize != 0 && (c.ChunkSize < minChunkSize || c.ChunkSize > maxChunkSize) {
+		return fmt.Errorf("invalid chunk size %d: must be between %d and %d MB",
+			c.ChunkSize, minChunkSize, maxChunkSize)
+	}
+	_, err := c.level()
+	return err
+}
+
+// chunkSize in bytes
+func (c ArchiveConfig) chunkSize() int64 {
+	if c.ChunkSize == 0 {
+		return DefaultChunkSize << 20
+	}
+	return int64(c.ChunkSize) << 20
+}
+
+func (c ArchiveConfig) level() (int, error) {
+	switch c.CompressionLevel {
+	case "", DefaultCompression:
+		return gzip.DefaultCompression, nil
+	case BestSpeed:
+		return gzip.BestSpeed, nil
+	case BestCompression:
+		return gzip.BestCompression, nil
+	default:
+		return 0, fmt.Errorf("invalid compression level %q: must be one of %s, %s, %s",
+			c.CompressionLevel, DefaultCompression, BestSpeed, BestCompression)
+	}
+}
+
+// archiver streams the shard files of a class into compressed tar chunks
+type archiver struct {
+	sourcePath string // data path of all source files
+	tempDir    string // chunks are written here, relative to sourcePath
+	chunkSize  int64
+	level      int
+}
+
+func newArchiver(sourcePath, backupID string, cfg ArchiveConfig) (*archiver, error) {
+	level, err := cfg.level()
+	if err != nil {
+		return nil, err
+	}
+	return &archiver{
+		sourcePath: sourcePath,
+		tempDir:    path.Join(_TempDirectory, backupID),
+		chunkSize:  cfg.chunkSize(),
+		level:      level,
+	}, nil
+}
+
+// class writes the given shard files of class into chunks and passes every
+// completed chunk to put. It returns the descriptors of all uploaded chunks.
+func (a *archiver) class(ctx context.Context, class string, files []string,
+	put func(ctx context.Context, key, srcPath string) error,
+) ([]backup.ChunkDescriptor, error) {
+	classDir := path.Join(a.sourcePath, a.tempDir, class)
+	if err := os.MkdirAll(classDir, os.ModePerm); err != nil {
+		return nil, fmt.Errorf("create chunk folder %s: %w", classDir, err)
+	}
+	defer os.RemoveAll(classDir)
+
+	chunks := make([]backup.ChunkDescriptor, 0, 4)
+	for len(files) > 0 {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		key := fmt.Sprintf("%s/chunk-%d.tar.gz", class, len(chunks))
+		srcPath := path.Join(a.tempDir, key)
+		chunk, n, err := a.writeChunk(path.Join(a.sourcePath, srcPath), files)
+		if err != nil {
+			return nil, fmt.Errorf("write chunk %s: %w", key, err)
+		}
+		if err := put(ctx, key, srcPath); err != nil {
+			return nil, fmt.Errorf("upload chunk %s: %w", key, err)
+		}
+		os.Remove(path.Join(a.sourcePath, srcPath))
+		chunk.Key = key
+		chunks = append(chunks, chunk)
+		files = files[n:]
+	}
+	return chunks, nil
+}
+
+// writeChunk writes files into the archive destPath until the chunk size is
+// reached. It returns the descriptor of the chunk and the number of files
+// it contains.
+func (a *archiver) writeChunk(destPath string, files []string) (chunk backup.ChunkDescriptor, n int, err error) {
+	f, err := os.Create(destPath)
+	if err != nil {
+		return chunk, 0, err
+	}
+	defer f.Close()
+
+	cw := &checksumWriter{w: f, hash: sha256.New()}
+	zw, err := gzip.NewWriterLevel(cw, a.level)
+	if err != nil {
+		return chunk, 0, err
+	}
+	tw := tar.NewWriter(zw)
+	var size int64
+	for _, fpath := range files {
+		info, err := os.Stat(path.Join(a.sourcePath, fpath))
+		if err != nil {
+			return chunk, 0, err
+		}
+		if n > 0 && size+info.Size() > a.chunkSize {
+			break
+		}
+		if err := a.addFile(tw, fpath, info); err != nil {
+			return chunk, 0, fmt.Errorf("add file %s: %w", fpath, err)
+		}
+		size += info.Size()
+		n++
+	}
+	if err := tw.Close(); err != nil {
+		return chunk, 0, err
+	}
+	if err := zw.Close(); err != nil {
+		return chunk, 0, err
+	}
+	if err := f.Close(); err != nil {
+		return chunk, 0, err
+	}
+	chunk.Size = cw.n
+	chunk.Checksum = hex.EncodeToString(cw.hash.Sum(nil))
+	return chunk, n, nil
+}
+
+func (a *archiver) addFile(tw *tar.Writer, fpath string, info os.FileInfo) error {
+	hdr, err := tar.FileInfoHeader(info, "")
+	if err != nil {
+		return err
+	}
+	hdr.Name = fpath
+	if err := tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+	f, err := os.Open(path.Join(a.sourcePath, fpath))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	// copy exactly the announced size in case the file is still being appended to
+	_, err = io.CopyN(tw, f, hdr.Size)
+	return err
+}
+
+// unarchive extracts all files of the chunk srcPath into destDir
+func unarchive(srcPath, destDir string) error {
+	f, err := os.Open(srcPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	zr, err := gzip.NewReader(f)
+	if err != nil {
+		return err
+	}
+	defer zr.Close()
+	tr := tar.NewReader(zr)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		name := filepath.Clean(hdr.Name)
+		if filepath.IsAbs(name) || name == ".." || strings.HasPrefix(name, ".."+string(filepath.Separator)) {
+			return fmt.Errorf("invalid file path %q", hdr.Name)
+		}
+		if err := extractFile(tr, filepath.Join(destDir, name), hdr.FileInfo().Mode()); err != nil {
+			return fmt.Errorf("extract %s: %w", name, err)
+		}
+	}
+}
+
+func extractFile(r io.Reader, destPath string, mode os.FileMode) error {
+	if err := os.MkdirAll(filepath.Dir(destPath), os.ModePerm); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(destPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, mode)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// checksumWriter hashes and counts all bytes written to w
+type checksumWriter struct {
+	w    io.Writer
+	hash hash.Hash
+	n    int64
+}
+
+func (c *checksumWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.hash.Write(p[:n])
+	c.n += int64(n)
+	return n, err
+}
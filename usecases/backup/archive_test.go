@@ -0,0 +1,160 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchiveConfig(t *testing.T) {
+	tests := []struct {
+		cfg     ArchiveConfig
+		success bool
+	}{
+		{cfg: ArchiveConfig{}, success: true},
+		{cfg: ArchiveConfig{ChunkSize: 10000, CompressionLevel: "fast"}, success: true}, // disabled
+		{cfg: ArchiveConfig{Enabled: true}, success: true},
+		{cfg: ArchiveConfig{Enabled: true, ChunkSize: minChunkSize, CompressionLevel: BestSpeed}, success: true},
+		{cfg: ArchiveConfig{Enabled: true, ChunkSize: maxChunkSize, CompressionLevel: BestCompression}, success: true},
+		{cfg: ArchiveConfig{Enabled: true, ChunkSize: maxChunkSize + 1}},
+		{cfg: ArchiveConfig{Enabled: true, ChunkSize: -1}},
+		{cfg: ArchiveConfig{Enabled: true, CompressionLevel: "fast"}},
+	}
+	for i, tc := range tests {
+		err := tc.cfg.Validate()
+		if got := err == nil; got != tc.success {
+			t.Errorf("%d. validate(%+v): want=%v got=%v err=%v", i, tc.cfg, tc.success, got, err)
+		}
+	}
+
+	t.Run("Parse", func(t *testing.T) {
+		cfg, err := ParseArchiveConfig(map[string]interface{}{
+			"archive": true, "chunkSize": 64, "compressionLevel": BestSpeed,
+		})
+		require.Nil(t, err)
+		assert.Equal(t, ArchiveConfig{Enabled: true, ChunkSize: 64, CompressionLevel: BestSpeed}, cfg)
+
+		cfg, err = ParseArchiveConfig(nil)
+		require.Nil(t, err)
+		assert.False(t, cfg.Enabled)
+
+		_, err = ParseArchiveConfig(map[string]interface{}{"chunkSize": "big"})
+		assert.NotNil(t, err)
+	})
+}
+
+func TestArchiveChunks(t *testing.T) {
+	var (
+		ctx      = context.Background()
+		backupID = "1"
+		cls      = "Class-A"
+		files    = map[string]string{
+			"dir1/file1": "0123456789",
+			"dir1/file2": "abcdefghij",
+			"dir2/file3": "klmnopqrstuvwxyz",
+		}
+		sourcePath = t.TempDir()
+		storePath  = t.TempDir() // plays the role of the backup backend
+	)
+	for fpath, content := range files {
+		require.Nil(t, os.MkdirAll(path.Join(sourcePath, path.Dir(fpath)), os.ModePerm))
+		require.Nil(t, os.WriteFile(path.Join(sourcePath, fpath), []byte(content), os.ModePerm))
+	}
+	desc := genClassDescriptions(cls)[0]
+	desc.Shards[0].Files = []string{"dir1/file1", "dir1/file2", "dir2/file3"}
+
+	put := func(ctx context.Context, key, srcPath string) error {
+		bytes, err := os.ReadFile(path.Join(sourcePath, srcPath))
+		if err != nil {
+			return err
+		}
+		require.Nil(t, os.MkdirAll(path.Join(storePath, path.Dir(key)), os.ModePerm))
+		return os.WriteFile(path.Join(storePath, key), bytes, os.ModePerm)
+	}
+
+	a, err := newArchiver(sourcePath, backupID, ArchiveConfig{Enabled: true})
+	require.Nil(t, err)
+	a.chunkSize = 20 // bytes, the first two files fit into one chunk
+	desc.Chunks, err = a.class(ctx, cls, desc.Shards[0].Files, put)
+	require.Nil(t, err)
+
+	t.Run("Chunks", func(t *testing.T) {
+		require.Len(t, desc.Chunks, 2)
+		for i, chunk := range desc.Chunks {
+			assert.Equal(t, fmt.Sprintf("%s/chunk-%d.tar.gz", cls, i), chunk.Key)
+			assert.Nil(t, verifyChunk(path.Join(storePath, chunk.Key), chunk))
+		}
+		assert.NoDirExists(t, path.Join(sourcePath, _TempDirectory, backupID, cls))
+	})
+
+	newWriter := func(destDir string) *fileWriter {
+		backend := newFakeBackend()
+		backend.On("SourceDataPath").Return(destDir)
+		backend.On("WriteToFile", ctx, mock.Anything, mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) {
+				bytes, _ := os.ReadFile(path.Join(storePath, args.String(2)))
+				os.WriteFile(args.String(3), bytes, os.ModePerm)
+			}).Return(nil)
+		return newFileWriter(nil, nodeStore{objStore{b: backend, BasePath: backupID}}, backupID)
+	}
+
+	t.Run("Restore", func(t *testing.T) {
+		destDir := t.TempDir()
+		_, err := newWriter(destDir).Write(ctx, &desc)
+		require.Nil(t, err)
+		for fpath, content := range files {
+			bytes, err := os.ReadFile(path.Join(destDir, fpath))
+			require.Nil(t, err)
+			assert.Equal(t, content, string(bytes))
+		}
+	})
+
+	t.Run("ChecksumMismatch", func(t *testing.T) {
+		key := path.Join(storePath, desc.Chunks[1].Key)
+		bytes, err := os.ReadFile(key)
+		require.Nil(t, err)
+		bytes[len(bytes)-1] ^= 0xff
+		require.Nil(t, os.WriteFile(key, bytes, os.ModePerm))
+
+		destDir := t.TempDir()
+		_, err = newWriter(destDir).Write(ctx, &desc)
+		assert.True(t, errors.Is(err, errChecksumMismatch))
+		// nothing has been moved into the data path
+		entries, err := os.ReadDir(destDir)
+		require.Nil(t, err)
+		for _, e := range entries {
+			assert.Equal(t, _TempDirectory, e.Name())
+		}
+	})
+
+	t.Run("InvalidFilePath", func(t *testing.T) {
+		srcDir := t.TempDir()
+		require.Nil(t, os.WriteFile(path.Join(srcDir, "evil"), []byte("x"), os.ModePerm))
+		a, err := newArchiver(srcDir, backupID, ArchiveConfig{Enabled: true})
+		require.Nil(t, err)
+		require.Nil(t, os.MkdirAll(path.Join(srcDir, _TempDirectory), os.ModePerm))
+		chunkPath := path.Join(srcDir, _TempDirectory, "chunk.tar.gz")
+		_, _, err = a.writeChunk(chunkPath, []string{"../" + filepath.Base(srcDir) + "/evil"})
+		require.Nil(t, err)
+		assert.NotNil(t, unarchive(chunkPath, t.TempDir()))
+	})
+}
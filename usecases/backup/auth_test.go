@@ -49,6 +49,18 @@ func Test_Authorization(t *testing.T) {
 			expectedVerb:     "get",
 			expectedResource: "backups/s3/123",
 		},
+		{
+			methodName:       "List",
+			additionalArgs:   []interface{}{"s3"},
+			expectedVerb:     "get",
+			expectedResource: "backups/s3",
+		},
+		{
+			methodName:       "Delete",
+			additionalArgs:   []interface{}{"s3", "123"},
+			expectedVerb:     "delete",
+			expectedResource: "backups/s3/123",
+		},
 		{
 			methodName:       "Restore",
 			additionalArgs:   []interface{}{req},
@@ -71,7 +83,7 @@ func Test_Authorization(t *testing.T) {
 
 		for _, method := range allExportedMethods(&Scheduler{}) {
 			switch method {
-			case "OnCommit", "OnAbort", "OnCanCommit", "OnStatus":
+			case "OnCommit", "OnAbort", "OnCanCommit", "OnStatus", "StartSchedule":
 				continue
 			}
 			assert.Contains(t, testedMethods, method)
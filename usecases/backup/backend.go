@@ -118,6 +118,11 @@ func (s *nodeStore) Meta(ctx context.Context, backupID string, adjustBasePath bo
 	return &result, err
 }
 
+// base returns the store of a previous backup created by node
+func (s *nodeStore) base(backupID, node string) nodeStore {
+	return nodeStore{objStore{b: s.b, BasePath: fmt.Sprintf("%s/%s", backupID, node)}}
+}
+
 // meta marshals and uploads metadata
 func (s *nodeStore) PutMeta(ctx context.Context, desc *backup.BackupDescriptor) error {
 	return s.putMeta(ctx, BackupFile, desc)
@@ -150,13 +155,16 @@ type uploader struct {
 	sourcer   Sourcer
 	backend   nodeStore
 	backupID  string
+	archive   ArchiveConfig
+	base      map[string]baseFile // files which can be referenced
 	setStatus func(st backup.Status)
 }
 
 func newUploader(sourcer Sourcer, backend nodeStore,
-	backupID string, setstaus func(st backup.Status),
+	backupID string, archive ArchiveConfig, base map[string]baseFile,
+	setstaus func(st backup.Status),
 ) *uploader {
-	return &uploader{sourcer, backend, backupID, setstaus}
+	return &uploader{sourcer, backend, backupID, archive, base, setstaus}
 }
 
 // all uploads all files in addition to the metadata file
@@ -185,7 +193,7 @@ Loop:
 			if cdesc.Error != nil {
 				return cdesc.Error
 			}
-			if err := u.class(ctx, desc.ID, cdesc); err != nil {
+			if err := u.class(ctx, desc.ID, &cdesc); err != nil {
 				return err
 			}
 			desc.Classes = append(desc.Classes, cdesc)
@@ -200,7 +208,9 @@ Loop:
 }
 
 // class uploads one class
-func (u *uploader) class(ctx context.Context, id string, desc backup.ClassDescriptor) (err error) {
+// Checksums and references to files of the base backup are added to the
+// shards of desc, in archive mode also the descriptors of the uploaded chunks.
+func (u *uploader) class(ctx context.Context, id string, desc *backup.ClassDescriptor) (err error) {
 	metric, err := monitoring.GetMetrics().BackupStoreDurations.GetMetricWithLabelValues(getType(u.backend.b), desc.Name)
 	if err == nil {
 		timer := prometheus.NewTimer(metric)
@@ -212,17 +222,58 @@ func (u *uploader) class(ctx context.Context, id string, desc backup.ClassDescri
 	}()
 	ctx, cancel := context.WithTimeout(ctx, storeTimeout)
 	defer cancel()
-	for _, shard := range desc.Shards {
+	files, err := u.checksum(ctx, desc)
+	if err != nil {
+		return err
+	}
+	if u.archive.Enabled {
+		a, err := newArchiver(u.backend.SourceDataPath(), id, u.archive)
+		if err != nil {
+			return err
+		}
+		desc.Chunks, err = a.class(ctx, desc.Name, files, u.backend.PutFile)
+		return err
+	}
+	for _, fpath := range files {
 		if err := ctx.Err(); err != nil {
 			return err
 		}
+		if err := u.backend.PutFile(ctx, fpath, fpath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checksum records the checksums of all shard files of desc. Files which are
+// unchanged since the base backup are referenced in the shard descriptor,
+// all other files are returned since they need to be stored.
+func (u *uploader) checksum(ctx context.Context, desc *backup.ClassDescriptor) ([]string, error) {
+	sourcePath := u.backend.SourceDataPath()
+	files := make([]string, 0, 64)
+	for i := range desc.Shards {
+		shard := &desc.Shards[i]
+		shard.Checksums = make(map[string]string, len(shard.Files))
 		for _, fpath := range shard.Files {
-			if err := u.backend.PutFile(ctx, fpath, fpath); err != nil {
-				return err
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			_, checksum, err := hashFile(path.Join(sourcePath, fpath))
+			if err != nil {
+				return nil, fmt.Errorf("checksum %s: %w", fpath, err)
 			}
+			shard.Checksums[fpath] = checksum
+			if f, ok := u.base[fpath]; ok && f.checksum == checksum {
+				if shard.BaseFiles == nil {
+					shard.BaseFiles = make(map[string]string)
+				}
+				shard.BaseFiles[fpath] = f.backupID
+				continue
+			}
+			files = append(files, fpath)
 		}
 	}
-	return nil
+	return files, nil
 }
 
 // fileWriter downloads files from object store and writes files to the destintion folder destDir
@@ -281,16 +332,29 @@ func (fw *fileWriter) writeTempFiles(ctx context.Context, classTempDir string, d
 	if err := os.MkdirAll(classTempDir, os.ModePerm); err != nil {
 		return fmt.Errorf("create temp class folder %s: %w", classTempDir, err)
 	}
+	if err := fw.writeTempChunks(ctx, classTempDir, desc); err != nil {
+		return err
+	}
+	archived := len(desc.Chunks) > 0
 	for _, part := range desc.Shards {
 		for _, key := range part.Files {
+			store := fw.backend
+			if id, ok := part.BaseFiles[key]; ok {
+				store = fw.backend.base(id, part.Node)
+			} else if archived { // already extracted from chunks
+				continue
+			}
 			destPath := path.Join(classTempDir, key)
 			destDir := path.Dir(destPath)
 			if err := os.MkdirAll(destDir, os.ModePerm); err != nil {
 				return fmt.Errorf("create folder %s: %w", destDir, err)
 			}
-			if err := fw.backend.WriteToFile(ctx, key, destPath); err != nil {
+			if err := store.WriteToFile(ctx, key, destPath); err != nil {
 				return fmt.Errorf("write file %s: %w", destPath, err)
 			}
+			if err := verifyFile(destPath, part.Checksums[key]); err != nil {
+				return err
+			}
 		}
 		destPath := path.Join(classTempDir, part.DocIDCounterPath)
 		if err := os.WriteFile(destPath, part.DocIDCounter, os.ModePerm); err != nil {
@@ -308,6 +372,33 @@ func (fw *fileWriter) writeTempFiles(ctx context.Context, classTempDir string, d
 	return nil
 }
 
+// writeTempChunks downloads the chunks of an archived class, verifies their
+// checksums and extracts them into classTempDir
+func (fw *fileWriter) writeTempChunks(ctx context.Context, classTempDir string, desc *backup.ClassDescriptor) error {
+	if len(desc.Chunks) == 0 {
+		return nil
+	}
+	chunkDir := classTempDir + ".chunks"
+	if err := os.MkdirAll(chunkDir, os.ModePerm); err != nil {
+		return fmt.Errorf("create chunk folder %s: %w", chunkDir, err)
+	}
+	defer os.RemoveAll(chunkDir)
+	for i, chunk := range desc.Chunks {
+		destPath := path.Join(chunkDir, fmt.Sprintf("chunk-%d.tar.gz", i))
+		if err := fw.backend.WriteToFile(ctx, chunk.Key, destPath); err != nil {
+			return fmt.Errorf("write chunk %s: %w", destPath, err)
+		}
+		if err := verifyChunk(destPath, chunk); err != nil {
+			return err
+		}
+		if err := unarchive(destPath, classTempDir); err != nil {
+			return fmt.Errorf("extract chunk %s: %w", chunk.Key, err)
+		}
+		os.Remove(destPath)
+	}
+	return nil
+}
+
 // moveAll moves all files to the destination
 func (fw *fileWriter) moveAll(classTempDir string) (err error) {
 	files, err := os.ReadDir(classTempDir)
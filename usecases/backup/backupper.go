@@ -45,13 +45,15 @@ func newBackupper(node string, logger logrus.FieldLogger, sourcer Sourcer, backe
 
 // Backup is called by the User
 func (b *backupper) Backup(ctx context.Context,
-	store nodeStore, id string, classes []string,
+	store nodeStore, id string, classes []string, archive ArchiveConfig, baseID string,
 ) (*backup.CreateMeta, error) {
 	// make sure there is no active backup
 	req := Request{
-		Method:  OpCreate,
-		ID:      id,
-		Classes: classes,
+		Method:       OpCreate,
+		ID:           id,
+		Classes:      classes,
+		Archive:      archive,
+		BaseBackupID: baseID,
 	}
 	if _, err := b.backup(ctx, store, &req); err != nil {
 		return nil, backup.NewErrUnprocessable(err)
@@ -131,6 +133,14 @@ func (b *backupper) backup(ctx context.Context,
 		ID:      req.ID,
 		Timeout: expiration,
 	}
+	var base map[string]baseFile
+	if req.BaseBackupID != "" {
+		meta, err := loadBase(ctx, store, req.BaseBackupID, b.node)
+		if err != nil {
+			return ret, err
+		}
+		base = baseFiles(meta)
+	}
 	// make sure there is no active backup
 	if prevID := b.lastOp.renew(id, store.HomeDir()); prevID != "" {
 		return ret, fmt.Errorf("backup %s already in progress", prevID)
@@ -146,13 +156,14 @@ func (b *backupper) backup(ctx context.Context,
 			return
 
 		}
-		provider := newUploader(b.sourcer, store, req.ID, b.lastOp.set)
+		provider := newUploader(b.sourcer, store, req.ID, req.Archive, base, b.lastOp.set)
 		result := backup.BackupDescriptor{
 			StartedAt:     time.Now().UTC(),
 			ID:            id,
 			Classes:       make([]backup.ClassDescriptor, 0, len(req.Classes)),
 			Version:       Version,
 			ServerVersion: config.ServerVersion,
+			BaseBackupID:  req.BaseBackupID,
 		}
 		if err := provider.all(context.Background(), req.Classes, &result); err != nil {
 			b.logger.WithField("action", "create_backup").
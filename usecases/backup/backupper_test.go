@@ -15,6 +15,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -24,6 +26,7 @@ import (
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 const (
@@ -397,6 +400,7 @@ func TestManagerCreateBackup(t *testing.T) {
 		backend.On("Initialize", ctx, nodeHome).Return(nil)
 		backend.On("PutObject", mock.Anything, nodeHome, BackupFile, mock.Anything).Return(nil).Once()
 		backend.On("PutFile", mock.Anything, nodeHome, mock.Anything, mock.Anything).Return(nil)
+		backend.On("SourceDataPath").Return(genSourceFiles(t, genClassDescriptions(cls)...))
 		m := createManager(sourcer, nil, backend, nil)
 
 		resp, err := m.Backup(ctx, nil, &req)
@@ -437,6 +441,7 @@ func TestManagerCreateBackup(t *testing.T) {
 
 		backend.On("Initialize", ctx, nodeHome).Return(nil)
 		backend.On("PutFile", mock.Anything, nodeHome, mock.Anything, mock.Anything).Return(ErrAny).Once()
+		backend.On("SourceDataPath").Return(genSourceFiles(t, genClassDescriptions(cls)...))
 		backend.On("PutObject", mock.Anything, nodeHome, BackupFile, mock.Anything).Return(nil).Once()
 		m := createManager(sourcer, nil, backend, nil)
 
@@ -479,6 +484,7 @@ func TestManagerCreateBackup(t *testing.T) {
 
 		backend.On("Initialize", ctx, nodeHome).Return(nil)
 		backend.On("PutFile", mock.Anything, nodeHome, mock.Anything, mock.Anything).Return(nil)
+		backend.On("SourceDataPath").Return(genSourceFiles(t, genClassDescriptions(cls)...))
 		backend.On("PutObject", mock.Anything, nodeHome, BackupFile, mock.Anything).Return(nil).Once()
 		m := createManager(sourcer, nil, backend, nil)
 
@@ -599,6 +605,7 @@ func TestManagerCoordinatedBackup(t *testing.T) {
 		backend.On("Initialize", ctx, nodeHome).Return(nil)
 		backend.On("PutObject", mock.Anything, nodeHome, BackupFile, mock.Anything).Return(nil).Once()
 		backend.On("PutFile", mock.Anything, nodeHome, mock.Anything, mock.Anything).Return(nil)
+		backend.On("SourceDataPath").Return(genSourceFiles(t, genClassDescriptions(cls)...))
 		m := createManager(sourcer, nil, backend, nil)
 
 		req := req
@@ -633,6 +640,7 @@ func TestManagerCoordinatedBackup(t *testing.T) {
 		backend.On("Initialize", ctx, nodeHome).Return(nil)
 		backend.On("PutObject", mock.Anything, nodeHome, BackupFile, mock.Anything).Return(nil).Once()
 		backend.On("PutFile", mock.Anything, nodeHome, mock.Anything, mock.Anything).Return(nil)
+		backend.On("SourceDataPath").Return(genSourceFiles(t, genClassDescriptions(cls)...))
 		m := createManager(sourcer, nil, backend, nil)
 
 		req := req
@@ -666,6 +674,7 @@ func TestManagerCoordinatedBackup(t *testing.T) {
 		backend.On("Initialize", ctx, nodeHome).Return(nil)
 		backend.On("PutObject", mock.Anything, nodeHome, BackupFile, mock.Anything).Return(nil).Once()
 		backend.On("PutFile", mock.Anything, backupID, mock.Anything, mock.Anything).Return(nil)
+		backend.On("SourceDataPath").Return(genSourceFiles(t, genClassDescriptions(cls)...))
 		m := createManager(sourcer, nil, backend, nil)
 
 		req := req
@@ -720,6 +729,21 @@ func fakeBackupDescriptor(descs ...backup.ClassDescriptor) <-chan backup.ClassDe
 	return ch
 }
 
+// genSourceFiles writes the shard files of descs into a temporary data path
+func genSourceFiles(t *testing.T, descs ...backup.ClassDescriptor) string {
+	dataPath := t.TempDir()
+	for _, desc := range descs {
+		for _, shard := range desc.Shards {
+			for _, fpath := range shard.Files {
+				fpath = filepath.Join(dataPath, fpath)
+				require.Nil(t, os.MkdirAll(filepath.Dir(fpath), os.ModePerm))
+				require.Nil(t, os.WriteFile(fpath, []byte(fpath), os.ModePerm))
+			}
+		}
+	}
+	return dataPath
+}
+
 func createManager(sourcer Sourcer, schema schemaManger, backend modulecapabilities.BackupBackend, backendErr error) *Manager {
 	backends := &fakeBackupBackendProvider{backend, backendErr}
 	if sourcer == nil {
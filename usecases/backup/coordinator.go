@@ -38,6 +38,8 @@ var (
 	errCannotCommit = errors.New("cannot commit")
 	errMetaNotFound = errors.New("metadata not found")
 	errUnknownOp    = errors.New("unknown backup operation")
+
+	errChecksumMismatch = errors.New("checksum mismatch")
 )
 
 const (
@@ -141,12 +143,18 @@ func (c *coordinator) Backup(ctx context.Context, store coordStore, req *Request
 		Nodes:         groups,
 		Version:       Version,
 		ServerVersion: config.ServerVersion,
+		BaseBackupID:  req.BaseBackupID,
 	}
 	for key := range c.Participants {
 		delete(c.Participants, key)
 	}
 
-	nodes, err := c.canCommit(ctx, OpCreate, req.Backend)
+	nodes, err := c.canCommit(ctx, &Request{
+		Method:       OpCreate,
+		Backend:      req.Backend,
+		Archive:      req.Archive,
+		BaseBackupID: req.BaseBackupID,
+	})
 	if err != nil {
 		c.lastOp.reset()
 		return err
@@ -183,7 +191,7 @@ func (c *coordinator) Restore(ctx context.Context, store coordStore, backend str
 	}
 	c.descriptor = desc.ResetStatus()
 
-	nodes, err := c.canCommit(ctx, OpRestore, backend)
+	nodes, err := c.canCommit(ctx, &Request{Method: OpRestore, Backend: backend})
 	if err != nil {
 		c.lastOp.reset()
 		return err
@@ -239,7 +247,10 @@ func (c *coordinator) OnStatus(ctx context.Context, store coordStore, req *Statu
 
 // canCommit asks candidates if they agree to participate in DBRO
 // It returns and error if any candidates refuses to participate
-func (c *coordinator) canCommit(ctx context.Context, method Op, backend string) (map[string]string, error) {
+//
+// req provides method, backend and options of the operation, classes are
+// taken from the descriptor of the corresponding node.
+func (c *coordinator) canCommit(ctx context.Context, req *Request) (map[string]string, error) {
 	ctx, cancel := context.WithTimeout(ctx, c.timeoutCanCommit)
 	defer cancel()
 
@@ -252,6 +263,7 @@ func (c *coordinator) canCommit(ctx context.Context, method Op, backend string)
 		r *Request
 	}
 
+	method, backend := req.Method, req.Backend
 	id := c.descriptor.ID
 	groups := c.descriptor.Nodes
 
@@ -275,11 +287,13 @@ func (c *coordinator) canCommit(ctx context.Context, method Op, backend string)
 			reqChan <- pair{
 				nodeHost{node, host},
 				&Request{
-					Method:   method,
-					ID:       id,
-					Backend:  backend,
-					Classes:  gr.Classes,
-					Duration: _BookingPeriod,
+					Method:       method,
+					ID:           id,
+					Backend:      backend,
+					Classes:      gr.Classes,
+					Duration:     _BookingPeriod,
+					Archive:      req.Archive,
+					BaseBackupID: req.BaseBackupID,
 				},
 			}
 		}
@@ -304,9 +318,8 @@ func (c *coordinator) canCommit(ctx context.Context, method Op, backend string)
 			return nil
 		})
 	}
-	req := &AbortRequest{Method: method, ID: id, Backend: backend}
 	if err := g.Wait(); err != nil {
-		c.abortAll(ctx, req, nodes)
+		c.abortAll(ctx, &AbortRequest{Method: method, ID: id, Backend: backend}, nodes)
 		return nil, err
 	}
 	return nodes, nil
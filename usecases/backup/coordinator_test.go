@@ -98,6 +98,34 @@ func TestCoordinatedBackup(t *testing.T) {
 		assert.Equal(t, want, got)
 	})
 
+	t.Run("Archive", func(t *testing.T) {
+		t.Parallel()
+		archive := ArchiveConfig{Enabled: true, ChunkSize: 16}
+		req := req
+		req.Archive = archive
+		creq := *creq
+		creq.Archive = archive
+		fc := newFakeCoordinator(nodeResolver)
+		fc.selector.On("Shards", ctx, classes[0]).Return(nodes)
+		fc.selector.On("Shards", ctx, classes[1]).Return(nodes)
+
+		fc.client.On("CanCommit", any, nodes[0], &creq).Return(cresp, nil)
+		fc.client.On("CanCommit", any, nodes[1], &creq).Return(cresp, nil)
+		fc.client.On("Commit", any, nodes[0], sReq).Return(nil)
+		fc.client.On("Commit", any, nodes[1], sReq).Return(nil)
+		fc.client.On("Status", any, nodes[0], sReq).Return(sresp, nil)
+		fc.client.On("Status", any, nodes[1], sReq).Return(sresp, nil)
+		fc.backend.On("HomeDir", backupID).Return("bucket/" + backupID)
+		fc.backend.On("PutObject", any, backupID, GlobalBackupFile, any).Return(nil).Once()
+
+		coordinator := *fc.coordinator()
+		store := coordStore{objStore{fc.backend, req.ID}}
+		err := coordinator.Backup(ctx, store, &req)
+		assert.Nil(t, err)
+		<-fc.backend.doneChan
+		assert.Equal(t, backup.Success, fc.backend.glMeta.Status)
+	})
+
 	t.Run("Shards", func(t *testing.T) {
 		t.Parallel()
 		fc := newFakeCoordinator(nodeResolver)
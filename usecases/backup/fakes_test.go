@@ -118,6 +118,23 @@ func (s *fakeBackend) Initialize(ctx context.Context, backupID string) error {
 	return args.Error(0)
 }
 
+func (s *fakeBackend) ListBackups(ctx context.Context) ([]string, error) {
+	s.RLock()
+	defer s.RUnlock()
+	args := s.Called(ctx)
+	if args.Get(0) != nil {
+		return args.Get(0).([]string), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (s *fakeBackend) DeleteBackup(ctx context.Context, backupID string) error {
+	s.Lock()
+	defer s.Unlock()
+	args := s.Called(ctx, backupID)
+	return args.Error(0)
+}
+
 func (s *fakeBackend) SourceDataPath() string {
 	s.RLock()
 	defer s.RUnlock()
@@ -105,6 +105,13 @@ type BackupRequest struct {
 	// Exclude means include all classes but those specified in Exclude
 	// The same class cannot appear in both Include and Exclude in the same request
 	Exclude []string
+
+	// Archive configures the archive mode of a backup, it is ignored by restore
+	Archive ArchiveConfig
+	// BaseBackupID makes the backup incremental to the given backup, files which
+	// are unchanged since then are referenced instead of being stored again.
+	// It is ignored by restore.
+	BaseBackupID string
 }
 
 func (m *Manager) Backup(ctx context.Context, pr *models.Principal, req *BackupRequest,
@@ -123,7 +130,7 @@ func (m *Manager) Backup(ctx context.Context, pr *models.Principal, req *BackupR
 	if err := store.Initialize(ctx); err != nil {
 		return nil, backup.NewErrUnprocessable(fmt.Errorf("init uploader: %w", err))
 	}
-	if meta, err := m.backupper.Backup(ctx, store, req.ID, classes); err != nil {
+	if meta, err := m.backupper.Backup(ctx, store, req.ID, classes, req.Archive, req.BaseBackupID); err != nil {
 		return nil, err
 	} else {
 		status := string(meta.Status)
@@ -288,6 +295,12 @@ func (m *Manager) validateBackupRequest(ctx context.Context, store nodeStore, re
 	if len(req.Include) > 0 && len(req.Exclude) > 0 {
 		return nil, fmt.Errorf("malformed request: 'include' and 'exclude' cannot both contain values")
 	}
+	if err := req.Archive.Validate(); err != nil {
+		return nil, err
+	}
+	if err := validateBaseID(req.ID, req.BaseBackupID); err != nil {
+		return nil, err
+	}
 	classes := req.Include
 	if len(classes) == 0 {
 		classes = m.backupper.sourcer.ListBackupable()
@@ -338,6 +351,19 @@ func validateID(backupID string) error {
 	return nil
 }
 
+func validateBaseID(backupID, baseID string) error {
+	if baseID == "" {
+		return nil
+	}
+	if err := validateID(baseID); err != nil {
+		return fmt.Errorf("base backup: %w", err)
+	}
+	if baseID == backupID {
+		return fmt.Errorf("backup %q cannot be its own base", backupID)
+	}
+	return nil
+}
+
 func nodeBackend(node string, provider BackupBackendProvider, backend, id string) (nodeStore, error) {
 	caps, err := provider.BackupBackend(backend)
 	if err != nil {
@@ -0,0 +1,139 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package backup
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/semi-technologies/weaviate/entities/backup"
+)
+
+// baseFile is a file of a previous backup which an incremental backup can
+// reference instead of storing it again
+type baseFile struct {
+	checksum string
+	backupID string // backup actually storing the file
+}
+
+// baseFiles indexes all files of base which can be referenced by an
+// incremental backup. References are resolved, so that every entry points to
+// the backup which stores the file. Files of an archived class are only
+// contained in its chunks and can therefore not be referenced.
+func baseFiles(base *backup.BackupDescriptor) map[string]baseFile {
+	files := make(map[string]baseFile, 64)
+	if base == nil {
+		return files
+	}
+	for _, c := range base.Classes {
+		archived := len(c.Chunks) > 0
+		for _, s := range c.Shards {
+			for _, fpath := range s.Files {
+				checksum := s.Checksums[fpath]
+				if checksum == "" {
+					continue // created before checksums have been recorded
+				}
+				if id, ok := s.BaseFiles[fpath]; ok {
+					files[fpath] = baseFile{checksum, id}
+				} else if !archived {
+					files[fpath] = baseFile{checksum, base.ID}
+				}
+			}
+		}
+	}
+	return files
+}
+
+// loadBase loads the metadata of a previous backup of the same node and makes
+// sure it has been successful
+func loadBase(ctx context.Context, store nodeStore, backupID, node string) (*backup.BackupDescriptor, error) {
+	bs := store.base(backupID, node)
+	meta, err := bs.Meta(ctx, backupID, false)
+	if err != nil {
+		return nil, fmt.Errorf("base backup %q: %w", backupID, err)
+	}
+	if meta.ID != backupID {
+		return nil, fmt.Errorf("wrong base backup file: expected %q got %q", backupID, meta.ID)
+	}
+	if meta.Status != string(backup.Success) {
+		return nil, fmt.Errorf("invalid base backup %q status: %s", backupID, meta.Status)
+	}
+	return meta, nil
+}
+
+// resolveBaseFiles makes sure that every file desc references is stored with
+// the same checksum in the referenced backup
+func resolveBaseFiles(ctx context.Context, store nodeStore, node string, desc *backup.BackupDescriptor) error {
+	stored := make(map[string]map[string]baseFile)
+	for _, id := range desc.BaseBackups() {
+		meta, err := loadBase(ctx, store, id, node)
+		if err != nil {
+			return err
+		}
+		stored[id] = baseFiles(meta)
+	}
+	for _, c := range desc.Classes {
+		for _, s := range c.Shards {
+			// sorted, so that the reported file does not depend on map order
+			fpaths := make([]string, 0, len(s.BaseFiles))
+			for fpath := range s.BaseFiles {
+				fpaths = append(fpaths, fpath)
+			}
+			sort.Strings(fpaths)
+			for _, fpath := range fpaths {
+				id := s.BaseFiles[fpath]
+				f, ok := stored[id][fpath]
+				if !ok || f.backupID != id || f.checksum != s.Checksums[fpath] {
+					return fmt.Errorf("file %q of shard %q.%q is not stored in base backup %q",
+						fpath, c.Name, s.Name, id)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// hashFile returns size and hex encoded SHA-256 of the file at fpath
+func hashFile(fpath string) (int64, string, error) {
+	f, err := os.Open(fpath)
+	if err != nil {
+		return 0, "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	n, err := io.Copy(h, f)
+	if err != nil {
+		return 0, "", err
+	}
+	return n, hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// verifyFile compares the checksum of the file at fpath with the recorded one
+// An empty checksum is not verified as older backups do not record them.
+func verifyFile(fpath, checksum string) error {
+	if checksum == "" {
+		return nil
+	}
+	_, got, err := hashFile(fpath)
+	if err != nil {
+		return fmt.Errorf("read %s: %w", fpath, err)
+	}
+	if got != checksum {
+		return fmt.Errorf("%w: file %s", errChecksumMismatch, fpath)
+	}
+	return nil
+}
@@ -0,0 +1,177 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package backup
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/backup"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBaseFiles(t *testing.T) {
+	base := backup.BackupDescriptor{
+		ID: "2",
+		Classes: []backup.ClassDescriptor{
+			{
+				Name: "A",
+				Shards: []backup.ShardDescriptor{{
+					Files:     []string{"a/1", "a/2", "a/3"},
+					Checksums: map[string]string{"a/1": "c1", "a/2": "c2"},
+					BaseFiles: map[string]string{"a/2": "1"},
+				}},
+			},
+			{
+				Name: "B",
+				Shards: []backup.ShardDescriptor{{
+					Files:     []string{"b/1", "b/2"},
+					Checksums: map[string]string{"b/1": "c1", "b/2": "c2"},
+					BaseFiles: map[string]string{"b/2": "1"},
+				}},
+				Chunks: []backup.ChunkDescriptor{{Key: "B/chunk-0.tar.gz"}},
+			},
+		},
+	}
+	want := map[string]baseFile{
+		"a/1": {"c1", "2"},
+		"a/2": {"c2", "1"}, // stored by the base of the base
+		"b/2": {"c2", "1"}, // not contained in a chunk
+	}
+	assert.Equal(t, want, baseFiles(&base))
+	assert.Empty(t, baseFiles(nil))
+	assert.Equal(t, []string{"1"}, base.BaseBackups())
+}
+
+func TestIncrementalBackup(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		cls       = "Class-A"
+		dataPath  = t.TempDir()
+		storePath = t.TempDir() // plays the role of the backup backend
+		files     = []string{"dir1/file1", "dir1/file2", "dir2/file3"}
+	)
+	writeSource := func(fpath, content string) {
+		require.Nil(t, os.MkdirAll(path.Join(dataPath, path.Dir(fpath)), os.ModePerm))
+		require.Nil(t, os.WriteFile(path.Join(dataPath, fpath), []byte(content), os.ModePerm))
+	}
+	copyFile := func(src, dest string) {
+		bytes, err := os.ReadFile(src)
+		require.Nil(t, err)
+		require.Nil(t, os.MkdirAll(path.Dir(dest), os.ModePerm))
+		require.Nil(t, os.WriteFile(dest, bytes, os.ModePerm))
+	}
+	for _, fpath := range files {
+		writeSource(fpath, fpath)
+	}
+
+	backend := newFakeBackend()
+	backend.On("SourceDataPath").Return(dataPath)
+	backend.On("PutFile", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			copyFile(path.Join(dataPath, args.String(3)), path.Join(storePath, args.String(1), args.String(2)))
+		}).Return(nil)
+	backend.On("WriteToFile", ctx, mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			copyFile(path.Join(storePath, args.String(1), args.String(2)), args.String(3))
+		}).Return(nil)
+	sourcer := &fakeSourcer{}
+	sourcer.On("ReleaseBackup", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	// createBackup uploads cls like a backup with the given id and base would
+	createBackup := func(id string, base *backup.BackupDescriptor) *backup.BackupDescriptor {
+		store := nodeStore{objStore{b: backend, BasePath: id + "/" + nodeName}}
+		desc := genClassDescriptions(cls)[0]
+		desc.Shards[0].Files = files
+		u := newUploader(sourcer, store, id, ArchiveConfig{}, baseFiles(base), func(backup.Status) {})
+		require.Nil(t, u.class(ctx, id, &desc))
+		return &backup.BackupDescriptor{
+			ID: id, Status: string(backup.Success), Classes: []backup.ClassDescriptor{desc},
+		}
+	}
+
+	full := createBackup("1", nil)
+	writeSource("dir1/file2", "changed")
+	incr1 := createBackup("2", full)
+	writeSource("dir2/file3", "changed")
+	incr2 := createBackup("3", incr1)
+
+	t.Run("References", func(t *testing.T) {
+		assert.Len(t, full.Classes[0].Shards[0].Checksums, 3)
+		assert.Empty(t, full.Classes[0].Shards[0].BaseFiles)
+		assert.Equal(t, map[string]string{"dir1/file1": "1", "dir2/file3": "1"},
+			incr1.Classes[0].Shards[0].BaseFiles)
+		assert.Equal(t, map[string]string{"dir1/file1": "1", "dir1/file2": "2"},
+			incr2.Classes[0].Shards[0].BaseFiles)
+		assert.NoFileExists(t, path.Join(storePath, "3", nodeName, "dir1/file1"))
+		assert.FileExists(t, path.Join(storePath, "3", nodeName, "dir2/file3"))
+	})
+
+	metas := map[string]*backup.BackupDescriptor{"1": full, "2": incr1, "3": incr2}
+	for id, meta := range metas {
+		backend.On("GetObject", ctx, id+"/"+nodeName, BackupFile).Return(marshalMeta(*meta), nil)
+	}
+	store := nodeStore{objStore{b: backend, BasePath: "3/" + nodeName}}
+
+	t.Run("Restore", func(t *testing.T) {
+		require.Nil(t, resolveBaseFiles(ctx, store, nodeName, incr2))
+
+		destDir := t.TempDir()
+		fw := &fileWriter{backend: store, destDir: destDir, tempDir: path.Join(destDir, _TempDirectory)}
+		_, err := fw.Write(ctx, &incr2.Classes[0])
+		require.Nil(t, err)
+		for _, fpath := range files {
+			want, err := os.ReadFile(path.Join(dataPath, fpath))
+			require.Nil(t, err)
+			got, err := os.ReadFile(path.Join(destDir, fpath))
+			require.Nil(t, err)
+			assert.Equal(t, want, got)
+		}
+	})
+
+	t.Run("MissingBase", func(t *testing.T) {
+		backend := newFakeBackend()
+		backend.On("GetObject", ctx, mock.Anything, BackupFile).Return(nil, backup.ErrNotFound{})
+		store := nodeStore{objStore{b: backend, BasePath: "3/" + nodeName}}
+		err := resolveBaseFiles(ctx, store, nodeName, incr2)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "base backup")
+	})
+
+	t.Run("ChangedBase", func(t *testing.T) {
+		changed := *incr2
+		changed.Classes = []backup.ClassDescriptor{incr2.Classes[0]}
+		changed.Classes[0].Shards = []backup.ShardDescriptor{incr2.Classes[0].Shards[0]}
+		checksums := make(map[string]string)
+		for fpath, sum := range incr2.Classes[0].Shards[0].Checksums {
+			checksums[fpath] = sum
+		}
+		checksums["dir1/file1"] = "other"
+		changed.Classes[0].Shards[0].Checksums = checksums
+		err := resolveBaseFiles(ctx, store, nodeName, &changed)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "dir1/file1")
+	})
+
+	t.Run("CorruptedBaseFile", func(t *testing.T) {
+		require.Nil(t, os.WriteFile(path.Join(storePath, "1", nodeName, "dir1/file1"), []byte("x"), os.ModePerm))
+		destDir := t.TempDir()
+		fw := &fileWriter{backend: store, destDir: destDir, tempDir: path.Join(destDir, _TempDirectory)}
+		_, err := fw.Write(ctx, &incr2.Classes[0])
+		assert.True(t, errors.Is(err, errChecksumMismatch))
+	})
+}
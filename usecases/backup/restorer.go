@@ -185,6 +185,19 @@ func (r *restorer) restoreOne(ctx context.Context,
 	return nil
 }
 
+// verifyChunk makes sure the downloaded chunk at fpath matches the size and
+// checksum recorded in the backup descriptor
+func verifyChunk(fpath string, chunk backup.ChunkDescriptor) error {
+	n, checksum, err := hashFile(fpath)
+	if err != nil {
+		return fmt.Errorf("read chunk %s: %w", fpath, err)
+	}
+	if n != chunk.Size || checksum != chunk.Checksum {
+		return fmt.Errorf("%w: chunk %s", errChecksumMismatch, chunk.Key)
+	}
+	return nil
+}
+
 // AnyExists checks if any classes of cs exists in DB
 func (r *restorer) AnyExists(cs []string) string {
 	for _, cls := range cs {
@@ -232,6 +245,9 @@ func (r *restorer) validate(ctx context.Context, store *nodeStore, req *Request)
 	if err := meta.Validate(); err != nil {
 		return nil, nil, fmt.Errorf("corrupted backup file: %w", err)
 	}
+	if err := resolveBaseFiles(ctx, *store, r.node, meta); err != nil {
+		return nil, nil, err
+	}
 	cs := meta.List()
 	if len(req.Classes) > 0 {
 		if first := meta.AllExist(req.Classes); first != "" {
@@ -0,0 +1,146 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/semi-technologies/weaviate/entities/backup"
+	"github.com/semi-technologies/weaviate/usecases/config"
+)
+
+// scheduledPrefix is the prefix of the IDs of scheduled backups. Retention
+// only applies to backups with this prefix.
+const scheduledPrefix = "scheduled-"
+
+const _ScheduleStatusPeriod = 5 * time.Second
+
+// members is used to elect the node which runs scheduled backups
+type members interface {
+	AllNames() []string
+	LocalName() string
+}
+
+// StartSchedule creates a backup of all classes on the configured backend
+// every interval until ctx is cancelled. Only the node with the smallest name
+// in the cluster runs scheduled backups.
+func (s *Scheduler) StartSchedule(ctx context.Context, cfg config.BackupSchedule, nodes members) {
+	if cfg.Backend == "" || cfg.Interval <= 0 {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(cfg.Interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-ticker.C:
+				if isScheduleLeader(nodes) {
+					s.runSchedule(ctx, cfg, now)
+				}
+			}
+		}
+	}()
+}
+
+// runSchedule starts a new backup and applies the retention policy once the
+// backup has finished, so that the new backup is counted as well
+func (s *Scheduler) runSchedule(ctx context.Context, cfg config.BackupSchedule, now time.Time) {
+	id := scheduledID(now)
+	begin := time.Now()
+	_, err := s.backup(ctx, &BackupRequest{ID: id, Backend: cfg.Backend})
+	if err == nil {
+		err = s.waitForBackup(ctx, cfg.Backend, id)
+	}
+	logOperation(s.logger, "scheduled_backup", id, cfg.Backend, begin, err)
+
+	if cfg.Retention > 0 && ctx.Err() == nil {
+		s.applyRetention(ctx, cfg.Backend, cfg.Retention)
+	}
+}
+
+// waitForBackup blocks until the backup has finished. It returns an error if
+// the backup did not succeed.
+func (s *Scheduler) waitForBackup(ctx context.Context, backend, id string) error {
+	ticker := time.NewTicker(s.scheduleStatusPeriod)
+	defer ticker.Stop()
+	for s.backupper.lastOp.get().ID == id {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+
+	store, err := coordBackend(s.backends, backend, id)
+	if err != nil {
+		return err
+	}
+	st, err := s.backupper.OnStatus(ctx, store, &StatusRequest{OpCreate, id, backend})
+	if err != nil {
+		return err
+	}
+	if st.Status != backup.Success {
+		return fmt.Errorf("backup finished with status %s: %s", st.Status, st.Err)
+	}
+	return nil
+}
+
+// applyRetention deletes all failed scheduled backups, as well as the oldest
+// successful ones until at most retention of them are left. Backups which are
+// the base of another backup are kept.
+func (s *Scheduler) applyRetention(ctx context.Context, backend string, retention int) {
+	descs, err := s.list(ctx, backend)
+	if err != nil {
+		logOperation(s.logger, "backup_retention", "", backend, time.Now(), err)
+		return
+	}
+
+	var successful, obsolete []*backup.DistributedBackupDescriptor
+	for _, desc := range descs {
+		if !strings.HasPrefix(desc.ID, scheduledPrefix) {
+			continue
+		}
+		switch desc.Status {
+		case backup.Success:
+			successful = append(successful, desc)
+		case backup.Failed:
+			obsolete = append(obsolete, desc)
+		}
+	}
+	if n := len(successful) - retention; n > 0 {
+		obsolete = append(obsolete, successful[:n]...)
+	}
+	for _, desc := range obsolete {
+		begin := time.Now()
+		err := s.delete(ctx, backend, desc.ID)
+		logOperation(s.logger, "backup_retention", desc.ID, backend, begin, err)
+	}
+}
+
+func scheduledID(now time.Time) string {
+	return scheduledPrefix + now.UTC().Format("20060102-150405")
+}
+
+func isScheduleLeader(nodes members) bool {
+	names := nodes.AllNames()
+	if len(names) == 0 {
+		return true
+	}
+	sort.Strings(names)
+	return names[0] == nodes.LocalName()
+}
@@ -0,0 +1,179 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package backup
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/semi-technologies/weaviate/entities/backup"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type fakeMembers struct {
+	names []string
+	local string
+}
+
+func (f *fakeMembers) AllNames() []string { return f.names }
+func (f *fakeMembers) LocalName() string  { return f.local }
+
+func TestScheduleLeader(t *testing.T) {
+	assert.True(t, isScheduleLeader(&fakeMembers{[]string{"N2", "N1", "N3"}, "N1"}))
+	assert.False(t, isScheduleLeader(&fakeMembers{[]string{"N2", "N1", "N3"}, "N3"}))
+	assert.True(t, isScheduleLeader(&fakeMembers{nil, "N1"}))
+}
+
+func TestScheduledID(t *testing.T) {
+	now := time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)
+	id := scheduledID(now)
+	assert.Equal(t, "scheduled-20220102-030405", id)
+	assert.Nil(t, validateID(id))
+}
+
+func TestScheduleRetention(t *testing.T) {
+	var (
+		backendName = "s3"
+		ctx         = context.Background()
+		starTime    = time.Date(2022, 1, 1, 1, 0, 0, 0, time.UTC)
+	)
+	metas := []backup.DistributedBackupDescriptor{
+		{ID: "scheduled-1", StartedAt: starTime, Status: backup.Success},
+		{ID: "manual", StartedAt: starTime.Add(time.Hour), Status: backup.Success},
+		{ID: "scheduled-2", StartedAt: starTime.Add(2 * time.Hour), Status: backup.Success},
+		{ID: "scheduled-3", StartedAt: starTime.Add(3 * time.Hour), Status: backup.Failed},
+		{ID: "scheduled-4", StartedAt: starTime.Add(4 * time.Hour), Status: backup.Success},
+		{ID: "scheduled-5", StartedAt: starTime.Add(5 * time.Hour), Status: backup.Success},
+	}
+
+	t.Run("DeleteOldest", func(t *testing.T) {
+		fs := newFakeScheduler(nil)
+		ids := []string{}
+		for _, m := range metas {
+			ids = append(ids, m.ID)
+			fs.backend.On("GetObject", ctx, m.ID, GlobalBackupFile).Return(marshalCoordinatorMeta(m), nil)
+		}
+		fs.backend.On("ListBackups", ctx).Return(ids, nil)
+		fs.backend.On("DeleteBackup", ctx, mock.Anything).Return(nil)
+
+		fs.scheduler().applyRetention(ctx, backendName, 2)
+		fs.backend.AssertNumberOfCalls(t, "DeleteBackup", 3)
+		fs.backend.AssertCalled(t, "DeleteBackup", ctx, "scheduled-1")
+		fs.backend.AssertCalled(t, "DeleteBackup", ctx, "scheduled-2")
+		fs.backend.AssertCalled(t, "DeleteBackup", ctx, "scheduled-3")
+	})
+
+	t.Run("KeepBase", func(t *testing.T) {
+		fs := newFakeScheduler(nil)
+		ids := []string{}
+		for _, m := range metas {
+			if m.ID == "scheduled-5" {
+				m.BaseBackupID = "scheduled-1"
+			}
+			ids = append(ids, m.ID)
+			fs.backend.On("GetObject", ctx, m.ID, GlobalBackupFile).Return(marshalCoordinatorMeta(m), nil)
+		}
+		fs.backend.On("ListBackups", ctx).Return(ids, nil)
+		fs.backend.On("DeleteBackup", ctx, mock.Anything).Return(nil)
+
+		fs.scheduler().applyRetention(ctx, backendName, 2)
+		fs.backend.AssertNumberOfCalls(t, "DeleteBackup", 2)
+		fs.backend.AssertCalled(t, "DeleteBackup", ctx, "scheduled-2")
+		fs.backend.AssertCalled(t, "DeleteBackup", ctx, "scheduled-3")
+	})
+
+	t.Run("ListFails", func(t *testing.T) {
+		fs := newFakeScheduler(nil)
+		fs.backend.On("ListBackups", ctx).Return(nil, ErrAny)
+
+		fs.scheduler().applyRetention(ctx, backendName, 2)
+		fs.backend.AssertNotCalled(t, "DeleteBackup", mock.Anything, mock.Anything)
+	})
+}
+
+func TestScheduleRun(t *testing.T) {
+	var (
+		cls         = "Class-A"
+		node        = "Node-A"
+		backendName = "s3"
+		any         = mock.Anything
+		ctx         = context.Background()
+		starTime    = time.Date(2022, 1, 1, 1, 0, 0, 0, time.UTC)
+		now         = starTime.Add(5 * time.Hour)
+		backupID    = scheduledID(now)
+		cfg         = config.BackupSchedule{Backend: backendName, Interval: time.Hour, Retention: 2}
+		cresp       = &CanCommitResponse{Method: OpCreate, ID: backupID, Timeout: 1}
+		sReq        = &StatusRequest{OpCreate, backupID, backendName}
+		sresp       = &StatusResponse{Status: backup.Success, ID: backupID, Method: OpCreate}
+	)
+	metas := []backup.DistributedBackupDescriptor{
+		{ID: "scheduled-1", StartedAt: starTime, Status: backup.Success},
+		{ID: "manual", StartedAt: starTime.Add(time.Hour), Status: backup.Success},
+		{ID: "scheduled-2", StartedAt: starTime.Add(2 * time.Hour), Status: backup.Success},
+		{ID: "scheduled-3", StartedAt: starTime.Add(3 * time.Hour), Status: backup.Failed},
+		{ID: "scheduled-4", StartedAt: starTime.Add(4 * time.Hour), Status: backup.Success},
+	}
+
+	fs := newFakeScheduler(newFakeNodeResolver([]string{node}))
+	fs.selector.On("ListClasses", ctx).Return([]string{cls})
+	fs.selector.On("Backupable", ctx, []string{cls}).Return(nil)
+	fs.selector.On("Shards", ctx, cls).Return([]string{node})
+	fs.client.On("CanCommit", any, node, any).Return(cresp, nil)
+	fs.client.On("Commit", any, node, sReq).Return(nil)
+	fs.client.On("Status", any, node, sReq).Return(sresp, nil)
+	fs.backend.On("HomeDir", any).Return("dst/path")
+	fs.backend.On("Initialize", ctx, backupID).Return(nil)
+	fs.backend.On("PutObject", any, backupID, GlobalBackupFile, any).Return(nil)
+
+	ids := []string{}
+	for _, m := range metas {
+		ids = append(ids, m.ID)
+		fs.backend.On("GetObject", ctx, m.ID, GlobalBackupFile).Return(marshalCoordinatorMeta(m), nil)
+	}
+	// the new backup only exists once it has been created
+	newMeta := backup.DistributedBackupDescriptor{ID: backupID, StartedAt: now, Status: backup.Success}
+	fs.backend.On("GetObject", ctx, backupID, GlobalBackupFile).Return(nil, backup.ErrNotFound{}).Once()
+	fs.backend.On("GetObject", ctx, backupID, BackupFile).Return(nil, backup.ErrNotFound{})
+	fs.backend.On("GetObject", ctx, backupID, GlobalBackupFile).Return(marshalCoordinatorMeta(newMeta), nil)
+	fs.backend.On("ListBackups", ctx).Return(append(ids, backupID), nil)
+	fs.backend.On("DeleteBackup", ctx, any).Return(nil)
+
+	s := fs.scheduler()
+	s.scheduleStatusPeriod = 10 * time.Millisecond
+	s.runSchedule(ctx, cfg, now)
+
+	assert.Equal(t, backup.Success, fs.backend.glMeta.Status)
+	fs.backend.AssertNumberOfCalls(t, "DeleteBackup", 3)
+	fs.backend.AssertCalled(t, "DeleteBackup", ctx, "scheduled-1")
+	fs.backend.AssertCalled(t, "DeleteBackup", ctx, "scheduled-2")
+	fs.backend.AssertCalled(t, "DeleteBackup", ctx, "scheduled-3")
+
+	// exactly the configured number of scheduled backups is left, including
+	// the new one
+	remaining := []string{}
+	for _, id := range append(ids, backupID) {
+		deleted := false
+		for _, call := range fs.backend.Calls {
+			if call.Method == "DeleteBackup" && call.Arguments.String(1) == id {
+				deleted = true
+			}
+		}
+		if !deleted && strings.HasPrefix(id, scheduledPrefix) {
+			remaining = append(remaining, id)
+		}
+	}
+	assert.ElementsMatch(t, []string{"scheduled-4", backupID}, remaining)
+}
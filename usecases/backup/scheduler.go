@@ -15,6 +15,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/semi-technologies/weaviate/entities/backup"
@@ -35,6 +36,10 @@ type Scheduler struct {
 	backupper  *coordinator
 	restorer   *coordinator
 	backends   BackupBackendProvider
+
+	// scheduleStatusPeriod is how often a scheduled backup is checked for
+	// completion
+	scheduleStatusPeriod time.Duration
 }
 
 // NewScheduler creates a new scheduler with two coordinators
@@ -58,6 +63,7 @@ func NewScheduler(
 			sourcer,
 			client,
 			logger, nodeResolver),
+		scheduleStatusPeriod: _ScheduleStatusPeriod,
 	}
 	return m
 }
@@ -72,6 +78,10 @@ func (s *Scheduler) Backup(ctx context.Context, pr *models.Principal, req *Backu
 	if err := s.authorizer.Authorize(pr, "add", path); err != nil {
 		return nil, err
 	}
+	return s.backup(ctx, req)
+}
+
+func (s *Scheduler) backup(ctx context.Context, req *BackupRequest) (*models.BackupCreateResponse, error) {
 	store, err := coordBackend(s.backends, req.Backend, req.ID)
 	if err != nil {
 		err = fmt.Errorf("no backup backend %q: %w, did you enable the right module?", req.Backend, err)
@@ -87,10 +97,12 @@ func (s *Scheduler) Backup(ctx context.Context, pr *models.Principal, req *Backu
 		return nil, backup.NewErrUnprocessable(fmt.Errorf("init uploader: %w", err))
 	}
 	breq := Request{
-		Method:  OpCreate,
-		ID:      req.ID,
-		Backend: req.Backend,
-		Classes: classes,
+		Method:       OpCreate,
+		ID:           req.ID,
+		Backend:      req.Backend,
+		Classes:      classes,
+		Archive:      req.Archive,
+		BaseBackupID: req.BaseBackupID,
 	}
 	if err := s.backupper.Backup(ctx, store, &breq); err != nil {
 		return nil, backup.NewErrUnprocessable(err)
@@ -193,6 +205,106 @@ func (s *Scheduler) RestorationStatus(ctx context.Context, principal *models.Pri
 	return st, nil
 }
 
+// List returns the backups stored by the backend whose metadata has been
+// written, i.e. which have either succeeded or failed
+func (s *Scheduler) List(ctx context.Context, principal *models.Principal, backend string,
+) (_ *models.BackupListResponse, err error) {
+	defer func(begin time.Time) {
+		logOperation(s.logger, "list_backups", "", backend, begin, err)
+	}(time.Now())
+	path := fmt.Sprintf("backups/%s", backend)
+	if err := s.authorizer.Authorize(principal, "get", path); err != nil {
+		return nil, err
+	}
+	descs, err := s.list(ctx, backend)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &models.BackupListResponse{Backups: make([]*models.BackupListItem, 0, len(descs))}
+	for _, desc := range descs {
+		resp.Backups = append(resp.Backups, &models.BackupListItem{
+			ID:           desc.ID,
+			Classes:      desc.Classes(),
+			Status:       string(desc.Status),
+			Error:        desc.Error,
+			BaseBackupID: desc.BaseBackupID,
+		})
+	}
+	return resp, nil
+}
+
+// Delete removes a backup from the backend. A backup cannot be deleted while
+// it is in progress or while another backup is incremental to it.
+func (s *Scheduler) Delete(ctx context.Context, principal *models.Principal, backend, backupID string,
+) (err error) {
+	defer func(begin time.Time) {
+		logOperation(s.logger, "delete_backup", backupID, backend, begin, err)
+	}(time.Now())
+	path := fmt.Sprintf("backups/%s/%s", backend, backupID)
+	if err := s.authorizer.Authorize(principal, "delete", path); err != nil {
+		return err
+	}
+	return s.delete(ctx, backend, backupID)
+}
+
+// list returns the global metadata of all backups stored by the backend
+// ordered by their start time
+func (s *Scheduler) list(ctx context.Context, backend string) ([]*backup.DistributedBackupDescriptor, error) {
+	caps, err := s.backends.BackupBackend(backend)
+	if err != nil {
+		err = fmt.Errorf("no backup backend %q: %w, did you enable the right module?", backend, err)
+		return nil, backup.NewErrUnprocessable(err)
+	}
+	ids, err := caps.ListBackups(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list backups: %w", err)
+	}
+
+	descs := make([]*backup.DistributedBackupDescriptor, 0, len(ids))
+	for _, id := range ids {
+		store := coordStore{objStore{b: caps, BasePath: id}}
+		desc, err := store.Meta(ctx, GlobalBackupFile)
+		if err != nil {
+			// either in progress or not a backup at all
+			continue
+		}
+		descs = append(descs, desc)
+	}
+	sort.SliceStable(descs, func(i, j int) bool {
+		return descs[i].StartedAt.Before(descs[j].StartedAt)
+	})
+	return descs, nil
+}
+
+func (s *Scheduler) delete(ctx context.Context, backend, backupID string) error {
+	if err := validateID(backupID); err != nil {
+		return backup.NewErrUnprocessable(err)
+	}
+	caps, err := s.backends.BackupBackend(backend)
+	if err != nil {
+		err = fmt.Errorf("no backup backend %q: %w, did you enable the right module?", backend, err)
+		return backup.NewErrUnprocessable(err)
+	}
+	if st := s.backupper.lastOp.get(); st.ID == backupID {
+		return backup.NewErrUnprocessable(fmt.Errorf("backup %q is in progress", backupID))
+	}
+	if st := s.restorer.lastOp.get(); st.ID == backupID {
+		return backup.NewErrUnprocessable(fmt.Errorf("restoration of backup %q is in progress", backupID))
+	}
+	descs, err := s.list(ctx, backend)
+	if err != nil {
+		return err
+	}
+	for _, desc := range descs {
+		if desc.BaseBackupID == backupID {
+			err := fmt.Errorf("backup %q is the base of backup %q", backupID, desc.ID)
+			return backup.NewErrUnprocessable(err)
+		}
+	}
+	return caps.DeleteBackup(ctx, backupID)
+}
+
 func coordBackend(provider BackupBackendProvider, backend, id string) (coordStore, error) {
 	caps, err := provider.BackupBackend(backend)
 	if err != nil {
@@ -211,6 +323,12 @@ func (s *Scheduler) validateBackupRequest(ctx context.Context, store coordStore,
 	if len(req.Include) > 0 && len(req.Exclude) > 0 {
 		return nil, errIncludeExclude
 	}
+	if err := req.Archive.Validate(); err != nil {
+		return nil, err
+	}
+	if err := validateBaseID(req.ID, req.BaseBackupID); err != nil {
+		return nil, err
+	}
 	classes := req.Include
 	if len(classes) == 0 {
 		classes = s.backupper.selector.ListClasses(ctx)
@@ -231,6 +349,16 @@ func (s *Scheduler) validateBackupRequest(ctx context.Context, store coordStore,
 	if _, ok := err.(backup.ErrNotFound); !ok {
 		return nil, fmt.Errorf("check if backup %q exists at %q: %w", req.ID, destPath, err)
 	}
+	if req.BaseBackupID != "" {
+		base := coordStore{objStore{b: store.b, BasePath: req.BaseBackupID}}
+		meta, err := base.Meta(ctx, GlobalBackupFile)
+		if err != nil {
+			return nil, fmt.Errorf("base backup %q: %w", req.BaseBackupID, err)
+		}
+		if meta.Status != backup.Success {
+			return nil, fmt.Errorf("invalid base backup %q status: %s", req.BaseBackupID, meta.Status)
+		}
+	}
 	return classes, nil
 }
 
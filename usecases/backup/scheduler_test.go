@@ -123,6 +123,36 @@ func TestSchedulerValidateCreateBackup(t *testing.T) {
 		assert.Contains(t, err.Error(), fmt.Sprintf("backup %q already exists", id))
 		assert.IsType(t, backup.ErrUnprocessable{}, err)
 	})
+	t.Run("OwnBase", func(t *testing.T) {
+		_, err := s.Backup(ctx, nil, &BackupRequest{
+			Backend:      backendName,
+			ID:           id,
+			Include:      []string{cls},
+			BaseBackupID: id,
+		})
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "its own base")
+	})
+	t.Run("BaseNotFound", func(t *testing.T) {
+		baseID := "base"
+		fs := newFakeScheduler(nil)
+		fs.selector.On("Backupable", ctx, []string{cls}).Return(nil)
+		fs.backend.On("HomeDir", mock.Anything).Return(path)
+		fs.backend.On("GetObject", ctx, id, GlobalBackupFile).Return(nil, backup.ErrNotFound{})
+		fs.backend.On("GetObject", ctx, id, BackupFile).Return(nil, backup.ErrNotFound{})
+		fs.backend.On("GetObject", ctx, baseID, GlobalBackupFile).Return(nil, backup.ErrNotFound{})
+		fs.backend.On("GetObject", ctx, baseID, BackupFile).Return(nil, backup.ErrNotFound{})
+		meta, err := fs.scheduler().Backup(ctx, nil, &BackupRequest{
+			Backend:      backendName,
+			ID:           id,
+			Include:      []string{cls},
+			BaseBackupID: baseID,
+		})
+		assert.Nil(t, meta)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), fmt.Sprintf("base backup %q", baseID))
+		assert.IsType(t, backup.ErrUnprocessable{}, err)
+	})
 }
 
 func TestSchedulerBackupStatus(t *testing.T) {
@@ -209,6 +239,125 @@ func TestSchedulerBackupStatus(t *testing.T) {
 	})
 }
 
+func TestSchedulerList(t *testing.T) {
+	t.Parallel()
+	var (
+		backendName = "s3"
+		ctx         = context.Background()
+		starTime    = time.Date(2022, 1, 1, 1, 0, 0, 0, time.UTC)
+	)
+
+	t.Run("GetBackupProvider", func(t *testing.T) {
+		fs := newFakeScheduler(nil)
+		fs.backendErr = ErrAny
+		_, err := fs.scheduler().List(ctx, nil, backendName)
+		assert.NotNil(t, err)
+		assert.IsType(t, backup.ErrUnprocessable{}, err)
+	})
+
+	t.Run("ListFails", func(t *testing.T) {
+		fs := newFakeScheduler(nil)
+		fs.backend.On("ListBackups", ctx).Return(nil, ErrAny)
+		_, err := fs.scheduler().List(ctx, nil, backendName)
+		assert.ErrorIs(t, err, ErrAny)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		fs := newFakeScheduler(nil)
+		fs.backend.On("ListBackups", ctx).Return([]string{"b2", "b1", "tmp"}, nil)
+		fs.backend.On("GetObject", ctx, "b1", GlobalBackupFile).Return(marshalCoordinatorMeta(
+			backup.DistributedBackupDescriptor{
+				ID: "b1", StartedAt: starTime, Status: backup.Success,
+				Nodes: map[string]*backup.NodeDescriptor{"N1": {Classes: []string{"C1"}}},
+			}), nil)
+		fs.backend.On("GetObject", ctx, "b2", GlobalBackupFile).Return(marshalCoordinatorMeta(
+			backup.DistributedBackupDescriptor{
+				ID: "b2", StartedAt: starTime.Add(time.Hour), Status: backup.Failed,
+				Error: "some error", BaseBackupID: "b1",
+			}), nil)
+		fs.backend.On("GetObject", ctx, "tmp", GlobalBackupFile).Return(nil, backup.ErrNotFound{})
+		fs.backend.On("GetObject", ctx, "tmp", BackupFile).Return(nil, backup.ErrNotFound{})
+
+		got, err := fs.scheduler().List(ctx, nil, backendName)
+		assert.Nil(t, err)
+		want := &models.BackupListResponse{Backups: []*models.BackupListItem{
+			{ID: "b1", Classes: []string{"C1"}, Status: string(backup.Success)},
+			{ID: "b2", Classes: []string{}, Status: string(backup.Failed), Error: "some error", BaseBackupID: "b1"},
+		}}
+		assert.Equal(t, want, got)
+	})
+}
+
+func TestSchedulerDelete(t *testing.T) {
+	t.Parallel()
+	var (
+		backendName = "s3"
+		id          = "1234"
+		ctx         = context.Background()
+	)
+
+	t.Run("ValidateID", func(t *testing.T) {
+		fs := newFakeScheduler(nil)
+		err := fs.scheduler().Delete(ctx, nil, backendName, "A*:")
+		assert.IsType(t, backup.ErrUnprocessable{}, err)
+	})
+
+	t.Run("GetBackupProvider", func(t *testing.T) {
+		fs := newFakeScheduler(nil)
+		fs.backendErr = ErrAny
+		err := fs.scheduler().Delete(ctx, nil, backendName, id)
+		assert.IsType(t, backup.ErrUnprocessable{}, err)
+	})
+
+	t.Run("BackupInProgress", func(t *testing.T) {
+		s := newFakeScheduler(nil).scheduler()
+		s.backupper.lastOp.reqStat = reqStat{ID: id, Status: backup.Transferring}
+		err := s.Delete(ctx, nil, backendName, id)
+		assert.IsType(t, backup.ErrUnprocessable{}, err)
+		assert.Contains(t, err.Error(), "in progress")
+	})
+
+	t.Run("RestorationInProgress", func(t *testing.T) {
+		s := newFakeScheduler(nil).scheduler()
+		s.restorer.lastOp.reqStat = reqStat{ID: id, Status: backup.Transferring}
+		err := s.Delete(ctx, nil, backendName, id)
+		assert.IsType(t, backup.ErrUnprocessable{}, err)
+		assert.Contains(t, err.Error(), "in progress")
+	})
+
+	t.Run("BaseOfAnotherBackup", func(t *testing.T) {
+		fs := newFakeScheduler(nil)
+		fs.backend.On("ListBackups", ctx).Return([]string{id, "incr"}, nil)
+		fs.backend.On("GetObject", ctx, id, GlobalBackupFile).Return(marshalCoordinatorMeta(
+			backup.DistributedBackupDescriptor{ID: id, Status: backup.Success}), nil)
+		fs.backend.On("GetObject", ctx, "incr", GlobalBackupFile).Return(marshalCoordinatorMeta(
+			backup.DistributedBackupDescriptor{ID: "incr", Status: backup.Success, BaseBackupID: id}), nil)
+		err := fs.scheduler().Delete(ctx, nil, backendName, id)
+		assert.IsType(t, backup.ErrUnprocessable{}, err)
+		assert.Contains(t, err.Error(), "incr")
+		fs.backend.AssertNotCalled(t, "DeleteBackup", mock.Anything, mock.Anything)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		fs := newFakeScheduler(nil)
+		fs.backend.On("ListBackups", ctx).Return([]string{}, nil)
+		fs.backend.On("DeleteBackup", ctx, id).Return(backup.NewErrNotFound(ErrAny))
+		err := fs.scheduler().Delete(ctx, nil, backendName, id)
+		assert.IsType(t, backup.ErrNotFound{}, err)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		fs := newFakeScheduler(nil)
+		fs.backend.On("ListBackups", ctx).Return([]string{id}, nil)
+		fs.backend.On("GetObject", ctx, id, GlobalBackupFile).Return(marshalCoordinatorMeta(
+			backup.DistributedBackupDescriptor{ID: id, Status: backup.Success}), nil)
+		fs.backend.On("DeleteBackup", ctx, id).Return(nil)
+		err := fs.scheduler().Delete(ctx, nil, backendName, id)
+		assert.Nil(t, err)
+		fs.backend.AssertCalled(t, "DeleteBackup", ctx, id)
+	})
+}
+
 func TestSchedulerRestorationStatus(t *testing.T) {
 	t.Parallel()
 	var (
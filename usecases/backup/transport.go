@@ -40,6 +40,11 @@ type Request struct {
 	// Classes is list of class which need to be backed up
 	Classes []string
 
+	// Archive configures the archive mode of a backup
+	Archive ArchiveConfig
+	// BaseBackupID is the backup an incremental backup is based on
+	BaseBackupID string
+
 	// Duration
 	Duration time.Duration
 }
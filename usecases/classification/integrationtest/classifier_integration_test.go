@@ -81,7 +81,7 @@ func Test_Classifier_KNN_SaveConsistency(t *testing.T) {
 				}
 			}
 
-			res, err := vrepo.BatchPutObjects(context.Background(), bt)
+			res, err := vrepo.BatchPutObjects(context.Background(), bt, nil)
 			require.Nil(t, err)
 			for _, elem := range res {
 				require.Nil(t, elem.Err)
@@ -98,7 +98,7 @@ func Test_Classifier_KNN_SaveConsistency(t *testing.T) {
 					Object:        elem.Object(),
 				}
 			}
-			res, err := vrepo.BatchPutObjects(context.Background(), bt)
+			res, err := vrepo.BatchPutObjects(context.Background(), bt, nil)
 			require.Nil(t, err)
 			for _, elem := range res {
 				require.Nil(t, elem.Err)
@@ -217,7 +217,7 @@ func Test_Classifier_ZeroShot_SaveConsistency(t *testing.T) {
 				}
 			}
 
-			res, err := vrepo.BatchPutObjects(context.Background(), bt)
+			res, err := vrepo.BatchPutObjects(context.Background(), bt, nil)
 			require.Nil(t, err)
 			for _, elem := range res {
 				require.Nil(t, elem.Err)
@@ -67,7 +67,7 @@ func singleShardState() *sharding.State {
 	}
 
 	s, err := sharding.InitState("test-index", config,
-		fakeNodes{[]string{"node1"}})
+		fakeNodes{[]string{"node1"}}, 1, false)
 	if err != nil {
 		panic(err)
 	}
@@ -361,9 +361,9 @@ func (f *fakeRemoteClient) MergeObject(ctx context.Context, hostName, indexName,
 }
 
 func (f *fakeRemoteClient) SearchShard(ctx context.Context, hostName, indexName,
-	shardName string, vector []float32, limit int, filters *filters.LocalFilter,
+	shardName string, vector []float32, targetVector string, limit int, filters *filters.LocalFilter,
 	keywordRanking *searchparams.KeywordRanking, sort []filters.Sort,
-	additional additional.Properties,
+	cursor *filters.Cursor, additional additional.Properties,
 ) ([]*storobj.Object, []float32, error) {
 	return nil, nil, nil
 }
@@ -404,6 +404,12 @@ func (f *fakeRemoteClient) DeleteObjectBatch(ctx context.Context, hostName, inde
 	return nil
 }
 
+func (f *fakeRemoteClient) DeleteObjects(ctx context.Context, hostName, indexName,
+	shardName string, ids []strfmt.UUID,
+) []error {
+	return nil
+}
+
 func (f *fakeRemoteClient) GetShardStatus(ctx context.Context,
 	hostName, indexName, shardName string,
 ) (string, error) {
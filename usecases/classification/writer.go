@@ -123,7 +123,7 @@ func (r *batchWriter) saveObjects(items objects.BatchObjects) {
 	defer cancel()
 
 	if len(items) > 0 {
-		saved, err := r.vectorRepo.BatchPutObjects(ctx, items)
+		saved, err := r.vectorRepo.BatchPutObjects(ctx, items, nil)
 		if err != nil {
 			r.ec.Add(err)
 		}
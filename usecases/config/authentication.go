@@ -11,12 +11,17 @@
 
 package config
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+)
 
 // Authentication configuration
 type Authentication struct {
 	OIDC            OIDC            `json:"oidc" yaml:"oidc"`
 	AnonymousAccess AnonymousAccess `json:"anonymous_access" yaml:"anonymous_access"`
+	APIKey          APIKey          `json:"apikey" yaml:"apikey"`
 }
 
 // Validate the Authentication configuration. This only validates at a general
@@ -27,11 +32,15 @@ func (a Authentication) Validate() error {
 		return fmt.Errorf("no authentication scheme configured, you must select at least one")
 	}
 
+	if err := a.APIKey.Validate(); err != nil {
+		return errors.Wrap(err, "invalid apikey config")
+	}
+
 	return nil
 }
 
 func (a Authentication) anyAuthMethodSelected() bool {
-	return a.AnonymousAccess.Enabled || a.OIDC.Enabled
+	return a.AnonymousAccess.Enabled || a.OIDC.Enabled || a.APIKey.Enabled
 }
 
 // AnonymousAccess considers users without any auth information as
@@ -52,3 +61,47 @@ type OIDC struct {
 	GroupsClaim       string   `yaml:"groups_claim" json:"groups_claim"`
 	Scopes            []string `yaml:"scopes" json:"scopes"`
 }
+
+// APIKey configures static API keys which are sent as bearer tokens. Each key
+// is mapped to a user, which can then be used by the authorization plugins,
+// such as the admin list. Either a single user is configured, which is then
+// used for all keys, or exactly one user per key.
+type APIKey struct {
+	Enabled     bool     `json:"enabled" yaml:"enabled"`
+	Users       []string `json:"users" yaml:"users"`
+	AllowedKeys []string `json:"allowed_keys" yaml:"allowed_keys"`
+}
+
+// Validate the APIKey configuration. A disabled config is always valid.
+func (a APIKey) Validate() error {
+	if !a.Enabled {
+		return nil
+	}
+
+	if len(a.AllowedKeys) == 0 {
+		return fmt.Errorf("at least one key must be configured in allowed_keys")
+	}
+
+	for i, key := range a.AllowedKeys {
+		if key == "" {
+			return fmt.Errorf("allowed_keys: key at position %d is empty", i)
+		}
+	}
+
+	if len(a.Users) == 0 {
+		return fmt.Errorf("at least one user must be configured in users")
+	}
+
+	if len(a.Users) > 1 && len(a.Users) != len(a.AllowedKeys) {
+		return fmt.Errorf("users must contain either a single user or one user "+
+			"per key, got %d users for %d keys", len(a.Users), len(a.AllowedKeys))
+	}
+
+	for i, user := range a.Users {
+		if user == "" {
+			return fmt.Errorf("users: user at position %d is empty", i)
+		}
+	}
+
+	return nil
+}
@@ -70,4 +70,104 @@ func TestConfig_Authentication(t *testing.T) {
 
 		assert.Nil(t, err, "should not error")
 	})
+	t.Run("only apikey selected", func(t *testing.T) {
+		auth := Authentication{
+			APIKey: APIKey{
+				Enabled:     true,
+				AllowedKeys: []string{"secret-key"},
+				Users:       []string{"jane"},
+			},
+		}
+
+		err := auth.Validate()
+
+		assert.Nil(t, err, "should not error")
+	})
+
+	t.Run("apikey with an invalid config", func(t *testing.T) {
+		auth := Authentication{
+			APIKey: APIKey{
+				Enabled:     true,
+				AllowedKeys: []string{"secret-key"},
+			},
+		}
+
+		err := auth.Validate()
+
+		assert.NotNil(t, err)
+	})
+}
+
+func TestConfig_APIKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      APIKey
+		expectedErr bool
+	}{
+		{
+			name:   "disabled",
+			config: APIKey{},
+		},
+		{
+			name: "single user for all keys",
+			config: APIKey{
+				Enabled:     true,
+				AllowedKeys: []string{"key1", "key2"},
+				Users:       []string{"jane"},
+			},
+		},
+		{
+			name: "one user per key",
+			config: APIKey{
+				Enabled:     true,
+				AllowedKeys: []string{"key1", "key2"},
+				Users:       []string{"jane", "john"},
+			},
+		},
+		{
+			name: "without keys",
+			config: APIKey{
+				Enabled: true,
+				Users:   []string{"jane"},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "with an empty key",
+			config: APIKey{
+				Enabled:     true,
+				AllowedKeys: []string{"key1", ""},
+				Users:       []string{"jane"},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "without users",
+			config: APIKey{
+				Enabled:     true,
+				AllowedKeys: []string{"key1"},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "user and key count mismatch",
+			config: APIKey{
+				Enabled:     true,
+				AllowedKeys: []string{"key1", "key2", "key3"},
+				Users:       []string{"jane", "john"},
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.config.Validate()
+			if test.expectedErr {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
 }
@@ -16,6 +16,7 @@ import (
 	"fmt"
 	"os"
 	"regexp"
+	"time"
 
 	"github.com/go-openapi/swag"
 	"github.com/pkg/errors"
@@ -83,11 +84,14 @@ type Config struct {
 	AutoSchema                       AutoSchema     `json:"auto_schema" yaml:"auto_schema"`
 	Cluster                          cluster.Config `json:"cluster" yaml:"cluster"`
 	Monitoring                       Monitoring     `json:"monitoring" yaml:"monitoring"`
+	GRPC                             GRPC           `json:"grpc" yaml:"grpc"`
 	Profiling                        Profiling      `json:"profiling" yaml:"profiling"`
 	ResourceUsage                    ResourceUsage  `json:"resource_usage" yaml:"resource_usage"`
 	MaxImportGoroutinesFactor        float64        `json:"max_import_goroutine_factor" yaml:"max_import_goroutine_factor"`
 	TrackVectorDimensions            bool           `json:"track_vector_dimensions" yaml:"track_vector_dimensions"`
 	ReindexVectorDimensionsAtStartup bool           `json:"reindex_vector_dimensions_at_startup" yaml:"reindex_vector_dimensions_at_startup"`
+	IndexFilterableRoaringSet        bool           `json:"index_filterable_roaring_set" yaml:"index_filterable_roaring_set"`
+	BackupSchedule                   BackupSchedule `json:"backup_schedule" yaml:"backup_schedule"`
 }
 
 type moduleProvider interface {
@@ -161,6 +165,30 @@ type Monitoring struct {
 	Port    int    `json:"port" yaml:"port"`
 }
 
+// GRPC configures the gRPC server that is started next to the REST server,
+// unless it is disabled. Enabled is nil if it was neither set in the config
+// file nor in the environment.
+type GRPC struct {
+	Enabled *bool `json:"enabled" yaml:"enabled"`
+	Port    int   `json:"port" yaml:"port"`
+}
+
+// IsEnabled returns whether the gRPC server should be started, it is enabled
+// by default
+func (g GRPC) IsEnabled() bool {
+	return g.Enabled == nil || *g.Enabled
+}
+
+// BackupSchedule configures periodic backups of all classes. Scheduled
+// backups are disabled if Backend is empty. If Retention is larger than 0,
+// only that many successful scheduled backups are kept and failed scheduled
+// backups are deleted.
+type BackupSchedule struct {
+	Backend   string        `json:"backend" yaml:"backend"`
+	Interval  time.Duration `json:"interval" yaml:"interval"`
+	Retention int           `json:"retention" yaml:"retention"`
+}
+
 type Profiling struct {
 	BlockProfileRate     int `json:"blockProfileRate" yaml:"blockProfileRate"`
 	MutexProfileFraction int `json:"mutexProfileFraction" yaml:"mutexProfileFraction"`
@@ -169,6 +197,9 @@ type Profiling struct {
 type Persistence struct {
 	DataPath                string `json:"dataPath" yaml:"dataPath"`
 	FlushIdleMemtablesAfter int    `json:"flushIdleMemtablesAfter" yaml:"flushIdleMemtablesAfter"`
+	// ObjectsCompression is the block compression of the segments of the
+	// objects buckets, one of "none", "snappy" or "zstd"
+	ObjectsCompression string `json:"objectsCompression" yaml:"objectsCompression"`
 }
 
 func (p Persistence) Validate() error {
@@ -176,6 +207,13 @@ func (p Persistence) Validate() error {
 		return fmt.Errorf("persistence.dataPath must be set")
 	}
 
+	switch p.ObjectsCompression {
+	case "", "none", "snappy", "zstd":
+	default:
+		return fmt.Errorf("persistence.objectsCompression must be one of "+
+			"\"none\", \"snappy\" or \"zstd\", got %q", p.ObjectsCompression)
+	}
+
 	return nil
 }
 
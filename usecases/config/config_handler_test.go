@@ -12,9 +12,11 @@
 package config
 
 import (
+	"os"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestConfig(t *testing.T) {
@@ -84,3 +86,12 @@ func TestConfig(t *testing.T) {
 		assert.Nil(t, err, "should not error")
 	})
 }
+
+func TestConfigFileGRPCEnabled(t *testing.T) {
+	os.Clearenv()
+	f := &WeaviateConfig{}
+	config, err := f.parseConfigFile([]byte("grpc:\n  enabled: false\n"), "weaviate.conf.yaml")
+	require.Nil(t, err)
+	require.Nil(t, FromEnv(&config))
+	assert.False(t, config.GRPC.IsEnabled())
+}
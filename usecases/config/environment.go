@@ -16,6 +16,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/usecases/cluster"
@@ -41,6 +42,10 @@ func FromEnv(config *Config) error {
 		}
 	}
 
+	if enabled(os.Getenv("INDEX_FILTERABLE_ROARING_SET")) {
+		config.IndexFilterableRoaringSet = true
+	}
+
 	if v := os.Getenv("PROMETHEUS_MONITORING_PORT"); v != "" {
 		asInt, err := strconv.Atoi(v)
 		if err != nil {
@@ -82,6 +87,18 @@ func FromEnv(config *Config) error {
 		}
 	}
 
+	if enabled(os.Getenv("AUTHENTICATION_APIKEY_ENABLED")) {
+		config.Authentication.APIKey.Enabled = true
+
+		if v := os.Getenv("AUTHENTICATION_APIKEY_ALLOWED_KEYS"); v != "" {
+			config.Authentication.APIKey.AllowedKeys = strings.Split(v, ",")
+		}
+
+		if v := os.Getenv("AUTHENTICATION_APIKEY_USERS"); v != "" {
+			config.Authentication.APIKey.Users = strings.Split(v, ",")
+		}
+	}
+
 	if enabled(os.Getenv("AUTHORIZATION_ADMINLIST_ENABLED")) {
 		config.Authorization.AdminList.Enabled = true
 
@@ -116,6 +133,12 @@ func FromEnv(config *Config) error {
 		config.Persistence.FlushIdleMemtablesAfter = DefaultPersistenceFlushIdleMemtablesAfter
 	}
 
+	if v := os.Getenv("PERSISTENCE_OBJECTS_COMPRESSION"); v != "" {
+		config.Persistence.ObjectsCompression = v
+	} else if config.Persistence.ObjectsCompression == "" {
+		config.Persistence.ObjectsCompression = DefaultPersistenceObjectsCompression
+	}
+
 	if v := os.Getenv("ORIGIN"); v != "" {
 		config.Origin = v
 	}
@@ -157,6 +180,24 @@ func FromEnv(config *Config) error {
 		config.MaxImportGoroutinesFactor = DefaultMaxImportGoroutinesFactor
 	}
 
+	if v := os.Getenv("GRPC_ENABLED"); v != "" {
+		grpcEnabled := enabled(v)
+		config.GRPC.Enabled = &grpcEnabled
+	} else if config.GRPC.Enabled == nil {
+		grpcEnabled := true
+		config.GRPC.Enabled = &grpcEnabled
+	}
+	if v := os.Getenv("GRPC_PORT"); v != "" {
+		asInt, err := strconv.Atoi(v)
+		if err != nil {
+			return errors.Wrapf(err, "parse GRPC_PORT as int")
+		}
+
+		config.GRPC.Port = asInt
+	} else if config.GRPC.Port == 0 {
+		config.GRPC.Port = DefaultGRPCPort
+	}
+
 	if v := os.Getenv("DEFAULT_VECTORIZER_MODULE"); v != "" {
 		config.DefaultVectorizerModule = v
 	} else {
@@ -192,6 +233,34 @@ func FromEnv(config *Config) error {
 		config.AutoSchema.DefaultDate = v
 	}
 
+	if v := os.Getenv("BACKUP_SCHEDULE_BACKEND"); v != "" {
+		config.BackupSchedule.Backend = v
+	}
+
+	if v := os.Getenv("BACKUP_SCHEDULE_INTERVAL"); v != "" {
+		interval, err := time.ParseDuration(v)
+		if err != nil {
+			return errors.Wrapf(err, "parse BACKUP_SCHEDULE_INTERVAL as duration")
+		} else if interval <= 0 {
+			return errors.New("BACKUP_SCHEDULE_INTERVAL must be a positive duration")
+		}
+
+		config.BackupSchedule.Interval = interval
+	} else if config.BackupSchedule.Interval == 0 {
+		config.BackupSchedule.Interval = DefaultBackupScheduleInterval
+	}
+
+	if v := os.Getenv("BACKUP_SCHEDULE_RETENTION"); v != "" {
+		asInt, err := strconv.Atoi(v)
+		if err != nil {
+			return errors.Wrapf(err, "parse BACKUP_SCHEDULE_RETENTION as int")
+		} else if asInt < 0 {
+			return errors.New("negative BACKUP_SCHEDULE_RETENTION")
+		}
+
+		config.BackupSchedule.Retention = asInt
+	}
+
 	ru, err := parseResourceUsageEnvVars()
 	if err != nil {
 		return err
@@ -223,8 +292,14 @@ const DefaultQueryMaximumResults = int64(10000)
 
 const DefaultPersistenceFlushIdleMemtablesAfter = 60
 
+const DefaultPersistenceObjectsCompression = "none"
+
 const VectorizerModuleNone = "none"
 
+const DefaultGRPCPort = 50051
+
+const DefaultBackupScheduleInterval = 24 * time.Hour
+
 // DefaultGossipBindPort uses the hashicorp/memberlist default
 // port value assigned with the use of DefaultLocalConfig
 const DefaultGossipBindPort = 7946
@@ -15,6 +15,7 @@ import (
 	"errors"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/semi-technologies/weaviate/usecases/cluster"
 	"github.com/stretchr/testify/assert"
@@ -86,6 +87,161 @@ func TestEnvironmentSetFlushAfter(t *testing.T) {
 	}
 }
 
+func TestEnvironmentSetObjectsCompression(t *testing.T) {
+	factors := []struct {
+		name        string
+		compression []string
+		fromFile    string
+		expected    string
+	}{
+		{"not given", []string{}, "", DefaultPersistenceObjectsCompression},
+		{"zstd", []string{"zstd"}, "", "zstd"},
+		{"set in the config file", []string{}, "snappy", "snappy"},
+		{"env overrides the config file", []string{"zstd"}, "snappy", "zstd"},
+	}
+	for _, tt := range factors {
+		t.Run(tt.name, func(t *testing.T) {
+			os.Clearenv()
+			if len(tt.compression) == 1 {
+				os.Setenv("PERSISTENCE_OBJECTS_COMPRESSION", tt.compression[0])
+			}
+			conf := Config{Persistence: Persistence{ObjectsCompression: tt.fromFile}}
+			err := FromEnv(&conf)
+
+			require.Nil(t, err)
+			require.Equal(t, tt.expected, conf.Persistence.ObjectsCompression)
+		})
+	}
+}
+
+func TestEnvironmentSetGRPCPort(t *testing.T) {
+	factors := []struct {
+		name        string
+		port        []string
+		expected    int
+		expectedErr bool
+	}{
+		{"Valid", []string{"50052"}, 50052, false},
+		{"not given", []string{}, DefaultGRPCPort, false},
+		{"not parsable", []string{"I'm not a number"}, -1, true},
+	}
+	for _, tt := range factors {
+		t.Run(tt.name, func(t *testing.T) {
+			os.Clearenv()
+			if len(tt.port) == 1 {
+				os.Setenv("GRPC_PORT", tt.port[0])
+			}
+			conf := Config{}
+			err := FromEnv(&conf)
+
+			if tt.expectedErr {
+				require.NotNil(t, err)
+			} else {
+				require.Equal(t, tt.expected, conf.GRPC.Port)
+			}
+		})
+	}
+}
+
+func TestEnvironmentSetGRPCEnabled(t *testing.T) {
+	enabled, disabled := true, false
+	factors := []struct {
+		name     string
+		value    []string
+		fromFile *bool
+		expected bool
+	}{
+		{"not given", []string{}, nil, true},
+		{"enabled", []string{"true"}, nil, true},
+		{"disabled", []string{"false"}, nil, false},
+		{"disabled uppercase", []string{"FALSE"}, nil, false},
+		{"disabled in the config file", []string{}, &disabled, false},
+		{"enabled in the config file", []string{}, &enabled, true},
+		{"env overrides the config file", []string{"on"}, &disabled, true},
+	}
+	for _, tt := range factors {
+		t.Run(tt.name, func(t *testing.T) {
+			os.Clearenv()
+			if len(tt.value) == 1 {
+				os.Setenv("GRPC_ENABLED", tt.value[0])
+			}
+			conf := Config{GRPC: GRPC{Enabled: tt.fromFile}}
+			err := FromEnv(&conf)
+
+			require.Nil(t, err)
+			require.Equal(t, tt.expected, conf.GRPC.IsEnabled())
+		})
+	}
+}
+
+func TestEnvironmentSetBackupSchedule(t *testing.T) {
+	factors := []struct {
+		name        string
+		interval    []string
+		retention   []string
+		expected    BackupSchedule
+		expectedErr bool
+	}{
+		{"Valid", []string{"6h"}, []string{"7"}, BackupSchedule{"s3", 6 * time.Hour, 7}, false},
+		{"not given", []string{}, []string{}, BackupSchedule{"s3", DefaultBackupScheduleInterval, 0}, false},
+		{"zero interval", []string{"0s"}, []string{}, BackupSchedule{}, true},
+		{"interval not parsable", []string{"daily"}, []string{}, BackupSchedule{}, true},
+		{"negative retention", []string{}, []string{"-1"}, BackupSchedule{}, true},
+		{"retention not parsable", []string{}, []string{"all"}, BackupSchedule{}, true},
+	}
+	for _, tt := range factors {
+		t.Run(tt.name, func(t *testing.T) {
+			os.Clearenv()
+			os.Setenv("BACKUP_SCHEDULE_BACKEND", "s3")
+			if len(tt.interval) == 1 {
+				os.Setenv("BACKUP_SCHEDULE_INTERVAL", tt.interval[0])
+			}
+			if len(tt.retention) == 1 {
+				os.Setenv("BACKUP_SCHEDULE_RETENTION", tt.retention[0])
+			}
+			conf := Config{}
+			err := FromEnv(&conf)
+
+			if tt.expectedErr {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+				require.Equal(t, tt.expected, conf.BackupSchedule)
+			}
+		})
+	}
+}
+
+func TestEnvironmentAPIKey(t *testing.T) {
+	t.Run("enabled with keys and users", func(t *testing.T) {
+		os.Clearenv()
+		os.Setenv("AUTHENTICATION_APIKEY_ENABLED", "true")
+		os.Setenv("AUTHENTICATION_APIKEY_ALLOWED_KEYS", "key1,key2")
+		os.Setenv("AUTHENTICATION_APIKEY_USERS", "jane,john")
+
+		conf := Config{}
+		err := FromEnv(&conf)
+		require.Nil(t, err)
+
+		assert.Equal(t, APIKey{
+			Enabled:     true,
+			AllowedKeys: []string{"key1", "key2"},
+			Users:       []string{"jane", "john"},
+		}, conf.Authentication.APIKey)
+	})
+
+	t.Run("keys are ignored when not enabled", func(t *testing.T) {
+		os.Clearenv()
+		os.Setenv("AUTHENTICATION_APIKEY_ALLOWED_KEYS", "key1,key2")
+
+		conf := Config{}
+		err := FromEnv(&conf)
+		require.Nil(t, err)
+
+		assert.Equal(t, APIKey{}, conf.Authentication.APIKey)
+	})
+}
+
 func TestEnvironmentParseClusterConfig(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -13,6 +13,7 @@ package modules
 
 import (
 	"context"
+	"sort"
 
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/entities/models"
@@ -21,24 +22,22 @@ import (
 )
 
 // SetClassDefaults sets the module-specific defaults for the class itself, but
-// also for each prop
+// also for each prop. This applies to the vectorizer of the class as well as
+// to the vectorizers of its named vectors.
 func (p *Provider) SetClassDefaults(class *models.Class) {
-	if class.Vectorizer == "none" {
-		// the class does not use a vectorizer, nothing to do for us
-		return
+	for _, vectorizer := range classVectorizers(class) {
+		mod := p.GetByName(vectorizer)
+		cc, ok := mod.(modulecapabilities.ClassConfigurator)
+		if !ok {
+			// the module exists, but is not a class configurator, nothing to do for us
+			continue
+		}
+
+		cfg := NewClassBasedModuleConfig(class, vectorizer)
+
+		p.setPerClassConfigDefaults(class, vectorizer, cfg, cc)
+		p.setPerPropertyConfigDefaults(class, vectorizer, cfg, cc)
 	}
-
-	mod := p.GetByName(class.Vectorizer)
-	cc, ok := mod.(modulecapabilities.ClassConfigurator)
-	if !ok {
-		// the module exists, but is not a class configurator, nothing to do for us
-		return
-	}
-
-	cfg := NewClassBasedModuleConfig(class, class.Vectorizer)
-
-	p.setPerClassConfigDefaults(class, cfg, cc)
-	p.setPerPropertyConfigDefaults(class, cfg, cc)
 }
 
 // SetSinglePropertyDefaults can be used when a property is added later, e.g.
@@ -46,25 +45,54 @@ func (p *Provider) SetClassDefaults(class *models.Class) {
 func (p *Provider) SetSinglePropertyDefaults(class *models.Class,
 	prop *models.Property,
 ) {
-	if class.Vectorizer == "none" {
-		// the class does not use a vectorizer, nothing to do for us
-		return
+	for _, vectorizer := range classVectorizers(class) {
+		mod := p.GetByName(vectorizer)
+		cc, ok := mod.(modulecapabilities.ClassConfigurator)
+		if !ok {
+			// the module exists, but is not a class configurator, nothing to do for us
+			continue
+		}
+
+		cfg := NewClassBasedModuleConfig(class, vectorizer)
+
+		p.setSinglePropertyConfigDefaults(class, vectorizer, prop, cfg, cc)
 	}
+}
 
-	mod := p.GetByName(class.Vectorizer)
-	cc, ok := mod.(modulecapabilities.ClassConfigurator)
-	if !ok {
-		// the module exists, but is not a class configurator, nothing to do for us
-		return
+// classVectorizers returns the distinct vectorizer modules of the class and
+// its named vectors, classes without a vectorizer are skipped
+func classVectorizers(class *models.Class) []string {
+	var out []string
+	seen := map[string]struct{}{}
+	add := func(vectorizer string) {
+		if vectorizer == "" || vectorizer == "none" {
+			return
+		}
+		if _, ok := seen[vectorizer]; ok {
+			return
+		}
+		seen[vectorizer] = struct{}{}
+		out = append(out, vectorizer)
 	}
 
-	cfg := NewClassBasedModuleConfig(class, class.Vectorizer)
+	add(class.Vectorizer)
+
+	// sort the names, so the defaults are always applied in the same order
+	names := make([]string, 0, len(class.VectorConfig))
+	for name := range class.VectorConfig {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		add(class.VectorConfig[name].Vectorizer)
+	}
 
-	p.setSinglePropertyConfigDefaults(class, prop, cfg, cc)
+	return out
 }
 
 func (p *Provider) setPerClassConfigDefaults(class *models.Class,
-	cfg *ClassBasedModuleConfig, cc modulecapabilities.ClassConfigurator,
+	vectorizer string, cfg *ClassBasedModuleConfig,
+	cc modulecapabilities.ClassConfigurator,
 ) {
 	modDefaults := cc.ClassConfigDefaults()
 	userSpecified := cfg.Class()
@@ -81,19 +109,20 @@ func (p *Provider) setPerClassConfigDefaults(class *models.Class,
 		class.ModuleConfig = map[string]interface{}{}
 	}
 
-	class.ModuleConfig.(map[string]interface{})[class.Vectorizer] = mergedConfig
+	class.ModuleConfig.(map[string]interface{})[vectorizer] = mergedConfig
 }
 
 func (p *Provider) setPerPropertyConfigDefaults(class *models.Class,
-	cfg *ClassBasedModuleConfig, cc modulecapabilities.ClassConfigurator,
+	vectorizer string, cfg *ClassBasedModuleConfig,
+	cc modulecapabilities.ClassConfigurator,
 ) {
 	for _, prop := range class.Properties {
-		p.setSinglePropertyConfigDefaults(class, prop, cfg, cc)
+		p.setSinglePropertyConfigDefaults(class, vectorizer, prop, cfg, cc)
 	}
 }
 
 func (p *Provider) setSinglePropertyConfigDefaults(class *models.Class,
-	prop *models.Property, cfg *ClassBasedModuleConfig,
+	vectorizer string, prop *models.Property, cfg *ClassBasedModuleConfig,
 	cc modulecapabilities.ClassConfigurator,
 ) {
 	dt, _ := schema.GetPropertyDataType(class, prop.Name)
@@ -112,26 +141,23 @@ func (p *Provider) setSinglePropertyConfigDefaults(class *models.Class,
 		prop.ModuleConfig = map[string]interface{}{}
 	}
 
-	prop.ModuleConfig.(map[string]interface{})[class.Vectorizer] = mergedConfig
+	prop.ModuleConfig.(map[string]interface{})[vectorizer] = mergedConfig
 }
 
 func (p *Provider) ValidateClass(ctx context.Context, class *models.Class) error {
-	if class.Vectorizer == "none" {
-		// the class does not use a vectorizer, nothing to do for us
-		return nil
-	}
-
-	mod := p.GetByName(class.Vectorizer)
-	cc, ok := mod.(modulecapabilities.ClassConfigurator)
-	if !ok {
-		// the module exists, but is not a class configurator, nothing to do for us
-		return nil
-	}
-
-	cfg := NewClassBasedModuleConfig(class, class.Vectorizer)
-	err := cc.ValidateClass(ctx, class, cfg)
-	if err != nil {
-		return errors.Wrapf(err, "module '%s'", class.Vectorizer)
+	for _, vectorizer := range classVectorizers(class) {
+		mod := p.GetByName(vectorizer)
+		cc, ok := mod.(modulecapabilities.ClassConfigurator)
+		if !ok {
+			// the module exists, but is not a class configurator, nothing to do for us
+			continue
+		}
+
+		cfg := NewClassBasedModuleConfig(class, vectorizer)
+		err := cc.ValidateClass(ctx, class, cfg)
+		if err != nil {
+			return errors.Wrapf(err, "module '%s'", vectorizer)
+		}
 	}
 
 	return nil
@@ -244,7 +244,16 @@ func (m *Provider) moduleProvidesMultipleVectorizers(moduleType modulecapabiliti
 func (m *Provider) shouldIncludeClassArgument(class *models.Class, module string,
 	moduleType modulecapabilities.ModuleType,
 ) bool {
-	return class.Vectorizer == module || !m.isVectorizerModule(moduleType)
+	if !m.isVectorizerModule(moduleType) {
+		return true
+	}
+
+	for _, vectorizer := range classVectorizers(class) {
+		if vectorizer == module {
+			return true
+		}
+	}
+	return false
 }
 
 func (m *Provider) shouldCrossClassIncludeClassArgument(class *models.Class, module string,
@@ -589,8 +598,13 @@ func (m *Provider) VectorFromSearchParam(ctx context.Context,
 		return nil, err
 	}
 
+	vectorizer, err := m.targetVectorizer(class, params)
+	if err != nil {
+		return nil, err
+	}
+
 	for _, mod := range m.GetAll() {
-		if m.shouldIncludeClassArgument(class, mod.Name(), mod.Type()) {
+		if !m.isVectorizerModule(mod.Type()) || mod.Name() == vectorizer {
 			var moduleName string
 			var vectorSearches modulecapabilities.ArgumentVectorForParams
 			if searcher, ok := mod.(modulecapabilities.Searcher); ok {
@@ -598,8 +612,8 @@ func (m *Provider) VectorFromSearchParam(ctx context.Context,
 				vectorSearches = searcher.VectorSearches()
 			} else if searchers, ok := mod.(modulecapabilities.DependencySearcher); ok {
 				if dependencySearchers := searchers.VectorSearches(); dependencySearchers != nil {
-					moduleName = class.Vectorizer
-					vectorSearches = dependencySearchers[class.Vectorizer]
+					moduleName = vectorizer
+					vectorSearches = dependencySearchers[vectorizer]
 				}
 			}
 			if vectorSearches != nil {
@@ -618,6 +632,25 @@ func (m *Provider) VectorFromSearchParam(ctx context.Context,
 	panic("VectorFromParams was called without any known params present")
 }
 
+// targetVectorizer returns the vectorizer of the named vector the search
+// params target, or the vectorizer of the class if they don't target a
+// named vector
+func (m *Provider) targetVectorizer(class *models.Class,
+	params interface{},
+) (string, error) {
+	p, ok := params.(interface{ GetTargetVector() string })
+	if !ok || p.GetTargetVector() == "" {
+		return class.Vectorizer, nil
+	}
+
+	vectorConfig, ok := class.VectorConfig[p.GetTargetVector()]
+	if !ok {
+		return "", errors.Errorf("class %s has no named vector %q",
+			class.Class, p.GetTargetVector())
+	}
+	return vectorConfig.Vectorizer, nil
+}
+
 // CrossClassVectorFromSearchParam gets a vector for a given argument without
 // being specific to any one class and it's configuration. This is used in
 // Explore() { } for example
@@ -522,3 +522,11 @@ func (m *dummyBackupModuleWithAltNames) PutObject(ctx context.Context, backupID,
 func (m *dummyBackupModuleWithAltNames) Initialize(ctx context.Context, backupID string) error {
 	return nil
 }
+
+func (m *dummyBackupModuleWithAltNames) ListBackups(ctx context.Context) ([]string, error) {
+	return nil, nil
+}
+
+func (m *dummyBackupModuleWithAltNames) DeleteBackup(ctx context.Context, backupID string) error {
+	return nil
+}
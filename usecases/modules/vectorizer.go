@@ -19,6 +19,7 @@ import (
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/modulecapabilities"
 	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/vectorindex/flat"
 	"github.com/semi-technologies/weaviate/entities/vectorindex/hnsw"
 	"github.com/semi-technologies/weaviate/usecases/config"
 	"github.com/sirupsen/logrus"
@@ -28,8 +29,8 @@ const (
 	errorVectorizerCapability = "module %q exists, but does not provide the " +
 		"Vectorizer or ReferenceVectorizer capability"
 
-	errorVectorIndexType = "vector index config (%T) is not of type HNSW, " +
-		"but objects manager is restricted to HNSW"
+	errorVectorIndexType = "vector index config (%T) is not of type HNSW or flat, " +
+		"but objects manager is restricted to HNSW and flat"
 
 	warningVectorIgnored = "This vector will be ignored. If you meant to index " +
 		"the vector, make sure to set vectorIndexConfig.skip to 'false'. If the previous " +
@@ -79,6 +80,8 @@ func (m *Provider) UsingRef2Vec(className string) bool {
 	return false
 }
 
+// UpdateVector vectorizes the object for the default vector of its class as
+// well as for every named vector, unless the vector was provided by the user
 func (m *Provider) UpdateVector(ctx context.Context, object *models.Object,
 	findObjectFn modulecapabilities.FindObjectFn, logger logrus.FieldLogger,
 ) error {
@@ -87,18 +90,36 @@ func (m *Provider) UpdateVector(ctx context.Context, object *models.Object,
 		return err
 	}
 
+	if err := m.updateDefaultVector(ctx, class, object, findObjectFn,
+		logger); err != nil {
+		return err
+	}
+
+	return m.updateNamedVectors(ctx, class, object, findObjectFn)
+}
+
+func (m *Provider) updateDefaultVector(ctx context.Context, class *models.Class,
+	object *models.Object, findObjectFn modulecapabilities.FindObjectFn,
+	logger logrus.FieldLogger,
+) error {
 	vectorizerName, idxCfg, err := m.getClassVectorizer(object.Class)
 	if err != nil {
 		return err
 	}
 
-	hnswConfig, ok := idxCfg.(hnsw.UserConfig)
-	if !ok {
+	// only hnsw can skip indexing, a flat index always reads the vectors
+	// straight from the object store
+	var skip bool
+	switch typed := idxCfg.(type) {
+	case hnsw.UserConfig:
+		skip = typed.Skip
+	case flat.UserConfig:
+	default:
 		return fmt.Errorf(errorVectorIndexType, idxCfg)
 	}
 
 	if vectorizerName == config.VectorizerModuleNone {
-		if hnswConfig.Skip && len(object.Vector) > 0 {
+		if skip && len(object.Vector) > 0 {
 			logger.WithField("className", object.Class).
 				Warningf(warningSkipVectorProvided)
 		}
@@ -106,7 +127,7 @@ func (m *Provider) UpdateVector(ctx context.Context, object *models.Object,
 		return nil
 	}
 
-	if hnswConfig.Skip {
+	if skip {
 		logger.WithField("className", object.Class).
 			WithField("vectorizer", vectorizerName).
 			Warningf(warningSkipVectorGenerated, vectorizerName)
@@ -144,6 +165,82 @@ func (m *Provider) UpdateVector(ctx context.Context, object *models.Object,
 	return nil
 }
 
+func (m *Provider) updateNamedVectors(ctx context.Context, class *models.Class,
+	object *models.Object, findObjectFn modulecapabilities.FindObjectFn,
+) error {
+	for name, vectorConfig := range class.VectorConfig {
+		if vectorConfig.Vectorizer == config.VectorizerModuleNone ||
+			len(object.Vectors[name]) > 0 {
+			continue
+		}
+
+		vector, err := m.vectorizeNamedVector(ctx, class, vectorConfig, object,
+			findObjectFn)
+		if err != nil {
+			return fmt.Errorf("update named vector %q: %w", name, err)
+		}
+
+		if object.Vectors == nil {
+			object.Vectors = models.Vectors{}
+		}
+		object.Vectors[name] = vector
+	}
+
+	return nil
+}
+
+// vectorizeNamedVector vectorizes a copy of the object which only contains the
+// source properties of the named vector, so that neither the default vector
+// nor the properties of the original object are touched by the module
+func (m *Provider) vectorizeNamedVector(ctx context.Context,
+	class *models.Class, vectorConfig models.VectorConfig,
+	object *models.Object, findObjectFn modulecapabilities.FindObjectFn,
+) ([]float32, error) {
+	if err := m.ValidateVectorizer(vectorConfig.Vectorizer); err != nil {
+		return nil, err
+	}
+
+	cfg := NewClassBasedModuleConfig(class, vectorConfig.Vectorizer)
+	input := &models.Object{
+		Class:      object.Class,
+		ID:         object.ID,
+		Tenant:     object.Tenant,
+		Properties: sourceProperties(object.Properties, vectorConfig.SourceProperties),
+	}
+
+	switch vectorizer := m.GetByName(vectorConfig.Vectorizer).(type) {
+	case modulecapabilities.Vectorizer:
+		if err := vectorizer.VectorizeObject(ctx, input, cfg); err != nil {
+			return nil, err
+		}
+	case modulecapabilities.ReferenceVectorizer:
+		if err := vectorizer.VectorizeObject(ctx, input, cfg,
+			findObjectFn); err != nil {
+			return nil, err
+		}
+	}
+
+	return input.Vector, nil
+}
+
+func sourceProperties(props models.PropertySchema,
+	names []string,
+) models.PropertySchema {
+	asMap, ok := props.(map[string]interface{})
+	if !ok || len(names) == 0 {
+		return props
+	}
+
+	out := make(map[string]interface{}, len(names))
+	for _, name := range names {
+		if value, ok := asMap[name]; ok {
+			out[name] = value
+		}
+	}
+
+	return out
+}
+
 func (m *Provider) VectorizerName(className string) (string, error) {
 	name, _, err := m.getClassVectorizer(className)
 	if err != nil {
@@ -241,12 +241,101 @@ func TestProvider_UpdateVector(t *testing.T) {
 
 		obj := &models.Object{Class: className, ID: newUUID()}
 		err := p.UpdateVector(ctx, obj, repo.Object, logger)
-		expectedErr := "vector index config (struct {}) is not of type HNSW, " +
-			"but objects manager is restricted to HNSW"
+		expectedErr := "vector index config (struct {}) is not of type HNSW or flat, " +
+			"but objects manager is restricted to HNSW and flat"
 		assert.EqualError(t, err, expectedErr)
 	})
 }
 
+func TestProvider_UpdateVector_NamedVectors(t *testing.T) {
+	ctx := context.Background()
+	modName := "some-vzr"
+	className := "SomeClass"
+	sch := schema.Schema{Objects: &models.Schema{
+		Classes: []*models.Class{{
+			Class:      className,
+			Vectorizer: "none",
+			ModuleConfig: map[string]interface{}{
+				modName: struct{}{},
+			},
+			VectorIndexConfig: hnsw.UserConfig{},
+			VectorConfig: map[string]models.VectorConfig{
+				"title": {
+					Vectorizer:        modName,
+					VectorIndexConfig: hnsw.UserConfig{},
+					SourceProperties:  []string{"title"},
+				},
+				"custom": {
+					Vectorizer:        "none",
+					VectorIndexConfig: hnsw.UserConfig{},
+				},
+			},
+		}},
+	}}
+	repo := &fakeObjectsRepo{}
+	logger, _ := test.NewNullLogger()
+
+	p := NewProvider()
+	p.Register(newDummyModule(modName, modulecapabilities.Text2Vec))
+	p.SetSchemaGetter(&fakeSchemaGetter{sch})
+
+	t.Run("vectorizes the named vectors with a vectorizer", func(t *testing.T) {
+		obj := &models.Object{
+			Class: className,
+			ID:    newUUID(),
+			Properties: map[string]interface{}{
+				"title": "journey",
+				"body":  "a long way",
+			},
+		}
+		err := p.UpdateVector(ctx, obj, repo.Object, logger)
+		assert.Nil(t, err)
+
+		assert.Nil(t, obj.Vector)
+		assert.Equal(t, models.Vectors{
+			"title": []float32{1, 2, 3},
+		}, obj.Vectors)
+		assert.Equal(t, map[string]interface{}{
+			"title": "journey",
+			"body":  "a long way",
+		}, obj.Properties, "the original properties are untouched")
+	})
+
+	t.Run("keeps named vectors provided by the user", func(t *testing.T) {
+		obj := &models.Object{
+			Class: className,
+			ID:    newUUID(),
+			Vectors: models.Vectors{
+				"title":  []float32{4, 5, 6},
+				"custom": []float32{7, 8},
+			},
+		}
+		err := p.UpdateVector(ctx, obj, repo.Object, logger)
+		assert.Nil(t, err)
+
+		assert.Equal(t, models.Vectors{
+			"title":  []float32{4, 5, 6},
+			"custom": []float32{7, 8},
+		}, obj.Vectors)
+	})
+}
+
+func TestSourceProperties(t *testing.T) {
+	props := map[string]interface{}{
+		"title": "journey",
+		"body":  "a long way",
+	}
+
+	t.Run("without source properties", func(t *testing.T) {
+		assert.Equal(t, props, sourceProperties(props, nil))
+	})
+
+	t.Run("with source properties", func(t *testing.T) {
+		assert.Equal(t, map[string]interface{}{"title": "journey"},
+			sourceProperties(props, []string{"title", "missing"}))
+	})
+}
+
 func newUUID() strfmt.UUID {
 	return strfmt.UUID(uuid.NewString())
 }
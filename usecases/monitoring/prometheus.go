@@ -30,6 +30,8 @@ type PrometheusMetrics struct {
 	LSMSegmentSize                     *prometheus.GaugeVec
 	LSMMemtableSize                    *prometheus.GaugeVec
 	LSMMemtableDurations               *prometheus.HistogramVec
+	LSMCompressionBytes                *prometheus.CounterVec
+	LSMCompressionRatio                *prometheus.GaugeVec
 	VectorIndexTombstones              *prometheus.GaugeVec
 	VectorIndexTombstoneCleanupThreads *prometheus.GaugeVec
 	VectorIndexTombstoneCleanedCount   *prometheus.CounterVec
@@ -137,6 +139,14 @@ func newPrometheusMetrics() *PrometheusMetrics {
 			Help:    "Time in ms for a bucket operation to complete",
 			Buckets: msBuckets,
 		}, []string{"strategy", "class_name", "shard_name", "path", "operation"}),
+		LSMCompressionBytes: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "lsm_compression_bytes",
+			Help: "Bytes of segment data before (unit=raw) and after (unit=compressed) block compression",
+		}, []string{"strategy", "class_name", "shard_name", "path", "operation", "unit"}),
+		LSMCompressionRatio: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "lsm_compression_ratio",
+			Help: "Ratio of raw to compressed segment data of the most recently written segment",
+		}, []string{"strategy", "class_name", "shard_name", "path"}),
 
 		VectorIndexTombstones: promauto.NewGaugeVec(prometheus.GaugeOpts{
 			Name: "vector_index_tombstones",
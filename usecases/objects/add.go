@@ -17,6 +17,7 @@ import (
 
 	"github.com/go-openapi/strfmt"
 	"github.com/google/uuid"
+	"github.com/semi-technologies/weaviate/entities/additional"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/usecases/objects/validation"
@@ -32,7 +33,7 @@ type schemaManager interface {
 
 // AddObject Class Instance to the connected DB.
 func (m *Manager) AddObject(ctx context.Context, principal *models.Principal,
-	object *models.Object,
+	object *models.Object, repl *additional.ReplicationProperties,
 ) (*models.Object, error) {
 	err := m.authorizer.Authorize(principal, "create", "objects")
 	if err != nil {
@@ -48,11 +49,11 @@ func (m *Manager) AddObject(ctx context.Context, principal *models.Principal,
 	m.metrics.AddObjectInc()
 	defer m.metrics.AddObjectDec()
 
-	return m.addObjectToConnectorAndSchema(ctx, principal, object)
+	return m.addObjectToConnectorAndSchema(ctx, principal, object, repl)
 }
 
 func (m *Manager) checkIDOrAssignNew(ctx context.Context, class string,
-	id strfmt.UUID,
+	id strfmt.UUID, repl *additional.ReplicationProperties, tenant string,
 ) (strfmt.UUID, error) {
 	if id == "" {
 		newID, err := generateUUID()
@@ -63,18 +64,22 @@ func (m *Manager) checkIDOrAssignNew(ctx context.Context, class string,
 	}
 
 	// only validate ID uniqueness if explicitly set
-	if ok, err := m.vectorRepo.Exists(ctx, class, id); ok {
+	if ok, err := m.vectorRepo.Exists(ctx, class, id, repl, tenant); ok {
 		return "", NewErrInvalidUserInput("id '%s' already exists", id)
 	} else if err != nil {
+		if isMultiTenancyErr(err) {
+			return "", NewErrInvalidUserInput("%v", err)
+		}
 		return "", NewErrInternal(err.Error())
 	}
 	return id, nil
 }
 
 func (m *Manager) addObjectToConnectorAndSchema(ctx context.Context, principal *models.Principal,
-	object *models.Object,
+	object *models.Object, repl *additional.ReplicationProperties,
 ) (*models.Object, error) {
-	id, err := m.checkIDOrAssignNew(ctx, object.Class, object.ID)
+	id, err := m.checkIDOrAssignNew(ctx, object.Class, object.ID, repl,
+		object.Tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -85,7 +90,7 @@ func (m *Manager) addObjectToConnectorAndSchema(ctx context.Context, principal *
 		return nil, NewErrInvalidUserInput("invalid object: %v", err)
 	}
 
-	err = m.validateObject(ctx, principal, object)
+	err = m.validateObject(ctx, principal, object, repl)
 	if err != nil {
 		return nil, NewErrInvalidUserInput("invalid object: %v", err)
 	}
@@ -102,15 +107,20 @@ func (m *Manager) addObjectToConnectorAndSchema(ctx context.Context, principal *
 		return nil, err
 	}
 
-	err = m.vectorRepo.PutObject(ctx, object, object.Vector)
+	err = m.vectorRepo.PutObject(ctx, object, object.Vector, repl)
 	if err != nil {
+		if isMultiTenancyErr(err) {
+			return nil, NewErrInvalidUserInput("put object: %v", err)
+		}
 		return nil, fmt.Errorf("put object: %s", err)
 	}
 
 	return object, nil
 }
 
-func (m *Manager) validateObject(ctx context.Context, principal *models.Principal, object *models.Object) error {
+func (m *Manager) validateObject(ctx context.Context, principal *models.Principal,
+	object *models.Object, repl *additional.ReplicationProperties,
+) error {
 	// Validate schema given in body with the weaviate schema
 	if _, err := uuid.Parse(object.ID.String()); err != nil {
 		return err
@@ -121,5 +131,5 @@ func (m *Manager) validateObject(ctx context.Context, principal *models.Principa
 		return err
 	}
 
-	return validation.New(s, m.vectorRepo.Exists, m.config).Object(ctx, object)
+	return validation.New(s, m.vectorRepo.Exists, m.config, repl).Object(ctx, object)
 }
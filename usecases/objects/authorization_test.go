@@ -84,7 +84,7 @@ func Test_Kinds_Authorization(t *testing.T) {
 		},
 		{
 			methodName:       "MergeObject",
-			additionalArgs:   []interface{}{&models.Object{Class: "class", ID: "foo"}},
+			additionalArgs:   []interface{}{&models.Object{Class: "class", ID: "foo"}, (*additional.ReplicationProperties)(nil)},
 			expectedVerb:     "update",
 			expectedResource: "objects/class/foo",
 		},
@@ -206,21 +206,21 @@ func Test_BatchKinds_Authorization(t *testing.T) {
 
 		{
 			methodName:       "AddObjects",
-			additionalArgs:   []interface{}{[]*models.Object{}, []*string{}},
+			additionalArgs:   []interface{}{[]*models.Object{}, []*string{}, (*additional.ReplicationProperties)(nil)},
 			expectedVerb:     "create",
 			expectedResource: "batch/objects",
 		},
 
 		{
 			methodName:       "AddReferences",
-			additionalArgs:   []interface{}{[]*models.BatchReference{}},
+			additionalArgs:   []interface{}{[]*models.BatchReference{}, (*additional.ReplicationProperties)(nil)},
 			expectedVerb:     "update",
 			expectedResource: "batch/*",
 		},
 
 		{
 			methodName:       "DeleteObjects",
-			additionalArgs:   []interface{}{&models.BatchDeleteMatch{}, (*bool)(nil), (*string)(nil)},
+			additionalArgs:   []interface{}{&models.BatchDeleteMatch{}, (*bool)(nil), (*string)(nil), (*additional.ReplicationProperties)(nil), ""},
 			expectedVerb:     "delete",
 			expectedResource: "batch/objects",
 		},
@@ -17,6 +17,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/filterext"
+	"github.com/semi-technologies/weaviate/entities/additional"
 	"github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema"
@@ -30,6 +31,7 @@ const (
 // DeleteObjects deletes objects in batch based on the match filter
 func (b *BatchManager) DeleteObjects(ctx context.Context, principal *models.Principal,
 	match *models.BatchDeleteMatch, dryRun *bool, output *string,
+	repl *additional.ReplicationProperties, tenant string,
 ) (*BatchDeleteResponse, error) {
 	err := b.authorizer.Authorize(principal, "delete", "batch/objects")
 	if err != nil {
@@ -45,19 +47,24 @@ func (b *BatchManager) DeleteObjects(ctx context.Context, principal *models.Prin
 	b.metrics.BatchDeleteInc()
 	defer b.metrics.BatchDeleteDec()
 
-	return b.deleteObjects(ctx, principal, match, dryRun, output)
+	return b.deleteObjects(ctx, principal, match, dryRun, output, repl, tenant)
 }
 
 func (b *BatchManager) deleteObjects(ctx context.Context, principal *models.Principal,
 	match *models.BatchDeleteMatch, dryRun *bool, output *string,
+	repl *additional.ReplicationProperties, tenant string,
 ) (*BatchDeleteResponse, error) {
 	params, err := b.validateBatchDelete(ctx, principal, match, dryRun, output)
 	if err != nil {
 		return nil, NewErrInvalidUserInput("validate: %v", err)
 	}
+	params.Tenant = tenant
 
-	result, err := b.vectorRepo.BatchDeleteObjects(ctx, *params)
+	result, err := b.vectorRepo.BatchDeleteObjects(ctx, *params, repl)
 	if err != nil {
+		if isMultiTenancyErr(err) {
+			return nil, NewErrInvalidUserInput("batch delete objects: %v", err)
+		}
 		return nil, NewErrInternal("batch delete objects: %#v", err)
 	}
 
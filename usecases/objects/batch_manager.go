@@ -14,6 +14,7 @@ package objects
 import (
 	"context"
 
+	"github.com/semi-technologies/weaviate/entities/additional"
 	"github.com/semi-technologies/weaviate/usecases/config"
 	"github.com/semi-technologies/weaviate/usecases/monitoring"
 	"github.com/sirupsen/logrus"
@@ -39,9 +40,12 @@ type BatchVectorRepo interface {
 }
 
 type batchRepoNew interface {
-	BatchPutObjects(ctx context.Context, objects BatchObjects) (BatchObjects, error)
-	BatchDeleteObjects(ctx context.Context, params BatchDeleteParams) (BatchDeleteResult, error)
-	AddBatchReferences(ctx context.Context, references BatchReferences) (BatchReferences, error)
+	BatchPutObjects(ctx context.Context, objects BatchObjects,
+		repl *additional.ReplicationProperties) (BatchObjects, error)
+	BatchDeleteObjects(ctx context.Context, params BatchDeleteParams,
+		repl *additional.ReplicationProperties) (BatchDeleteResult, error)
+	AddBatchReferences(ctx context.Context, references BatchReferences,
+		repl *additional.ReplicationProperties) (BatchReferences, error)
 }
 
 // NewBatchManager creates a new manager
@@ -55,6 +55,7 @@ type BatchReference struct {
 	Err           error               `json:"err"`
 	From          *crossref.RefSource `json:"from"`
 	To            *crossref.Ref       `json:"to"`
+	Tenant        string              `json:"tenant,omitempty"`
 }
 
 // BatchReferences groups many Reference items together. The order matches the
@@ -74,6 +75,7 @@ type BatchDeleteParams struct {
 	Filters   *filters.LocalFilter `json:"filters"`
 	DryRun    bool
 	Output    string
+	Tenant    string `json:"tenant,omitempty"`
 }
 
 type BatchDeleteResult struct {
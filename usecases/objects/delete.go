@@ -24,7 +24,10 @@ import (
 //
 // if class == "" it will delete all object with same id regardless of the class name.
 // This is due to backward compatibility reasons and should be removed in the future
-func (m *Manager) DeleteObject(ctx context.Context, principal *models.Principal, class string, id strfmt.UUID) error {
+func (m *Manager) DeleteObject(ctx context.Context, principal *models.Principal,
+	class string, id strfmt.UUID, repl *additional.ReplicationProperties,
+	tenant string,
+) error {
 	path := fmt.Sprintf("objects/%s/%s", class, id)
 	if class == "" {
 		path = fmt.Sprintf("objects/%s", id)
@@ -44,18 +47,21 @@ func (m *Manager) DeleteObject(ctx context.Context, principal *models.Principal,
 	defer m.metrics.DeleteObjectDec()
 
 	if class == "" { // deprecated
-		return m.deleteObjectFromRepo(ctx, id)
+		return m.deleteObjectFromRepo(ctx, id, repl)
 	}
 
-	ok, err := m.vectorRepo.Exists(ctx, class, id)
+	ok, err := m.vectorRepo.Exists(ctx, class, id, repl, tenant)
 	if err != nil {
+		if isMultiTenancyErr(err) {
+			return NewErrMultiTenancy(err)
+		}
 		return NewErrInternal("check object existence: %v", err)
 	}
 	if !ok {
 		return NewErrNotFound("object %v could not be found", path)
 	}
 
-	err = m.vectorRepo.DeleteObject(ctx, class, id)
+	err = m.vectorRepo.DeleteObject(ctx, class, id, repl, tenant)
 	if err != nil {
 		return NewErrInternal("could not delete object from vector repo: %v", err)
 	}
@@ -65,14 +71,16 @@ func (m *Manager) DeleteObject(ctx context.Context, principal *models.Principal,
 // deleteObjectFromRepo deletes objects with same id and different classes.
 //
 // Deprecated
-func (m *Manager) deleteObjectFromRepo(ctx context.Context, id strfmt.UUID) error {
+func (m *Manager) deleteObjectFromRepo(ctx context.Context, id strfmt.UUID,
+	repl *additional.ReplicationProperties,
+) error {
 	// There might be a situation to have UUIDs which are not unique across classes.
 	// Added loop in order to delete all of the objects with given UUID across all classes.
 	// This change is added in response to this issue:
 	// https://github.com/semi-technologies/weaviate/issues/1836
 	deleteCounter := 0
 	for {
-		objectRes, err := m.getObjectFromRepo(ctx, "", id, additional.Properties{})
+		objectRes, err := m.getObjectFromRepo(ctx, "", id, additional.Properties{}, repl, "")
 		if err != nil {
 			_, ok := err.(ErrNotFound)
 			if ok {
@@ -85,7 +93,7 @@ func (m *Manager) deleteObjectFromRepo(ctx context.Context, id strfmt.UUID) erro
 		}
 
 		object := objectRes.Object()
-		err = m.vectorRepo.DeleteObject(ctx, object.Class, id)
+		err = m.vectorRepo.DeleteObject(ctx, object.Class, id, repl, "")
 		if err != nil {
 			return NewErrInternal("could not delete object from vector repo: %v", err)
 		}
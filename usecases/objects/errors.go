@@ -12,6 +12,7 @@
 package objects
 
 import (
+	"errors"
 	"fmt"
 )
 
@@ -92,3 +93,28 @@ func (e ErrNotFound) Error() string {
 func NewErrNotFound(format string, args ...interface{}) ErrNotFound {
 	return ErrNotFound{msg: fmt.Sprintf(format, args...)}
 }
+
+// ErrMultiTenancy indicates that the tenant of a request does not match the
+// multi-tenancy configuration of the targeted class
+type ErrMultiTenancy struct {
+	err error
+}
+
+func (e ErrMultiTenancy) Error() string {
+	return e.err.Error()
+}
+
+func (e ErrMultiTenancy) Unwrap() error {
+	return e.err
+}
+
+// NewErrMultiTenancy with error signature
+func NewErrMultiTenancy(err error) ErrMultiTenancy {
+	return ErrMultiTenancy{err: err}
+}
+
+// isMultiTenancyErr returns true if err was caused by a request that does not
+// match the multi-tenancy configuration of the class
+func isMultiTenancyErr(err error) bool {
+	return errors.As(err, &ErrMultiTenancy{})
+}
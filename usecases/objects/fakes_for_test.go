@@ -128,7 +128,7 @@ type fakeVectorRepo struct {
 }
 
 func (f *fakeVectorRepo) Exists(ctx context.Context, class string,
-	id strfmt.UUID,
+	id strfmt.UUID, repl *additional.ReplicationProperties, tenant string,
 ) (bool, error) {
 	args := f.Called(class, id)
 	return args.Bool(0), args.Error(1)
@@ -136,6 +136,7 @@ func (f *fakeVectorRepo) Exists(ctx context.Context, class string,
 
 func (f *fakeVectorRepo) Object(ctx context.Context, cls string,
 	id strfmt.UUID, props search.SelectProperties, additional additional.Properties,
+	repl *additional.ReplicationProperties, tenant string,
 ) (*search.Result, error) {
 	args := f.Called(cls, id, props, additional)
 	if args.Get(0) != nil {
@@ -168,34 +169,43 @@ func (f *fakeVectorRepo) Query(ctx context.Context, q *QueryInput) (search.Resul
 }
 
 func (f *fakeVectorRepo) PutObject(ctx context.Context,
-	concept *models.Object, vector []float32,
+	concept *models.Object, vector []float32, repl *additional.ReplicationProperties,
 ) error {
 	args := f.Called(concept, vector)
 	return args.Error(0)
 }
 
-func (f *fakeVectorRepo) BatchPutObjects(ctx context.Context, batch BatchObjects) (BatchObjects, error) {
+func (f *fakeVectorRepo) BatchPutObjects(ctx context.Context, batch BatchObjects,
+	repl *additional.ReplicationProperties,
+) (BatchObjects, error) {
 	args := f.Called(batch)
 	return batch, args.Error(0)
 }
 
-func (f *fakeVectorRepo) AddBatchReferences(ctx context.Context, batch BatchReferences) (BatchReferences, error) {
+func (f *fakeVectorRepo) AddBatchReferences(ctx context.Context, batch BatchReferences,
+	repl *additional.ReplicationProperties,
+) (BatchReferences, error) {
 	args := f.Called(batch)
 	return batch, args.Error(0)
 }
 
-func (f *fakeVectorRepo) BatchDeleteObjects(ctx context.Context, params BatchDeleteParams) (BatchDeleteResult, error) {
+func (f *fakeVectorRepo) BatchDeleteObjects(ctx context.Context, params BatchDeleteParams,
+	repl *additional.ReplicationProperties,
+) (BatchDeleteResult, error) {
 	args := f.Called(params)
 	return args.Get(0).(BatchDeleteResult), args.Error(1)
 }
 
-func (f *fakeVectorRepo) Merge(ctx context.Context, merge MergeDocument) error {
+func (f *fakeVectorRepo) Merge(ctx context.Context, merge MergeDocument,
+	repl *additional.ReplicationProperties,
+) error {
 	args := f.Called(merge)
 	return args.Error(0)
 }
 
 func (f *fakeVectorRepo) DeleteObject(ctx context.Context,
-	className string, id strfmt.UUID,
+	className string, id strfmt.UUID, repl *additional.ReplicationProperties,
+	tenant string,
 ) error {
 	args := f.Called(className, id)
 	return args.Error(0)
@@ -203,7 +213,7 @@ func (f *fakeVectorRepo) DeleteObject(ctx context.Context,
 
 func (f *fakeVectorRepo) AddReference(ctx context.Context,
 	class string, source strfmt.UUID, prop string,
-	ref *models.SingleRef,
+	ref *models.SingleRef, repl *additional.ReplicationProperties, tenant string,
 ) error {
 	args := f.Called(class, source, prop, ref)
 	return args.Error(0)
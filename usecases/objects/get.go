@@ -27,7 +27,8 @@ import (
 
 // GetObject Class from the connected DB
 func (m *Manager) GetObject(ctx context.Context, principal *models.Principal, class string,
-	id strfmt.UUID, additional additional.Properties,
+	id strfmt.UUID, additional additional.Properties, repl *additional.ReplicationProperties,
+	tenant string,
 ) (*models.Object, error) {
 	path := fmt.Sprintf("objects/%s", id)
 	if class != "" {
@@ -47,7 +48,7 @@ func (m *Manager) GetObject(ctx context.Context, principal *models.Principal, cl
 	m.metrics.GetObjectInc()
 	defer m.metrics.GetObjectDec()
 
-	res, err := m.getObjectFromRepo(ctx, class, id, additional)
+	res, err := m.getObjectFromRepo(ctx, class, id, additional, repl, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -95,7 +96,7 @@ func (m *Manager) GetObjectsClass(ctx context.Context, principal *models.Princip
 	m.metrics.GetObjectInc()
 	defer m.metrics.GetObjectDec()
 
-	res, err := m.getObjectFromRepo(ctx, "", id, additional.Properties{})
+	res, err := m.getObjectFromRepo(ctx, "", id, additional.Properties{}, nil, "")
 	if err != nil {
 		return nil, err
 	}
@@ -109,14 +110,18 @@ func (m *Manager) GetObjectsClass(ctx context.Context, principal *models.Princip
 }
 
 func (m *Manager) getObjectFromRepo(ctx context.Context, class string, id strfmt.UUID,
-	adds additional.Properties,
+	adds additional.Properties, repl *additional.ReplicationProperties,
+	tenant string,
 ) (res *search.Result, err error) {
 	if class != "" {
-		res, err = m.vectorRepo.Object(ctx, class, id, search.SelectProperties{}, adds)
+		res, err = m.vectorRepo.Object(ctx, class, id, search.SelectProperties{}, adds, repl, tenant)
 	} else {
 		res, err = m.vectorRepo.ObjectByID(ctx, id, search.SelectProperties{}, adds)
 	}
 	if err != nil {
+		if isMultiTenancyErr(err) {
+			return nil, NewErrMultiTenancy(err)
+		}
 		return nil, NewErrInternal("repo: object by id: %v", err)
 	}
 
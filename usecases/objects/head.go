@@ -16,11 +16,14 @@ import (
 	"fmt"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/additional"
 	"github.com/semi-technologies/weaviate/entities/models"
 )
 
 // HeadObject check object's existence in the conncected DB
-func (m *Manager) HeadObject(ctx context.Context, principal *models.Principal, class string, id strfmt.UUID) (bool, *Error) {
+func (m *Manager) HeadObject(ctx context.Context, principal *models.Principal, class string,
+	id strfmt.UUID, repl *additional.ReplicationProperties, tenant string,
+) (bool, *Error) {
 	path := fmt.Sprintf("objects/%s", id)
 	if class != "" {
 		path = fmt.Sprintf("objects/%s/%s", class, id)
@@ -38,8 +41,11 @@ func (m *Manager) HeadObject(ctx context.Context, principal *models.Principal, c
 	m.metrics.HeadObjectInc()
 	defer m.metrics.HeadObjectDec()
 
-	ok, err := m.vectorRepo.Exists(ctx, class, id)
+	ok, err := m.vectorRepo.Exists(ctx, class, id, repl, tenant)
 	if err != nil {
+		if isMultiTenancyErr(err) {
+			return false, &Error{"repo.exists", StatusBadRequest, err}
+		}
 		return false, &Error{"repo.exists", StatusInternalServerError, err}
 	}
 	return ok, nil
@@ -88,20 +88,26 @@ type authorizer interface {
 }
 
 type VectorRepo interface {
-	PutObject(ctx context.Context, concept *models.Object, vector []float32) error
-	DeleteObject(ctx context.Context, className string, id strfmt.UUID) error
+	PutObject(ctx context.Context, concept *models.Object, vector []float32,
+		repl *additional.ReplicationProperties) error
+	DeleteObject(ctx context.Context, className string, id strfmt.UUID,
+		repl *additional.ReplicationProperties, tenant string) error
 	// Object returns object of the specified class giving by its id
 	Object(ctx context.Context, class string, id strfmt.UUID, props search.SelectProperties,
-		additional additional.Properties) (*search.Result, error)
+		additional additional.Properties,
+		repl *additional.ReplicationProperties, tenant string) (*search.Result, error)
 	// Exists returns true if an object of a giving class exists
-	Exists(ctx context.Context, class string, id strfmt.UUID) (bool, error)
+	Exists(ctx context.Context, class string, id strfmt.UUID,
+		repl *additional.ReplicationProperties, tenant string) (bool, error)
 	ObjectByID(ctx context.Context, id strfmt.UUID, props search.SelectProperties,
 		additional additional.Properties) (*search.Result, error)
 	ObjectSearch(ctx context.Context, offset, limit int, filters *filters.LocalFilter,
 		sort []filters.Sort, additional additional.Properties) (search.Results, error)
 	AddReference(ctx context.Context, className string,
-		source strfmt.UUID, propName string, ref *models.SingleRef) error
-	Merge(ctx context.Context, merge MergeDocument) error
+		source strfmt.UUID, propName string, ref *models.SingleRef,
+		repl *additional.ReplicationProperties, tenant string) error
+	Merge(ctx context.Context, merge MergeDocument,
+		repl *additional.ReplicationProperties) error
 	Query(context.Context, *QueryInput) (search.Results, *Error)
 }
 
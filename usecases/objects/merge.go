@@ -30,11 +30,15 @@ type MergeDocument struct {
 	PrimitiveSchema      map[string]interface{}      `json:"primitiveSchema"`
 	References           BatchReferences             `json:"references"`
 	Vector               []float32                   `json:"vector"`
+	Vectors              map[string][]float32        `json:"vectors,omitempty"`
 	UpdateTime           int64                       `json:"updateTime"`
 	AdditionalProperties models.AdditionalProperties `json:"additionalProperties"`
+	Tenant               string                      `json:"tenant,omitempty"`
 }
 
-func (m *Manager) MergeObject(ctx context.Context, principal *models.Principal, updates *models.Object) *Error {
+func (m *Manager) MergeObject(ctx context.Context, principal *models.Principal,
+	updates *models.Object, repl *additional.ReplicationProperties,
+) *Error {
 	if err := m.validateInputs(updates); err != nil {
 		return &Error{"bad request", StatusBadRequest, err}
 	}
@@ -47,28 +51,34 @@ func (m *Manager) MergeObject(ctx context.Context, principal *models.Principal,
 	m.metrics.MergeObjectInc()
 	defer m.metrics.MergeObjectDec()
 
-	if err := m.validateObject(ctx, principal, updates); err != nil {
+	if err := m.validateObject(ctx, principal, updates, repl); err != nil {
 		return &Error{"bad request", StatusBadRequest, err}
 	}
 	if updates.Properties == nil {
 		updates.Properties = map[string]interface{}{}
 	}
-	obj, err := m.vectorRepo.Object(ctx, cls, id, nil, additional.Properties{})
+	obj, err := m.vectorRepo.Object(ctx, cls, id, nil, additional.Properties{}, repl,
+		updates.Tenant)
 	if err != nil {
+		if isMultiTenancyErr(err) {
+			return &Error{"repo.object", StatusBadRequest, err}
+		}
 		return &Error{"repo.object", StatusInternalServerError, err}
 	}
 	if obj == nil {
 		return &Error{"not found", StatusNotFound, err}
 	}
-	return m.patchObject(ctx, principal, obj, updates)
+	return m.patchObject(ctx, principal, obj, updates, repl)
 }
 
 // patchObject patches an existing object obj with updates
-func (m *Manager) patchObject(ctx context.Context, principal *models.Principal, obj *search.Result, updates *models.Object) *Error {
+func (m *Manager) patchObject(ctx context.Context, principal *models.Principal,
+	obj *search.Result, updates *models.Object, repl *additional.ReplicationProperties,
+) *Error {
 	cls, id := updates.Class, updates.ID
 	primitive, refs := m.splitPrimitiveAndRefs(updates.Properties.(map[string]interface{}), cls, id)
 	objWithVec, err := m.mergeObjectSchemaAndVectorize(ctx, cls, obj.Schema,
-		primitive, principal, obj.Vector, updates.Vector)
+		primitive, principal, obj.Vector, updates.Vector, obj.Vectors, updates.Vectors)
 	if err != nil {
 		return &Error{"merge and vectorize", StatusInternalServerError, err}
 	}
@@ -79,13 +89,21 @@ func (m *Manager) patchObject(ctx context.Context, principal *models.Principal,
 		References:      refs,
 		Vector:          objWithVec.Vector,
 		UpdateTime:      m.timeSource.Now(),
+		Tenant:          updates.Tenant,
+	}
+
+	if len(objWithVec.Vectors) > 0 {
+		mergeDoc.Vectors = make(map[string][]float32, len(objWithVec.Vectors))
+		for name, vector := range objWithVec.Vectors {
+			mergeDoc.Vectors[name] = vector
+		}
 	}
 
 	if objWithVec.Additional != nil {
 		mergeDoc.AdditionalProperties = objWithVec.Additional
 	}
 
-	if err := m.vectorRepo.Merge(ctx, mergeDoc); err != nil {
+	if err := m.vectorRepo.Merge(ctx, mergeDoc, repl); err != nil {
 		return &Error{"repo.merge", StatusInternalServerError, err}
 	}
 
@@ -108,6 +126,7 @@ func (m *Manager) validateInputs(updates *models.Object) error {
 func (m *Manager) mergeObjectSchemaAndVectorize(ctx context.Context, className string,
 	old interface{}, new map[string]interface{},
 	principal *models.Principal, oldVec, newVec []float32,
+	oldVecs map[string][]float32, newVecs models.Vectors,
 ) (*models.Object, error) {
 	var merged map[string]interface{}
 	var vector []float32
@@ -138,9 +157,19 @@ func (m *Manager) mergeObjectSchemaAndVectorize(ctx context.Context, className s
 		}
 	}
 
+	vectors, err := m.mergeNamedVectors(principal, className, oldVecs, newVecs)
+	if err != nil {
+		return nil, err
+	}
+
 	// Note: vector could be a nil vector in case a vectorizer is configered,
 	// then the vectorizer will set it
-	obj := &models.Object{Class: className, Properties: merged, Vector: vector}
+	obj := &models.Object{
+		Class:      className,
+		Properties: merged,
+		Vector:     vector,
+		Vectors:    vectors,
+	}
 
 	if err := m.modulesProvider.UpdateVector(ctx, obj, m.findObject, m.logger); err != nil {
 		return nil, err
@@ -149,6 +178,44 @@ func (m *Manager) mergeObjectSchemaAndVectorize(ctx context.Context, className s
 	return obj, nil
 }
 
+// mergeNamedVectors keeps the previous named vectors, which can't be
+// recalculated, as they are not created by a vectorizer. All others are
+// left out, so that they are vectorized again based on the merged
+// properties.
+func (m *Manager) mergeNamedVectors(principal *models.Principal,
+	className string, oldVecs map[string][]float32, newVecs models.Vectors,
+) (models.Vectors, error) {
+	vectors := models.Vectors{}
+	for name, vector := range newVecs {
+		vectors[name] = vector
+	}
+
+	if len(oldVecs) == 0 {
+		return vectors, nil
+	}
+
+	s, err := m.schemaManager.GetSchema(principal)
+	if err != nil {
+		return nil, err
+	}
+	class := s.FindClassByName(schema.ClassName(className))
+	if class == nil {
+		return nil, fmt.Errorf("class %q not found in schema", className)
+	}
+
+	for name, vector := range oldVecs {
+		if _, ok := vectors[name]; ok {
+			continue
+		}
+		if vectorConfig, ok := class.VectorConfig[name]; ok &&
+			vectorConfig.Vectorizer == config.VectorizerModuleNone {
+			vectors[name] = vector
+		}
+	}
+
+	return vectors, nil
+}
+
 func (m *Manager) splitPrimitiveAndRefs(in map[string]interface{}, sourceClass string,
 	sourceID strfmt.UUID,
 ) (map[string]interface{}, BatchReferences) {
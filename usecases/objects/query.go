@@ -25,17 +25,21 @@ type QueryInput struct {
 	Offset     int
 	Limit      int
 	Filters    *filters.LocalFilter
+	Cursor     *filters.Cursor
 	Sort       []filters.Sort
 	Additional additional.Properties
+	Tenant     string
 }
 
 type QueryParams struct {
 	Class      string
 	Offset     *int64
 	Limit      *int64
+	After      *string
 	Sort       *string
 	Order      *string
 	Additional additional.Properties
+	Tenant     string
 }
 
 func (q *QueryParams) inputs(m *Manager) (*QueryInput, error) {
@@ -43,12 +47,24 @@ func (q *QueryParams) inputs(m *Manager) (*QueryInput, error) {
 	if err != nil {
 		return nil, err
 	}
+	sort := m.getSort(q.Sort, q.Order)
+
+	var cursor *filters.Cursor
+	if q.After != nil {
+		cursor = &filters.Cursor{After: *q.After, Limit: smartLimit}
+		if err := filters.ValidateCursor(q.Class, cursor, smartOffset, nil, sort); err != nil {
+			return nil, err
+		}
+	}
+
 	return &QueryInput{
 		Class:      q.Class,
 		Offset:     smartOffset,
 		Limit:      smartLimit,
-		Sort:       m.getSort(q.Sort, q.Order),
+		Cursor:     cursor,
+		Sort:       sort,
 		Additional: q.Additional,
+		Tenant:     q.Tenant,
 	}, nil
 }
 
@@ -68,7 +84,7 @@ func (m *Manager) Query(ctx context.Context, principal *models.Principal, params
 
 	q, err := params.inputs(m)
 	if err != nil {
-		return nil, &Error{"offset or limit", StatusBadRequest, err}
+		return nil, &Error{"query params", StatusBadRequest, err}
 	}
 	res, rerr := m.vectorRepo.Query(ctx, q)
 	if rerr != nil {
@@ -30,13 +30,14 @@ func (m *Manager) AddObjectReference(
 	ctx context.Context,
 	principal *models.Principal,
 	input *AddReferenceInput,
+	repl *additional.ReplicationProperties,
 ) *Error {
 	m.metrics.AddReferenceInc()
 	defer m.metrics.AddReferenceDec()
 
 	deprecatedEndpoint := input.Class == ""
 	if deprecatedEndpoint { // for backward compatibility only
-		objectRes, err := m.getObjectFromRepo(ctx, "", input.ID, additional.Properties{})
+		objectRes, err := m.getObjectFromRepo(ctx, "", input.ID, additional.Properties{}, repl, "")
 		if err != nil {
 			errnf := ErrNotFound{} // treated as StatusBadRequest for backward comp
 			if errors.As(err, &errnf) {
@@ -57,13 +58,16 @@ func (m *Manager) AddObjectReference(
 	}
 	defer unlock()
 
-	validator := validation.New(schema.Schema{}, m.vectorRepo.Exists, m.config)
+	validator := validation.New(schema.Schema{}, m.vectorRepo.Exists, m.config, repl)
 	if err := input.validate(ctx, principal, validator, m.schemaManager); err != nil {
 		return &Error{"validate inputs", StatusBadRequest, err}
 	}
 	if !deprecatedEndpoint {
-		ok, err := m.vectorRepo.Exists(ctx, input.Class, input.ID)
+		ok, err := m.vectorRepo.Exists(ctx, input.Class, input.ID, repl, input.Tenant)
 		if err != nil {
+			if isMultiTenancyErr(err) {
+				return &Error{"source object", StatusBadRequest, err}
+			}
 			return &Error{"source object", StatusInternalServerError, err}
 		}
 		if !ok {
@@ -72,11 +76,11 @@ func (m *Manager) AddObjectReference(
 	}
 
 	if err := m.vectorRepo.AddReference(
-		ctx, input.Class, input.ID, input.Property, &input.Ref); err != nil {
+		ctx, input.Class, input.ID, input.Property, &input.Ref, repl, input.Tenant); err != nil {
 		return &Error{"add reference to repo", StatusInternalServerError, err}
 	}
 
-	if err := m.updateRefVector(ctx, input.Class, input.ID); err != nil {
+	if err := m.updateRefVector(ctx, input.Class, input.ID, repl, input.Tenant); err != nil {
 		return &Error{"update ref vector", StatusInternalServerError, err}
 	}
 
@@ -93,6 +97,8 @@ type AddReferenceInput struct {
 	Property string
 	// Ref cross reference
 	Ref models.SingleRef
+	// Tenant the object belongs to, only set for multi-tenant classes
+	Tenant string
 }
 
 func (req *AddReferenceInput) validate(
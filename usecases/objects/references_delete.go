@@ -31,23 +31,30 @@ type DeleteReferenceInput struct {
 	Property string
 	// Reference cross reference
 	Reference models.SingleRef
+	// Tenant the object belongs to, only set for multi-tenant classes
+	Tenant string
 }
 
 func (m *Manager) DeleteObjectReference(
 	ctx context.Context,
 	principal *models.Principal,
 	input *DeleteReferenceInput,
+	repl *additional.ReplicationProperties,
 ) *Error {
 	m.metrics.DeleteReferenceInc()
 	defer m.metrics.DeleteReferenceDec()
 
 	deprecatedEndpoint := input.Class == ""
-	res, err := m.getObjectFromRepo(ctx, input.Class, input.ID, additional.Properties{})
+	res, err := m.getObjectFromRepo(ctx, input.Class, input.ID, additional.Properties{}, repl,
+		input.Tenant)
 	if err != nil {
 		errnf := ErrNotFound{}
 		if errors.As(err, &errnf) {
 			return &Error{"source object", StatusNotFound, err}
 		}
+		if isMultiTenancyErr(err) {
+			return &Error{"source object", StatusBadRequest, err}
+		}
 		return &Error{"source object", StatusInternalServerError, err}
 	}
 	input.Class = res.ClassName
@@ -80,12 +87,12 @@ func (m *Manager) DeleteObjectReference(
 	}
 	obj.LastUpdateTimeUnix = m.timeSource.Now()
 
-	err = m.vectorRepo.PutObject(ctx, obj, res.Vector)
+	err = m.vectorRepo.PutObject(ctx, obj, res.Vector, repl)
 	if err != nil {
 		return &Error{"repo.putobject", StatusInternalServerError, err}
 	}
 
-	if err := m.updateRefVector(ctx, input.Class, input.ID); err != nil {
+	if err := m.updateRefVector(ctx, input.Class, input.ID, repl, input.Tenant); err != nil {
 		return &Error{"update ref vector", StatusInternalServerError, err}
 	}
 
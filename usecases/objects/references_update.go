@@ -33,6 +33,8 @@ type PutReferenceInput struct {
 	Property string
 	// Ref cross reference
 	Refs models.MultipleRef
+	// Tenant the object belongs to, only set for multi-tenant classes
+	Tenant string
 }
 
 // UpdateObjectReferences of a specific data object. If the class contains a network
@@ -42,11 +44,13 @@ func (m *Manager) UpdateObjectReferences(
 	ctx context.Context,
 	principal *models.Principal,
 	input *PutReferenceInput,
+	repl *additional.ReplicationProperties,
 ) *Error {
 	m.metrics.UpdateReferenceInc()
 	defer m.metrics.UpdateReferenceDec()
 
-	res, err := m.getObjectFromRepo(ctx, input.Class, input.ID, additional.Properties{})
+	res, err := m.getObjectFromRepo(ctx, input.Class, input.ID, additional.Properties{}, repl,
+		input.Tenant)
 	if err != nil {
 		errnf := ErrNotFound{}
 		if errors.As(err, &errnf) {
@@ -55,6 +59,9 @@ func (m *Manager) UpdateObjectReferences(
 			}
 			return &Error{"source object", StatusNotFound, err}
 		}
+		if isMultiTenancyErr(err) {
+			return &Error{"source object", StatusBadRequest, err}
+		}
 		return &Error{"source object", StatusInternalServerError, err}
 	}
 	input.Class = res.ClassName
@@ -70,7 +77,7 @@ func (m *Manager) UpdateObjectReferences(
 	}
 	defer unlock()
 
-	validator := validation.New(schema.Schema{}, m.vectorRepo.Exists, m.config)
+	validator := validation.New(schema.Schema{}, m.vectorRepo.Exists, m.config, repl)
 	if err := input.validate(ctx, principal, validator, m.schemaManager); err != nil {
 		return &Error{"bad inputs", StatusBadRequest, err}
 	}
@@ -81,7 +88,7 @@ func (m *Manager) UpdateObjectReferences(
 		obj.Properties.(map[string]interface{})[input.Property] = input.Refs
 	}
 	obj.LastUpdateTimeUnix = m.timeSource.Now()
-	err = m.vectorRepo.PutObject(ctx, obj, res.Vector)
+	err = m.vectorRepo.PutObject(ctx, obj, res.Vector, repl)
 	if err != nil {
 		return &Error{"repo.putobject", StatusInternalServerError, err}
 	}
@@ -33,7 +33,7 @@ func (m *Manager) ValidateObject(ctx context.Context, principal *models.Principa
 	}
 	defer unlock()
 
-	err = m.validateObject(ctx, principal, class)
+	err = m.validateObject(ctx, principal, class, nil)
 	if err != nil {
 		return NewErrInvalidUserInput("invalid object: %v", err)
 	}
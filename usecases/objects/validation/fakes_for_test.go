@@ -15,6 +15,7 @@ import (
 	"context"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/additional"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema"
 )
@@ -41,6 +42,6 @@ func testSchema() schema.Schema {
 	}
 }
 
-func fakeExists(context.Context, string, strfmt.UUID) (bool, error) {
+func fakeExists(context.Context, string, strfmt.UUID, *additional.ReplicationProperties, string) (bool, error) {
 	return true, nil
 }
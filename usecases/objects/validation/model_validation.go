@@ -16,13 +16,15 @@ import (
 	"fmt"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/additional"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/entities/schema/crossref"
 	"github.com/semi-technologies/weaviate/usecases/config"
 )
 
-type exists func(_ context.Context, class string, _ strfmt.UUID) (bool, error)
+type exists func(_ context.Context, class string, _ strfmt.UUID,
+	repl *additional.ReplicationProperties, tenant string) (bool, error)
 
 const (
 	// ErrorMissingActionObjects message
@@ -63,15 +65,17 @@ type Validator struct {
 	schema schema.Schema
 	exists exists
 	config *config.WeaviateConfig
+	repl   *additional.ReplicationProperties
 }
 
 func New(schema schema.Schema, exists exists,
-	config *config.WeaviateConfig,
+	config *config.WeaviateConfig, repl *additional.ReplicationProperties,
 ) *Validator {
 	return &Validator{
 		schema: schema,
 		exists: exists,
 		config: config,
+		repl:   repl,
 	}
 }
 
@@ -93,6 +97,22 @@ func validateClass(class string) error {
 	return nil
 }
 
+// validateNamedVectors makes sure that only named vectors, which are
+// configured in the class, are set
+func validateNamedVectors(class *models.Class, vectors models.Vectors) error {
+	for name, vector := range vectors {
+		if _, ok := class.VectorConfig[name]; !ok {
+			return fmt.Errorf("named vector %q is not configured in class '%s'",
+				name, class.Class)
+		}
+		if len(vector) == 0 {
+			return fmt.Errorf("named vector %q is empty", name)
+		}
+	}
+
+	return nil
+}
+
 // ValidateSingleRef validates a single ref based on location URL and existence of the object in the database
 func (v *Validator) ValidateSingleRef(ctx context.Context, cref *models.SingleRef,
 	errorVal string,
@@ -107,7 +127,7 @@ func (v *Validator) ValidateSingleRef(ctx context.Context, cref *models.SingleRe
 	}
 
 	// locally check for object existence
-	ok, err := v.exists(ctx, ref.Class, ref.TargetID)
+	ok, err := v.exists(ctx, ref.Class, ref.TargetID, v.repl, "")
 	if err != nil {
 		return err
 	}
@@ -57,6 +57,10 @@ func (v *Validator) properties(ctx context.Context, object interface{}) error {
 		vectorWeights = res
 	}
 
+	if err := validateNamedVectors(class, object.(*models.Object).Vectors); err != nil {
+		return err
+	}
+
 	if isp == nil {
 		// no properties means nothing to validate
 		return nil
@@ -146,3 +146,42 @@ func TestValidator_extractAndValidateProperty(t *testing.T) {
 func getDataType(dataType schema.DataType) *schema.DataType {
 	return &dataType
 }
+
+func TestValidator_NamedVectors(t *testing.T) {
+	sch := schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class: "Article",
+					VectorConfig: map[string]models.VectorConfig{
+						"title": {Vectorizer: "none"},
+					},
+				},
+			},
+		},
+	}
+	validator := New(sch, nil, nil, nil)
+
+	tests := []struct {
+		name    string
+		vectors models.Vectors
+		wantErr bool
+	}{
+		{name: "without named vectors"},
+		{name: "with a configured named vector", vectors: models.Vectors{"title": {1, 2, 3}}},
+		{name: "with an unknown named vector", vectors: models.Vectors{"content": {1, 2, 3}}, wantErr: true},
+		{name: "with an empty named vector", vectors: models.Vectors{"title": {}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validator.Object(context.Background(), &models.Object{
+				Class:   "Article",
+				Vectors: tt.vectors,
+			})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validator.Object() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -21,11 +21,12 @@ import (
 )
 
 func (m *Manager) updateRefVector(ctx context.Context,
-	className string, id strfmt.UUID,
+	className string, id strfmt.UUID, repl *additional.ReplicationProperties,
+	tenant string,
 ) error {
 	if m.modulesProvider.UsingRef2Vec(className) {
 		parent, err := m.vectorRepo.Object(ctx, className,
-			id, search.SelectProperties{}, additional.Properties{})
+			id, search.SelectProperties{}, additional.Properties{}, repl, tenant)
 		if err != nil {
 			return fmt.Errorf("find parent '%s/%s': %w",
 				className, id, err)
@@ -39,7 +40,7 @@ func (m *Manager) updateRefVector(ctx context.Context,
 				className, id, err)
 		}
 
-		if err := m.vectorRepo.PutObject(ctx, obj, obj.Vector); err != nil {
+		if err := m.vectorRepo.PutObject(ctx, obj, obj.Vector, repl); err != nil {
 			return fmt.Errorf("put object: %w", err)
 		}
 
@@ -61,7 +62,7 @@ func (m *Manager) findObject(ctx context.Context, class string,
 	if class == "" {
 		return m.vectorRepo.ObjectByID(ctx, id, props, addl)
 	}
-	return m.vectorRepo.Object(ctx, class, id, props, addl)
+	return m.vectorRepo.Object(ctx, class, id, props, addl, nil, "")
 }
 
 // TODO: remove this method and just pass b.vectorRepo.Object to
@@ -75,5 +76,5 @@ func (b *BatchManager) findObject(ctx context.Context, class string,
 	if class == "" {
 		return b.vectorRepo.ObjectByID(ctx, id, props, addl)
 	}
-	return b.vectorRepo.Object(ctx, class, id, props, addl)
+	return b.vectorRepo.Object(ctx, class, id, props, addl, nil, "")
 }
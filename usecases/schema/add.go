@@ -23,6 +23,7 @@ import (
 	"github.com/semi-technologies/weaviate/entities/backup"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/vectorindex/flat"
 	"github.com/semi-technologies/weaviate/usecases/config"
 	"github.com/semi-technologies/weaviate/usecases/monitoring"
 	"github.com/semi-technologies/weaviate/usecases/sharding"
@@ -131,7 +132,8 @@ func (m *Manager) addClass(ctx context.Context, class *models.Class,
 	}
 
 	shardState, err := sharding.InitState(class.Class,
-		class.ShardingConfig.(sharding.Config), m.clusterState)
+		class.ShardingConfig.(sharding.Config), m.clusterState,
+		class.ReplicationConfig.Factor, schema.MultiTenancyEnabled(class))
 	if err != nil {
 		return errors.Wrap(err, "init sharding state")
 	}
@@ -177,12 +179,21 @@ func (m *Manager) setClassDefaults(class *models.Class) {
 		class.VectorIndexType = "hnsw"
 	}
 
-	if m.config.DefaultVectorDistanceMetric != "" {
-		if class.VectorIndexConfig == nil {
-			class.VectorIndexConfig = map[string]interface{}{"distance": m.config.DefaultVectorDistanceMetric}
-		} else if class.VectorIndexConfig.(map[string]interface{})["distance"] == nil {
-			class.VectorIndexConfig.(map[string]interface{})["distance"] = m.config.DefaultVectorDistanceMetric
+	class.VectorIndexConfig = m.setVectorIndexConfigDefaults(class.VectorIndexConfig)
+
+	for name, vectorConfig := range class.VectorConfig {
+		if vectorConfig.Vectorizer == "" {
+			vectorConfig.Vectorizer = m.config.DefaultVectorizerModule
+		}
+		if vectorConfig.VectorIndexType == "" {
+			vectorConfig.VectorIndexType = "hnsw"
+		}
+		vectorConfig.VectorIndexConfig = m.setVectorIndexConfigDefaults(
+			vectorConfig.VectorIndexConfig)
+		for i, prop := range vectorConfig.SourceProperties {
+			vectorConfig.SourceProperties[i] = lowerCaseFirstLetter(prop)
 		}
+		class.VectorConfig[name] = vectorConfig
 	}
 
 	if class.InvertedIndexConfig == nil {
@@ -193,6 +204,8 @@ func (m *Manager) setClassDefaults(class *models.Class) {
 		class.InvertedIndexConfig.CleanupIntervalSeconds = config.DefaultCleanupIntervalSeconds
 	}
 
+	setReplicationDefaults(class)
+
 	if class.InvertedIndexConfig.Bm25 == nil {
 		class.InvertedIndexConfig.Bm25 = &models.BM25Config{
 			K1: config.DefaultBM25k1,
@@ -213,6 +226,22 @@ func (m *Manager) setClassDefaults(class *models.Class) {
 	m.moduleConfig.SetClassDefaults(class)
 }
 
+func (m *Manager) setVectorIndexConfigDefaults(cfg interface{}) interface{} {
+	if m.config.DefaultVectorDistanceMetric == "" {
+		return cfg
+	}
+
+	if cfg == nil {
+		return map[string]interface{}{"distance": m.config.DefaultVectorDistanceMetric}
+	}
+
+	if asMap, ok := cfg.(map[string]interface{}); ok && asMap["distance"] == nil {
+		asMap["distance"] = m.config.DefaultVectorDistanceMetric
+	}
+
+	return cfg
+}
+
 func (m *Manager) setPropertyDefaults(prop *models.Property) {
 	m.setPropertyDefaultTokenization(prop)
 }
@@ -257,6 +286,10 @@ func (m *Manager) validateCanAddClass(
 		return err
 	}
 
+	if err := m.validateReplicationConfig(class); err != nil {
+		return err
+	}
+
 	if err := m.moduleConfig.ValidateClass(ctx, class); err != nil {
 		return err
 	}
@@ -294,6 +327,10 @@ func (m *Manager) validateProperty(
 		return err
 	}
 
+	if err := validatePropertyStemmer(property.Stemmer, property.Tokenization, propertyDataType); err != nil {
+		return err
+	}
+
 	// all is fine!
 	return nil
 }
@@ -301,22 +338,51 @@ func (m *Manager) validateProperty(
 func (m *Manager) parseVectorIndexConfig(ctx context.Context,
 	class *models.Class,
 ) error {
-	if class.VectorIndexType != "hnsw" {
-		return errors.Errorf(
-			"parse vector index config: unsupported vector index type: %q",
-			class.VectorIndexType)
-	}
-
-	parsed, err := m.hnswConfigParser(class.VectorIndexConfig)
+	parsed, err := m.parseGivenVectorIndexConfig(class.VectorIndexType,
+		class.VectorIndexConfig)
 	if err != nil {
-		return errors.Wrap(err, "parse vector index config")
+		return err
 	}
 
 	class.VectorIndexConfig = parsed
 
+	for name, vectorConfig := range class.VectorConfig {
+		parsed, err := m.parseGivenVectorIndexConfig(vectorConfig.VectorIndexType,
+			vectorConfig.VectorIndexConfig)
+		if err != nil {
+			return errors.Wrapf(err, "named vector %q", name)
+		}
+
+		vectorConfig.VectorIndexConfig = parsed
+		class.VectorConfig[name] = vectorConfig
+	}
+
 	return nil
 }
 
+func (m *Manager) parseGivenVectorIndexConfig(vectorIndexType string,
+	vectorIndexConfig interface{},
+) (schema.VectorIndexConfig, error) {
+	var parse VectorConfigParser
+	switch vectorIndexType {
+	case "hnsw":
+		parse = m.hnswConfigParser
+	case flat.IndexType:
+		parse = flat.ParseUserConfig
+	default:
+		return nil, errors.Errorf(
+			"parse vector index config: unsupported vector index type: %q",
+			vectorIndexType)
+	}
+
+	parsed, err := parse(vectorIndexConfig)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse vector index config")
+	}
+
+	return parsed, nil
+}
+
 func (m *Manager) parseShardingConfig(ctx context.Context,
 	class *models.Class,
 ) error {
@@ -331,6 +397,30 @@ func (m *Manager) parseShardingConfig(ctx context.Context,
 	return nil
 }
 
+func setReplicationDefaults(class *models.Class) {
+	if class.ReplicationConfig == nil {
+		class.ReplicationConfig = &models.ReplicationConfig{}
+	}
+
+	if class.ReplicationConfig.Factor == 0 {
+		class.ReplicationConfig.Factor = 1
+	}
+}
+
+func (m *Manager) validateReplicationConfig(class *models.Class) error {
+	factor := class.ReplicationConfig.Factor
+	if factor < 1 {
+		return errors.Errorf("replication factor must be at least 1, got %d", factor)
+	}
+
+	if nodeCount := m.clusterState.NodeCount(); int64(nodeCount) < factor {
+		return errors.Errorf("replication factor %d exceeds the number of nodes "+
+			"in the cluster (%d)", factor, nodeCount)
+	}
+
+	return nil
+}
+
 func upperCaseClassName(name string) string {
 	if len(name) < 1 {
 		return name
@@ -55,6 +55,21 @@ func (m *Manager) addClassProperty(ctx context.Context,
 		return err
 	}
 
+	// the values of a deleted prop are removed from the stored objects in the
+	// background, they must not be mixed up with the values of a new prop of
+	// the same name
+	deletions, err := m.migrator.PropertyDeletionStatus(ctx, className)
+	if err != nil {
+		return errors.Wrap(err, "check pending property deletions")
+	}
+	for _, status := range deletions {
+		if status.Property == prop.Name &&
+			status.Status != models.PropertyDeletionStatusStatusDONE {
+			return errors.Errorf("property %q of class %q is still being deleted, "+
+				"it can be added again once the deletion is done", prop.Name, className)
+		}
+	}
+
 	tx, err := m.cluster.BeginTransaction(ctx, AddProperty,
 		AddPropertyPayload{className, prop})
 	if err != nil {
@@ -17,6 +17,7 @@ import (
 
 	"github.com/semi-technologies/weaviate/adapters/repos/db/inverted/stopwords"
 	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/vectorindex/flat"
 	"github.com/semi-technologies/weaviate/usecases/config"
 	"github.com/stretchr/testify/require"
 )
@@ -110,6 +111,41 @@ func TestAddClass(t *testing.T) {
 		require.Equal(t, expectedStopwordConfig, mgr.state.ObjectSchema.Classes[0].InvertedIndexConfig.Stopwords)
 	})
 
+	t.Run("with default replication config", func(t *testing.T) {
+		mgr := newSchemaManager()
+
+		err := mgr.AddClass(context.Background(),
+			nil, &models.Class{Class: "NewClass"})
+		require.Nil(t, err)
+
+		require.NotEmpty(t, mgr.state.ObjectSchema.Classes)
+		require.Equal(t, &models.ReplicationConfig{Factor: 1},
+			mgr.state.ObjectSchema.Classes[0].ReplicationConfig)
+	})
+
+	t.Run("with a replication factor exceeding the node count", func(t *testing.T) {
+		mgr := newSchemaManager()
+
+		err := mgr.AddClass(context.Background(),
+			nil, &models.Class{
+				Class:             "NewClass",
+				ReplicationConfig: &models.ReplicationConfig{Factor: 2},
+			})
+		require.EqualError(t, err, "replication factor 2 exceeds the number of "+
+			"nodes in the cluster (1)")
+	})
+
+	t.Run("with a negative replication factor", func(t *testing.T) {
+		mgr := newSchemaManager()
+
+		err := mgr.AddClass(context.Background(),
+			nil, &models.Class{
+				Class:             "NewClass",
+				ReplicationConfig: &models.ReplicationConfig{Factor: -1},
+			})
+		require.EqualError(t, err, "replication factor must be at least 1, got -1")
+	})
+
 	t.Run("with valid property tokenization", func(t *testing.T) {
 		mgr := newSchemaManager()
 
@@ -183,6 +219,86 @@ func TestAddClass(t *testing.T) {
 						DataType:     []string{"text[]"},
 						Tokenization: "word",
 					},
+					{
+						Name:         "stringWhitespace",
+						DataType:     []string{"string"},
+						Tokenization: "whitespace",
+					},
+					{
+						Name:         "stringLowercase",
+						DataType:     []string{"string"},
+						Tokenization: "lowercase",
+					},
+					{
+						Name:         "stringTrigram",
+						DataType:     []string{"string"},
+						Tokenization: "trigram",
+					},
+					{
+						Name:         "stringCjk",
+						DataType:     []string{"string"},
+						Tokenization: "cjk",
+					},
+					{
+						Name:         "stringArrayWhitespace",
+						DataType:     []string{"string[]"},
+						Tokenization: "whitespace",
+					},
+					{
+						Name:         "stringArrayLowercase",
+						DataType:     []string{"string[]"},
+						Tokenization: "lowercase",
+					},
+					{
+						Name:         "stringArrayTrigram",
+						DataType:     []string{"string[]"},
+						Tokenization: "trigram",
+					},
+					{
+						Name:         "stringArrayCjk",
+						DataType:     []string{"string[]"},
+						Tokenization: "cjk",
+					},
+					{
+						Name:         "textWhitespace",
+						DataType:     []string{"text"},
+						Tokenization: "whitespace",
+					},
+					{
+						Name:         "textLowercase",
+						DataType:     []string{"text"},
+						Tokenization: "lowercase",
+					},
+					{
+						Name:         "textTrigram",
+						DataType:     []string{"text"},
+						Tokenization: "trigram",
+					},
+					{
+						Name:         "textCjk",
+						DataType:     []string{"text"},
+						Tokenization: "cjk",
+					},
+					{
+						Name:         "textArrayWhitespace",
+						DataType:     []string{"text[]"},
+						Tokenization: "whitespace",
+					},
+					{
+						Name:         "textArrayLowercase",
+						DataType:     []string{"text[]"},
+						Tokenization: "lowercase",
+					},
+					{
+						Name:         "textArrayTrigram",
+						DataType:     []string{"text[]"},
+						Tokenization: "trigram",
+					},
+					{
+						Name:         "textArrayCjk",
+						DataType:     []string{"text[]"},
+						Tokenization: "cjk",
+					},
 					{
 						Name:     "IntDefault",
 						DataType: []string{"int"},
@@ -451,6 +567,18 @@ func TestAddClass(t *testing.T) {
 				tokenization: "notExisting",
 				errorMsg:     "Tokenization 'notExisting' is not allowed for data type 'blob'",
 			},
+			{
+				name:         "intTrigram",
+				dataType:     []string{"int"},
+				tokenization: "trigram",
+				errorMsg:     "Tokenization 'trigram' is not allowed for data type 'int'",
+			},
+			{
+				name:         "booleanCjk",
+				dataType:     []string{"boolean"},
+				tokenization: "cjk",
+				errorMsg:     "Tokenization 'cjk' is not allowed for data type 'boolean'",
+			},
 			{
 				name:         "intArrayWord",
 				dataType:     []string{"int[]"},
@@ -544,6 +672,99 @@ func TestAddClass(t *testing.T) {
 		}
 	})
 
+	t.Run("with property stemmer", func(t *testing.T) {
+		type testData struct {
+			name         string
+			dataType     []string
+			tokenization string
+			stemmer      string
+			errorMsg     string
+		}
+
+		tests := []testData{
+			{
+				name:     "textDefaultTokenization",
+				dataType: []string{"text"},
+				stemmer:  "en",
+			},
+			{
+				name:         "textArrayLowercase",
+				dataType:     []string{"text[]"},
+				tokenization: "lowercase",
+				stemmer:      "en",
+			},
+			{
+				name:         "stringLowercase",
+				dataType:     []string{"string"},
+				tokenization: "lowercase",
+				stemmer:      "en",
+			},
+			{
+				name:         "stringTrigramNone",
+				dataType:     []string{"string"},
+				tokenization: "trigram",
+				stemmer:      "none",
+			},
+			{
+				name:         "stringWord",
+				dataType:     []string{"string"},
+				tokenization: "word",
+				stemmer:      "en",
+				errorMsg:     "Stemmer 'en' is not allowed for data type 'string' with tokenization 'word'",
+			},
+			{
+				name:         "textTrigram",
+				dataType:     []string{"text"},
+				tokenization: "trigram",
+				stemmer:      "en",
+				errorMsg:     "Stemmer 'en' is not allowed for data type 'text' with tokenization 'trigram'",
+			},
+			{
+				name:     "int",
+				dataType: []string{"int"},
+				stemmer:  "en",
+				errorMsg: "Stemmer 'en' is not allowed for data type 'int' with tokenization ''",
+			},
+			{
+				name:     "textNotExisting",
+				dataType: []string{"text"},
+				stemmer:  "klingon",
+				errorMsg: "stemmer \"klingon\" does not exist",
+			},
+			{
+				name:     "textGerman",
+				dataType: []string{"text"},
+				stemmer:  "de",
+				errorMsg: "stemmer \"de\" does not exist, stemming is only supported for \"en\", " +
+					"the \"de\" stopword preset can be used without a stemmer",
+			},
+		}
+
+		for _, td := range tests {
+			t.Run(td.name, func(t *testing.T) {
+				mgr := newSchemaManager()
+				err := mgr.AddClass(context.Background(),
+					nil, &models.Class{
+						Class: "NewClass",
+						Properties: []*models.Property{
+							{
+								Name:         td.name,
+								DataType:     td.dataType,
+								Tokenization: td.tokenization,
+								Stemmer:      td.stemmer,
+							},
+						},
+					})
+
+				if td.errorMsg == "" {
+					require.Nil(t, err)
+				} else {
+					require.EqualError(t, err, td.errorMsg)
+				}
+			})
+		}
+	})
+
 	t.Run("with default vector distance metric", func(t *testing.T) {
 		mgr := newSchemaManager()
 
@@ -603,4 +824,92 @@ func TestAddClass(t *testing.T) {
 		require.Equal(t, "NewClass", mgr.state.ObjectSchema.Classes[0].Class)
 		require.Equal(t, expected, mgr.state.ObjectSchema.Classes[0].VectorIndexConfig)
 	})
+	t.Run("with named vectors", func(t *testing.T) {
+		mgr := newSchemaManager()
+
+		err := mgr.AddClass(context.Background(),
+			nil, &models.Class{
+				Class: "NewClass",
+				Properties: []*models.Property{
+					{Name: "title", DataType: []string{"text"}},
+				},
+				VectorConfig: map[string]models.VectorConfig{
+					"title": {
+						Vectorizer:       "model1",
+						SourceProperties: []string{"Title"},
+					},
+					"custom": {
+						VectorIndexType: "flat",
+					},
+				},
+			})
+		require.Nil(t, err)
+
+		require.NotEmpty(t, mgr.state.ObjectSchema.Classes)
+		vectorConfig := mgr.state.ObjectSchema.Classes[0].VectorConfig
+		require.Len(t, vectorConfig, 2)
+		require.Equal(t, models.VectorConfig{
+			Vectorizer:       "model1",
+			VectorIndexType:  "hnsw",
+			SourceProperties: []string{"title"},
+			VectorIndexConfig: fakeVectorConfig{
+				raw: map[string]interface{}{"distance": "cosine"},
+			},
+		}, vectorConfig["title"])
+		require.Equal(t, config.VectorizerModuleNone, vectorConfig["custom"].Vectorizer)
+		require.Equal(t, "flat", vectorConfig["custom"].VectorIndexType)
+		require.IsType(t, flat.UserConfig{}, vectorConfig["custom"].VectorIndexConfig)
+	})
+
+	t.Run("with an invalid named vector", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			vectorConfig map[string]models.VectorConfig
+			expectedErr  string
+		}{
+			{
+				name: "invalid name",
+				vectorConfig: map[string]models.VectorConfig{
+					"with-dash": {},
+				},
+				expectedErr: "'with-dash' is not a valid vector name",
+			},
+			{
+				name: "unknown vectorizer",
+				vectorConfig: map[string]models.VectorConfig{
+					"title": {Vectorizer: "unknown"},
+				},
+				expectedErr: "named vector \"title\": vectorizer: invalid vectorizer \"unknown\"",
+			},
+			{
+				name: "unknown vector index type",
+				vectorConfig: map[string]models.VectorConfig{
+					"title": {VectorIndexType: "unknown"},
+				},
+				expectedErr: "named vector \"title\": unrecognized or unsupported vectorIndexType \"unknown\"",
+			},
+			{
+				name: "unknown source property",
+				vectorConfig: map[string]models.VectorConfig{
+					"title": {SourceProperties: []string{"body"}},
+				},
+				expectedErr: "named vector \"title\": source property \"body\" does not exist in class \"NewClass\"",
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				err := newSchemaManager().AddClass(context.Background(),
+					nil, &models.Class{
+						Class: "NewClass",
+						Properties: []*models.Property{
+							{Name: "title", DataType: []string{"text"}},
+						},
+						VectorConfig: test.vectorConfig,
+					})
+				require.NotNil(t, err)
+				require.Contains(t, err.Error(), test.expectedErr)
+			})
+		}
+	})
 }
@@ -96,6 +96,42 @@ func Test_Schema_Authorization(t *testing.T) {
 			expectedVerb:     "update",
 			expectedResource: "schema/className/shards/shardName",
 		},
+		{
+			methodName:       "AddTenants",
+			additionalArgs:   []interface{}{"className", []*models.Tenant{{Name: "tenantA"}}},
+			expectedVerb:     "create",
+			expectedResource: "schema/className/tenants",
+		},
+		{
+			methodName:       "DeleteTenants",
+			additionalArgs:   []interface{}{"className", []string{"tenantA"}},
+			expectedVerb:     "delete",
+			expectedResource: "schema/className/tenants",
+		},
+		{
+			methodName:       "GetTenants",
+			additionalArgs:   []interface{}{"className"},
+			expectedVerb:     "list",
+			expectedResource: "schema/className/tenants",
+		},
+		{
+			methodName:       "ReindexClass",
+			additionalArgs:   []interface{}{"className", &models.ReindexRequest{}},
+			expectedVerb:     "update",
+			expectedResource: "schema/objects",
+		},
+		{
+			methodName:       "GetReindexJob",
+			additionalArgs:   []interface{}{"className", "id"},
+			expectedVerb:     "list",
+			expectedResource: "schema/*",
+		},
+		{
+			methodName:       "CancelReindexJob",
+			additionalArgs:   []interface{}{"className", "id"},
+			expectedVerb:     "update",
+			expectedResource: "schema/objects",
+		},
 	}
 
 	t.Run("verify that a test for every public method exists", func(t *testing.T) {
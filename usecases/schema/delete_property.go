@@ -13,12 +13,16 @@ package schema
 
 import (
 	"context"
-	"fmt"
+	"sort"
 
+	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
 )
 
-// DeleteClassProperty from existing Schema
+// DeleteClassProperty from existing Schema. The indexes of the property are
+// dropped right away, its values are removed from the stored objects in the
+// background
 func (m *Manager) DeleteClassProperty(ctx context.Context, principal *models.Principal,
 	class string, property string,
 ) error {
@@ -27,7 +31,94 @@ func (m *Manager) DeleteClassProperty(ctx context.Context, principal *models.Pri
 		return err
 	}
 
-	return fmt.Errorf("deleting a property is currently not supported, see " +
-		"https://github.com/semi-technologies/weaviate/issues/973 for details.")
-	// return m.deleteClassProperty(ctx, class, property, kind.Action)
+	return m.deleteClassProperty(ctx, class, property)
+}
+
+func (m *Manager) deleteClassProperty(ctx context.Context,
+	className string, propName string,
+) error {
+	m.Lock()
+	defer m.Unlock()
+
+	semanticSchema := m.state.SchemaFor()
+	class, err := schema.GetClassByName(semanticSchema, className)
+	if err != nil {
+		return err
+	}
+
+	propName = lowerCaseFirstLetter(propName)
+	if _, err := schema.GetPropertyByName(class, propName); err != nil {
+		return err
+	}
+
+	if err := validatePropertyNotVectorized(class, propName); err != nil {
+		return err
+	}
+
+	tx, err := m.cluster.BeginTransaction(ctx, DeleteProperty,
+		DeletePropertyPayload{className, propName})
+	if err != nil {
+		// possible causes for errors could be nodes down (we expect every node to
+		// the up for a schema transaction) or concurrent transactions from other
+		// nodes
+		return errors.Wrap(err, "open cluster-wide transaction")
+	}
+
+	if err := m.cluster.CommitTransaction(ctx, tx); err != nil {
+		return errors.Wrap(err, "commit cluster-wide transaction")
+	}
+
+	return m.deleteClassPropertyApplyChanges(ctx, className, propName)
+}
+
+func (m *Manager) deleteClassPropertyApplyChanges(ctx context.Context,
+	className string, propName string,
+) error {
+	semanticSchema := m.state.SchemaFor()
+	class, err := schema.GetClassByName(semanticSchema, className)
+	if err != nil {
+		return err
+	}
+
+	// the properties might still be read by others without holding the schema
+	// lock, so the remaining ones are copied rather than removed in place
+	remaining := make([]*models.Property, 0, len(class.Properties))
+	for _, prop := range class.Properties {
+		if prop.Name != propName {
+			remaining = append(remaining, prop)
+		}
+	}
+	if len(remaining) == len(class.Properties) {
+		return errors.Errorf("property %q does not exist on class %q",
+			propName, className)
+	}
+	class.Properties = remaining
+
+	if err := m.saveSchema(ctx); err != nil {
+		return err
+	}
+
+	return m.migrator.DropProperty(ctx, className, propName)
+}
+
+// validatePropertyNotVectorized rejects the deletion of a prop which is a
+// source property of a named vector, as the class would no longer pass its
+// own validation, for example when it is restored from a backup
+func validatePropertyNotVectorized(class *models.Class, propName string) error {
+	vectorNames := make([]string, 0, len(class.VectorConfig))
+	for name := range class.VectorConfig {
+		vectorNames = append(vectorNames, name)
+	}
+	sort.Strings(vectorNames)
+
+	for _, name := range vectorNames {
+		for _, sourceProp := range class.VectorConfig[name].SourceProperties {
+			if sourceProp == propName {
+				return errors.Errorf("property %q is a source property of named "+
+					"vector %q and cannot be deleted", propName, name)
+			}
+		}
+	}
+
+	return nil
 }
@@ -13,4 +13,9 @@ package schema
 
 import "errors"
 
-var ErrNotFound = errors.New("not found")
+var (
+	ErrNotFound = errors.New("not found")
+	// ErrReindexJobFinished is returned when cancelling a reindex job which
+	// has already succeeded, failed or been cancelled
+	ErrReindexJobFinished = errors.New("reindex job has already finished")
+)
@@ -24,10 +24,18 @@ func (m *Manager) handleCommit(ctx context.Context, tx *cluster.Transaction) err
 		return m.handleAddClassCommit(ctx, tx)
 	case AddProperty:
 		return m.handleAddPropertyCommit(ctx, tx)
+	case DeleteProperty:
+		return m.handleDeletePropertyCommit(ctx, tx)
 	case DeleteClass:
 		return m.handleDeleteClassCommit(ctx, tx)
 	case UpdateClass:
 		return m.handleUpdateClassCommit(ctx, tx)
+	case AddTenants:
+		return m.handleAddTenantsCommit(ctx, tx)
+	case DeleteTenants:
+		return m.handleDeleteTenantsCommit(ctx, tx)
+	case StartReindex, CommitReindex, CancelReindex:
+		return m.handleReindexCommit(ctx, tx)
 	default:
 		return errors.Errorf("unrecognized commit type %q", tx.Type)
 	}
@@ -72,6 +80,21 @@ func (m *Manager) handleAddPropertyCommit(ctx context.Context,
 	return m.addClassPropertyApplyChanges(ctx, pl.ClassName, pl.Property)
 }
 
+func (m *Manager) handleDeletePropertyCommit(ctx context.Context,
+	tx *cluster.Transaction,
+) error {
+	m.Lock()
+	defer m.Unlock()
+
+	pl, ok := tx.Payload.(DeletePropertyPayload)
+	if !ok {
+		return errors.Errorf("expected commit payload to be DeletePropertyPayload, but got %T",
+			tx.Payload)
+	}
+
+	return m.deleteClassPropertyApplyChanges(ctx, pl.ClassName, pl.PropertyName)
+}
+
 func (m *Manager) handleDeleteClassCommit(ctx context.Context,
 	tx *cluster.Transaction,
 ) error {
@@ -109,3 +132,55 @@ func (m *Manager) handleUpdateClassCommit(ctx context.Context,
 
 	return m.updateClassApplyChanges(ctx, pl.ClassName, pl.Class)
 }
+
+func (m *Manager) handleAddTenantsCommit(ctx context.Context,
+	tx *cluster.Transaction,
+) error {
+	m.Lock()
+	defer m.Unlock()
+
+	pl, ok := tx.Payload.(AddTenantsPayload)
+	if !ok {
+		return errors.Errorf("expected commit payload to be AddTenantsPayload, but got %T",
+			tx.Payload)
+	}
+
+	return m.addTenantsApplyChanges(ctx, pl.ClassName, pl.Partitions)
+}
+
+func (m *Manager) handleDeleteTenantsCommit(ctx context.Context,
+	tx *cluster.Transaction,
+) error {
+	m.Lock()
+	defer m.Unlock()
+
+	pl, ok := tx.Payload.(DeleteTenantsPayload)
+	if !ok {
+		return errors.Errorf("expected commit payload to be DeleteTenantsPayload, but got %T",
+			tx.Payload)
+	}
+
+	return m.deleteTenantsApplyChanges(ctx, pl.ClassName, pl.Tenants)
+}
+
+func (m *Manager) handleReindexCommit(ctx context.Context,
+	tx *cluster.Transaction,
+) error {
+	m.Lock()
+	defer m.Unlock()
+
+	pl, ok := tx.Payload.(ReindexPayload)
+	if !ok {
+		return errors.Errorf("expected commit payload to be ReindexPayload, but got %T",
+			tx.Payload)
+	}
+
+	switch tx.Type {
+	case StartReindex:
+		return m.startReindexApplyChanges(ctx, pl)
+	case CommitReindex:
+		return m.commitReindexApplyChanges(ctx, pl)
+	default:
+		return m.cancelReindexApplyChanges(ctx, pl)
+	}
+}
@@ -226,8 +226,10 @@ func (m *Manager) checkSingleShardMigration(ctx context.Context) error {
 			return err
 		}
 
+		setReplicationDefaults(c)
 		shardState, err := sharding.InitState(c.Class,
-			c.ShardingConfig.(sharding.Config), m.clusterState)
+			c.ShardingConfig.(sharding.Config), m.clusterState,
+			c.ReplicationConfig.Factor, false)
 		if err != nil {
 			return errors.Wrap(err, "init sharding state")
 		}
@@ -256,6 +258,9 @@ func (m *Manager) parseConfigs(ctx context.Context, schema *State) error {
 			m.setPropertyDefaults(prop)
 		}
 
+		// classes created prior to the introduction of replication
+		setReplicationDefaults(class)
+
 		if err := m.parseVectorIndexConfig(ctx, class); err != nil {
 			return errors.Wrapf(err, "class %s: vector index config", class.Class)
 		}
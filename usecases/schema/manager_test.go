@@ -13,11 +13,14 @@ package schema
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/backup"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/vectorindex/flat"
 	"github.com/semi-technologies/weaviate/usecases/config"
 	"github.com/semi-technologies/weaviate/usecases/sharding"
 	"github.com/sirupsen/logrus/hooks/test"
@@ -87,6 +90,44 @@ func (n *NilMigrator) RecalculateVectorDimensions(ctx context.Context) error {
 	return nil
 }
 
+func (n *NilMigrator) NewTenants(ctx context.Context, className string, tenants []string) error {
+	return nil
+}
+
+func (n *NilMigrator) DeleteTenants(ctx context.Context, className string, tenants []string) error {
+	return nil
+}
+
+func (n *NilMigrator) StartReindex(ctx context.Context, className, id string,
+	props []*models.Property, vectorConfig schema.VectorIndexConfig,
+) error {
+	return nil
+}
+
+func (n *NilMigrator) CommitReindex(ctx context.Context, className, id string) error {
+	return nil
+}
+
+func (n *NilMigrator) CleanupReindex(ctx context.Context, className, id string) error {
+	return nil
+}
+
+func (n *NilMigrator) CancelReindex(ctx context.Context, className, id string) error {
+	return nil
+}
+
+func (n *NilMigrator) ReindexStatus(ctx context.Context,
+	className string,
+) ([]*models.ReindexShardStatus, error) {
+	return nil, nil
+}
+
+func (n *NilMigrator) PropertyDeletionStatus(ctx context.Context,
+	className string,
+) ([]*models.PropertyDeletionStatus, error) {
+	return nil, nil
+}
+
 var schemaTests = []struct {
 	name string
 	fn   func(*testing.T, *Manager)
@@ -97,6 +138,7 @@ var schemaTests = []struct {
 	{name: "AddObjectClassWithImplicitVectorizer", fn: testAddObjectClassImplicitVectorizer},
 	{name: "AddObjectClassWithWrongVectorizer", fn: testAddObjectClassWrongVectorizer},
 	{name: "AddObjectClassWithWrongIndexType", fn: testAddObjectClassWrongIndexType},
+	{name: "AddObjectClassWithFlatIndexType", fn: testAddObjectClassFlatIndexType},
 	{name: "RemoveObjectClass", fn: testRemoveObjectClass},
 	{name: "CantAddSameClassTwice", fn: testCantAddSameClassTwice},
 	{name: "CantAddSameClassTwiceDifferentKind", fn: testCantAddSameClassTwiceDifferentKinds},
@@ -251,6 +293,26 @@ func testAddObjectClassWrongIndexType(t *testing.T, lsm *Manager) {
 		"\"vector-index-2-million\"", err.Error())
 }
 
+func testAddObjectClassFlatIndexType(t *testing.T, lsm *Manager) {
+	t.Parallel()
+
+	err := lsm.AddClass(context.Background(), nil, &models.Class{
+		Class:             "Car",
+		VectorIndexType:   "flat",
+		VectorIndexConfig: map[string]interface{}{"distance": "dot"},
+		Properties: []*models.Property{{
+			DataType: []string{"string"},
+			Name:     "dummy",
+		}},
+	})
+	require.Nil(t, err)
+
+	objectClasses := testGetClasses(lsm)
+	require.Len(t, objectClasses, 1)
+	assert.Equal(t, "flat", objectClasses[0].VectorIndexType)
+	assert.Equal(t, flat.UserConfig{Distance: "dot"}, objectClasses[0].VectorIndexConfig)
+}
+
 func testRemoveObjectClass(t *testing.T, lsm *Manager) {
 	t.Parallel()
 
@@ -426,11 +488,6 @@ func testAddInvalidPropertyWithEmptyDataTypeDuringCreation(t *testing.T, lsm *Ma
 }
 
 func testDropProperty(t *testing.T, lsm *Manager) {
-	// TODO: https://github.com/semi-technologies/weaviate/issues/973
-	// Remove skip
-
-	t.Skip()
-
 	t.Parallel()
 
 	var properties []*models.Property = []*models.Property{
@@ -448,13 +505,122 @@ func testDropProperty(t *testing.T, lsm *Manager) {
 	assert.Len(t, objectClasses[0].Properties, 1)
 
 	// Now drop the property
-	lsm.DeleteClassProperty(context.Background(), nil, "Car", "color")
+	err = lsm.DeleteClassProperty(context.Background(), nil, "Car", "color")
+	assert.Nil(t, err)
 
 	objectClasses = testGetClasses(lsm)
 	require.Len(t, objectClasses, 1)
 	assert.Len(t, objectClasses[0].Properties, 0)
 }
 
+func TestDropPropertyOfNamedVector(t *testing.T) {
+	ctx := context.Background()
+	sm := newSchemaManager()
+
+	require.Nil(t, sm.AddClass(ctx, nil, &models.Class{
+		Class: "Article",
+		Properties: []*models.Property{
+			{Name: "title", DataType: []string{"text"}},
+			{Name: "body", DataType: []string{"text"}},
+		},
+		VectorConfig: map[string]models.VectorConfig{
+			"title": {
+				Vectorizer:       "model1",
+				SourceProperties: []string{"title"},
+			},
+		},
+	}))
+
+	t.Run("a source property cannot be deleted", func(t *testing.T) {
+		err := sm.DeleteClassProperty(ctx, nil, "Article", "title")
+		assert.EqualError(t, err, `property "title" is a source property of `+
+			`named vector "title" and cannot be deleted`)
+
+		class, err := sm.GetClass(ctx, nil, "Article")
+		require.Nil(t, err)
+		assert.Len(t, class.Properties, 2)
+	})
+
+	t.Run("other properties can be deleted", func(t *testing.T) {
+		require.Nil(t, sm.DeleteClassProperty(ctx, nil, "Article", "body"))
+
+		class, err := sm.GetClass(ctx, nil, "Article")
+		require.Nil(t, err)
+		require.Len(t, class.Properties, 1)
+		assert.Equal(t, "title", class.Properties[0].Name)
+	})
+
+	t.Run("the class can still be restored", func(t *testing.T) {
+		class, err := sm.GetClass(ctx, nil, "Article")
+		require.Nil(t, err)
+		schemaBytes, err := json.Marshal(class)
+		require.Nil(t, err)
+
+		shardingConfig, err := sharding.ParseConfig(nil, 1)
+		require.Nil(t, err)
+		shardingState, err := sharding.InitState(class.Class, shardingConfig,
+			fakeNodes{[]string{"node1"}}, 1, false)
+		require.Nil(t, err)
+		shardingBytes, err := shardingState.JSON()
+		require.Nil(t, err)
+
+		err = newSchemaManager().RestoreClass(ctx, &backup.ClassDescriptor{
+			Name:          class.Class,
+			Schema:        schemaBytes,
+			ShardingState: shardingBytes,
+		})
+		assert.Nil(t, err)
+	})
+}
+
+type propertyDeletionMigrator struct {
+	NilMigrator
+	deletions []*models.PropertyDeletionStatus
+}
+
+func (m *propertyDeletionMigrator) PropertyDeletionStatus(ctx context.Context,
+	className string,
+) ([]*models.PropertyDeletionStatus, error) {
+	return m.deletions, nil
+}
+
+func TestReAddPropertyWithPendingDeletion(t *testing.T) {
+	ctx := context.Background()
+	migrator := &propertyDeletionMigrator{}
+	sm := newSchemaManager()
+	sm.migrator = migrator
+
+	require.Nil(t, sm.AddClass(ctx, nil, &models.Class{
+		Class:      "Car",
+		Properties: []*models.Property{{Name: "color", DataType: []string{"string"}}},
+	}))
+	require.Nil(t, sm.DeleteClassProperty(ctx, nil, "Car", "color"))
+
+	t.Run("while the deletion is in progress", func(t *testing.T) {
+		migrator.deletions = []*models.PropertyDeletionStatus{{
+			Property: "color",
+			Status:   models.PropertyDeletionStatusStatusINPROGRESS,
+		}}
+
+		err := sm.AddClassProperty(ctx, nil, "Car",
+			&models.Property{Name: "color", DataType: []string{"string"}})
+		assert.EqualError(t, err, `property "color" of class "Car" is still being `+
+			`deleted, it can be added again once the deletion is done`)
+	})
+
+	t.Run("once the deletion is done", func(t *testing.T) {
+		migrator.deletions[0].Status = models.PropertyDeletionStatusStatusDONE
+
+		err := sm.AddClassProperty(ctx, nil, "Car",
+			&models.Property{Name: "color", DataType: []string{"string"}})
+		require.Nil(t, err)
+
+		objectClasses := testGetClasses(sm)
+		require.Len(t, objectClasses, 1)
+		assert.Len(t, objectClasses[0].Properties, 1)
+	})
+}
+
 // This grant parent test setups up the temporary directory needed for the tests.
 func TestSchema(t *testing.T) {
 	// We need this test here to make sure that we wait until all child tests
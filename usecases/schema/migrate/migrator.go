@@ -34,6 +34,7 @@ type Migrator interface {
 		prop *models.Property) error
 	UpdateProperty(ctx context.Context, className string,
 		propName string, newName *string) error
+	DropProperty(ctx context.Context, className string, propName string) error
 	ValidateVectorIndexConfigUpdate(ctx context.Context,
 		old, updated schema.VectorIndexConfig) error
 	UpdateVectorIndexConfig(ctx context.Context, className string,
@@ -43,4 +44,14 @@ type Migrator interface {
 	UpdateInvertedIndexConfig(ctx context.Context, className string,
 		updated *models.InvertedIndexConfig) error
 	RecalculateVectorDimensions(ctx context.Context) error
+	NewTenants(ctx context.Context, className string, tenants []string) error
+	DeleteTenants(ctx context.Context, className string, tenants []string) error
+	StartReindex(ctx context.Context, className, id string,
+		props []*models.Property, vectorConfig schema.VectorIndexConfig) error
+	CommitReindex(ctx context.Context, className, id string) error
+	CleanupReindex(ctx context.Context, className, id string) error
+	CancelReindex(ctx context.Context, className, id string) error
+	ReindexStatus(ctx context.Context, className string) ([]*models.ReindexShardStatus, error)
+	PropertyDeletionStatus(ctx context.Context,
+		className string) ([]*models.PropertyDeletionStatus, error)
 }
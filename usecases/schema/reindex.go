@@ -0,0 +1,546 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/cluster"
+)
+
+// reindexStatusInterval is the interval in which the node which started a
+// reindex job checks whether every shard has rebuilt its indexes
+var reindexStatusInterval = 2 * time.Second
+
+// ReindexClass rebuilds the indexes of a class whose settings cannot be
+// changed in place, such as the tokenization or data type of a property or
+// the type of the vector index. The new indexes are built next to the current
+// ones in the background and swapped in, once every shard is done. The schema
+// is only updated at that point.
+func (m *Manager) ReindexClass(ctx context.Context, principal *models.Principal,
+	className string, req *models.ReindexRequest,
+) (*models.ReindexJob, error) {
+	err := m.authorizer.Authorize(principal, "update", "schema/objects")
+	if err != nil {
+		return nil, err
+	}
+
+	return m.reindexClass(ctx, className, req)
+}
+
+func (m *Manager) reindexClass(ctx context.Context, className string,
+	req *models.ReindexRequest,
+) (*models.ReindexJob, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	class := m.getClassByName(className)
+	if class == nil {
+		return nil, ErrNotFound
+	}
+
+	if err := m.validateReindexRequest(class, req); err != nil {
+		return nil, err
+	}
+
+	statuses, err := m.migrator.ReindexStatus(ctx, className)
+	if err != nil {
+		return nil, errors.Wrap(err, "check running reindex jobs")
+	}
+	for _, status := range statuses {
+		if status.Status == models.ReindexShardStatusStatusINPROGRESS ||
+			status.Status == models.ReindexShardStatusStatusREADY {
+			return nil, errors.Errorf("reindex job %q of class %q is still running",
+				status.JobID, className)
+		}
+	}
+
+	pl := ReindexPayload{
+		ID:        uuid.New().String(),
+		ClassName: className,
+		Request:   req,
+	}
+
+	tx, err := m.cluster.BeginTransaction(ctx, StartReindex, pl)
+	if err != nil {
+		// possible causes for errors could be nodes down (we expect every node to
+		// the up for a schema transaction) or concurrent transactions from other
+		// nodes
+		return nil, errors.Wrap(err, "open cluster-wide transaction")
+	}
+
+	if err := m.cluster.CommitTransaction(ctx, tx); err != nil {
+		return nil, errors.Wrap(err, "commit cluster-wide transaction")
+	}
+
+	if err := m.startReindexApplyChanges(ctx, pl); err != nil {
+		return nil, err
+	}
+
+	go m.watchReindex(pl)
+
+	return &models.ReindexJob{
+		ID:        pl.ID,
+		ClassName: className,
+		Status:    models.ReindexJobStatusINPROGRESS,
+	}, nil
+}
+
+func (m *Manager) validateReindexRequest(class *models.Class,
+	req *models.ReindexRequest,
+) error {
+	if req == nil || (len(req.Properties) == 0 && req.VectorIndexType == "") {
+		return errors.New("reindex request contains no changes")
+	}
+
+	seen := map[string]bool{}
+	for _, change := range req.Properties {
+		change.Name = lowerCaseFirstLetter(change.Name)
+		if seen[change.Name] {
+			return errors.Errorf("property %q is listed more than once", change.Name)
+		}
+		seen[change.Name] = true
+
+		prop, err := schema.GetPropertyByName(class, change.Name)
+		if err != nil {
+			return err
+		}
+
+		if err := validateDataTypeChange(prop, change.DataType); err != nil {
+			return err
+		}
+
+		target := reindexTarget(prop, change)
+		sch := m.GetSchemaSkipAuth()
+		propertyDataType, err := (&sch).FindPropertyDataTypeWithRefs(target.DataType,
+			false, schema.ClassName(class.Class))
+		if err != nil {
+			return fmt.Errorf("property '%s': invalid dataType: %v", prop.Name, err)
+		}
+
+		if !propertyDataType.IsPrimitive() {
+			return errors.Errorf("property %q: reference properties cannot be reindexed",
+				prop.Name)
+		}
+
+		switch propertyDataType.AsPrimitive() {
+		case schema.DataTypeGeoCoordinates, schema.DataTypePhoneNumber, schema.DataTypeBlob:
+			return errors.Errorf("property %q: properties of data type %q cannot be reindexed",
+				prop.Name, propertyDataType.AsPrimitive())
+		}
+
+		if err := validatePropertyTokenization(target.Tokenization, propertyDataType); err != nil {
+			return err
+		}
+
+		if err := validatePropertyStemmer(target.Stemmer, target.Tokenization, propertyDataType); err != nil {
+			return err
+		}
+	}
+
+	if req.VectorIndexType == "" {
+		return nil
+	}
+
+	if len(class.VectorConfig) > 0 {
+		return errors.New("the vector index type of a class with named vectors cannot be changed")
+	}
+
+	if req.VectorIndexType == class.VectorIndexType {
+		return errors.Errorf("vector index type is already %q", req.VectorIndexType)
+	}
+
+	_, err := m.parseGivenVectorIndexConfig(req.VectorIndexType, req.VectorIndexConfig)
+	return err
+}
+
+// convertibleDataTypes lists the data types a prop can be changed to without
+// touching the stored objects, because their values are valid for the new
+// type as well. Only the indexes of the prop are rebuilt.
+var convertibleDataTypes = map[schema.DataType][]schema.DataType{
+	schema.DataTypeString:      {schema.DataTypeText},
+	schema.DataTypeText:        {schema.DataTypeString},
+	schema.DataTypeStringArray: {schema.DataTypeTextArray},
+	schema.DataTypeTextArray:   {schema.DataTypeStringArray},
+	schema.DataTypeInt:         {schema.DataTypeNumber},
+	schema.DataTypeIntArray:    {schema.DataTypeNumberArray},
+}
+
+func validateDataTypeChange(prop *models.Property, dataType []string) error {
+	if len(dataType) == 0 {
+		return nil
+	}
+
+	if len(dataType) != 1 || len(prop.DataType) != 1 {
+		return errors.Errorf("property %q: the data type can only be changed "+
+			"between primitive types", prop.Name)
+	}
+
+	from, to := schema.DataType(prop.DataType[0]), schema.DataType(dataType[0])
+	if from == to {
+		return nil
+	}
+
+	for _, allowed := range convertibleDataTypes[from] {
+		if to == allowed {
+			return nil
+		}
+	}
+
+	return errors.Errorf("property %q: data type %q cannot be changed to %q",
+		prop.Name, from, to)
+}
+
+// reindexTarget returns a copy of the prop with the changes applied
+func reindexTarget(prop *models.Property, change *models.ReindexProperty) *models.Property {
+	target := *prop
+	if len(change.DataType) > 0 {
+		target.DataType = append([]string{}, change.DataType...)
+	}
+	if change.IndexInverted != nil {
+		indexInverted := *change.IndexInverted
+		target.IndexInverted = &indexInverted
+	}
+	if change.Tokenization != "" {
+		target.Tokenization = change.Tokenization
+	}
+	if change.Stemmer != "" {
+		target.Stemmer = change.Stemmer
+	}
+
+	return &target
+}
+
+// reindexTargets returns the new definitions of the props of the request as
+// well as the config of the new vector index, which is nil if the vector
+// index is kept
+func (m *Manager) reindexTargets(className string, req *models.ReindexRequest,
+) ([]*models.Property, schema.VectorIndexConfig, error) {
+	class := m.getClassByName(className)
+	if class == nil {
+		return nil, nil, errors.Errorf("class %q not found", className)
+	}
+
+	targets := make([]*models.Property, len(req.Properties))
+	for i, change := range req.Properties {
+		prop, err := schema.GetPropertyByName(class, lowerCaseFirstLetter(change.Name))
+		if err != nil {
+			return nil, nil, err
+		}
+		targets[i] = reindexTarget(prop, change)
+	}
+
+	if req.VectorIndexType == "" {
+		return targets, nil, nil
+	}
+
+	vectorConfig, err := m.parseGivenVectorIndexConfig(req.VectorIndexType,
+		req.VectorIndexConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return targets, vectorConfig, nil
+}
+
+func (m *Manager) startReindexApplyChanges(ctx context.Context, pl ReindexPayload) error {
+	targets, vectorConfig, err := m.reindexTargets(pl.ClassName, pl.Request)
+	if err != nil {
+		return err
+	}
+
+	return m.migrator.StartReindex(ctx, pl.ClassName, pl.ID, targets, vectorConfig)
+}
+
+func (m *Manager) commitReindexApplyChanges(ctx context.Context, pl ReindexPayload) error {
+	targets, vectorConfig, err := m.reindexTargets(pl.ClassName, pl.Request)
+	if err != nil {
+		return err
+	}
+
+	// the local shards swap in the rebuilt indexes before the schema is
+	// saved, a restart in between undoes the swap. A shard which fails to
+	// swap finishes it on its next startup, since the schema is saved anyway.
+	commitErr := m.migrator.CommitReindex(ctx, pl.ClassName, pl.ID)
+
+	class := m.getClassByName(pl.ClassName)
+
+	// the properties might still be read by others without holding the schema
+	// lock, so they are replaced rather than changed in place
+	props := make([]*models.Property, len(class.Properties))
+	copy(props, class.Properties)
+	for _, target := range targets {
+		for i, prop := range props {
+			if prop.Name == target.Name {
+				props[i] = target
+			}
+		}
+	}
+	class.Properties = props
+
+	if vectorConfig != nil {
+		class.VectorIndexType = pl.Request.VectorIndexType
+		class.VectorIndexConfig = vectorConfig
+	}
+
+	if err := m.saveSchema(ctx); err != nil {
+		return err
+	}
+
+	cleanupErr := m.migrator.CleanupReindex(ctx, pl.ClassName, pl.ID)
+	if commitErr != nil {
+		return commitErr
+	}
+
+	return cleanupErr
+}
+
+func (m *Manager) cancelReindexApplyChanges(ctx context.Context, pl ReindexPayload) error {
+	return m.migrator.CancelReindex(ctx, pl.ClassName, pl.ID)
+}
+
+// watchReindex runs on the node which started the job. It commits the job
+// once every shard is ready, and cancels it everywhere as soon as a single
+// shard failed.
+func (m *Manager) watchReindex(pl ReindexPayload) {
+	logger := m.logger.WithField("action", "reindex").
+		WithField("class", pl.ClassName).
+		WithField("job", pl.ID)
+
+	ticker := time.NewTicker(reindexStatusInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		done, err := m.checkReindex(ctx, pl)
+		cancel()
+
+		if err != nil {
+			logger.WithError(err).Error("failed to finish reindex job")
+		}
+		if done {
+			return
+		}
+	}
+}
+
+// checkReindex returns true once the job has been committed or cancelled
+func (m *Manager) checkReindex(ctx context.Context, pl ReindexPayload) (bool, error) {
+	m.Lock()
+	class := m.getClassByName(pl.ClassName)
+	expected := 0
+	if state := m.state.ShardingState[pl.ClassName]; state != nil {
+		for _, shard := range state.Physical {
+			expected += len(shard.BelongsToNodes)
+		}
+	}
+	m.Unlock()
+
+	if class == nil {
+		// the class was deleted along with the job
+		return true, nil
+	}
+
+	statuses, err := m.reindexJobStatuses(ctx, pl.ClassName, pl.ID)
+	if err != nil {
+		return false, err
+	}
+
+	ready, stopped := 0, 0
+	for _, status := range statuses {
+		switch status.Status {
+		case models.ReindexShardStatusStatusSUCCESS:
+			return true, nil
+		case models.ReindexShardStatusStatusREADY:
+			ready++
+		case models.ReindexShardStatusStatusFAILED, models.ReindexShardStatusStatusCANCELLED:
+			stopped++
+		}
+	}
+
+	// a shard without a status lost the job, for example because its node
+	// was restarted, or cannot be reached. Either way the job cannot finish.
+	if stopped > 0 || len(statuses) < expected {
+		if stopped == len(statuses) {
+			return true, nil
+		}
+		return true, m.finishReindex(ctx, CancelReindex, pl)
+	}
+
+	if ready < len(statuses) {
+		return false, nil
+	}
+
+	if err := m.finishReindex(ctx, CommitReindex, pl); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// finishReindex commits or cancels the job in a cluster-wide transaction
+func (m *Manager) finishReindex(ctx context.Context, txType cluster.TransactionType,
+	pl ReindexPayload,
+) error {
+	m.Lock()
+	defer m.Unlock()
+
+	tx, err := m.cluster.BeginTransaction(ctx, txType, pl)
+	if err != nil {
+		return errors.Wrap(err, "open cluster-wide transaction")
+	}
+
+	if err := m.cluster.CommitTransaction(ctx, tx); err != nil {
+		return errors.Wrap(err, "commit cluster-wide transaction")
+	}
+
+	if txType == CommitReindex {
+		return m.commitReindexApplyChanges(ctx, pl)
+	}
+
+	return m.cancelReindexApplyChanges(ctx, pl)
+}
+
+// GetReindexJob returns the status of the job across all shards of the class
+func (m *Manager) GetReindexJob(ctx context.Context, principal *models.Principal,
+	className, id string,
+) (*models.ReindexJob, error) {
+	err := m.authorizer.Authorize(principal, "list", "schema/*")
+	if err != nil {
+		return nil, err
+	}
+
+	statuses, err := m.reindexJobStatuses(ctx, className, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(statuses) == 0 {
+		return nil, ErrNotFound
+	}
+
+	return reindexJob(className, id, statuses), nil
+}
+
+// CancelReindexJob stops the job in all shards and removes the indexes it
+// has built so far. Jobs which have already finished cannot be cancelled.
+func (m *Manager) CancelReindexJob(ctx context.Context, principal *models.Principal,
+	className, id string,
+) (*models.ReindexJob, error) {
+	err := m.authorizer.Authorize(principal, "update", "schema/objects")
+	if err != nil {
+		return nil, err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	statuses, err := m.reindexJobStatuses(ctx, className, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(statuses) == 0 {
+		return nil, ErrNotFound
+	}
+
+	job := reindexJob(className, id, statuses)
+	if job.Status != models.ReindexJobStatusINPROGRESS {
+		return nil, ErrReindexJobFinished
+	}
+
+	pl := ReindexPayload{ID: id, ClassName: className}
+	tx, err := m.cluster.BeginTransaction(ctx, CancelReindex, pl)
+	if err != nil {
+		return nil, errors.Wrap(err, "open cluster-wide transaction")
+	}
+
+	if err := m.cluster.CommitTransaction(ctx, tx); err != nil {
+		return nil, errors.Wrap(err, "commit cluster-wide transaction")
+	}
+
+	if err := m.cancelReindexApplyChanges(ctx, pl); err != nil {
+		return nil, err
+	}
+
+	job.Status = models.ReindexJobStatusCANCELLED
+	return job, nil
+}
+
+// reindexJobStatuses returns the statuses of the job in all shards of the
+// class, which is empty if the job is not known
+func (m *Manager) reindexJobStatuses(ctx context.Context,
+	className, id string,
+) ([]*models.ReindexShardStatus, error) {
+	all, err := m.migrator.ReindexStatus(ctx, className)
+	if err != nil {
+		return nil, errors.Wrap(err, "get reindex status of shards")
+	}
+
+	var out []*models.ReindexShardStatus
+	for _, status := range all {
+		if status.JobID == id {
+			out = append(out, status)
+		}
+	}
+
+	return out, nil
+}
+
+// reindexJob combines the statuses of the shards. A single shard which
+// failed or was cancelled decides the status of the whole job.
+func reindexJob(className, id string,
+	statuses []*models.ReindexShardStatus,
+) *models.ReindexJob {
+	job := &models.ReindexJob{ID: id, ClassName: className}
+
+	var failed, cancelled, running bool
+	var errs []string
+	for _, status := range statuses {
+		job.ObjectCount += status.ObjectCount
+		job.ObjectsProcessed += status.ObjectsProcessed
+		if status.Error != "" {
+			errs = append(errs, status.Error)
+		}
+
+		switch status.Status {
+		case models.ReindexShardStatusStatusFAILED:
+			failed = true
+		case models.ReindexShardStatusStatusCANCELLED:
+			cancelled = true
+		case models.ReindexShardStatusStatusSUCCESS:
+		default:
+			running = true
+		}
+	}
+
+	switch {
+	case failed:
+		job.Status = models.ReindexJobStatusFAILED
+	case cancelled:
+		job.Status = models.ReindexJobStatusCANCELLED
+	case running:
+		job.Status = models.ReindexJobStatusINPROGRESS
+	default:
+		job.Status = models.ReindexJobStatusSUCCESS
+	}
+	job.Error = strings.Join(errs, ", ")
+
+	return job
+}
@@ -0,0 +1,259 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReindexClass(t *testing.T) {
+	ctx := context.Background()
+
+	newManager := func(t *testing.T) *Manager {
+		mgr := newSchemaManager()
+		require.Nil(t, mgr.AddClass(ctx, nil, &models.Class{
+			Class:           "Article",
+			VectorIndexType: "hnsw",
+			Properties: []*models.Property{
+				{Name: "title", DataType: []string{"text"}},
+				{Name: "location", DataType: []string{"geoCoordinates"}},
+			},
+		}))
+		return mgr
+	}
+
+	t.Run("with invalid requests", func(t *testing.T) {
+		tests := []struct {
+			name   string
+			class  string
+			req    *models.ReindexRequest
+			errMsg string
+		}{
+			{
+				name:   "no changes",
+				class:  "Article",
+				req:    &models.ReindexRequest{},
+				errMsg: "reindex request contains no changes",
+			},
+			{
+				name:  "unknown property",
+				class: "Article",
+				req: &models.ReindexRequest{Properties: []*models.ReindexProperty{
+					{Name: "body", Tokenization: "lowercase"},
+				}},
+				errMsg: `no such prop with name 'body' found in class 'Article' in the schema. Check your schema files for which properties in this class are available`,
+			},
+			{
+				name:  "property listed twice",
+				class: "Article",
+				req: &models.ReindexRequest{Properties: []*models.ReindexProperty{
+					{Name: "title", Tokenization: "lowercase"},
+					{Name: "Title", Tokenization: "word"},
+				}},
+				errMsg: `property "title" is listed more than once`,
+			},
+			{
+				name:  "unsupported data type",
+				class: "Article",
+				req: &models.ReindexRequest{Properties: []*models.ReindexProperty{
+					{Name: "location", IndexInverted: new(bool)},
+				}},
+				errMsg: `property "location": properties of data type "geoCoordinates" cannot be reindexed`,
+			},
+			{
+				name:  "unsupported data type change",
+				class: "Article",
+				req: &models.ReindexRequest{Properties: []*models.ReindexProperty{
+					{Name: "title", DataType: []string{"int"}},
+				}},
+				errMsg: `property "title": data type "text" cannot be changed to "int"`,
+			},
+			{
+				name:  "invalid tokenization",
+				class: "Article",
+				req: &models.ReindexRequest{Properties: []*models.ReindexProperty{
+					{Name: "title", Tokenization: "field"},
+				}},
+				errMsg: "Tokenization 'field' is not allowed for data type 'text'",
+			},
+			{
+				name:   "unchanged vector index type",
+				class:  "Article",
+				req:    &models.ReindexRequest{VectorIndexType: "hnsw"},
+				errMsg: `vector index type is already "hnsw"`,
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				_, err := newManager(t).ReindexClass(ctx, nil, test.class, test.req)
+				assert.EqualError(t, err, test.errMsg)
+			})
+		}
+	})
+
+	t.Run("on a class that does not exist", func(t *testing.T) {
+		_, err := newManager(t).ReindexClass(ctx, nil, "Missing",
+			&models.ReindexRequest{VectorIndexType: "flat"})
+		assert.Equal(t, ErrNotFound, err)
+	})
+
+	t.Run("get and cancel an unknown job", func(t *testing.T) {
+		mgr := newManager(t)
+
+		_, err := mgr.GetReindexJob(ctx, nil, "Article", "unknown")
+		assert.Equal(t, ErrNotFound, err)
+
+		_, err = mgr.CancelReindexJob(ctx, nil, "Article", "unknown")
+		assert.Equal(t, ErrNotFound, err)
+	})
+
+	t.Run("committing a job updates the schema", func(t *testing.T) {
+		mgr := newManager(t)
+
+		err := mgr.commitReindexApplyChanges(ctx, ReindexPayload{
+			ID:        "job",
+			ClassName: "Article",
+			Request: &models.ReindexRequest{
+				Properties: []*models.ReindexProperty{
+					{
+						Name: "title", DataType: []string{"string"},
+						Tokenization: "lowercase", Stemmer: "en",
+					},
+				},
+				VectorIndexType: "flat",
+			},
+		})
+		require.Nil(t, err)
+
+		class, err := mgr.GetClass(ctx, nil, "Article")
+		require.Nil(t, err)
+		assert.Equal(t, []string{"string"}, class.Properties[0].DataType)
+		assert.Equal(t, "lowercase", class.Properties[0].Tokenization)
+		assert.Equal(t, "en", class.Properties[0].Stemmer)
+		assert.Equal(t, "flat", class.VectorIndexType)
+	})
+
+	t.Run("the shards swap the indexes before the schema is saved", func(t *testing.T) {
+		mgr := newManager(t)
+		migrator := &reindexCommitMigrator{mgr: mgr}
+		mgr.migrator = migrator
+
+		err := mgr.commitReindexApplyChanges(ctx, ReindexPayload{
+			ID:        "job",
+			ClassName: "Article",
+			Request: &models.ReindexRequest{Properties: []*models.ReindexProperty{
+				{Name: "title", Tokenization: "lowercase"},
+			}},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, []string{"commit with word", "cleanup"}, migrator.calls)
+	})
+
+	t.Run("the schema is saved if a shard fails to swap", func(t *testing.T) {
+		mgr := newManager(t)
+		migrator := &reindexCommitMigrator{mgr: mgr, commitErr: errors.New("disk full")}
+		mgr.migrator = migrator
+
+		err := mgr.commitReindexApplyChanges(ctx, ReindexPayload{
+			ID:        "job",
+			ClassName: "Article",
+			Request: &models.ReindexRequest{Properties: []*models.ReindexProperty{
+				{Name: "title", Tokenization: "lowercase"},
+			}},
+		})
+		assert.EqualError(t, err, "disk full")
+
+		class, err := mgr.GetClass(ctx, nil, "Article")
+		require.Nil(t, err)
+		assert.Equal(t, "lowercase", class.Properties[0].Tokenization)
+		assert.Equal(t, []string{"commit with word", "cleanup"}, migrator.calls)
+	})
+}
+
+// reindexCommitMigrator records the calls which commit a job, along with the
+// tokenization of the first prop in the schema at the time of the commit
+type reindexCommitMigrator struct {
+	NilMigrator
+	mgr       *Manager
+	commitErr error
+	calls     []string
+}
+
+func (m *reindexCommitMigrator) CommitReindex(ctx context.Context,
+	className, id string,
+) error {
+	class := m.mgr.getClassByName(className)
+	m.calls = append(m.calls, "commit with "+class.Properties[0].Tokenization)
+	return m.commitErr
+}
+
+func (m *reindexCommitMigrator) CleanupReindex(ctx context.Context,
+	className, id string,
+) error {
+	m.calls = append(m.calls, "cleanup")
+	return nil
+}
+
+func TestReindexJobStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		statuses []string
+		expected string
+	}{
+		{
+			name:     "all shards succeeded",
+			statuses: []string{"SUCCESS", "SUCCESS"},
+			expected: models.ReindexJobStatusSUCCESS,
+		},
+		{
+			name:     "some shards are still running",
+			statuses: []string{"READY", "IN_PROGRESS"},
+			expected: models.ReindexJobStatusINPROGRESS,
+		},
+		{
+			name:     "a shard was cancelled",
+			statuses: []string{"READY", "CANCELLED"},
+			expected: models.ReindexJobStatusCANCELLED,
+		},
+		{
+			name:     "a shard failed",
+			statuses: []string{"CANCELLED", "FAILED"},
+			expected: models.ReindexJobStatusFAILED,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var statuses []*models.ReindexShardStatus
+			for _, status := range test.statuses {
+				statuses = append(statuses, &models.ReindexShardStatus{
+					JobID:            "job",
+					Status:           status,
+					ObjectCount:      3,
+					ObjectsProcessed: 2,
+				})
+			}
+
+			job := reindexJob("Article", "job", statuses)
+			assert.Equal(t, test.expected, job.Status)
+			assert.Equal(t, int64(6), job.ObjectCount)
+			assert.Equal(t, int64(4), job.ObjectsProcessed)
+		})
+	}
+}
@@ -0,0 +1,216 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/sharding"
+)
+
+// AddTenants creates a new shard for each of the specified tenants. The
+// class must have been created with multi-tenancy enabled.
+func (m *Manager) AddTenants(ctx context.Context, principal *models.Principal,
+	className string, tenants []*models.Tenant,
+) error {
+	err := m.authorizer.Authorize(principal, "create",
+		fmt.Sprintf("schema/%s/tenants", className))
+	if err != nil {
+		return err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	class, ss, err := m.multiTenantClass(className)
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, len(tenants))
+	seen := map[string]struct{}{}
+	for i, tenant := range tenants {
+		if tenant == nil {
+			return errors.Errorf("tenant at position %d is empty", i)
+		}
+
+		if err := schema.ValidateTenantName(tenant.Name); err != nil {
+			return err
+		}
+
+		if _, ok := seen[tenant.Name]; ok {
+			return errors.Errorf("tenant %q is specified more than once", tenant.Name)
+		}
+		seen[tenant.Name] = struct{}{}
+		names[i] = tenant.Name
+	}
+
+	partitions, err := ss.AllocatePartitions(names, m.clusterState,
+		class.ReplicationConfig.Factor)
+	if err != nil {
+		return errors.Wrap(err, "allocate tenants")
+	}
+
+	tx, err := m.cluster.BeginTransaction(ctx, AddTenants,
+		AddTenantsPayload{className, partitions})
+	if err != nil {
+		// possible causes for errors could be nodes down (we expect every node to
+		// the up for a schema transaction) or concurrent transactions from other
+		// nodes
+		return errors.Wrap(err, "open cluster-wide transaction")
+	}
+
+	if err := m.cluster.CommitTransaction(ctx, tx); err != nil {
+		return errors.Wrap(err, "commit cluster-wide transaction")
+	}
+
+	return m.addTenantsApplyChanges(ctx, className, partitions)
+}
+
+func (m *Manager) addTenantsApplyChanges(ctx context.Context,
+	className string, partitions []sharding.Physical,
+) error {
+	ss, ok := m.state.ShardingState[className]
+	if !ok {
+		return errors.Errorf("sharding state for class %q not found", className)
+	}
+
+	// the previous state might still be read by others without holding the
+	// schema lock, so we swap in a modified copy instead of changing it in place
+	updated := ss.DeepCopy()
+	names := make([]string, len(partitions))
+	for i, p := range partitions {
+		updated.AddPartition(p)
+		names[i] = p.Name
+	}
+	m.state.ShardingState[className] = updated
+
+	if err := m.saveSchema(ctx); err != nil {
+		return err
+	}
+
+	return m.migrator.NewTenants(ctx, className, names)
+}
+
+// DeleteTenants removes the specified tenants including all of their data
+func (m *Manager) DeleteTenants(ctx context.Context, principal *models.Principal,
+	className string, tenants []string,
+) error {
+	err := m.authorizer.Authorize(principal, "delete",
+		fmt.Sprintf("schema/%s/tenants", className))
+	if err != nil {
+		return err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	_, ss, err := m.multiTenantClass(className)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range tenants {
+		if _, ok := ss.Physical[name]; !ok {
+			return errors.Errorf("tenant %q does not exist", name)
+		}
+	}
+
+	tx, err := m.cluster.BeginTransaction(ctx, DeleteTenants,
+		DeleteTenantsPayload{className, tenants})
+	if err != nil {
+		// possible causes for errors could be nodes down (we expect every node to
+		// the up for a schema transaction) or concurrent transactions from other
+		// nodes
+		return errors.Wrap(err, "open cluster-wide transaction")
+	}
+
+	if err := m.cluster.CommitTransaction(ctx, tx); err != nil {
+		return errors.Wrap(err, "commit cluster-wide transaction")
+	}
+
+	return m.deleteTenantsApplyChanges(ctx, className, tenants)
+}
+
+func (m *Manager) deleteTenantsApplyChanges(ctx context.Context,
+	className string, tenants []string,
+) error {
+	ss, ok := m.state.ShardingState[className]
+	if !ok {
+		return errors.Errorf("sharding state for class %q not found", className)
+	}
+
+	updated := ss.DeepCopy()
+	for _, name := range tenants {
+		updated.DeletePartition(name)
+	}
+	m.state.ShardingState[className] = updated
+
+	if err := m.saveSchema(ctx); err != nil {
+		return err
+	}
+
+	return m.migrator.DeleteTenants(ctx, className, tenants)
+}
+
+// GetTenants lists all tenants of a multi-tenant class ordered by name
+func (m *Manager) GetTenants(ctx context.Context, principal *models.Principal,
+	className string,
+) ([]*models.Tenant, error) {
+	err := m.authorizer.Authorize(principal, "list",
+		fmt.Sprintf("schema/%s/tenants", className))
+	if err != nil {
+		return nil, err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	_, ss, err := m.multiTenantClass(className)
+	if err != nil {
+		return nil, err
+	}
+
+	names := ss.AllPhysicalShards()
+	out := make([]*models.Tenant, len(names))
+	for i, name := range names {
+		out[i] = &models.Tenant{Name: name}
+	}
+
+	return out, nil
+}
+
+func (m *Manager) multiTenantClass(className string) (*models.Class,
+	*sharding.State, error,
+) {
+	class := m.getClassByName(className)
+	if class == nil {
+		return nil, nil, ErrNotFound
+	}
+
+	if !schema.MultiTenancyEnabled(class) {
+		return nil, nil, errors.Errorf("multi-tenancy is not enabled for class %q",
+			className)
+	}
+
+	ss, ok := m.state.ShardingState[className]
+	if !ok {
+		return nil, nil, errors.Errorf("sharding state for class %q not found",
+			className)
+	}
+
+	return class, ss, nil
+}
@@ -0,0 +1,88 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTenants(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("on a class without multi-tenancy", func(t *testing.T) {
+		mgr := newSchemaManager()
+		require.Nil(t, mgr.AddClass(ctx, nil, &models.Class{Class: "Plain"}))
+
+		err := mgr.AddTenants(ctx, nil, "Plain", []*models.Tenant{{Name: "t1"}})
+		assert.EqualError(t, err, `multi-tenancy is not enabled for class "Plain"`)
+	})
+
+	t.Run("on a class that does not exist", func(t *testing.T) {
+		mgr := newSchemaManager()
+
+		_, err := mgr.GetTenants(ctx, nil, "Missing")
+		assert.Equal(t, ErrNotFound, err)
+	})
+
+	t.Run("add, list and delete", func(t *testing.T) {
+		mgr := newSchemaManager()
+		require.Nil(t, mgr.AddClass(ctx, nil, &models.Class{
+			Class:              "MultiTenant",
+			MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true},
+		}))
+
+		tenants, err := mgr.GetTenants(ctx, nil, "MultiTenant")
+		require.Nil(t, err)
+		assert.Len(t, tenants, 0)
+
+		err = mgr.AddTenants(ctx, nil, "MultiTenant",
+			[]*models.Tenant{{Name: "t2"}, {Name: "t1"}})
+		require.Nil(t, err)
+
+		tenants, err = mgr.GetTenants(ctx, nil, "MultiTenant")
+		require.Nil(t, err)
+		assert.Equal(t, []*models.Tenant{{Name: "t1"}, {Name: "t2"}}, tenants)
+
+		err = mgr.AddTenants(ctx, nil, "MultiTenant", []*models.Tenant{{Name: "t1"}})
+		assert.EqualError(t, err, `allocate tenants: partition "t1" already exists`)
+
+		err = mgr.DeleteTenants(ctx, nil, "MultiTenant", []string{"t1"})
+		require.Nil(t, err)
+
+		tenants, err = mgr.GetTenants(ctx, nil, "MultiTenant")
+		require.Nil(t, err)
+		assert.Equal(t, []*models.Tenant{{Name: "t2"}}, tenants)
+
+		err = mgr.DeleteTenants(ctx, nil, "MultiTenant", []string{"t1"})
+		assert.EqualError(t, err, `tenant "t1" does not exist`)
+	})
+
+	t.Run("with invalid tenant names", func(t *testing.T) {
+		mgr := newSchemaManager()
+		require.Nil(t, mgr.AddClass(ctx, nil, &models.Class{
+			Class:              "MultiTenant",
+			MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true},
+		}))
+
+		err := mgr.AddTenants(ctx, nil, "MultiTenant", []*models.Tenant{nil})
+		assert.EqualError(t, err, "tenant at position 0 is empty")
+
+		err = mgr.AddTenants(ctx, nil, "MultiTenant",
+			[]*models.Tenant{{Name: "t1"}, {Name: "t1"}})
+		assert.EqualError(t, err, `tenant "t1" is specified more than once`)
+	})
+}
@@ -21,10 +21,16 @@ import (
 )
 
 const (
-	AddClass    cluster.TransactionType = "add_class"
-	AddProperty cluster.TransactionType = "add_property"
-	DeleteClass cluster.TransactionType = "delete_class"
-	UpdateClass cluster.TransactionType = "update_class"
+	AddClass       cluster.TransactionType = "add_class"
+	AddProperty    cluster.TransactionType = "add_property"
+	DeleteProperty cluster.TransactionType = "delete_property"
+	DeleteClass    cluster.TransactionType = "delete_class"
+	UpdateClass    cluster.TransactionType = "update_class"
+	AddTenants     cluster.TransactionType = "add_tenants"
+	DeleteTenants  cluster.TransactionType = "delete_tenants"
+	StartReindex   cluster.TransactionType = "start_reindex"
+	CommitReindex  cluster.TransactionType = "commit_reindex"
+	CancelReindex  cluster.TransactionType = "cancel_reindex"
 )
 
 type AddClassPayload struct {
@@ -37,6 +43,11 @@ type AddPropertyPayload struct {
 	Property  *models.Property `json:"property"`
 }
 
+type DeletePropertyPayload struct {
+	ClassName    string `json:"className"`
+	PropertyName string `json:"propertyName"`
+}
+
 type DeleteClassPayload struct {
 	ClassName string `json:"className"`
 }
@@ -51,6 +62,24 @@ type UpdateClassPayload struct {
 	State *sharding.State `json:"state"`
 }
 
+type AddTenantsPayload struct {
+	ClassName  string              `json:"className"`
+	Partitions []sharding.Physical `json:"partitions"`
+}
+
+type DeleteTenantsPayload struct {
+	ClassName string   `json:"className"`
+	Tenants   []string `json:"tenants"`
+}
+
+// ReindexPayload is shared by the transactions which start, commit and cancel
+// a reindex job. The request is not needed to cancel a job.
+type ReindexPayload struct {
+	ID        string                 `json:"id"`
+	ClassName string                 `json:"className"`
+	Request   *models.ReindexRequest `json:"request"`
+}
+
 func UnmarshalTransaction(txType cluster.TransactionType,
 	payload json.RawMessage,
 ) (interface{}, error) {
@@ -61,12 +90,24 @@ func UnmarshalTransaction(txType cluster.TransactionType,
 	case AddProperty:
 		return unmarshalAddProperty(payload)
 
+	case DeleteProperty:
+		return unmarshalDeleteProperty(payload)
+
 	case DeleteClass:
 		return unmarshalDeleteClass(payload)
 
 	case UpdateClass:
 		return unmarshalUpdateClass(payload)
 
+	case AddTenants:
+		return unmarshalAddTenants(payload)
+
+	case DeleteTenants:
+		return unmarshalDeleteTenants(payload)
+
+	case StartReindex, CommitReindex, CancelReindex:
+		return unmarshalReindex(payload)
+
 	default:
 		return nil, errors.Errorf("unrecognized schema transaction type %q", txType)
 
@@ -91,6 +132,15 @@ func unmarshalAddProperty(payload json.RawMessage) (interface{}, error) {
 	return pl, nil
 }
 
+func unmarshalDeleteProperty(payload json.RawMessage) (interface{}, error) {
+	var pl DeletePropertyPayload
+	if err := json.Unmarshal(payload, &pl); err != nil {
+		return nil, err
+	}
+
+	return pl, nil
+}
+
 func unmarshalDeleteClass(payload json.RawMessage) (interface{}, error) {
 	var pl DeleteClassPayload
 	if err := json.Unmarshal(payload, &pl); err != nil {
@@ -108,3 +158,30 @@ func unmarshalUpdateClass(payload json.RawMessage) (interface{}, error) {
 
 	return pl, nil
 }
+
+func unmarshalAddTenants(payload json.RawMessage) (interface{}, error) {
+	var pl AddTenantsPayload
+	if err := json.Unmarshal(payload, &pl); err != nil {
+		return nil, err
+	}
+
+	return pl, nil
+}
+
+func unmarshalDeleteTenants(payload json.RawMessage) (interface{}, error) {
+	var pl DeleteTenantsPayload
+	if err := json.Unmarshal(payload, &pl); err != nil {
+		return nil, err
+	}
+
+	return pl, nil
+}
+
+func unmarshalReindex(payload json.RawMessage) (interface{}, error) {
+	var pl ReindexPayload
+	if err := json.Unmarshal(payload, &pl); err != nil {
+		return nil, err
+	}
+
+	return pl, nil
+}
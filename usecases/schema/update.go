@@ -60,6 +60,10 @@ func (m *Manager) UpdateClass(ctx context.Context, principal *models.Principal,
 		return errors.Wrap(err, "vector index config")
 	}
 
+	if !reflect.DeepEqual(initial.VectorConfig, updated.VectorConfig) {
+		return errors.Errorf("named vectors are immutable")
+	}
+
 	if err := m.migrator.ValidateInvertedIndexConfigUpdate(ctx,
 		initial.InvertedIndexConfig, updated.InvertedIndexConfig); err != nil {
 		return errors.Wrap(err, "inverted index config")
@@ -118,10 +122,16 @@ func (m *Manager) validateImmutableFields(initial, updated *models.Class) error
 		{
 			name:     "vectorizer",
 			accessor: func(c *models.Class) string { return c.Vectorizer },
+			// a reindex job rebuilds the vector index from the stored vectors,
+			// it never calls the vectorizer
+			hint: "a reindex job does not recompute the vectors, import the " +
+				"objects into a new class to change the vectorizer",
 		},
 		{
 			name:     "vector index type",
 			accessor: func(c *models.Class) string { return c.VectorIndexType },
+			hint: "use a reindex job (e.g. \"POST /v1/schema/{className}/reindex\") " +
+				"to change the vector index type",
 		},
 	}
 
@@ -142,12 +152,26 @@ func (m *Manager) validateImmutableFields(initial, updated *models.Class) error
 		return errors.Errorf("module config is immutable")
 	}
 
+	if initial.ReplicationConfig.Factor != updated.ReplicationConfig.Factor {
+		return errors.Errorf("replication factor is immutable: attempted change "+
+			"from %d to %d", initial.ReplicationConfig.Factor,
+			updated.ReplicationConfig.Factor)
+	}
+
+	if schema.MultiTenancyEnabled(initial) != schema.MultiTenancyEnabled(updated) {
+		return errors.Errorf("multi-tenancy is immutable: attempted change "+
+			"from %t to %t", schema.MultiTenancyEnabled(initial),
+			schema.MultiTenancyEnabled(updated))
+	}
+
 	return nil
 }
 
 type immutableText struct {
 	accessor func(c *models.Class) string
 	name     string
+	// hint is appended to the error, if the field can be changed in another way
+	hint string
 }
 
 func (m *Manager) validateImmutableTextField(u immutableText,
@@ -156,6 +180,10 @@ func (m *Manager) validateImmutableTextField(u immutableText,
 	oldField := u.accessor(previous)
 	newField := u.accessor(next)
 	if oldField != newField {
+		if u.hint != "" {
+			return errors.Errorf("%s is immutable: attempted change from %q to %q. %s",
+				u.name, oldField, newField, u.hint)
+		}
 		return errors.Errorf("%s is immutable: attempted change from %q to %q",
 			u.name, oldField, newField)
 	}
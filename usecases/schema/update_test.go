@@ -57,7 +57,9 @@ func TestClassUpdates(t *testing.T) {
 				update:  &models.Class{Class: "InitialName", Vectorizer: "model2"},
 				expectedError: errors.Errorf(
 					"vectorizer is immutable: " +
-						"attempted change from \"model1\" to \"model2\""),
+						"attempted change from \"model1\" to \"model2\". " +
+						"a reindex job does not recompute the vectors, import the " +
+						"objects into a new class to change the vectorizer"),
 			},
 			{
 				name:    "attempting to modify the vector index type",
@@ -65,7 +67,9 @@ func TestClassUpdates(t *testing.T) {
 				update:  &models.Class{Class: "InitialName", VectorIndexType: "lsh"},
 				expectedError: errors.Errorf(
 					"vector index type is immutable: " +
-						"attempted change from \"hnsw\" to \"lsh\""),
+						"attempted change from \"hnsw\" to \"lsh\". " +
+						"use a reindex job (e.g. \"POST /v1/schema/{className}/reindex\") " +
+						"to change the vector index type"),
 			},
 			{
 				name:    "attempting to add a property",
@@ -16,8 +16,10 @@ import (
 	"fmt"
 
 	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/inverted/stemmer"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/vectorindex/flat"
 	"github.com/semi-technologies/weaviate/usecases/config"
 )
 
@@ -44,12 +46,16 @@ func validatePropertyTokenization(tokenization string, propertyDataType schema.P
 		switch primitiveDataType {
 		case schema.DataTypeString, schema.DataTypeStringArray:
 			switch tokenization {
-			case models.PropertyTokenizationField, models.PropertyTokenizationWord:
+			case models.PropertyTokenizationField, models.PropertyTokenizationWord,
+				models.PropertyTokenizationWhitespace, models.PropertyTokenizationLowercase,
+				models.PropertyTokenizationTrigram, models.PropertyTokenizationCjk:
 				return nil
 			}
 		case schema.DataTypeText, schema.DataTypeTextArray:
 			switch tokenization {
-			case models.PropertyTokenizationWord:
+			case models.PropertyTokenizationWord,
+				models.PropertyTokenizationWhitespace, models.PropertyTokenizationLowercase,
+				models.PropertyTokenizationTrigram, models.PropertyTokenizationCjk:
 				return nil
 			}
 		default:
@@ -68,6 +74,39 @@ func validatePropertyTokenization(tokenization string, propertyDataType schema.P
 	return fmt.Errorf("Tokenization '%s' is not allowed for reference data type", tokenization)
 }
 
+// validatePropertyStemmer only allows stemming lowercased words, which
+// excludes the n-gram tokenizations and strings tokenized by word, as the
+// latter keep their casing
+func validatePropertyStemmer(stemmerName, tokenization string, propertyDataType schema.PropertyDataType) error {
+	if _, err := stemmer.ForName(stemmerName); err != nil {
+		return err
+	}
+
+	if stemmerName == "" || stemmerName == stemmer.NoStemmer {
+		return nil
+	}
+
+	if !propertyDataType.IsPrimitive() {
+		return fmt.Errorf("Stemmer '%s' is not allowed for reference data type", stemmerName)
+	}
+
+	primitiveDataType := propertyDataType.AsPrimitive()
+	switch primitiveDataType {
+	case schema.DataTypeString, schema.DataTypeStringArray:
+		if tokenization == models.PropertyTokenizationLowercase {
+			return nil
+		}
+	case schema.DataTypeText, schema.DataTypeTextArray:
+		switch tokenization {
+		case models.PropertyTokenizationWord, models.PropertyTokenizationLowercase:
+			return nil
+		}
+	}
+
+	return fmt.Errorf("Stemmer '%s' is not allowed for data type '%s' with tokenization '%s'",
+		stemmerName, primitiveDataType, tokenization)
+}
+
 func (m *Manager) validateVectorSettings(ctx context.Context, class *models.Class) error {
 	if err := m.validateVectorizer(ctx, class); err != nil {
 		return err
@@ -77,6 +116,10 @@ func (m *Manager) validateVectorSettings(ctx context.Context, class *models.Clas
 		return err
 	}
 
+	if err := m.validateNamedVectors(ctx, class); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -93,11 +136,53 @@ func (m *Manager) validateVectorizer(ctx context.Context, class *models.Class) e
 }
 
 func (m *Manager) validateVectorIndex(ctx context.Context, class *models.Class) error {
-	switch class.VectorIndexType {
-	case "hnsw":
+	return validateVectorIndexType(class.VectorIndexType)
+}
+
+func validateVectorIndexType(vectorIndexType string) error {
+	switch vectorIndexType {
+	case "hnsw", flat.IndexType:
 		return nil
 	default:
 		return errors.Errorf("unrecognized or unsupported vectorIndexType %q",
-			class.VectorIndexType)
+			vectorIndexType)
 	}
 }
+
+func (m *Manager) validateNamedVectors(ctx context.Context, class *models.Class) error {
+	for name, vectorConfig := range class.VectorConfig {
+		if err := schema.ValidateVectorName(name); err != nil {
+			return err
+		}
+
+		if vectorConfig.Vectorizer != config.VectorizerModuleNone {
+			if err := m.vectorizerValidator.ValidateVectorizer(
+				vectorConfig.Vectorizer); err != nil {
+				return errors.Wrapf(err, "named vector %q: vectorizer", name)
+			}
+		}
+
+		if err := validateVectorIndexType(vectorConfig.VectorIndexType); err != nil {
+			return errors.Wrapf(err, "named vector %q", name)
+		}
+
+		for _, prop := range vectorConfig.SourceProperties {
+			if !hasProperty(class, prop) {
+				return errors.Errorf("named vector %q: source property %q "+
+					"does not exist in class %q", name, prop, class.Class)
+			}
+		}
+	}
+
+	return nil
+}
+
+func hasProperty(class *models.Class, name string) bool {
+	for _, prop := range class.Properties {
+		if prop.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
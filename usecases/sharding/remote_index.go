@@ -13,23 +13,34 @@ package sharding
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/entities/additional"
 	"github.com/semi-technologies/weaviate/entities/aggregation"
+	"github.com/semi-technologies/weaviate/entities/errorcompounder"
 	"github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/semi-technologies/weaviate/entities/search"
 	"github.com/semi-technologies/weaviate/entities/searchparams"
 	"github.com/semi-technologies/weaviate/entities/storobj"
 	"github.com/semi-technologies/weaviate/usecases/objects"
+	"github.com/sirupsen/logrus"
 )
 
+// backgroundWriteTimeout bounds the writes to replicas which are still
+// running once a replicated write has returned
+const backgroundWriteTimeout = 5 * time.Minute
+
 type RemoteIndex struct {
 	class        string
 	stateGetter  shardingStateGetter
 	client       RemoteIndexClient
 	nodeResolver nodeResolver
+	logger       logrus.FieldLogger
 }
 
 type shardingStateGetter interface {
@@ -38,13 +49,14 @@ type shardingStateGetter interface {
 
 func NewRemoteIndex(className string,
 	stateGetter shardingStateGetter, nodeResolver nodeResolver,
-	client RemoteIndexClient,
+	client RemoteIndexClient, logger logrus.FieldLogger,
 ) *RemoteIndex {
 	return &RemoteIndex{
 		class:        className,
 		stateGetter:  stateGetter,
 		client:       client,
 		nodeResolver: nodeResolver,
+		logger:       logger,
 	}
 }
 
@@ -71,256 +83,592 @@ type RemoteIndexClient interface {
 	MultiGetObjects(ctx context.Context, hostname, indexName, shardName string,
 		ids []strfmt.UUID) ([]*storobj.Object, error)
 	SearchShard(ctx context.Context, hostname, indexName, shardName string,
-		searchVector []float32, limit int, filters *filters.LocalFilter,
+		searchVector []float32, targetVector string, limit int, filters *filters.LocalFilter,
 		keywordRanking *searchparams.KeywordRanking, sort []filters.Sort,
-		additional additional.Properties) ([]*storobj.Object, []float32, error)
+		cursor *filters.Cursor, additional additional.Properties,
+	) ([]*storobj.Object, []float32, error)
 	Aggregate(ctx context.Context, hostname, indexName, shardName string,
 		params aggregation.Params) (*aggregation.Result, error)
 	FindDocIDs(ctx context.Context, hostName, indexName, shardName string,
 		filters *filters.LocalFilter) ([]uint64, error)
 	DeleteObjectBatch(ctx context.Context, hostName, indexName, shardName string,
 		docIDs []uint64, dryRun bool) objects.BatchSimpleObjects
+	DeleteObjects(ctx context.Context, hostName, indexName, shardName string,
+		ids []strfmt.UUID) []error
 	GetShardStatus(ctx context.Context, hostName, indexName, shardName string) (string, error)
 	UpdateShardStatus(ctx context.Context, hostName, indexName, shardName,
 		targetStatus string) error
 }
 
-func (ri *RemoteIndex) PutObject(ctx context.Context, shardName string,
-	obj *storobj.Object,
-) error {
-	shard, ok := ri.stateGetter.ShardingState(ri.class).Physical[shardName]
-	if !ok {
-		return errors.Errorf("class %s has no physical shard %q", ri.class, shardName)
+// replica is a remote node holding a copy of a shard
+type replica struct {
+	node string
+	host string
+}
+
+// remoteReplicas resolves all nodes other than the local one which hold a
+// replica of the specified shard
+func (ri *RemoteIndex) remoteReplicas(shardName string) ([]replica, error) {
+	state := ri.stateGetter.ShardingState(ri.class)
+	if _, ok := state.Physical[shardName]; !ok {
+		return nil, errors.Errorf("class %s has no physical shard %q", ri.class, shardName)
+	}
+
+	nodes := state.RemoteReplicas(shardName)
+	if len(nodes) == 0 {
+		return nil, errors.Errorf("shard %q of class %s has no remote replicas",
+			shardName, ri.class)
 	}
 
-	host, ok := ri.nodeResolver.NodeHostname(shard.BelongsToNode)
-	if !ok {
-		return errors.Errorf("resolve node name %q to host", shard.BelongsToNode)
+	out := make([]replica, len(nodes))
+	for i, node := range nodes {
+		host, ok := ri.nodeResolver.NodeHostname(node)
+		if !ok {
+			return nil, errors.Errorf("resolve node name %q to host", node)
+		}
+		out[i] = replica{node: node, host: host}
 	}
 
-	return ri.client.PutObject(ctx, host, ri.class, shardName, obj)
+	return out, nil
 }
 
-// helper for single errors that affect the entire batch, assign the error to
-// every single item in the batch
-func duplicateErr(in error, count int) []error {
-	out := make([]error, count)
-	for i := range out {
-		out[i] = in
-	}
-	return out
+// participant is a replica taking part in a replicated write
+type participant struct {
+	node string
+	// the write to the local replica is always waited for, so that it never
+	// outlives the request
+	local bool
+	write func(ctx context.Context) []error
 }
 
-func (ri *RemoteIndex) BatchPutObjects(ctx context.Context, shardName string,
-	objs []*storobj.Object,
+// replicaWrite is the outcome of a write on a single replica, with one error
+// per item
+type replicaWrite struct {
+	node  string
+	local bool
+	errs  []error
+}
+
+// replicate sends a write of count items to the replicas of the shard. If
+// this node holds a replica of the shard, local applies the write to it, the
+// remote replicas are written to with write. A shard without remote
+// replicas is written to directly by local.
+func (ri *RemoteIndex) replicate(ctx context.Context, shardName string,
+	count int, repl *additional.ReplicationProperties, local func() []error,
+	write func(ctx context.Context, host string) []error,
 ) []error {
-	shard, ok := ri.stateGetter.ShardingState(ri.class).Physical[shardName]
-	if !ok {
-		return duplicateErr(errors.Errorf("class %s has no physical shard %q",
-			ri.class, shardName), len(objs))
+	state := ri.stateGetter.ShardingState(ri.class)
+	var participants []participant
+	if state.IsShardLocal(shardName) {
+		if len(state.RemoteReplicas(shardName)) == 0 {
+			return local()
+		}
+		participants = append(participants, participant{
+			node:  state.localNodeName,
+			local: true,
+			write: func(context.Context) []error { return local() },
+		})
 	}
 
-	host, ok := ri.nodeResolver.NodeHostname(shard.BelongsToNode)
-	if !ok {
-		return duplicateErr(errors.Errorf("resolve node name %q to host",
-			shard.BelongsToNode), len(objs))
+	replicas, err := ri.remoteReplicas(shardName)
+	if err != nil {
+		return duplicateErr(err, count)
 	}
 
-	return ri.client.BatchPutObjects(ctx, host, ri.class, shardName, objs)
+	participants = append(participants, remoteParticipants(replicas, write)...)
+	return ri.coordinate(ctx, shardName, count, repl, nil, participants)
 }
 
-func (ri *RemoteIndex) BatchAddReferences(ctx context.Context, shardName string,
-	refs objects.BatchReferences,
+// replicateOne is the single item equivalent of replicate
+func (ri *RemoteIndex) replicateOne(ctx context.Context, shardName string,
+	repl *additional.ReplicationProperties, local func() error,
+	write func(ctx context.Context, host string) error,
+) error {
+	return ri.replicate(ctx, shardName, 1, repl, func() []error {
+		return []error{local()}
+	}, func(ctx context.Context, host string) []error {
+		return []error{write(ctx, host)}
+	})[0]
+}
+
+func remoteParticipants(replicas []replica,
+	write func(ctx context.Context, host string) []error,
+) []participant {
+	out := make([]participant, len(replicas))
+	for i, r := range replicas {
+		host := r.host
+		out[i] = participant{
+			node:  r.node,
+			write: func(ctx context.Context) []error { return write(ctx, host) },
+		}
+	}
+	return out
+}
+
+// coordinate sends a write of count items to all participants at once. The
+// replicas in acked have already applied the write. It returns as soon as
+// every item was either acknowledged by as many replicas as the consistency
+// level requires, or failed on too many replicas to still reach it. Writes
+// to the remaining remote replicas continue in the background, failures
+// among them are only logged. Writes are not rolled back, the error of an
+// item which did not reach the consistency level is a *ReplicationError.
+func (ri *RemoteIndex) coordinate(ctx context.Context, shardName string,
+	count int, repl *additional.ReplicationProperties, acked []string,
+	participants []participant,
 ) []error {
-	shard, ok := ri.stateGetter.ShardingState(ri.class).Physical[shardName]
-	if !ok {
-		return duplicateErr(errors.Errorf("class %s has no physical shard %q",
-			ri.class, shardName), len(refs))
+	required := repl.RequiredReplicas(len(acked) + len(participants))
+	outcomes := make([]*ReplicationError, count)
+	for pos := range outcomes {
+		outcomes[pos] = NewReplicationError(shardName)
+		for _, node := range acked {
+			outcomes[pos].Add(node, nil)
+		}
+	}
+
+	// the remote writes must not be aborted once the request has returned,
+	// they are only cancelled if the request is cancelled while they are
+	// still waited for, or after backgroundWriteTimeout
+	writeCtx, cancel := context.WithCancel(detachedContext{ctx})
+	results := make(chan replicaWrite, len(participants))
+	pending := make(map[string]struct{}, len(participants))
+	localPending := false
+	for _, p := range participants {
+		pending[p.node] = struct{}{}
+		localPending = localPending || p.local
+		go func(p participant) {
+			results <- replicaWrite{node: p.node, local: p.local, errs: p.write(writeCtx)}
+		}(p)
+	}
+
+	decided := func() bool {
+		for _, o := range outcomes {
+			if len(o.Acked) < required && len(o.Acked)+len(pending) >= required {
+				return false
+			}
+		}
+		return true
+	}
+
+	done := ctx.Done()
+	for len(pending) > 0 && (localPending || !decided()) {
+		select {
+		case r := <-results:
+			delete(pending, r.node)
+			localPending = localPending && !r.local
+			for pos, o := range outcomes {
+				o.Add(r.node, itemErr(r.errs, pos))
+			}
+		case <-done:
+			// the remaining writes fail right away with the cancelled context
+			cancel()
+			done = nil
+		}
+	}
+
+	stillPending := make([]string, 0, len(pending))
+	for node := range pending {
+		stillPending = append(stillPending, node)
+	}
+	sort.Strings(stillPending)
+
+	out := make([]error, count)
+	diverged := 0
+	for pos, o := range outcomes {
+		o.Pending = stillPending
+		out[pos] = o.ToErrorForLevel(repl)
+		if out[pos] == nil && len(o.Failed) > 0 {
+			diverged++
+		}
+	}
+
+	if diverged > 0 {
+		ri.logger.WithField("action", "replicate_write").
+			WithField("shard", shardName).
+			WithField("count", diverged).
+			Warnf("consistency level reached for %d item(s), but not all replicas "+
+				"applied them", diverged)
 	}
 
-	host, ok := ri.nodeResolver.NodeHostname(shard.BelongsToNode)
-	if !ok {
-		return duplicateErr(errors.Errorf("resolve node name %q to host",
-			shard.BelongsToNode), len(refs))
+	if len(pending) == 0 {
+		cancel()
+		return out
 	}
 
-	return ri.client.BatchAddReferences(ctx, host, ri.class, shardName, refs)
+	go ri.awaitRemaining(shardName, count, results, len(pending), cancel)
+	return out
 }
 
-func (ri *RemoteIndex) Exists(ctx context.Context, shardName string,
-	id strfmt.UUID,
-) (bool, error) {
-	shard, ok := ri.stateGetter.ShardingState(ri.class).Physical[shardName]
-	if !ok {
-		return false, errors.Errorf("class %s has no physical shard %q", ri.class, shardName)
+// awaitRemaining waits for the writes which were still running when
+// coordinate returned and logs the replicas which failed to apply them
+func (ri *RemoteIndex) awaitRemaining(shardName string, count int,
+	results <-chan replicaWrite, remaining int, cancel context.CancelFunc,
+) {
+	timer := time.AfterFunc(backgroundWriteTimeout, cancel)
+	defer timer.Stop()
+	defer cancel()
+
+	for i := 0; i < remaining; i++ {
+		r := <-results
+		failed := 0
+		var firstErr error
+		for pos := 0; pos < count; pos++ {
+			if err := itemErr(r.errs, pos); err != nil {
+				failed++
+				if firstErr == nil {
+					firstErr = err
+				}
+			}
+		}
+
+		if failed > 0 {
+			ri.logger.WithField("action", "replicate_write").
+				WithField("shard", shardName).
+				WithField("node", r.node).
+				WithError(firstErr).
+				Warnf("replica failed to apply %d of %d item(s) after the write "+
+					"had returned", failed, count)
+		}
 	}
+}
 
-	host, ok := ri.nodeResolver.NodeHostname(shard.BelongsToNode)
-	if !ok {
-		return false, errors.Errorf("resolve node name %q to host", shard.BelongsToNode)
+func itemErr(errs []error, pos int) error {
+	if pos >= len(errs) {
+		return errors.Errorf("no result for item %d", pos)
 	}
+	return errs[pos]
+}
 
-	return ri.client.Exists(ctx, host, ri.class, shardName, id)
+// detachedContext keeps the values of its parent, but is not cancelled
+// together with it
+type detachedContext struct {
+	parent context.Context
 }
 
-func (ri *RemoteIndex) DeleteObject(ctx context.Context, shardName string,
-	id strfmt.UUID,
+func (c detachedContext) Deadline() (time.Time, bool)       { return time.Time{}, false }
+func (c detachedContext) Done() <-chan struct{}             { return nil }
+func (c detachedContext) Err() error                        { return nil }
+func (c detachedContext) Value(key interface{}) interface{} { return c.parent.Value(key) }
+
+// readOne tries the remote replicas of the shard one after another and
+// returns as soon as one of them succeeded
+func (ri *RemoteIndex) readOne(shardName string,
+	read func(host string) error,
 ) error {
-	shard, ok := ri.stateGetter.ShardingState(ri.class).Physical[shardName]
-	if !ok {
-		return errors.Errorf("class %s has no physical shard %q", ri.class, shardName)
+	replicas, err := ri.remoteReplicas(shardName)
+	if err != nil {
+		return err
 	}
 
-	host, ok := ri.nodeResolver.NodeHostname(shard.BelongsToNode)
-	if !ok {
-		return errors.Errorf("resolve node name %q to host", shard.BelongsToNode)
+	ec := &errorcompounder.ErrorCompounder{}
+	for _, r := range replicas {
+		err := read(r.host)
+		if err == nil {
+			return nil
+		}
+		ec.AddWrap(err, "replica "+r.node)
 	}
 
-	return ri.client.DeleteObject(ctx, host, ri.class, shardName, id)
+	return ec.ToError()
 }
 
-func (ri *RemoteIndex) MergeObject(ctx context.Context, shardName string,
-	mergeDoc objects.MergeDocument,
-) error {
-	shard, ok := ri.stateGetter.ShardingState(ri.class).Physical[shardName]
-	if !ok {
-		return errors.Errorf("class %s has no physical shard %q", ri.class, shardName)
+// readReplicas reads from n different remote replicas of the shard. The
+// replicas are read from in parallel, if one of them fails the next one is
+// tried instead. It fails if fewer than n replicas could be read from.
+func readReplicas[T any](ri *RemoteIndex, shardName string, n int,
+	read func(host string) (T, error),
+) ([]T, error) {
+	replicas, err := ri.remoteReplicas(shardName)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]T, 0, n)
+	ec := &errorcompounder.ErrorCompounder{}
+	next := 0
+	for len(out) < n && next < len(replicas) {
+		end := next + n - len(out)
+		if end > len(replicas) {
+			end = len(replicas)
+		}
+		batch := replicas[next:end]
+		next = end
+
+		results := make([]T, len(batch))
+		errs := make([]error, len(batch))
+		wg := &sync.WaitGroup{}
+		for i, r := range batch {
+			wg.Add(1)
+			go func(i int, r replica) {
+				defer wg.Done()
+				results[i], errs[i] = read(r.host)
+			}(i, r)
+		}
+		wg.Wait()
+
+		for i := range batch {
+			if errs[i] != nil {
+				ec.AddWrap(errs[i], "replica "+batch[i].node)
+				continue
+			}
+			out = append(out, results[i])
+		}
 	}
 
-	host, ok := ri.nodeResolver.NodeHostname(shard.BelongsToNode)
-	if !ok {
-		return errors.Errorf("resolve node name %q to host", shard.BelongsToNode)
+	if len(out) < n {
+		msg := fmt.Sprintf("shard %s: read from %d of %d required remote replica(s)",
+			shardName, len(out), n)
+		if err := ec.ToError(); err != nil {
+			msg += ": " + err.Error()
+		}
+		return nil, errors.New(msg)
 	}
 
-	return ri.client.MergeObject(ctx, host, ri.class, shardName, mergeDoc)
+	return out, nil
+}
+
+// PutObject writes the object to all replicas of the shard, local writes it
+// to the local one, see replicate
+func (ri *RemoteIndex) PutObject(ctx context.Context, shardName string,
+	obj *storobj.Object, repl *additional.ReplicationProperties,
+	local func() error,
+) error {
+	return ri.replicateOne(ctx, shardName, repl, local,
+		func(ctx context.Context, host string) error {
+			return ri.client.PutObject(ctx, host, ri.class, shardName, obj)
+		})
+}
+
+// helper for single errors that affect the entire batch, assign the error to
+// every single item in the batch
+func duplicateErr(in error, count int) []error {
+	out := make([]error, count)
+	for i := range out {
+		out[i] = in
+	}
+	return out
+}
+
+func (ri *RemoteIndex) BatchPutObjects(ctx context.Context, shardName string,
+	objs []*storobj.Object, repl *additional.ReplicationProperties,
+	local func() []error,
+) []error {
+	return ri.replicate(ctx, shardName, len(objs), repl, local,
+		func(ctx context.Context, host string) []error {
+			return ri.client.BatchPutObjects(ctx, host, ri.class, shardName, objs)
+		})
+}
+
+func (ri *RemoteIndex) BatchAddReferences(ctx context.Context, shardName string,
+	refs objects.BatchReferences, repl *additional.ReplicationProperties,
+	local func() []error,
+) []error {
+	return ri.replicate(ctx, shardName, len(refs), repl, local,
+		func(ctx context.Context, host string) []error {
+			return ri.client.BatchAddReferences(ctx, host, ri.class, shardName, refs)
+		})
+}
+
+func (ri *RemoteIndex) Exists(ctx context.Context, shardName string,
+	id strfmt.UUID,
+) (bool, error) {
+	var exists bool
+	err := ri.readOne(shardName, func(host string) error {
+		var err error
+		exists, err = ri.client.Exists(ctx, host, ri.class, shardName, id)
+		return err
+	})
+
+	return exists, err
+}
+
+func (ri *RemoteIndex) DeleteObject(ctx context.Context, shardName string,
+	id strfmt.UUID, repl *additional.ReplicationProperties, local func() error,
+) error {
+	return ri.replicateOne(ctx, shardName, repl, local,
+		func(ctx context.Context, host string) error {
+			return ri.client.DeleteObject(ctx, host, ri.class, shardName, id)
+		})
+}
+
+func (ri *RemoteIndex) MergeObject(ctx context.Context, shardName string,
+	mergeDoc objects.MergeDocument, repl *additional.ReplicationProperties,
+	local func() error,
+) error {
+	return ri.replicateOne(ctx, shardName, repl, local,
+		func(ctx context.Context, host string) error {
+			return ri.client.MergeObject(ctx, host, ri.class, shardName, mergeDoc)
+		})
 }
 
 func (ri *RemoteIndex) GetObject(ctx context.Context, shardName string,
 	id strfmt.UUID, props search.SelectProperties,
 	additional additional.Properties,
 ) (*storobj.Object, error) {
-	shard, ok := ri.stateGetter.ShardingState(ri.class).Physical[shardName]
-	if !ok {
-		return nil, errors.Errorf("class %s has no physical shard %q", ri.class, shardName)
-	}
+	var obj *storobj.Object
+	err := ri.readOne(shardName, func(host string) error {
+		var err error
+		obj, err = ri.client.GetObject(ctx, host, ri.class, shardName, id, props,
+			additional)
+		return err
+	})
+
+	return obj, err
+}
 
-	host, ok := ri.nodeResolver.NodeHostname(shard.BelongsToNode)
-	if !ok {
-		return nil, errors.Errorf("resolve node name %q to host", shard.BelongsToNode)
-	}
+// GetObjectFromReplicas reads the object from n remote replicas, see
+// readReplicas. An object which does not exist on a replica is nil.
+func (ri *RemoteIndex) GetObjectFromReplicas(ctx context.Context, shardName string,
+	id strfmt.UUID, props search.SelectProperties,
+	additional additional.Properties, n int,
+) ([]*storobj.Object, error) {
+	return readReplicas(ri, shardName, n, func(host string) (*storobj.Object, error) {
+		return ri.client.GetObject(ctx, host, ri.class, shardName, id, props,
+			additional)
+	})
+}
 
-	return ri.client.GetObject(ctx, host, ri.class, shardName, id, props, additional)
+// ExistsOnReplicas checks the existence of the object on n remote replicas,
+// see readReplicas
+func (ri *RemoteIndex) ExistsOnReplicas(ctx context.Context, shardName string,
+	id strfmt.UUID, n int,
+) ([]bool, error) {
+	return readReplicas(ri, shardName, n, func(host string) (bool, error) {
+		return ri.client.Exists(ctx, host, ri.class, shardName, id)
+	})
+}
+
+// MultiGetObjectsFromReplicas reads the objects from n remote replicas, see
+// readReplicas
+func (ri *RemoteIndex) MultiGetObjectsFromReplicas(ctx context.Context,
+	shardName string, ids []strfmt.UUID, n int,
+) ([][]*storobj.Object, error) {
+	return readReplicas(ri, shardName, n, func(host string) ([]*storobj.Object, error) {
+		return ri.client.MultiGetObjects(ctx, host, ri.class, shardName, ids)
+	})
 }
 
 func (ri *RemoteIndex) MultiGetObjects(ctx context.Context, shardName string,
 	ids []strfmt.UUID,
 ) ([]*storobj.Object, error) {
-	shard, ok := ri.stateGetter.ShardingState(ri.class).Physical[shardName]
-	if !ok {
-		return nil, errors.Errorf("class %s has no physical shard %q", ri.class, shardName)
-	}
-
-	host, ok := ri.nodeResolver.NodeHostname(shard.BelongsToNode)
-	if !ok {
-		return nil, errors.Errorf("resolve node name %q to host", shard.BelongsToNode)
-	}
-
-	return ri.client.MultiGetObjects(ctx, host, ri.class, shardName, ids)
+	var objs []*storobj.Object
+	err := ri.readOne(shardName, func(host string) error {
+		var err error
+		objs, err = ri.client.MultiGetObjects(ctx, host, ri.class, shardName, ids)
+		return err
+	})
+
+	return objs, err
 }
 
 func (ri *RemoteIndex) SearchShard(ctx context.Context, shardName string,
-	searchVector []float32, limit int, filters *filters.LocalFilter,
+	searchVector []float32, targetVector string, limit int, filters *filters.LocalFilter,
 	keywordRanking *searchparams.KeywordRanking, sort []filters.Sort,
-	additional additional.Properties,
+	cursor *filters.Cursor, additional additional.Properties,
 ) ([]*storobj.Object, []float32, error) {
-	shard, ok := ri.stateGetter.ShardingState(ri.class).Physical[shardName]
-	if !ok {
-		return nil, nil, errors.Errorf("class %s has no physical shard %q", ri.class, shardName)
-	}
-
-	host, ok := ri.nodeResolver.NodeHostname(shard.BelongsToNode)
-	if !ok {
-		return nil, nil, errors.Errorf("resolve node name %q to host", shard.BelongsToNode)
-	}
-
-	return ri.client.SearchShard(ctx, host, ri.class, shardName, searchVector, limit,
-		filters, keywordRanking, sort, additional)
+	var (
+		objs  []*storobj.Object
+		dists []float32
+	)
+	err := ri.readOne(shardName, func(host string) error {
+		var err error
+		objs, dists, err = ri.client.SearchShard(ctx, host, ri.class, shardName,
+			searchVector, targetVector, limit, filters, keywordRanking, sort, cursor, additional)
+		return err
+	})
+
+	return objs, dists, err
 }
 
 func (ri *RemoteIndex) Aggregate(ctx context.Context, shardName string,
 	params aggregation.Params,
 ) (*aggregation.Result, error) {
-	shard, ok := ri.stateGetter.ShardingState(ri.class).Physical[shardName]
-	if !ok {
-		return nil, errors.Errorf("class %s has no physical shard %q", ri.class, shardName)
-	}
-
-	host, ok := ri.nodeResolver.NodeHostname(shard.BelongsToNode)
-	if !ok {
-		return nil, errors.Errorf("resolve node name %q to host", shard.BelongsToNode)
-	}
-
-	return ri.client.Aggregate(ctx, host, ri.class, shardName, params)
+	var res *aggregation.Result
+	err := ri.readOne(shardName, func(host string) error {
+		var err error
+		res, err = ri.client.Aggregate(ctx, host, ri.class, shardName, params)
+		return err
+	})
+
+	return res, err
 }
 
+// FindDocIDs and DeleteObjectBatch always target the first remote replica.
+// Doc IDs are local to a replica, so the ids returned by FindDocIDs are only
+// meaningful to the very same replica.
 func (ri *RemoteIndex) FindDocIDs(ctx context.Context, shardName string,
 	filters *filters.LocalFilter,
 ) ([]uint64, error) {
-	shard, ok := ri.stateGetter.ShardingState(ri.class).Physical[shardName]
-	if !ok {
-		return nil, errors.Errorf("class %s has no physical shard %q", ri.class, shardName)
-	}
-
-	host, ok := ri.nodeResolver.NodeHostname(shard.BelongsToNode)
-	if !ok {
-		return nil, errors.Errorf("resolve node name %q to host", shard.BelongsToNode)
+	replicas, err := ri.remoteReplicas(shardName)
+	if err != nil {
+		return nil, err
 	}
 
-	return ri.client.FindDocIDs(ctx, host, ri.class, shardName, filters)
+	return ri.client.FindDocIDs(ctx, replicas[0].host, ri.class, shardName, filters)
 }
 
 func (ri *RemoteIndex) DeleteObjectBatch(ctx context.Context, shardName string,
 	docIDs []uint64, dryRun bool,
 ) objects.BatchSimpleObjects {
-	shard, ok := ri.stateGetter.ShardingState(ri.class).Physical[shardName]
-	if !ok {
-		err := errors.Errorf("class %s has no physical shard %q", ri.class, shardName)
-		return objects.BatchSimpleObjects{objects.BatchSimpleObject{Err: err}}
-	}
-
-	host, ok := ri.nodeResolver.NodeHostname(shard.BelongsToNode)
-	if !ok {
-		err := errors.Errorf("resolve node name %q to host", shard.BelongsToNode)
+	replicas, err := ri.remoteReplicas(shardName)
+	if err != nil {
 		return objects.BatchSimpleObjects{objects.BatchSimpleObject{Err: err}}
 	}
 
-	return ri.client.DeleteObjectBatch(ctx, host, ri.class, shardName, docIDs, dryRun)
+	return ri.client.DeleteObjectBatch(ctx, replicas[0].host, ri.class, shardName,
+		docIDs, dryRun)
 }
 
-func (ri *RemoteIndex) GetShardStatus(ctx context.Context, shardName string) (string, error) {
-	shard, ok := ri.stateGetter.ShardingState(ri.class).Physical[shardName]
-	if !ok {
-		return "", errors.Errorf("class %s has no physical shard %q", ri.class, shardName)
+// DeleteObjects deletes the specified objects from the remote replicas of the
+// shard which did not execute the preceding DeleteObjectBatch. This is how a
+// batch delete is propagated to the remaining replicas, as the doc ids it was
+// based on are only valid on a single replica. The replica which executed
+// the batch counts towards the consistency level.
+func (ri *RemoteIndex) DeleteObjects(ctx context.Context, shardName string,
+	ids []strfmt.UUID, repl *additional.ReplicationProperties,
+) []error {
+	replicas, err := ri.remoteReplicas(shardName)
+	if err != nil {
+		return duplicateErr(err, len(ids))
 	}
 
-	host, ok := ri.nodeResolver.NodeHostname(shard.BelongsToNode)
-	if !ok {
-		return "", errors.Errorf("resolve node name %q to host", shard.BelongsToNode)
+	state := ri.stateGetter.ShardingState(ri.class)
+	executed := state.localNodeName
+	if !state.IsShardLocal(shardName) {
+		// the batch was executed by the first remote replica
+		executed, replicas = replicas[0].node, replicas[1:]
 	}
 
-	return ri.client.GetShardStatus(ctx, host, ri.class, shardName)
+	if len(replicas) == 0 {
+		return make([]error, len(ids))
+	}
+
+	return ri.coordinate(ctx, shardName, len(ids), repl, []string{executed},
+		remoteParticipants(replicas, func(ctx context.Context, host string) []error {
+			return ri.client.DeleteObjects(ctx, host, ri.class, shardName, ids)
+		}))
 }
 
-func (ri *RemoteIndex) UpdateShardStatus(ctx context.Context, shardName, targetStatus string) error {
-	shard, ok := ri.stateGetter.ShardingState(ri.class).Physical[shardName]
-	if !ok {
-		return errors.Errorf("class %s has no physical shard %q", ri.class, shardName)
-	}
+func (ri *RemoteIndex) GetShardStatus(ctx context.Context, shardName string) (string, error) {
+	var status string
+	err := ri.readOne(shardName, func(host string) error {
+		var err error
+		status, err = ri.client.GetShardStatus(ctx, host, ri.class, shardName)
+		return err
+	})
+
+	return status, err
+}
 
-	host, ok := ri.nodeResolver.NodeHostname(shard.BelongsToNode)
-	if !ok {
-		return errors.Errorf("resolve node name %q to host", shard.BelongsToNode)
+// UpdateShardStatus changes the status of every replica of the shard, it is
+// not bound to a user request and therefore uses consistency level ALL
+func (ri *RemoteIndex) UpdateShardStatus(ctx context.Context, shardName,
+	targetStatus string, local func() error,
+) error {
+	all := &additional.ReplicationProperties{
+		ConsistencyLevel: additional.ConsistencyLevelAll,
 	}
-
-	return ri.client.UpdateShardStatus(ctx, host, ri.class, shardName, targetStatus)
+	return ri.replicateOne(ctx, shardName, all, local,
+		func(ctx context.Context, host string) error {
+			return ri.client.UpdateShardStatus(ctx, host, ri.class, shardName, targetStatus)
+		})
 }
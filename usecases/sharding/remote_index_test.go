@@ -0,0 +1,548 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package sharding
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/additional"
+	"github.com/semi-technologies/weaviate/entities/aggregation"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/entities/searchparams"
+	"github.com/semi-technologies/weaviate/entities/storobj"
+	"github.com/semi-technologies/weaviate/usecases/objects"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoteIndexReplication(t *testing.T) {
+	ctx := context.Background()
+	id := strfmt.UUID("8b6e6b7d-1b3b-4c4b-9d2a-5e2d5f1a0c11")
+
+	newIndex := func(client *fakeReplicaClient, owners ...string) *RemoteIndex {
+		state := &State{
+			Physical: map[string]Physical{
+				"shard1": {Name: "shard1", BelongsToNodes: owners},
+			},
+			localNodeName: "node1",
+		}
+		return NewRemoteIndex("MyClass", fakeStateGetter{state},
+			fakeNodeResolver{}, client, nullLogger())
+	}
+	one := &additional.ReplicationProperties{ConsistencyLevel: additional.ConsistencyLevelOne}
+	all := &additional.ReplicationProperties{ConsistencyLevel: additional.ConsistencyLevelAll}
+
+	t.Run("a write is sent to every replica", func(t *testing.T) {
+		client := newFakeReplicaClient()
+		ri := newIndex(client, "node1", "node2", "node3", "node4")
+
+		localCalled := false
+		err := ri.PutObject(ctx, "shard1", &storobj.Object{}, all, func() error {
+			localCalled = true
+			return nil
+		})
+		require.Nil(t, err)
+		assert.True(t, localCalled)
+		assert.ElementsMatch(t, []string{"node2:8080", "node3:8080", "node4:8080"},
+			client.calledHosts())
+	})
+
+	t.Run("a write failing on some replicas", func(t *testing.T) {
+		client := newFakeReplicaClient()
+		client.errs["node3:8080"] = errors.New("disk full")
+		ri := newIndex(client, "node2", "node3", "node4")
+
+		err := ri.DeleteObject(ctx, "shard1", id, all, nil)
+		require.NotNil(t, err)
+
+		var replErr *ReplicationError
+		require.True(t, errors.As(err, &replErr))
+		// the write returns as soon as node3 failed, the outcome of the other
+		// replicas may not be known yet
+		assert.ElementsMatch(t, []string{"node2", "node4"},
+			append(append([]string{}, replErr.Acked...), replErr.Pending...))
+		require.Len(t, replErr.Failed, 1)
+		assert.EqualError(t, replErr.Failed["node3"], "disk full")
+		assert.Equal(t, 3, replErr.Required)
+	})
+
+	t.Run("a write failing on a tolerated replica", func(t *testing.T) {
+		client := newFakeReplicaClient()
+		client.errs["node3:8080"] = errors.New("disk full")
+		ri := newIndex(client, "node1", "node2", "node3")
+
+		err := ri.MergeObject(ctx, "shard1", objects.MergeDocument{}, nil,
+			func() error { return nil })
+		assert.Nil(t, err)
+	})
+
+	t.Run("batch errors are merged per item across replicas", func(t *testing.T) {
+		client := newFakeReplicaClient()
+		client.batchErrs["node2:8080"] = []error{nil, errors.New("invalid"), nil}
+		client.batchErrs["node3:8080"] = []error{nil, nil} // one result missing
+		ri := newIndex(client, "node1", "node2", "node3")
+
+		errs := ri.BatchPutObjects(ctx, "shard1",
+			[]*storobj.Object{{}, {}, {}}, all, func() []error {
+				return make([]error, 3)
+			})
+		require.Len(t, errs, 3)
+		assert.Nil(t, errs[0])
+
+		var replErr *ReplicationError
+		require.True(t, errors.As(errs[1], &replErr))
+		assert.ElementsMatch(t, []string{"node1", "node3"}, replErr.Acked)
+		require.Len(t, replErr.Failed, 1)
+		assert.EqualError(t, replErr.Failed["node2"], "invalid")
+		assert.Equal(t, 3, replErr.Required)
+
+		require.True(t, errors.As(errs[2], &replErr))
+		assert.ElementsMatch(t, []string{"node1", "node2"}, replErr.Acked)
+		require.Len(t, replErr.Failed, 1)
+		assert.EqualError(t, replErr.Failed["node3"], "no result for item 2")
+	})
+
+	t.Run("a batch with a failed local replica and the default level", func(t *testing.T) {
+		client := newFakeReplicaClient()
+		ri := newIndex(client, "node1", "node2", "node3")
+
+		errs := ri.BatchAddReferences(ctx, "shard1", objects.BatchReferences{{}, {}},
+			nil, func() []error {
+				return []error{errors.New("local failed"), nil}
+			})
+		assert.Equal(t, []error{nil, nil}, errs)
+	})
+
+	t.Run("a batch without a local replica failing on all replicas", func(t *testing.T) {
+		client := newFakeReplicaClient()
+		client.errs["node2:8080"] = errors.New("remote failed")
+		client.errs["node3:8080"] = errors.New("remote failed")
+		ri := newIndex(client, "node2", "node3")
+
+		errs := ri.BatchPutObjects(ctx, "shard1", []*storobj.Object{{}}, one,
+			func() []error {
+				t.Fatal("shard is not local")
+				return nil
+			})
+		require.Len(t, errs, 1)
+
+		var replErr *ReplicationError
+		require.True(t, errors.As(errs[0], &replErr))
+		assert.Empty(t, replErr.Acked)
+		assert.Len(t, replErr.Failed, 2)
+	})
+
+	t.Run("a write to a shard without remote replicas", func(t *testing.T) {
+		client := newFakeReplicaClient()
+		ri := newIndex(client, "node1")
+
+		err := ri.PutObject(ctx, "shard1", &storobj.Object{}, all, func() error {
+			return errors.New("local failed")
+		})
+		assert.EqualError(t, err, "local failed")
+		assert.Empty(t, client.calledHosts())
+	})
+
+	t.Run("a read falls back to the next replica", func(t *testing.T) {
+		client := newFakeReplicaClient()
+		client.errs["node2:8080"] = errors.New("connection refused")
+		ri := newIndex(client, "node2", "node3")
+
+		obj, err := ri.GetObject(ctx, "shard1", id, nil, additional.Properties{})
+		require.Nil(t, err)
+		require.NotNil(t, obj)
+		assert.Equal(t, id, obj.ID())
+		assert.Equal(t, []string{"node2:8080", "node3:8080"}, client.calledHosts())
+	})
+
+	t.Run("a read fails if all replicas fail", func(t *testing.T) {
+		client := newFakeReplicaClient()
+		client.errs["node2:8080"] = errors.New("connection refused")
+		client.errs["node3:8080"] = errors.New("timeout")
+		ri := newIndex(client, "node2", "node3")
+
+		_, err := ri.GetObject(ctx, "shard1", id, nil, additional.Properties{})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "replica node2: connection refused")
+		assert.Contains(t, err.Error(), "replica node3: timeout")
+	})
+
+	t.Run("a read from multiple replicas", func(t *testing.T) {
+		client := newFakeReplicaClient()
+		ri := newIndex(client, "node2", "node3", "node4")
+
+		objs, err := ri.GetObjectFromReplicas(ctx, "shard1", id, nil,
+			additional.Properties{}, 2)
+		require.Nil(t, err)
+		require.Len(t, objs, 2)
+		assert.Equal(t, []string{"node2:8080", "node3:8080"}, sorted(client.calledHosts()))
+	})
+
+	t.Run("a read from multiple replicas falls back to the next replica", func(t *testing.T) {
+		client := newFakeReplicaClient()
+		client.errs["node3:8080"] = errors.New("connection refused")
+		ri := newIndex(client, "node2", "node3", "node4")
+
+		exists, err := ri.ExistsOnReplicas(ctx, "shard1", id, 2)
+		require.Nil(t, err)
+		assert.Equal(t, []bool{true, true}, exists)
+		assert.ElementsMatch(t, []string{"node2:8080", "node3:8080", "node4:8080"},
+			client.calledHosts())
+	})
+
+	t.Run("a read from more replicas than available", func(t *testing.T) {
+		client := newFakeReplicaClient()
+		client.errs["node3:8080"] = errors.New("connection refused")
+		ri := newIndex(client, "node2", "node3")
+
+		_, err := ri.MultiGetObjectsFromReplicas(ctx, "shard1",
+			[]strfmt.UUID{id}, 2)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "read from 1 of 2 required remote replica(s)")
+		assert.Contains(t, err.Error(), "replica node3: connection refused")
+	})
+
+	t.Run("replicated batch deletes skip the replica which ran the batch", func(t *testing.T) {
+		client := newFakeReplicaClient()
+		ri := newIndex(client, "node2", "node3", "node4")
+
+		errs := ri.DeleteObjects(ctx, "shard1", []strfmt.UUID{id}, all)
+		assert.Equal(t, []error{nil}, errs)
+		assert.ElementsMatch(t, []string{"node3:8080", "node4:8080"},
+			client.calledHosts())
+	})
+
+	t.Run("replicated batch deletes with a local primary", func(t *testing.T) {
+		client := newFakeReplicaClient()
+		ri := newIndex(client, "node1", "node2")
+
+		errs := ri.DeleteObjects(ctx, "shard1", []strfmt.UUID{id}, all)
+		assert.Equal(t, []error{nil}, errs)
+		assert.Equal(t, []string{"node2:8080"}, client.calledHosts())
+	})
+
+	t.Run("a replicated batch delete counts the replica which ran the batch", func(t *testing.T) {
+		client := newFakeReplicaClient()
+		client.errs["node3:8080"] = errors.New("remote failed")
+		ri := newIndex(client, "node2", "node3", "node4")
+
+		errs := ri.DeleteObjects(ctx, "shard1", []strfmt.UUID{id}, nil)
+		assert.Equal(t, []error{nil}, errs)
+
+		errs = ri.DeleteObjects(ctx, "shard1", []strfmt.UUID{id}, all)
+		require.Len(t, errs, 1)
+		var replErr *ReplicationError
+		require.True(t, errors.As(errs[0], &replErr))
+		assert.Contains(t, replErr.Acked, "node2")
+		assert.Contains(t, replErr.Failed, "node3")
+	})
+}
+
+func TestRemoteIndexWriteCoordination(t *testing.T) {
+	ctx := context.Background()
+	quorum := &additional.ReplicationProperties{ConsistencyLevel: additional.ConsistencyLevelQuorum}
+	one := &additional.ReplicationProperties{ConsistencyLevel: additional.ConsistencyLevelOne}
+	all := &additional.ReplicationProperties{ConsistencyLevel: additional.ConsistencyLevelAll}
+
+	newIndex := func(client *fakeReplicaClient, logger logrus.FieldLogger,
+		owners ...string,
+	) *RemoteIndex {
+		state := &State{
+			Physical: map[string]Physical{
+				"shard1": {Name: "shard1", BelongsToNodes: owners},
+			},
+			localNodeName: "node1",
+		}
+		return NewRemoteIndex("MyClass", fakeStateGetter{state},
+			fakeNodeResolver{}, client, logger)
+	}
+	local := func() error { return nil }
+
+	t.Run("QUORUM does not wait for a slow replica", func(t *testing.T) {
+		client := newFakeReplicaClient()
+		client.slow["node3:8080"] = make(chan struct{})
+		defer close(client.slow["node3:8080"])
+		ri := newIndex(client, nullLogger(), "node1", "node2", "node3")
+
+		err := ri.PutObject(ctx, "shard1", &storobj.Object{}, quorum, local)
+		assert.Nil(t, err)
+	})
+
+	t.Run("ONE does not wait for slow remote replicas", func(t *testing.T) {
+		client := newFakeReplicaClient()
+		client.slow["node2:8080"] = make(chan struct{})
+		client.slow["node3:8080"] = make(chan struct{})
+		defer close(client.slow["node2:8080"])
+		defer close(client.slow["node3:8080"])
+		ri := newIndex(client, nullLogger(), "node1", "node2", "node3")
+
+		errs := ri.BatchPutObjects(ctx, "shard1", []*storobj.Object{{}, {}}, one,
+			func() []error { return make([]error, 2) })
+		assert.Equal(t, []error{nil, nil}, errs)
+	})
+
+	t.Run("the local replica is always waited for", func(t *testing.T) {
+		client := newFakeReplicaClient()
+		ri := newIndex(client, nullLogger(), "node1", "node2", "node3")
+
+		var done int32
+		err := ri.DeleteObject(ctx, "shard1", "", one, func() error {
+			time.Sleep(50 * time.Millisecond)
+			atomic.StoreInt32(&done, 1)
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&done))
+	})
+
+	t.Run("a write fails without waiting once the level can't be reached", func(t *testing.T) {
+		client := newFakeReplicaClient()
+		client.errs["node2:8080"] = errors.New("disk full")
+		client.slow["node3:8080"] = make(chan struct{})
+		defer close(client.slow["node3:8080"])
+		ri := newIndex(client, nullLogger(), "node1", "node2", "node3")
+
+		err := ri.PutObject(ctx, "shard1", &storobj.Object{}, all, local)
+		require.NotNil(t, err)
+
+		var replErr *ReplicationError
+		require.True(t, errors.As(err, &replErr))
+		assert.Equal(t, []string{"node1"}, replErr.Acked)
+		assert.Equal(t, []string{"node3"}, replErr.Pending)
+		assert.Contains(t, err.Error(), "write failed on 1 of 3 replica(s)")
+		assert.Contains(t, err.Error(), "outcome unknown on node3")
+	})
+
+	t.Run("a cancelled request stops waiting", func(t *testing.T) {
+		client := newFakeReplicaClient()
+		client.slow["node2:8080"] = make(chan struct{})
+		defer close(client.slow["node2:8080"])
+		ri := newIndex(client, nullLogger(), "node1", "node2")
+
+		ctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+		err := ri.PutObject(ctx, "shard1", &storobj.Object{}, all, local)
+		require.NotNil(t, err)
+
+		var replErr *ReplicationError
+		require.True(t, errors.As(err, &replErr))
+		assert.ErrorIs(t, replErr.Failed["node2"], context.Canceled)
+	})
+
+	t.Run("remaining writes finish in the background", func(t *testing.T) {
+		client := newFakeReplicaClient()
+		client.errs["node3:8080"] = errors.New("disk full")
+		client.slow["node3:8080"] = make(chan struct{})
+		logger, hook := test.NewNullLogger()
+		ri := newIndex(client, logger, "node1", "node2", "node3")
+
+		reqCtx, cancel := context.WithCancel(ctx)
+		err := ri.PutObject(reqCtx, "shard1", &storobj.Object{}, quorum, local)
+		require.Nil(t, err)
+
+		// the returned request must not abort the write to node3
+		cancel()
+		close(client.slow["node3:8080"])
+		assert.Eventually(t, func() bool {
+			entry := hook.LastEntry()
+			return entry != nil && entry.Data["node"] == "node3"
+		}, time.Second, 10*time.Millisecond)
+		assert.EqualError(t, hook.LastEntry().Data[logrus.ErrorKey].(error), "disk full")
+	})
+}
+
+func nullLogger() logrus.FieldLogger {
+	l, _ := test.NewNullLogger()
+	return l
+}
+
+func sorted(in []string) []string {
+	out := append([]string{}, in...)
+	sort.Strings(out)
+	return out
+}
+
+type fakeStateGetter struct {
+	state *State
+}
+
+func (f fakeStateGetter) ShardingState(class string) *State {
+	return f.state
+}
+
+type fakeNodeResolver struct{}
+
+func (f fakeNodeResolver) NodeHostname(nodeName string) (string, bool) {
+	return nodeName + ":8080", true
+}
+
+// fakeReplicaClient records which hosts were called and fails the calls to
+// the hosts configured in errs and batchErrs
+type fakeReplicaClient struct {
+	sync.Mutex
+	hosts     []string
+	errs      map[string]error
+	batchErrs map[string][]error
+	// calls to slow hosts block until the channel is closed
+	slow map[string]chan struct{}
+}
+
+func newFakeReplicaClient() *fakeReplicaClient {
+	return &fakeReplicaClient{
+		errs:      map[string]error{},
+		batchErrs: map[string][]error{},
+		slow:      map[string]chan struct{}{},
+	}
+}
+
+func (f *fakeReplicaClient) call(ctx context.Context, host string) error {
+	f.Lock()
+	f.hosts = append(f.hosts, host)
+	slow, err := f.slow[host], f.errs[host]
+	f.Unlock()
+
+	if slow != nil {
+		select {
+		case <-slow:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return err
+}
+
+func (f *fakeReplicaClient) batchCall(ctx context.Context, host string, count int) []error {
+	if err := f.call(ctx, host); err != nil {
+		return duplicateErr(err, count)
+	}
+
+	f.Lock()
+	defer f.Unlock()
+	if errs, ok := f.batchErrs[host]; ok {
+		return errs
+	}
+	return make([]error, count)
+}
+
+func (f *fakeReplicaClient) calledHosts() []string {
+	f.Lock()
+	defer f.Unlock()
+	return f.hosts
+}
+
+func (f *fakeReplicaClient) PutObject(ctx context.Context, hostName, indexName,
+	shardName string, obj *storobj.Object,
+) error {
+	return f.call(ctx, hostName)
+}
+
+func (f *fakeReplicaClient) BatchPutObjects(ctx context.Context, hostName, indexName,
+	shardName string, objs []*storobj.Object,
+) []error {
+	return f.batchCall(ctx, hostName, len(objs))
+}
+
+func (f *fakeReplicaClient) BatchAddReferences(ctx context.Context, hostName,
+	indexName, shardName string, refs objects.BatchReferences,
+) []error {
+	return f.batchCall(ctx, hostName, len(refs))
+}
+
+func (f *fakeReplicaClient) GetObject(ctx context.Context, hostName, indexName,
+	shardName string, id strfmt.UUID, props search.SelectProperties,
+	additional additional.Properties,
+) (*storobj.Object, error) {
+	if err := f.call(ctx, hostName); err != nil {
+		return nil, err
+	}
+	return &storobj.Object{Object: models.Object{ID: id}}, nil
+}
+
+func (f *fakeReplicaClient) Exists(ctx context.Context, hostName, indexName,
+	shardName string, id strfmt.UUID,
+) (bool, error) {
+	return true, f.call(ctx, hostName)
+}
+
+func (f *fakeReplicaClient) DeleteObject(ctx context.Context, hostName, indexName,
+	shardName string, id strfmt.UUID,
+) error {
+	return f.call(ctx, hostName)
+}
+
+func (f *fakeReplicaClient) MergeObject(ctx context.Context, hostName, indexName,
+	shardName string, mergeDoc objects.MergeDocument,
+) error {
+	return f.call(ctx, hostName)
+}
+
+func (f *fakeReplicaClient) MultiGetObjects(ctx context.Context, hostName,
+	indexName, shardName string, ids []strfmt.UUID,
+) ([]*storobj.Object, error) {
+	return nil, f.call(ctx, hostName)
+}
+
+func (f *fakeReplicaClient) SearchShard(ctx context.Context, hostName, indexName,
+	shardName string, searchVector []float32, targetVector string, limit int,
+	filters *filters.LocalFilter, keywordRanking *searchparams.KeywordRanking,
+	sort []filters.Sort, cursor *filters.Cursor, additional additional.Properties,
+) ([]*storobj.Object, []float32, error) {
+	return nil, nil, f.call(ctx, hostName)
+}
+
+func (f *fakeReplicaClient) Aggregate(ctx context.Context, hostName, indexName,
+	shardName string, params aggregation.Params,
+) (*aggregation.Result, error) {
+	return nil, f.call(ctx, hostName)
+}
+
+func (f *fakeReplicaClient) FindDocIDs(ctx context.Context, hostName, indexName,
+	shardName string, filters *filters.LocalFilter,
+) ([]uint64, error) {
+	return nil, f.call(ctx, hostName)
+}
+
+func (f *fakeReplicaClient) DeleteObjectBatch(ctx context.Context, hostName,
+	indexName, shardName string, docIDs []uint64, dryRun bool,
+) objects.BatchSimpleObjects {
+	f.call(ctx, hostName)
+	return nil
+}
+
+func (f *fakeReplicaClient) DeleteObjects(ctx context.Context, hostName, indexName,
+	shardName string, ids []strfmt.UUID,
+) []error {
+	return f.batchCall(ctx, hostName, len(ids))
+}
+
+func (f *fakeReplicaClient) GetShardStatus(ctx context.Context, hostName,
+	indexName, shardName string,
+) (string, error) {
+	return "READY", f.call(ctx, hostName)
+}
+
+func (f *fakeReplicaClient) UpdateShardStatus(ctx context.Context, hostName,
+	indexName, shardName, targetStatus string,
+) error {
+	return f.call(ctx, hostName)
+}
@@ -0,0 +1,139 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package sharding
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/semi-technologies/weaviate/entities/additional"
+)
+
+// ReplicationError is returned if a write was not applied to enough replicas
+// of a shard. Writes are not rolled back: the replicas listed in Acked hold
+// the write, while the ones in Failed do not. The replicas of the shard
+// diverge until the affected object is written again.
+type ReplicationError struct {
+	Shard  string
+	Acked  []string
+	Failed map[string]error
+
+	// Pending lists the replicas whose outcome was not known yet when the
+	// write returned, see RemoteIndex.coordinate
+	Pending []string
+
+	// Level and Required are set if the outcome was checked against a
+	// consistency level, see ToErrorForLevel
+	Level    string
+	Required int
+}
+
+func NewReplicationError(shard string) *ReplicationError {
+	return &ReplicationError{Shard: shard, Failed: map[string]error{}}
+}
+
+// Add records the outcome of the write on the specified node
+func (e *ReplicationError) Add(node string, err error) {
+	if err != nil {
+		e.Failed[node] = err
+		return
+	}
+
+	e.Acked = append(e.Acked, node)
+}
+
+// Merge records the outcome of a write which was sent to the specified
+// nodes. The error is expected to be the result of such a write: if it is a
+// *ReplicationError (or wraps one) its per-node outcomes are taken over, any
+// other error is considered to have affected all nodes.
+func (e *ReplicationError) Merge(nodes []string, err error) {
+	if err == nil {
+		e.Acked = append(e.Acked, nodes...)
+		return
+	}
+
+	var other *ReplicationError
+	if errors.As(err, &other) {
+		e.Acked = append(e.Acked, other.Acked...)
+		for node, err := range other.Failed {
+			e.Failed[node] = err
+		}
+		return
+	}
+
+	for _, node := range nodes {
+		e.Failed[node] = err
+	}
+}
+
+// ToError returns nil if the write was acknowledged by every replica
+func (e *ReplicationError) ToError() error {
+	if len(e.Failed) == 0 {
+		return nil
+	}
+
+	return e
+}
+
+// ToErrorForLevel returns nil if the write was acknowledged by as many
+// replicas as the consistency level requires. Replicas which failed are
+// tolerated in this case, see Partial.
+func (e *ReplicationError) ToErrorForLevel(repl *additional.ReplicationProperties) error {
+	if len(e.Failed) == 0 && len(e.Pending) == 0 {
+		return nil
+	}
+
+	e.Level = repl.Level()
+	e.Required = repl.RequiredReplicas(len(e.Acked) + len(e.Failed) + len(e.Pending))
+	if len(e.Acked) >= e.Required {
+		return nil
+	}
+
+	return e
+}
+
+// Partial indicates that at least one replica applied the write
+func (e *ReplicationError) Partial() bool {
+	return len(e.Acked) > 0
+}
+
+func (e *ReplicationError) Error() string {
+	nodes := make([]string, 0, len(e.Failed))
+	for node := range e.Failed {
+		nodes = append(nodes, node)
+	}
+	sort.Strings(nodes)
+
+	msgs := make([]string, len(nodes))
+	for i, node := range nodes {
+		msgs[i] = fmt.Sprintf("%s: %v", node, e.Failed[node])
+	}
+
+	msg := fmt.Sprintf("shard %s: write failed on %d of %d replica(s): %s",
+		e.Shard, len(e.Failed), len(e.Failed)+len(e.Acked)+len(e.Pending),
+		strings.Join(msgs, ", "))
+	if len(e.Pending) > 0 {
+		msg += fmt.Sprintf("; outcome unknown on %s", strings.Join(e.Pending, ", "))
+	}
+	if e.Level != "" {
+		msg += fmt.Sprintf("; consistency level %s requires %d acknowledgement(s)",
+			e.Level, e.Required)
+	}
+	if e.Partial() {
+		msg += fmt.Sprintf("; write was applied and not rolled back on %s",
+			strings.Join(e.Acked, ", "))
+	}
+
+	return msg
+}
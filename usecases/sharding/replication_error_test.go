@@ -0,0 +1,55 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2022 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package sharding
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/additional"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplicationErrorForLevel(t *testing.T) {
+	outcome := func(failed int, acked ...string) *ReplicationError {
+		res := NewReplicationError("shard1")
+		for _, node := range acked {
+			res.Add(node, nil)
+		}
+		for i := 0; i < failed; i++ {
+			res.Add("failed"+string(rune('a'+i)), errors.New("timeout"))
+		}
+		return res
+	}
+
+	one := &additional.ReplicationProperties{ConsistencyLevel: "ONE"}
+	quorum := &additional.ReplicationProperties{ConsistencyLevel: "QUORUM"}
+	all := &additional.ReplicationProperties{ConsistencyLevel: "ALL"}
+
+	assert.Nil(t, outcome(0, "node1", "node2", "node3").ToErrorForLevel(all))
+	assert.Nil(t, outcome(2, "node1").ToErrorForLevel(one))
+	assert.Nil(t, outcome(1, "node1", "node2").ToErrorForLevel(quorum))
+	assert.Nil(t, outcome(1, "node1", "node2").ToErrorForLevel(nil))
+
+	err := outcome(2, "node1").ToErrorForLevel(quorum)
+	assert.EqualError(t, err, "shard shard1: write failed on 2 of 3 replica(s): "+
+		"faileda: timeout, failedb: timeout; consistency level QUORUM requires "+
+		"2 acknowledgement(s); write was applied and not rolled back on node1")
+
+	err = outcome(1, "node1", "node2").ToErrorForLevel(all)
+	var replErr *ReplicationError
+	assert.True(t, errors.As(err, &replErr))
+	assert.Equal(t, 3, replErr.Required)
+	assert.True(t, replErr.Partial())
+
+	assert.NotNil(t, outcome(1).ToErrorForLevel(one))
+}
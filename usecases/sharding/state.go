@@ -16,6 +16,7 @@ import (
 	"math/rand"
 	"sort"
 
+	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/usecases/cluster"
 	"github.com/spaolacci/murmur3"
 )
@@ -28,6 +29,11 @@ type State struct {
 	Physical map[string]Physical `json:"physical"`
 	Virtual  []Virtual           `json:"virtual"`
 
+	// PartitioningEnabled is set for classes with multi-tenancy enabled. Such
+	// a state has no virtual shards, instead every physical shard represents
+	// exactly one partition (tenant) and is addressed by its name.
+	PartitioningEnabled bool `json:"partitioningEnabled"`
+
 	// different for each node, not to be serialized
 	localNodeName string
 }
@@ -43,7 +49,31 @@ type Physical struct {
 	Name           string   `json:"name"`
 	OwnsVirtual    []string `json:"ownsVirtual"`
 	OwnsPercentage float64  `json:"ownsPercentage"`
-	BelongsToNode  string   `json:"belongsToNode"`
+
+	// BelongsToNodes contains every node which holds a replica of this shard.
+	// The first entry is the node the shard was originally assigned to.
+	BelongsToNodes []string `json:"belongsToNodes,omitempty"`
+}
+
+// BelongsToNode returns the first node which holds a replica of this shard
+func (p Physical) BelongsToNode() string {
+	if len(p.BelongsToNodes) == 0 {
+		return ""
+	}
+
+	return p.BelongsToNodes[0]
+}
+
+// OwnedByNode indicates whether the specified node holds a replica of this
+// shard
+func (p Physical) OwnedByNode(nodeName string) bool {
+	for _, node := range p.BelongsToNodes {
+		if node == nodeName {
+			return true
+		}
+	}
+
+	return false
 }
 
 type nodes interface {
@@ -51,10 +81,26 @@ type nodes interface {
 	LocalName() string
 }
 
-func InitState(id string, config Config, nodes nodes) (*State, error) {
-	out := &State{Config: config, IndexID: id, localNodeName: nodes.LocalName()}
+// InitState creates a new sharding state for the specified index. Each
+// physical shard is assigned to replicationFactor distinct nodes. If
+// partitioningEnabled is set, the state starts out without any shards, they
+// are added later on as partitions through AddPartition.
+func InitState(id string, config Config, nodes nodes,
+	replicationFactor int64, partitioningEnabled bool,
+) (*State, error) {
+	out := &State{
+		Config:              config,
+		IndexID:             id,
+		localNodeName:       nodes.LocalName(),
+		PartitioningEnabled: partitioningEnabled,
+	}
 
-	if err := out.initPhysical(nodes); err != nil {
+	if partitioningEnabled {
+		out.Physical = map[string]Physical{}
+		return out, nil
+	}
+
+	if err := out.initPhysical(nodes, replicationFactor); err != nil {
 		return nil, err
 	}
 
@@ -125,25 +171,125 @@ func (s *State) SetLocalName(name string) {
 }
 
 func (s *State) IsShardLocal(name string) bool {
-	return s.Physical[name].BelongsToNode == s.localNodeName
+	return s.Physical[name].OwnedByNode(s.localNodeName)
 }
 
-func (s *State) initPhysical(nodes nodes) error {
-	it, err := cluster.NewNodeIterator(nodes, cluster.StartRandom)
+// RemoteReplicas returns the nodes other than the local one which hold a
+// replica of the specified shard
+func (s *State) RemoteReplicas(name string) []string {
+	shard := s.Physical[name]
+	out := make([]string, 0, len(shard.BelongsToNodes))
+	for _, node := range shard.BelongsToNodes {
+		if node == s.localNodeName {
+			continue
+		}
+		out = append(out, node)
+	}
+
+	return out
+}
+
+func (s *State) initPhysical(nodes nodes, replicationFactor int64) error {
+	names := make([]string, s.Config.DesiredCount)
+	for i := range names {
+		names[i] = generateShardName()
+	}
+
+	physical, err := allocatePhysical(names, nodes, replicationFactor)
 	if err != nil {
 		return err
 	}
 
 	s.Physical = map[string]Physical{}
-
-	for i := 0; i < s.Config.DesiredCount; i++ {
-		name := generateShardName()
-		s.Physical[name] = Physical{Name: name, BelongsToNode: it.Next()}
+	for _, p := range physical {
+		s.Physical[p.Name] = p
 	}
 
 	return nil
 }
 
+// AllocatePartitions assigns each of the named partitions to
+// replicationFactor distinct nodes. The state itself is not modified, so that
+// the allocation can be distributed to all nodes first and then applied with
+// AddPartition everywhere.
+func (s *State) AllocatePartitions(names []string, nodes nodes,
+	replicationFactor int64,
+) ([]Physical, error) {
+	if !s.PartitioningEnabled {
+		return nil, errors.Errorf("partitioning is not enabled for %q", s.IndexID)
+	}
+
+	for _, name := range names {
+		if _, ok := s.Physical[name]; ok {
+			return nil, errors.Errorf("partition %q already exists", name)
+		}
+	}
+
+	return allocatePhysical(names, nodes, replicationFactor)
+}
+
+func allocatePhysical(names []string, nodes nodes,
+	replicationFactor int64,
+) ([]Physical, error) {
+	if replicationFactor < 1 {
+		return nil, errors.Errorf("replication factor must be at least 1, got %d",
+			replicationFactor)
+	}
+
+	nodeCount := len(nodes.AllNames())
+	if int64(nodeCount) < replicationFactor {
+		return nil, errors.Errorf("not enough nodes for replication factor %d: "+
+			"only %d node(s) in the cluster", replicationFactor, nodeCount)
+	}
+
+	it, err := cluster.NewNodeIterator(nodes, cluster.StartRandom)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]Physical, len(names))
+	for i, name := range names {
+		// the iterator cycles through all nodes in order, so consecutive calls
+		// are guaranteed to return distinct nodes as long as the replication
+		// factor does not exceed the node count
+		owners := make([]string, replicationFactor)
+		for j := range owners {
+			owners[j] = it.Next()
+		}
+
+		out[i] = Physical{Name: name, BelongsToNodes: owners}
+	}
+
+	return out, nil
+}
+
+// AddPartition adds a physical shard which represents a single partition
+func (s *State) AddPartition(p Physical) {
+	s.Physical[p.Name] = p
+}
+
+// DeletePartition removes the physical shard of a partition
+func (s *State) DeletePartition(name string) {
+	delete(s.Physical, name)
+}
+
+// DeepCopy returns a copy of the state that shares no memory with the
+// original, so it can be modified while others still read the original.
+func (s *State) DeepCopy() *State {
+	out := *s
+
+	out.Physical = make(map[string]Physical, len(s.Physical))
+	for name, p := range s.Physical {
+		p.OwnsVirtual = append([]string(nil), p.OwnsVirtual...)
+		p.BelongsToNodes = append([]string(nil), p.BelongsToNodes...)
+		out.Physical[name] = p
+	}
+
+	out.Virtual = append([]Virtual(nil), s.Virtual...)
+
+	return &out
+}
+
 func (s *State) initVirtual() error {
 	count := s.Config.DesiredVirtualCount
 	s.Virtual = make([]Virtual, count)
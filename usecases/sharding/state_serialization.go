@@ -28,3 +28,38 @@ func StateFromJSON(in []byte, nodes nodes) (*State, error) {
 
 	return &s, nil
 }
+
+// MarshalJSON keeps writing the legacy "belongsToNode" alongside
+// "belongsToNodes", so that nodes which are not aware of replication yet,
+// e.g. during a rolling upgrade or after a downgrade, can still route to the
+// shard.
+func (p Physical) MarshalJSON() ([]byte, error) {
+	type alias Physical
+	aux := struct {
+		alias
+		LegacyBelongsToNode string `json:"belongsToNode,omitempty"`
+	}{alias: alias(p), LegacyBelongsToNode: p.BelongsToNode()}
+
+	return json.Marshal(aux)
+}
+
+// UnmarshalJSON makes sure that states persisted prior to the introduction of
+// replication, which contain only a single "belongsToNode", can still be
+// read.
+func (p *Physical) UnmarshalJSON(data []byte) error {
+	type alias Physical
+	aux := struct {
+		*alias
+		LegacyBelongsToNode string `json:"belongsToNode"`
+	}{alias: (*alias)(p)}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	if len(p.BelongsToNodes) == 0 && aux.LegacyBelongsToNode != "" {
+		p.BelongsToNodes = []string{aux.LegacyBelongsToNode}
+	}
+
+	return nil
+}
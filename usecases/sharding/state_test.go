@@ -26,7 +26,7 @@ func TestState(t *testing.T) {
 	require.Nil(t, err)
 
 	nodes := fakeNodes{[]string{"node1", "node2"}}
-	state, err := InitState("my-index", cfg, nodes)
+	state, err := InitState("my-index", cfg, nodes, 1, false)
 	require.Nil(t, err)
 
 	physicalCount := map[string]int{}
@@ -72,6 +72,119 @@ func TestState(t *testing.T) {
 	assert.Equal(t, physicalCount, physicalCountReloaded)
 }
 
+func TestStateReplication(t *testing.T) {
+	cfg, err := ParseConfig(map[string]interface{}{"desiredCount": float64(3)}, 3)
+	require.Nil(t, err)
+
+	nodes := fakeNodes{[]string{"node1", "node2", "node3"}}
+
+	t.Run("each shard is assigned to distinct nodes", func(t *testing.T) {
+		state, err := InitState("my-index", cfg, nodes, 2, false)
+		require.Nil(t, err)
+
+		require.Len(t, state.Physical, 3)
+		for name, shard := range state.Physical {
+			require.Len(t, shard.BelongsToNodes, 2)
+			assert.NotEqual(t, shard.BelongsToNodes[0], shard.BelongsToNodes[1])
+			assert.Equal(t, shard.BelongsToNodes[0], shard.BelongsToNode())
+
+			// the local node is node1
+			assert.Equal(t, shard.OwnedByNode("node1"), state.IsShardLocal(name))
+			assert.NotContains(t, state.RemoteReplicas(name), "node1")
+			if state.IsShardLocal(name) {
+				assert.Len(t, state.RemoteReplicas(name), 1)
+			} else {
+				assert.Len(t, state.RemoteReplicas(name), 2)
+			}
+		}
+	})
+
+	t.Run("with a replication factor exceeding the node count", func(t *testing.T) {
+		_, err := InitState("my-index", cfg, nodes, 4, false)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("with a replication factor below 1", func(t *testing.T) {
+		_, err := InitState("my-index", cfg, nodes, 0, false)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestStatePartitioning(t *testing.T) {
+	cfg, err := ParseConfig(map[string]interface{}{"desiredCount": float64(3)}, 3)
+	require.Nil(t, err)
+
+	nodes := fakeNodes{[]string{"node1", "node2", "node3"}}
+
+	state, err := InitState("my-index", cfg, nodes, 2, true)
+	require.Nil(t, err)
+	assert.Empty(t, state.Physical)
+	assert.Empty(t, state.Virtual)
+
+	t.Run("allocating partitions does not modify the state", func(t *testing.T) {
+		partitions, err := state.AllocatePartitions([]string{"tenant1", "tenant2"},
+			nodes, 2)
+		require.Nil(t, err)
+		require.Len(t, partitions, 2)
+		assert.Empty(t, state.Physical)
+
+		for _, p := range partitions {
+			require.Len(t, p.BelongsToNodes, 2)
+			assert.NotEqual(t, p.BelongsToNodes[0], p.BelongsToNodes[1])
+			state.AddPartition(p)
+		}
+	})
+
+	t.Run("partitions are addressed by name", func(t *testing.T) {
+		assert.Equal(t, []string{"tenant1", "tenant2"}, state.AllPhysicalShards())
+	})
+
+	t.Run("allocating an existing partition", func(t *testing.T) {
+		_, err := state.AllocatePartitions([]string{"tenant3", "tenant1"}, nodes, 1)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("deleting a partition from a copy", func(t *testing.T) {
+		copied := state.DeepCopy()
+		copied.DeletePartition("tenant1")
+
+		assert.Equal(t, []string{"tenant2"}, copied.AllPhysicalShards())
+		assert.Equal(t, []string{"tenant1", "tenant2"}, state.AllPhysicalShards())
+	})
+
+	t.Run("partitioning survives serialization", func(t *testing.T) {
+		bytes, err := state.JSON()
+		require.Nil(t, err)
+
+		reloaded, err := StateFromJSON(bytes, nodes)
+		require.Nil(t, err)
+		assert.True(t, reloaded.PartitioningEnabled)
+		assert.Equal(t, state.Physical, reloaded.Physical)
+	})
+
+	t.Run("partitions cannot be allocated without partitioning", func(t *testing.T) {
+		regular, err := InitState("my-index", cfg, nodes, 1, false)
+		require.Nil(t, err)
+
+		_, err = regular.AllocatePartitions([]string{"tenant1"}, nodes, 1)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestStateFromLegacyJSON(t *testing.T) {
+	raw := []byte(`{"indexID":"my-index","physical":{"abc":{"name":"abc",` +
+		`"ownsVirtual":["def"],"ownsPercentage":1,"belongsToNode":"node2"}},` +
+		`"virtual":[{"name":"def","upper":1,"ownsPercentage":1,` +
+		`"assignedToPhysical":"abc"}]}`)
+
+	state, err := StateFromJSON(raw, fakeNodes{[]string{"node1", "node2"}})
+	require.Nil(t, err)
+
+	assert.Equal(t, []string{"node2"}, state.Physical["abc"].BelongsToNodes)
+	assert.False(t, state.IsShardLocal("abc"))
+	assert.Equal(t, []string{"node2"}, state.RemoteReplicas("abc"))
+}
+
 type fakeNodes struct {
 	nodes []string
 }
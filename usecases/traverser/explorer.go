@@ -22,6 +22,7 @@ import (
 	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/entities/schema/crossref"
 	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/entities/searchparams"
 	"github.com/semi-technologies/weaviate/entities/vectorindex/hnsw"
 	"github.com/semi-technologies/weaviate/usecases/floatcomp"
 	uc "github.com/semi-technologies/weaviate/usecases/schema"
@@ -63,10 +64,12 @@ type ModulesProvider interface {
 type vectorClassSearch interface {
 	ClassSearch(ctx context.Context, params GetParams) ([]search.Result, error)
 	VectorClassSearch(ctx context.Context, params GetParams) ([]search.Result, error)
+	HybridClassSearch(ctx context.Context, params GetParams) ([]search.Result, error)
 	VectorSearch(ctx context.Context, vector []float32, offset, limit int,
 		filters *filters.LocalFilter) ([]search.Result, error)
 	Object(ctx context.Context, className string, id strfmt.UUID,
-		props search.SelectProperties, additional additional.Properties) (*search.Result, error)
+		props search.SelectProperties, additional additional.Properties,
+		repl *additional.ReplicationProperties, tenant string) (*search.Result, error)
 	ObjectsByID(ctx context.Context, id strfmt.UUID,
 		props search.SelectProperties, additional additional.Properties) (search.Results, error)
 }
@@ -108,6 +111,21 @@ func (e *Explorer) GetClass(ctx context.Context,
 		return nil, errors.Wrap(err, "invalid 'sort' filter")
 	}
 
+	if err := params.ReplicationProperties.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid 'consistencyLevel'")
+	}
+
+	if params.Cursor != nil {
+		if err := e.validateCursor(params); err != nil {
+			return nil, errors.Wrap(err, "invalid 'after' parameter")
+		}
+		return e.getClassList(ctx, params)
+	}
+
+	if params.HybridSearch != nil {
+		return e.getClassHybridSearch(ctx, params)
+	}
+
 	if params.KeywordRanking != nil {
 		return e.getClassKeywordBased(ctx, params)
 	}
@@ -119,6 +137,19 @@ func (e *Explorer) GetClass(ctx context.Context,
 	return e.getClassList(ctx, params)
 }
 
+// validateCursor makes sure a cursor is not combined with any search that
+// would rank or group results, as cursors always iterate in id order
+func (e *Explorer) validateCursor(params GetParams) error {
+	if params.NearVector != nil || params.NearObject != nil ||
+		len(params.ModuleParams) > 0 || params.KeywordRanking != nil ||
+		params.HybridSearch != nil || params.Group != nil {
+		return errors.New("after cannot be combined with near<Media>, bm25, hybrid or group")
+	}
+
+	return filters.ValidateCursor(params.ClassName, params.Cursor,
+		params.Pagination.Offset, params.Filters, params.Sort)
+}
+
 func (e *Explorer) getClassKeywordBased(ctx context.Context,
 	params GetParams,
 ) ([]interface{}, error) {
@@ -126,22 +157,121 @@ func (e *Explorer) getClassKeywordBased(ctx context.Context,
 		return nil, errors.Errorf("conflict: both near<Media> and keyword-based (bm25) arguments present, choose one")
 	}
 
-	if params.Filters != nil {
-		return nil, errors.Errorf("filtered keyword search (bm25) not supported yet")
+	if err := e.validateKeywordRanking(params.KeywordRanking, params.Filters); err != nil {
+		return nil, err
+	}
+
+	if len(params.AdditionalProperties.ModuleParams) > 0 {
+		// if a module-specific additional prop is set, assume it needs the vector
+		// present for backward-compatibility. This could be improved by actually
+		// asking the module based on specific conditions
+		params.AdditionalProperties.Vector = true
+	}
+
+	res, err := e.search.ClassSearch(ctx, params)
+	if err != nil {
+		return nil, errors.Errorf("explorer: get class: vector search: %v", err)
+	}
+
+	if params.Group != nil {
+		grouped, err := grouper.New(e.logger).Group(res, params.Group.Strategy, params.Group.Force)
+		if err != nil {
+			return nil, errors.Errorf("grouper: %v", err)
+		}
+
+		res = grouped
 	}
 
-	if len(params.KeywordRanking.Properties) == 0 {
-		return nil, errors.Errorf("keyword search (bm25) requires exactly one property")
+	if e.modulesProvider != nil {
+		res, err = e.modulesProvider.GetExploreAdditionalExtend(ctx, res,
+			params.AdditionalProperties.ModuleParams, nil, params.ModuleParams)
+		if err != nil {
+			return nil, errors.Errorf("explorer: get class: extend: %v", err)
+		}
 	}
 
-	if len(params.KeywordRanking.Properties) > 1 {
-		return nil, errors.Errorf("multi-property keyword search (BM25F) not supported yet")
+	return e.searchResultsToGetResponse(ctx, res, nil, params)
+}
+
+func (e *Explorer) validateKeywordRanking(keywordRanking *searchparams.KeywordRanking,
+	filters *filters.LocalFilter,
+) error {
+	if filters != nil {
+		return errors.Errorf("filtered keyword search (bm25) not supported yet")
 	}
 
-	if len(params.KeywordRanking.Query) == 0 {
-		return nil, errors.Errorf("keyword search (bm25) must have query set")
+	if len(keywordRanking.Properties) == 0 {
+		return errors.Errorf("keyword search (bm25) requires at least one property")
+	}
+
+	for _, prop := range keywordRanking.Properties {
+		if _, _, err := searchparams.ParsePropertyBoost(prop); err != nil {
+			return errors.Wrap(err, "keyword search (bm25)")
+		}
 	}
 
+	if len(keywordRanking.Query) == 0 {
+		return errors.Errorf("keyword search (bm25) must have query set")
+	}
+
+	return nil
+}
+
+func (e *Explorer) getClassHybridSearch(ctx context.Context,
+	params GetParams,
+) ([]interface{}, error) {
+	if params.KeywordRanking != nil {
+		return nil, errors.Errorf("conflict: both hybrid and keyword-based (bm25) arguments present, choose one")
+	}
+
+	if len(params.Sort) > 0 {
+		return nil, errors.Errorf("sorting a hybrid search is not supported, " +
+			"results are always ordered by their fused score")
+	}
+
+	if err := e.validateKeywordRanking(params.HybridSearch.KeywordRanking(),
+		params.Filters); err != nil {
+		return nil, errors.Wrap(err, "hybrid")
+	}
+
+	if params.HybridSearch.Alpha < 0 || params.HybridSearch.Alpha > 1 {
+		return nil, errors.Errorf("hybrid: alpha must be between 0 and 1, got %v",
+			params.HybridSearch.Alpha)
+	}
+
+	// the results are ranked by their fused score, which can't be limited by
+	// the distance or certainty of the vector part
+	if _, withDistance := ExtractDistanceFromParams(params); withDistance {
+		return nil, errors.Errorf("hybrid: distance is not supported, " +
+			"results are ordered by their fused score")
+	}
+	if ExtractCertaintyFromParams(params) != 0 {
+		return nil, errors.Errorf("hybrid: certainty is not supported, " +
+			"results are ordered by their fused score")
+	}
+
+	searchVector := params.HybridSearch.Vector
+	if params.NearVector != nil || params.NearObject != nil || len(params.ModuleParams) > 0 {
+		if searchVector != nil {
+			return nil, errors.Errorf("conflict: both hybrid vector and near<Media> " +
+				"arguments present, choose one")
+		}
+
+		vector, err := e.vectorFromParams(ctx, params)
+		if err != nil {
+			return nil, errors.Errorf("explorer: get class: vectorize params: %v", err)
+		}
+		searchVector = vector
+		params.TargetVector = e.targetVectorFromParams(params)
+	}
+
+	if searchVector == nil {
+		return nil, errors.Errorf("hybrid search requires a vector, either set " +
+			"the vector of the hybrid argument or combine it with a near<Media> argument")
+	}
+
+	params.SearchVector = searchVector
+
 	if len(params.AdditionalProperties.ModuleParams) > 0 {
 		// if a module-specific additional prop is set, assume it needs the vector
 		// present for backward-compatibility. This could be improved by actually
@@ -149,9 +279,9 @@ func (e *Explorer) getClassKeywordBased(ctx context.Context,
 		params.AdditionalProperties.Vector = true
 	}
 
-	res, err := e.search.ClassSearch(ctx, params)
+	res, err := e.search.HybridClassSearch(ctx, params)
 	if err != nil {
-		return nil, errors.Errorf("explorer: get class: vector search: %v", err)
+		return nil, errors.Errorf("explorer: get class: hybrid search: %v", err)
 	}
 
 	if params.Group != nil {
@@ -165,12 +295,16 @@ func (e *Explorer) getClassKeywordBased(ctx context.Context,
 
 	if e.modulesProvider != nil {
 		res, err = e.modulesProvider.GetExploreAdditionalExtend(ctx, res,
-			params.AdditionalProperties.ModuleParams, nil, params.ModuleParams)
+			params.AdditionalProperties.ModuleParams, searchVector, params.ModuleParams)
 		if err != nil {
 			return nil, errors.Errorf("explorer: get class: extend: %v", err)
 		}
 	}
 
+	e.trackUsageGet(res, params)
+
+	// the results are ranked by their fused score, not by distance, so there
+	// is no search vector to evaluate certainty or distance against
 	return e.searchResultsToGetResponse(ctx, res, nil, params)
 }
 
@@ -183,6 +317,7 @@ func (e *Explorer) getClassVectorSearch(ctx context.Context,
 	}
 
 	params.SearchVector = searchVector
+	params.TargetVector = e.targetVectorFromParams(params)
 
 	if len(params.AdditionalProperties.ModuleParams) > 0 {
 		// if a module-specific additional prop is set, assume it needs the vector
@@ -316,6 +451,10 @@ func (e *Explorer) searchResultsToGetResponse(ctx context.Context,
 			}
 		}
 
+		if params.AdditionalProperties.Score {
+			additionalProperties["score"] = res.Score
+		}
+
 		if params.AdditionalProperties.ID {
 			additionalProperties["id"] = res.ID
 		}
@@ -463,7 +602,12 @@ func (e *Explorer) vectorFromParams(ctx context.Context,
 	params GetParams,
 ) ([]float32, error) {
 	return e.nearParamsVector.vectorFromParams(ctx, params.NearVector,
-		params.NearObject, params.ModuleParams, params.ClassName)
+		params.NearObject, params.ModuleParams, params.ClassName, params.Tenant)
+}
+
+func (e *Explorer) targetVectorFromParams(params GetParams) string {
+	return e.nearParamsVector.targetVectorFromParams(params.NearVector,
+		params.NearObject, params.ModuleParams)
 }
 
 func (e *Explorer) vectorFromExploreParams(ctx context.Context,
@@ -474,6 +618,13 @@ func (e *Explorer) vectorFromExploreParams(ctx context.Context,
 		return nil, err
 	}
 
+	// named vectors are specific to a class, so they can't be searched across
+	// classes
+	if e.nearParamsVector.targetVectorFromParams(params.NearVector,
+		params.NearObject, params.ModuleParams) != "" {
+		return nil, errors.Errorf("targetVector is not supported in Explore")
+	}
+
 	if len(params.ModuleParams) == 1 {
 		for name, value := range params.ModuleParams {
 			return e.crossClassVectorFromModules(ctx, name, value)
@@ -524,12 +675,12 @@ func (e *Explorer) checkCertaintyCompatibility(className string) error {
 	if class == nil {
 		return errors.Errorf("failed to get class: %s", className)
 	}
-	hnswConfig, err := typeAssertVectorIndex(class)
+	distance, err := vectorIndexDistance(class)
 	if err != nil {
 		return err
 	}
-	if hnswConfig.Distance != hnsw.DistanceCosine {
-		return certaintyUnsupportedError(hnswConfig.Distance)
+	if distance != hnsw.DistanceCosine {
+		return certaintyUnsupportedError(distance)
 	}
 
 	return nil
@@ -675,6 +826,10 @@ func (e *Explorer) usageOperationFromGetParams(params GetParams) string {
 		return param
 	}
 
+	if params.HybridSearch != nil {
+		return "hybrid"
+	}
+
 	return "n/a"
 }
 
@@ -2435,6 +2435,198 @@ func Test_Explorer_GetClass_With_Modules(t *testing.T) {
 	})
 }
 
+func Test_Explorer_GetClass_Hybrid(t *testing.T) {
+	t.Run("with a vector set on the hybrid params", func(t *testing.T) {
+		params := GetParams{
+			ClassName:  "BestClass",
+			Pagination: &filters.Pagination{Limit: 100},
+			HybridSearch: &searchparams.HybridSearch{
+				Query:      "some query",
+				Properties: []string{"name"},
+				Vector:     []float32{0.8, 0.2, 0.7},
+				Alpha:      0.5,
+				FusionType: searchparams.HybridRankedFusion,
+			},
+			AdditionalProperties: additional.Properties{
+				Score: true,
+			},
+		}
+
+		searchResults := []search.Result{
+			{
+				ID: "id1",
+				Schema: map[string]interface{}{
+					"name": "Foo",
+				},
+				Score: 0.016,
+				Dims:  3,
+			},
+		}
+
+		search := &fakeVectorSearcher{}
+		log, _ := test.NewNullLogger()
+		metrics := &fakeMetrics{}
+		explorer := NewExplorer(search, log, getFakeModulesProvider(), metrics)
+		expectedParamsToSearch := params
+		expectedParamsToSearch.SearchVector = []float32{0.8, 0.2, 0.7}
+		search.
+			On("HybridClassSearch", expectedParamsToSearch).
+			Return(searchResults, nil)
+		metrics.On("AddUsageDimensions", "BestClass", "get_graphql", "hybrid", 3)
+
+		res, err := explorer.GetClass(context.Background(), params)
+
+		t.Run("hybrid search must be called with right params", func(t *testing.T) {
+			assert.Nil(t, err)
+			search.AssertExpectations(t)
+		})
+
+		t.Run("response must contain the fused score", func(t *testing.T) {
+			require.Len(t, res, 1)
+
+			resMap := res[0].(map[string]interface{})
+			assert.Equal(t, "Foo", resMap["name"])
+			assert.Equal(t, map[string]interface{}{
+				"score": float32(0.016),
+			}, resMap["_additional"])
+		})
+	})
+
+	t.Run("with a nearVector argument providing the vector", func(t *testing.T) {
+		params := GetParams{
+			ClassName:  "BestClass",
+			Pagination: &filters.Pagination{Limit: 100},
+			HybridSearch: &searchparams.HybridSearch{
+				Query:      "some query",
+				Properties: []string{"name"},
+				Alpha:      0.5,
+				FusionType: searchparams.HybridRankedFusion,
+			},
+			NearVector: &searchparams.NearVector{
+				Vector: []float32{0.8, 0.2, 0.7},
+			},
+		}
+
+		searchResults := []search.Result{}
+
+		search := &fakeVectorSearcher{}
+		log, _ := test.NewNullLogger()
+		metrics := &fakeMetrics{}
+		explorer := NewExplorer(search, log, getFakeModulesProvider(), metrics)
+		expectedParamsToSearch := params
+		expectedParamsToSearch.SearchVector = []float32{0.8, 0.2, 0.7}
+		search.
+			On("HybridClassSearch", expectedParamsToSearch).
+			Return(searchResults, nil)
+
+		_, err := explorer.GetClass(context.Background(), params)
+		assert.Nil(t, err)
+		search.AssertExpectations(t)
+	})
+
+	t.Run("with invalid params", func(t *testing.T) {
+		type testcase struct {
+			name          string
+			params        GetParams
+			expectedError string
+		}
+
+		tests := []testcase{
+			{
+				name: "without any vector",
+				params: GetParams{
+					ClassName: "BestClass",
+					HybridSearch: &searchparams.HybridSearch{
+						Query:      "some query",
+						Properties: []string{"name"},
+					},
+				},
+				expectedError: "hybrid search requires a vector",
+			},
+			{
+				name: "with both a hybrid vector and nearVector",
+				params: GetParams{
+					ClassName: "BestClass",
+					HybridSearch: &searchparams.HybridSearch{
+						Query:      "some query",
+						Properties: []string{"name"},
+						Vector:     []float32{0.8, 0.2, 0.7},
+					},
+					NearVector: &searchparams.NearVector{
+						Vector: []float32{0.8, 0.2, 0.7},
+					},
+				},
+				expectedError: "conflict",
+			},
+			{
+				name: "without a query",
+				params: GetParams{
+					ClassName: "BestClass",
+					HybridSearch: &searchparams.HybridSearch{
+						Properties: []string{"name"},
+						Vector:     []float32{0.8, 0.2, 0.7},
+					},
+				},
+				expectedError: "must have query set",
+			},
+			{
+				name: "with an alpha out of range",
+				params: GetParams{
+					ClassName: "BestClass",
+					HybridSearch: &searchparams.HybridSearch{
+						Query:      "some query",
+						Properties: []string{"name"},
+						Vector:     []float32{0.8, 0.2, 0.7},
+						Alpha:      1.5,
+					},
+				},
+				expectedError: "alpha must be between 0 and 1",
+			},
+			{
+				name: "with a distance",
+				params: GetParams{
+					ClassName: "BestClass",
+					HybridSearch: &searchparams.HybridSearch{
+						Query:      "some query",
+						Properties: []string{"name"},
+					},
+					NearVector: &searchparams.NearVector{
+						Vector:       []float32{0.8, 0.2, 0.7},
+						Distance:     0.4,
+						WithDistance: true,
+					},
+				},
+				expectedError: "distance is not supported",
+			},
+			{
+				name: "with a certainty",
+				params: GetParams{
+					ClassName: "BestClass",
+					HybridSearch: &searchparams.HybridSearch{
+						Query:      "some query",
+						Properties: []string{"name"},
+					},
+					NearVector: &searchparams.NearVector{
+						Vector:    []float32{0.8, 0.2, 0.7},
+						Certainty: 0.8,
+					},
+				},
+				expectedError: "certainty is not supported",
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				explorer := NewExplorer(&fakeVectorSearcher{}, nil,
+					getFakeModulesProvider(), &fakeMetrics{})
+				_, err := explorer.GetClass(context.Background(), test.params)
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), test.expectedError)
+			})
+		}
+	})
+}
+
 func ptFloat32(in float32) *float32 {
 	return &in
 }
@@ -2565,6 +2757,76 @@ func getFakeModulesProviderWithCustomExtenders(
 	}
 }
 
+func Test_Explorer_GetClass_WithCursor(t *testing.T) {
+	t.Run("when only a cursor is set", func(t *testing.T) {
+		params := GetParams{
+			ClassName:  "BestClass",
+			Pagination: &filters.Pagination{Limit: 2},
+			Cursor: &filters.Cursor{
+				After: "8d5a3aa2-3c8d-4589-9ae1-3f638f506970",
+				Limit: 2,
+			},
+		}
+
+		searchResults := []search.Result{
+			{
+				ID: "8d5a3aa2-3c8d-4589-9ae1-3f638f506971",
+			},
+			{
+				ID: "8d5a3aa2-3c8d-4589-9ae1-3f638f506972",
+			},
+		}
+
+		search := &fakeVectorSearcher{}
+		log, _ := test.NewNullLogger()
+		metrics := &fakeMetrics{}
+		explorer := NewExplorer(search, log, getFakeModulesProvider(), metrics)
+		search.
+			On("ClassSearch", params).
+			Return(searchResults, nil)
+
+		res, err := explorer.GetClass(context.Background(), params)
+		require.Nil(t, err)
+		search.AssertExpectations(t)
+		assert.Len(t, res, 2)
+	})
+
+	t.Run("when a cursor is combined with a vector search", func(t *testing.T) {
+		params := GetParams{
+			ClassName:  "BestClass",
+			Pagination: &filters.Pagination{Limit: 2},
+			Cursor:     &filters.Cursor{Limit: 2},
+			NearVector: &searchparams.NearVector{
+				Vector: []float32{0.8, 0.2, 0.7},
+			},
+		}
+
+		search := &fakeVectorSearcher{}
+		log, _ := test.NewNullLogger()
+		explorer := NewExplorer(search, log, getFakeModulesProvider(), &fakeMetrics{})
+
+		_, err := explorer.GetClass(context.Background(), params)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "invalid 'after' parameter")
+	})
+
+	t.Run("when a cursor is combined with an offset", func(t *testing.T) {
+		params := GetParams{
+			ClassName:  "BestClass",
+			Pagination: &filters.Pagination{Offset: 5, Limit: 2},
+			Cursor:     &filters.Cursor{Limit: 2},
+		}
+
+		search := &fakeVectorSearcher{}
+		log, _ := test.NewNullLogger()
+		explorer := NewExplorer(search, log, getFakeModulesProvider(), &fakeMetrics{})
+
+		_, err := explorer.GetClass(context.Background(), params)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "offset cannot be combined with the after parameter")
+	})
+}
+
 func getFakeModulesProvider() ModulesProvider {
 	return &fakeModulesProvider{}
 }
@@ -107,6 +107,13 @@ func (f *fakeVectorSearcher) VectorClassSearch(ctx context.Context,
 	return args.Get(0).([]search.Result), args.Error(1)
 }
 
+func (f *fakeVectorSearcher) HybridClassSearch(ctx context.Context,
+	params GetParams,
+) ([]search.Result, error) {
+	args := f.Called(params)
+	return args.Get(0).([]search.Result), args.Error(1)
+}
+
 func (f *fakeVectorSearcher) ClassSearch(ctx context.Context,
 	params GetParams,
 ) ([]search.Result, error) {
@@ -117,6 +124,7 @@ func (f *fakeVectorSearcher) ClassSearch(ctx context.Context,
 func (f *fakeVectorSearcher) Object(ctx context.Context,
 	className string, id strfmt.UUID,
 	props search.SelectProperties, additional additional.Properties,
+	repl *additional.ReplicationProperties, tenant string,
 ) (*search.Result, error) {
 	args := f.Called(className, id)
 	return args.Get(0).(*search.Result), args.Error(1)
@@ -147,6 +155,7 @@ func (f *fakeVectorRepo) ObjectsByID(ctx context.Context, id strfmt.UUID,
 
 func (f *fakeVectorRepo) Object(ctx context.Context, className string, id strfmt.UUID,
 	props search.SelectProperties, additional additional.Properties,
+	repl *additional.ReplicationProperties, tenant string,
 ) (*search.Result, error) {
 	return nil, nil
 }
@@ -33,7 +33,8 @@ type nearParamsVector struct {
 
 type nearParamsSearcher interface {
 	Object(ctx context.Context, className string, id strfmt.UUID,
-		props search.SelectProperties, additional additional.Properties) (*search.Result, error)
+		props search.SelectProperties, additional additional.Properties,
+		repl *additional.ReplicationProperties, tenant string) (*search.Result, error)
 	ObjectsByID(ctx context.Context, id strfmt.UUID,
 		props search.SelectProperties, additional additional.Properties) (search.Results, error)
 }
@@ -44,7 +45,7 @@ func newNearParamsVector(modulesProvider ModulesProvider, search nearParamsSearc
 
 func (v *nearParamsVector) vectorFromParams(ctx context.Context,
 	nearVector *searchparams.NearVector, nearObject *searchparams.NearObject,
-	moduleParams map[string]interface{}, className string,
+	moduleParams map[string]interface{}, className, tenant string,
 ) ([]float32, error) {
 	err := v.validateNearParams(nearVector, nearObject, moduleParams, className)
 	if err != nil {
@@ -62,7 +63,7 @@ func (v *nearParamsVector) vectorFromParams(ctx context.Context,
 	}
 
 	if nearObject != nil {
-		vector, err := v.vectorFromNearObjectParams(ctx, className, nearObject)
+		vector, err := v.vectorFromNearObjectParams(ctx, className, nearObject, tenant)
 		if err != nil {
 			return nil, errors.Errorf("nearObject params: %v", err)
 		}
@@ -75,6 +76,34 @@ func (v *nearParamsVector) vectorFromParams(ctx context.Context,
 	panic("vectorFromParams was called without any known params present")
 }
 
+// targetVectorFromParams returns the name of the named vector the near
+// params search, an empty name refers to the default vector
+func (v *nearParamsVector) targetVectorFromParams(nearVector *searchparams.NearVector,
+	nearObject *searchparams.NearObject, moduleParams map[string]interface{},
+) string {
+	if nearVector != nil {
+		return nearVector.TargetVector
+	}
+
+	if nearObject != nil {
+		return nearObject.TargetVector
+	}
+
+	for _, value := range moduleParams {
+		if p, ok := value.(targetVectorParam); ok {
+			return p.GetTargetVector()
+		}
+	}
+
+	return ""
+}
+
+// targetVectorParam is implemented by the module search params, which
+// support selecting a named vector, such as nearText
+type targetVectorParam interface {
+	GetTargetVector() string
+}
+
 func (v *nearParamsVector) validateNearParams(nearVector *searchparams.NearVector,
 	nearObject *searchparams.NearObject,
 	moduleParams map[string]interface{}, className ...string,
@@ -162,19 +191,21 @@ func (v *nearParamsVector) findVector(ctx context.Context, className string, id
 		// Explore cross class searches where we don't have class context
 		return v.crossClassFindVector(ctx, id)
 	default:
-		return v.classFindVector(ctx, className, id)
+		return v.classFindVector(ctx, className, id, "", "")
 	}
 }
 
-func (v *nearParamsVector) classFindVector(ctx context.Context, className string, id strfmt.UUID) ([]float32, error) {
-	res, err := v.search.Object(ctx, className, id, search.SelectProperties{}, additional.Properties{})
+func (v *nearParamsVector) classFindVector(ctx context.Context, className string, id strfmt.UUID,
+	tenant, targetVector string,
+) ([]float32, error) {
+	res, err := v.search.Object(ctx, className, id, search.SelectProperties{}, additional.Properties{}, nil, tenant)
 	if err != nil {
 		return nil, err
 	}
 	if res == nil {
 		return nil, errors.New("vector not found")
 	}
-	return res.Vector, nil
+	return resultVector(*res, targetVector)
 }
 
 func (v *nearParamsVector) crossClassFindVector(ctx context.Context, id strfmt.UUID) ([]float32, error) {
@@ -196,14 +227,29 @@ func (v *nearParamsVector) crossClassFindVector(ctx context.Context, id strfmt.U
 	}
 }
 
+// resultVector returns the default vector of the result or the named vector
+// with the given name
+func resultVector(res search.Result, targetVector string) ([]float32, error) {
+	if targetVector == "" {
+		return res.Vector, nil
+	}
+
+	vector, ok := res.Vectors[targetVector]
+	if !ok {
+		return nil, errors.Errorf("object %s has no named vector %q",
+			res.ID, targetVector)
+	}
+	return vector, nil
+}
+
 func (v *nearParamsVector) crossClassVectorFromNearObjectParams(ctx context.Context,
 	params *searchparams.NearObject,
 ) ([]float32, error) {
-	return v.vectorFromNearObjectParams(ctx, "", params)
+	return v.vectorFromNearObjectParams(ctx, "", params, "")
 }
 
 func (v *nearParamsVector) vectorFromNearObjectParams(ctx context.Context,
-	className string, params *searchparams.NearObject,
+	className string, params *searchparams.NearObject, tenant string,
 ) ([]float32, error) {
 	if len(params.ID) == 0 && len(params.Beacon) == 0 {
 		return nil, errors.New("empty id and beacon")
@@ -220,9 +266,20 @@ func (v *nearParamsVector) vectorFromNearObjectParams(ctx context.Context,
 			return nil, err
 		}
 		id = ref.TargetID
-		if ref.Class != "" {
+		if ref.Class != "" && ref.Class != className {
+			// the tenant only applies to the class that is searched
 			targetClassName = ref.Class
+			tenant = ""
+		}
+	}
+
+	if tenant != "" || params.TargetVector != "" {
+		if targetClassName == "" {
+			return nil, errors.New("a target vector can only be used " +
+				"for searches within a class")
 		}
+		return v.classFindVector(ctx, targetClassName, id, tenant,
+			params.TargetVector)
 	}
 
 	return v.findVector(ctx, targetClassName, id)
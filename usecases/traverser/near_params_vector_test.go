@@ -253,6 +253,29 @@ func Test_nearParamsVector_vectorFromParams(t *testing.T) {
 			want:    []float32{1.0, 1.0, 1.0},
 			wantErr: false,
 		},
+		{
+			name: "Should get named vector from nearObject",
+			args: args{
+				nearObject: &searchparams.NearObject{
+					ID:           "uuid",
+					TargetVector: "title",
+				},
+				className: "Class",
+			},
+			want:    []float32{2.0, 2.0, 2.0},
+			wantErr: false,
+		},
+		{
+			name: "Should fail for a nearObject without the named vector",
+			args: args{
+				nearObject: &searchparams.NearObject{
+					ID:           "uuid",
+					TargetVector: "content",
+				},
+				className: "Class",
+			},
+			wantErr: true,
+		},
 		{
 			name: "Should get vector from nearObject across classes",
 			args: args{
@@ -270,7 +293,7 @@ func Test_nearParamsVector_vectorFromParams(t *testing.T) {
 				modulesProvider: &fakeModulesProvider{},
 				search:          &fakeNearParamsSearcher{},
 			}
-			got, err := e.vectorFromParams(tt.args.ctx, tt.args.nearVector, tt.args.nearObject, tt.args.moduleParams, tt.args.className)
+			got, err := e.vectorFromParams(tt.args.ctx, tt.args.nearVector, tt.args.nearObject, tt.args.moduleParams, tt.args.className, "")
 			if (err != nil) != tt.wantErr {
 				t.Errorf("nearParamsVector.vectorFromParams() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -282,6 +305,30 @@ func Test_nearParamsVector_vectorFromParams(t *testing.T) {
 	}
 }
 
+func Test_nearParamsVector_targetVectorFromParams(t *testing.T) {
+	e := &nearParamsVector{}
+
+	assert.Equal(t, "", e.targetVectorFromParams(
+		&searchparams.NearVector{Vector: []float32{1, 2, 3}}, nil, nil))
+	assert.Equal(t, "title", e.targetVectorFromParams(
+		&searchparams.NearVector{Vector: []float32{1, 2, 3}, TargetVector: "title"},
+		nil, nil))
+	assert.Equal(t, "title", e.targetVectorFromParams(nil,
+		&searchparams.NearObject{ID: "uuid", TargetVector: "title"}, nil))
+	assert.Equal(t, "title", e.targetVectorFromParams(nil, nil,
+		map[string]interface{}{"nearText": targetVectorParams{"title"}}))
+	assert.Equal(t, "", e.targetVectorFromParams(nil, nil,
+		map[string]interface{}{"nearCustomText": &nearCustomTextParams{}}))
+}
+
+type targetVectorParams struct {
+	targetVector string
+}
+
+func (p targetVectorParams) GetTargetVector() string {
+	return p.targetVector
+}
+
 func Test_nearParamsVector_extractCertaintyFromParams(t *testing.T) {
 	type args struct {
 		nearVector   *searchparams.NearVector
@@ -381,6 +428,7 @@ func (f *fakeNearParamsSearcher) ObjectsByID(ctx context.Context, id strfmt.UUID
 
 func (f *fakeNearParamsSearcher) Object(ctx context.Context, className string, id strfmt.UUID,
 	props search.SelectProperties, additional additional.Properties,
+	repl *additional.ReplicationProperties, tenant string,
 ) (*search.Result, error) {
 	if className == "SpecifiedClass" {
 		return &search.Result{
@@ -389,6 +437,9 @@ func (f *fakeNearParamsSearcher) Object(ctx context.Context, className string, i
 	} else {
 		return &search.Result{
 			Vector: []float32{1.0, 1.0, 1.0},
+			Vectors: map[string][]float32{
+				"title": {2.0, 2.0, 2.0},
+			},
 		}, nil
 	}
 }
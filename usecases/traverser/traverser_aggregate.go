@@ -47,11 +47,14 @@ func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 			return nil, err
 		}
 		searchVector, err := t.nearParamsVector.vectorFromParams(ctx,
-			params.NearVector, params.NearObject, params.ModuleParams, className)
+			params.NearVector, params.NearObject, params.ModuleParams, className,
+			params.Tenant)
 		if err != nil {
 			return nil, err
 		}
 		params.SearchVector = searchVector
+		params.TargetVector = t.nearParamsVector.targetVectorFromParams(
+			params.NearVector, params.NearObject, params.ModuleParams)
 		certainty := t.nearParamsVector.extractCertaintyFromParams(params.NearVector,
 			params.NearObject, params.ModuleParams)
 
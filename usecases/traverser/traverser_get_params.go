@@ -19,18 +19,30 @@ import (
 )
 
 type GetParams struct {
-	Filters              *filters.LocalFilter
-	ClassName            string
-	Pagination           *filters.Pagination
-	Sort                 []filters.Sort
-	Properties           search.SelectProperties
-	NearVector           *searchparams.NearVector
-	NearObject           *searchparams.NearObject
-	KeywordRanking       *searchparams.KeywordRanking
-	SearchVector         []float32
+	Filters        *filters.LocalFilter
+	ClassName      string
+	Pagination     *filters.Pagination
+	Cursor         *filters.Cursor
+	Sort           []filters.Sort
+	Properties     search.SelectProperties
+	NearVector     *searchparams.NearVector
+	NearObject     *searchparams.NearObject
+	KeywordRanking *searchparams.KeywordRanking
+	HybridSearch   *searchparams.HybridSearch
+	SearchVector   []float32
+	// TargetVector is the name of the named vector to search, the default
+	// vector is searched if it is empty
+	TargetVector         string
 	Group                *GroupParams
 	ModuleParams         map[string]interface{}
 	AdditionalProperties additional.Properties
+
+	// ReplicationProperties control how many replicas of a shard have to
+	// take part in the search, see refreshFromReplicas in the db
+	ReplicationProperties *additional.ReplicationProperties
+
+	// Tenant restricts the search to a single tenant of a multi-tenant class
+	Tenant string
 }
 
 type GroupParams struct {
@@ -18,6 +18,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/vectorindex/flat"
 	"github.com/semi-technologies/weaviate/entities/vectorindex/hnsw"
 )
 
@@ -57,14 +58,14 @@ func (t *Traverser) validateCrossClassDistanceCompatibility() (distType string,
 			continue
 		}
 
-		hnswConfig, assertErr := typeAssertVectorIndex(class)
+		distance, assertErr := vectorIndexDistance(class)
 		if assertErr != nil {
 			err = assertErr
 			return
 		}
 
-		distancerTypes[hnswConfig.Distance] = struct{}{}
-		classDistanceConfigs[class.Class] = hnswConfig.Distance
+		distancerTypes[distance] = struct{}{}
+		classDistanceConfigs[class.Class] = distance
 	}
 
 	if len(distancerTypes) != 1 {
@@ -102,26 +103,28 @@ func (t *Traverser) validateGetDistanceParams(params GetParams) error {
 		return fmt.Errorf("failed to find class '%s' in schema", params.ClassName)
 	}
 
-	hnswConfig, err := typeAssertVectorIndex(class)
+	distance, err := vectorIndexDistance(class)
 	if err != nil {
 		return err
 	}
 
-	if hnswConfig.Distance != hnsw.DistanceCosine {
-		return certaintyUnsupportedError(hnswConfig.Distance)
+	if distance != hnsw.DistanceCosine {
+		return certaintyUnsupportedError(distance)
 	}
 
 	return nil
 }
 
-func typeAssertVectorIndex(class *models.Class) (hnsw.UserConfig, error) {
-	hnswConfig, ok := class.VectorIndexConfig.(hnsw.UserConfig)
-	if !ok {
-		return hnsw.UserConfig{}, fmt.Errorf("class '%s' vector index: config is not hnsw.UserConfig: %T",
-			class.Class, class.VectorIndexConfig)
+func vectorIndexDistance(class *models.Class) (string, error) {
+	switch config := class.VectorIndexConfig.(type) {
+	case hnsw.UserConfig:
+		return config.Distance, nil
+	case flat.UserConfig:
+		return config.Distance, nil
+	default:
+		return "", fmt.Errorf("class '%s' vector index: config is neither hnsw.UserConfig "+
+			"nor flat.UserConfig: %T", class.Class, class.VectorIndexConfig)
 	}
-
-	return hnswConfig, nil
 }
 
 func crossClassDistCompatError(classDistanceConfigs map[string]string) error {